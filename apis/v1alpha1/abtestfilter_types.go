@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ABTestFilter is a filter that makes the split of the requests between the backendRefs of the HTTPRoute rules
+// that reference it with an extensionRef filter sticky to the clients, so that the clients of an A/B experiment
+// don't switch between the variants across requests. The variants are the backendRefs of the rule, and
+// the requests are split between them by their weights.
+type ABTestFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ABTestFilter.
+	Spec ABTestFilterSpec `json:"spec"`
+
+	// Status defines the state of the ABTestFilter.
+	Status FilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ABTestFilterList contains a list of ABTestFilters.
+type ABTestFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ABTestFilter `json:"items"`
+}
+
+// ABTestFilterSpec defines the desired state of the ABTestFilter.
+type ABTestFilterSpec struct {
+	// OverrideHeader is the name of a request header that selects the variant of a request by the name
+	// of the Service of a backendRef of the rule, for example, to test a variant before it gets any traffic.
+	// The requests without the header, or with a value that is not the name of such a Service,
+	// are split by the assignment cookie.
+	//
+	// +optional
+	OverrideHeader *v1.HTTPHeaderName `json:"overrideHeader,omitempty"`
+
+	// Cookie configures the cookie that assigns a client to a variant. NGINX sets the cookie to a random key
+	// in the response to the first request of a client, and splits the requests between the variants by the key,
+	// so that the later requests of the client are proxied to the same variant as long as the weights
+	// of the backendRefs don't change.
+	Cookie ABTestCookie `json:"cookie"`
+}
+
+// ABTestCookie configures the cookie that assigns a client to a variant.
+type ABTestCookie struct {
+	// MaxAge is the time after which the cookie expires, and the client may be assigned to another variant.
+	// If not set, the cookie expires when the browser session ends.
+	//
+	// +optional
+	MaxAge *Duration `json:"maxAge,omitempty"`
+
+	// Path is the path of the cookie. Default is "/", so that the client is assigned to the same variant
+	// by all rules that use the cookie.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9\-._~/]*$`
+	Path *string `json:"path,omitempty"`
+
+	// Name is the name of the cookie.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]+$`
+	Name string `json:"name"`
+}
@@ -0,0 +1,119 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=botpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// BotMitigationPolicy is a Direct Attached Policy. It blocks or challenges the requests of bots and scanners
+// to HTTPRoutes and GRPCRoutes, based on the User-Agent header and the headers that browsers always send.
+type BotMitigationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the BotMitigationPolicy.
+	Spec BotMitigationPolicySpec `json:"spec"`
+
+	// Status defines the state of the BotMitigationPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BotMitigationPolicyList contains a list of BotMitigationPolicies.
+type BotMitigationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BotMitigationPolicy `json:"items"`
+}
+
+// BotMitigationPolicySpec defines the desired state of the BotMitigationPolicy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of blockKnownScanners, userAgentPatterns, or requiredHeaders must be specified",rule="(has(self.blockKnownScanners) && self.blockKnownScanners) || has(self.userAgentPatterns) || has(self.requiredHeaders)"
+//
+//nolint:lll
+type BotMitigationPolicySpec struct {
+	// Action defines what NGINX does with the requests of bots.
+	// Default: Block.
+	//
+	// +optional
+	Action *BotMitigationAction `json:"action,omitempty"`
+
+	// BlockKnownScanners treats the requests with the User-Agent of well-known vulnerability scanners,
+	// such as sqlmap or Nikto, as requests of bots.
+	//
+	// +optional
+	BlockKnownScanners *bool `json:"blockKnownScanners,omitempty"`
+
+	// UserAgentPatterns treats the requests with a User-Agent that matches any of the regular expressions
+	// as requests of bots. The regular expressions are case-insensitive.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MaxLength=256
+	UserAgentPatterns []string `json:"userAgentPatterns,omitempty"`
+
+	// RequiredHeaders treats the requests that don't have all the headers as requests of bots.
+	// For example, browsers always send the Accept and Accept-Language headers.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:Pattern=`^[A-Za-z0-9-]+$`
+	RequiredHeaders []string `json:"requiredHeaders,omitempty"`
+
+	// VerifiedCrawlers allows the requests of crawlers, such as search engine crawlers, even if they match
+	// the other rules of the policy. A request is allowed only if its User-Agent matches the crawler and it comes
+	// from one of the source CIDRs of the crawler. The requests that claim to be from a crawler, but come from
+	// other addresses, are treated as requests of bots.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	VerifiedCrawlers []VerifiedCrawler `json:"verifiedCrawlers,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute, GRPCRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: HTTPRoute or GRPCRoute",rule="(self.exists(t, t.kind=='HTTPRoute') || self.exists(t, t.kind=='GRPCRoute'))"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// BotMitigationAction defines what NGINX does with the requests of bots.
+//
+// +kubebuilder:validation:Enum=Block;Challenge
+type BotMitigationAction string
+
+const (
+	// BotMitigationActionBlock rejects the requests of bots with the status code 403.
+	BotMitigationActionBlock BotMitigationAction = "Block"
+	// BotMitigationActionChallenge responds to the requests of bots with a page that sets a cookie with JavaScript
+	// and reloads the page. The clients that don't run JavaScript, such as most scanners, can't pass the challenge.
+	BotMitigationActionChallenge BotMitigationAction = "Challenge"
+)
+
+// VerifiedCrawler defines a crawler that is allowed.
+type VerifiedCrawler struct {
+	// UserAgentPattern is a case-insensitive regular expression that matches the User-Agent of the crawler.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	UserAgentPattern string `json:"userAgentPattern"`
+
+	// SourceCIDRs are the CIDRs of the addresses that the crawler sends requests from.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	SourceCIDRs []string `json:"sourceCIDRs"`
+}
@@ -36,12 +36,27 @@ type ClientSettingsPolicyList struct {
 }
 
 // ClientSettingsPolicySpec defines the desired state of ClientSettingsPolicy.
+//
+// +kubebuilder:validation:XValidation:message="header can only be specified if the targetRef kind is Gateway",rule="!(has(self.header) && self.targetRef.kind != 'Gateway')"
+//
+//nolint:lll
 type ClientSettingsPolicySpec struct {
+	// Bandwidth defines the bandwidth settings of the responses to clients.
+	//
+	// +optional
+	Bandwidth *ClientBandwidth `json:"bandwidth,omitempty"`
+
 	// Body defines the client request body settings.
 	//
 	// +optional
 	Body *ClientBody `json:"body,omitempty"`
 
+	// Header defines the client request header settings.
+	// Header settings can only be specified if the policy targets a Gateway.
+	//
+	// +optional
+	Header *ClientHeader `json:"header,omitempty"`
+
 	// KeepAlive defines the keep-alive settings.
 	//
 	// +optional
@@ -50,11 +65,32 @@ type ClientSettingsPolicySpec struct {
 	// TargetRef identifies an API object to apply the policy to.
 	// Object must be in the same namespace as the policy.
 	// Support: Gateway, HTTPRoute, GRPCRoute.
+	// SectionName is only supported for a Gateway, to apply the policy to a single Listener of the Gateway.
 	//
 	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="(self.kind=='Gateway' || self.kind=='HTTPRoute' || self.kind=='GRPCRoute')"
 	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="(self.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef SectionName is only supported for Gateway",rule="(!has(self.sectionName) || self.kind=='Gateway')"
 	//nolint:lll
-	TargetRef gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRef"`
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
+}
+
+// ClientBandwidth contains the settings that limit the rate of the transmission of responses to clients,
+// for example, to throttle large file downloads and protect the bandwidth of the backends.
+// The limits are set per request, so a client can exceed them by opening multiple connections.
+type ClientBandwidth struct {
+	// Rate limits the rate of the transmission of a response to a client, in bytes per second.
+	// Setting the rate to 0 disables rate limiting.
+	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#limit_rate.
+	//
+	// +optional
+	Rate *Size `json:"rate,omitempty"`
+
+	// RateAfter sets the initial amount of a response that is transmitted to a client before the transmission
+	// is rate limited.
+	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#limit_rate_after.
+	//
+	// +optional
+	RateAfter *Size `json:"rateAfter,omitempty"`
 }
 
 // ClientBody contains the settings for the client request body.
@@ -78,6 +114,46 @@ type ClientBody struct {
 	Timeout *Duration `json:"timeout,omitempty"`
 }
 
+// ClientHeader contains the settings for the client request header.
+// If the settings differ between the listeners of the same port, NGINX may use the settings of the default server.
+type ClientHeader struct {
+	// BufferSize sets the buffer size for reading the client request header. For most requests, a buffer of 1K bytes
+	// is enough. However, if a request includes long cookies, or comes from a WAP client, it may not fit into 1K.
+	// If a request line or a request header field does not fit into this buffer then larger buffers,
+	// configured by LargeBuffers, are allocated.
+	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#client_header_buffer_size.
+	//
+	// +optional
+	BufferSize *Size `json:"bufferSize,omitempty"`
+
+	// LargeBuffers sets the maximum number and size of buffers used for reading large client request headers.
+	// A request line cannot exceed the size of one buffer, or the 414 (Request-URI Too Large) error is returned
+	// to the client. A request header field cannot exceed the size of one buffer as well, or the
+	// 400 (Bad Request) error is returned to the client.
+	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#large_client_header_buffers.
+	//
+	// +optional
+	LargeBuffers *ClientLargeHeaderBuffers `json:"largeBuffers,omitempty"`
+
+	// Timeout defines a timeout for reading the client request header. If a client does not transmit the entire
+	// header within this time, the request is terminated with the 408 (Request Time-out) error.
+	// Default: https://nginx.org/en/docs/http/ngx_http_core_module.html#client_header_timeout.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// ClientLargeHeaderBuffers defines the buffers used for reading large client request headers.
+type ClientLargeHeaderBuffers struct {
+	// Size is the size of each buffer.
+	Size Size `json:"size"`
+
+	// Number is the maximum number of buffers.
+	//
+	// +kubebuilder:validation:Minimum=1
+	Number int32 `json:"number"`
+}
+
 // ClientKeepAlive defines the keep-alive settings for clients.
 type ClientKeepAlive struct {
 	// Requests sets the maximum number of requests that can be served through one keep-alive connection.
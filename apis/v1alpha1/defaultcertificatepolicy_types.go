@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=dcpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// DefaultCertificatePolicy is a Direct Attached Policy. It configures the certificate that NGINX presents to
+// the clients that connect to an HTTPS Listener of the Gateway without SNI, or with a server name that none of
+// the Listeners match. Without a DefaultCertificatePolicy, NGINX rejects the TLS handshake of those clients.
+type DefaultCertificatePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the DefaultCertificatePolicy.
+	Spec DefaultCertificatePolicySpec `json:"spec"`
+
+	// Status defines the state of the DefaultCertificatePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefaultCertificatePolicyList contains a list of DefaultCertificatePolicies.
+type DefaultCertificatePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DefaultCertificatePolicy `json:"items"`
+}
+
+// DefaultCertificatePolicySpec defines the desired state of the DefaultCertificatePolicy.
+type DefaultCertificatePolicySpec struct {
+	// CertificateRef references the certificate and the private key that NGINX presents to the clients
+	// without SNI or with an unmatched server name. The requests of those clients are answered with a 404.
+	CertificateRef DefaultCertificateReference `json:"certificateRef"`
+
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// Support: Gateway.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: Gateway",rule="self.kind=='Gateway'"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.group=='gateway.networking.k8s.io'"
+	//nolint:lll
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRef"`
+}
+
+// DefaultCertificateReference references a Secret or an ExternalCertificate that holds a certificate
+// and a private key. A Secret must be in the same namespace as the policy, and hold the certificate
+// in the same formats as the Secrets of the Listeners.
+type DefaultCertificateReference struct {
+	// Group is the group of the referent. It must be "" for a Secret, and gateway.nginx.org
+	// for an ExternalCertificate.
+	//
+	// +optional
+	// +kubebuilder:default=""
+	Group *gatewayv1.Group `json:"group,omitempty"`
+
+	// Kind is the kind of the referent.
+	//
+	// +optional
+	// +kubebuilder:default=Secret
+	// +kubebuilder:validation:Enum=Secret;ExternalCertificate
+	Kind *gatewayv1.Kind `json:"kind,omitempty"`
+
+	// Name is the name of the referent.
+	Name gatewayv1.ObjectName `json:"name"`
+}
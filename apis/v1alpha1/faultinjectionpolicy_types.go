@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=fipolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// FaultInjectionPolicy is a Direct Attached Policy. It provides a way to inject faults, such as delays and aborted
+// requests, into the traffic of HTTPRoutes, so that the resilience of the clients of the backends can be tested.
+type FaultInjectionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the FaultInjectionPolicy.
+	Spec FaultInjectionPolicySpec `json:"spec"`
+
+	// Status defines the state of the FaultInjectionPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FaultInjectionPolicyList contains a list of FaultInjectionPolicies.
+type FaultInjectionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FaultInjectionPolicy `json:"items"`
+}
+
+// FaultInjectionPolicySpec defines the desired state of the FaultInjectionPolicy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of delay or abort must be specified",rule="has(self.delay) || has(self.abort)"
+//
+//nolint:lll
+type FaultInjectionPolicySpec struct {
+	// Delay injects a fixed delay before NGINX proxies the requests to the backends.
+	//
+	// +optional
+	Delay *FaultDelay `json:"delay,omitempty"`
+
+	// Abort aborts the requests with a status code instead of proxying them to the backends.
+	// The aborted requests are not delayed.
+	//
+	// +optional
+	Abort *FaultAbort `json:"abort,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: HTTPRoute",rule="self.all(t, t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// FaultDelay injects a fixed delay into a percentage of the requests.
+type FaultDelay struct {
+	// Percentage is the percentage of the requests to delay. Integer from 0 to 100.
+	// By default, all requests are delayed.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+
+	// Duration is the delay. The max delay is 1 hour.
+	Duration Duration `json:"duration"`
+}
+
+// FaultAbort aborts a percentage of the requests with a status code.
+type FaultAbort struct {
+	// Percentage is the percentage of the requests to abort. Integer from 0 to 100.
+	// By default, all requests are aborted.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+
+	// StatusCode is the status code of the response to the aborted requests.
+	//
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	StatusCode int32 `json:"statusCode"`
+}
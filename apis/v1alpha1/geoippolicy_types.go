@@ -0,0 +1,116 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=geoippolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// GeoIPPolicy is a Direct Attached Policy. It allows or blocks the requests to HTTPRoutes and GRPCRoutes
+// based on the country of the client, and passes the location of the client to the backends in request headers.
+// The location of the client is looked up in the GeoIP database of the NginxProxy resource.
+type GeoIPPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the GeoIPPolicy.
+	Spec GeoIPPolicySpec `json:"spec"`
+
+	// Status defines the state of the GeoIPPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GeoIPPolicyList contains a list of GeoIPPolicies.
+type GeoIPPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GeoIPPolicy `json:"items"`
+}
+
+// GeoIPPolicySpec defines the desired state of the GeoIPPolicy.
+//
+// +kubebuilder:validation:XValidation:message="allowCountries and blockCountries cannot both be specified",rule="!(has(self.allowCountries) && has(self.blockCountries))"
+// +kubebuilder:validation:XValidation:message="at least one of allowCountries, blockCountries, or requestHeaders must be specified",rule="has(self.allowCountries) || has(self.blockCountries) || has(self.requestHeaders)"
+//
+//nolint:lll
+type GeoIPPolicySpec struct {
+	// AllowCountries only allows the requests from the countries. The requests from other countries,
+	// and the requests from addresses that are not in the database, such as private addresses,
+	// are rejected with the status code 403.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=250
+	AllowCountries []CountryCode `json:"allowCountries,omitempty"`
+
+	// BlockCountries rejects the requests from the countries with the status code 403.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=250
+	BlockCountries []CountryCode `json:"blockCountries,omitempty"`
+
+	// RequestHeaders sets request headers to the location of the client before the requests are passed
+	// to the backends. The value of a header is empty if the location of the client is unknown.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	RequestHeaders []GeoIPRequestHeader `json:"requestHeaders,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute, GRPCRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: HTTPRoute or GRPCRoute",rule="(self.exists(t, t.kind=='HTTPRoute') || self.exists(t, t.kind=='GRPCRoute'))"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// CountryCode is an ISO 3166-1 alpha-2 country code, such as US or DE.
+//
+// +kubebuilder:validation:Pattern=`^[A-Z]{2}$`
+type CountryCode string
+
+// GeoIPRequestHeader defines a request header that is set to a field of the location of the client.
+type GeoIPRequestHeader struct {
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	Name string `json:"name"`
+
+	// Field is the field of the location of the client that the header is set to.
+	Field GeoIPField `json:"field"`
+}
+
+// GeoIPField is a field of the location of the client in the GeoIP database.
+//
+// +kubebuilder:validation:Enum=CountryCode;CountryName;ContinentCode;CityName;SubdivisionCode
+type GeoIPField string
+
+const (
+	// GeoIPFieldCountryCode is the ISO 3166-1 alpha-2 code of the country, such as US.
+	GeoIPFieldCountryCode GeoIPField = "CountryCode"
+	// GeoIPFieldCountryName is the English name of the country, such as United States.
+	GeoIPFieldCountryName GeoIPField = "CountryName"
+	// GeoIPFieldContinentCode is the code of the continent, such as NA.
+	GeoIPFieldContinentCode GeoIPField = "ContinentCode"
+	// GeoIPFieldCityName is the English name of the city. Requires a City database.
+	GeoIPFieldCityName GeoIPField = "CityName"
+	// GeoIPFieldSubdivisionCode is the ISO 3166-2 code of the first subdivision, such as the state CA.
+	// Requires a City database.
+	GeoIPFieldSubdivisionCode GeoIPField = "SubdivisionCode"
+)
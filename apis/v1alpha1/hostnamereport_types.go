@@ -0,0 +1,100 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=hnreport
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HostnameReport lists the hostnames that the Routes attached to a Gateway claim, by namespace and Route, and the
+// hostname conflicts, so that platform administrators can audit the hostname usage of the tenants of a shared Gateway.
+//
+// NGINX Gateway Fabric generates the report when the HostnameReport feature is enabled. The report has the same name
+// and namespace as its Gateway, and is deleted together with the Gateway.
+type HostnameReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Hostnames are the hostnames that the Routes attached to the Gateway claim, sorted by hostname.
+	// The Routes that a parent HTTPRoute delegates path prefixes to are not listed, because they serve the
+	// hostnames of the parent HTTPRoute.
+	//
+	// +optional
+	Hostnames []HostnameUsage `json:"hostnames,omitempty"`
+
+	// Conflicts are the hostnames that are claimed by more than one tenant, or whose Listeners or Routes are not
+	// accepted because of a conflict, sorted by hostname.
+	//
+	// +optional
+	Conflicts []HostnameConflict `json:"conflicts,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HostnameReportList contains a list of HostnameReports.
+type HostnameReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HostnameReport `json:"items"`
+}
+
+// HostnameUsage lists the Routes that claim a hostname.
+type HostnameUsage struct {
+	// Hostname is the hostname. A Route that has no hostnames and is attached to a Listener that has no hostname
+	// claims all hostnames, which is reported as "*".
+	Hostname string `json:"hostname"`
+
+	// Namespaces are the namespaces of the Routes that claim the hostname, sorted alphabetically.
+	Namespaces []string `json:"namespaces"`
+
+	// Routes are the Routes that claim the hostname, sorted by namespace, name, and kind.
+	Routes []HostnameRoute `json:"routes"`
+}
+
+// HostnameRoute is a Route that claims a hostname.
+type HostnameRoute struct {
+	// Kind is the kind of the Route.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the Route.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the Route.
+	Name string `json:"name"`
+
+	// Listeners are the names of the Listeners of the Gateway on which the Route claims the hostname.
+	Listeners []string `json:"listeners"`
+}
+
+// HostnameConflict describes a conflict of a hostname.
+type HostnameConflict struct {
+	// Hostname is the hostname.
+	Hostname string `json:"hostname"`
+
+	// Reason is the reason of the conflict.
+	Reason HostnameConflictReason `json:"reason"`
+
+	// Message explains the conflict.
+	Message string `json:"message"`
+}
+
+// HostnameConflictReason is the reason of a hostname conflict.
+//
+// +kubebuilder:validation:Enum=MultipleNamespaces;ListenerConflict;RouteConflict
+type HostnameConflictReason string
+
+const (
+	// HostnameConflictReasonMultipleNamespaces is used when the Routes of more than one namespace claim
+	// the hostname. The traffic to the hostname is split between the tenants by path.
+	HostnameConflictReasonMultipleNamespaces HostnameConflictReason = "MultipleNamespaces"
+
+	// HostnameConflictReasonListenerConflict is used when a Listener of the Gateway is not accepted,
+	// because its hostname conflicts with another Listener on the same port.
+	HostnameConflictReasonListenerConflict HostnameConflictReason = "ListenerConflict"
+
+	// HostnameConflictReasonRouteConflict is used when a Route is not attached to the Gateway,
+	// because its hostname conflicts with another Route of the same kind on the same port.
+	HostnameConflictReasonRouteConflict HostnameConflictReason = "RouteConflict"
+)
@@ -0,0 +1,173 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=modsecpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// ModSecurityPolicy is a Direct Attached Policy. It protects the traffic of HTTPRoutes and GRPCRoutes with
+// the ModSecurity web application firewall and the OWASP Core Rule Set.
+// ModSecurityPolicy is only supported by NGINX. With NGINX Plus, use the WAFPolicy instead.
+type ModSecurityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ModSecurityPolicy.
+	Spec ModSecurityPolicySpec `json:"spec"`
+
+	// Status defines the state of the ModSecurityPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ModSecurityPolicyList contains a list of ModSecurityPolicies.
+type ModSecurityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ModSecurityPolicy `json:"items"`
+}
+
+// ModSecurityPolicySpec defines the desired state of the ModSecurityPolicy.
+type ModSecurityPolicySpec struct {
+	// Mode defines whether ModSecurity blocks the requests that match the rules, or only logs them.
+	// Default: Block.
+	//
+	// +optional
+	Mode *ModSecurityMode `json:"mode,omitempty"`
+
+	// ParanoiaLevel is the paranoia level of the OWASP Core Rule Set. A higher paranoia level enables more
+	// rules, which detect more attacks, but also block more legitimate requests.
+	// Default: 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4
+	ParanoiaLevel *int32 `json:"paranoiaLevel,omitempty"`
+
+	// RuleExclusions removes rules of the OWASP Core Rule Set that block legitimate requests of the targeted Routes.
+	//
+	// +optional
+	RuleExclusions *ModSecurityRuleExclusions `json:"ruleExclusions,omitempty"`
+
+	// AuditLog defines the audit log of the requests that match the rules.
+	// By default, the audit log is disabled.
+	//
+	// +optional
+	AuditLog *ModSecurityAuditLog `json:"auditLog,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute, GRPCRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: HTTPRoute or GRPCRoute",rule="(self.exists(t, t.kind=='HTTPRoute') || self.exists(t, t.kind=='GRPCRoute'))"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// ModSecurityMode defines what ModSecurity does with the requests that match the rules.
+//
+// +kubebuilder:validation:Enum=Block;Detect
+type ModSecurityMode string
+
+const (
+	// ModSecurityModeBlock blocks the requests that match the rules.
+	ModSecurityModeBlock ModSecurityMode = "Block"
+	// ModSecurityModeDetect only logs the requests that match the rules, without blocking them.
+	ModSecurityModeDetect ModSecurityMode = "Detect"
+)
+
+// ModSecurityRuleExclusions defines the rules that are removed.
+type ModSecurityRuleExclusions struct {
+	// RuleIDs are the IDs of the removed rules.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:Minimum=1
+	RuleIDs []int32 `json:"ruleIDs,omitempty"`
+
+	// Tags are the tags of the removed rules, such as "attack-sqli".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[a-zA-Z0-9._/-]+$`
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ModSecurityAuditLog defines the audit log of ModSecurity.
+//
+// +kubebuilder:validation:XValidation:message="url must be specified for destination HTTPS",rule="self.destination != 'HTTPS' || has(self.url)"
+// +kubebuilder:validation:XValidation:message="url can only be specified for destination HTTPS",rule="self.destination == 'HTTPS' || !has(self.url)"
+//
+//nolint:lll
+type ModSecurityAuditLog struct {
+	// Relevance defines which requests are logged.
+	// Default: RelevantOnly.
+	//
+	// +optional
+	Relevance *ModSecurityAuditLogRelevance `json:"relevance,omitempty"`
+
+	// Format is the format of the audit log entries.
+	// Default: JSON.
+	//
+	// +optional
+	Format *ModSecurityAuditLogFormat `json:"format,omitempty"`
+
+	// URL is the HTTPS URL of the collector that the audit log entries are shipped to.
+	// Only used if the destination is HTTPS.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https://[a-zA-Z0-9.-]+(:[0-9]{1,5})?(/[a-zA-Z0-9._~/-]*)?$`
+	URL *string `json:"url,omitempty"`
+
+	// Destination is where the audit log entries are sent.
+	Destination ModSecurityAuditLogDestination `json:"destination"`
+}
+
+// ModSecurityAuditLogRelevance defines which requests are logged in the audit log.
+//
+// +kubebuilder:validation:Enum=RelevantOnly;All
+type ModSecurityAuditLogRelevance string
+
+const (
+	// ModSecurityAuditLogRelevanceRelevantOnly logs the requests that match the rules, or that fail with
+	// a server error.
+	ModSecurityAuditLogRelevanceRelevantOnly ModSecurityAuditLogRelevance = "RelevantOnly"
+	// ModSecurityAuditLogRelevanceAll logs all requests.
+	ModSecurityAuditLogRelevanceAll ModSecurityAuditLogRelevance = "All"
+)
+
+// ModSecurityAuditLogFormat is the format of the audit log entries.
+//
+// +kubebuilder:validation:Enum=JSON;Native
+type ModSecurityAuditLogFormat string
+
+const (
+	// ModSecurityAuditLogFormatJSON logs the entries in JSON.
+	ModSecurityAuditLogFormatJSON ModSecurityAuditLogFormat = "JSON"
+	// ModSecurityAuditLogFormatNative logs the entries in the native format of ModSecurity.
+	ModSecurityAuditLogFormatNative ModSecurityAuditLogFormat = "Native"
+)
+
+// ModSecurityAuditLogDestination is the destination of the audit log entries.
+//
+// +kubebuilder:validation:Enum=Stdout;HTTPS
+type ModSecurityAuditLogDestination string
+
+const (
+	// ModSecurityAuditLogDestinationStdout writes the entries to the stdout of the NGINX container.
+	ModSecurityAuditLogDestinationStdout ModSecurityAuditLogDestination = "Stdout"
+	// ModSecurityAuditLogDestinationHTTPS ships the entries to an HTTPS collector.
+	ModSecurityAuditLogDestinationHTTPS ModSecurityAuditLogDestination = "HTTPS"
+)
@@ -36,6 +36,11 @@ type NginxGatewaySpec struct {
 	//
 	// +optional
 	Logging *Logging `json:"logging,omitempty"`
+
+	// EventBatching defines settings for batching the changes to the cluster resources.
+	//
+	// +optional
+	EventBatching *EventBatching `json:"eventBatching,omitempty"`
 }
 
 // Logging defines logging related settings for the control plane.
@@ -63,6 +68,19 @@ const (
 	ControllerLogLevelError ControllerLogLevel = "error"
 )
 
+// EventBatching defines settings for batching the changes to the cluster resources.
+// The control plane handles the changes in batches. Every batch results in at most one NGINX reload.
+type EventBatching struct {
+	// Delay is the time the control plane waits for more changes after it receives a change while
+	// it is idle, before it handles the batch. A longer delay results in fewer NGINX reloads
+	// when many resources change at once, at the cost of a slower reaction to a single change.
+	// A delay of 0s means the control plane handles a change immediately.
+	//
+	// +optional
+	// +kubebuilder:default="0s"
+	Delay *Duration `json:"delay,omitempty"`
+}
+
 // NginxGatewayStatus defines the state of the NginxGateway.
 type NginxGatewayStatus struct {
 	// +optional
@@ -1,6 +1,9 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
 
 // +genclient
 // +kubebuilder:object:root=true
@@ -54,16 +57,401 @@ type NginxProxySpec struct {
 	// +optional
 	Telemetry *Telemetry `json:"telemetry,omitempty"`
 	// RewriteClientIP defines configuration for rewriting the client IP to the original client's IP.
-	// +kubebuilder:validation:XValidation:message="if mode is set, trustedAddresses is a required field",rule="!(has(self.mode) && (!has(self.trustedAddresses) || size(self.trustedAddresses) == 0))"
+	// +kubebuilder:validation:XValidation:message="if mode is set, trustedAddresses or trustedHops is a required field",rule="!(has(self.mode) && (!has(self.trustedAddresses) || size(self.trustedAddresses) == 0) && (!has(self.trustedHops) || size(self.trustedHops) == 0))"
+	// +kubebuilder:validation:XValidation:message="trustedHops requires mode to be XForwardedFor",rule="!has(self.trustedHops) || (has(self.mode) && self.mode == 'XForwardedFor')"
 	//
 	// +optional
 	//nolint:lll
 	RewriteClientIP *RewriteClientIP `json:"rewriteClientIP,omitempty"`
+	// HTTPSRedirect configures NGINX to generate HTTP servers that redirect requests to HTTPS
+	// for the hostnames of all HTTPS listeners. This removes the need to create an HTTPRoute
+	// with a RequestRedirect filter for each hostname. A hostname that is already served on the redirect port
+	// by an HTTP listener with attached routes is not redirected. Listeners without a hostname are ignored.
+	// A Gateway with the gateway.nginx.org/https-redirect-listeners annotation only redirects for the hostnames
+	// of the listed HTTPS listeners, and does so even if HTTPSRedirect is not set.
+	//
+	// +optional
+	HTTPSRedirect *HTTPSRedirect `json:"httpsRedirect,omitempty"`
+	// ServerHeader configures the Server response header and the NGINX version shown on error pages.
+	//
+	// +optional
+	ServerHeader *ServerHeader `json:"serverHeader,omitempty"`
+	// UpstreamZoneSize overrides the size of the shared memory zone of every upstream.
+	// By default, the zone size is calculated from the number of endpoints of each upstream,
+	// so that large Services do not overflow the zone. NGINX requires a zone size of at least 32k.
+	// Sets NGINX directive zone: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#zone
+	//
+	// +optional
+	UpstreamZoneSize *Size `json:"upstreamZoneSize,omitempty"`
+	// UpstreamDrainTimeout keeps the upstream of a backend that is no longer referenced by any Route rule
+	// in the NGINX configuration for the duration before removing it, so that a weight change between two
+	// backends, such as a blue/green cutover from weight 100/0 to 0/100, or the removal of a backendRef,
+	// doesn't drop the requests in flight to the old backend. NGF emits an UpstreamDrained Event for the Gateway
+	// once the upstream is removed. A backend with the weight 0 stays referenced, so its upstream is kept until
+	// the backendRef is removed. By default, the upstreams are removed immediately.
+	//
+	// +optional
+	UpstreamDrainTimeout *Duration `json:"upstreamDrainTimeout,omitempty"`
+	// ScaleFromZero configures NGINX to forward the requests for Services without ready endpoints to an activator,
+	// such as the interceptor of the KEDA HTTP add-on or the Knative activator. The activator holds the requests,
+	// scales the Service up from zero replicas, and forwards the requests once the Service is ready.
+	// Only the Services annotated with gateway.nginx.org/scale-from-zero: "true" are forwarded to the activator.
+	//
+	// +optional
+	ScaleFromZero *ScaleFromZero `json:"scaleFromZero,omitempty"`
+	// ConnectionLimits configures the limits on the connections and requests that NGINX accepts,
+	// so that the traffic spike of a single hostname or client can't exhaust the shared data plane.
+	//
+	// +optional
+	ConnectionLimits *ConnectionLimits `json:"connectionLimits,omitempty"`
+	// GeoIP configures the GeoIP2 module, which looks up the country and city of the client IP address
+	// in a MaxMind database. GeoIPPolicies and the GeoIP fields of the access log require it.
+	// The database file must be mounted into the NGINX container, for example from a ConfigMap or a volume
+	// with the extraVolumes and nginx.extraVolumeMounts Helm values.
+	//
+	// +optional
+	GeoIP *GeoIP `json:"geoIP,omitempty"`
+	// Logging configures the logs of NGINX.
+	//
+	// +optional
+	Logging *NginxLogging `json:"logging,omitempty"`
+	// DefaultServers configures the response of the catch-all default servers that handle requests
+	// which do not match the hostname of any listener or route. By default, NGINX returns a 404.
+	// Only HTTP listener ports are supported, because the default server of an HTTPS listener port
+	// rejects the TLS handshake.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	DefaultServers []DefaultServer `json:"defaultServers,omitempty"`
 	// DisableHTTP2 defines if http2 should be disabled for all servers.
 	// Default is false, meaning http2 will be enabled for all servers.
 	//
 	// +optional
 	DisableHTTP2 bool `json:"disableHTTP2,omitempty"`
+	// CaseInsensitivePaths defines if the paths of the HTTPRoute and GRPCRoute matches should be matched
+	// case-insensitively for all servers. Exact and PathPrefix paths match the request path in any case,
+	// and RegularExpression paths ignore the case of the letters. The backends still receive the request path
+	// in its original case.
+	// Default is false, meaning the paths are matched case-sensitively, as the Gateway API specifies.
+	//
+	// +optional
+	CaseInsensitivePaths bool `json:"caseInsensitivePaths,omitempty"`
+	// ACMEChallengePassthrough defines if the HTTPRoute rules that match the paths of the ACME HTTP-01 challenges,
+	// which start with "/.well-known/acme-challenge/", should take precedence over the other rules and bypass
+	// the policies and the HTTPS redirects, so that the challenges of ACME clients such as cert-manager succeed.
+	// When enabled, the policies that apply to the other rules of the server don't apply to these rules,
+	// a PathPrefix challenge path takes precedence over the RegularExpression paths, and the servers generated
+	// by HTTPSRedirect serve the challenges of their hostname instead of redirecting them.
+	// Default is false.
+	//
+	// +optional
+	ACMEChallengePassthrough bool `json:"acmeChallengePassthrough,omitempty"`
+}
+
+// ServerHeader configures the Server response header.
+type ServerHeader struct {
+	// Value sets the value of the Server response header and the signature on error pages.
+	// An empty string removes the Server header. Only supported by NGINX Plus. When using NGINX OSS,
+	// the NginxProxy is invalid if Value is set.
+	// Sets NGINX directive server_tokens: https://nginx.org/en/docs/http/ngx_http_core_module.html#server_tokens
+	// Format: must have all '"' escaped and must not contain any '$' or end with an unescaped '\'
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([^"$\\]|\\[^$])*$`
+	Value *string `json:"value,omitempty"`
+
+	// HideVersion hides the NGINX version in the Server response header and on error pages.
+	// Sets NGINX directive server_tokens to off: https://nginx.org/en/docs/http/ngx_http_core_module.html#server_tokens
+	// Default is false.
+	//
+	// +optional
+	HideVersion bool `json:"hideVersion,omitempty"`
+}
+
+// ScaleFromZeroAnnotation is the annotation of a Service that opts the Service in to scaling from zero replicas.
+// When set to "true" and ScaleFromZero is configured in the NginxProxy, the requests for the Service are forwarded
+// to the activator while the Service has no ready endpoints.
+const ScaleFromZeroAnnotation = "gateway.nginx.org/scale-from-zero"
+
+// ConnectionLimits defines the limits on the connections and requests that NGINX accepts.
+type ConnectionLimits struct {
+	// WorkerConnections is the maximum number of simultaneous connections that each NGINX worker process
+	// can open. It includes the connections with the clients and with the backends.
+	// Default: 1024.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#worker_connections
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	WorkerConnections *int32 `json:"workerConnections,omitempty"`
+
+	// DefaultServerMaxConnections is the maximum number of simultaneous connections from a single client
+	// IP address to the catch-all default servers, which handle the requests that don't match the hostname
+	// of any listener. The connections above the limit are rejected with the 503 (Service Unavailable) error.
+	// By default, the connections are not limited.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	DefaultServerMaxConnections *int32 `json:"defaultServerMaxConnections,omitempty"`
+
+	// MaxRequestRate is the maximum rate of requests to each hostname, in requests per second. A burst of
+	// up to one second of requests is served without a delay. The requests above the rate are rejected
+	// with the 503 (Service Unavailable) error, or with the reject code of a RateLimitFilter of the route.
+	// By default, the rate is not limited.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=999999
+	MaxRequestRate *int32 `json:"maxRequestRate,omitempty"`
+}
+
+// NginxLogging configures the logs of NGINX.
+type NginxLogging struct {
+	// AccessLogFormat is the format of the access log of the HTTP requests. The kubernetes format logs
+	// the requests as JSON objects with the Kubernetes context of each request: the Gateway, the namespace,
+	// the name and the rule index of the Route, the backend Service and the address of the backend Pod.
+	// The locations of the Routes with GeoIP enabled by an ObservabilityPolicy keep the GeoIP format.
+	// Default: combined.
+	//
+	// +optional
+	AccessLogFormat *NginxAccessLogFormat `json:"accessLogFormat,omitempty"`
+
+	// ErrorLevel is the minimum severity level of the messages that NGINX writes to its error log.
+	// A Gateway can override it with the gateway.nginx.org/error-log-level annotation.
+	// Default: info.
+	// Directive: https://nginx.org/en/docs/ngx_core_module.html#error_log
+	//
+	// +optional
+	ErrorLevel *NginxErrorLogLevel `json:"errorLevel,omitempty"`
+
+	// StreamAccessLog configures the access log of the connections that NGINX proxies for the stream routes,
+	// such as TLSRoutes.
+	//
+	// +optional
+	StreamAccessLog *StreamAccessLog `json:"streamAccessLog,omitempty"`
+}
+
+// NginxAccessLogFormat is the format of the NGINX access log.
+//
+// +kubebuilder:validation:Enum=combined;kubernetes
+type NginxAccessLogFormat string
+
+const (
+	// NginxAccessLogFormatCombined is the predefined combined format of NGINX.
+	NginxAccessLogFormatCombined NginxAccessLogFormat = "combined"
+
+	// NginxAccessLogFormatKubernetes is a JSON format that includes the Kubernetes context of the requests.
+	NginxAccessLogFormatKubernetes NginxAccessLogFormat = "kubernetes"
+)
+
+// NginxErrorLogLevel is the severity level of the messages of the NGINX error log.
+//
+// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+type NginxErrorLogLevel string
+
+const (
+	// NginxErrorLogLevelDebug is the debug level of the NGINX error log. NGINX only logs the debug messages
+	// if it was built with the debugging support.
+	NginxErrorLogLevelDebug NginxErrorLogLevel = "debug"
+
+	// NginxErrorLogLevelInfo is the info level of the NGINX error log.
+	NginxErrorLogLevelInfo NginxErrorLogLevel = "info"
+
+	// NginxErrorLogLevelNotice is the notice level of the NGINX error log.
+	NginxErrorLogLevelNotice NginxErrorLogLevel = "notice"
+
+	// NginxErrorLogLevelWarn is the warn level of the NGINX error log.
+	NginxErrorLogLevelWarn NginxErrorLogLevel = "warn"
+
+	// NginxErrorLogLevelError is the error level of the NGINX error log.
+	NginxErrorLogLevelError NginxErrorLogLevel = "error"
+
+	// NginxErrorLogLevelCrit is the crit level of the NGINX error log.
+	NginxErrorLogLevelCrit NginxErrorLogLevel = "crit"
+
+	// NginxErrorLogLevelAlert is the alert level of the NGINX error log.
+	NginxErrorLogLevelAlert NginxErrorLogLevel = "alert"
+
+	// NginxErrorLogLevelEmerg is the emerg level of the NGINX error log.
+	NginxErrorLogLevelEmerg NginxErrorLogLevel = "emerg"
+)
+
+// ErrorLogLevelAnnotation is the annotation of a Gateway that overrides the ErrorLevel of the NginxProxy
+// for the NGINX of the Gateway. Its value is one of the NginxErrorLogLevels.
+const ErrorLogLevelAnnotation = "gateway.nginx.org/error-log-level"
+
+// StreamAccessLog configures the access log of the stream connections.
+type StreamAccessLog struct {
+	// Format is the format of the entries of the access log. It can contain the variables of the NGINX stream
+	// modules, for example, $remote_addr, $bytes_sent and $session_time, and $ngf_stream_route, which is
+	// the namespace and the name of the Route of the connection.
+	// Default: $remote_addr [$time_local] $protocol $status $bytes_sent $bytes_received $session_time
+	// "$ssl_preread_server_name".
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_log_module.html#log_format.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^[^'\\]*$`
+	Format *string `json:"format,omitempty"`
+
+	// Disable turns off the access log of the stream connections. The metrics of the stream routes
+	// are still recorded.
+	//
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+}
+
+// GeoIP configures the GeoIP2 module.
+type GeoIP struct {
+	// AutoReloadInterval is the interval at which NGINX checks the database file for changes and reloads it,
+	// so that the updates of the mounted file are picked up without reloading NGINX.
+	// By default, the database is only loaded when NGINX reloads its configuration.
+	// Directive: https://github.com/leev/ngx_http_geoip2_module#configure
+	//
+	// +optional
+	AutoReloadInterval *Duration `json:"autoReloadInterval,omitempty"`
+
+	// DatabasePath is the absolute path of the MaxMind DB (MMDB) file in the NGINX container,
+	// such as a GeoLite2 or GeoIP2 Country or City database. The city fields are empty with a Country database.
+	//
+	// +kubebuilder:validation:MaxLength=4096
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9._/-]*\.mmdb$`
+	DatabasePath string `json:"databasePath"`
+}
+
+// ScaleFromZero configures the forwarding of requests for Services without ready endpoints to an activator.
+type ScaleFromZero struct {
+	// Activator references the Service of the activator.
+	Activator ActivatorReference `json:"activator"`
+}
+
+// ActivatorReference references the Service of an activator.
+type ActivatorReference struct {
+	// Name is the name of the activator Service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the activator Service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+
+	// Port is the port of the activator Service to which NGINX forwards the requests.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// HTTPSRedirect configures the generated HTTP to HTTPS redirect servers.
+type HTTPSRedirect struct {
+	// Port is the port on which the generated HTTP servers listen.
+	// The port must be exposed by the NGINX Service.
+	// Default is 80.
+	//
+	// +optional
+	// +kubebuilder:default:=80
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// StatusCode is the HTTP status code of the redirect.
+	// Default is 301.
+	//
+	// +optional
+	// +kubebuilder:default:=301
+	// +kubebuilder:validation:Enum=301;302;307;308
+	StatusCode *int `json:"statusCode,omitempty"`
+}
+
+// HTTPSRedirectListenersAnnotation is the annotation of a Gateway that enables the generated HTTP to HTTPS redirect
+// servers for the hostnames of some of the HTTPS listeners of the Gateway. Its value is a comma-separated list of
+// the names of the HTTPS listeners. The redirect servers use the port and the status code of the HTTPSRedirect
+// of the NginxProxy, or the defaults if the NginxProxy doesn't configure HTTPSRedirect.
+const HTTPSRedirectListenersAnnotation = "gateway.nginx.org/https-redirect-listeners"
+
+// DefaultServer configures the response of the default server for unmatched hostnames.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of redirect or response must be specified",rule="has(self.redirect) != has(self.response)"
+//
+//nolint:lll
+type DefaultServer struct {
+	// Port is the listener port whose default server is configured.
+	// If not specified, the configuration applies to the default servers of all HTTP listener ports
+	// that are not configured by another entry with a Port.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// Redirect redirects unmatched requests to the specified URL.
+	//
+	// +optional
+	Redirect *DefaultServerRedirect `json:"redirect,omitempty"`
+
+	// Response returns the specified response for unmatched requests.
+	//
+	// +optional
+	Response *DefaultServerResponse `json:"response,omitempty"`
+}
+
+// DefaultServerRedirect redirects requests to a URL.
+type DefaultServerRedirect struct {
+	// StatusCode is the HTTP status code of the redirect.
+	// Default is 302.
+	//
+	// +optional
+	// +kubebuilder:default:=302
+	// +kubebuilder:validation:Enum=301;302;307;308
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	// URL is the absolute URL to redirect requests to. For example, https://www.example.com.
+	// Format: must have all '"' escaped and must not contain any '$' or end with an unescaped '\'
+	//
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://([^"$\\\s]|\\[^$])*$`
+	URL string `json:"url"`
+
+	// PreserveRequestURI appends the original request URI (path and query string) to the redirect URL.
+	//
+	// +optional
+	PreserveRequestURI bool `json:"preserveRequestURI,omitempty"`
+}
+
+// DefaultServerResponse is a response returned directly by NGINX.
+type DefaultServerResponse struct {
+	// Body is the response body. If the ContentType is "text/plain", the body may reference the $host and
+	// $request_uri variables to include the requested hostname and URI.
+	// Format: must have all '"' escaped and must not end with an unescaped '\'
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	// +kubebuilder:validation:Pattern=`^([^"\\]|\\.)*$`
+	Body *string `json:"body,omitempty"`
+
+	// ContentType is the Content-Type of the response.
+	// Default is "text/plain".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9!#&^_.+-]+/[a-zA-Z0-9!#&^_.+-]+(;\s*[a-zA-Z0-9_-]+=[a-zA-Z0-9_.-]+)?$`
+	//
+	//nolint:lll
+	ContentType *string `json:"contentType,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	//
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode int `json:"statusCode"`
 }
 
 // Telemetry specifies the OpenTelemetry configuration.
@@ -160,6 +548,44 @@ type RewriteClientIP struct {
 	//
 	// +optional
 	TrustedAddresses []Address `json:"trustedAddresses,omitempty"`
+
+	// TrustedHops declares the layers of proxies in front of NGINX when requests traverse more than one proxy,
+	// for example, a CDN in front of a cloud load balancer. The hops are ordered from the client to NGINX.
+	// The addresses of all hops are trusted in addition to TrustedAddresses, and the client IP is selected
+	// with a recursive search of the X-Forwarded-For header, as if SetIPRecursively was true.
+	// For requests from the addresses of a hop, the scheme of the client request is taken from the ProtoHeader
+	// of the first hop that sets it to http or https, and is used in the X-Forwarded-Proto header
+	// sent to the backends and in the redirects of RequestRedirect filters that don't set a scheme.
+	// Requires mode to be XForwardedFor.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=map
+	// +listMapKey=name
+	TrustedHops []TrustedProxyHop `json:"trustedHops,omitempty"`
+}
+
+// TrustedProxyHop is a layer of proxies in front of NGINX.
+type TrustedProxyHop struct {
+	// ProtoHeader is the request header in which the proxies of the hop send the scheme of the requests
+	// they receive, for example, CloudFront-Forwarded-Proto. If the header has a list of values,
+	// the first value is used. Default is X-Forwarded-Proto.
+	//
+	// +optional
+	ProtoHeader *v1.HTTPHeaderName `json:"protoHeader,omitempty"`
+
+	// Name is the name of the hop, for example, cdn.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9-]+$`
+	Name string `json:"name"`
+
+	// Addresses are the addresses of the proxies of the hop.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Addresses []Address `json:"addresses"`
 }
 
 // RewriteClientIPModeType defines how NGINX Gateway Fabric will determine the client's original IP address.
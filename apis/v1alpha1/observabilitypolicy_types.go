@@ -43,6 +43,16 @@ type ObservabilityPolicySpec struct {
 	// +optional
 	Tracing *Tracing `json:"tracing,omitempty"`
 
+	// AccessLog allows for configuring the access logging of requests.
+	//
+	// +optional
+	AccessLog *AccessLog `json:"accessLog,omitempty"`
+
+	// Metrics allows for enabling the collection of metrics of requests.
+	//
+	// +optional
+	Metrics *RouteMetrics `json:"metrics,omitempty"`
+
 	// TargetRefs identifies the API object(s) to apply the policy to.
 	// Objects must be in the same namespace as the policy.
 	// Support: HTTPRoute, GRPCRoute.
@@ -98,6 +108,61 @@ type Tracing struct {
 	SpanAttributes []SpanAttribute `json:"spanAttributes,omitempty"`
 }
 
+// AccessLog allows for configuring the access logging of requests.
+//
+// +kubebuilder:validation:XValidation:message="sampleRatio cannot be specified if access logging is disabled",rule="!(has(self.sampleRatio) && has(self.disable) && self.disable)"
+// +kubebuilder:validation:XValidation:message="geoIP cannot be enabled if access logging is disabled",rule="!(has(self.geoIP) && self.geoIP && has(self.disable) && self.disable)"
+//
+//nolint:lll
+type AccessLog struct {
+	// Disable disables access logging. By default, all requests are logged.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// SampleRatio is the percentage of requests that should be logged. Integer from 0 to 100.
+	// By default, 100% of requests are logged. If ratio is set to 0, access logging is disabled.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SampleRatio *int32 `json:"sampleRatio,omitempty"`
+
+	// GeoIP appends the country code and the city name of the client to each access log entry.
+	// Requires GeoIP to be configured in the NginxProxy resource.
+	//
+	// +optional
+	GeoIP *bool `json:"geoIP,omitempty"`
+}
+
+// RouteMetrics allows for enabling the collection of metrics of requests.
+type RouteMetrics struct {
+	// LatencyHistogram enables the collection of a histogram of the latency of the requests of each targeted Route.
+	// The histogram is exposed as the nginx_gateway_fabric_route_request_duration_seconds metric
+	// by the metrics endpoint of NGINX Gateway Fabric, if metrics are enabled.
+	//
+	// +optional
+	LatencyHistogram *bool `json:"latencyHistogram,omitempty"`
+
+	// SLI enables the collection of the service level indicators of the requests of each targeted Route:
+	// the number of the requests, of the requests that failed with a 5xx status code, and of the requests
+	// that took longer than the latency threshold. The indicators are exposed as
+	// the nginx_gateway_fabric_route_sli_* metrics by the metrics endpoint of NGINX Gateway Fabric,
+	// if metrics are enabled.
+	//
+	// +optional
+	SLI *RouteSLI `json:"sli,omitempty"`
+}
+
+// RouteSLI configures the service level indicators of the requests of a Route.
+type RouteSLI struct {
+	// LatencyThreshold is the latency above which a request counts as slow for the latency indicator.
+	// Default: 500ms.
+	//
+	// +optional
+	LatencyThreshold *Duration `json:"latencyThreshold,omitempty"`
+}
+
 // TraceStrategy defines the tracing strategy.
 //
 // +kubebuilder:validation:Enum=ratio;parent
@@ -8,8 +8,8 @@ import (
 // Figure out a way to generate these methods for all our policies.
 // These methods implement the policies.Policy interface which extends client.Object to add the following methods.
 
-func (p *ClientSettingsPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReference {
-	return []v1alpha2.LocalPolicyTargetReference{p.Spec.TargetRef}
+func (p *ClientSettingsPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	return []v1alpha2.LocalPolicyTargetReferenceWithSectionName{p.Spec.TargetRef}
 }
 
 func (p *ClientSettingsPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
@@ -20,8 +20,13 @@ func (p *ClientSettingsPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
 	p.Status = status
 }
 
-func (p *ObservabilityPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReference {
-	return p.Spec.TargetRefs
+func (p *ObservabilityPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	refs := make([]v1alpha2.LocalPolicyTargetReferenceWithSectionName, 0, len(p.Spec.TargetRefs))
+	for _, ref := range p.Spec.TargetRefs {
+		refs = append(refs, v1alpha2.LocalPolicyTargetReferenceWithSectionName{LocalPolicyTargetReference: ref})
+	}
+
+	return refs
 }
 
 func (p *ObservabilityPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
@@ -31,3 +36,167 @@ func (p *ObservabilityPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
 func (p *ObservabilityPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
 	p.Status = status
 }
+
+func (p *ProxySettingsPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	return []v1alpha2.LocalPolicyTargetReferenceWithSectionName{p.Spec.TargetRef}
+}
+
+func (p *ProxySettingsPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *ProxySettingsPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *FaultInjectionPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	refs := make([]v1alpha2.LocalPolicyTargetReferenceWithSectionName, 0, len(p.Spec.TargetRefs))
+	for _, ref := range p.Spec.TargetRefs {
+		refs = append(refs, v1alpha2.LocalPolicyTargetReferenceWithSectionName{LocalPolicyTargetReference: ref})
+	}
+
+	return refs
+}
+
+func (p *FaultInjectionPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *FaultInjectionPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *UpstreamSettingsPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	refs := make([]v1alpha2.LocalPolicyTargetReferenceWithSectionName, 0, len(p.Spec.TargetRefs))
+	for _, ref := range p.Spec.TargetRefs {
+		refs = append(refs, v1alpha2.LocalPolicyTargetReferenceWithSectionName{LocalPolicyTargetReference: ref})
+	}
+
+	return refs
+}
+
+func (p *UpstreamSettingsPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *UpstreamSettingsPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *WAFPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	return []v1alpha2.LocalPolicyTargetReferenceWithSectionName{p.Spec.TargetRef}
+}
+
+func (p *WAFPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *WAFPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *ModSecurityPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	refs := make([]v1alpha2.LocalPolicyTargetReferenceWithSectionName, 0, len(p.Spec.TargetRefs))
+	for _, ref := range p.Spec.TargetRefs {
+		refs = append(refs, v1alpha2.LocalPolicyTargetReferenceWithSectionName{LocalPolicyTargetReference: ref})
+	}
+
+	return refs
+}
+
+func (p *ModSecurityPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *ModSecurityPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *BotMitigationPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	refs := make([]v1alpha2.LocalPolicyTargetReferenceWithSectionName, 0, len(p.Spec.TargetRefs))
+	for _, ref := range p.Spec.TargetRefs {
+		refs = append(refs, v1alpha2.LocalPolicyTargetReferenceWithSectionName{LocalPolicyTargetReference: ref})
+	}
+
+	return refs
+}
+
+func (p *BotMitigationPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *BotMitigationPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *GeoIPPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	refs := make([]v1alpha2.LocalPolicyTargetReferenceWithSectionName, 0, len(p.Spec.TargetRefs))
+	for _, ref := range p.Spec.TargetRefs {
+		refs = append(refs, v1alpha2.LocalPolicyTargetReferenceWithSectionName{LocalPolicyTargetReference: ref})
+	}
+
+	return refs
+}
+
+func (p *GeoIPPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *GeoIPPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *RequestHeadersPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	return []v1alpha2.LocalPolicyTargetReferenceWithSectionName{p.Spec.TargetRef}
+}
+
+func (p *RequestHeadersPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *RequestHeadersPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *SecureLinkPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	refs := make([]v1alpha2.LocalPolicyTargetReferenceWithSectionName, 0, len(p.Spec.TargetRefs))
+	for _, ref := range p.Spec.TargetRefs {
+		refs = append(refs, v1alpha2.LocalPolicyTargetReferenceWithSectionName{LocalPolicyTargetReference: ref})
+	}
+
+	return refs
+}
+
+func (p *SecureLinkPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *SecureLinkPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *StaticContentPolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	return []v1alpha2.LocalPolicyTargetReferenceWithSectionName{p.Spec.TargetRef}
+}
+
+func (p *StaticContentPolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *StaticContentPolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
+
+func (p *DefaultCertificatePolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	return []v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+		{LocalPolicyTargetReference: p.Spec.TargetRef},
+	}
+}
+
+func (p *DefaultCertificatePolicy) GetPolicyStatus() v1alpha2.PolicyStatus {
+	return p.Status
+}
+
+func (p *DefaultCertificatePolicy) SetPolicyStatus(status v1alpha2.PolicyStatus) {
+	p.Status = status
+}
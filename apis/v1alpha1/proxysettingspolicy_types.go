@@ -0,0 +1,145 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=pspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ProxySettingsPolicy is an Inherited Attached Policy. It provides a way to configure the behavior of the connection
+// between NGINX Gateway Fabric and the backends.
+type ProxySettingsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ProxySettingsPolicy.
+	Spec ProxySettingsPolicySpec `json:"spec"`
+
+	// Status defines the state of the ProxySettingsPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProxySettingsPolicyList contains a list of ProxySettingsPolicies.
+type ProxySettingsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProxySettingsPolicy `json:"items"`
+}
+
+// ProxySettingsPolicySpec defines the desired state of ProxySettingsPolicy.
+//
+// +kubebuilder:validation:XValidation:message="NoEndpoints is only supported for HTTPRoute and GRPCRoute",rule="!has(self.noEndpoints) || self.targetRef.kind != 'Gateway'"
+// +kubebuilder:validation:XValidation:message="Hedging is only supported for HTTPRoute",rule="!has(self.hedging) || self.targetRef.kind == 'HTTPRoute'"
+//
+//nolint:lll
+type ProxySettingsPolicySpec struct {
+	// Timeouts defines the timeouts of the connection to the backends.
+	// These timeouts are independent of the request timeouts of the Gateway API HTTPRoute.
+	//
+	// +optional
+	Timeouts *ProxyTimeouts `json:"timeouts,omitempty"`
+
+	// NoEndpoints defines the response to the requests of a Route rule when none of its backends have
+	// ready endpoints, for example, when the backend Services are scaled to zero.
+	// By default, NGINX responds with a 502 status code.
+	// Only supported when the policy targets an HTTPRoute or GRPCRoute.
+	//
+	// +optional
+	NoEndpoints *NoEndpoints `json:"noEndpoints,omitempty"`
+
+	// Hedging reduces the tail latency of the idempotent requests of a Route. If a GET or HEAD request is not
+	// answered within the delay, NGINX sends a copy of it to the backends, which is usually proxied to another
+	// endpoint, and responds with the first response. The other requests are proxied as usual.
+	// Only supported when the policy targets an HTTPRoute.
+	//
+	// +optional
+	Hedging *ProxyHedging `json:"hedging,omitempty"`
+
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute, GRPCRoute.
+	// SectionName is only supported for a Gateway, to apply the policy to a single Listener of the Gateway.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="(self.kind=='Gateway' || self.kind=='HTTPRoute' || self.kind=='GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="(self.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef SectionName is only supported for Gateway",rule="(!has(self.sectionName) || self.kind=='Gateway')"
+	//nolint:lll
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
+}
+
+// ProxyTimeouts defines the timeouts of the connection between NGINX and the backends.
+// The timeouts apply to both HTTP and gRPC backends.
+type ProxyTimeouts struct {
+	// Connect defines a timeout for establishing a connection with a backend.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_connect_timeout.
+	//
+	// +optional
+	Connect *Duration `json:"connect,omitempty"`
+
+	// Send defines a timeout for transmitting a request to a backend. The timeout is set only between
+	// two successive write operations, not for the transmission of the whole request.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_send_timeout.
+	//
+	// +optional
+	Send *Duration `json:"send,omitempty"`
+
+	// Read defines a timeout for reading a response from a backend. The timeout is set only between
+	// two successive read operations, not for the transmission of the whole response.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_read_timeout.
+	//
+	// +optional
+	Read *Duration `json:"read,omitempty"`
+}
+
+// ProxyHedging defines the hedging of the idempotent requests of a Route.
+//
+// The hedged requests are proxied with subrequests, so their responses are buffered in memory, and the responses
+// larger than 1 MiB fail. Only enable hedging for the read paths with small responses, where the backends can
+// absorb the extra requests.
+type ProxyHedging struct {
+	// Delay is the time after which NGINX sends the copy of a request that is not answered yet.
+	// A delay close to the usual latency of the backends, such as its 95th percentile, hedges only the slowest
+	// requests.
+	Delay Duration `json:"delay"`
+}
+
+// NoEndpoints defines the response to the requests of a Route rule when none of its backends have ready endpoints.
+//
+// +kubebuilder:validation:XValidation:message="RetryAfterSeconds is only supported for the Return503 action",rule="!has(self.retryAfterSeconds) || self.action == 'Return503'"
+//
+//nolint:lll
+type NoEndpoints struct {
+	// RetryAfterSeconds sets the Retry-After header of the 503 response, which tells the clients how many seconds
+	// to wait before retrying the request. Only supported for the Return503 action.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetryAfterSeconds *int32 `json:"retryAfterSeconds,omitempty"`
+
+	// Action defines how NGINX responds.
+	Action NoEndpointsAction `json:"action"`
+}
+
+// NoEndpointsAction defines how NGINX responds to the requests of a Route rule when none of its backends have
+// ready endpoints.
+//
+// +kubebuilder:validation:Enum=Return502;Return503
+type NoEndpointsAction string
+
+const (
+	// NoEndpointsActionReturn502 responds with the 502 (Bad Gateway) status code.
+	NoEndpointsActionReturn502 NoEndpointsAction = "Return502"
+
+	// NoEndpointsActionReturn503 responds with the 503 (Service Unavailable) status code, which tells the clients
+	// that the backends are temporarily unavailable, for example, while an autoscaler scales them up from zero.
+	NoEndpointsActionReturn503 NoEndpointsAction = "Return503"
+)
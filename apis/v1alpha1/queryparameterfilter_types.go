@@ -0,0 +1,116 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// QueryParameterFilter is a filter that modifies the query parameters of the requests of the HTTPRoute rules
+// that reference it with an extensionRef filter, before NGINX proxies the requests to the backends.
+type QueryParameterFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the QueryParameterFilter.
+	Spec QueryParameterFilterSpec `json:"spec"`
+
+	// Status defines the state of the QueryParameterFilter.
+	Status FilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QueryParameterFilterList contains a list of QueryParameterFilters.
+type QueryParameterFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QueryParameterFilter `json:"items"`
+}
+
+// QueryParameterFilterSpec defines the desired state of the QueryParameterFilter.
+// The modifications are applied in the following order: Remove, Set, Add.
+//
+// +kubebuilder:validation:XValidation:message="at least one of set, add, or remove must be specified",rule="has(self.set) || has(self.add) || has(self.remove)"
+//
+//nolint:lll
+type QueryParameterFilterSpec struct {
+	// Set overwrites the query parameters with the given names. If the request has several parameters
+	// with the same name, the first one is overwritten and the rest are removed. If the request doesn't have
+	// the parameter, it is added.
+	//
+	// Input:
+	//   GET /foo?version=v1&version=v2
+	//
+	// Config:
+	//   set:
+	//   - name: "version"
+	//     value: "v3"
+	//
+	// Output:
+	//   GET /foo?version=v3
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Set []QueryParameter `json:"set,omitempty"`
+
+	// Add adds the query parameters, in addition to the parameters with the same names that the request has.
+	//
+	// Input:
+	//   GET /foo?tag=a
+	//
+	// Config:
+	//   add:
+	//   - name: "tag"
+	//     value: "b"
+	//
+	// Output:
+	//   GET /foo?tag=a&tag=b
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Add []QueryParameter `json:"add,omitempty"`
+
+	// Remove removes the query parameters with the given names.
+	//
+	// Input:
+	//   GET /foo?utm_source=mail&id=1
+	//
+	// Config:
+	//   remove: ["utm_source"]
+	//
+	// Output:
+	//   GET /foo?id=1
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Remove []QueryParameterName `json:"remove,omitempty"`
+}
+
+// QueryParameter is a query parameter.
+type QueryParameter struct {
+	// Name is the name of the query parameter. The name is case-sensitive.
+	Name QueryParameterName `json:"name"`
+
+	// Value is the value of the query parameter. NGINX Gateway Fabric percent-encodes the value.
+	//
+	// +kubebuilder:validation:MaxLength=1024
+	Value string `json:"value"`
+}
+
+// QueryParameterName is the name of a query parameter. NGINX Gateway Fabric percent-encodes the name.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=256
+// +kubebuilder:validation:Pattern=`^[A-Za-z0-9\-._~\[\]]+$`
+type QueryParameterName string
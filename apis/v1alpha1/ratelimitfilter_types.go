@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced
+// +kubebuilder:printcolumn:name="Rate",type=string,JSONPath=`.spec.rate`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RateLimitFilter is a filter that limits the rate of the requests of the HTTPRoute rules that reference it
+// with an extensionRef filter. The requests above the rate are rejected.
+type RateLimitFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RateLimitFilter.
+	Spec RateLimitFilterSpec `json:"spec"`
+
+	// Status defines the state of the RateLimitFilter.
+	Status FilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RateLimitFilterList contains a list of RateLimitFilters.
+type RateLimitFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RateLimitFilter `json:"items"`
+}
+
+// RateLimitFilterSpec defines the desired state of the RateLimitFilter.
+type RateLimitFilterSpec struct {
+	// Key is the key that the requests are limited by. The rate applies to each value of the key separately.
+	// The key can contain NGINX variables. Default: $binary_remote_addr, which limits the requests per client
+	// IP address.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_zone
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([^"\s;{}\\]|\\[^\s])*$`
+	Key *string `json:"key,omitempty"`
+
+	// ZoneSize is the size of the shared memory zone that stores the states of the keys.
+	// Default: 10m, which stores about 160 thousand states of $binary_remote_addr.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_zone
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+
+	// Burst is the number of requests above the rate that are delayed, or, if NoDelay is true, served
+	// without a delay. The requests above the burst are rejected.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Burst *int32 `json:"burst,omitempty"`
+
+	// NoDelay serves the requests of the burst without a delay.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req
+	//
+	// +optional
+	NoDelay *bool `json:"noDelay,omitempty"`
+
+	// RejectCode is the status code of the response to the rejected requests. Default: 503.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_status
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	RejectCode *int32 `json:"rejectCode,omitempty"`
+
+	// Rate is the maximum rate of the requests, in requests per second (r/s) or per minute (r/m).
+	// Examples: 10r/s, 30r/m.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_req_module.html#limit_req_zone
+	//
+	// +kubebuilder:validation:Pattern=`^[1-9][0-9]{0,5}r/(s|m)$`
+	Rate string `json:"rate"`
+}
@@ -34,12 +34,46 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&NginxGateway{},
 		&NginxGatewayList{},
+		&HostnameReport{},
+		&HostnameReportList{},
 		&NginxProxy{},
 		&NginxProxyList{},
 		&ObservabilityPolicy{},
 		&ObservabilityPolicyList{},
 		&ClientSettingsPolicy{},
 		&ClientSettingsPolicyList{},
+		&ProxySettingsPolicy{},
+		&ProxySettingsPolicyList{},
+		&FaultInjectionPolicy{},
+		&FaultInjectionPolicyList{},
+		&UpstreamSettingsPolicy{},
+		&UpstreamSettingsPolicyList{},
+		&WAFPolicy{},
+		&WAFPolicyList{},
+		&ModSecurityPolicy{},
+		&ModSecurityPolicyList{},
+		&BotMitigationPolicy{},
+		&BotMitigationPolicyList{},
+		&GeoIPPolicy{},
+		&GeoIPPolicyList{},
+		&RequestHeadersPolicy{},
+		&RequestHeadersPolicyList{},
+		&SecureLinkPolicy{},
+		&SecureLinkPolicyList{},
+		&StaticContentPolicy{},
+		&StaticContentPolicyList{},
+		&DefaultCertificatePolicy{},
+		&DefaultCertificatePolicyList{},
+		&SnippetsFilter{},
+		&SnippetsFilterList{},
+		&RateLimitFilter{},
+		&RateLimitFilterList{},
+		&ResponseHeaderFilter{},
+		&ResponseHeaderFilterList{},
+		&QueryParameterFilter{},
+		&QueryParameterFilterList{},
+		&ABTestFilter{},
+		&ABTestFilterList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -0,0 +1,99 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=rhpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// RequestHeadersPolicy is an Inherited Attached Policy. It provides a way to set default headers in the requests
+// that NGINX proxies to the backends, such as the name of the environment or the location of the client.
+// The headers of a policy that targets a Gateway are set in the requests of all Routes attached to the Gateway,
+// unless a policy that targets a Route disables them.
+type RequestHeadersPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RequestHeadersPolicy.
+	Spec RequestHeadersPolicySpec `json:"spec"`
+
+	// Status defines the state of the RequestHeadersPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RequestHeadersPolicyList contains a list of RequestHeadersPolicies.
+type RequestHeadersPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RequestHeadersPolicy `json:"items"`
+}
+
+// RequestHeadersPolicySpec defines the desired state of the RequestHeadersPolicy.
+//
+// +kubebuilder:validation:XValidation:message="Disable is only supported for HTTPRoute and GRPCRoute",rule="!has(self.disable) || self.targetRef.kind != 'Gateway'"
+// +kubebuilder:validation:XValidation:message="at least one of headers or disable must be specified",rule="has(self.headers) || has(self.disable)"
+//
+//nolint:lll
+type RequestHeadersPolicySpec struct {
+	// Disable disables the headers that the Route inherits from the policies of the Gateway, and the headers
+	// of the policy itself. Only supported when the policy targets an HTTPRoute or GRPCRoute.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute, GRPCRoute.
+	// SectionName is only supported for a Gateway, to apply the policy to a single Listener of the Gateway.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="(self.kind=='Gateway' || self.kind=='HTTPRoute' || self.kind=='GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="(self.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef SectionName is only supported for Gateway",rule="(!has(self.sectionName) || self.kind=='Gateway')"
+	//nolint:lll
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// Headers are the headers to set in the requests. A header of a policy that targets a Route overrides
+	// the header with the same name that the Route inherits from the policies of the Gateway.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []DefaultRequestHeader `json:"headers,omitempty"`
+}
+
+// DefaultRequestHeader defines a header that is set in the requests. The value of the header is either
+// a static value or a field of the location of the client.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of value or geoIPField must be specified",rule="has(self.value) != has(self.geoIPField)"
+//
+//nolint:lll
+type DefaultRequestHeader struct {
+	// Value is the static value of the header.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	Value *string `json:"value,omitempty"`
+
+	// GeoIPField is the field of the location of the client that the header is set to. The value of the header
+	// is empty if the location of the client is unknown. Requires GeoIP to be configured in the NginxProxy resource.
+	//
+	// +optional
+	GeoIPField *GeoIPField `json:"geoIPField,omitempty"`
+
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	Name string `json:"name"`
+}
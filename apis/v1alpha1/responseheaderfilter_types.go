@@ -0,0 +1,124 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ResponseHeaderFilter is a filter that adds headers to the responses of the HTTPRoute rules that reference it
+// with an extensionRef filter, but only to the responses that match the conditions of the headers, such as
+// the status code of the response or a header of the upstream response. Unlike the ResponseHeaderModifier
+// filter, which modifies the headers of all responses, it can, for example, add a Cache-Control header only
+// to the 200 responses.
+type ResponseHeaderFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ResponseHeaderFilter.
+	Spec ResponseHeaderFilterSpec `json:"spec"`
+
+	// Status defines the state of the ResponseHeaderFilter.
+	Status FilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResponseHeaderFilterList contains a list of ResponseHeaderFilters.
+type ResponseHeaderFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResponseHeaderFilter `json:"items"`
+}
+
+// ResponseHeaderFilterSpec defines the desired state of the ResponseHeaderFilter.
+type ResponseHeaderFilterSpec struct {
+	// Headers are the headers that are added to the responses that match their conditions.
+	// The headers are added in addition to the headers of the upstream response.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_headers_module.html#add_header
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Headers []ConditionalResponseHeader `json:"headers"`
+}
+
+// ConditionalResponseHeader is a header that is added to the responses that match its condition.
+type ConditionalResponseHeader struct {
+	// Name is the name of the header.
+	Name v1.HTTPHeaderName `json:"name"`
+
+	// Value is the value of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value"`
+
+	// When is the condition that a response must match for the header to be added.
+	When ResponseCondition `json:"when"`
+}
+
+// ResponseCondition is a condition on a response. A response matches the condition if it matches all
+// of the set fields.
+//
+// +kubebuilder:validation:XValidation:message="at least one of statusCodes or header must be specified",rule="has(self.statusCodes) || has(self.header)"
+//
+//nolint:lll
+type ResponseCondition struct {
+	// Header matches a header of the upstream response.
+	//
+	// +optional
+	Header *ResponseHeaderMatch `json:"header,omitempty"`
+
+	// StatusCodes match the status code of the response, such as 200. The response matches if its status code
+	// is one of the status codes.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	StatusCodes []StatusCode `json:"statusCodes,omitempty"`
+}
+
+// StatusCode is an HTTP status code.
+//
+// +kubebuilder:validation:Minimum=100
+// +kubebuilder:validation:Maximum=599
+type StatusCode int32
+
+// ResponseHeaderMatch matches a header of the upstream response.
+//
+// +kubebuilder:validation:XValidation:message="value must be specified if and only if type is Exact",rule="(self.type == 'Exact') == has(self.value)"
+//
+//nolint:lll
+type ResponseHeaderMatch struct {
+	// Value is the value that the header must have. Required if Type is Exact.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Value *string `json:"value,omitempty"`
+
+	// Type is the type of the match.
+	Type ResponseHeaderMatchType `json:"type"`
+
+	// Name is the name of the header.
+	Name v1.HTTPHeaderName `json:"name"`
+}
+
+// ResponseHeaderMatchType is the type of a ResponseHeaderMatch.
+//
+// +kubebuilder:validation:Enum=Exact;Absent
+type ResponseHeaderMatchType string
+
+const (
+	// ResponseHeaderMatchExact matches if the header has the exact value.
+	ResponseHeaderMatchExact ResponseHeaderMatchType = "Exact"
+	// ResponseHeaderMatchAbsent matches if the upstream response does not have the header, or the header is empty.
+	ResponseHeaderMatchAbsent ResponseHeaderMatchType = "Absent"
+)
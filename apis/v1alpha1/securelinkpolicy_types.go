@@ -0,0 +1,102 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=slpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// SecureLinkPolicy is a Direct Attached Policy. It only allows the requests to HTTPRoutes with signed URLs,
+// so that the access to an origin behind a CDN can be restricted to the requests that the CDN signs.
+// The URLs are signed with the NGINX secure_link module: the signature is the MD5 hash of the expiry time,
+// the path, the optional client IP address, and a secret.
+type SecureLinkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the SecureLinkPolicy.
+	Spec SecureLinkPolicySpec `json:"spec"`
+
+	// Status defines the state of the SecureLinkPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecureLinkPolicyList contains a list of SecureLinkPolicies.
+type SecureLinkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecureLinkPolicy `json:"items"`
+}
+
+// SecureLinkPolicySpec defines the desired state of the SecureLinkPolicy.
+type SecureLinkPolicySpec struct {
+	// SignatureParameter is the name of the query parameter that holds the signature of the URL:
+	// the base64url-encoded MD5 hash, without padding, of the string "<expires><path><clientIP> <secret>",
+	// where <expires> is the value of the ExpiresParameter, <path> is the path of the request URI as sent
+	// by the client, and <clientIP> is the IP address of the client if BindClientIP is true.
+	// Default is "md5".
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]+$`
+	SignatureParameter *string `json:"signatureParameter,omitempty"`
+
+	// ExpiresParameter is the name of the query parameter that holds the time at which the URL expires,
+	// in seconds since the Unix epoch. The requests with an expired URL are rejected with the status code 410.
+	// If not set, the URLs don't expire, and <expires> is empty in the signed string.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]+$`
+	ExpiresParameter *string `json:"expiresParameter,omitempty"`
+
+	// BindClientIP adds the IP address of the client to the signed string, so that a signed URL can only
+	// be used by the client it was signed for. Behind a CDN or a load balancer, configure the rewriteClientIP
+	// settings of the NginxProxy resource, so that NGINX sees the address of the client.
+	//
+	// +optional
+	BindClientIP *bool `json:"bindClientIP,omitempty"`
+
+	// SecretRef references the key of a Secret that holds the secret that the URLs are signed with.
+	// The Secret must be in the same namespace as the policy.
+	SecretRef SecureLinkSecretKeyReference `json:"secretRef"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: HTTPRoute",rule="self.all(t, t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// SecureLinkSecretKeyReference references a key of a Secret.
+type SecureLinkSecretKeyReference struct {
+	// Name is the name of the Secret.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the key of the Secret that holds the secret. The secret must be at least 16 characters long,
+	// and may only contain alphanumeric characters, '-', '_', '.', '+', '/', or '='.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+}
@@ -1,5 +1,10 @@
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
 // Duration is a string value representing a duration in time.
 // Duration can be specified in milliseconds (ms), seconds (s), minutes (m), hours (h).
 // A value without a suffix is seconds.
@@ -26,3 +31,47 @@ type SpanAttribute struct {
 	// +kubebuilder:validation:Pattern=`^([^"$\\]|\\[^$])*$`
 	Value string `json:"value"`
 }
+
+// FilterStatus defines the state of a filter that HTTPRoutes reference with an extensionRef filter.
+type FilterStatus struct {
+	// Controllers is a list of the statuses of the filter, one for each Gateway controller that processes
+	// the HTTPRoutes that reference the filter.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Controllers []ControllerStatus `json:"controllers,omitempty"`
+}
+
+// ControllerStatus is the status of a resource for a Gateway controller.
+type ControllerStatus struct {
+	// ControllerName is the name of the Gateway controller that wrote this status.
+	ControllerName gatewayv1.GatewayController `json:"controllerName"`
+
+	// Conditions describe the status of the resource. The known condition type is "Accepted".
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// FilterConditionType is a type of condition associated with a filter.
+// This type should be used with the ControllerStatus.Conditions field of the FilterStatus.
+type FilterConditionType string
+
+// FilterConditionReason defines the set of reasons that explain why a
+// particular filter condition type has been raised.
+type FilterConditionReason string
+
+const (
+	// FilterConditionAccepted is a condition that is true when the filter is syntactically and semantically valid,
+	// so that the HTTPRoutes that reference it can use it.
+	FilterConditionAccepted FilterConditionType = "Accepted"
+
+	// FilterReasonAccepted is a reason that is used with the "Accepted" condition when the condition is True.
+	FilterReasonAccepted FilterConditionReason = "Accepted"
+
+	// FilterReasonInvalid is a reason that is used with the "Accepted" condition when the condition is False.
+	FilterReasonInvalid FilterConditionReason = "Invalid"
+)
@@ -0,0 +1,80 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SnippetsFilter is a filter that inserts NGINX configuration snippets into the generated NGINX configuration of
+// the HTTPRoute rules that reference it with an extensionRef filter. Snippets are not validated by
+// NGINX Gateway Fabric, so an invalid snippet makes NGINX fail to reload. SnippetsFilters are only supported
+// when the SnippetsFilter feature is enabled.
+type SnippetsFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the SnippetsFilter.
+	Spec SnippetsFilterSpec `json:"spec"`
+
+	// Status defines the state of the SnippetsFilter.
+	Status FilterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnippetsFilterList contains a list of SnippetsFilters.
+type SnippetsFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SnippetsFilter `json:"items"`
+}
+
+// SnippetsFilterSpec defines the desired state of the SnippetsFilter.
+type SnippetsFilterSpec struct {
+	// Snippets is a list of NGINX configuration snippets.
+	// There can only be one snippet per context.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	// +kubebuilder:validation:XValidation:message="Only one snippet allowed per context",rule="self.all(s1, self.exists_one(s2, s1.context == s2.context))"
+	//nolint:lll
+	Snippets []Snippet `json:"snippets"`
+}
+
+// Snippet represents an NGINX configuration snippet.
+type Snippet struct {
+	// Context is the NGINX context to insert the snippet into.
+	Context NginxContext `json:"context"`
+
+	// Value is the NGINX configuration snippet.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// NginxContext represents the NGINX configuration context.
+//
+// +kubebuilder:validation:Enum=http;http.server;http.server.location
+type NginxContext string
+
+const (
+	// NginxContextHTTP is the http context of the NGINX configuration.
+	// https://nginx.org/en/docs/http/ngx_http_core_module.html#http
+	NginxContextHTTP NginxContext = "http"
+
+	// NginxContextHTTPServer is the server context of the NGINX configuration.
+	// The snippet is inserted into the servers of the hostnames of the HTTPRoute.
+	// https://nginx.org/en/docs/http/ngx_http_core_module.html#server
+	NginxContextHTTPServer NginxContext = "http.server"
+
+	// NginxContextHTTPServerLocation is the location context of the NGINX configuration.
+	// The snippet is inserted into the locations of the HTTPRoute rule.
+	// https://nginx.org/en/docs/http/ngx_http_core_module.html#location
+	NginxContextHTTPServerLocation NginxContext = "http.server.location"
+)
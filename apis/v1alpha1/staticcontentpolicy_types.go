@@ -0,0 +1,100 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=scpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// StaticContentPolicy is a Direct Attached Policy. It serves static files from a ConfigMap directly from
+// the Gateway, without a backend Service, such as maintenance pages, security.txt and other .well-known files,
+// or the responses to ACME HTTP-01 challenges.
+type StaticContentPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the StaticContentPolicy.
+	Spec StaticContentPolicySpec `json:"spec"`
+
+	// Status defines the state of the StaticContentPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StaticContentPolicyList contains a list of StaticContentPolicies.
+type StaticContentPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticContentPolicy `json:"items"`
+}
+
+// StaticContentPolicySpec defines the desired state of the StaticContentPolicy.
+type StaticContentPolicySpec struct {
+	// ConfigMapRef references the ConfigMap that holds the content of the files.
+	// The ConfigMap must be in the same namespace as the policy.
+	ConfigMapRef StaticContentConfigMapReference `json:"configMapRef"`
+
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// Support: Gateway.
+	// SectionName is supported, to only serve the files on a single Listener of the Gateway.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: Gateway",rule="self.kind=='Gateway'"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="self.group=='gateway.networking.k8s.io'"
+	//nolint:lll
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// Files are the files to serve. The files are served for all hostnames of the Listeners of the Gateway.
+	//
+	// +listType=map
+	// +listMapKey=path
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Files []StaticFile `json:"files"`
+}
+
+// StaticContentConfigMapReference references a ConfigMap.
+type StaticContentConfigMapReference struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// StaticFile defines a file that is served at a path.
+type StaticFile struct {
+	// ContentType is the media type of the responses with the file, with an optional parameter,
+	// such as "text/plain; charset=utf-8". If not set, the media type is determined by the extension of the path,
+	// such as "text/html" for ".html" and "text/plain" for ".txt", and is "application/octet-stream"
+	// for the paths without a known extension.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.+-]+/[A-Za-z0-9.+-]+(; ?[A-Za-z0-9.+-]+=[A-Za-z0-9.+-]+)?$`
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Path is the exact path that the file is served at, such as "/.well-known/security.txt".
+	// A Route rule that matches the same exact path takes precedence over the file.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9\-._~/]*$`
+	Path string `json:"path"`
+
+	// Key is the key of the ConfigMap that holds the content of the file, in either the data or the binaryData
+	// field of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+}
@@ -0,0 +1,227 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=uspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// UpstreamSettingsPolicy is a Direct Attached Policy. It provides a way to configure the behavior of
+// the connection between NGINX and the upstream applications, which are the Services referenced by Routes.
+type UpstreamSettingsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the UpstreamSettingsPolicy.
+	Spec UpstreamSettingsPolicySpec `json:"spec"`
+
+	// Status defines the state of the UpstreamSettingsPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UpstreamSettingsPolicyList contains a list of UpstreamSettingsPolicies.
+type UpstreamSettingsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpstreamSettingsPolicy `json:"items"`
+}
+
+// UpstreamSettingsPolicySpec defines the desired state of the UpstreamSettingsPolicy.
+type UpstreamSettingsPolicySpec struct {
+	// HealthCheck configures the active health checks of the endpoints of the upstream. The endpoints that fail
+	// the health checks don't receive requests until they pass them again. gRPC servers reject HTTP health checks,
+	// so the endpoints of gRPC Services must be checked with the GRPC type.
+	// HealthCheck is only supported by NGINX Plus.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check.
+	//
+	// +optional
+	HealthCheck *UpstreamHealthCheck `json:"healthCheck,omitempty"`
+
+	// KeepAlive configures the keep-alive connections to the endpoints of the upstream. Keep-alive connections
+	// are enabled by default, so that NGINX doesn't open a new connection for every proxied request.
+	//
+	// +optional
+	KeepAlive *UpstreamKeepAlive `json:"keepAlive,omitempty"`
+
+	// LoadBalancingMethod is the method that NGINX uses to choose the endpoint of the upstream for each request.
+	// The LeastTimeHeader and LeastTimeLastByte methods are only supported by NGINX Plus. They choose the endpoint
+	// with the least average response time and the least number of active connections, which suits
+	// latency-sensitive Services whose endpoints have different performance.
+	// Default: RandomTwoLeastConnections.
+	//
+	// +optional
+	LoadBalancingMethod *LoadBalancingMethod `json:"loadBalancingMethod,omitempty"`
+
+	// MaxConnections limits the maximum number of simultaneous active connections to each endpoint of
+	// the upstream. If the limit is reached, the requests are queued, if Queue is set. Otherwise,
+	// NGINX tries the other endpoints of the upstream, and responds with an error if all endpoints
+	// have reached the limit. Setting the value to 0 disables the limit.
+	// Default: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#max_conns.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
+	// Queue queues the requests that cannot be proxied to an endpoint of the upstream immediately, because
+	// all endpoints have reached MaxConnections, or are unavailable. This allows NGINX to absorb bursts of
+	// traffic instead of overwhelming backends with a fixed concurrency.
+	// Queue is only supported by NGINX Plus.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#queue.
+	//
+	// +optional
+	Queue *UpstreamQueue `json:"queue,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Service.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: Service",rule="self.all(t, t.kind=='Service')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be the core group.",rule="self.all(t, t.group=='')"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// LoadBalancingMethod is the load balancing method of an upstream.
+//
+// +kubebuilder:validation:Enum=RandomTwoLeastConnections;LeastTimeHeader;LeastTimeLastByte
+type LoadBalancingMethod string
+
+const (
+	// LoadBalancingMethodRandomTwoLeastConnections chooses two random endpoints, and then the one of them with
+	// the least number of active connections.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#random.
+	LoadBalancingMethodRandomTwoLeastConnections LoadBalancingMethod = "RandomTwoLeastConnections"
+
+	// LoadBalancingMethodLeastTimeHeader chooses the endpoint with the least average time to receive
+	// the response header and the least number of active connections.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#least_time.
+	LoadBalancingMethodLeastTimeHeader LoadBalancingMethod = "LeastTimeHeader"
+
+	// LoadBalancingMethodLeastTimeLastByte chooses the endpoint with the least average time to receive
+	// the full response and the least number of active connections.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#least_time.
+	LoadBalancingMethodLeastTimeLastByte LoadBalancingMethod = "LeastTimeLastByte"
+)
+
+// UpstreamHealthCheck defines the active health checks of the endpoints of an upstream.
+//
+// +kubebuilder:validation:XValidation:message="path is only supported for HTTP health checks",rule="!has(self.path) || !has(self.type) || self.type == 'HTTP'"
+// +kubebuilder:validation:XValidation:message="grpcService is only supported for GRPC health checks",rule="!has(self.grpcService) || (has(self.type) && self.type == 'GRPC')"
+//
+//nolint:lll
+type UpstreamHealthCheck struct {
+	// Type is the protocol of the health checks.
+	// Default: HTTP.
+	//
+	// +optional
+	Type *HealthCheckType `json:"type,omitempty"`
+
+	// Path is the path of the HTTP requests of the HTTP health checks.
+	// Default: /.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/[A-Za-z0-9\-._~%/]*$`
+	Path *string `json:"path,omitempty"`
+
+	// GRPCService is the name of the service whose status the GRPC health checks request,
+	// for example, helloworld.Greeter. If not set, the health checks request the overall status of the server.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.]+$`
+	GRPCService *string `json:"grpcService,omitempty"`
+
+	// Interval is the time between two consecutive health checks of an endpoint.
+	// Default: 5s.
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// Fails is the number of consecutive failed health checks after which the endpoint is considered unhealthy.
+	// Default: 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Fails *int32 `json:"fails,omitempty"`
+
+	// Passes is the number of consecutive passed health checks after which the endpoint is considered healthy.
+	// Default: 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Passes *int32 `json:"passes,omitempty"`
+}
+
+// HealthCheckType is the protocol of the health checks of an upstream.
+//
+// +kubebuilder:validation:Enum=HTTP;GRPC
+type HealthCheckType string
+
+const (
+	// HealthCheckTypeHTTP checks the endpoints with HTTP requests. An endpoint passes the health check if it
+	// responds with a 2xx or 3xx status code.
+	HealthCheckTypeHTTP HealthCheckType = "HTTP"
+
+	// HealthCheckTypeGRPC checks the endpoints with the Check method of the gRPC health checking protocol
+	// (grpc.health.v1.Health). An endpoint passes the health check if it responds with the SERVING status.
+	HealthCheckTypeGRPC HealthCheckType = "GRPC"
+)
+
+// UpstreamQueue defines the queue of the requests to an upstream.
+type UpstreamQueue struct {
+	// Timeout is the maximum time that a request can wait in the queue. If the request cannot be proxied
+	// within this time, the 502 (Bad Gateway) error is returned to the client.
+	// Default: 60s.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// Size is the maximum number of requests in the queue. If the queue is full, or a request cannot be
+	// proxied within the timeout, the 502 (Bad Gateway) error is returned to the client.
+	//
+	// +kubebuilder:validation:Minimum=1
+	Size int32 `json:"size"`
+}
+
+// UpstreamKeepAlive defines the keep-alive connections to an upstream.
+type UpstreamKeepAlive struct {
+	// Connections is the maximum number of idle keep-alive connections to the endpoints of the upstream that are
+	// preserved in the cache of each NGINX worker process. When the number is exceeded, the least recently used
+	// connections are closed. Setting the value to 0 disables the keep-alive connections.
+	// Default: 16.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Connections *int32 `json:"connections,omitempty"`
+
+	// Requests is the maximum number of requests that can be proxied through one keep-alive connection.
+	// After the maximum number of requests is made, the connection is closed.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive_requests.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Requests *int32 `json:"requests,omitempty"`
+
+	// Time is the maximum time during which requests can be proxied through one keep-alive connection.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive_time.
+	//
+	// +optional
+	Time *Duration `json:"time,omitempty"`
+
+	// Timeout is the timeout during which an idle keep-alive connection to an endpoint stays open.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive_timeout.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
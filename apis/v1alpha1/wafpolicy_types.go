@@ -0,0 +1,149 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=wafpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// WAFPolicy is an Inherited Attached Policy. It enables NGINX App Protect WAF for the traffic of the targeted
+// Gateway or Route, using a compiled NGINX App Protect policy bundle.
+// WAFPolicy is only supported by NGINX Plus with NGINX App Protect WAF v5.
+type WAFPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the WAFPolicy.
+	Spec WAFPolicySpec `json:"spec"`
+
+	// Status defines the state of the WAFPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WAFPolicyList contains a list of WAFPolicies.
+type WAFPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WAFPolicy `json:"items"`
+}
+
+// WAFPolicySpec defines the desired state of the WAFPolicy.
+type WAFPolicySpec struct {
+	// PolicyBundle is the compiled NGINX App Protect policy bundle that NGINX enforces.
+	PolicyBundle WAFPolicyBundle `json:"policyBundle"`
+
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute, GRPCRoute.
+	// SectionName is only supported for a Gateway, to apply the policy to a single Listener of the Gateway.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="(self.kind=='Gateway' || self.kind=='HTTPRoute' || self.kind=='GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="(self.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef SectionName is only supported for Gateway",rule="(!has(self.sectionName) || self.kind=='Gateway')"
+	//nolint:lll
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReferenceWithSectionName `json:"targetRef"`
+
+	// SecurityLogs defines the security logs of the requests that NGINX App Protect inspects.
+	// If not set, the security logs are inherited from a WAFPolicy attached to a less specific target.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	SecurityLogs []WAFSecurityLog `json:"securityLogs,omitempty"`
+}
+
+// WAFPolicyBundle defines the source of a compiled NGINX App Protect policy bundle.
+type WAFPolicyBundle struct {
+	// ConfigMapRef references the key of a ConfigMap that holds the policy bundle in its binaryData.
+	// The bundle is a gzip-compressed tar archive produced by the NGINX App Protect compiler.
+	// The ConfigMap must be in the same namespace as the policy.
+	ConfigMapRef WAFConfigMapKeyReference `json:"configMapRef"`
+}
+
+// WAFConfigMapKeyReference references a key of a ConfigMap.
+type WAFConfigMapKeyReference struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the key of the ConfigMap that holds the policy bundle.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+}
+
+// WAFSecurityLog defines a security log of NGINX App Protect.
+type WAFSecurityLog struct {
+	// Destination is where the security log is sent.
+	Destination WAFSecurityLogDestination `json:"destination"`
+
+	// LogProfile is the built-in log profile that defines which requests are logged, and the format of the log.
+	LogProfile WAFLogProfile `json:"logProfile"`
+}
+
+// WAFLogProfile is a built-in NGINX App Protect log profile.
+//
+// +kubebuilder:validation:Enum=log_default;log_all;log_illegal;log_blocked
+type WAFLogProfile string
+
+const (
+	// WAFLogProfileDefault logs the illegal requests in the default format.
+	WAFLogProfileDefault WAFLogProfile = "log_default"
+	// WAFLogProfileAll logs all requests.
+	WAFLogProfileAll WAFLogProfile = "log_all"
+	// WAFLogProfileIllegal logs the illegal requests.
+	WAFLogProfileIllegal WAFLogProfile = "log_illegal"
+	// WAFLogProfileBlocked logs the blocked requests.
+	WAFLogProfileBlocked WAFLogProfile = "log_blocked"
+)
+
+// WAFSecurityLogDestination defines the destination of a security log.
+//
+// +kubebuilder:validation:XValidation:message="syslog must be specified for type Syslog",rule="self.type != 'Syslog' || has(self.syslog)"
+// +kubebuilder:validation:XValidation:message="syslog can only be specified for type Syslog",rule="self.type == 'Syslog' || !has(self.syslog)"
+//
+//nolint:lll
+type WAFSecurityLogDestination struct {
+	// Syslog defines the syslog server that receives the security log.
+	// Only used if the type is Syslog.
+	//
+	// +optional
+	Syslog *WAFSyslogDestination `json:"syslog,omitempty"`
+
+	// Type is the type of the destination.
+	Type WAFSecurityLogDestinationType `json:"type"`
+}
+
+// WAFSecurityLogDestinationType is the type of the destination of a security log.
+//
+// +kubebuilder:validation:Enum=Stderr;Syslog
+type WAFSecurityLogDestinationType string
+
+const (
+	// WAFSecurityLogDestinationTypeStderr sends the security log to the stderr of the NGINX container.
+	WAFSecurityLogDestinationTypeStderr WAFSecurityLogDestinationType = "Stderr"
+	// WAFSecurityLogDestinationTypeSyslog sends the security log to a syslog server.
+	WAFSecurityLogDestinationTypeSyslog WAFSecurityLogDestinationType = "Syslog"
+)
+
+// WAFSyslogDestination defines a syslog server.
+type WAFSyslogDestination struct {
+	// Server is the address of the syslog server.
+	// Format: alphanumeric hostname with port.
+	//
+	//nolint:lll
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*:\d{1,5}$`
+	Server string `json:"server"`
+}
@@ -5,11 +5,162 @@
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ABTestCookie) DeepCopyInto(out *ABTestCookie) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ABTestCookie.
+func (in *ABTestCookie) DeepCopy() *ABTestCookie {
+	if in == nil {
+		return nil
+	}
+	out := new(ABTestCookie)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ABTestFilter) DeepCopyInto(out *ABTestFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ABTestFilter.
+func (in *ABTestFilter) DeepCopy() *ABTestFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ABTestFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ABTestFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ABTestFilterList) DeepCopyInto(out *ABTestFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ABTestFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ABTestFilterList.
+func (in *ABTestFilterList) DeepCopy() *ABTestFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ABTestFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ABTestFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ABTestFilterSpec) DeepCopyInto(out *ABTestFilterSpec) {
+	*out = *in
+	if in.OverrideHeader != nil {
+		in, out := &in.OverrideHeader, &out.OverrideHeader
+		*out = new(v1.HTTPHeaderName)
+		**out = **in
+	}
+	in.Cookie.DeepCopyInto(&out.Cookie)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ABTestFilterSpec.
+func (in *ABTestFilterSpec) DeepCopy() *ABTestFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ABTestFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLog) DeepCopyInto(out *AccessLog) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SampleRatio != nil {
+		in, out := &in.SampleRatio, &out.SampleRatio
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GeoIP != nil {
+		in, out := &in.GeoIP, &out.GeoIP
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLog.
+func (in *AccessLog) DeepCopy() *AccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivatorReference) DeepCopyInto(out *ActivatorReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivatorReference.
+func (in *ActivatorReference) DeepCopy() *ActivatorReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivatorReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Address) DeepCopyInto(out *Address) {
 	*out = *in
@@ -25,6 +176,137 @@ func (in *Address) DeepCopy() *Address {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BotMitigationPolicy) DeepCopyInto(out *BotMitigationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BotMitigationPolicy.
+func (in *BotMitigationPolicy) DeepCopy() *BotMitigationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BotMitigationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BotMitigationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BotMitigationPolicyList) DeepCopyInto(out *BotMitigationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BotMitigationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BotMitigationPolicyList.
+func (in *BotMitigationPolicyList) DeepCopy() *BotMitigationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(BotMitigationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BotMitigationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BotMitigationPolicySpec) DeepCopyInto(out *BotMitigationPolicySpec) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(BotMitigationAction)
+		**out = **in
+	}
+	if in.BlockKnownScanners != nil {
+		in, out := &in.BlockKnownScanners, &out.BlockKnownScanners
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserAgentPatterns != nil {
+		in, out := &in.UserAgentPatterns, &out.UserAgentPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredHeaders != nil {
+		in, out := &in.RequiredHeaders, &out.RequiredHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerifiedCrawlers != nil {
+		in, out := &in.VerifiedCrawlers, &out.VerifiedCrawlers
+		*out = make([]VerifiedCrawler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BotMitigationPolicySpec.
+func (in *BotMitigationPolicySpec) DeepCopy() *BotMitigationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BotMitigationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientBandwidth) DeepCopyInto(out *ClientBandwidth) {
+	*out = *in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(Size)
+		**out = **in
+	}
+	if in.RateAfter != nil {
+		in, out := &in.RateAfter, &out.RateAfter
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientBandwidth.
+func (in *ClientBandwidth) DeepCopy() *ClientBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientBody) DeepCopyInto(out *ClientBody) {
 	*out = *in
@@ -50,6 +332,36 @@ func (in *ClientBody) DeepCopy() *ClientBody {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientHeader) DeepCopyInto(out *ClientHeader) {
+	*out = *in
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.LargeBuffers != nil {
+		in, out := &in.LargeBuffers, &out.LargeBuffers
+		*out = new(ClientLargeHeaderBuffers)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientHeader.
+func (in *ClientHeader) DeepCopy() *ClientHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientKeepAlive) DeepCopyInto(out *ClientKeepAlive) {
 	*out = *in
@@ -105,6 +417,21 @@ func (in *ClientKeepAliveTimeout) DeepCopy() *ClientKeepAliveTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientLargeHeaderBuffers) DeepCopyInto(out *ClientLargeHeaderBuffers) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientLargeHeaderBuffers.
+func (in *ClientLargeHeaderBuffers) DeepCopy() *ClientLargeHeaderBuffers {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientLargeHeaderBuffers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientSettingsPolicy) DeepCopyInto(out *ClientSettingsPolicy) {
 	*out = *in
@@ -167,17 +494,27 @@ func (in *ClientSettingsPolicyList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientSettingsPolicySpec) DeepCopyInto(out *ClientSettingsPolicySpec) {
 	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(ClientBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Body != nil {
 		in, out := &in.Body, &out.Body
 		*out = new(ClientBody)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(ClientHeader)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KeepAlive != nil {
 		in, out := &in.KeepAlive, &out.KeepAlive
 		*out = new(ClientKeepAlive)
 		(*in).DeepCopyInto(*out)
 	}
-	out.TargetRef = in.TargetRef
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSettingsPolicySpec.
@@ -191,46 +528,2136 @@ func (in *ClientSettingsPolicySpec) DeepCopy() *ClientSettingsPolicySpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Logging) DeepCopyInto(out *Logging) {
+func (in *ConditionalResponseHeader) DeepCopyInto(out *ConditionalResponseHeader) {
 	*out = *in
-	if in.Level != nil {
-		in, out := &in.Level, &out.Level
-		*out = new(ControllerLogLevel)
-		**out = **in
-	}
+	in.When.DeepCopyInto(&out.When)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
-func (in *Logging) DeepCopy() *Logging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalResponseHeader.
+func (in *ConditionalResponseHeader) DeepCopy() *ConditionalResponseHeader {
 	if in == nil {
 		return nil
 	}
-	out := new(Logging)
+	out := new(ConditionalResponseHeader)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
+func (in *ConnectionLimits) DeepCopyInto(out *ConnectionLimits) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.WorkerConnections != nil {
+		in, out := &in.WorkerConnections, &out.WorkerConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DefaultServerMaxConnections != nil {
+		in, out := &in.DefaultServerMaxConnections, &out.DefaultServerMaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRequestRate != nil {
+		in, out := &in.MaxRequestRate, &out.MaxRequestRate
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLimits.
+func (in *ConnectionLimits) DeepCopy() *ConnectionLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerStatus.
+func (in *ControllerStatus) DeepCopy() *ControllerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultCertificatePolicy) DeepCopyInto(out *DefaultCertificatePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultCertificatePolicy.
+func (in *DefaultCertificatePolicy) DeepCopy() *DefaultCertificatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultCertificatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultCertificatePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultCertificatePolicyList) DeepCopyInto(out *DefaultCertificatePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DefaultCertificatePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultCertificatePolicyList.
+func (in *DefaultCertificatePolicyList) DeepCopy() *DefaultCertificatePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultCertificatePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefaultCertificatePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultCertificatePolicySpec) DeepCopyInto(out *DefaultCertificatePolicySpec) {
+	*out = *in
+	in.CertificateRef.DeepCopyInto(&out.CertificateRef)
+	out.TargetRef = in.TargetRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultCertificatePolicySpec.
+func (in *DefaultCertificatePolicySpec) DeepCopy() *DefaultCertificatePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultCertificatePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultCertificateReference) DeepCopyInto(out *DefaultCertificateReference) {
+	*out = *in
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(v1.Group)
+		**out = **in
+	}
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(v1.Kind)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultCertificateReference.
+func (in *DefaultCertificateReference) DeepCopy() *DefaultCertificateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultCertificateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRequestHeader) DeepCopyInto(out *DefaultRequestHeader) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.GeoIPField != nil {
+		in, out := &in.GeoIPField, &out.GeoIPField
+		*out = new(GeoIPField)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRequestHeader.
+func (in *DefaultRequestHeader) DeepCopy() *DefaultRequestHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRequestHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServer) DeepCopyInto(out *DefaultServer) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(DefaultServerRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(DefaultServerResponse)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServer.
+func (in *DefaultServer) DeepCopy() *DefaultServer {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServerRedirect) DeepCopyInto(out *DefaultServerRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServerRedirect.
+func (in *DefaultServerRedirect) DeepCopy() *DefaultServerRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServerRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServerResponse) DeepCopyInto(out *DefaultServerResponse) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServerResponse.
+func (in *DefaultServerResponse) DeepCopy() *DefaultServerResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServerResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBatching) DeepCopyInto(out *EventBatching) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBatching.
+func (in *EventBatching) DeepCopy() *EventBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultAbort) DeepCopyInto(out *FaultAbort) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbort.
+func (in *FaultAbort) DeepCopy() *FaultAbort {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultAbort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelay) DeepCopyInto(out *FaultDelay) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelay.
+func (in *FaultDelay) DeepCopy() *FaultDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionPolicy) DeepCopyInto(out *FaultInjectionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionPolicy.
+func (in *FaultInjectionPolicy) DeepCopy() *FaultInjectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FaultInjectionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionPolicyList) DeepCopyInto(out *FaultInjectionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FaultInjectionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionPolicyList.
+func (in *FaultInjectionPolicyList) DeepCopy() *FaultInjectionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FaultInjectionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionPolicySpec) DeepCopyInto(out *FaultInjectionPolicySpec) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionPolicySpec.
+func (in *FaultInjectionPolicySpec) DeepCopy() *FaultInjectionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterStatus) DeepCopyInto(out *FilterStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]ControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterStatus.
+func (in *FilterStatus) DeepCopy() *FilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIP) DeepCopyInto(out *GeoIP) {
+	*out = *in
+	if in.AutoReloadInterval != nil {
+		in, out := &in.AutoReloadInterval, &out.AutoReloadInterval
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIP.
+func (in *GeoIP) DeepCopy() *GeoIP {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIPPolicy) DeepCopyInto(out *GeoIPPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIPPolicy.
+func (in *GeoIPPolicy) DeepCopy() *GeoIPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GeoIPPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIPPolicyList) DeepCopyInto(out *GeoIPPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GeoIPPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIPPolicyList.
+func (in *GeoIPPolicyList) DeepCopy() *GeoIPPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIPPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GeoIPPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIPPolicySpec) DeepCopyInto(out *GeoIPPolicySpec) {
+	*out = *in
+	if in.AllowCountries != nil {
+		in, out := &in.AllowCountries, &out.AllowCountries
+		*out = make([]CountryCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockCountries != nil {
+		in, out := &in.BlockCountries, &out.BlockCountries
+		*out = make([]CountryCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]GeoIPRequestHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIPPolicySpec.
+func (in *GeoIPPolicySpec) DeepCopy() *GeoIPPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIPPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoIPRequestHeader) DeepCopyInto(out *GeoIPRequestHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoIPRequestHeader.
+func (in *GeoIPRequestHeader) DeepCopy() *GeoIPRequestHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoIPRequestHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSRedirect) DeepCopyInto(out *HTTPSRedirect) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSRedirect.
+func (in *HTTPSRedirect) DeepCopy() *HTTPSRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameConflict) DeepCopyInto(out *HostnameConflict) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameConflict.
+func (in *HostnameConflict) DeepCopy() *HostnameConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameReport) DeepCopyInto(out *HostnameReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]HostnameUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]HostnameConflict, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameReport.
+func (in *HostnameReport) DeepCopy() *HostnameReport {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostnameReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameReportList) DeepCopyInto(out *HostnameReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HostnameReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameReportList.
+func (in *HostnameReportList) DeepCopy() *HostnameReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HostnameReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameRoute) DeepCopyInto(out *HostnameRoute) {
+	*out = *in
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameRoute.
+func (in *HostnameRoute) DeepCopy() *HostnameRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostnameUsage) DeepCopyInto(out *HostnameUsage) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]HostnameRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostnameUsage.
+func (in *HostnameUsage) DeepCopy() *HostnameUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(HostnameUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Logging) DeepCopyInto(out *Logging) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(ControllerLogLevel)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModSecurityAuditLog) DeepCopyInto(out *ModSecurityAuditLog) {
+	*out = *in
+	if in.Relevance != nil {
+		in, out := &in.Relevance, &out.Relevance
+		*out = new(ModSecurityAuditLogRelevance)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(ModSecurityAuditLogFormat)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModSecurityAuditLog.
+func (in *ModSecurityAuditLog) DeepCopy() *ModSecurityAuditLog {
+	if in == nil {
+		return nil
+	}
+	out := new(ModSecurityAuditLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModSecurityPolicy) DeepCopyInto(out *ModSecurityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModSecurityPolicy.
+func (in *ModSecurityPolicy) DeepCopy() *ModSecurityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ModSecurityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModSecurityPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModSecurityPolicyList) DeepCopyInto(out *ModSecurityPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModSecurityPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModSecurityPolicyList.
+func (in *ModSecurityPolicyList) DeepCopy() *ModSecurityPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModSecurityPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModSecurityPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModSecurityPolicySpec) DeepCopyInto(out *ModSecurityPolicySpec) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(ModSecurityMode)
+		**out = **in
+	}
+	if in.ParanoiaLevel != nil {
+		in, out := &in.ParanoiaLevel, &out.ParanoiaLevel
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RuleExclusions != nil {
+		in, out := &in.RuleExclusions, &out.RuleExclusions
+		*out = new(ModSecurityRuleExclusions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuditLog != nil {
+		in, out := &in.AuditLog, &out.AuditLog
+		*out = new(ModSecurityAuditLog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModSecurityPolicySpec.
+func (in *ModSecurityPolicySpec) DeepCopy() *ModSecurityPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModSecurityPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModSecurityRuleExclusions) DeepCopyInto(out *ModSecurityRuleExclusions) {
+	*out = *in
+	if in.RuleIDs != nil {
+		in, out := &in.RuleIDs, &out.RuleIDs
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModSecurityRuleExclusions.
+func (in *ModSecurityRuleExclusions) DeepCopy() *ModSecurityRuleExclusions {
+	if in == nil {
+		return nil
+	}
+	out := new(ModSecurityRuleExclusions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGateway.
+func (in *NginxGateway) DeepCopy() *NginxGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NginxGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
+func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+	*out = *in
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(Logging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventBatching != nil {
+		in, out := &in.EventBatching, &out.EventBatching
+		*out = new(EventBatching)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
+func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
+func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxLogging) DeepCopyInto(out *NginxLogging) {
+	*out = *in
+	if in.AccessLogFormat != nil {
+		in, out := &in.AccessLogFormat, &out.AccessLogFormat
+		*out = new(NginxAccessLogFormat)
+		**out = **in
+	}
+	if in.ErrorLevel != nil {
+		in, out := &in.ErrorLevel, &out.ErrorLevel
+		*out = new(NginxErrorLogLevel)
+		**out = **in
+	}
+	if in.StreamAccessLog != nil {
+		in, out := &in.StreamAccessLog, &out.StreamAccessLog
+		*out = new(StreamAccessLog)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxLogging.
+func (in *NginxLogging) DeepCopy() *NginxLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxy) DeepCopyInto(out *NginxProxy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxy.
+func (in *NginxProxy) DeepCopy() *NginxProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxProxy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxyList) DeepCopyInto(out *NginxProxyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NginxProxy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyList.
+func (in *NginxProxyList) DeepCopy() *NginxProxyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NginxProxyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
+	*out = *in
+	if in.IPFamily != nil {
+		in, out := &in.IPFamily, &out.IPFamily
+		*out = new(IPFamilyType)
+		**out = **in
+	}
+	if in.Telemetry != nil {
+		in, out := &in.Telemetry, &out.Telemetry
+		*out = new(Telemetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RewriteClientIP != nil {
+		in, out := &in.RewriteClientIP, &out.RewriteClientIP
+		*out = new(RewriteClientIP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPSRedirect != nil {
+		in, out := &in.HTTPSRedirect, &out.HTTPSRedirect
+		*out = new(HTTPSRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerHeader != nil {
+		in, out := &in.ServerHeader, &out.ServerHeader
+		*out = new(ServerHeader)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpstreamZoneSize != nil {
+		in, out := &in.UpstreamZoneSize, &out.UpstreamZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.UpstreamDrainTimeout != nil {
+		in, out := &in.UpstreamDrainTimeout, &out.UpstreamDrainTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ScaleFromZero != nil {
+		in, out := &in.ScaleFromZero, &out.ScaleFromZero
+		*out = new(ScaleFromZero)
+		**out = **in
+	}
+	if in.ConnectionLimits != nil {
+		in, out := &in.ConnectionLimits, &out.ConnectionLimits
+		*out = new(ConnectionLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeoIP != nil {
+		in, out := &in.GeoIP, &out.GeoIP
+		*out = new(GeoIP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(NginxLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultServers != nil {
+		in, out := &in.DefaultServers, &out.DefaultServers
+		*out = make([]DefaultServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxySpec.
+func (in *NginxProxySpec) DeepCopy() *NginxProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NoEndpoints) DeepCopyInto(out *NoEndpoints) {
+	*out = *in
+	if in.RetryAfterSeconds != nil {
+		in, out := &in.RetryAfterSeconds, &out.RetryAfterSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NoEndpoints.
+func (in *NoEndpoints) DeepCopy() *NoEndpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(NoEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
+func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ObservabilityPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
+func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
+	*out = *in
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(Tracing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = new(AccessLog)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(RouteMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
+func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyHedging) DeepCopyInto(out *ProxyHedging) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyHedging.
+func (in *ProxyHedging) DeepCopy() *ProxyHedging {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyHedging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySettingsPolicy) DeepCopyInto(out *ProxySettingsPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicy.
+func (in *ProxySettingsPolicy) DeepCopy() *ProxySettingsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySettingsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxySettingsPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySettingsPolicyList) DeepCopyInto(out *ProxySettingsPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProxySettingsPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicyList.
+func (in *ProxySettingsPolicyList) DeepCopy() *ProxySettingsPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySettingsPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxySettingsPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySettingsPolicySpec) DeepCopyInto(out *ProxySettingsPolicySpec) {
+	*out = *in
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(ProxyTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NoEndpoints != nil {
+		in, out := &in.NoEndpoints, &out.NoEndpoints
+		*out = new(NoEndpoints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hedging != nil {
+		in, out := &in.Hedging, &out.Hedging
+		*out = new(ProxyHedging)
+		**out = **in
+	}
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicySpec.
+func (in *ProxySettingsPolicySpec) DeepCopy() *ProxySettingsPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySettingsPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyTimeouts) DeepCopyInto(out *ProxyTimeouts) {
+	*out = *in
+	if in.Connect != nil {
+		in, out := &in.Connect, &out.Connect
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Send != nil {
+		in, out := &in.Send, &out.Send
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Read != nil {
+		in, out := &in.Read, &out.Read
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyTimeouts.
+func (in *ProxyTimeouts) DeepCopy() *ProxyTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameter) DeepCopyInto(out *QueryParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameter.
+func (in *QueryParameter) DeepCopy() *QueryParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameterFilter) DeepCopyInto(out *QueryParameterFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameterFilter.
+func (in *QueryParameterFilter) DeepCopy() *QueryParameterFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameterFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueryParameterFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameterFilterList) DeepCopyInto(out *QueryParameterFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QueryParameterFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameterFilterList.
+func (in *QueryParameterFilterList) DeepCopy() *QueryParameterFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameterFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueryParameterFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameterFilterSpec) DeepCopyInto(out *QueryParameterFilterSpec) {
+	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make([]QueryParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Add != nil {
+		in, out := &in.Add, &out.Add
+		*out = make([]QueryParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = make([]QueryParameterName, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameterFilterSpec.
+func (in *QueryParameterFilterSpec) DeepCopy() *QueryParameterFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameterFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitFilter) DeepCopyInto(out *RateLimitFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilter.
+func (in *RateLimitFilter) DeepCopy() *RateLimitFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitFilterList) DeepCopyInto(out *RateLimitFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RateLimitFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilterList.
+func (in *RateLimitFilterList) DeepCopy() *RateLimitFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RateLimitFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitFilterSpec) DeepCopyInto(out *RateLimitFilterSpec) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NoDelay != nil {
+		in, out := &in.NoDelay, &out.NoDelay
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RejectCode != nil {
+		in, out := &in.RejectCode, &out.RejectCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitFilterSpec.
+func (in *RateLimitFilterSpec) DeepCopy() *RateLimitFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestHeadersPolicy) DeepCopyInto(out *RequestHeadersPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestHeadersPolicy.
+func (in *RequestHeadersPolicy) DeepCopy() *RequestHeadersPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestHeadersPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequestHeadersPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestHeadersPolicyList) DeepCopyInto(out *RequestHeadersPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RequestHeadersPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestHeadersPolicyList.
+func (in *RequestHeadersPolicyList) DeepCopy() *RequestHeadersPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestHeadersPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequestHeadersPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestHeadersPolicySpec) DeepCopyInto(out *RequestHeadersPolicySpec) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]DefaultRequestHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestHeadersPolicySpec.
+func (in *RequestHeadersPolicySpec) DeepCopy() *RequestHeadersPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestHeadersPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCondition) DeepCopyInto(out *ResponseCondition) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(ResponseHeaderMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]StatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCondition.
+func (in *ResponseCondition) DeepCopy() *ResponseCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseHeaderFilter) DeepCopyInto(out *ResponseHeaderFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseHeaderFilter.
+func (in *ResponseHeaderFilter) DeepCopy() *ResponseHeaderFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseHeaderFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResponseHeaderFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseHeaderFilterList) DeepCopyInto(out *ResponseHeaderFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResponseHeaderFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseHeaderFilterList.
+func (in *ResponseHeaderFilterList) DeepCopy() *ResponseHeaderFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseHeaderFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResponseHeaderFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseHeaderFilterSpec) DeepCopyInto(out *ResponseHeaderFilterSpec) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]ConditionalResponseHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseHeaderFilterSpec.
+func (in *ResponseHeaderFilterSpec) DeepCopy() *ResponseHeaderFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseHeaderFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseHeaderMatch) DeepCopyInto(out *ResponseHeaderMatch) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseHeaderMatch.
+func (in *ResponseHeaderMatch) DeepCopy() *ResponseHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RewriteClientIP) DeepCopyInto(out *RewriteClientIP) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(RewriteClientIPModeType)
+		**out = **in
+	}
+	if in.SetIPRecursively != nil {
+		in, out := &in.SetIPRecursively, &out.SetIPRecursively
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TrustedAddresses != nil {
+		in, out := &in.TrustedAddresses, &out.TrustedAddresses
+		*out = make([]Address, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedHops != nil {
+		in, out := &in.TrustedHops, &out.TrustedHops
+		*out = make([]TrustedProxyHop, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RewriteClientIP.
+func (in *RewriteClientIP) DeepCopy() *RewriteClientIP {
+	if in == nil {
+		return nil
+	}
+	out := new(RewriteClientIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteMetrics) DeepCopyInto(out *RouteMetrics) {
+	*out = *in
+	if in.LatencyHistogram != nil {
+		in, out := &in.LatencyHistogram, &out.LatencyHistogram
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SLI != nil {
+		in, out := &in.SLI, &out.SLI
+		*out = new(RouteSLI)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteMetrics.
+func (in *RouteMetrics) DeepCopy() *RouteMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteSLI) DeepCopyInto(out *RouteSLI) {
+	*out = *in
+	if in.LatencyThreshold != nil {
+		in, out := &in.LatencyThreshold, &out.LatencyThreshold
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSLI.
+func (in *RouteSLI) DeepCopy() *RouteSLI {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteSLI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleFromZero) DeepCopyInto(out *ScaleFromZero) {
+	*out = *in
+	out.Activator = in.Activator
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleFromZero.
+func (in *ScaleFromZero) DeepCopy() *ScaleFromZero {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleFromZero)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureLinkPolicy) DeepCopyInto(out *SecureLinkPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureLinkPolicy.
+func (in *SecureLinkPolicy) DeepCopy() *SecureLinkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureLinkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecureLinkPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureLinkPolicyList) DeepCopyInto(out *SecureLinkPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecureLinkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureLinkPolicyList.
+func (in *SecureLinkPolicyList) DeepCopy() *SecureLinkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureLinkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecureLinkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureLinkPolicySpec) DeepCopyInto(out *SecureLinkPolicySpec) {
+	*out = *in
+	if in.SignatureParameter != nil {
+		in, out := &in.SignatureParameter, &out.SignatureParameter
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExpiresParameter != nil {
+		in, out := &in.ExpiresParameter, &out.ExpiresParameter
+		*out = new(string)
+		**out = **in
+	}
+	if in.BindClientIP != nil {
+		in, out := &in.BindClientIP, &out.BindClientIP
+		*out = new(bool)
+		**out = **in
+	}
+	out.SecretRef = in.SecretRef
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureLinkPolicySpec.
+func (in *SecureLinkPolicySpec) DeepCopy() *SecureLinkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureLinkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecureLinkSecretKeyReference) DeepCopyInto(out *SecureLinkSecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecureLinkSecretKeyReference.
+func (in *SecureLinkSecretKeyReference) DeepCopy() *SecureLinkSecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecureLinkSecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerHeader) DeepCopyInto(out *ServerHeader) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerHeader.
+func (in *ServerHeader) DeepCopy() *ServerHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snippet) DeepCopyInto(out *Snippet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snippet.
+func (in *Snippet) DeepCopy() *Snippet {
+	if in == nil {
+		return nil
+	}
+	out := new(Snippet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnippetsFilter) DeepCopyInto(out *SnippetsFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilter.
+func (in *SnippetsFilter) DeepCopy() *SnippetsFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(SnippetsFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnippetsFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGateway.
-func (in *NginxGateway) DeepCopy() *NginxGateway {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnippetsFilterList) DeepCopyInto(out *SnippetsFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SnippetsFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterList.
+func (in *SnippetsFilterList) DeepCopy() *SnippetsFilterList {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGateway)
+	out := new(SnippetsFilterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGateway) DeepCopyObject() runtime.Object {
+func (in *SnippetsFilterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -238,99 +2665,419 @@ func (in *NginxGateway) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayList) DeepCopyInto(out *NginxGatewayList) {
+func (in *SnippetsFilterSpec) DeepCopyInto(out *SnippetsFilterSpec) {
+	*out = *in
+	if in.Snippets != nil {
+		in, out := &in.Snippets, &out.Snippets
+		*out = make([]Snippet, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnippetsFilterSpec.
+func (in *SnippetsFilterSpec) DeepCopy() *SnippetsFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnippetsFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanAttribute) DeepCopyInto(out *SpanAttribute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttribute.
+func (in *SpanAttribute) DeepCopy() *SpanAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticContentConfigMapReference) DeepCopyInto(out *StaticContentConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentConfigMapReference.
+func (in *StaticContentConfigMapReference) DeepCopy() *StaticContentConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticContentConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticContentPolicy) DeepCopyInto(out *StaticContentPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentPolicy.
+func (in *StaticContentPolicy) DeepCopy() *StaticContentPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticContentPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticContentPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticContentPolicyList) DeepCopyInto(out *StaticContentPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NginxGateway, len(*in))
+		*out = make([]StaticContentPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentPolicyList.
+func (in *StaticContentPolicyList) DeepCopy() *StaticContentPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticContentPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticContentPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticContentPolicySpec) DeepCopyInto(out *StaticContentPolicySpec) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]StaticFile, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayList.
-func (in *NginxGatewayList) DeepCopy() *NginxGatewayList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticContentPolicySpec.
+func (in *StaticContentPolicySpec) DeepCopy() *StaticContentPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticContentPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticFile) DeepCopyInto(out *StaticFile) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticFile.
+func (in *StaticFile) DeepCopy() *StaticFile {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamAccessLog) DeepCopyInto(out *StreamAccessLog) {
+	*out = *in
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamAccessLog.
+func (in *StreamAccessLog) DeepCopy() *StreamAccessLog {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamAccessLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Telemetry) DeepCopyInto(out *Telemetry) {
+	*out = *in
+	if in.Exporter != nil {
+		in, out := &in.Exporter, &out.Exporter
+		*out = new(TelemetryExporter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SpanAttributes != nil {
+		in, out := &in.SpanAttributes, &out.SpanAttributes
+		*out = make([]SpanAttribute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Telemetry.
+func (in *Telemetry) DeepCopy() *Telemetry {
+	if in == nil {
+		return nil
+	}
+	out := new(Telemetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TelemetryExporter) DeepCopyInto(out *TelemetryExporter) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchCount != nil {
+		in, out := &in.BatchCount, &out.BatchCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryExporter.
+func (in *TelemetryExporter) DeepCopy() *TelemetryExporter {
+	if in == nil {
+		return nil
+	}
+	out := new(TelemetryExporter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tracing) DeepCopyInto(out *Tracing) {
+	*out = *in
+	if in.Ratio != nil {
+		in, out := &in.Ratio, &out.Ratio
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Context != nil {
+		in, out := &in.Context, &out.Context
+		*out = new(TraceContext)
+		**out = **in
+	}
+	if in.SpanName != nil {
+		in, out := &in.SpanName, &out.SpanName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SpanAttributes != nil {
+		in, out := &in.SpanAttributes, &out.SpanAttributes
+		*out = make([]SpanAttribute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tracing.
+func (in *Tracing) DeepCopy() *Tracing {
+	if in == nil {
+		return nil
+	}
+	out := new(Tracing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedProxyHop) DeepCopyInto(out *TrustedProxyHop) {
+	*out = *in
+	if in.ProtoHeader != nil {
+		in, out := &in.ProtoHeader, &out.ProtoHeader
+		*out = new(v1.HTTPHeaderName)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]Address, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedProxyHop.
+func (in *TrustedProxyHop) DeepCopy() *TrustedProxyHop {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedProxyHop)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamHealthCheck) DeepCopyInto(out *UpstreamHealthCheck) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(HealthCheckType)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.GRPCService != nil {
+		in, out := &in.GRPCService, &out.GRPCService
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Fails != nil {
+		in, out := &in.Fails, &out.Fails
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Passes != nil {
+		in, out := &in.Passes, &out.Passes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamHealthCheck.
+func (in *UpstreamHealthCheck) DeepCopy() *UpstreamHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayList)
+	out := new(UpstreamHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxGatewayList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewaySpec) DeepCopyInto(out *NginxGatewaySpec) {
+func (in *UpstreamKeepAlive) DeepCopyInto(out *UpstreamKeepAlive) {
 	*out = *in
-	if in.Logging != nil {
-		in, out := &in.Logging, &out.Logging
-		*out = new(Logging)
-		(*in).DeepCopyInto(*out)
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewaySpec.
-func (in *NginxGatewaySpec) DeepCopy() *NginxGatewaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamKeepAlive.
+func (in *UpstreamKeepAlive) DeepCopy() *UpstreamKeepAlive {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewaySpec)
+	out := new(UpstreamKeepAlive)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
+func (in *UpstreamQueue) DeepCopyInto(out *UpstreamQueue) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxGatewayStatus.
-func (in *NginxGatewayStatus) DeepCopy() *NginxGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamQueue.
+func (in *UpstreamQueue) DeepCopy() *UpstreamQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxGatewayStatus)
+	out := new(UpstreamQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxy) DeepCopyInto(out *NginxProxy) {
+func (in *UpstreamSettingsPolicy) DeepCopyInto(out *UpstreamSettingsPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxy.
-func (in *NginxProxy) DeepCopy() *NginxProxy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSettingsPolicy.
+func (in *UpstreamSettingsPolicy) DeepCopy() *UpstreamSettingsPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxy)
+	out := new(UpstreamSettingsPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxProxy) DeepCopyObject() runtime.Object {
+func (in *UpstreamSettingsPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -338,31 +3085,31 @@ func (in *NginxProxy) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxyList) DeepCopyInto(out *NginxProxyList) {
+func (in *UpstreamSettingsPolicyList) DeepCopyInto(out *UpstreamSettingsPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NginxProxy, len(*in))
+		*out = make([]UpstreamSettingsPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxyList.
-func (in *NginxProxyList) DeepCopy() *NginxProxyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSettingsPolicyList.
+func (in *UpstreamSettingsPolicyList) DeepCopy() *UpstreamSettingsPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxyList)
+	out := new(UpstreamSettingsPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NginxProxyList) DeepCopyObject() runtime.Object {
+func (in *UpstreamSettingsPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -370,88 +3117,106 @@ func (in *NginxProxyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
+func (in *UpstreamSettingsPolicySpec) DeepCopyInto(out *UpstreamSettingsPolicySpec) {
 	*out = *in
-	if in.IPFamily != nil {
-		in, out := &in.IPFamily, &out.IPFamily
-		*out = new(IPFamilyType)
-		**out = **in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(UpstreamHealthCheck)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Telemetry != nil {
-		in, out := &in.Telemetry, &out.Telemetry
-		*out = new(Telemetry)
+	if in.KeepAlive != nil {
+		in, out := &in.KeepAlive, &out.KeepAlive
+		*out = new(UpstreamKeepAlive)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RewriteClientIP != nil {
-		in, out := &in.RewriteClientIP, &out.RewriteClientIP
-		*out = new(RewriteClientIP)
+	if in.LoadBalancingMethod != nil {
+		in, out := &in.LoadBalancingMethod, &out.LoadBalancingMethod
+		*out = new(LoadBalancingMethod)
+		**out = **in
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(UpstreamQueue)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxySpec.
-func (in *NginxProxySpec) DeepCopy() *NginxProxySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamSettingsPolicySpec.
+func (in *UpstreamSettingsPolicySpec) DeepCopy() *UpstreamSettingsPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxProxySpec)
+	out := new(UpstreamSettingsPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicy) DeepCopyInto(out *ObservabilityPolicy) {
+func (in *VerifiedCrawler) DeepCopyInto(out *VerifiedCrawler) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicy.
-func (in *ObservabilityPolicy) DeepCopy() *ObservabilityPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerifiedCrawler.
+func (in *VerifiedCrawler) DeepCopy() *VerifiedCrawler {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicy)
+	out := new(VerifiedCrawler)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicy) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFConfigMapKeyReference) DeepCopyInto(out *WAFConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFConfigMapKeyReference.
+func (in *WAFConfigMapKeyReference) DeepCopy() *WAFConfigMapKeyReference {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(WAFConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicyList) DeepCopyInto(out *ObservabilityPolicyList) {
+func (in *WAFPolicy) DeepCopyInto(out *WAFPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ObservabilityPolicy, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicyList.
-func (in *ObservabilityPolicyList) DeepCopy() *ObservabilityPolicyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFPolicy.
+func (in *WAFPolicy) DeepCopy() *WAFPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicyList)
+	out := new(WAFPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
+func (in *WAFPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -459,166 +3224,124 @@ func (in *ObservabilityPolicyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ObservabilityPolicySpec) DeepCopyInto(out *ObservabilityPolicySpec) {
+func (in *WAFPolicyBundle) DeepCopyInto(out *WAFPolicyBundle) {
 	*out = *in
-	if in.Tracing != nil {
-		in, out := &in.Tracing, &out.Tracing
-		*out = new(Tracing)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TargetRefs != nil {
-		in, out := &in.TargetRefs, &out.TargetRefs
-		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
-		copy(*out, *in)
-	}
+	out.ConfigMapRef = in.ConfigMapRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityPolicySpec.
-func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFPolicyBundle.
+func (in *WAFPolicyBundle) DeepCopy() *WAFPolicyBundle {
 	if in == nil {
 		return nil
 	}
-	out := new(ObservabilityPolicySpec)
+	out := new(WAFPolicyBundle)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RewriteClientIP) DeepCopyInto(out *RewriteClientIP) {
+func (in *WAFPolicyList) DeepCopyInto(out *WAFPolicyList) {
 	*out = *in
-	if in.Mode != nil {
-		in, out := &in.Mode, &out.Mode
-		*out = new(RewriteClientIPModeType)
-		**out = **in
-	}
-	if in.SetIPRecursively != nil {
-		in, out := &in.SetIPRecursively, &out.SetIPRecursively
-		*out = new(bool)
-		**out = **in
-	}
-	if in.TrustedAddresses != nil {
-		in, out := &in.TrustedAddresses, &out.TrustedAddresses
-		*out = make([]Address, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WAFPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RewriteClientIP.
-func (in *RewriteClientIP) DeepCopy() *RewriteClientIP {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFPolicyList.
+func (in *WAFPolicyList) DeepCopy() *WAFPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(RewriteClientIP)
+	out := new(WAFPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WAFPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpanAttribute) DeepCopyInto(out *SpanAttribute) {
+func (in *WAFPolicySpec) DeepCopyInto(out *WAFPolicySpec) {
 	*out = *in
+	out.PolicyBundle = in.PolicyBundle
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.SecurityLogs != nil {
+		in, out := &in.SecurityLogs, &out.SecurityLogs
+		*out = make([]WAFSecurityLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttribute.
-func (in *SpanAttribute) DeepCopy() *SpanAttribute {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFPolicySpec.
+func (in *WAFPolicySpec) DeepCopy() *WAFPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SpanAttribute)
+	out := new(WAFPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Telemetry) DeepCopyInto(out *Telemetry) {
+func (in *WAFSecurityLog) DeepCopyInto(out *WAFSecurityLog) {
 	*out = *in
-	if in.Exporter != nil {
-		in, out := &in.Exporter, &out.Exporter
-		*out = new(TelemetryExporter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ServiceName != nil {
-		in, out := &in.ServiceName, &out.ServiceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SpanAttributes != nil {
-		in, out := &in.SpanAttributes, &out.SpanAttributes
-		*out = make([]SpanAttribute, len(*in))
-		copy(*out, *in)
-	}
+	in.Destination.DeepCopyInto(&out.Destination)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Telemetry.
-func (in *Telemetry) DeepCopy() *Telemetry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFSecurityLog.
+func (in *WAFSecurityLog) DeepCopy() *WAFSecurityLog {
 	if in == nil {
 		return nil
 	}
-	out := new(Telemetry)
+	out := new(WAFSecurityLog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TelemetryExporter) DeepCopyInto(out *TelemetryExporter) {
+func (in *WAFSecurityLogDestination) DeepCopyInto(out *WAFSecurityLogDestination) {
 	*out = *in
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(Duration)
-		**out = **in
-	}
-	if in.BatchSize != nil {
-		in, out := &in.BatchSize, &out.BatchSize
-		*out = new(int32)
-		**out = **in
-	}
-	if in.BatchCount != nil {
-		in, out := &in.BatchCount, &out.BatchCount
-		*out = new(int32)
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(WAFSyslogDestination)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TelemetryExporter.
-func (in *TelemetryExporter) DeepCopy() *TelemetryExporter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFSecurityLogDestination.
+func (in *WAFSecurityLogDestination) DeepCopy() *WAFSecurityLogDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(TelemetryExporter)
+	out := new(WAFSecurityLogDestination)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tracing) DeepCopyInto(out *Tracing) {
+func (in *WAFSyslogDestination) DeepCopyInto(out *WAFSyslogDestination) {
 	*out = *in
-	if in.Ratio != nil {
-		in, out := &in.Ratio, &out.Ratio
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Context != nil {
-		in, out := &in.Context, &out.Context
-		*out = new(TraceContext)
-		**out = **in
-	}
-	if in.SpanName != nil {
-		in, out := &in.SpanName, &out.SpanName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SpanAttributes != nil {
-		in, out := &in.SpanAttributes, &out.SpanAttributes
-		*out = make([]SpanAttribute, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tracing.
-func (in *Tracing) DeepCopy() *Tracing {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFSyslogDestination.
+func (in *WAFSyslogDestination) DeepCopy() *WAFSyslogDestination {
 	if in == nil {
 		return nil
 	}
-	out := new(Tracing)
+	out := new(WAFSyslogDestination)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -91,3 +91,16 @@ Expand leader election lock name.
 {{- printf "%s-%s" (include "nginx-gateway.fullname" .) "leader-election" -}}
 {{- end -}}
 {{- end -}}
+
+{{/*
+Returns "true" if the feature is enabled, either with its feature gate or with the experimental features of Gateway API.
+Usage: include "nginx-gateway.featureEnabled" (dict "root" . "feature" "TLSRoute")
+*/}}
+{{- define "nginx-gateway.featureEnabled" -}}
+{{- $gates := .root.Values.nginxGateway.featureGates | default dict -}}
+{{- if hasKey $gates .feature -}}
+{{- if get $gates .feature }}true{{ end -}}
+{{- else if .root.Values.nginxGateway.gwAPIExperimentalFeatures.enable -}}
+true
+{{- end -}}
+{{- end -}}
@@ -6,15 +6,16 @@ import (
 	"os"
 	"runtime/debug"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log"
-	ctlrZap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/provisioner"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static"
@@ -29,6 +30,10 @@ const (
 	gatewayCtlrNameFlag     = "gateway-ctlr-name"
 	gatewayCtlrNameUsageFmt = `The name of the Gateway controller. ` +
 		`The controller name must be of the form: DOMAIN/PATH. The controller's domain is '%s'`
+	logFormatFlag  = "log-format"
+	logFormatUsage = `The format of the logs. Supported values: "json", "console".`
+	logLevelFlag   = "log-level"
+	logLevelUsage  = `The level of the logs. Supported values: "info", "debug", "error".`
 )
 
 func createRootCommand() *cobra.Command {
@@ -53,18 +58,34 @@ func createStaticModeCommand() *cobra.Command {
 		updateGCStatusFlag          = "update-gatewayclass-status"
 		metricsDisableFlag          = "metrics-disable"
 		metricsSecureFlag           = "metrics-secure-serving"
+		debugEndpointsFlag          = "debug-endpoints"
 		metricsPortFlag             = "metrics-port"
 		healthDisableFlag           = "health-disable"
 		healthPortFlag              = "health-port"
 		leaderElectionDisableFlag   = "leader-election-disable"
 		leaderElectionLockNameFlag  = "leader-election-lock-name"
 		productTelemetryDisableFlag = "product-telemetry-disable"
+		// disableTelemetryFlag is an alias of the productTelemetryDisableFlag.
+		disableTelemetryFlag        = "disable-telemetry"
 		plusFlag                    = "nginx-plus"
 		gwAPIExperimentalFlag       = "gateway-api-experimental-features"
+		featureGatesFlag            = "feature-gates"
 		usageReportSecretFlag       = "usage-report-secret"
 		usageReportServerURLFlag    = "usage-report-server-url"
 		usageReportSkipVerifyFlag   = "usage-report-skip-verify"
 		usageReportClusterNameFlag  = "usage-report-cluster-name"
+		webhookFlag                 = "admission-webhook"
+		webhookPortFlag             = "admission-webhook-port"
+		webhookCertDirFlag          = "admission-webhook-cert-dir"
+		profilingFlag               = "profiling"
+		profilingPortFlag           = "profiling-port"
+		certExpiryWarningWindowFlag = "certificate-expiry-warning-window"
+		externalCertificatesDirFlag = "external-certificates-dir"
+		templateOverridesDirFlag    = "config-template-overrides-dir"
+		unprivilegedPortOffsetFlag  = "unprivileged-port-offset"
+		sessionTicketKeysSecretFlag = "session-ticket-keys-secret"
+		sessionTicketRotationFlag   = "session-ticket-key-rotation-period"
+		auditConfigMapFlag          = "audit-config-map"
 	)
 
 	// flag values
@@ -87,6 +108,7 @@ func createStaticModeCommand() *cobra.Command {
 		}
 		disableMetrics    bool
 		metricsSecure     bool
+		debugEndpoints    bool
 		metricsListenPort = intValidatingValue{
 			validator: validatePort,
 			value:     9113,
@@ -104,6 +126,7 @@ func createStaticModeCommand() *cobra.Command {
 		}
 
 		gwExperimentalFeatures bool
+		featureGates           = config.NewFeatureGates()
 
 		disableProductTelemetry bool
 
@@ -116,15 +139,54 @@ func createStaticModeCommand() *cobra.Command {
 		usageReportServerURL  = stringValidatingValue{
 			validator: validateURL,
 		}
+
+		enableWebhook     bool
+		webhookListenPort = intValidatingValue{
+			validator: validatePort,
+			value:     9443,
+		}
+		webhookCertDir string
+
+		enableProfiling     bool
+		profilingListenPort = intValidatingValue{
+			validator: validatePort,
+			value:     6060,
+		}
+
+		certExpiryWarningWindow time.Duration
+
+		externalCertificatesDir string
+
+		templateOverridesDir string
+
+		unprivilegedPortOffset = intValidatingValue{
+			validator: validateUnprivilegedPortOffset,
+		}
+
+		sessionTicketKeysSecretName = namespacedNameValue{}
+		sessionTicketKeyRotation    time.Duration
+
+		auditConfigMapName = namespacedNameValue{}
+
+		logFormat = stringValidatingValue{
+			validator: validateLogFormat,
+			value:     logFormatJSON,
+		}
+		logLevel = stringValidatingValue{
+			validator: validateLogLevel,
+			value:     logLevelInfo,
+		}
 	)
 
 	cmd := &cobra.Command{
 		Use:   "static-mode",
 		Short: "Configure NGINX in the scope of a single Gateway resource",
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			atom := zap.NewAtomicLevel()
+			logger, atom, err := createLogger(os.Stderr, logFormat.value, logLevel.value)
+			if err != nil {
+				return fmt.Errorf("error creating logger: %w", err)
+			}
 
-			logger := ctlrZap.New(ctlrZap.Level(atom))
 			commit, date, dirty := getBuildInfo()
 			logger.Info(
 				"Starting NGINX Gateway Fabric in static mode",
@@ -135,7 +197,25 @@ func createStaticModeCommand() *cobra.Command {
 			)
 			log.SetLogger(logger)
 
-			if err := ensureNoPortCollisions(metricsListenPort.value, healthListenPort.value); err != nil {
+			if gwExperimentalFeatures {
+				for _, f := range config.GatewayAPIExperimentalFeatures {
+					if err := featureGates.SetDefault(f, true); err != nil {
+						return fmt.Errorf("error enabling experimental feature: %w", err)
+					}
+				}
+			}
+
+			logger.Info("Feature gates", "enabled", featureGates.EnabledFeatures())
+
+			ports := []int{metricsListenPort.value, healthListenPort.value}
+			if enableWebhook {
+				ports = append(ports, webhookListenPort.value)
+			}
+			if enableProfiling {
+				ports = append(ports, profilingListenPort.value)
+			}
+
+			if err := ensureNoPortCollisions(ports...); err != nil {
 				return fmt.Errorf("error validating ports: %w", err)
 			}
 
@@ -180,6 +260,14 @@ func createStaticModeCommand() *cobra.Command {
 				gwNsName = &gateway.value
 			}
 
+			if certExpiryWarningWindow < 0 {
+				return errors.New("certificate-expiry-warning-window must not be negative")
+			}
+
+			if debugEndpoints && disableMetrics {
+				return errors.New("debug-endpoints is only valid if metrics are enabled")
+			}
+
 			var usageReportConfig *config.UsageReportConfig
 			if cmd.Flags().Changed(usageReportSecretFlag) {
 				if !plus {
@@ -194,6 +282,23 @@ func createStaticModeCommand() *cobra.Command {
 				}
 			}
 
+			var sessionTicketKeysConfig *config.SessionTicketKeysConfig
+			if cmd.Flags().Changed(sessionTicketKeysSecretFlag) {
+				if sessionTicketKeyRotation <= 0 {
+					return errors.New("session-ticket-key-rotation-period must be positive")
+				}
+
+				sessionTicketKeysConfig = &config.SessionTicketKeysConfig{
+					SecretNsName:   sessionTicketKeysSecretName.value,
+					RotationPeriod: sessionTicketKeyRotation,
+				}
+			}
+
+			var auditConfigMapNsName *types.NamespacedName
+			if cmd.Flags().Changed(auditConfigMapFlag) {
+				auditConfigMapNsName = &auditConfigMapName.value
+			}
+
 			flagKeys, flagValues := parseFlags(cmd.Flags())
 
 			conf := config.Config{
@@ -201,6 +306,7 @@ func createStaticModeCommand() *cobra.Command {
 				ConfigName:               configName.String(),
 				Logger:                   logger,
 				AtomicLevel:              atom,
+				LogLevel:                 logLevel.value,
 				GatewayClassName:         gatewayClassName.value,
 				GatewayNsName:            gwNsName,
 				UpdateGatewayClassStatus: updateGCStatus,
@@ -215,26 +321,42 @@ func createStaticModeCommand() *cobra.Command {
 					Port:    healthListenPort.value,
 				},
 				MetricsConfig: config.MetricsConfig{
-					Enabled: !disableMetrics,
-					Port:    metricsListenPort.value,
-					Secure:  metricsSecure,
+					Enabled:        !disableMetrics,
+					Port:           metricsListenPort.value,
+					Secure:         metricsSecure,
+					DebugEndpoints: debugEndpoints,
+				},
+				WebhookConfig: config.WebhookConfig{
+					Enabled: enableWebhook,
+					Port:    webhookListenPort.value,
+					CertDir: webhookCertDir,
+				},
+				ProfilingConfig: config.ProfilingConfig{
+					Enabled: enableProfiling,
+					Port:    profilingListenPort.value,
 				},
 				LeaderElection: config.LeaderElectionConfig{
 					Enabled:  !disableLeaderElection,
 					LockName: leaderElectionLockName.String(),
 					Identity: podName,
 				},
-				UsageReportConfig: usageReportConfig,
+				UsageReportConfig:              usageReportConfig,
+				SessionTicketKeysConfig:        sessionTicketKeysConfig,
+				AuditConfigMapNsName:           auditConfigMapNsName,
+				CertificateExpiryWarningWindow: certExpiryWarningWindow,
+				ExternalCertificatesDir:        externalCertificatesDir,
+				ConfigTemplateOverridesDir:     templateOverridesDir,
+				UnprivilegedPortOffset:         int32(unprivilegedPortOffset.value), //nolint:gosec // validated range
 				ProductTelemetryConfig: config.ProductTelemetryConfig{
 					ReportPeriod:     period,
 					Enabled:          !disableProductTelemetry,
 					Endpoint:         telemetryEndpoint,
 					EndpointInsecure: telemetryEndpointInsecure,
 				},
-				Plus:                 plus,
-				Version:              version,
-				ExperimentalFeatures: gwExperimentalFeatures,
-				ImageSource:          imageSource,
+				Plus:         plus,
+				Version:      version,
+				FeatureGates: featureGates,
+				ImageSource:  imageSource,
 				Flags: config.Flags{
 					Names:  flagKeys,
 					Values: flagValues,
@@ -316,6 +438,16 @@ func createStaticModeCommand() *cobra.Command {
 			" Please note that this endpoint will be secured with a self-signed certificate.",
 	)
 
+	cmd.Flags().BoolVar(
+		&debugEndpoints,
+		debugEndpointsFlag,
+		false,
+		"Serve the latest graph of resources and the latest generated NGINX configuration on the "+
+			"/debug/graph and /debug/config endpoints of the metrics server, and a support bundle with the state of "+
+			"the control plane on the /debug/support-bundle endpoint. Requests must include a bearer token "+
+			"of a user that is allowed to get the endpoint path.",
+	)
+
 	cmd.Flags().BoolVar(
 		&disableHealth,
 		healthDisableFlag,
@@ -349,9 +481,17 @@ func createStaticModeCommand() *cobra.Command {
 		&disableProductTelemetry,
 		productTelemetryDisableFlag,
 		false,
-		"Disable the collection of product telemetry.",
+		"Disable the collection of product telemetry. Also available as --"+disableTelemetryFlag+".",
 	)
 
+	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == disableTelemetryFlag {
+			name = productTelemetryDisableFlag
+		}
+
+		return pflag.NormalizedName(name)
+	})
+
 	cmd.Flags().BoolVar(
 		&plus,
 		plusFlag,
@@ -364,7 +504,16 @@ func createStaticModeCommand() *cobra.Command {
 		gwAPIExperimentalFlag,
 		false,
 		"Enable the experimental features of Gateway API which are supported by NGINX Gateway Fabric. "+
-			"Requires the Gateway APIs installed from the experimental channel.",
+			"Requires the Gateway APIs installed from the experimental channel. "+
+			"Enables the TLSRoute and BackendTLSPolicy features unless they are set with the feature gates. "+
+			"Features whose CRDs are not installed are disabled on startup.",
+	)
+
+	cmd.Flags().Var(
+		featureGates,
+		featureGatesFlag,
+		"A set of key=value pairs that enable or disable features that are not generally available. "+
+			"Options are:\n"+strings.Join(featureGates.KnownFeatures(), "\n"),
 	)
 
 	cmd.Flags().Var(
@@ -394,10 +543,132 @@ func createStaticModeCommand() *cobra.Command {
 		"Disable client verification of the NGINX Plus usage reporting server certificate.",
 	)
 
+	cmd.Flags().BoolVar(
+		&enableWebhook,
+		webhookFlag,
+		false,
+		"Enable the validating admission webhook, which rejects invalid HTTPRoutes and NGINX Gateway Fabric"+
+			" policies when they are applied. Requires a ValidatingWebhookConfiguration that targets the webhook.",
+	)
+
+	cmd.Flags().Var(
+		&webhookListenPort,
+		webhookPortFlag,
+		"Set the port where the admission webhook server is exposed. Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().StringVar(
+		&webhookCertDir,
+		webhookCertDirFlag,
+		"/var/run/secrets/nginx-gateway/webhook",
+		"The directory that contains the TLS certificate (tls.crt) and key (tls.key)"+
+			" of the admission webhook server.",
+	)
+
+	cmd.Flags().BoolVar(
+		&enableProfiling,
+		profilingFlag,
+		false,
+		"Enable the profiling server, which serves pprof profiles, goroutine dumps, and expvar variables on localhost.",
+	)
+
+	cmd.Flags().Var(
+		&profilingListenPort,
+		profilingPortFlag,
+		"Set the port on localhost where the profiling server is exposed. Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().DurationVar(
+		&certExpiryWarningWindow,
+		certExpiryWarningWindowFlag,
+		30*24*time.Hour,
+		"Set the window before the expiry of a certificate referenced by a Gateway listener in which warning Events"+
+			" are emitted for the Gateway. Must be parsable by https://pkg.go.dev/time#ParseDuration."+
+			" Set to 0 to disable the Events.",
+	)
+
+	cmd.Flags().StringVar(
+		&externalCertificatesDir,
+		externalCertificatesDirFlag,
+		"",
+		"The directory that contains the certificates that Gateway listeners can reference with a certificateRef"+
+			" of the group gateway.nginx.org and the kind ExternalCertificate, such as a Secrets Store CSI driver volume."+
+			" The certificate and key of the ExternalCertificate <name> are the files <name>.crt and <name>.key."+
+			" The files are reloaded when they change. If not set, ExternalCertificates are not supported.",
+	)
+
+	cmd.Flags().StringVar(
+		&templateOverridesDir,
+		templateOverridesDirFlag,
+		"",
+		"The directory that contains the templates that override the built-in templates of the sections of the NGINX"+
+			" configuration, such as a mounted ConfigMap. The supported files are http.tmpl, servers.tmpl,"+
+			" and upstreams.tmpl. An invalid template is reported, and the built-in template is used instead."+
+			" The templates are loaded at startup. If not set, the built-in templates are used.",
+	)
+
+	cmd.Flags().Var(
+		&unprivilegedPortOffset,
+		unprivilegedPortOffsetFlag,
+		"The offset that is added to the privileged ports (below 1024) of the Gateway listeners, so that NGINX binds"+
+			" to unprivileged ports and runs without any capabilities, for example, with the restricted"+
+			" SecurityContextConstraints of OpenShift. The Service of NGINX must target the ports plus the offset."+
+			" Set to 0 to bind to the ports of the listeners. Format: [0 - 64512]",
+	)
+
+	cmd.Flags().Var(
+		&sessionTicketKeysSecretName,
+		sessionTicketKeysSecretFlag,
+		"The namespace/name of the Secret that holds the TLS session ticket keys of NGINX. The leader creates the"+
+			" Secret and rotates the keys, and every replica configures its NGINX with the keys, so that the clients"+
+			" can resume their TLS sessions on any replica. If not set, every NGINX generates its own keys.",
+	)
+
+	cmd.Flags().DurationVar(
+		&sessionTicketKeyRotation,
+		sessionTicketRotationFlag,
+		12*time.Hour,
+		"The period at which a new TLS session ticket key is generated. Only used with the "+
+			sessionTicketKeysSecretFlag+" flag. Must be parsable by https://pkg.go.dev/time#ParseDuration.",
+	)
+
+	cmd.Flags().Var(
+		&auditConfigMapName,
+		auditConfigMapFlag,
+		"The namespace/name of a ConfigMap in which the leader keeps the latest records of the audit trail of the"+
+			" NGINX configuration: the time, the triggering resources, the summary of the changes, and the result of"+
+			" every generation. If not set, the records are only logged.",
+	)
+
+	cmd.Flags().Var(
+		&logFormat,
+		logFormatFlag,
+		logFormatUsage,
+	)
+
+	cmd.Flags().Var(
+		&logLevel,
+		logLevelFlag,
+		logLevelUsage+
+			" If the NginxGateway resource is configured, its logging level takes precedence once it is read"+
+			" and can be changed at runtime.",
+	)
+
 	return cmd
 }
 
 func createProvisionerModeCommand() *cobra.Command {
+	// flag names
+	const (
+		nginxImageFlag         = "nginx-image"
+		nginxGatewayImageFlag  = "nginx-gateway-image"
+		imagePullPolicyFlag    = "image-pull-policy"
+		imagePullSecretsFlag   = "image-pull-secrets"
+		nginxCPURequestFlag    = "nginx-cpu-request"
+		nginxMemoryRequestFlag = "nginx-memory-request"
+	)
+
+	// flag values
 	var (
 		gatewayCtlrName = stringValidatingValue{
 			validator: validateGatewayControllerName,
@@ -405,6 +676,31 @@ func createProvisionerModeCommand() *cobra.Command {
 		gatewayClassName = stringValidatingValue{
 			validator: validateResourceName,
 		}
+		logFormat = stringValidatingValue{
+			validator: validateLogFormat,
+			value:     logFormatJSON,
+		}
+		logLevel = stringValidatingValue{
+			validator: validateLogLevel,
+			value:     logLevelInfo,
+		}
+		nginxImage = stringValidatingValue{
+			validator: validateImage,
+		}
+		nginxGatewayImage = stringValidatingValue{
+			validator: validateImage,
+		}
+		imagePullPolicy = stringValidatingValue{
+			validator: validateImagePullPolicy,
+		}
+		nginxCPURequest = stringValidatingValue{
+			validator: validateResourceQuantity,
+		}
+		nginxMemoryRequest = stringValidatingValue{
+			validator: validateResourceQuantity,
+		}
+
+		imagePullSecrets []string
 	)
 
 	cmd := &cobra.Command{
@@ -412,7 +708,11 @@ func createProvisionerModeCommand() *cobra.Command {
 		Short:  "Provision a static-mode NGINX Gateway Fabric Deployment per Gateway resource",
 		Hidden: true,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			logger := ctlrZap.New()
+			logger, _, err := createLogger(os.Stderr, logFormat.value, logLevel.value)
+			if err != nil {
+				return fmt.Errorf("error creating logger: %w", err)
+			}
+
 			commit, date, dirty := getBuildInfo()
 			logger.Info(
 				"Starting NGINX Gateway Fabric Provisioner",
@@ -422,10 +722,31 @@ func createProvisionerModeCommand() *cobra.Command {
 				"dirty", dirty,
 			)
 
+			for _, secret := range imagePullSecrets {
+				if err := validateResourceName(secret); err != nil {
+					return fmt.Errorf("error validating image pull secret %q: %w", secret, err)
+				}
+			}
+
+			resourceRequests := make(v1.ResourceList)
+			if nginxCPURequest.value != "" {
+				resourceRequests[v1.ResourceCPU] = resource.MustParse(nginxCPURequest.value)
+			}
+			if nginxMemoryRequest.value != "" {
+				resourceRequests[v1.ResourceMemory] = resource.MustParse(nginxMemoryRequest.value)
+			}
+
 			return provisioner.StartManager(provisioner.Config{
 				Logger:           logger,
 				GatewayClassName: gatewayClassName.value,
 				GatewayCtlrName:  gatewayCtlrName.value,
+				DeploymentConfig: provisioner.DeploymentConfig{
+					NginxImage:            nginxImage.value,
+					NginxGatewayImage:     nginxGatewayImage.value,
+					ImagePullPolicy:       v1.PullPolicy(imagePullPolicy.value),
+					ImagePullSecrets:      imagePullSecrets,
+					NginxResourceRequests: resourceRequests,
+				},
 			})
 		},
 	}
@@ -444,6 +765,268 @@ func createProvisionerModeCommand() *cobra.Command {
 	)
 	utilruntime.Must(cmd.MarkFlagRequired(gatewayClassFlag))
 
+	cmd.Flags().Var(
+		&logFormat,
+		logFormatFlag,
+		logFormatUsage,
+	)
+
+	cmd.Flags().Var(
+		&logLevel,
+		logLevelFlag,
+		logLevelUsage,
+	)
+
+	cmd.Flags().Var(
+		&nginxImage,
+		nginxImageFlag,
+		"The image of the nginx container of the provisioned Deployments. "+
+			"If not set, the image of the Deployment manifest is used.",
+	)
+
+	cmd.Flags().Var(
+		&nginxGatewayImage,
+		nginxGatewayImageFlag,
+		"The image of the nginx-gateway container of the provisioned Deployments. "+
+			"If not set, the image of the Deployment manifest is used.",
+	)
+
+	cmd.Flags().Var(
+		&imagePullPolicy,
+		imagePullPolicyFlag,
+		"The image pull policy of the containers of the provisioned Deployments. "+
+			`Supported values: "Always", "IfNotPresent", "Never". `+
+			"If not set, the image pull policy of the Deployment manifest is used.",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&imagePullSecrets,
+		imagePullSecretsFlag,
+		nil,
+		"A comma-separated list of the names of the Secrets to pull the images of the provisioned Deployments with. "+
+			"The Secrets must exist in the Namespace of the provisioned Deployments.",
+	)
+
+	cmd.Flags().Var(
+		&nginxCPURequest,
+		nginxCPURequestFlag,
+		"The CPU request of the nginx container of the provisioned Deployments, for example, 100m.",
+	)
+
+	cmd.Flags().Var(
+		&nginxMemoryRequest,
+		nginxMemoryRequestFlag,
+		"The memory request of the nginx container of the provisioned Deployments, for example, 128Mi.",
+	)
+
+	return cmd
+}
+
+func createGenerateCommand() *cobra.Command {
+	// flag names
+	const (
+		resourcesFlag               = "resources"
+		plusFlag                    = "nginx-plus"
+		externalCertificatesDirFlag = "external-certificates-dir"
+	)
+
+	// flag values
+	var (
+		gatewayCtlrName = stringValidatingValue{
+			validator: validateGatewayControllerName,
+		}
+		gatewayClassName = stringValidatingValue{
+			validator: validateResourceName,
+		}
+		resources               string
+		externalCertificatesDir string
+		plus                    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Print the NGINX configuration generated for Kubernetes resource manifests without a cluster",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			manifests, err := readManifests(resources)
+			if err != nil {
+				return err
+			}
+
+			files, err := static.Generate(
+				cmd.Context(),
+				config.GenerateConfig{
+					GatewayCtlrName:         gatewayCtlrName.value,
+					GatewayClassName:        gatewayClassName.value,
+					ExternalCertificatesDir: externalCertificatesDir,
+					Plus:                    plus,
+				},
+				manifests,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to generate NGINX configuration: %w", err)
+			}
+
+			return printFiles(cmd.OutOrStdout(), files)
+		},
+	}
+
+	cmd.Flags().Var(
+		&gatewayCtlrName,
+		gatewayCtlrNameFlag,
+		fmt.Sprintf(gatewayCtlrNameUsageFmt, domain),
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayCtlrNameFlag))
+
+	cmd.Flags().Var(
+		&gatewayClassName,
+		gatewayClassFlag,
+		gatewayClassNameUsage,
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayClassFlag))
+
+	cmd.Flags().StringVarP(
+		&resources,
+		resourcesFlag,
+		"f",
+		"",
+		"A YAML or JSON manifest file, or a directory of manifest files, containing the Gateway API, NGF, "+
+			"and Kubernetes resources to generate the NGINX configuration for. Use '-' to read from stdin.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(resourcesFlag))
+
+	cmd.Flags().BoolVar(
+		&plus,
+		plusFlag,
+		false,
+		"Generate the configuration for NGINX Plus instead of NGINX OSS.",
+	)
+
+	cmd.Flags().StringVar(
+		&externalCertificatesDir,
+		externalCertificatesDirFlag,
+		"",
+		"The directory that contains the certificates that Gateway listeners reference as ExternalCertificates."+
+			" The certificate and key of the ExternalCertificate <name> are the files <name>.crt and <name>.key.",
+	)
+
+	return cmd
+}
+
+func createDescribeCommand() *cobra.Command {
+	// flag names
+	const (
+		resourcesFlag               = "resources"
+		plusFlag                    = "nginx-plus"
+		routeFlag                   = "route"
+		hostnameFlag                = "hostname"
+		externalCertificatesDirFlag = "external-certificates-dir"
+	)
+
+	// flag values
+	var (
+		gatewayCtlrName = stringValidatingValue{
+			validator: validateGatewayControllerName,
+		}
+		gatewayClassName = stringValidatingValue{
+			validator: validateResourceName,
+		}
+		route                   = namespacedNameValue{}
+		resources               string
+		hostname                string
+		externalCertificatesDir string
+		plus                    bool
+	)
+
+	cmd := &cobra.Command{
+		Use: "describe",
+		Short: "Print the Listeners, Policies, and NGINX configuration that apply to a Route or hostname " +
+			"in Kubernetes resource manifests without a cluster",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			manifests, err := readManifests(resources)
+			if err != nil {
+				return err
+			}
+
+			query := static.DescribeQuery{Hostname: hostname}
+			if cmd.Flags().Changed(routeFlag) {
+				query.Route = &route.value
+			}
+
+			err = static.DescribeRoutes(
+				cmd.Context(),
+				config.GenerateConfig{
+					GatewayCtlrName:         gatewayCtlrName.value,
+					GatewayClassName:        gatewayClassName.value,
+					ExternalCertificatesDir: externalCertificatesDir,
+					Plus:                    plus,
+				},
+				manifests,
+				query,
+				cmd.OutOrStdout(),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to describe: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Var(
+		&gatewayCtlrName,
+		gatewayCtlrNameFlag,
+		fmt.Sprintf(gatewayCtlrNameUsageFmt, domain),
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayCtlrNameFlag))
+
+	cmd.Flags().Var(
+		&gatewayClassName,
+		gatewayClassFlag,
+		gatewayClassNameUsage,
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayClassFlag))
+
+	cmd.Flags().StringVarP(
+		&resources,
+		resourcesFlag,
+		"f",
+		"",
+		"A YAML or JSON manifest file, or a directory of manifest files, containing the Gateway API, NGF, "+
+			"and Kubernetes resources to describe. Use '-' to read from stdin.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(resourcesFlag))
+
+	cmd.Flags().BoolVar(
+		&plus,
+		plusFlag,
+		false,
+		"Describe the configuration for NGINX Plus instead of NGINX OSS.",
+	)
+
+	cmd.Flags().StringVar(
+		&externalCertificatesDir,
+		externalCertificatesDirFlag,
+		"",
+		"The directory that contains the certificates that Gateway listeners reference as ExternalCertificates."+
+			" The certificate and key of the ExternalCertificate <name> are the files <name>.crt and <name>.key.",
+	)
+
+	cmd.Flags().Var(
+		&route,
+		routeFlag,
+		"The namespaced name of the HTTPRoute or GRPCRoute to describe. Format: NAMESPACE/NAME",
+	)
+
+	cmd.Flags().StringVar(
+		&hostname,
+		hostnameFlag,
+		"",
+		"The hostname to describe the Routes and NGINX servers of, for example, cafe.example.com.",
+	)
+
+	cmd.MarkFlagsOneRequired(routeFlag, hostnameFlag)
+	cmd.MarkFlagsMutuallyExclusive(routeFlag, hostnameFlag)
+
 	return cmd
 }
 
@@ -2,6 +2,7 @@ package main
 
 import (
 	"io"
+	"slices"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -126,6 +127,18 @@ func TestCommonFlagsValidation(t *testing.T) {
 			t.Parallel()
 			testFlag(t, createProvisionerModeCommand(), test)
 		})
+		t.Run(test.name+"_generate", func(t *testing.T) {
+			t.Parallel()
+			generateTest := test
+			generateTest.args = append(slices.Clone(test.args), "--resources=manifests.yaml")
+			testFlag(t, createGenerateCommand(), generateTest)
+		})
+		t.Run(test.name+"_describe", func(t *testing.T) {
+			t.Parallel()
+			describeTest := test
+			describeTest.args = append(slices.Clone(test.args), "--resources=manifests.yaml", "--hostname=foo.example.com")
+			testFlag(t, createDescribeCommand(), describeTest)
+		})
 	}
 }
 
@@ -144,6 +157,7 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--metrics-port=9114",
 				"--metrics-disable",
 				"--metrics-secure-serving",
+				"--debug-endpoints",
 				"--health-port=8081",
 				"--health-disable",
 				"--leader-election-lock-name=my-lock",
@@ -151,6 +165,21 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--usage-report-secret=default/my-secret",
 				"--usage-report-server-url=https://my-api.com",
 				"--usage-report-cluster-name=my-cluster",
+				"--admission-webhook",
+				"--admission-webhook-port=9444",
+				"--admission-webhook-cert-dir=/etc/certs",
+				"--profiling",
+				"--profiling-port=6061",
+				"--certificate-expiry-warning-window=168h",
+				"--external-certificates-dir=/var/run/secrets/nginx-gateway/external",
+				"--config-template-overrides-dir=/etc/nginx-gateway/template-overrides",
+				"--unprivileged-port-offset=8000",
+				"--session-ticket-keys-secret=nginx-gateway/session-ticket-keys",
+				"--session-ticket-key-rotation-period=6h",
+				"--audit-config-map=nginx-gateway/audit",
+				"--log-format=console",
+				"--log-level=debug",
+				"--feature-gates=TLSRoute=true,BackendTLSPolicy=false",
 			},
 			wantErr: false,
 		},
@@ -263,6 +292,15 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			expectedErrPrefix: `invalid argument "999" for "--metrics-secure-serving" flag: strconv.ParseBool:` +
 				` parsing "999": invalid syntax`,
 		},
+		{
+			name: "debug-endpoints is not a bool",
+			args: []string{
+				"--debug-endpoints=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--debug-endpoints" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
 		{
 			name: "health-port is invalid type",
 			args: []string{
@@ -281,6 +319,113 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			expectedErrPrefix: `invalid argument "999" for "--health-port" flag:` +
 				` port outside of valid port range [1024 - 65535]: 999`,
 		},
+		{
+			name: "admission-webhook-port is outside of range",
+			args: []string{
+				"--admission-webhook-port=999", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--admission-webhook-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "admission-webhook is not a bool",
+			args: []string{
+				"--admission-webhook=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--admission-webhook" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
+		{
+			name: "profiling-port is outside of range",
+			args: []string{
+				"--profiling-port=999", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--profiling-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "unprivileged-port-offset is outside of range",
+			args: []string{
+				"--unprivileged-port-offset=64513", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "64513" for "--unprivileged-port-offset" flag:` +
+				` offset outside of valid range [0 - 64512]: 64513`,
+		},
+		{
+			name: "profiling is not a bool",
+			args: []string{
+				"--profiling=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--profiling" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
+		{
+			name: "certificate-expiry-warning-window is not a duration",
+			args: []string{
+				"--certificate-expiry-warning-window=7d", // not a duration
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "7d" for "--certificate-expiry-warning-window" flag:` +
+				` time: unknown unit "d" in duration "7d"`,
+		},
+		{
+			name: "session-ticket-keys-secret is invalid",
+			args: []string{
+				"--session-ticket-keys-secret=session-ticket-keys", // no namespace
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "session-ticket-keys" for "--session-ticket-keys-secret" flag: ` +
+				"invalid format; must be NAMESPACE/NAME",
+		},
+		{
+			name: "audit-config-map is invalid",
+			args: []string{
+				"--audit-config-map=audit", // no namespace
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "audit" for "--audit-config-map" flag: ` +
+				"invalid format; must be NAMESPACE/NAME",
+		},
+		{
+			name: "feature-gates has unknown feature",
+			args: []string{
+				"--feature-gates=HTTP3=true",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "HTTP3=true" for "--feature-gates" flag: unknown feature gate "HTTP3"`,
+		},
+		{
+			name: "feature-gates has invalid value",
+			args: []string{
+				"--feature-gates=TLSRoute=yes",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "TLSRoute=yes" for "--feature-gates" flag:` +
+				` invalid value "yes" for feature gate "TLSRoute"`,
+		},
+		{
+			name: "log-format is not supported",
+			args: []string{
+				"--log-format=text",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "text" for "--log-format" flag:` +
+				` unsupported log format "text"; must be one of: json, console`,
+		},
+		{
+			name: "log-level is not supported",
+			args: []string{
+				"--log-level=warn",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "warn" for "--log-level" flag:` +
+				` unsupported log level "warn"; must be one of: info, debug, error`,
+		},
 		{
 			name: "health-disable is not a bool",
 			args: []string{
@@ -306,6 +451,23 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			wantErr:           true,
 			expectedErrPrefix: `invalid argument "" for "--leader-election-disable" flag: strconv.ParseBool`,
 		},
+		{
+			name: "disable-telemetry is set",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--disable-telemetry",
+			},
+			wantErr: false,
+		},
+		{
+			name: "disable-telemetry is set to empty string",
+			args: []string{
+				"--disable-telemetry=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--product-telemetry-disable" flag: strconv.ParseBool`,
+		},
 		{
 			name: "usage-report-secret is set to empty string",
 			args: []string{
@@ -381,18 +543,187 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 
 func TestProvisionerModeCmdFlagValidation(t *testing.T) {
 	t.Parallel()
-	testCase := flagTestCase{
-		name: "valid flags",
-		args: []string{
-			"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
-			"--gatewayclass=nginx",                                // common and required flag
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--log-format=console",
+				"--log-level=error",
+				"--nginx-image=registry.example.com/nginx-gateway-fabric/nginx:edge",
+				"--nginx-gateway-image=registry.example.com/nginx-gateway-fabric:edge",
+				"--image-pull-policy=IfNotPresent",
+				"--image-pull-secrets=registry-secret1,registry-secret2",
+				"--nginx-cpu-request=100m",
+				"--nginx-memory-request=128Mi",
+			},
+			wantErr: false,
+		},
+		{
+			name: "nginx-image is set to empty string",
+			args: []string{
+				"--nginx-image=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--nginx-image" flag: must be set`,
+		},
+		{
+			name: "image-pull-policy is invalid",
+			args: []string{
+				"--image-pull-policy=Sometimes",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "Sometimes" for "--image-pull-policy" flag: unsupported image pull policy`,
+		},
+		{
+			name: "nginx-cpu-request is invalid",
+			args: []string{
+				"--nginx-cpu-request=fast",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "fast" for "--nginx-cpu-request" flag: invalid resource quantity`,
+		},
+		{
+			name: "nginx-memory-request is invalid",
+			args: []string{
+				"--nginx-memory-request=-128Mi",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "-128Mi" for "--nginx-memory-request" flag: must not be negative`,
+		},
+	}
+
+	// common flags validation is tested separately
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			testFlag(t, createProvisionerModeCommand(), test)
+		})
+	}
+}
+
+func TestGenerateCmdFlagValidation(t *testing.T) {
+	t.Parallel()
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--resources=manifests.yaml",
+				"--nginx-plus",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid flags, shorthand",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"-f=-",
+			},
+			wantErr: false,
+		},
+		{
+			name: "resources is not set",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "resources" not set`,
+		},
+	}
+
+	// common flags validation is tested separately
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			testFlag(t, createGenerateCommand(), test)
+		})
+	}
+}
+
+func TestDescribeCmdFlagValidation(t *testing.T) {
+	t.Parallel()
+	tests := []flagTestCase{
+		{
+			name: "valid flags, route",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--resources=manifests.yaml",
+				"--route=default/coffee",
+				"--nginx-plus",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid flags, hostname",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"-f=-",
+				"--hostname=cafe.example.com",
+			},
+			wantErr: false,
+		},
+		{
+			name: "resources is not set",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--hostname=cafe.example.com",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "resources" not set`,
+		},
+		{
+			name: "neither route nor hostname is set",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--resources=manifests.yaml",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `at least one of the flags in the group [route hostname] is required`,
+		},
+		{
+			name: "both route and hostname are set",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--resources=manifests.yaml",
+				"--route=default/coffee",
+				"--hostname=cafe.example.com",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `if any flags in the group [route hostname] are set none of the others can be`,
+		},
+		{
+			name: "route is invalid",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway", // common and required flag
+				"--gatewayclass=nginx",                                // common and required flag
+				"--resources=manifests.yaml",
+				"--route=coffee",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "coffee" for "--route" flag: invalid format; must be NAMESPACE/NAME`,
 		},
-		wantErr: false,
 	}
 
 	// common flags validation is tested separately
 
-	testFlag(t, createProvisionerModeCommand(), testCase)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			testFlag(t, createDescribeCommand(), test)
+		})
+	}
 }
 
 func TestSleepCmdFlagValidation(t *testing.T) {
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	ctlrZap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	logFormatJSON    = "json"
+	logFormatConsole = "console"
+
+	logLevelInfo  = "info"
+	logLevelDebug = "debug"
+	logLevelError = "error"
+)
+
+// createLogger creates a logger that writes to w in the given format, starting at the given level.
+// The level can be changed at runtime through the returned AtomicLevel.
+func createLogger(w io.Writer, format, level string) (logr.Logger, zap.AtomicLevel, error) {
+	atom, err := zap.ParseAtomicLevel(level)
+	if err != nil {
+		return logr.Logger{}, zap.AtomicLevel{}, fmt.Errorf("error parsing log level: %w", err)
+	}
+
+	encoder := ctlrZap.JSONEncoder()
+	if format == logFormatConsole {
+		encoder = ctlrZap.ConsoleEncoder()
+	}
+
+	return ctlrZap.New(ctlrZap.Level(atom), encoder, ctlrZap.WriteTo(w)), atom, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCreateLogger(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		format    string
+		level     string
+		expOutput string
+		expLevel  zapcore.Level
+	}{
+		{
+			name:      "json",
+			format:    logFormatJSON,
+			level:     logLevelInfo,
+			expOutput: `"msg":"info message"`,
+			expLevel:  zapcore.InfoLevel,
+		},
+		{
+			name:      "console",
+			format:    logFormatConsole,
+			level:     logLevelDebug,
+			expOutput: "INFO\tinfo message",
+			expLevel:  zapcore.DebugLevel,
+		},
+		{
+			name:     "error level",
+			format:   logFormatJSON,
+			level:    logLevelError,
+			expLevel: zapcore.ErrorLevel,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var buf bytes.Buffer
+
+			logger, atom, err := createLogger(&buf, test.format, test.level)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(atom.Level()).To(Equal(test.expLevel))
+
+			logger.Info("info message")
+
+			if test.expOutput == "" {
+				g.Expect(buf.String()).To(BeEmpty())
+			} else {
+				g.Expect(buf.String()).To(ContainSubstring(test.expOutput))
+			}
+
+			// the level can be changed at runtime
+			atom.SetLevel(zapcore.InfoLevel)
+			buf.Reset()
+
+			logger.Info("info message")
+			g.Expect(buf.String()).To(ContainSubstring("info message"))
+		})
+	}
+}
+
+func TestCreateLogger_InvalidLevel(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	_, _, err := createLogger(&bytes.Buffer{}, logFormatJSON, "invalid")
+	g.Expect(err).To(MatchError(ContainSubstring("error parsing log level")))
+}
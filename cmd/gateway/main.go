@@ -24,6 +24,8 @@ func main() {
 		createStaticModeCommand(),
 		createProvisionerModeCommand(),
 		createSleepCommand(),
+		createGenerateCommand(),
+		createDescribeCommand(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+)
+
+// manifestExtensions are the extensions of the files read from a manifest directory.
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// readManifests returns a reader of the manifests in the file or directory at path.
+// If path is a directory, the manifest files in it and its subdirectories are concatenated
+// in lexical order as separate YAML documents. If path is "-", the manifests are read from stdin.
+func readManifests(path string) (io.Reader, error) {
+	if path == "-" {
+		return os.Stdin, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifests: %w", err)
+	}
+
+	if !info.IsDir() {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifests: %w", err)
+		}
+
+		return bytes.NewReader(content), nil
+	}
+
+	var buf bytes.Buffer
+
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || !slices.Contains(manifestExtensions, strings.ToLower(filepath.Ext(p))) {
+			return nil
+		}
+
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString("\n---\n")
+		buf.Write(content)
+		buf.WriteString("\n")
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifests: %w", err)
+	}
+
+	return &buf, nil
+}
+
+// printFiles prints the files sorted by path. The contents of secret files are not printed.
+func printFiles(w io.Writer, files []file.File) error {
+	sorted := slices.Clone(files)
+	slices.SortFunc(sorted, func(a, b file.File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	for _, f := range sorted {
+		content := string(f.Content)
+		if f.Type == file.TypeSecret {
+			content = "<redacted>\n"
+		}
+
+		if _, err := fmt.Fprintf(w, "# %s\n%s\n", f.Path, content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+)
+
+func TestReadManifests(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		NewWithT(t).Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		NewWithT(t).Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	}
+
+	writeFile("a.yaml", "kind: A")
+	writeFile("sub/b.yml", "kind: B")
+	writeFile("sub/c.json", `{"kind": "C"}`)
+	writeFile("README.md", "ignored")
+
+	tests := []struct {
+		name        string
+		path        string
+		expContent  string
+		expectedErr bool
+	}{
+		{
+			name:       "file",
+			path:       filepath.Join(dir, "a.yaml"),
+			expContent: "kind: A",
+		},
+		{
+			name:       "directory",
+			path:       dir,
+			expContent: "\n---\nkind: A\n\n---\nkind: B\n\n---\n{\"kind\": \"C\"}\n",
+		},
+		{
+			name:        "path does not exist",
+			path:        filepath.Join(dir, "missing.yaml"),
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			r, err := readManifests(test.path)
+			if test.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+
+			content, err := io.ReadAll(r)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(content)).To(Equal(test.expContent))
+		})
+	}
+}
+
+func TestPrintFiles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("http {}\n"),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/secrets/cert.pem",
+			Content: []byte("secret"),
+			Type:    file.TypeSecret,
+		},
+		{
+			Path:    "/etc/nginx/conf.d/config-version.conf",
+			Content: []byte("version\n"),
+			Type:    file.TypeRegular,
+		},
+	}
+
+	var buf bytes.Buffer
+	g.Expect(printFiles(&buf, files)).To(Succeed())
+
+	expected := "# /etc/nginx/conf.d/config-version.conf\nversion\n\n" +
+		"# /etc/nginx/conf.d/http.conf\nhttp {}\n\n" +
+		"# /etc/nginx/secrets/cert.pem\n<redacted>\n\n"
+	g.Expect(buf.String()).To(Equal(expected))
+	g.Expect(files[0].Path).To(Equal("/etc/nginx/conf.d/http.conf"))
+}
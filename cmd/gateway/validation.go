@@ -9,6 +9,8 @@ import (
 	"strconv"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -170,6 +172,86 @@ func validatePort(port int) error {
 	return nil
 }
 
+// validateUnprivilegedPortOffset makes sure that the privileged ports plus the offset are valid unprivileged ports.
+func validateUnprivilegedPortOffset(offset int) error {
+	const maxOffset = 65535 - 1023
+
+	if offset < 0 || offset > maxOffset {
+		return fmt.Errorf("offset outside of valid range [0 - %d]: %v", maxOffset, offset)
+	}
+
+	return nil
+}
+
+// validateLogFormat makes sure a given log format is supported.
+func validateLogFormat(format string) error {
+	switch format {
+	case logFormatJSON, logFormatConsole:
+		return nil
+	default:
+		return fmt.Errorf("unsupported log format %q; must be one of: %s, %s", format, logFormatJSON, logFormatConsole)
+	}
+}
+
+// validateLogLevel makes sure a given log level is supported.
+func validateLogLevel(level string) error {
+	switch level {
+	case logLevelInfo, logLevelDebug, logLevelError:
+		return nil
+	default:
+		return fmt.Errorf(
+			"unsupported log level %q; must be one of: %s, %s, %s",
+			level,
+			logLevelInfo,
+			logLevelDebug,
+			logLevelError,
+		)
+	}
+}
+
+// validateImage makes sure a given container image is set.
+func validateImage(image string) error {
+	if image == "" {
+		return errors.New("must be set")
+	}
+
+	if strings.ContainsAny(image, " \t\n") {
+		return errors.New("must not contain whitespace")
+	}
+
+	return nil
+}
+
+// validateImagePullPolicy makes sure a given image pull policy is supported by Kubernetes.
+func validateImagePullPolicy(policy string) error {
+	switch corev1.PullPolicy(policy) {
+	case corev1.PullAlways, corev1.PullIfNotPresent, corev1.PullNever:
+		return nil
+	default:
+		return fmt.Errorf(
+			"unsupported image pull policy %q; must be one of: %s, %s, %s",
+			policy,
+			corev1.PullAlways,
+			corev1.PullIfNotPresent,
+			corev1.PullNever,
+		)
+	}
+}
+
+// validateResourceQuantity makes sure a given resource quantity, such as 100m or 128Mi, is valid.
+func validateResourceQuantity(quantity string) error {
+	q, err := resource.ParseQuantity(quantity)
+	if err != nil {
+		return fmt.Errorf("invalid resource quantity: %w", err)
+	}
+
+	if q.Sign() < 0 {
+		return errors.New("must not be negative")
+	}
+
+	return nil
+}
+
 // ensureNoPortCollisions checks if the same port has been defined multiple times.
 func ensureNoPortCollisions(ports ...int) error {
 	seen := make(map[int]struct{})
@@ -541,6 +541,103 @@ func TestValidatePort(t *testing.T) {
 	}
 }
 
+func TestValidateUnprivilegedPortOffset(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		offset int
+		expErr bool
+	}{
+		{
+			name:   "negative offset",
+			offset: -1,
+			expErr: true,
+		},
+		{
+			name:   "offset over maximum allowed value",
+			offset: 64513,
+			expErr: true,
+		},
+		{
+			name:   "zero offset",
+			offset: 0,
+			expErr: false,
+		},
+		{
+			name:   "valid offset",
+			offset: 8000,
+			expErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateUnprivilegedPortOffset(tc.offset)
+			if !tc.expErr {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestValidateLogFormat(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateLogFormat(logFormatJSON)).To(Succeed())
+	g.Expect(validateLogFormat(logFormatConsole)).To(Succeed())
+	g.Expect(validateLogFormat("text")).ToNot(Succeed())
+	g.Expect(validateLogFormat("")).ToNot(Succeed())
+}
+
+func TestValidateLogLevel(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateLogLevel(logLevelInfo)).To(Succeed())
+	g.Expect(validateLogLevel(logLevelDebug)).To(Succeed())
+	g.Expect(validateLogLevel(logLevelError)).To(Succeed())
+	g.Expect(validateLogLevel("warn")).ToNot(Succeed())
+	g.Expect(validateLogLevel("")).ToNot(Succeed())
+}
+
+func TestValidateImage(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateImage("registry.example.com/nginx-gateway-fabric/nginx:1.4.0")).To(Succeed())
+	g.Expect(validateImage("")).ToNot(Succeed())
+	g.Expect(validateImage("registry.example.com/nginx :1.4.0")).ToNot(Succeed())
+}
+
+func TestValidateImagePullPolicy(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateImagePullPolicy("Always")).To(Succeed())
+	g.Expect(validateImagePullPolicy("IfNotPresent")).To(Succeed())
+	g.Expect(validateImagePullPolicy("Never")).To(Succeed())
+	g.Expect(validateImagePullPolicy("always")).ToNot(Succeed())
+	g.Expect(validateImagePullPolicy("")).ToNot(Succeed())
+}
+
+func TestValidateResourceQuantity(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateResourceQuantity("100m")).To(Succeed())
+	g.Expect(validateResourceQuantity("128Mi")).To(Succeed())
+	g.Expect(validateResourceQuantity("1")).To(Succeed())
+	g.Expect(validateResourceQuantity("-1")).ToNot(Succeed())
+	g.Expect(validateResourceQuantity("1 GB")).ToNot(Succeed())
+	g.Expect(validateResourceQuantity("")).ToNot(Succeed())
+}
+
 func TestEnsureNoPortCollisions(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
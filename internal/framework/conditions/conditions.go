@@ -2,6 +2,7 @@ package conditions
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -80,14 +81,15 @@ func NewDefaultGatewayClassConditions() []Condition {
 // NewGatewayClassSupportedVersionBestEffort returns a Condition that indicates that the GatewayClass is accepted,
 // but the Gateway API CRD versions are not supported. This means NGF will attempt to generate configuration,
 // but it does not guarantee support.
-func NewGatewayClassSupportedVersionBestEffort(recommendedVersion string) []Condition {
+func NewGatewayClassSupportedVersionBestEffort(recommendedVersion string, installedVersions []string) []Condition {
 	return []Condition{
 		{
 			Type:   string(v1.GatewayClassConditionStatusSupportedVersion),
 			Status: metav1.ConditionFalse,
 			Reason: string(v1.GatewayClassReasonUnsupportedVersion),
 			Message: fmt.Sprintf(
-				"Gateway API CRD versions are not recommended. Recommended version is %s",
+				"Gateway API CRD versions are not recommended. Installed versions are %s. Recommended version is %s",
+				strings.Join(installedVersions, ", "),
 				recommendedVersion,
 			),
 		},
@@ -96,25 +98,25 @@ func NewGatewayClassSupportedVersionBestEffort(recommendedVersion string) []Cond
 
 // NewGatewayClassUnsupportedVersion returns Conditions that indicate that the GatewayClass is not accepted because
 // the Gateway API CRD versions are not supported. NGF will not generate configuration in this case.
-func NewGatewayClassUnsupportedVersion(recommendedVersion string) []Condition {
+func NewGatewayClassUnsupportedVersion(recommendedVersion string, installedVersions []string) []Condition {
+	msg := fmt.Sprintf(
+		"Gateway API CRD versions are not supported. Installed versions are %s. Please install version %s",
+		strings.Join(installedVersions, ", "),
+		recommendedVersion,
+	)
+
 	return []Condition{
 		{
-			Type:   string(v1.GatewayClassConditionStatusAccepted),
-			Status: metav1.ConditionFalse,
-			Reason: string(v1.GatewayClassReasonUnsupportedVersion),
-			Message: fmt.Sprintf(
-				"Gateway API CRD versions are not supported. Please install version %s",
-				recommendedVersion,
-			),
+			Type:    string(v1.GatewayClassConditionStatusAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1.GatewayClassReasonUnsupportedVersion),
+			Message: msg,
 		},
 		{
-			Type:   string(v1.GatewayClassConditionStatusSupportedVersion),
-			Status: metav1.ConditionFalse,
-			Reason: string(v1.GatewayClassReasonUnsupportedVersion),
-			Message: fmt.Sprintf(
-				"Gateway API CRD versions are not supported. Please install version %s",
-				recommendedVersion,
-			),
+			Type:    string(v1.GatewayClassConditionStatusSupportedVersion),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1.GatewayClassReasonUnsupportedVersion),
+			Message: msg,
 		},
 	}
 }
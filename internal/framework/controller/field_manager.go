@@ -0,0 +1,6 @@
+package controller
+
+// FieldManager is the field manager of the changes that NGF makes to resources with server-side apply.
+// The API server records the fields that NGF sets under it, so that NGF and other controllers that write to the same
+// resources don't overwrite each other's fields.
+const FieldManager = "nginx-gateway-fabric"
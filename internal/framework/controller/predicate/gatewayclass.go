@@ -1,13 +1,16 @@
 package predicate
 
 import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 // GatewayClassPredicate implements a predicate function based on the controllerName of a GatewayClass.
 // This predicate will skip events for GatewayClasses that don't reference this controller.
+// It supports both the v1 and the v1beta1 GatewayClasses.
 type GatewayClassPredicate struct {
 	predicate.Funcs
 	ControllerName string
@@ -15,47 +18,37 @@ type GatewayClassPredicate struct {
 
 // Create implements default CreateEvent filter for validating a GatewayClass controllerName.
 func (gcp GatewayClassPredicate) Create(e event.CreateEvent) bool {
-	if e.Object == nil {
-		return false
-	}
-
-	gc, ok := e.Object.(*v1.GatewayClass)
-	if !ok {
-		return false
-	}
-
-	return string(gc.Spec.ControllerName) == gcp.ControllerName
+	return gcp.referencesController(e.Object)
 }
 
 // Update implements default UpdateEvent filter for validating a GatewayClass controllerName.
 func (gcp GatewayClassPredicate) Update(e event.UpdateEvent) bool {
-	if e.ObjectOld != nil {
-		gcOld, ok := e.ObjectOld.(*v1.GatewayClass)
-		if ok && string(gcOld.Spec.ControllerName) == gcp.ControllerName {
-			return true
-		}
-	}
-
-	if e.ObjectNew != nil {
-		gcNew, ok := e.ObjectNew.(*v1.GatewayClass)
-		if ok && string(gcNew.Spec.ControllerName) == gcp.ControllerName {
-			return true
-		}
-	}
-
-	return false
+	return gcp.referencesController(e.ObjectOld) || gcp.referencesController(e.ObjectNew)
 }
 
 // Delete implements default DeleteEvent filter for validating a GatewayClass controllerName.
 func (gcp GatewayClassPredicate) Delete(e event.DeleteEvent) bool {
-	if e.Object == nil {
-		return false
-	}
+	return gcp.referencesController(e.Object)
+}
+
+// referencesController returns true if obj is a GatewayClass that references this controller.
+func (gcp GatewayClassPredicate) referencesController(obj client.Object) bool {
+	var controllerName v1.GatewayController
 
-	gc, ok := e.Object.(*v1.GatewayClass)
-	if !ok {
+	switch gc := obj.(type) {
+	case *v1.GatewayClass:
+		if gc == nil {
+			return false
+		}
+		controllerName = gc.Spec.ControllerName
+	case *v1beta1.GatewayClass:
+		if gc == nil {
+			return false
+		}
+		controllerName = gc.Spec.ControllerName
+	default:
 		return false
 	}
 
-	return string(gc.Spec.ControllerName) == gcp.ControllerName
+	return string(controllerName) == gcp.ControllerName
 }
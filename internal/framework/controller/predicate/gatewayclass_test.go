@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 func TestGatewayClassPredicate(t *testing.T) {
@@ -36,4 +37,13 @@ func TestGatewayClassPredicate(t *testing.T) {
 	g.Expect(p.Delete(event.DeleteEvent{Object: nil})).To(BeFalse())
 	g.Expect(p.Delete(event.DeleteEvent{Object: gc2})).To(BeFalse())
 	g.Expect(p.Delete(event.DeleteEvent{Object: &v1.HTTPRoute{}})).To(BeFalse())
+
+	v1beta1GC := &v1beta1.GatewayClass{
+		Spec: v1.GatewayClassSpec{
+			ControllerName: "nginx-ctlr",
+		},
+	}
+	g.Expect(p.Create(event.CreateEvent{Object: v1beta1GC})).To(BeTrue())
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: gc2, ObjectNew: v1beta1GC})).To(BeTrue())
+	g.Expect(p.Delete(event.DeleteEvent{Object: v1beta1GC})).To(BeTrue())
 }
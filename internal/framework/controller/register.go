@@ -26,6 +26,7 @@ type config struct {
 	fieldIndices         index.FieldIndices
 	newReconciler        NewReconcilerFunc
 	onlyMetadata         bool
+	onlyMetadataCache    bool
 }
 
 // NewReconcilerFunc defines a function that creates a new Reconciler. Used for unit-testing.
@@ -72,6 +73,18 @@ func WithOnlyMetadata() Option {
 	}
 }
 
+// WithOnlyMetadataCache tells the controller to only cache metadata, and to watch the API server in metadata-only
+// form, like WithOnlyMetadata, but the reconciler still gets the full resources with the client of the manager.
+// The client must get the resources from the API server, rather than from the cache
+// (see client.CacheOptions.DisableFor), so that the full resources are not cached.
+// Use it with WithNamespacedNameFilter, so that only the resources that are needed are got from the API server.
+// If using this option, you must set the GroupVersionKind on the ObjectType you pass into the Register function.
+func WithOnlyMetadataCache() Option {
+	return func(cfg *config) {
+		cfg.onlyMetadataCache = true
+	}
+}
+
 func defaultConfig() config {
 	return config{
 		newReconciler: NewReconciler,
@@ -108,7 +121,7 @@ func Register(
 	}
 
 	var forOpts []ctlrBuilder.ForOption
-	if cfg.onlyMetadata {
+	if cfg.onlyMetadata || cfg.onlyMetadataCache {
 		if objectType.GetObjectKind().GroupVersionKind().Empty() {
 			panic("the object must have its GVK set")
 		}
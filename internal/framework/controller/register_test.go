@@ -177,3 +177,47 @@ func TestRegister(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterOnlyMetadataCache(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v1.Install(scheme))
+
+	mgr := &controllerfakes.FakeManager{}
+	mgr.GetClientReturns(fake.NewClientBuilder().Build())
+	mgr.GetSchemeReturns(scheme)
+	mgr.GetLoggerReturns(zap.New())
+	mgr.GetFieldIndexerReturns(&controllerfakes.FakeFieldIndexer{})
+
+	objectType := &v1.HTTPRoute{}
+	objectType.SetGroupVersionKind(
+		schema.GroupVersionKind{Group: v1.GroupName, Version: "v1", Kind: kinds.HTTPRoute},
+	)
+
+	newReconciler := func(c controller.ReconcilerConfig) *controller.Reconciler {
+		// the reconciler gets the full resources, even though only their metadata is cached
+		g.Expect(c.OnlyMetadata).To(BeFalse())
+		g.Expect(c.Getter).To(BeIdenticalTo(mgr.GetClient()))
+
+		return controller.NewReconciler(c)
+	}
+
+	register := func(objectType ngftypes.ObjectType) error {
+		return controller.Register(
+			context.Background(),
+			objectType,
+			"only-metadata-cache",
+			mgr,
+			make(chan<- interface{}),
+			controller.WithNewReconciler(newReconciler),
+			controller.WithOnlyMetadataCache(),
+		)
+	}
+
+	g.Expect(register(objectType)).To(Succeed())
+	g.Expect(mgr.AddCallCount()).To(Equal(1))
+
+	g.Expect(func() { _ = register(&v1.HTTPRoute{}) }).To(Panic())
+}
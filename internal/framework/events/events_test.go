@@ -2,6 +2,7 @@ package events
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -34,3 +35,20 @@ func TestEventLoop_SwapBatches(t *testing.T) {
 	g.Expect(eventLoop.nextBatch).To(BeEmpty())
 	g.Expect(eventLoop.nextBatch).To(HaveCap(3))
 }
+
+func TestBatchDelay(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	var delay BatchDelay
+	g.Expect(delay.Get()).To(BeZero())
+
+	delay.Set(time.Second)
+	g.Expect(delay.Get()).To(Equal(time.Second))
+
+	eventLoop := NewEventLoop(nil, zap.New(), nil, nil)
+	g.Expect(eventLoop.getBatchDelay()).To(BeZero())
+
+	eventLoop = NewEventLoop(nil, zap.New(), nil, nil, WithBatchDelay(&delay))
+	g.Expect(eventLoop.getBatchDelay()).To(Equal(time.Second))
+}
@@ -2,7 +2,9 @@ package events
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -29,6 +31,15 @@ type Reader interface {
 	client.Reader
 }
 
+// CacheSyncer waits for the caches of the resources to sync.
+// A Reader that also implements CacheSyncer, like the cache of the controller-runtime manager, makes
+// FirstEventBatchPreparerImpl wait for all caches to sync before it prepares the first batch.
+type CacheSyncer interface {
+	// WaitForCacheSync waits for the caches of all started informers to sync. It returns false if the caches
+	// could not sync.
+	WaitForCacheSync(ctx context.Context) bool
+}
+
 // EachListItemFunc lists each item of a client.ObjectList.
 // It is from k8s.io/apimachinery/pkg/api/meta.
 type EachListItemFunc func(obj runtime.Object, fn func(runtime.Object) error) error
@@ -67,13 +78,19 @@ func (p *FirstEventBatchPreparerImpl) SetEachListItem(eachListItem EachListItemF
 }
 
 func (p *FirstEventBatchPreparerImpl) Prepare(ctx context.Context) (EventBatch, error) {
-	total := 0
+	if err := p.listAll(ctx); err != nil {
+		return nil, err
+	}
 
-	for _, list := range p.objectLists {
-		if err := p.reader.List(ctx, list); err != nil {
-			return nil, err
-		}
+	// The controllers start the informers of the resources that they watch at the same time. Waiting for all caches
+	// makes sure that the first batch is prepared from the complete view of the cluster, so that the first generated
+	// configuration is not partial.
+	if syncer, ok := p.reader.(CacheSyncer); ok && !syncer.WaitForCacheSync(ctx) {
+		return nil, errors.New("failed to wait for the caches to sync")
+	}
 
+	total := 0
+	for _, list := range p.objectLists {
 		total += meta.LenList(list)
 	}
 
@@ -111,3 +128,23 @@ func (p *FirstEventBatchPreparerImpl) Prepare(ctx context.Context) (EventBatch,
 
 	return batch, nil
 }
+
+// listAll lists the resources of all objectLists concurrently. Listing the resources of a type from the cache for
+// the first time starts the informer of the type and waits for it to sync, so the informers of all types sync at
+// the same time rather than one after another.
+func (p *FirstEventBatchPreparerImpl) listAll(ctx context.Context) error {
+	errs := make([]error, len(p.objectLists))
+
+	var wg sync.WaitGroup
+	for i, list := range p.objectLists {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = p.reader.List(ctx, list)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
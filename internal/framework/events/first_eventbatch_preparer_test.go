@@ -101,6 +101,43 @@ var _ = Describe("FirstEventBatchPreparer", func() {
 		})
 	})
 
+	Describe("Waiting for the caches to sync", func() {
+		var reader *syncingReader
+
+		BeforeEach(func() {
+			reader = &syncingReader{FakeReader: fakeReader}
+			fakeReader.GetReturns(apierrors.NewNotFound(schema.GroupResource{}, "test"))
+			fakeReader.ListReturns(nil)
+
+			preparer = events.NewFirstEventBatchPreparerImpl(
+				reader,
+				[]client.Object{&v1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: gcName}}},
+				[]client.ObjectList{&v1.HTTPRouteList{}, &v1.GatewayList{}},
+			)
+		})
+
+		It("should prepare the batch after the caches sync", func() {
+			reader.synced = true
+
+			batch, err := preparer.Prepare(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batch).To(BeEmpty())
+
+			Expect(reader.waitCalls).To(Equal(1))
+			Expect(fakeReader.ListCallCount()).To(Equal(2))
+			Expect(fakeReader.GetCallCount()).To(Equal(1))
+		})
+
+		It("should return error if the caches don't sync", func() {
+			batch, err := preparer.Prepare(context.Background())
+			Expect(err).To(MatchError("failed to wait for the caches to sync"))
+			Expect(batch).To(BeNil())
+
+			Expect(reader.waitCalls).To(Equal(1))
+			Expect(fakeReader.GetCallCount()).To(BeZero())
+		})
+	})
+
 	Describe("Edge cases", func() {
 		Describe("EachListItem cases", func() {
 			BeforeEach(func() {
@@ -175,3 +212,15 @@ func (f *fakeRuntimeObject) GetObjectKind() schema.ObjectKind {
 func (f *fakeRuntimeObject) DeepCopyObject() runtime.Object {
 	return nil
 }
+
+// syncingReader is a Reader that also waits for the caches to sync, like the cache of the manager.
+type syncingReader struct {
+	*eventsfakes.FakeReader
+	waitCalls int
+	synced    bool
+}
+
+func (r *syncingReader) WaitForCacheSync(_ context.Context) bool {
+	r.waitCalls++
+	return r.synced
+}
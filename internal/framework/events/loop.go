@@ -3,6 +3,8 @@ package events
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-logr/logr"
 )
@@ -22,11 +24,16 @@ import (
 // FIXME(pleshakov): better document the side effects and how to prevent and mitigate them.
 // So when the EventLoop have 100 saved events, it is better to process them at once rather than one by one.
 // https://github.com/nginxinc/nginx-gateway-fabric/issues/551
+//
+// If a BatchDelay is configured, the new event that comes while no event(s) are being handled is not handled
+// immediately. Instead, the EventLoop waits for the delay, so that the events that come in the meantime are batched
+// with it.
 type EventLoop struct {
-	handler  EventHandler
-	preparer FirstEventBatchPreparer
-	eventCh  <-chan interface{}
-	logger   logr.Logger
+	handler    EventHandler
+	preparer   FirstEventBatchPreparer
+	eventCh    <-chan interface{}
+	batchDelay *BatchDelay
+	logger     logr.Logger
 
 	// The EventLoop uses double buffering to handle event batch processing.
 	// The goroutine that handles the batch will always read from the currentBatch slice.
@@ -39,14 +46,41 @@ type EventLoop struct {
 	currentBatchID int
 }
 
+// BatchDelay is the time the EventLoop waits for more events before it handles a batch.
+// It is safe to change the delay while the EventLoop is running.
+type BatchDelay struct {
+	delay atomic.Int64
+}
+
+// Set sets the delay.
+func (d *BatchDelay) Set(delay time.Duration) {
+	d.delay.Store(int64(delay))
+}
+
+// Get returns the delay.
+func (d *BatchDelay) Get() time.Duration {
+	return time.Duration(d.delay.Load())
+}
+
+// EventLoopOption is an option for the EventLoop.
+type EventLoopOption func(*EventLoop)
+
+// WithBatchDelay configures the EventLoop to wait for the delay before it handles a batch.
+func WithBatchDelay(delay *BatchDelay) EventLoopOption {
+	return func(el *EventLoop) {
+		el.batchDelay = delay
+	}
+}
+
 // NewEventLoop creates a new EventLoop.
 func NewEventLoop(
 	eventCh <-chan interface{},
 	logger logr.Logger,
 	handler EventHandler,
 	preparer FirstEventBatchPreparer,
+	options ...EventLoopOption,
 ) *EventLoop {
-	return &EventLoop{
+	el := &EventLoop{
 		eventCh:      eventCh,
 		logger:       logger,
 		handler:      handler,
@@ -54,6 +88,12 @@ func NewEventLoop(
 		currentBatch: make(EventBatch, 0),
 		nextBatch:    make(EventBatch, 0),
 	}
+
+	for _, opt := range options {
+		opt(el)
+	}
+
+	return el
 }
 
 // Start starts the EventLoop.
@@ -63,6 +103,8 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	var handling bool
 	// handlingDone is used to signal the completion of handling a batch.
 	handlingDone := make(chan struct{})
+	// delayDone is used to signal the end of the batch delay. It is nil if the EventLoop is not waiting.
+	var delayDone <-chan time.Time
 
 	handleBatch := func() {
 		go func(batch EventBatch) {
@@ -126,10 +168,21 @@ func (el *EventLoop) Start(ctx context.Context) error {
 				"total", len(el.nextBatch),
 			)
 
-			// If no batch is currently being handled, swap batches and begin handling the batch.
-			if !handling {
-				swapAndHandleBatch()
+			// If no batch is currently being handled, swap batches and begin handling the batch,
+			// unless we need to wait for more events first.
+			if handling || delayDone != nil {
+				continue
+			}
+
+			if delay := el.getBatchDelay(); delay > 0 {
+				delayDone = time.After(delay)
+				continue
 			}
+
+			swapAndHandleBatch()
+		case <-delayDone:
+			delayDone = nil
+			swapAndHandleBatch()
 		case <-handlingDone:
 			handling = false
 
@@ -141,6 +194,14 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	}
 }
 
+func (el *EventLoop) getBatchDelay() time.Duration {
+	if el.batchDelay == nil {
+		return 0
+	}
+
+	return el.batchDelay.Get()
+}
+
 // swapBatches swaps the current and next batches.
 func (el *EventLoop) swapBatches() {
 	el.currentBatch, el.nextBatch = el.nextBatch, el.currentBatch
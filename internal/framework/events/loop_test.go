@@ -120,6 +120,61 @@ var _ = Describe("EventLoop", func() {
 		})
 	})
 
+	Describe("Batch delay", func() {
+		var batchDelay *events.BatchDelay
+
+		BeforeEach(func() {
+			batchDelay = &events.BatchDelay{}
+			batchDelay.Set(200 * time.Millisecond)
+
+			eventLoop = events.NewEventLoop(eventCh, zap.New(), fakeHandler, fakePreparer, events.WithBatchDelay(batchDelay))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			DeferCleanup(func(dctx SpecContext) {
+				cancel()
+				var err error
+				Eventually(errorCh).WithContext(dctx).Should(Receive(&err))
+				Expect(err).ToNot(HaveOccurred())
+			}, NodeTimeout(time.Second*10))
+
+			fakePreparer.PrepareReturns(events.EventBatch{"event0"}, nil)
+
+			go func() {
+				errorCh <- eventLoop.Start(ctx)
+			}()
+
+			// Ensure the first batch is handled without the delay
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(1))
+		})
+
+		It("should batch the events that come during the delay", func() {
+			e1 := "event1"
+			e2 := "event2"
+
+			eventCh <- e1
+			eventCh <- e2
+
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(2))
+			_, _, batch := fakeHandler.HandleEventBatchArgsForCall(1)
+
+			var expectedBatch events.EventBatch = []interface{}{e1, e2}
+			Expect(batch).Should(Equal(expectedBatch))
+		})
+
+		It("should handle the events immediately after the delay is disabled", func() {
+			batchDelay.Set(0)
+
+			e1 := "event1"
+			eventCh <- e1
+
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(2))
+			_, _, batch := fakeHandler.HandleEventBatchArgsForCall(1)
+
+			var expectedBatch events.EventBatch = []interface{}{e1}
+			Expect(batch).Should(Equal(expectedBatch))
+		})
+	})
+
 	Describe("Edge cases", func() {
 		It("should return error when preparer returns error without blocking", func(ctx SpecContext) {
 			preparerError := errors.New("test")
@@ -0,0 +1,7 @@
+/*
+Package featuregates allows enabling and disabling features with feature gates.
+
+Features that are not ready for general use ship disabled by default, so that they can be enabled per cluster
+with a command-line flag in the format Feature1=true,Feature2=false.
+*/
+package featuregates
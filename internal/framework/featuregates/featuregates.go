@@ -0,0 +1,167 @@
+package featuregates
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Stage is the maturity stage of a feature.
+type Stage string
+
+const (
+	// Alpha features are experimental. They may change or be removed in any release.
+	Alpha Stage = "ALPHA"
+	// Beta features are well tested. They may change in incompatible ways in a future release.
+	Beta Stage = "BETA"
+)
+
+// Feature is the name of a feature.
+type Feature string
+
+// FeatureSpec specifies a feature.
+type FeatureSpec struct {
+	// Stage is the maturity stage of the feature.
+	Stage Stage
+	// Default indicates whether the feature is enabled by default.
+	Default bool
+}
+
+// FeatureGates holds whether the known features are enabled.
+// It implements the pflag.Value interface, so that the features can be set with a command-line flag
+// in the format Feature1=true,Feature2=false.
+type FeatureGates struct {
+	known map[Feature]FeatureSpec
+	// set holds the features that were explicitly enabled or disabled.
+	set map[Feature]bool
+}
+
+// New creates a new FeatureGates with the known features.
+func New(known map[Feature]FeatureSpec) *FeatureGates {
+	knownCopy := make(map[Feature]FeatureSpec, len(known))
+	for f, spec := range known {
+		knownCopy[f] = spec
+	}
+
+	return &FeatureGates{
+		known: knownCopy,
+		set:   make(map[Feature]bool),
+	}
+}
+
+// Enabled returns true if the feature is enabled. Unknown features are never enabled.
+func (g *FeatureGates) Enabled(f Feature) bool {
+	if enabled, ok := g.set[f]; ok {
+		return enabled
+	}
+
+	return g.known[f].Default
+}
+
+// SetDefault changes whether the known feature is enabled by default.
+// It doesn't affect the feature if it was explicitly enabled or disabled.
+func (g *FeatureGates) SetDefault(f Feature, enabled bool) error {
+	spec, ok := g.known[f]
+	if !ok {
+		return fmt.Errorf("unknown feature gate %q", f)
+	}
+
+	spec.Default = enabled
+	g.known[f] = spec
+
+	return nil
+}
+
+// SetEnabled explicitly enables or disables the known feature.
+func (g *FeatureGates) SetEnabled(f Feature, enabled bool) error {
+	if _, ok := g.known[f]; !ok {
+		return fmt.Errorf("unknown feature gate %q", f)
+	}
+
+	g.set[f] = enabled
+
+	return nil
+}
+
+// EnabledFeatures returns the sorted names of the enabled features.
+func (g *FeatureGates) EnabledFeatures() []string {
+	var enabled []string
+
+	for f := range g.known {
+		if g.Enabled(f) {
+			enabled = append(enabled, string(f))
+		}
+	}
+
+	sort.Strings(enabled)
+
+	return enabled
+}
+
+// KnownFeatures returns the sorted descriptions of the known features, for example, for the usage of a flag.
+func (g *FeatureGates) KnownFeatures() []string {
+	known := make([]string, 0, len(g.known))
+
+	for f, spec := range g.known {
+		known = append(known, fmt.Sprintf("%s=true|false (%s - default=%t)", f, spec.Stage, spec.Default))
+	}
+
+	sort.Strings(known)
+
+	return known
+}
+
+// String returns the explicitly set features in the format Feature1=true,Feature2=false.
+func (g *FeatureGates) String() string {
+	pairs := make([]string, 0, len(g.set))
+
+	for f, enabled := range g.set {
+		pairs = append(pairs, fmt.Sprintf("%s=%t", f, enabled))
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// Set parses the features in the format Feature1=true,Feature2=false and sets them.
+// The features are only set if all of them are valid.
+func (g *FeatureGates) Set(value string) error {
+	parsed := make(map[Feature]bool)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		name, val, found := strings.Cut(pair, "=")
+		if !found {
+			return fmt.Errorf("missing bool value for feature gate %q", name)
+		}
+
+		f := Feature(strings.TrimSpace(name))
+		if _, ok := g.known[f]; !ok {
+			return fmt.Errorf("unknown feature gate %q", f)
+		}
+
+		enabled, err := strconv.ParseBool(strings.TrimSpace(val))
+		if err != nil {
+			return fmt.Errorf("invalid value %q for feature gate %q: %w", val, f, err)
+		}
+
+		parsed[f] = enabled
+	}
+
+	for f, enabled := range parsed {
+		g.set[f] = enabled
+	}
+
+	return nil
+}
+
+// Type returns the type of the flag value.
+func (g *FeatureGates) Type() string {
+	return "mapStringBool"
+}
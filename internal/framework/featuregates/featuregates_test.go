@@ -0,0 +1,142 @@
+package featuregates
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	alphaFeature Feature = "AlphaFeature"
+	betaFeature  Feature = "BetaFeature"
+)
+
+func newTestFeatureGates() *FeatureGates {
+	return New(map[Feature]FeatureSpec{
+		alphaFeature: {Stage: Alpha, Default: false},
+		betaFeature:  {Stage: Beta, Default: true},
+	})
+}
+
+func TestFeatureGates_Set(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		value           string
+		expErr          string
+		expString       string
+		expEnabled      []string
+		expAlphaEnabled bool
+	}{
+		{
+			name:       "empty",
+			value:      "",
+			expEnabled: []string{"BetaFeature"},
+		},
+		{
+			name:            "enable alpha, disable beta",
+			value:           "AlphaFeature=true,BetaFeature=false",
+			expString:       "AlphaFeature=true,BetaFeature=false",
+			expEnabled:      []string{"AlphaFeature"},
+			expAlphaEnabled: true,
+		},
+		{
+			name:            "whitespace and trailing comma",
+			value:           " AlphaFeature = true ,",
+			expString:       "AlphaFeature=true",
+			expEnabled:      []string{"AlphaFeature", "BetaFeature"},
+			expAlphaEnabled: true,
+		},
+		{
+			name:       "unknown feature",
+			value:      "AlphaFeature=true,Unknown=true",
+			expErr:     `unknown feature gate "Unknown"`,
+			expEnabled: []string{"BetaFeature"},
+		},
+		{
+			name:       "missing value",
+			value:      "AlphaFeature",
+			expErr:     `missing bool value for feature gate "AlphaFeature"`,
+			expEnabled: []string{"BetaFeature"},
+		},
+		{
+			name:       "invalid value",
+			value:      "AlphaFeature=yes",
+			expErr:     `invalid value "yes" for feature gate "AlphaFeature"`,
+			expEnabled: []string{"BetaFeature"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gates := newTestFeatureGates()
+
+			err := gates.Set(test.value)
+			if test.expErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErr)))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			g.Expect(gates.String()).To(Equal(test.expString))
+			g.Expect(gates.EnabledFeatures()).To(Equal(test.expEnabled))
+			g.Expect(gates.Enabled(alphaFeature)).To(Equal(test.expAlphaEnabled))
+		})
+	}
+}
+
+func TestFeatureGates_SetDefault(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gates := newTestFeatureGates()
+
+	g.Expect(gates.SetDefault(alphaFeature, true)).To(Succeed())
+	g.Expect(gates.Enabled(alphaFeature)).To(BeTrue())
+
+	// explicitly set features take precedence over the default
+	g.Expect(gates.Set("BetaFeature=false")).To(Succeed())
+	g.Expect(gates.SetDefault(betaFeature, true)).To(Succeed())
+	g.Expect(gates.Enabled(betaFeature)).To(BeFalse())
+
+	g.Expect(gates.SetDefault("Unknown", true)).To(MatchError(`unknown feature gate "Unknown"`))
+	g.Expect(gates.Enabled("Unknown")).To(BeFalse())
+
+	// the known features passed to New are not modified
+	g.Expect(newTestFeatureGates().Enabled(alphaFeature)).To(BeFalse())
+}
+
+func TestFeatureGates_SetEnabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gates := newTestFeatureGates()
+
+	g.Expect(gates.SetEnabled(alphaFeature, true)).To(Succeed())
+	g.Expect(gates.SetEnabled(betaFeature, false)).To(Succeed())
+	g.Expect(gates.EnabledFeatures()).To(Equal([]string{"AlphaFeature"}))
+	g.Expect(gates.String()).To(Equal("AlphaFeature=true,BetaFeature=false"))
+
+	g.Expect(gates.SetEnabled("Unknown", true)).To(MatchError(`unknown feature gate "Unknown"`))
+}
+
+func TestFeatureGates_KnownFeatures(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(newTestFeatureGates().KnownFeatures()).To(Equal([]string{
+		"AlphaFeature=true|false (ALPHA - default=false)",
+		"BetaFeature=true|false (BETA - default=true)",
+	}))
+}
+
+func TestFeatureGates_Type(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(newTestFeatureGates().Type()).To(Equal("mapStringBool"))
+}
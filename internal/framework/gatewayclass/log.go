@@ -0,0 +1,69 @@
+package gatewayclass
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+)
+
+// CRDVersionsLogger logs guidance about the installed Gateway API CRD versions when the result of their validation
+// changes, so that a version skew is reported once instead of on every event batch.
+//
+// CRDVersionsLogger is not thread-safe.
+type CRDVersionsLogger struct {
+	lastMessage string
+}
+
+// Log logs the result of the validation of the Gateway API CRD versions if it changed since the previous call.
+// The conds are the Conditions of the GatewayClass, which include the Conditions returned by ValidateCRDVersions.
+func (l *CRDVersionsLogger) Log(logger logr.Logger, conds []conditions.Condition) {
+	var msg string
+	supported := true
+
+	for _, cond := range conds {
+		if cond.Reason != string(v1.GatewayClassReasonUnsupportedVersion) {
+			continue
+		}
+
+		msg = cond.Message
+		if cond.Type == string(v1.GatewayClassConditionStatusAccepted) {
+			supported = false
+		}
+	}
+
+	if msg == l.lastMessage {
+		return
+	}
+
+	l.lastMessage = msg
+
+	installURL := fmt.Sprintf(
+		"https://github.com/kubernetes-sigs/gateway-api/releases/download/%s/standard-install.yaml",
+		SupportedVersion,
+	)
+
+	switch {
+	case msg == "":
+		logger.Info("Gateway API CRD versions are supported", "supportedVersion", SupportedVersion)
+	case !supported:
+		logger.Error(
+			errors.New(msg),
+			"Gateway API CRD versions are not supported, so NGINX configuration will not be generated. "+
+				"Install the Gateway API CRDs of the supported version from the standard or experimental channel",
+			"supportedVersion", SupportedVersion,
+			"installURL", installURL,
+		)
+	default:
+		logger.Info(
+			"Gateway API CRD versions are not recommended, so NGINX configuration is generated on a best-effort basis. "+
+				"Install the Gateway API CRDs of the supported version from the standard or experimental channel",
+			"reason", msg,
+			"supportedVersion", SupportedVersion,
+			"installURL", installURL,
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package gatewayclass_test
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
+)
+
+func TestCRDVersionsLogger(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	var logs []string
+	logger := funcr.New(func(_, args string) { logs = append(logs, args) }, funcr.Options{})
+
+	bestEffort := conditions.NewGatewayClassSupportedVersionBestEffort(
+		gatewayclass.SupportedVersion,
+		[]string{"v1.99.0"},
+	)
+	unsupported := conditions.NewGatewayClassUnsupportedVersion(gatewayclass.SupportedVersion, []string{"v99.0.0"})
+
+	steps := []struct {
+		expLog string
+		conds  []conditions.Condition
+	}{
+		{
+			conds: nil,
+		},
+		{
+			conds:  unsupported,
+			expLog: "Gateway API CRD versions are not supported",
+		},
+		{
+			conds: unsupported,
+		},
+		{
+			conds:  bestEffort,
+			expLog: "Gateway API CRD versions are not recommended",
+		},
+		{
+			conds:  conditions.NewDefaultGatewayClassConditions(),
+			expLog: "Gateway API CRD versions are supported",
+		},
+		{
+			conds: conditions.NewDefaultGatewayClassConditions(),
+		},
+	}
+
+	var crdVersionsLogger gatewayclass.CRDVersionsLogger
+
+	for _, step := range steps {
+		logs = nil
+
+		crdVersionsLogger.Log(logger, step.conds)
+
+		if step.expLog == "" {
+			g.Expect(logs).To(BeEmpty())
+		} else {
+			g.Expect(logs).To(HaveLen(1))
+			g.Expect(logs[0]).To(ContainSubstring(step.expLog))
+			g.Expect(logs[0]).To(ContainSubstring(gatewayclass.SupportedVersion))
+		}
+	}
+}
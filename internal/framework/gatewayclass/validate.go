@@ -1,6 +1,7 @@
 package gatewayclass
 
 import (
+	"sort"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,11 +27,17 @@ var gatewayCRDs = map[string]apiVersion{
 	"tlsroutes.gateway.networking.k8s.io":          {},
 }
 
+// unknownVersion is reported for the Gateway API CRDs without the bundle version annotation.
+const unknownVersion = "unknown"
+
 type apiVersion struct {
 	major string
 	minor string
 }
 
+// ValidateCRDVersions validates the bundle versions of the installed Gateway API CRDs against the supported version.
+// It returns the SupportedVersion Conditions for the GatewayClass, and false if the versions are not supported,
+// in which case NGF must not generate configuration.
 func ValidateCRDVersions(
 	crdMetadata map[types.NamespacedName]*metav1.PartialObjectMetadata,
 ) (conds []conditions.Condition, valid bool) {
@@ -48,11 +55,14 @@ func ValidateCRDVersions(
 	}
 
 	if unsupported {
-		return conditions.NewGatewayClassUnsupportedVersion(SupportedVersion), false
+		return conditions.NewGatewayClassUnsupportedVersion(SupportedVersion, getInstalledVersions(crdMetadata)), false
 	}
 
 	if bestEffort {
-		return conditions.NewGatewayClassSupportedVersionBestEffort(SupportedVersion), true
+		return conditions.NewGatewayClassSupportedVersionBestEffort(
+			SupportedVersion,
+			getInstalledVersions(crdMetadata),
+		), true
 	}
 
 	return nil, true
@@ -84,3 +94,28 @@ func getBundleVersions(crdMetadata map[types.NamespacedName]*metav1.PartialObjec
 
 	return versions
 }
+
+// getInstalledVersions returns the sorted unique bundle versions of the installed Gateway API CRDs.
+func getInstalledVersions(crdMetadata map[types.NamespacedName]*metav1.PartialObjectMetadata) []string {
+	unique := make(map[string]struct{})
+
+	for nsname, md := range crdMetadata {
+		if _, ok := gatewayCRDs[nsname.Name]; ok {
+			version := md.Annotations[BundleVersionAnnotation]
+			if version == "" {
+				version = unknownVersion
+			}
+
+			unique[version] = struct{}{}
+		}
+	}
+
+	versions := make([]string, 0, len(unique))
+	for version := range unique {
+		versions = append(versions, version)
+	}
+
+	sort.Strings(versions)
+
+	return versions
+}
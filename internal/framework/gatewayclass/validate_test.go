@@ -28,7 +28,9 @@ func TestValidateCRDVersions(t *testing.T) {
 	fields := strings.Split(gatewayclass.SupportedVersion, ".")
 	fields[2] = "99"
 
-	validVersionWithPatch := createCRDMetadata(strings.Join(fields, "."))
+	validVersion := strings.Join(fields, ".")
+
+	validVersionWithPatch := createCRDMetadata(validVersion)
 	bestEffortVersion := createCRDMetadata("v1.99.99")
 	unsupportedVersion := createCRDMetadata("v99.0.0")
 
@@ -67,8 +69,11 @@ func TestValidateCRDVersions(t *testing.T) {
 				{Name: "httproutes.gateway.networking.k8s.io"}:      bestEffortVersion,
 				{Name: "referencegrants.gateway.networking.k8s.io"}: bestEffortVersion,
 			},
-			valid:    true,
-			expConds: conditions.NewGatewayClassSupportedVersionBestEffort(gatewayclass.SupportedVersion),
+			valid: true,
+			expConds: conditions.NewGatewayClassSupportedVersionBestEffort(
+				gatewayclass.SupportedVersion,
+				[]string{"v1.99.99"},
+			),
 		},
 		{
 			name: "valid; mix of supported and best effort versions",
@@ -78,8 +83,11 @@ func TestValidateCRDVersions(t *testing.T) {
 				{Name: "httproutes.gateway.networking.k8s.io"}:      validVersionWithPatch,
 				{Name: "referencegrants.gateway.networking.k8s.io"}: validVersionWithPatch,
 			},
-			valid:    true,
-			expConds: conditions.NewGatewayClassSupportedVersionBestEffort(gatewayclass.SupportedVersion),
+			valid: true,
+			expConds: conditions.NewGatewayClassSupportedVersionBestEffort(
+				gatewayclass.SupportedVersion,
+				[]string{validVersion, "v1.99.99"},
+			),
 		},
 		{
 			name: "invalid; all unsupported versions",
@@ -89,8 +97,11 @@ func TestValidateCRDVersions(t *testing.T) {
 				{Name: "httproutes.gateway.networking.k8s.io"}:      unsupportedVersion,
 				{Name: "referencegrants.gateway.networking.k8s.io"}: unsupportedVersion,
 			},
-			valid:    false,
-			expConds: conditions.NewGatewayClassUnsupportedVersion(gatewayclass.SupportedVersion),
+			valid: false,
+			expConds: conditions.NewGatewayClassUnsupportedVersion(
+				gatewayclass.SupportedVersion,
+				[]string{"v99.0.0"},
+			),
 		},
 		{
 			name: "invalid; mix unsupported and best effort versions",
@@ -100,16 +111,33 @@ func TestValidateCRDVersions(t *testing.T) {
 				{Name: "httproutes.gateway.networking.k8s.io"}:      unsupportedVersion,
 				{Name: "referencegrants.gateway.networking.k8s.io"}: bestEffortVersion,
 			},
-			valid:    false,
-			expConds: conditions.NewGatewayClassUnsupportedVersion(gatewayclass.SupportedVersion),
+			valid: false,
+			expConds: conditions.NewGatewayClassUnsupportedVersion(
+				gatewayclass.SupportedVersion,
+				[]string{"v1.99.99", "v99.0.0"},
+			),
 		},
 		{
 			name: "invalid; bad version string",
 			crds: map[types.NamespacedName]*metav1.PartialObjectMetadata{
 				{Name: "gatewayclasses.gateway.networking.k8s.io"}: createCRDMetadata("v"),
 			},
-			valid:    false,
-			expConds: conditions.NewGatewayClassUnsupportedVersion(gatewayclass.SupportedVersion),
+			valid: false,
+			expConds: conditions.NewGatewayClassUnsupportedVersion(
+				gatewayclass.SupportedVersion,
+				[]string{"v"},
+			),
+		},
+		{
+			name: "invalid; missing version annotation",
+			crds: map[types.NamespacedName]*metav1.PartialObjectMetadata{
+				{Name: "gatewayclasses.gateway.networking.k8s.io"}: {},
+			},
+			valid: false,
+			expConds: conditions.NewGatewayClassUnsupportedVersion(
+				gatewayclass.SupportedVersion,
+				[]string{"unknown"},
+			),
 		},
 	}
 
@@ -3,12 +3,16 @@ package helpers
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"text/template"
 
 	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // Diff prints the diff between two structs.
@@ -45,6 +49,76 @@ func PrepareTimeForFakeClient(t metav1.Time) metav1.Time {
 	return t
 }
 
+// ApplyAsUpdateInterceptorFuncs returns the interceptor functions for a fake client
+// from sigs.k8s.io/controller-runtime/pkg/client/fake, which handle server-side apply patches as creates or updates
+// of the whole object, because the fake client doesn't support server-side apply.
+// It makes it possible to use the fake client in tests of the code that uses server-side apply.
+func ApplyAsUpdateInterceptorFuncs() interceptor.Funcs {
+	return interceptor.Funcs{
+		Patch: func(
+			ctx context.Context,
+			c client.WithWatch,
+			obj client.Object,
+			patch client.Patch,
+			opts ...client.PatchOption,
+		) error {
+			if patch.Type() != types.ApplyPatchType {
+				return c.Patch(ctx, obj, patch, opts...)
+			}
+
+			if err := setLatestResourceVersion(ctx, c, obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					return c.Create(ctx, obj)
+				}
+
+				return err
+			}
+
+			return c.Update(ctx, obj)
+		},
+		SubResourcePatch: func(
+			ctx context.Context,
+			c client.Client,
+			subResourceName string,
+			obj client.Object,
+			patch client.Patch,
+			opts ...client.SubResourcePatchOption,
+		) error {
+			if patch.Type() != types.ApplyPatchType {
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			}
+
+			if err := setLatestResourceVersion(ctx, c, obj); err != nil {
+				return err
+			}
+
+			return c.SubResource(subResourceName).Update(ctx, obj)
+		},
+	}
+}
+
+// setLatestResourceVersion sets the latest resource version for an applied object without a resource version.
+// If the object has a resource version, the update fails with a conflict when the version is not the latest, like
+// the apply does.
+func setLatestResourceVersion(ctx context.Context, c client.Reader, obj client.Object) error {
+	if obj.GetResourceVersion() != "" {
+		return nil
+	}
+
+	latest, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		panic(fmt.Errorf("unexpected object type %T", obj))
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+		return err
+	}
+
+	obj.SetResourceVersion(latest.GetResourceVersion())
+
+	return nil
+}
+
 // MustCastObject casts the client.Object to the specified type that implements it.
 func MustCastObject[T client.Object](object client.Object) T {
 	if obj, ok := object.(T); ok {
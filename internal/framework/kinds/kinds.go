@@ -21,6 +21,16 @@ const (
 	GRPCRoute = "GRPCRoute"
 	// TLSRoute is the TLSRoute kind.
 	TLSRoute = "TLSRoute"
+	// BackendTLSPolicy is the BackendTLSPolicy kind.
+	BackendTLSPolicy = "BackendTLSPolicy"
+)
+
+// Core Kubernetes kinds.
+const (
+	// Service is the Service kind.
+	Service = "Service"
+	// Secret is the Secret kind.
+	Secret = "Secret"
 )
 
 // NGINX Gateway Fabric kinds.
@@ -29,8 +39,45 @@ const (
 	ClientSettingsPolicy = "ClientSettingsPolicy"
 	// ObservabilityPolicy is the ObservabilityPolicy kind.
 	ObservabilityPolicy = "ObservabilityPolicy"
+	// ProxySettingsPolicy is the ProxySettingsPolicy kind.
+	ProxySettingsPolicy = "ProxySettingsPolicy"
+	// FaultInjectionPolicy is the FaultInjectionPolicy kind.
+	FaultInjectionPolicy = "FaultInjectionPolicy"
+	// UpstreamSettingsPolicy is the UpstreamSettingsPolicy kind.
+	UpstreamSettingsPolicy = "UpstreamSettingsPolicy"
+	// WAFPolicy is the WAFPolicy kind.
+	WAFPolicy = "WAFPolicy"
+	// ModSecurityPolicy is the ModSecurityPolicy kind.
+	ModSecurityPolicy = "ModSecurityPolicy"
+	// BotMitigationPolicy is the BotMitigationPolicy kind.
+	BotMitigationPolicy = "BotMitigationPolicy"
+	// GeoIPPolicy is the GeoIPPolicy kind.
+	GeoIPPolicy = "GeoIPPolicy"
+	// RequestHeadersPolicy is the RequestHeadersPolicy kind.
+	RequestHeadersPolicy = "RequestHeadersPolicy"
+	// SecureLinkPolicy is the SecureLinkPolicy kind.
+	SecureLinkPolicy = "SecureLinkPolicy"
+	// StaticContentPolicy is the StaticContentPolicy kind.
+	StaticContentPolicy = "StaticContentPolicy"
+	// DefaultCertificatePolicy is the DefaultCertificatePolicy kind.
+	DefaultCertificatePolicy = "DefaultCertificatePolicy"
 	// NginxProxy is the NginxProxy kind.
 	NginxProxy = "NginxProxy"
+	// HostnameReport is the HostnameReport kind.
+	HostnameReport = "HostnameReport"
+	// SnippetsFilter is the SnippetsFilter kind.
+	SnippetsFilter = "SnippetsFilter"
+	// RateLimitFilter is the RateLimitFilter kind.
+	RateLimitFilter = "RateLimitFilter"
+	// ResponseHeaderFilter is the ResponseHeaderFilter kind.
+	ResponseHeaderFilter = "ResponseHeaderFilter"
+	// QueryParameterFilter is the QueryParameterFilter kind.
+	QueryParameterFilter = "QueryParameterFilter"
+	// ABTestFilter is the ABTestFilter kind.
+	ABTestFilter = "ABTestFilter"
+	// ExternalCertificate is the kind of the certificateRefs of the Listeners that reference the certificates
+	// of the certificate source of NGINX Gateway Fabric. There is no ExternalCertificate resource.
+	ExternalCertificate = "ExternalCertificate"
 )
 
 // MustExtractGVK is a function that extracts the GroupVersionKind (GVK) of a client.object.
@@ -0,0 +1,7 @@
+/*
+Package profiling provides a server for diagnosing the runtime of the process.
+
+The server exposes pprof profiles (including goroutine dumps) and expvar variables. It only listens on localhost,
+so it can be reached only from within the Pod, for example, with kubectl port-forward.
+*/
+package profiling
@@ -0,0 +1,101 @@
+package profiling
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof" //nolint:gosec // the handlers are only served on localhost when profiling is enabled
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+// Server is a Runnable that serves pprof and expvar on localhost.
+// It runs regardless of whether the current instance is the leader.
+type Server struct {
+	logger logr.Logger
+	addr   string
+}
+
+var (
+	_ manager.LeaderElectionRunnable = &Server{}
+	_ manager.Runnable               = &Server{}
+)
+
+// NewServer creates a new Server that listens on the port of localhost.
+func NewServer(port int, logger logr.Logger) *Server {
+	return &Server{
+		addr:   net.JoinHostPort("127.0.0.1", strconv.Itoa(port)),
+		logger: logger,
+	}
+}
+
+// Start starts the Server and blocks until the context is canceled.
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("cannot listen on %s: %w", s.addr, err)
+	}
+
+	return s.serve(ctx, listener)
+}
+
+func (s *Server) serve(ctx context.Context, listener net.Listener) error {
+	srv := &http.Server{
+		Handler:           newHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.Info("Serving profiling endpoints", "address", listener.Addr().String())
+		errCh <- srv.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("profiling server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("cannot shut down profiling server: %w", err)
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("profiling server failed: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// newHandler returns the handler of the profiling endpoints:
+//   - /debug/pprof/ lists the pprof profiles. For example, /debug/pprof/profile?seconds=30 returns a CPU profile and
+//     /debug/pprof/goroutine?debug=2 returns the stack traces of all goroutines.
+//   - /debug/vars returns the expvar variables, including the memory statistics of the runtime.
+func newHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	return mux
+}
@@ -0,0 +1,91 @@
+package profiling
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	s := NewServer(0, logr.Discard())
+	g.Expect(s.NeedLeaderElection()).To(BeFalse())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.serve(ctx, listener)
+	}()
+
+	baseURL := "http://" + listener.Addr().String()
+
+	tests := []struct {
+		path               string
+		expContentContains string
+	}{
+		{
+			path:               "/debug/pprof/",
+			expContentContains: "goroutine",
+		},
+		{
+			path:               "/debug/pprof/goroutine?debug=2",
+			expContentContains: "goroutine",
+		},
+		{
+			path:               "/debug/pprof/cmdline",
+			expContentContains: "profiling.test",
+		},
+		{
+			path:               "/debug/vars",
+			expContentContains: "memstats",
+		},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+test.path, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		resp, err := http.DefaultClient.Do(req)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		body, err := io.ReadAll(resp.Body)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resp.Body.Close()).To(Succeed())
+
+		g.Expect(resp.StatusCode).To(Equal(http.StatusOK), test.path)
+		g.Expect(string(body)).To(ContainSubstring(test.expContentContains), test.path)
+	}
+
+	cancel()
+	g.Eventually(errCh).Should(Receive(BeNil()))
+}
+
+func TestServer_ListenError(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, portStr, err := net.SplitHostPort(listener.Addr().String())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	port, err := strconv.Atoi(portStr)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = NewServer(port, logr.Discard()).Start(context.Background())
+	g.Expect(err).To(MatchError(ContainSubstring("cannot listen on")))
+
+	g.Expect(listener.Close()).To(Succeed())
+}
@@ -65,8 +65,14 @@ func (u *LeaderAwareGroupUpdater) Enable(ctx context.Context) {
 
 	u.enabled = true
 
-	for name, reqs := range u.groupReqs {
-		u.updater.Update(ctx, reqs...)
+	// The saved requests of all groups are updated together,
+	// so that the statuses of the resources that belong to several groups are written once.
+	var reqs []UpdateRequest
+
+	for name, groupReqs := range u.groupReqs {
+		reqs = append(reqs, groupReqs...)
 		delete(u.groupReqs, name)
 	}
+
+	u.updater.Update(ctx, reqs...)
 }
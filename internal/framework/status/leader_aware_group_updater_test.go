@@ -11,6 +11,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 )
 
 // We only use one resource type in this test - GatewayClass.
@@ -29,6 +31,7 @@ var _ = Describe("LeaderAwareGroupUpdater", func() {
 			WithStatusSubresource(
 				&v1.GatewayClass{},
 			).
+			WithInterceptorFuncs(helpers.ApplyAsUpdateInterceptorFuncs()).
 			Build()
 	})
 
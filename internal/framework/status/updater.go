@@ -10,7 +10,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/flowcontrol"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller"
 	ngftypes "github.com/nginxinc/nginx-gateway-fabric/internal/framework/types"
@@ -50,23 +52,50 @@ type Setter func(client.Object) (wasSet bool)
 // result of processing some other new change to a resource(s).
 // FIXME(pleshakov): https://github.com/nginxinc/nginx-gateway-fabric/issues/1813
 type Updater struct {
-	client client.Client
-	logger logr.Logger
+	client      client.Client
+	rateLimiter flowcontrol.RateLimiter
+	logger      logr.Logger
 }
 
+const (
+	// defaultWriteQPS and defaultWriteBurst limit the rate of the status writes, so that a large number of
+	// status updates doesn't use up the client-side rate limit of the k8s client, which is shared with the other
+	// API calls, and doesn't get the Updater throttled by the API server.
+	defaultWriteQPS   = 10
+	defaultWriteBurst = 50
+)
+
 var ErrFailedAssert = errors.New("type assertion failed")
 
+// UpdaterOption is an option of the Updater.
+type UpdaterOption func(*Updater)
+
+// WithRateLimiter sets the rate limiter of the status writes.
+func WithRateLimiter(rateLimiter flowcontrol.RateLimiter) UpdaterOption {
+	return func(u *Updater) {
+		u.rateLimiter = rateLimiter
+	}
+}
+
 // NewUpdater creates a new Updater.
-func NewUpdater(c client.Client, logger logr.Logger) *Updater {
-	return &Updater{
-		client: c,
-		logger: logger,
+func NewUpdater(c client.Client, logger logr.Logger, options ...UpdaterOption) *Updater {
+	u := &Updater{
+		client:      c,
+		logger:      logger,
+		rateLimiter: flowcontrol.NewTokenBucketRateLimiter(defaultWriteQPS, defaultWriteBurst),
 	}
+
+	for _, opt := range options {
+		opt(u)
+	}
+
+	return u
 }
 
 // Update updates the status of the resources from the requests.
+// The requests for the same resource are combined, so that the status of a resource is written at most once.
 func (u *Updater) Update(ctx context.Context, reqs ...UpdateRequest) {
-	for _, r := range reqs {
+	for _, r := range combineRequests(reqs) {
 		select {
 		case <-ctx.Done():
 			return
@@ -106,7 +135,14 @@ func (u *Updater) writeStatuses(
 			Cap:      time.Millisecond * 3000,
 		},
 		// Function returns true if the condition is satisfied, or an error if the loop should be aborted.
-		NewRetryUpdateFunc(u.client, u.client.Status(), nsname, obj, u.logger, statusSetter),
+		NewRetryUpdateFunc(
+			u.client,
+			&rateLimitedUpdater{updater: &statusApplier{client: u.client}, rateLimiter: u.rateLimiter},
+			nsname,
+			obj,
+			u.logger,
+			statusSetter,
+		),
 	)
 	if err != nil && !errors.Is(err, context.Canceled) {
 		u.logger.Error(
@@ -118,13 +154,101 @@ func (u *Updater) writeStatuses(
 	}
 }
 
+// combineRequests combines the requests for the same resource into a single request, which runs the Setters of
+// the requests in their order. The combined requests keep the order of the first request for every resource.
+func combineRequests(reqs []UpdateRequest) []UpdateRequest {
+	type resourceKey struct {
+		resourceType string
+		nsname       types.NamespacedName
+	}
+
+	combined := make([]UpdateRequest, 0, len(reqs))
+	indexes := make(map[resourceKey]int, len(reqs))
+
+	for _, r := range reqs {
+		key := resourceKey{resourceType: fmt.Sprintf("%T", r.ResourceType), nsname: r.NsName}
+
+		idx, exists := indexes[key]
+		if !exists {
+			indexes[key] = len(combined)
+			combined = append(combined, r)
+
+			continue
+		}
+
+		first, second := combined[idx].Setter, r.Setter
+		combined[idx].Setter = func(obj client.Object) bool {
+			firstSet := first(obj)
+			secondSet := second(obj)
+
+			return firstSet || secondSet
+		}
+	}
+
+	return combined
+}
+
+// statusApplier is a K8sUpdater that writes the status of a resource with server-side apply, using the NGF field
+// manager, so that the API server tracks the status fields that NGF owns separately from the fields of other
+// controllers.
+//
+// The lists of the statuses, such as the parents of a Route and the ancestors of a Policy, are atomic, so the applied
+// object replaces the entries of the other controllers too. The Setters keep those entries, but only those of the
+// version of the resource that we got, which can be stale. That's why the applied object keeps its resource version:
+// the write fails with a conflict if the resource changed after we got it, and the retry gets the resource again.
+type statusApplier struct {
+	client client.Client
+}
+
+func (a *statusApplier) Update(ctx context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+	// The objects from the cache don't have their kind set, which server-side apply requires.
+	gvk, err := apiutil.GVKForObject(obj, a.client.Scheme())
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	// An applied object must not include the managed fields.
+	obj.SetManagedFields(nil)
+
+	return a.client.Status().Patch(
+		ctx,
+		obj,
+		client.Apply,
+		client.FieldOwner(controller.FieldManager),
+		client.ForceOwnership,
+	)
+}
+
+// rateLimitedUpdater is a K8sUpdater that waits for the rate limiter before every update.
+type rateLimitedUpdater struct {
+	updater     K8sUpdater
+	rateLimiter flowcontrol.RateLimiter
+}
+
+func (u *rateLimitedUpdater) Update(
+	ctx context.Context,
+	obj client.Object,
+	opts ...client.SubResourceUpdateOption,
+) error {
+	if err := u.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	return u.updater.Update(ctx, obj, opts...)
+}
+
 // NewRetryUpdateFunc returns a function which will be used in wait.ExponentialBackoffWithContext.
 // The function will attempt to Update a kubernetes resource and will be retried in
 // wait.ExponentialBackoffWithContext if an error occurs. Exported for testing purposes.
 //
 // wait.ExponentialBackoffWithContext will retry if this function returns nil as its error,
-// which is what we want if we encounter an error from the functions we call. However,
-// the linter will complain if we return nil if an error was found.
+// which is what we want if we encounter a retryable error from the functions we call, such as a conflict, which
+// means that the resource was changed after we got it. However, the linter will complain if we return nil if
+// an error was found.
+// If the update fails with an error that a retry can't fix, for example, because the status is invalid,
+// the function returns the error to abort the retries. If the API server asks to retry after a delay,
+// the function waits for the delay before the next retry.
 //
 // Note: this function is public because fake dependencies require us to test this function from the test package
 // to avoid import cycles.
@@ -172,17 +296,43 @@ func NewRetryUpdateFunc(
 		}
 
 		if err := updater.Update(ctx, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+
+			if isPermanentUpdateError(err) {
+				return false, err
+			}
+
 			logger.V(1).Info(
 				"Encountered error updating status",
 				"error", err,
+				"conflict", apierrors.IsConflict(err),
 				"namespace", nsname.Namespace,
 				"name", nsname.Name,
 				"kind", obj.GetObjectKind().GroupVersionKind().Kind,
 			)
 
+			if delay, ok := apierrors.SuggestsClientDelay(err); ok {
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Duration(delay) * time.Second):
+				}
+			}
+
 			return false, nil
 		}
 
 		return true, nil
 	}
 }
+
+// isPermanentUpdateError returns true if the update failed because of the request itself,
+// so retrying the same update will fail again.
+func isPermanentUpdateError(err error) bool {
+	return apierrors.IsInvalid(err) ||
+		apierrors.IsBadRequest(err) ||
+		apierrors.IsForbidden(err) ||
+		apierrors.IsUnauthorized(err) ||
+		apierrors.IsMethodNotSupported(err)
+}
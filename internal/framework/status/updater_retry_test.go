@@ -27,6 +27,7 @@ func TestNewRetryUpdateFunc(t *testing.T) {
 		expUpdateCallCount  int
 		statusSetterReturns bool
 		expConditionPassed  bool
+		expErr              bool
 	}{
 		{
 			getReturns:          errors.New("failed to get resource"),
@@ -52,6 +53,39 @@ func TestNewRetryUpdateFunc(t *testing.T) {
 			name:                "update fails",
 			expConditionPassed:  false,
 		},
+		{
+			getReturns:          nil,
+			updateReturns:       apierrors.NewConflict(schema.GroupResource{}, "conflict", errors.New("changed")),
+			statusSetterReturns: true,
+			expUpdateCallCount:  1,
+			name:                "update fails with conflict",
+			expConditionPassed:  false,
+		},
+		{
+			getReturns:          nil,
+			updateReturns:       apierrors.NewTooManyRequests("too many requests", 0),
+			statusSetterReturns: true,
+			expUpdateCallCount:  1,
+			name:                "update is throttled",
+			expConditionPassed:  false,
+		},
+		{
+			getReturns:          nil,
+			updateReturns:       apierrors.NewNotFound(schema.GroupResource{}, "not found"),
+			statusSetterReturns: true,
+			expUpdateCallCount:  1,
+			name:                "update fails and apierrors is not found",
+			expConditionPassed:  true,
+		},
+		{
+			getReturns:          nil,
+			updateReturns:       apierrors.NewInvalid(schema.GroupKind{}, "invalid", nil),
+			statusSetterReturns: true,
+			expUpdateCallCount:  1,
+			name:                "update fails and apierrors is invalid",
+			expConditionPassed:  false,
+			expErr:              true,
+		},
 		{
 			getReturns:          nil,
 			updateReturns:       nil,
@@ -92,8 +126,12 @@ func TestNewRetryUpdateFunc(t *testing.T) {
 
 			conditionPassed, err := f(context.Background())
 
-			// The function should always return nil.
-			g.Expect(err).ToNot(HaveOccurred())
+			// The function only returns an error when retrying can't fix the update.
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
 			g.Expect(conditionPassed).To(Equal(test.expConditionPassed))
 			g.Expect(fakeStatusUpdater.UpdateCallCount()).To(Equal(test.expUpdateCallCount))
 		})
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
 )
@@ -105,6 +106,7 @@ var _ = Describe("Updater", func() {
 			WithStatusSubresource(
 				&v1.GatewayClass{},
 			).
+			WithInterceptorFuncs(helpers.ApplyAsUpdateInterceptorFuncs()).
 			Build()
 	})
 
@@ -202,4 +204,197 @@ var _ = Describe("Updater", func() {
 			})
 		})
 	})
+
+	Describe("Combining status updates", func() {
+		var (
+			updater     *Updater
+			statusCalls int
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(v1.Install(scheme)).Should(Succeed())
+
+			statusCalls = 0
+
+			interceptorFuncs := helpers.ApplyAsUpdateInterceptorFuncs()
+			applyStatus := interceptorFuncs.SubResourcePatch
+			interceptorFuncs.SubResourcePatch = func(
+				ctx context.Context,
+				c client.Client,
+				subResourceName string,
+				obj client.Object,
+				patch client.Patch,
+				opts ...client.SubResourcePatchOption,
+			) error {
+				statusCalls++
+
+				patchOptions := &client.SubResourcePatchOptions{}
+				patchOptions.ApplyOptions(opts)
+
+				Expect(patch.Type()).To(Equal(types.ApplyPatchType))
+				Expect(patchOptions.FieldManager).To(Equal(controller.FieldManager))
+				Expect(patchOptions.Force).To(Equal(helpers.GetPointer(true)))
+
+				return applyStatus(ctx, c, subResourceName, obj, patch, opts...)
+			}
+
+			k8sClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&v1.GatewayClass{}).
+				WithInterceptorFuncs(interceptorFuncs).
+				Build()
+
+			updater = NewUpdater(k8sClient, zap.New())
+
+			Expect(k8sClient.Create(context.Background(), createGC("combined"))).Should(Succeed())
+		})
+
+		It("should write the status of a GatewayClass once for all its requests", func() {
+			appendCondition := UpdateRequest{
+				NsName:       types.NamespacedName{Name: "combined"},
+				ResourceType: &v1.GatewayClass{},
+				Setter: func(obj client.Object) bool {
+					gc, ok := obj.(*v1.GatewayClass)
+					Expect(ok).To(BeTrue(), "obj is not a *v1.GatewayClass")
+					gc.Status.Conditions = append(gc.Status.Conditions, createConditions("TestAppended")...)
+					return true
+				},
+			}
+
+			updater.Update(
+				context.Background(),
+				prepareReq("combined", "TestCombined", updateNeeded),
+				appendCondition,
+				prepareReq("combined", "TestCombined", updateNotNeeded),
+			)
+
+			var gc v1.GatewayClass
+			Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: "combined"}, &gc)).To(Succeed())
+			Expect(gc.Status.Conditions).To(Equal(
+				append(createConditions("TestCombined"), createConditions("TestAppended")...),
+			))
+			Expect(statusCalls).To(Equal(1))
+		})
+
+		It("should not write the status of a GatewayClass if none of its requests sets it", func() {
+			updater.Update(
+				context.Background(),
+				prepareReq("combined", "TestCombined", updateNotNeeded),
+				prepareReq("combined", "TestCombined", updateNotNeeded),
+			)
+
+			Expect(statusCalls).To(BeZero())
+		})
+	})
+
+	Describe("Writing the status of a resource with the statuses of other controllers", func() {
+		const (
+			ngfControllerName   = "gateway.nginx.org/nginx-gateway-controller"
+			otherControllerName = "example.com/other-controller"
+		)
+
+		var (
+			updater     *Updater
+			statusCalls int
+			routeNsName = types.NamespacedName{Namespace: "test", Name: "route"}
+		)
+
+		createParentStatus := func(controllerName string) v1.RouteParentStatus {
+			return v1.RouteParentStatus{
+				ParentRef:      v1.ParentReference{Name: v1.ObjectName(controllerName)},
+				ControllerName: v1.GatewayController(controllerName),
+				Conditions:     createConditions("Accepted"),
+			}
+		}
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(v1.Install(scheme)).Should(Succeed())
+
+			statusCalls = 0
+
+			route := &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: routeNsName.Namespace, Name: routeNsName.Name},
+			}
+
+			var staleRoute *v1.HTTPRoute
+
+			interceptorFuncs := helpers.ApplyAsUpdateInterceptorFuncs()
+			applyStatus := interceptorFuncs.SubResourcePatch
+			interceptorFuncs.SubResourcePatch = func(
+				ctx context.Context,
+				c client.Client,
+				subResourceName string,
+				obj client.Object,
+				patch client.Patch,
+				opts ...client.SubResourcePatchOption,
+			) error {
+				statusCalls++
+				return applyStatus(ctx, c, subResourceName, obj, patch, opts...)
+			}
+			// the first read returns the route without the status of the other controller, like a stale cache
+			interceptorFuncs.Get = func(
+				ctx context.Context,
+				c client.WithWatch,
+				key client.ObjectKey,
+				obj client.Object,
+				opts ...client.GetOption,
+			) error {
+				if r, ok := obj.(*v1.HTTPRoute); ok && staleRoute != nil {
+					staleRoute.DeepCopyInto(r)
+					staleRoute = nil
+
+					return nil
+				}
+
+				return c.Get(ctx, key, obj, opts...)
+			}
+
+			k8sClient = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&v1.HTTPRoute{}).
+				WithInterceptorFuncs(interceptorFuncs).
+				Build()
+
+			updater = NewUpdater(k8sClient, zap.New())
+
+			Expect(k8sClient.Create(context.Background(), route)).Should(Succeed())
+			staleRoute = route.DeepCopy()
+
+			route.Status.Parents = []v1.RouteParentStatus{createParentStatus(otherControllerName)}
+			Expect(k8sClient.Status().Update(context.Background(), route)).Should(Succeed())
+		})
+
+		It("should keep the status of the other controller that the first read missed", func() {
+			updater.Update(context.Background(), UpdateRequest{
+				NsName:       routeNsName,
+				ResourceType: &v1.HTTPRoute{},
+				Setter: func(obj client.Object) bool {
+					route, ok := obj.(*v1.HTTPRoute)
+					Expect(ok).To(BeTrue(), "obj is not a *v1.HTTPRoute")
+
+					// like the status setters, keep the statuses of the other controllers
+					parents := make([]v1.RouteParentStatus, 0, len(route.Status.Parents)+1)
+					for _, p := range route.Status.Parents {
+						if p.ControllerName != ngfControllerName {
+							parents = append(parents, p)
+						}
+					}
+					route.Status.Parents = append(parents, createParentStatus(ngfControllerName))
+
+					return true
+				},
+			})
+
+			var route v1.HTTPRoute
+			Expect(k8sClient.Get(context.Background(), routeNsName, &route)).To(Succeed())
+			Expect(route.Status.Parents).To(Equal([]v1.RouteParentStatus{
+				createParentStatus(otherControllerName),
+				createParentStatus(ngfControllerName),
+			}))
+			// the write of the stale route fails with a conflict
+			Expect(statusCalls).To(Equal(2))
+		})
+	})
 })
@@ -5,15 +5,41 @@ import (
 	"strings"
 
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
+const (
+	nginxGatewayContainerName = "nginx-gateway"
+	nginxContainerName        = "nginx"
+)
+
+// DeploymentConfig configures the images and the resources of the provisioned Deployments, for example, to pull
+// the images from a mirrored registry. The zero fields keep the values of the Deployment manifest.
+type DeploymentConfig struct {
+	// NginxResourceRequests are the resource requests of the nginx container.
+	NginxResourceRequests corev1.ResourceList
+	// NginxGatewayImage is the image of the nginx-gateway container.
+	NginxGatewayImage string
+	// NginxImage is the image of the nginx container.
+	NginxImage string
+	// ImagePullPolicy is the pull policy of the images of all containers.
+	ImagePullPolicy corev1.PullPolicy
+	// ImagePullSecrets are the names of the Secrets to pull the images with.
+	ImagePullSecrets []string
+}
+
 // prepareDeployment prepares a new the static mode Deployment based on the YAML manifest.
 // It will use the specified id to set unique parts of the deployment, so it must be unique among all Deployments for
 // Gateways.
 // It will configure the Deployment to use the Gateway with the given NamespacedName.
-func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName) (*v1.Deployment, error) {
+func prepareDeployment(
+	depYAML []byte,
+	id string,
+	gwNsName types.NamespacedName,
+	cfg DeploymentConfig,
+) (*v1.Deployment, error) {
 	dep := &v1.Deployment{}
 	if err := yaml.Unmarshal(depYAML, dep); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal deployment: %w", err)
@@ -39,5 +65,42 @@ func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName)
 
 	dep.Spec.Template.Spec.Containers[0].Args = finalArgs
 
+	applyDeploymentConfig(&dep.Spec.Template.Spec, cfg)
+
 	return dep, nil
 }
+
+func applyDeploymentConfig(podSpec *corev1.PodSpec, cfg DeploymentConfig) {
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+
+		switch container.Name {
+		case nginxGatewayContainerName:
+			if cfg.NginxGatewayImage != "" {
+				container.Image = cfg.NginxGatewayImage
+			}
+		case nginxContainerName:
+			if cfg.NginxImage != "" {
+				container.Image = cfg.NginxImage
+			}
+
+			if len(cfg.NginxResourceRequests) > 0 {
+				if container.Resources.Requests == nil {
+					container.Resources.Requests = make(corev1.ResourceList, len(cfg.NginxResourceRequests))
+				}
+
+				for name, quantity := range cfg.NginxResourceRequests {
+					container.Resources.Requests[name] = quantity
+				}
+			}
+		}
+
+		if cfg.ImagePullPolicy != "" {
+			container.ImagePullPolicy = cfg.ImagePullPolicy
+		}
+	}
+
+	for _, secret := range cfg.ImagePullSecrets {
+		podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+}
@@ -0,0 +1,84 @@
+package provisioner
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+
+	embeddedfiles "github.com/nginxinc/nginx-gateway-fabric"
+)
+
+func TestPrepareDeployment(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	findContainer := func(podSpec corev1.PodSpec, name string) corev1.Container {
+		for _, c := range podSpec.Containers {
+			if c.Name == name {
+				return c
+			}
+		}
+
+		t.Fatalf("container %q not found", name)
+		return corev1.Container{}
+	}
+
+	t.Run("keeps the values of the manifest", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		dep, err := prepareDeployment(embeddedfiles.StaticModeDeploymentYAML, "nginx-gateway-1", gwNsName, DeploymentConfig{})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		podSpec := dep.Spec.Template.Spec
+		nginx := findContainer(podSpec, nginxContainerName)
+		g.Expect(nginx.Image).To(Equal("ghcr.io/nginxinc/nginx-gateway-fabric/nginx:edge"))
+		g.Expect(nginx.ImagePullPolicy).To(Equal(corev1.PullAlways))
+		g.Expect(nginx.Resources.Requests).To(BeEmpty())
+
+		nginxGateway := findContainer(podSpec, nginxGatewayContainerName)
+		g.Expect(nginxGateway.Image).To(Equal("ghcr.io/nginxinc/nginx-gateway-fabric:edge"))
+
+		g.Expect(podSpec.ImagePullSecrets).To(BeEmpty())
+	})
+
+	t.Run("applies the deployment config", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		cfg := DeploymentConfig{
+			NginxImage:        "registry.example.com/nginx:1.0",
+			NginxGatewayImage: "registry.example.com/nginx-gateway-fabric:1.0",
+			ImagePullPolicy:   corev1.PullIfNotPresent,
+			ImagePullSecrets:  []string{"registry-secret1", "registry-secret2"},
+			NginxResourceRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+		}
+
+		dep, err := prepareDeployment(embeddedfiles.StaticModeDeploymentYAML, "nginx-gateway-1", gwNsName, cfg)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		podSpec := dep.Spec.Template.Spec
+
+		nginx := findContainer(podSpec, nginxContainerName)
+		g.Expect(nginx.Image).To(Equal("registry.example.com/nginx:1.0"))
+		g.Expect(nginx.ImagePullPolicy).To(Equal(corev1.PullIfNotPresent))
+		g.Expect(nginx.Resources.Requests).To(Equal(cfg.NginxResourceRequests))
+
+		nginxGateway := findContainer(podSpec, nginxGatewayContainerName)
+		g.Expect(nginxGateway.Image).To(Equal("registry.example.com/nginx-gateway-fabric:1.0"))
+		g.Expect(nginxGateway.ImagePullPolicy).To(Equal(corev1.PullIfNotPresent))
+		g.Expect(nginxGateway.Resources.Requests).To(BeEmpty())
+
+		g.Expect(podSpec.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{
+			{Name: "registry-secret1"},
+			{Name: "registry-secret2"},
+		}))
+	})
+}
@@ -12,6 +12,7 @@ import (
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
@@ -35,8 +36,13 @@ type eventHandler struct {
 	k8sClient     client.Client
 	timeNow       timeNowFunc
 
+	// crdVersionsLogger logs the changes in the support of the installed Gateway API CRD versions.
+	crdVersionsLogger gatewayclass.CRDVersionsLogger
+
 	staticModeDeploymentYAML []byte
 
+	deploymentCfg DeploymentConfig
+
 	gatewayNextID int64
 }
 
@@ -45,6 +51,7 @@ func newEventHandler(
 	statusUpdater *status.Updater,
 	k8sClient client.Client,
 	staticModeDeploymentYAML []byte,
+	deploymentCfg DeploymentConfig,
 	timeNow timeNowFunc,
 ) *eventHandler {
 	return &eventHandler{
@@ -54,6 +61,7 @@ func newEventHandler(
 		gcName:                   gcName,
 		k8sClient:                k8sClient,
 		staticModeDeploymentYAML: staticModeDeploymentYAML,
+		deploymentCfg:            deploymentCfg,
 		gatewayNextID:            1,
 		timeNow:                  timeNow,
 	}
@@ -133,13 +141,26 @@ func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context, logge
 	// Create new deployments
 
 	for _, nsname := range gwsWithoutDeps {
-		deployment, err := prepareDeployment(h.staticModeDeploymentYAML, h.generateDeploymentID(), nsname)
+		deployment, err := prepareDeployment(
+			h.staticModeDeploymentYAML,
+			h.generateDeploymentID(),
+			nsname,
+			h.deploymentCfg,
+		)
 		if err != nil {
 			panic(fmt.Errorf("failed to prepare deployment: %w", err))
 		}
 
-		if err = h.k8sClient.Create(ctx, deployment); err != nil {
-			panic(fmt.Errorf("failed to create deployment: %w", err))
+		// Server-side apply creates the deployment or, if it already exists, updates the fields that we manage.
+		err = h.k8sClient.Patch(
+			ctx,
+			deployment,
+			client.Apply,
+			client.FieldOwner(controller.FieldManager),
+			client.ForceOwnership,
+		)
+		if err != nil {
+			panic(fmt.Errorf("failed to apply deployment: %w", err))
 		}
 
 		h.provisions[nsname] = deployment
@@ -172,6 +193,10 @@ func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context, logge
 
 func (h *eventHandler) HandleEventBatch(ctx context.Context, logger logr.Logger, batch events.EventBatch) {
 	h.store.update(batch)
+
+	supportedVersionConds, _ := gatewayclass.ValidateCRDVersions(h.store.crdMetadata)
+	h.crdVersionsLogger.Log(logger, supportedVersionConds)
+
 	h.setGatewayClassStatuses(ctx)
 	h.ensureDeploymentsMatchGateways(ctx, logger)
 }
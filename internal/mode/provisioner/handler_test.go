@@ -53,6 +53,7 @@ var _ = Describe("handler", func() {
 				&gatewayv1.Gateway{},
 				&gatewayv1.GatewayClass{},
 			).
+			WithInterceptorFuncs(helpers.ApplyAsUpdateInterceptorFuncs()).
 			Build()
 
 		fakeTime := helpers.PrepareTimeForFakeClient(metav1.Now())
@@ -204,7 +205,7 @@ var _ = Describe("handler", func() {
 					LastTransitionTime: fakeTimeNow(),
 					Reason:             string(gatewayv1.GatewayClassReasonUnsupportedVersion),
 					Message: fmt.Sprintf("Gateway API CRD versions are not supported. "+
-						"Please install version %s", gatewayclass.SupportedVersion),
+						"Installed versions are %s. Please install version %s", version, gatewayclass.SupportedVersion),
 				},
 				{
 					Type:               string(gatewayv1.GatewayClassReasonSupportedVersion),
@@ -213,7 +214,7 @@ var _ = Describe("handler", func() {
 					LastTransitionTime: fakeTimeNow(),
 					Reason:             string(gatewayv1.GatewayClassReasonUnsupportedVersion),
 					Message: fmt.Sprintf("Gateway API CRD versions are not supported. "+
-						"Please install version %s", gatewayclass.SupportedVersion),
+						"Installed versions are %s. Please install version %s", version, gatewayclass.SupportedVersion),
 				},
 			}
 		} else {
@@ -233,7 +234,7 @@ var _ = Describe("handler", func() {
 					LastTransitionTime: fakeTimeNow(),
 					Reason:             string(gatewayv1.GatewayClassReasonUnsupportedVersion),
 					Message: fmt.Sprintf("Gateway API CRD versions are not recommended. "+
-						"Recommended version is %s", gatewayclass.SupportedVersion),
+						"Installed versions are %s. Recommended version is %s", version, gatewayclass.SupportedVersion),
 				},
 			}
 		}
@@ -273,6 +274,7 @@ var _ = Describe("handler", func() {
 				statusUpdater,
 				k8sclient,
 				embeddedfiles.StaticModeDeploymentYAML,
+				DeploymentConfig{},
 				fakeTimeNow,
 			)
 		})
@@ -447,6 +449,7 @@ var _ = Describe("handler", func() {
 				statusUpdater,
 				k8sclient,
 				embeddedfiles.StaticModeDeploymentYAML,
+				DeploymentConfig{},
 				fakeTimeNow,
 			)
 		})
@@ -479,11 +482,11 @@ var _ = Describe("handler", func() {
 			})
 		})
 
-		When("upserting Gateway when Deployment can't be created", func() {
-			It("should panic", func() {
+		When("upserting Gateway when Deployment already exists", func() {
+			It("should apply the Deployment", func() {
 				itShouldUpsertGatewayClass()
 
-				// Create a deployment so that the Handler will fail to create it because it already exists.
+				// Create a deployment so that the Handler applies its configuration to the existing deployment.
 
 				dep := &v1.Deployment{
 					ObjectMeta: metav1.ObjectMeta{
@@ -495,7 +498,7 @@ var _ = Describe("handler", func() {
 				err := k8sclient.Create(context.Background(), dep)
 				Expect(err).ToNot(HaveOccurred())
 
-				itShouldPanicWhenUpsertingGateway(gwNsName)
+				itShouldUpsertGateway(gwNsName, 1)
 			})
 		})
 
@@ -559,6 +562,7 @@ var _ = Describe("handler", func() {
 					statusUpdater,
 					k8sclient,
 					[]byte("broken YAML"),
+					DeploymentConfig{},
 					fakeTimeNow,
 				)
 
@@ -29,6 +29,8 @@ type Config struct {
 	Logger           logr.Logger
 	GatewayClassName string
 	GatewayCtlrName  string
+	// DeploymentConfig configures the provisioned Deployments.
+	DeploymentConfig DeploymentConfig
 }
 
 // StartManager starts a Manager for the provisioner mode, which provisions
@@ -133,6 +135,7 @@ func StartManager(cfg Config) error {
 		statusUpdater,
 		mgr.GetClient(),
 		embeddedfiles.StaticModeDeploymentYAML,
+		cfg.DeploymentConfig,
 		metav1.Now,
 	)
 
@@ -0,0 +1,76 @@
+package static
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// apiServerReconnectedEvent is sent to the event loop when the connection to the API server recovered,
+// so that the statuses that couldn't be written while NGF was disconnected are written again.
+type apiServerReconnectedEvent struct{}
+
+// apiServerConnectivityCollector records the disconnections from the API server.
+type apiServerConnectivityCollector interface {
+	ObserveAPIServerDisconnected(since time.Time)
+	ObserveAPIServerConnected()
+}
+
+// apiServerConnectivityMonitor periodically checks the connection to the API server.
+//
+// While NGF is disconnected, the caches keep the last known state of the resources and the event loop doesn't get
+// any events, so NGINX keeps running the last applied configuration: it is neither removed nor reloaded. Only the
+// statuses are affected, because their writes fail. Once the connection recovers, the monitor notifies the event
+// loop, which writes the latest statuses again.
+type apiServerConnectivityMonitor struct {
+	// disconnectedSince is the time of the first failed check of the current disconnection.
+	// It is zero if NGF is connected.
+	disconnectedSince time.Time
+	// probe makes a request to the API server. It returns an error if the API server can't be reached.
+	probe   func(ctx context.Context) error
+	metrics apiServerConnectivityCollector
+	eventCh chan<- interface{}
+	logger  logr.Logger
+}
+
+// check probes the API server. It records the start of a disconnection, and sends an apiServerReconnectedEvent
+// when the connection recovers.
+func (m *apiServerConnectivityMonitor) check(ctx context.Context) {
+	probeCtx, cancel := context.WithTimeout(ctx, clusterTimeout)
+	defer cancel()
+
+	if err := m.probe(probeCtx); err != nil {
+		// the probe fails when NGF stops, which is not a disconnection
+		if ctx.Err() != nil {
+			return
+		}
+
+		if m.disconnectedSince.IsZero() {
+			m.disconnectedSince = time.Now()
+			m.metrics.ObserveAPIServerDisconnected(m.disconnectedSince)
+			m.logger.Error(
+				err,
+				"Lost the connection to the API server; NGINX keeps running the last applied configuration",
+			)
+		}
+
+		return
+	}
+
+	if m.disconnectedSince.IsZero() {
+		return
+	}
+
+	m.logger.Info(
+		"Connection to the API server recovered; writing the statuses again",
+		"disconnectedFor", time.Since(m.disconnectedSince).String(),
+	)
+
+	select {
+	case m.eventCh <- &apiServerReconnectedEvent{}:
+		m.disconnectedSince = time.Time{}
+		m.metrics.ObserveAPIServerConnected()
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,92 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+)
+
+type fakeConnectivityCollector struct {
+	disconnectedSince time.Time
+}
+
+func (c *fakeConnectivityCollector) ObserveAPIServerDisconnected(since time.Time) {
+	c.disconnectedSince = since
+}
+
+func (c *fakeConnectivityCollector) ObserveAPIServerConnected() {
+	c.disconnectedSince = time.Time{}
+}
+
+func TestAPIServerConnectivityMonitorCheck(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	var probeErr error
+	collector := &fakeConnectivityCollector{}
+	eventCh := make(chan interface{}, 1)
+
+	monitor := &apiServerConnectivityMonitor{
+		probe: func(_ context.Context) error {
+			return probeErr
+		},
+		metrics: collector,
+		eventCh: eventCh,
+		logger:  logr.Discard(),
+	}
+
+	// NGF is connected.
+	monitor.check(context.Background())
+	g.Expect(eventCh).ToNot(Receive())
+	g.Expect(collector.disconnectedSince).To(BeZero())
+
+	// NGF lost the connection.
+	probeErr = errors.New("connection refused")
+
+	monitor.check(context.Background())
+	g.Expect(eventCh).ToNot(Receive())
+	g.Expect(collector.disconnectedSince).ToNot(BeZero())
+
+	// The start of the disconnection doesn't change while NGF stays disconnected.
+	disconnectedSince := collector.disconnectedSince
+
+	monitor.check(context.Background())
+	g.Expect(eventCh).ToNot(Receive())
+	g.Expect(collector.disconnectedSince).To(Equal(disconnectedSince))
+
+	// The connection recovered.
+	probeErr = nil
+
+	monitor.check(context.Background())
+	g.Expect(eventCh).To(Receive(Equal(&apiServerReconnectedEvent{})))
+	g.Expect(collector.disconnectedSince).To(BeZero())
+
+	monitor.check(context.Background())
+	g.Expect(eventCh).ToNot(Receive())
+}
+
+func TestAPIServerConnectivityMonitorCheck_Stopped(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	collector := &fakeConnectivityCollector{}
+
+	monitor := &apiServerConnectivityMonitor{
+		probe: func(ctx context.Context) error {
+			return ctx.Err()
+		},
+		metrics: collector,
+		eventCh: make(chan interface{}),
+		logger:  logr.Discard(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	monitor.check(ctx)
+	g.Expect(collector.disconnectedSince).To(BeZero())
+}
@@ -0,0 +1,83 @@
+package static
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// getAttachmentCounts counts the Routes attached to the listeners of the Gateway, the accepted and the rejected
+// Routes that reference the Gateway, and the Policies that target the resources.
+func getAttachmentCounts(gr *graph.Graph) collectors.AttachmentCounts {
+	counts := collectors.AttachmentCounts{
+		ListenerRoutes: make(map[collectors.ListenerKey]int),
+		GatewayRoutes:  make(map[collectors.GatewayRoutesKey]int),
+		TargetPolicies: make(map[collectors.PolicyTargetKey]int),
+	}
+
+	if gr.Gateway != nil {
+		gwNsName := client.ObjectKeyFromObject(gr.Gateway.Source)
+
+		for _, l := range gr.Gateway.Listeners {
+			key := collectors.ListenerKey{Gateway: gwNsName, Name: l.Name}
+			counts.ListenerRoutes[key] = len(l.Routes) + len(l.L4Routes)
+		}
+
+		for _, r := range gr.Routes {
+			countGatewayRoute(counts, gwNsName, r.Source, r.Valid, r.ParentRefs)
+		}
+
+		for _, r := range gr.L4Routes {
+			countGatewayRoute(counts, gwNsName, r.Source, r.Valid, r.ParentRefs)
+		}
+	}
+
+	for key, p := range gr.NGFPolicies {
+		for _, ref := range p.TargetRefs {
+			target := collectors.PolicyTargetKey{
+				Target:     ref.Nsname,
+				Kind:       key.GVK.Kind,
+				TargetKind: string(ref.Kind),
+			}
+			counts.TargetPolicies[target]++
+		}
+	}
+
+	return counts
+}
+
+// countGatewayRoute counts the Route if it references the Gateway. The Route is accepted if it is attached to
+// the Gateway with any of its ParentRefs.
+func countGatewayRoute(
+	counts collectors.AttachmentCounts,
+	gwNsName types.NamespacedName,
+	source client.Object,
+	valid bool,
+	parentRefs []graph.ParentRef,
+) {
+	var referenced, attached bool
+
+	for _, ref := range parentRefs {
+		if ref.ParentRoute != nil || ref.Gateway != gwNsName {
+			continue
+		}
+
+		referenced = true
+		if ref.Attachment != nil && ref.Attachment.Attached {
+			attached = true
+		}
+	}
+
+	if !referenced {
+		return
+	}
+
+	key := collectors.GatewayRoutesKey{
+		Gateway:  gwNsName,
+		Kind:     kindOf(source),
+		Accepted: valid && attached,
+	}
+	counts.GatewayRoutes[key]++
+}
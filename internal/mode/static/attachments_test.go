@@ -0,0 +1,166 @@
+package static
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestGetAttachmentCounts(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	otherGwNsName := types.NamespacedName{Namespace: "test", Name: "other"}
+
+	attachedRef := graph.ParentRef{
+		Gateway:    gwNsName,
+		Attachment: &graph.ParentRefAttachmentStatus{Attached: true},
+	}
+	notAttachedRef := graph.ParentRef{
+		Gateway:    gwNsName,
+		Attachment: &graph.ParentRefAttachmentStatus{Attached: false},
+	}
+
+	httpRoute := &graph.L7Route{
+		Source:     &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"}},
+		Valid:      true,
+		ParentRefs: []graph.ParentRef{notAttachedRef, attachedRef},
+	}
+	invalidHTTPRoute := &graph.L7Route{
+		Source:     &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "invalid"}},
+		ParentRefs: []graph.ParentRef{{Gateway: gwNsName}},
+	}
+	otherGwHTTPRoute := &graph.L7Route{
+		Source:     &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "other"}},
+		Valid:      true,
+		ParentRefs: []graph.ParentRef{{Gateway: otherGwNsName}},
+	}
+	grpcRoute := &graph.L7Route{
+		Source:     &gatewayv1.GRPCRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gr"}},
+		Valid:      true,
+		ParentRefs: []graph.ParentRef{notAttachedRef},
+	}
+	tlsRoute := &graph.L4Route{
+		Source:     &v1alpha2.TLSRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tr"}},
+		Valid:      true,
+		ParentRefs: []graph.ParentRef{attachedRef},
+	}
+
+	policyKey := func(kind, name string) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    schema.GroupVersionKind{Kind: kind},
+		}
+	}
+	gatewayTarget := graph.PolicyTargetRef{Kind: kinds.Gateway, Nsname: gwNsName}
+	routeTarget := graph.PolicyTargetRef{
+		Kind:   kinds.HTTPRoute,
+		Nsname: types.NamespacedName{Namespace: "test", Name: "hr"},
+	}
+
+	tests := []struct {
+		graph     *graph.Graph
+		name      string
+		expCounts collectors.AttachmentCounts
+	}{
+		{
+			name:  "empty graph",
+			graph: &graph.Graph{},
+			expCounts: collectors.AttachmentCounts{
+				ListenerRoutes: map[collectors.ListenerKey]int{},
+				GatewayRoutes:  map[collectors.GatewayRoutesKey]int{},
+				TargetPolicies: map[collectors.PolicyTargetKey]int{},
+			},
+		},
+		{
+			name: "routes and policies",
+			graph: &graph.Graph{
+				Gateway: &graph.Gateway{
+					Source: &gatewayv1.Gateway{
+						ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+					},
+					Listeners: []*graph.Listener{
+						{
+							Name: "http",
+							Routes: map[graph.RouteKey]*graph.L7Route{
+								graph.CreateRouteKey(httpRoute.Source): httpRoute,
+							},
+						},
+						{
+							Name: "tls",
+							L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+								graph.CreateRouteKeyL4(tlsRoute.Source): tlsRoute,
+							},
+						},
+						{
+							Name: "empty",
+						},
+					},
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(httpRoute.Source):        httpRoute,
+					graph.CreateRouteKey(invalidHTTPRoute.Source): invalidHTTPRoute,
+					graph.CreateRouteKey(otherGwHTTPRoute.Source): otherGwHTTPRoute,
+					graph.CreateRouteKey(grpcRoute.Source):        grpcRoute,
+				},
+				L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+					graph.CreateRouteKeyL4(tlsRoute.Source): tlsRoute,
+				},
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					policyKey(kinds.ClientSettingsPolicy, "csp"): {
+						TargetRefs: []graph.PolicyTargetRef{gatewayTarget},
+					},
+					policyKey(kinds.ObservabilityPolicy, "obs-1"): {
+						TargetRefs: []graph.PolicyTargetRef{routeTarget},
+					},
+					policyKey(kinds.ObservabilityPolicy, "obs-2"): {
+						TargetRefs: []graph.PolicyTargetRef{routeTarget},
+					},
+				},
+			},
+			expCounts: collectors.AttachmentCounts{
+				ListenerRoutes: map[collectors.ListenerKey]int{
+					{Gateway: gwNsName, Name: "http"}:  1,
+					{Gateway: gwNsName, Name: "tls"}:   1,
+					{Gateway: gwNsName, Name: "empty"}: 0,
+				},
+				GatewayRoutes: map[collectors.GatewayRoutesKey]int{
+					{Gateway: gwNsName, Kind: "HTTPRoute", Accepted: true}:  1,
+					{Gateway: gwNsName, Kind: "HTTPRoute", Accepted: false}: 1,
+					{Gateway: gwNsName, Kind: "GRPCRoute", Accepted: false}: 1,
+					{Gateway: gwNsName, Kind: "TLSRoute", Accepted: true}:   1,
+				},
+				TargetPolicies: map[collectors.PolicyTargetKey]int{
+					{
+						Target:     gwNsName,
+						Kind:       kinds.ClientSettingsPolicy,
+						TargetKind: kinds.Gateway,
+					}: 1,
+					{
+						Target:     routeTarget.Nsname,
+						Kind:       kinds.ObservabilityPolicy,
+						TargetKind: kinds.HTTPRoute,
+					}: 2,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getAttachmentCounts(test.graph)).To(Equal(test.expCounts))
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+const (
+	// auditRecordsKey is the key of the ConfigMap data with the records of the audit trail.
+	auditRecordsKey = "records.json"
+	// maxAuditRecords is the number of the latest records that the audit trail keeps.
+	maxAuditRecords = 50
+	// maxAuditNames is the number of the triggers, servers, and upstreams that a record lists. The record counts
+	// the rest, so that the ConfigMap stays small when a generation changes the whole configuration.
+	maxAuditNames = 20
+
+	auditResultApplied = "Applied"
+	auditResultFailed  = "Failed"
+)
+
+// auditRecord is the record of a generation of the NGINX configuration in the audit trail.
+type auditRecord struct {
+	// Time is the time at which NGINX was updated with the generation.
+	Time time.Time `json:"time"`
+	// Result is Applied if NGINX was updated with the generation, and Failed otherwise.
+	Result string `json:"result"`
+	// Error is the error of the update of NGINX if it failed.
+	Error string `json:"error,omitempty"`
+	// Triggers are the changes of the resources in the event batch of the generation.
+	Triggers []string `json:"triggers,omitempty"`
+	// Diff summarizes the changes of the generation to the previous generation.
+	Diff configDiff `json:"diff"`
+	// Version is the version of the generation.
+	Version int `json:"version"`
+}
+
+// configDiff summarizes the changes of a generation of the NGINX configuration to the previous generation.
+// The servers are named <protocol>://<hostname>:<port>, and the upstreams by their names.
+type configDiff struct {
+	AddedServers     []string `json:"addedServers,omitempty"`
+	RemovedServers   []string `json:"removedServers,omitempty"`
+	ChangedServers   []string `json:"changedServers,omitempty"`
+	AddedUpstreams   []string `json:"addedUpstreams,omitempty"`
+	RemovedUpstreams []string `json:"removedUpstreams,omitempty"`
+	ChangedUpstreams []string `json:"changedUpstreams,omitempty"`
+}
+
+// auditTrail records the generations of the NGINX configuration, so that the changes that caused a problem can
+// be found later. It logs every record and keeps the latest records, which it also writes to a ConfigMap with
+// server-side apply if configured. Like the status updater, it only writes the ConfigMap when NGF is the leader.
+type auditTrail struct {
+	k8sClient client.Client
+	// configMap is the NamespacedName of the ConfigMap. If nil, the records are not written.
+	configMap *types.NamespacedName
+	logger    logr.Logger
+	// records are the latest records, the newest last.
+	records []auditRecord
+	lock    sync.Mutex
+	enabled bool
+}
+
+// newAuditTrail creates a new auditTrail.
+func newAuditTrail(k8sClient client.Client, configMap *types.NamespacedName, logger logr.Logger) *auditTrail {
+	return &auditTrail{
+		k8sClient: k8sClient,
+		configMap: configMap,
+		logger:    logger,
+	}
+}
+
+// Record logs and keeps the record, and writes the kept records to the ConfigMap if the trail is enabled.
+func (t *auditTrail) Record(ctx context.Context, record auditRecord) {
+	keysAndValues := []interface{}{
+		"version", record.Version,
+		"result", record.Result,
+		"triggers", record.Triggers,
+		"diff", record.Diff,
+	}
+	if record.Error != "" {
+		keysAndValues = append(keysAndValues, "error", record.Error)
+	}
+
+	t.logger.Info("Recorded NGINX configuration generation", keysAndValues...)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.records = append(t.records, record)
+	if len(t.records) > maxAuditRecords {
+		t.records = t.records[len(t.records)-maxAuditRecords:]
+	}
+
+	if t.enabled {
+		t.write(ctx)
+	}
+}
+
+// Enable enables the writes of the ConfigMap, writing the saved records.
+func (t *auditTrail) Enable(ctx context.Context) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.enabled = true
+	if len(t.records) > 0 {
+		t.write(ctx)
+	}
+}
+
+// MarshalRecords returns the latest records as JSON, the newest last.
+func (t *auditTrail) MarshalRecords() ([]byte, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return json.MarshalIndent(t.records, "", "  ")
+}
+
+func (t *auditTrail) write(ctx context.Context) {
+	if t.configMap == nil {
+		return
+	}
+
+	records, err := json.Marshal(t.records)
+	if err != nil {
+		t.logger.Error(err, "Failed to marshal the audit records")
+		return
+	}
+
+	configMap := &v1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: t.configMap.Namespace,
+			Name:      t.configMap.Name,
+		},
+		Data: map[string]string{
+			auditRecordsKey: string(records),
+		},
+	}
+
+	// The ConfigMap holds all saved records, so a failed write is recovered by the next write. A new leader
+	// replaces the records of the previous leader with its own records, which are the same generations,
+	// because all replicas process the same resources.
+	err = t.k8sClient.Patch(
+		ctx,
+		configMap,
+		client.Apply,
+		client.FieldOwner(controller.FieldManager),
+		client.ForceOwnership,
+	)
+	if err != nil {
+		t.logger.Error(
+			err,
+			"Failed to write the audit trail ConfigMap",
+			"namespace", t.configMap.Namespace,
+			"name", t.configMap.Name,
+		)
+	}
+}
+
+// newAuditRecord creates the record of the generation cur of the NGINX configuration. The generation was
+// triggered by the batch, and prev is the previous generation, which is nil for the first generation.
+// updateErr is the error of the update of NGINX.
+func newAuditRecord(
+	batch events.EventBatch,
+	prev *dataplane.Configuration,
+	cur *dataplane.Configuration,
+	updateErr error,
+	now time.Time,
+) auditRecord {
+	record := auditRecord{
+		Time:     now.UTC(),
+		Version:  cur.Version,
+		Result:   auditResultApplied,
+		Triggers: auditTriggers(batch),
+		Diff:     diffConfigurations(prev, cur),
+	}
+
+	if updateErr != nil {
+		record.Result = auditResultFailed
+		record.Error = updateErr.Error()
+	}
+
+	return record
+}
+
+// auditTriggers describes the changes of the resources in the batch, without duplicates.
+func auditTriggers(batch events.EventBatch) []string {
+	var triggers []string
+
+	for _, event := range batch {
+		var trigger string
+
+		switch e := event.(type) {
+		case *events.UpsertEvent:
+			trigger = fmt.Sprintf("%s %s upserted", kindOf(e.Resource), client.ObjectKeyFromObject(e.Resource))
+		case *events.DeleteEvent:
+			trigger = fmt.Sprintf("%s %s deleted", kindOf(e.Type), e.NamespacedName)
+		case *upstreamDrainExpiredEvent:
+			trigger = "upstream drain expired"
+		case *externalCertificatesEvent:
+			trigger = "ExternalCertificates changed"
+		default:
+			continue
+		}
+
+		if !slices.Contains(triggers, trigger) {
+			triggers = append(triggers, trigger)
+		}
+	}
+
+	return limitAuditNames(triggers)
+}
+
+// kindOf returns the kind of the object from its Go type, because the objects from the cache don't have
+// their TypeMeta set.
+func kindOf(obj client.Object) string {
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
+
+// diffConfigurations compares the servers and the upstreams of the generations of the NGINX configuration.
+func diffConfigurations(prev, cur *dataplane.Configuration) configDiff {
+	if prev == nil {
+		prev = &dataplane.Configuration{}
+	}
+
+	var diff configDiff
+
+	diff.AddedServers, diff.RemovedServers, diff.ChangedServers = diffByName(
+		configurationServers(prev),
+		configurationServers(cur),
+	)
+	diff.AddedUpstreams, diff.RemovedUpstreams, diff.ChangedUpstreams = diffByName(
+		configurationUpstreams(prev),
+		configurationUpstreams(cur),
+	)
+
+	return diff
+}
+
+func configurationServers(conf *dataplane.Configuration) map[string]interface{} {
+	servers := make(map[string]interface{})
+
+	for _, s := range conf.HTTPServers {
+		servers[serverName("http", s.Hostname, s.Port, s.IsDefault)] = s
+	}
+	for _, s := range conf.SSLServers {
+		servers[serverName("https", s.Hostname, s.Port, s.IsDefault)] = s
+	}
+	for _, s := range conf.TLSPassthroughServers {
+		servers[serverName("tls", s.Hostname, s.Port, s.IsDefault)] = s
+	}
+
+	return servers
+}
+
+func serverName(protocol, hostname string, port int32, isDefault bool) string {
+	if isDefault {
+		hostname = "default"
+	}
+
+	return fmt.Sprintf("%s://%s:%d", protocol, hostname, port)
+}
+
+func configurationUpstreams(conf *dataplane.Configuration) map[string]interface{} {
+	upstreams := make(map[string]interface{}, len(conf.Upstreams)+len(conf.StreamUpstreams))
+
+	for _, u := range conf.Upstreams {
+		upstreams[u.Name] = u
+	}
+	for _, u := range conf.StreamUpstreams {
+		upstreams["stream "+u.Name] = u
+	}
+
+	return upstreams
+}
+
+func diffByName(prev, cur map[string]interface{}) (added, removed, changed []string) {
+	for name, c := range cur {
+		p, exists := prev[name]
+
+		switch {
+		case !exists:
+			added = append(added, name)
+		case !reflect.DeepEqual(p, c):
+			changed = append(changed, name)
+		}
+	}
+
+	for name := range prev {
+		if _, exists := cur[name]; !exists {
+			removed = append(removed, name)
+		}
+	}
+
+	slices.Sort(added)
+	slices.Sort(removed)
+	slices.Sort(changed)
+
+	return limitAuditNames(added), limitAuditNames(removed), limitAuditNames(changed)
+}
+
+// limitAuditNames limits the names to maxAuditNames, replacing the rest with their number.
+func limitAuditNames(names []string) []string {
+	if len(names) <= maxAuditNames {
+		return names
+	}
+
+	return append(names[:maxAuditNames], fmt.Sprintf("and %d more", len(names)-maxAuditNames))
+}
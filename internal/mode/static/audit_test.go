@@ -0,0 +1,236 @@
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+func TestNewAuditRecord(t *testing.T) {
+	t.Parallel()
+
+	batch := events.EventBatch{
+		&events.UpsertEvent{
+			Resource: &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "coffee"}},
+		},
+		&events.UpsertEvent{
+			Resource: &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "coffee"}},
+		},
+		&events.DeleteEvent{
+			Type:           &v1.Secret{},
+			NamespacedName: types.NamespacedName{Namespace: "test", Name: "secret"},
+		},
+		&upstreamDrainExpiredEvent{},
+	}
+
+	prev := &dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "cafe.example.com", Port: 80},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{Hostname: "tea.example.com", Port: 443},
+		},
+		Upstreams: []dataplane.Upstream{
+			{Name: "test_coffee_80", Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 8080}}},
+			{Name: "test_tea_80"},
+		},
+		Version: 1,
+	}
+
+	cur := &dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "cafe.example.com", Port: 80, PathRules: []dataplane.PathRule{{Path: "/coffee"}}},
+		},
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{Hostname: "app.example.com", Port: 443},
+		},
+		Upstreams: []dataplane.Upstream{
+			{Name: "test_coffee_80", Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 8080}}},
+			{Name: "test_tea_80"},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{Name: "test_app_443"},
+		},
+		Version: 2,
+	}
+
+	now := time.Date(2024, 1, 1, 14, 32, 0, 0, time.UTC)
+
+	tests := []struct {
+		updateErr error
+		prev      *dataplane.Configuration
+		expected  auditRecord
+		name      string
+	}{
+		{
+			name: "applied generation",
+			prev: prev,
+			expected: auditRecord{
+				Time:    now,
+				Version: 2,
+				Result:  auditResultApplied,
+				Triggers: []string{
+					"HTTPRoute test/coffee upserted",
+					"Secret test/secret deleted",
+					"upstream drain expired",
+				},
+				Diff: configDiff{
+					AddedServers:     []string{"tls://app.example.com:443"},
+					RemovedServers:   []string{"https://tea.example.com:443"},
+					ChangedServers:   []string{"http://cafe.example.com:80"},
+					AddedUpstreams:   []string{"stream test_app_443"},
+					ChangedUpstreams: []string{"test_coffee_80"},
+				},
+			},
+		},
+		{
+			name:      "failed first generation",
+			updateErr: errors.New("reload failed"),
+			expected: auditRecord{
+				Time:    now,
+				Version: 2,
+				Result:  auditResultFailed,
+				Error:   "reload failed",
+				Triggers: []string{
+					"HTTPRoute test/coffee upserted",
+					"Secret test/secret deleted",
+					"upstream drain expired",
+				},
+				Diff: configDiff{
+					AddedServers: []string{
+						"http://cafe.example.com:80",
+						"http://default:80",
+						"tls://app.example.com:443",
+					},
+					AddedUpstreams: []string{"stream test_app_443", "test_coffee_80", "test_tea_80"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			record := newAuditRecord(batch, test.prev, cur, test.updateErr, now)
+			g.Expect(record).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestLimitAuditNames(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	names := make([]string, 0, maxAuditNames+5)
+	for i := range maxAuditNames + 5 {
+		names = append(names, fmt.Sprintf("name-%d", i))
+	}
+
+	limited := limitAuditNames(names)
+	g.Expect(limited).To(HaveLen(maxAuditNames + 1))
+	g.Expect(limited[maxAuditNames-1]).To(Equal(fmt.Sprintf("name-%d", maxAuditNames-1)))
+	g.Expect(limited[maxAuditNames]).To(Equal("and 5 more"))
+
+	g.Expect(limitAuditNames([]string{"name"})).To(Equal([]string{"name"}))
+}
+
+func TestAuditTrail(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(helpers.ApplyAsUpdateInterceptorFuncs()).
+		Build()
+
+	configMapNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "audit"}
+
+	getRecords := func() []auditRecord {
+		var configMap v1.ConfigMap
+		if err := k8sClient.Get(context.Background(), configMapNsName, &configMap); err != nil {
+			return nil
+		}
+
+		var records []auditRecord
+		g.Expect(json.Unmarshal([]byte(configMap.Data[auditRecordsKey]), &records)).To(Succeed())
+
+		return records
+	}
+
+	now := time.Date(2024, 1, 1, 14, 32, 0, 0, time.UTC)
+
+	trail := newAuditTrail(k8sClient, &configMapNsName, logr.Discard())
+
+	// The records are saved until the trail is enabled.
+	trail.Record(context.Background(), auditRecord{Time: now, Version: 1, Result: auditResultApplied})
+	g.Expect(getRecords()).To(BeEmpty())
+
+	trail.Enable(context.Background())
+	g.Expect(getRecords()).To(Equal([]auditRecord{{Time: now, Version: 1, Result: auditResultApplied}}))
+
+	// Only the latest records are kept.
+	for version := 2; version <= maxAuditRecords+1; version++ {
+		trail.Record(context.Background(), auditRecord{Time: now, Version: version, Result: auditResultApplied})
+	}
+
+	records := getRecords()
+	g.Expect(records).To(HaveLen(maxAuditRecords))
+	g.Expect(records[0].Version).To(Equal(2))
+	g.Expect(records[maxAuditRecords-1].Version).To(Equal(maxAuditRecords + 1))
+
+	marshaled, err := trail.MarshalRecords()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var unmarshaled []auditRecord
+	g.Expect(json.Unmarshal(marshaled, &unmarshaled)).To(Succeed())
+	g.Expect(unmarshaled).To(Equal(records))
+}
+
+func TestAuditTrail_NoConfigMap(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	k8sClient := fake.NewClientBuilder().Build()
+
+	now := time.Date(2024, 1, 1, 14, 32, 0, 0, time.UTC)
+
+	// Without a ConfigMap, the records are kept, but not written.
+	trail := newAuditTrail(k8sClient, nil, logr.Discard())
+	trail.Enable(context.Background())
+	trail.Record(context.Background(), auditRecord{Time: now, Version: 1, Result: auditResultApplied})
+
+	var configMaps v1.ConfigMapList
+	g.Expect(k8sClient.List(context.Background(), &configMaps)).To(Succeed())
+	g.Expect(configMaps.Items).To(BeEmpty())
+
+	marshaled, err := trail.MarshalRecords()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var records []auditRecord
+	g.Expect(json.Unmarshal(marshaled, &records)).To(Succeed())
+	g.Expect(records).To(Equal([]auditRecord{{Time: now, Version: 1, Result: auditResultApplied}}))
+}
@@ -0,0 +1,92 @@
+package static
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// listenerCertificate is a certificate referenced by the listeners of the Gateway.
+type listenerCertificate struct {
+	// expiry is the time when the certificate expires.
+	expiry time.Time
+	// secret is the Secret that holds the certificate.
+	secret types.NamespacedName
+	// listeners are the names of the listeners that reference the certificate.
+	listeners []string
+}
+
+// getListenerCertificates returns the certificates referenced by the listeners of the Gateway, sorted by Secret.
+// The Secrets that were not resolved for a listener, or that don't hold a valid certificate, are skipped.
+func getListenerCertificates(gr *graph.Graph) []listenerCertificate {
+	if gr.Gateway == nil {
+		return nil
+	}
+
+	certs := make(map[types.NamespacedName]*listenerCertificate)
+
+	for _, l := range gr.Gateway.Listeners {
+		if l.ResolvedSecret == nil {
+			continue
+		}
+
+		nsname := *l.ResolvedSecret
+
+		cert, exists := certs[nsname]
+		if !exists {
+			secret, ok := gr.ReferencedSecrets[nsname]
+			if !ok || secret.Source == nil {
+				continue
+			}
+
+			certData, _ := secret.TLSKeyPair()
+
+			expiry, err := getCertificateExpiry(certData)
+			if err != nil {
+				continue
+			}
+
+			cert = &listenerCertificate{
+				secret: nsname,
+				expiry: expiry,
+			}
+			certs[nsname] = cert
+		}
+
+		cert.listeners = append(cert.listeners, l.Name)
+	}
+
+	result := make([]listenerCertificate, 0, len(certs))
+	for _, cert := range certs {
+		result = append(result, *cert)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].secret.String() < result[j].secret.String()
+	})
+
+	return result
+}
+
+// getCertificateExpiry returns the expiry of the first certificate of a PEM-encoded certificate chain,
+// which is the certificate of the server.
+func getCertificateExpiry(certPEM []byte) (time.Time, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return time.Time{}, errors.New("failed to decode PEM certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return cert.NotAfter, nil
+}
@@ -0,0 +1,153 @@
+package static
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func createCertificatePEM(g Gomega, notAfter time.Time) []byte {
+	cert, _ := createKeyPairPEM(g, notAfter)
+	return cert
+}
+
+func createKeyPairPEM(g Gomega, notAfter time.Time) (cert, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	cert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	key = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return cert, key
+}
+
+func TestGetCertificateExpiry(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	notAfter := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	expiry, err := getCertificateExpiry(createCertificatePEM(g, notAfter))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(expiry).To(BeTemporally("==", notAfter))
+
+	_, err = getCertificateExpiry([]byte("not a certificate"))
+	g.Expect(err).To(MatchError("failed to decode PEM certificate"))
+
+	_, err = getCertificateExpiry(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")}))
+	g.Expect(err).To(MatchError(ContainSubstring("failed to parse certificate")))
+}
+
+func TestGetListenerCertificates(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	expiry1 := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	expiry2 := time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	secret1 := types.NamespacedName{Namespace: "test", Name: "secret1"}
+	secret2 := types.NamespacedName{Namespace: "test", Name: "secret2"}
+	invalidSecret := types.NamespacedName{Namespace: "test", Name: "invalid"}
+
+	createSecret := func(cert, key []byte) *graph.Secret {
+		return &graph.Secret{
+			Source: &apiv1.Secret{
+				Data: map[string][]byte{
+					apiv1.TLSCertKey:       cert,
+					apiv1.TLSPrivateKeyKey: key,
+				},
+			},
+		}
+	}
+
+	referencedSecrets := map[types.NamespacedName]*graph.Secret{
+		secret1:       createSecret(createKeyPairPEM(g, expiry1)),
+		secret2:       createSecret(createKeyPairPEM(g, expiry2)),
+		invalidSecret: createSecret([]byte("invalid"), nil),
+	}
+
+	tests := []struct {
+		graph    *graph.Graph
+		name     string
+		expCerts []listenerCertificate
+	}{
+		{
+			name:     "no gateway",
+			graph:    &graph.Graph{ReferencedSecrets: referencedSecrets},
+			expCerts: nil,
+		},
+		{
+			name: "listeners reference certificates",
+			graph: &graph.Graph{
+				Gateway: &graph.Gateway{
+					Listeners: []*graph.Listener{
+						{Name: "http"},
+						{Name: "https-2", ResolvedSecret: &secret2},
+						{Name: "https-1", ResolvedSecret: &secret1},
+						{Name: "https-1-other", ResolvedSecret: &secret1},
+						{Name: "https-invalid", ResolvedSecret: &invalidSecret},
+					},
+				},
+				ReferencedSecrets: referencedSecrets,
+			},
+			expCerts: []listenerCertificate{
+				{
+					secret:    secret1,
+					expiry:    expiry1,
+					listeners: []string{"https-1", "https-1-other"},
+				},
+				{
+					secret:    secret2,
+					expiry:    expiry2,
+					listeners: []string{"https-2"},
+				},
+			},
+		},
+		{
+			name: "listeners don't reference certificates",
+			graph: &graph.Graph{
+				Gateway: &graph.Gateway{
+					Listeners: []*graph.Listener{
+						{Name: "http"},
+					},
+				},
+				ReferencedSecrets: referencedSecrets,
+			},
+			expCerts: []listenerCertificate{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getListenerCertificates(test.graph)).To(Equal(test.expCerts))
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/go-logr/logr"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/featuregates"
 )
 
 type Config struct {
@@ -13,6 +15,14 @@ type Config struct {
 	AtomicLevel zap.AtomicLevel
 	// UsageReportConfig specifies the NGINX Plus usage reporting config.
 	UsageReportConfig *UsageReportConfig
+	// SessionTicketKeysConfig specifies the configuration of the TLS session ticket keys.
+	// If nil, every NGINX generates its own keys.
+	SessionTicketKeysConfig *SessionTicketKeysConfig
+	// AuditConfigMapNsName is the namespaced name of the ConfigMap with the latest records of the audit trail of
+	// the NGINX configuration. If nil, the records are only logged.
+	AuditConfigMapNsName *types.NamespacedName
+	// FeatureGates holds whether the features that are not generally available are enabled.
+	FeatureGates *featuregates.FeatureGates
 	// Version is the running NGF version.
 	Version string
 	// ImageSource is the source of the NGINX Gateway image.
@@ -28,24 +38,53 @@ type Config struct {
 	Logger logr.Logger
 	// GatewayCtlrName is the name of this controller.
 	GatewayCtlrName string
+	// LogLevel is the initial logging level. It is also used if the NginxGateway resource is deleted.
+	LogLevel string
 	// ConfigName is the name of the NginxGateway resource for this controller.
 	ConfigName string
 	// GatewayClassName is the name of the GatewayClass resource that the Gateway will use.
 	GatewayClassName string
+	// ExternalCertificatesDir is the directory with the certificates that listeners reference as
+	// ExternalCertificates. If empty, ExternalCertificates are not supported.
+	ExternalCertificatesDir string
+	// ConfigTemplateOverridesDir is the directory with the templates that override the built-in templates of
+	// the sections of the NGINX configuration. If empty, the built-in templates are used.
+	ConfigTemplateOverridesDir string
+	// UnprivilegedPortOffset is the offset that is added to the privileged ports of the listeners, so that NGINX
+	// binds to unprivileged ports. If zero, NGINX binds to the ports of the listeners.
+	UnprivilegedPortOffset int32
 	// LeaderElection contains the configuration for leader election.
 	LeaderElection LeaderElectionConfig
+	// WebhookConfig specifies the admission webhook config.
+	WebhookConfig WebhookConfig
 	// ProductTelemetryConfig contains the configuration for collecting product telemetry.
 	ProductTelemetryConfig ProductTelemetryConfig
 	// MetricsConfig specifies the metrics config.
 	MetricsConfig MetricsConfig
 	// HealthConfig specifies the health probe config.
 	HealthConfig HealthConfig
+	// ProfilingConfig specifies the profiling server config.
+	ProfilingConfig ProfilingConfig
+	// CertificateExpiryWarningWindow is the window before the expiry of a certificate referenced by a listener
+	// in which warning Events are emitted for the Gateway. If zero, no Events are emitted.
+	CertificateExpiryWarningWindow time.Duration
 	// UpdateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	UpdateGatewayClassStatus bool
 	// Plus indicates whether NGINX Plus is being used.
 	Plus bool
-	// ExperimentalFeatures indicates if experimental features are enabled.
-	ExperimentalFeatures bool
+}
+
+// GenerateConfig is the configuration for generating the NGINX configuration without a cluster.
+type GenerateConfig struct {
+	// GatewayCtlrName is the name of the Gateway controller.
+	GatewayCtlrName string
+	// GatewayClassName is the name of the GatewayClass resource that the Gateway will use.
+	GatewayClassName string
+	// ExternalCertificatesDir is the directory with the certificates that listeners reference as
+	// ExternalCertificates. If empty, ExternalCertificates are not supported.
+	ExternalCertificatesDir string
+	// Plus indicates whether NGINX Plus is being used.
+	Plus bool
 }
 
 // GatewayPodConfig contains information about this Pod.
@@ -68,6 +107,8 @@ type MetricsConfig struct {
 	Enabled bool
 	// Secure is the flag for toggling the metrics endpoint to https.
 	Secure bool
+	// DebugEndpoints is the flag for serving the debug endpoints on the metrics server.
+	DebugEndpoints bool
 }
 
 // HealthConfig specifies the health probe config.
@@ -78,6 +119,24 @@ type HealthConfig struct {
 	Enabled bool
 }
 
+// WebhookConfig specifies the admission webhook config.
+type WebhookConfig struct {
+	// CertDir is the directory that contains the TLS certificate and key of the webhook server.
+	CertDir string
+	// Port is the port that the webhook server listens on.
+	Port int
+	// Enabled is the flag for toggling the admission webhook on or off.
+	Enabled bool
+}
+
+// ProfilingConfig specifies the profiling server config.
+type ProfilingConfig struct {
+	// Port is the port on localhost that the profiling server listens on.
+	Port int
+	// Enabled is the flag for toggling the profiling server on or off.
+	Enabled bool
+}
+
 // LeaderElectionConfig contains the configuration for leader election.
 type LeaderElectionConfig struct {
 	// LockName holds the name of the leader election lock.
@@ -112,6 +171,15 @@ type UsageReportConfig struct {
 	InsecureSkipVerify bool
 }
 
+// SessionTicketKeysConfig contains the configuration of the TLS session ticket keys, which are shared by
+// the NGINX of all replicas.
+type SessionTicketKeysConfig struct {
+	// SecretNsName is the namespaced name of the Secret that holds the keys.
+	SecretNsName types.NamespacedName
+	// RotationPeriod is the period at which a new key is generated.
+	RotationPeriod time.Duration
+}
+
 // Flags contains the NGF command-line flag names and values.
 // Flag Names and Values are paired based off of index in slice.
 type Flags struct {
@@ -0,0 +1,39 @@
+package config
+
+import "github.com/nginxinc/nginx-gateway-fabric/internal/framework/featuregates"
+
+// Features that can be enabled or disabled with feature gates.
+const (
+	// FeatureTLSRoute enables support for TLSRoutes.
+	// Requires the TLSRoute CRD from the experimental channel of Gateway API.
+	FeatureTLSRoute featuregates.Feature = "TLSRoute"
+	// FeatureBackendTLSPolicy enables support for BackendTLSPolicies.
+	// Requires the BackendTLSPolicy CRD from the experimental channel of Gateway API.
+	FeatureBackendTLSPolicy featuregates.Feature = "BackendTLSPolicy"
+	// FeatureHostnameReport enables the HostnameReport of the Gateway, which lists the hostnames that the Routes
+	// claim and the hostname conflicts.
+	FeatureHostnameReport featuregates.Feature = "HostnameReport"
+	// FeatureSnippetsFilter enables support for SnippetsFilters, which insert NGINX configuration snippets
+	// into the configuration of HTTPRoutes.
+	FeatureSnippetsFilter featuregates.Feature = "SnippetsFilter"
+	// FeatureExternalDNS enables the annotation of the Service that fronts NGF with the hostnames of the Gateway,
+	// so that external-dns publishes the DNS records of the hostnames.
+	FeatureExternalDNS featuregates.Feature = "ExternalDNS"
+)
+
+// GatewayAPIExperimentalFeatures are the features that require the experimental channel of Gateway API.
+var GatewayAPIExperimentalFeatures = []featuregates.Feature{
+	FeatureTLSRoute,
+	FeatureBackendTLSPolicy,
+}
+
+// NewFeatureGates creates the feature gates with the known features, which are disabled by default.
+func NewFeatureGates() *featuregates.FeatureGates {
+	return featuregates.New(map[featuregates.Feature]featuregates.FeatureSpec{
+		FeatureTLSRoute:         {Stage: featuregates.Alpha},
+		FeatureBackendTLSPolicy: {Stage: featuregates.Alpha},
+		FeatureHostnameReport:   {Stage: featuregates.Alpha},
+		FeatureSnippetsFilter:   {Stage: featuregates.Alpha},
+		FeatureExternalDNS:      {Stage: featuregates.Alpha},
+	})
+}
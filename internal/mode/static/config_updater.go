@@ -3,6 +3,8 @@ package static
 import (
 	"encoding/json"
 	"fmt"
+	"time"
+	"unicode"
 
 	"github.com/go-logr/logr"
 	apiv1 "k8s.io/api/core/v1"
@@ -12,22 +14,29 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 )
 
 // updateControlPlane updates the control plane configuration with the given user spec.
 // If any fields are not set within the user spec, the default configuration values are used.
+// The default log level is the level configured by the command-line flag.
 func updateControlPlane(
 	cfg *ngfAPI.NginxGateway,
 	logger logr.Logger,
 	eventRecorder record.EventRecorder,
 	configNSName types.NamespacedName,
 	logLevelSetter logLevelSetter,
+	defaultLogLevel ngfAPI.ControllerLogLevel,
+	eventBatchDelay *events.BatchDelay,
 ) error {
 	// build up default configuration
 	controlConfig := ngfAPI.NginxGatewaySpec{
 		Logging: &ngfAPI.Logging{
-			Level: helpers.GetPointer(ngfAPI.ControllerLogLevelInfo),
+			Level: helpers.GetPointer(defaultLogLevel),
+		},
+		EventBatching: &ngfAPI.EventBatching{
+			Delay: helpers.GetPointer[ngfAPI.Duration]("0s"),
 		},
 	}
 
@@ -64,6 +73,15 @@ func updateControlPlane(
 		return err
 	}
 
+	delay, err := parseDuration(*controlConfig.EventBatching.Delay)
+	if err != nil {
+		return field.Invalid(
+			field.NewPath("eventBatching.delay"),
+			*controlConfig.EventBatching.Delay,
+			err.Error(),
+		)
+	}
+
 	if err := logLevelSetter.SetLevel(string(level)); err != nil {
 		return field.Invalid(
 			field.NewPath("logging.level"),
@@ -72,9 +90,21 @@ func updateControlPlane(
 		)
 	}
 
+	eventBatchDelay.Set(delay)
+
 	return nil
 }
 
+// parseDuration parses the Duration. A Duration without a unit is in seconds.
+func parseDuration(d ngfAPI.Duration) (time.Duration, error) {
+	value := string(d)
+	if value != "" && unicode.IsDigit(rune(value[len(value)-1])) {
+		value += "s"
+	}
+
+	return time.ParseDuration(value)
+}
+
 func validateLogLevel(level ngfAPI.ControllerLogLevel) error {
 	switch level {
 	case ngfAPI.ControllerLogLevelInfo, ngfAPI.ControllerLogLevelDebug, ngfAPI.ControllerLogLevelError:
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
@@ -11,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/staticfakes"
 )
@@ -25,6 +27,22 @@ func TestUpdateControlPlane(t *testing.T) {
 		},
 	}
 
+	batchDelayCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			EventBatching: &ngfAPI.EventBatching{
+				Delay: helpers.GetPointer[ngfAPI.Duration]("500ms"),
+			},
+		},
+	}
+
+	invalidDelayCfg := &ngfAPI.NginxGateway{
+		Spec: ngfAPI.NginxGatewaySpec{
+			EventBatching: &ngfAPI.EventBatching{
+				Delay: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+			},
+		},
+	}
+
 	invalidLevelConfig := &ngfAPI.NginxGateway{
 		Spec: ngfAPI.NginxGatewaySpec{
 			Logging: &ngfAPI.Logging{
@@ -42,13 +60,29 @@ func TestUpdateControlPlane(t *testing.T) {
 		nginxGateway         *ngfAPI.NginxGateway
 		name                 string
 		expErrString         string
+		expLevel             string
 		expSetLevelCallCount int
+		expBatchDelay        time.Duration
 		expEvent             bool
 	}{
 		{
 			name:                 "change log level",
 			nginxGateway:         debugLogCfg,
+			expLevel:             "debug",
+			expSetLevelCallCount: 1,
+		},
+		{
+			name:                 "change event batch delay",
+			nginxGateway:         batchDelayCfg,
+			expLevel:             "error",
 			expSetLevelCallCount: 1,
+			expBatchDelay:        500 * time.Millisecond,
+		},
+		{
+			name:                 "invalid event batch delay",
+			nginxGateway:         invalidDelayCfg,
+			expErrString:         `eventBatching.delay: Invalid value: "invalid"`,
+			expSetLevelCallCount: 0,
 		},
 		{
 			name:                 "invalid log level",
@@ -60,6 +94,7 @@ func TestUpdateControlPlane(t *testing.T) {
 			name:                 "nil NginxGateway",
 			nginxGateway:         nil,
 			expEvent:             true,
+			expLevel:             "error",
 			expSetLevelCallCount: 1,
 		},
 		{
@@ -82,7 +117,18 @@ func TestUpdateControlPlane(t *testing.T) {
 				},
 			}
 
-			err := updateControlPlane(test.nginxGateway, logger, fakeEventRecorder, nsname, fakeLogSetter)
+			batchDelay := &events.BatchDelay{}
+			batchDelay.Set(time.Second)
+
+			err := updateControlPlane(
+				test.nginxGateway,
+				logger,
+				fakeEventRecorder,
+				nsname,
+				fakeLogSetter,
+				ngfAPI.ControllerLogLevelError,
+				batchDelay,
+			)
 
 			if test.expErrString != "" {
 				g.Expect(err).To(HaveOccurred())
@@ -100,6 +146,15 @@ func TestUpdateControlPlane(t *testing.T) {
 			}
 
 			g.Expect(fakeLogSetter.SetLevelCallCount()).To(Equal(test.expSetLevelCallCount))
+			if test.expLevel != "" {
+				g.Expect(fakeLogSetter.SetLevelArgsForCall(0)).To(Equal(test.expLevel))
+			}
+
+			if test.expErrString == "" {
+				g.Expect(batchDelay.Get()).To(Equal(test.expBatchDelay))
+			} else {
+				g.Expect(batchDelay.Get()).To(Equal(time.Second))
+			}
 		})
 	}
 }
@@ -136,3 +191,56 @@ func TestValidateLogLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		duration    ngfAPI.Duration
+		expDuration time.Duration
+		expErr      bool
+	}{
+		{
+			name:        "milliseconds",
+			duration:    "50ms",
+			expDuration: 50 * time.Millisecond,
+		},
+		{
+			name:        "minutes",
+			duration:    "5m",
+			expDuration: 5 * time.Minute,
+		},
+		{
+			name:        "no unit",
+			duration:    "10",
+			expDuration: 10 * time.Second,
+		},
+		{
+			name:     "empty",
+			duration: "",
+			expErr:   true,
+		},
+		{
+			name:     "invalid",
+			duration: "invalid",
+			expErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			d, err := parseDuration(test.duration)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(d).To(Equal(test.expDuration))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KubernetesAuthorizer authenticates tokens with a TokenReview and authorizes users with a SubjectAccessReview,
+// so that access to the debug endpoints is managed with RBAC rules for non-resource URLs.
+type KubernetesAuthorizer struct {
+	k8sClient client.Client
+}
+
+// NewKubernetesAuthorizer creates a new KubernetesAuthorizer.
+// The client scheme must include the authentication/v1 and authorization/v1 APIs.
+func NewKubernetesAuthorizer(k8sClient client.Client) *KubernetesAuthorizer {
+	return &KubernetesAuthorizer{k8sClient: k8sClient}
+}
+
+// Authorize authorizes the user identified by the token to get the path.
+func (a *KubernetesAuthorizer) Authorize(ctx context.Context, token, path string) error {
+	review := &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{Token: token},
+	}
+
+	if err := a.k8sClient.Create(ctx, review); err != nil {
+		return fmt.Errorf("failed to create TokenReview: %w", err)
+	}
+
+	if !review.Status.Authenticated {
+		return ErrUnauthenticated
+	}
+
+	user := review.Status.User
+
+	extra := make(map[string]authzv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authzv1.ExtraValue(v)
+	}
+
+	accessReview := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			NonResourceAttributes: &authzv1.NonResourceAttributes{
+				Path: path,
+				Verb: "get",
+			},
+			User:   user.Username,
+			Groups: user.Groups,
+			UID:    user.UID,
+			Extra:  extra,
+		},
+	}
+
+	if err := a.k8sClient.Create(ctx, accessReview); err != nil {
+		return fmt.Errorf("failed to create SubjectAccessReview: %w", err)
+	}
+
+	if !accessReview.Status.Allowed {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+var _ Authorizer = &KubernetesAuthorizer{}
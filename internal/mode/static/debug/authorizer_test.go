@@ -0,0 +1,118 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestKubernetesAuthorizer(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(authnv1.AddToScheme(scheme)).To(Succeed())
+	NewWithT(t).Expect(authzv1.AddToScheme(scheme)).To(Succeed())
+
+	const (
+		validToken   = "valid-token"
+		allowedPath  = "/debug/graph"
+		errorToken   = "error-token"
+		errorSARPath = "/debug/error"
+	)
+
+	createFunc := func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+		switch o := obj.(type) {
+		case *authnv1.TokenReview:
+			if o.Spec.Token == errorToken {
+				return errors.New("token review error")
+			}
+			o.Status.Authenticated = o.Spec.Token == validToken
+			o.Status.User = authnv1.UserInfo{
+				Username: "user",
+				Groups:   []string{"group"},
+				Extra:    map[string]authnv1.ExtraValue{"key": {"value"}},
+			}
+		case *authzv1.SubjectAccessReview:
+			if o.Spec.NonResourceAttributes.Path == errorSARPath {
+				return errors.New("subject access review error")
+			}
+			o.Status.Allowed = o.Spec.User == "user" &&
+				o.Spec.NonResourceAttributes.Verb == "get" &&
+				o.Spec.NonResourceAttributes.Path == allowedPath &&
+				o.Spec.Groups[0] == "group" &&
+				o.Spec.Extra["key"][0] == "value"
+		}
+		return nil
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{Create: createFunc}).
+		Build()
+
+	authorizer := NewKubernetesAuthorizer(k8sClient)
+
+	tests := []struct {
+		expErr          error
+		name            string
+		token           string
+		path            string
+		expErrSubstring string
+	}{
+		{
+			name:  "allowed",
+			token: validToken,
+			path:  allowedPath,
+		},
+		{
+			name:   "invalid token",
+			token:  "invalid-token",
+			path:   allowedPath,
+			expErr: ErrUnauthenticated,
+		},
+		{
+			name:   "forbidden path",
+			token:  validToken,
+			path:   "/debug/config",
+			expErr: ErrForbidden,
+		},
+		{
+			name:            "token review fails",
+			token:           errorToken,
+			path:            allowedPath,
+			expErrSubstring: "failed to create TokenReview",
+		},
+		{
+			name:            "subject access review fails",
+			token:           validToken,
+			path:            errorSARPath,
+			expErrSubstring: "failed to create SubjectAccessReview",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := authorizer.Authorize(context.Background(), test.token, test.path)
+
+			switch {
+			case test.expErr != nil:
+				g.Expect(err).To(MatchError(test.expErr))
+			case test.expErrSubstring != "":
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErrSubstring)))
+			default:
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
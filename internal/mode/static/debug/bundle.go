@@ -0,0 +1,195 @@
+package debug
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	goruntime "runtime"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+)
+
+// SupportBundlePath is the path of the endpoint that serves the support bundle.
+const SupportBundlePath = "/debug/support-bundle"
+
+// The files of the support bundle.
+const (
+	bundleVersionFile = "version.json"
+	bundleGraphFile   = "graph.json"
+	bundleChangesFile = "changes.json"
+	bundleMetricsFile = "metrics.txt"
+	// bundleNginxDir is the directory with the generated NGINX configuration files, which keep their paths
+	// in the NGINX container.
+	bundleNginxDir = "nginx"
+)
+
+//counterfeiter:generate . ChangeHistory
+
+// ChangeHistory provides the records of the latest generations of the NGINX configuration.
+type ChangeHistory interface {
+	// MarshalRecords returns the records as JSON.
+	MarshalRecords() ([]byte, error)
+}
+
+// BuildInfo describes the running NGF for the support bundle.
+type BuildInfo struct {
+	// Flags maps the names of the command-line flags to their values for boolean flags, and to whether they are
+	// set to the default or a user-defined value for the other flags, because their values can be sensitive.
+	Flags map[string]string `json:"flags"`
+	// Version is the version of NGF.
+	Version string `json:"version"`
+	// ImageSource is the source of the NGF image.
+	ImageSource string `json:"imageSource"`
+	// GoVersion is the version of Go that NGF was built with.
+	GoVersion string `json:"goVersion"`
+	// Plus is true if NGF uses NGINX Plus.
+	Plus bool `json:"plus"`
+}
+
+// serveSupportBundle serves a gzipped tarball with the state of NGF for attaching to support tickets:
+// the build information, the summary of the latest Graph, the latest generated NGINX configuration with the
+// contents of the secret files redacted, the records of the latest generations, and a snapshot of the metrics.
+// The files of the state that is not available yet are left out.
+func serveSupportBundle(w http.ResponseWriter, cfg Config) {
+	now := time.Now().UTC()
+
+	var buf bytes.Buffer
+	if err := writeSupportBundle(&buf, cfg, now); err != nil {
+		cfg.Logger.Error(err, "Failed to create the support bundle")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set(
+		"Content-Disposition",
+		fmt.Sprintf(`attachment; filename="ngf-support-bundle-%s.tar.gz"`, now.Format("20060102T150405Z")),
+	)
+
+	if _, err := io.Copy(w, &buf); err != nil {
+		cfg.Logger.Error(err, "Failed to write debug response")
+	}
+}
+
+func writeSupportBundle(w io.Writer, cfg Config, now time.Time) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	bundle := supportBundleWriter{tw: tw, modTime: now}
+
+	buildInfo := cfg.BuildInfo
+	buildInfo.GoVersion = goruntime.Version()
+	if err := bundle.writeJSON(bundleVersionFile, buildInfo); err != nil {
+		return err
+	}
+
+	if g := cfg.GraphGetter.GetLatestGraph(); g != nil {
+		if err := bundle.writeJSON(bundleGraphFile, summarizeGraph(g)); err != nil {
+			return err
+		}
+	}
+
+	if conf := cfg.ConfigurationGetter.GetLatestConfiguration(); conf != nil {
+		for _, f := range cfg.Generator.Generate(*conf) {
+			content := f.Content
+			if f.Type == file.TypeSecret {
+				content = []byte(redacted)
+			}
+
+			name := path.Join(bundleNginxDir, strings.TrimPrefix(f.Path, "/"))
+			if err := bundle.writeFile(name, content); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cfg.ChangeHistory != nil {
+		records, err := cfg.ChangeHistory.MarshalRecords()
+		if err != nil {
+			return fmt.Errorf("failed to marshal the change records: %w", err)
+		}
+
+		if err := bundle.writeFile(bundleChangesFile, records); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Gatherer != nil {
+		metrics, err := gatherMetrics(cfg.Gatherer)
+		if err != nil {
+			return err
+		}
+
+		if err := bundle.writeFile(bundleMetricsFile, metrics); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to close the tarball: %w", err)
+	}
+
+	return gz.Close()
+}
+
+// gatherMetrics returns the metrics in the Prometheus text format.
+func gatherMetrics(gatherer prometheus.Gatherer) ([]byte, error) {
+	families, err := gatherer.Gather()
+	if err != nil {
+		return nil, fmt.Errorf("failed to gather the metrics: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := expfmt.NewEncoder(&buf, expfmt.NewFormat(expfmt.TypeTextPlain))
+
+	for _, family := range families {
+		if err := encoder.Encode(family); err != nil {
+			return nil, fmt.Errorf("failed to encode the metrics: %w", err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+type supportBundleWriter struct {
+	tw      *tar.Writer
+	modTime time.Time
+}
+
+func (b supportBundleWriter) writeJSON(name string, v any) error {
+	content, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+
+	return b.writeFile(name, content)
+}
+
+func (b supportBundleWriter) writeFile(name string, content []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(content)),
+		ModTime: b.modTime,
+	}
+
+	if err := b.tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write the header of %s: %w", name, err)
+	}
+
+	if _, err := b.tw.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package debug_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug/debugfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestSupportBundle(t *testing.T) {
+	t.Parallel()
+
+	testGraph := &graph.Graph{
+		GatewayClass: &graph.GatewayClass{
+			Source: &gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			Valid:  true,
+		},
+	}
+
+	generatedFiles := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("http {}"),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/secrets/cert.pem",
+			Content: []byte("secret"),
+			Type:    file.TypeSecret,
+		},
+	}
+
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "nginx_reloads_total", Help: "reloads"})
+	counter.Inc()
+	registry.MustRegister(counter)
+
+	tests := []struct {
+		graph        *graph.Graph
+		conf         *dataplane.Configuration
+		historyErr   error
+		expFiles     map[string]string
+		name         string
+		expStatus    int
+		withHistory  bool
+		withGatherer bool
+	}{
+		{
+			name:         "all state",
+			graph:        testGraph,
+			conf:         &dataplane.Configuration{Version: 2},
+			withHistory:  true,
+			withGatherer: true,
+			expStatus:    http.StatusOK,
+			expFiles: map[string]string{
+				"version.json":                     "",
+				"graph.json":                       `"kind": "GatewayClass"`,
+				"nginx/etc/nginx/conf.d/http.conf": "http {}",
+				"nginx/etc/nginx/secrets/cert.pem": "<redacted>",
+				"changes.json":                     `[{"version":2}]`,
+				"metrics.txt":                      "nginx_reloads_total 1",
+			},
+		},
+		{
+			name:      "nothing built yet",
+			expStatus: http.StatusOK,
+			expFiles: map[string]string{
+				"version.json": `"version": "1.2.3"`,
+			},
+		},
+		{
+			name:        "change history error",
+			withHistory: true,
+			historyErr:  errors.New("marshal error"),
+			expStatus:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			graphGetter := &debugfakes.FakeGraphGetter{}
+			graphGetter.GetLatestGraphReturns(test.graph)
+
+			confGetter := &debugfakes.FakeConfigurationGetter{}
+			confGetter.GetLatestConfigurationReturns(test.conf)
+
+			generator := &configfakes.FakeGenerator{}
+			generator.GenerateReturns(generatedFiles)
+
+			cfg := debug.Config{
+				GraphGetter:         graphGetter,
+				ConfigurationGetter: confGetter,
+				Generator:           generator,
+				Authorizer:          &debugfakes.FakeAuthorizer{},
+				Logger:              logr.Discard(),
+				BuildInfo: debug.BuildInfo{
+					Version: "1.2.3",
+					Flags:   map[string]string{"gateway": "user-defined"},
+				},
+			}
+
+			if test.withHistory {
+				history := &debugfakes.FakeChangeHistory{}
+				history.MarshalRecordsReturns([]byte(`[{"version":2}]`), test.historyErr)
+				cfg.ChangeHistory = history
+			}
+
+			if test.withGatherer {
+				cfg.Gatherer = registry
+			}
+
+			mux := http.NewServeMux()
+			register := func(path string, handler http.Handler) error {
+				mux.Handle(path, handler)
+				return nil
+			}
+			g.Expect(debug.Register(register, cfg)).To(Succeed())
+
+			req := httptest.NewRequest(http.MethodGet, debug.SupportBundlePath, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).To(Equal(test.expStatus))
+			if test.expStatus != http.StatusOK {
+				return
+			}
+
+			g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/gzip"))
+			g.Expect(rec.Header().Get("Content-Disposition")).To(MatchRegexp(
+				`^attachment; filename="ngf-support-bundle-\d{8}T\d{6}Z\.tar\.gz"$`,
+			))
+
+			files := readTarball(g, rec.Body)
+			g.Expect(files).To(HaveLen(len(test.expFiles)))
+
+			for name, content := range test.expFiles {
+				g.Expect(files).To(HaveKey(name))
+				g.Expect(files[name]).To(ContainSubstring(content))
+			}
+		})
+	}
+}
+
+func readTarball(g *WithT, r io.Reader) map[string]string {
+	gz, err := gzip.NewReader(r)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	tr := tar.NewReader(gz)
+	files := make(map[string]string)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		g.Expect(err).ToNot(HaveOccurred())
+
+		content, err := io.ReadAll(tr)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		files[header.Name] = string(content)
+	}
+
+	return files
+}
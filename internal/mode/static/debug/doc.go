@@ -0,0 +1,9 @@
+/*
+Package debug contains the HTTP endpoints that expose the internal state of NGF for troubleshooting.
+
+The endpoints serve a summary of the latest Graph, with the accepted and rejected resources and the reasons,
+and the latest generated NGINX configuration, and a support bundle that packages the state of NGF into a tarball
+for attaching to support tickets. They are served by the metrics server, and every request must be
+authenticated and authorized by the Kubernetes API server.
+*/
+package debug
@@ -0,0 +1,213 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+
+	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+const (
+	// GraphPath is the path of the endpoint that serves the summary of the latest Graph.
+	GraphPath = "/debug/graph"
+	// ConfigPath is the path of the endpoint that serves the latest generated NGINX configuration.
+	ConfigPath = "/debug/config"
+)
+
+//counterfeiter:generate . GraphGetter
+
+// GraphGetter gets the latest Graph.
+type GraphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+//counterfeiter:generate . ConfigurationGetter
+
+// ConfigurationGetter gets the latest Configuration.
+type ConfigurationGetter interface {
+	GetLatestConfiguration() *dataplane.Configuration
+}
+
+//counterfeiter:generate . Authorizer
+
+// Authorizer checks whether the user identified by a bearer token may access a path.
+type Authorizer interface {
+	// Authorize returns ErrUnauthenticated if the token is not valid, and ErrForbidden if the user
+	// may not access the path.
+	Authorize(ctx context.Context, token, path string) error
+}
+
+var (
+	// ErrUnauthenticated is returned by Authorizer if the token is not valid.
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrForbidden is returned by Authorizer if the user may not access the path.
+	ErrForbidden = errors.New("forbidden")
+)
+
+// Config is the configuration of the debug endpoints.
+type Config struct {
+	// GraphGetter gets the latest Graph.
+	GraphGetter GraphGetter
+	// ConfigurationGetter gets the latest Configuration.
+	ConfigurationGetter ConfigurationGetter
+	// Generator generates the NGINX configuration files from the latest Configuration.
+	Generator ngxcfg.Generator
+	// Authorizer authorizes the requests.
+	Authorizer Authorizer
+	// ChangeHistory provides the records of the latest generations for the support bundle. If nil, the support
+	// bundle doesn't include the records.
+	ChangeHistory ChangeHistory
+	// Gatherer gathers the metrics for the support bundle. If nil, the support bundle doesn't include the metrics.
+	Gatherer prometheus.Gatherer
+	// Logger is the logger.
+	Logger logr.Logger
+	// BuildInfo describes the running NGF for the support bundle.
+	BuildInfo BuildInfo
+}
+
+// Register registers the debug endpoints using the register function,
+// for example, the AddMetricsServerExtraHandler method of the manager.
+func Register(register func(path string, handler http.Handler) error, cfg Config) error {
+	handlers := map[string]http.HandlerFunc{
+		GraphPath:         func(w http.ResponseWriter, _ *http.Request) { serveGraph(w, cfg) },
+		ConfigPath:        func(w http.ResponseWriter, _ *http.Request) { serveConfig(w, cfg) },
+		SupportBundlePath: func(w http.ResponseWriter, _ *http.Request) { serveSupportBundle(w, cfg) },
+	}
+
+	for _, path := range []string{GraphPath, ConfigPath, SupportBundlePath} {
+		if err := register(path, withAuthorization(path, handlers[path], cfg)); err != nil {
+			return fmt.Errorf("cannot register debug endpoint %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// withAuthorization only lets the requests through that are authorized to get the path.
+func withAuthorization(path string, next http.Handler, cfg Config) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || token == "" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		err := cfg.Authorizer.Authorize(r.Context(), token, path)
+		switch {
+		case err == nil:
+			next.ServeHTTP(w, r)
+		case errors.Is(err, ErrUnauthenticated):
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		case errors.Is(err, ErrForbidden):
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		default:
+			cfg.Logger.Error(err, "Failed to authorize request", "path", path)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}
+	})
+}
+
+func serveGraph(w http.ResponseWriter, cfg Config) {
+	g := cfg.GraphGetter.GetLatestGraph()
+	if g == nil {
+		http.Error(w, "the graph has not been built yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	writeJSON(w, cfg.Logger, summarizeGraph(g))
+}
+
+// configSnapshot is the latest generated NGINX configuration.
+type configSnapshot struct {
+	// HashedUpstreamNames maps the hashed names of the upstreams to their Services and ports, in the
+	// <namespace>/<name>:<port> format. The names are hashed if they are too long or contain unusual characters.
+	HashedUpstreamNames map[string]string `json:"hashedUpstreamNames,omitempty"`
+	// Files are the NGINX configuration files. The contents of secret files are redacted.
+	Files []configFile `json:"files"`
+	// Version is the version of the configuration.
+	Version int `json:"version"`
+}
+
+type configFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+const redacted = "<redacted>"
+
+func serveConfig(w http.ResponseWriter, cfg Config) {
+	conf := cfg.ConfigurationGetter.GetLatestConfiguration()
+	if conf == nil {
+		http.Error(w, "the configuration has not been generated yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	files := cfg.Generator.Generate(*conf)
+
+	snapshot := configSnapshot{
+		Version: conf.Version,
+		Files:   make([]configFile, 0, len(files)),
+	}
+
+	for _, f := range files {
+		content := string(f.Content)
+		if f.Type == file.TypeSecret {
+			content = redacted
+		}
+
+		snapshot.Files = append(snapshot.Files, configFile{Path: f.Path, Content: content})
+	}
+
+	snapshot.HashedUpstreamNames = hashedUpstreamNames(conf.Upstreams, conf.StreamUpstreams)
+
+	writeJSON(w, cfg.Logger, snapshot)
+}
+
+// hashedUpstreamNames returns the reverse-lookup map of the hashed upstream names. It returns nil if no name is
+// hashed.
+func hashedUpstreamNames(upstreamLists ...[]dataplane.Upstream) map[string]string {
+	var names map[string]string
+
+	for _, upstreams := range upstreamLists {
+		for _, u := range upstreams {
+			if u.HashedFrom == "" {
+				continue
+			}
+
+			if names == nil {
+				names = make(map[string]string)
+			}
+			names[u.Name] = u.HashedFrom
+		}
+	}
+
+	return names
+}
+
+func writeJSON(w http.ResponseWriter, logger logr.Logger, v any) {
+	w.Header().Set("Content-Type", "application/json")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(v); err != nil {
+		logger.Error(err, "Failed to write debug response")
+	}
+}
@@ -0,0 +1,235 @@
+package debug_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug/debugfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+
+	testGraph := &graph.Graph{
+		GatewayClass: &graph.GatewayClass{
+			Source: &gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			Valid:  true,
+		},
+	}
+
+	expGraphSummary := `{"resources": [{"kind": "GatewayClass", "name": "nginx", "valid": true}]}`
+
+	testConf := &dataplane.Configuration{
+		Version: 2,
+		Upstreams: []dataplane.Upstream{
+			{Name: "test_coffee_80"},
+			{Name: "test_tea_80_0123456789", HashedFrom: "test/tea:80"},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{Name: "test_water_443_9876543210", HashedFrom: "test/water:443"},
+		},
+	}
+
+	generatedFiles := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("http {}"),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/secrets/cert.pem",
+			Content: []byte("secret"),
+			Type:    file.TypeSecret,
+		},
+	}
+
+	expConfigSnapshot := `{
+		"version": 2,
+		"hashedUpstreamNames": {
+			"test_tea_80_0123456789": "test/tea:80",
+			"test_water_443_9876543210": "test/water:443"
+		},
+		"files": [
+			{"path": "/etc/nginx/conf.d/http.conf", "content": "http {}"},
+			{"path": "/etc/nginx/secrets/cert.pem", "content": "<redacted>"}
+		]
+	}`
+
+	tests := []struct {
+		graph        *graph.Graph
+		conf         *dataplane.Configuration
+		authErr      error
+		name         string
+		method       string
+		path         string
+		authHeader   string
+		expResponse  string
+		expStatus    int
+		expAuthorize bool
+	}{
+		{
+			name:         "graph",
+			method:       http.MethodGet,
+			path:         debug.GraphPath,
+			authHeader:   "Bearer token",
+			graph:        testGraph,
+			expStatus:    http.StatusOK,
+			expResponse:  expGraphSummary,
+			expAuthorize: true,
+		},
+		{
+			name:         "config",
+			method:       http.MethodGet,
+			path:         debug.ConfigPath,
+			authHeader:   "Bearer token",
+			conf:         testConf,
+			expStatus:    http.StatusOK,
+			expResponse:  expConfigSnapshot,
+			expAuthorize: true,
+		},
+		{
+			name:         "graph not built yet",
+			method:       http.MethodGet,
+			path:         debug.GraphPath,
+			authHeader:   "Bearer token",
+			expStatus:    http.StatusServiceUnavailable,
+			expAuthorize: true,
+		},
+		{
+			name:         "config not generated yet",
+			method:       http.MethodGet,
+			path:         debug.ConfigPath,
+			authHeader:   "Bearer token",
+			expStatus:    http.StatusServiceUnavailable,
+			expAuthorize: true,
+		},
+		{
+			name:      "method not allowed",
+			method:    http.MethodPost,
+			path:      debug.GraphPath,
+			expStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:      "no bearer token",
+			method:    http.MethodGet,
+			path:      debug.GraphPath,
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "basic auth",
+			method:     http.MethodGet,
+			path:       debug.GraphPath,
+			authHeader: "Basic dXNlcjpwYXNz",
+			expStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:         "unauthenticated",
+			method:       http.MethodGet,
+			path:         debug.GraphPath,
+			authHeader:   "Bearer token",
+			authErr:      debug.ErrUnauthenticated,
+			expStatus:    http.StatusUnauthorized,
+			expAuthorize: true,
+		},
+		{
+			name:         "forbidden",
+			method:       http.MethodGet,
+			path:         debug.ConfigPath,
+			authHeader:   "Bearer token",
+			authErr:      debug.ErrForbidden,
+			expStatus:    http.StatusForbidden,
+			expAuthorize: true,
+		},
+		{
+			name:         "authorization error",
+			method:       http.MethodGet,
+			path:         debug.ConfigPath,
+			authHeader:   "Bearer token",
+			authErr:      errors.New("error"),
+			expStatus:    http.StatusInternalServerError,
+			expAuthorize: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			graphGetter := &debugfakes.FakeGraphGetter{}
+			graphGetter.GetLatestGraphReturns(test.graph)
+
+			confGetter := &debugfakes.FakeConfigurationGetter{}
+			confGetter.GetLatestConfigurationReturns(test.conf)
+
+			generator := &configfakes.FakeGenerator{}
+			generator.GenerateReturns(generatedFiles)
+
+			authorizer := &debugfakes.FakeAuthorizer{}
+			authorizer.AuthorizeReturns(test.authErr)
+
+			mux := http.NewServeMux()
+			register := func(path string, handler http.Handler) error {
+				mux.Handle(path, handler)
+				return nil
+			}
+
+			err := debug.Register(register, debug.Config{
+				GraphGetter:         graphGetter,
+				ConfigurationGetter: confGetter,
+				Generator:           generator,
+				Authorizer:          authorizer,
+				Logger:              logr.Discard(),
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			req := httptest.NewRequest(test.method, test.path, nil)
+			if test.authHeader != "" {
+				req.Header.Set("Authorization", test.authHeader)
+			}
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).To(Equal(test.expStatus))
+
+			if test.expAuthorize {
+				g.Expect(authorizer.AuthorizeCallCount()).To(Equal(1))
+				_, token, path := authorizer.AuthorizeArgsForCall(0)
+				g.Expect(token).To(Equal("token"))
+				g.Expect(path).To(Equal(test.path))
+			} else {
+				g.Expect(authorizer.AuthorizeCallCount()).To(BeZero())
+			}
+
+			if test.expResponse != "" {
+				g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+				g.Expect(rec.Body.String()).To(MatchJSON(test.expResponse))
+			}
+		})
+	}
+}
+
+func TestRegister_Error(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	register := func(string, http.Handler) error {
+		return errors.New("register error")
+	}
+
+	err := debug.Register(register, debug.Config{})
+	g.Expect(err).To(MatchError(ContainSubstring("cannot register debug endpoint /debug/graph: register error")))
+}
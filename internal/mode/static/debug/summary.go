@@ -0,0 +1,240 @@
+package debug
+
+import (
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// graphSummary is the summary of the resources in the Graph.
+type graphSummary struct {
+	// Resources are the processed resources, sorted by kind, namespace, and name.
+	Resources []resource `json:"resources"`
+}
+
+// resource is the summary of a resource in the Graph.
+type resource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Conditions explain why the resource is or isn't accepted.
+	Conditions []condition `json:"conditions,omitempty"`
+	// Errors are the validation errors of the resource that are not reported as conditions.
+	Errors []string `json:"errors,omitempty"`
+	// Listeners are the Listeners of a Gateway.
+	Listeners []listener `json:"listeners,omitempty"`
+	// Parents are the parents that a Route references.
+	Parents []parent `json:"parents,omitempty"`
+	// Valid indicates whether NGF accepted the resource.
+	Valid bool `json:"valid"`
+	// Ignored indicates whether NGF ignores the resource, for example, because another GatewayClass or Gateway won.
+	Ignored bool `json:"ignored,omitempty"`
+}
+
+type listener struct {
+	Name       string      `json:"name"`
+	Conditions []condition `json:"conditions,omitempty"`
+	Valid      bool        `json:"valid"`
+}
+
+type parent struct {
+	// FailedCondition explains why the Route isn't attached to the parent.
+	FailedCondition *condition `json:"failedCondition,omitempty"`
+	Gateway         string     `json:"gateway,omitempty"`
+	// Route is the parent HTTPRoute that delegates path prefixes to the Route.
+	Route string `json:"route,omitempty"`
+	// Listeners are the Listeners that the Route is attached to.
+	Listeners []string `json:"listeners,omitempty"`
+	Attached  bool     `json:"attached"`
+}
+
+type condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+func summarizeGraph(g *graph.Graph) graphSummary {
+	var resources []resource
+
+	if g.GatewayClass != nil {
+		resources = append(resources, resource{
+			Kind:       kinds.GatewayClass,
+			Name:       g.GatewayClass.Source.Name,
+			Conditions: convertConditions(g.GatewayClass.Conditions),
+			Valid:      g.GatewayClass.Valid,
+		})
+	}
+
+	for nsname := range g.IgnoredGatewayClasses {
+		resources = append(resources, resource{Kind: kinds.GatewayClass, Name: nsname.Name, Ignored: true})
+	}
+
+	if g.Gateway != nil {
+		resources = append(resources, summarizeGateway(g.Gateway))
+	}
+
+	for nsname := range g.IgnoredGateways {
+		resources = append(resources, resource{
+			Kind:      kinds.Gateway,
+			Namespace: nsname.Namespace,
+			Name:      nsname.Name,
+			Ignored:   true,
+		})
+	}
+
+	for _, route := range g.Routes {
+		kind := kinds.HTTPRoute
+		if route.RouteType == graph.RouteTypeGRPC {
+			kind = kinds.GRPCRoute
+		}
+
+		resources = append(resources, resource{
+			Kind:       kind,
+			Namespace:  route.Source.GetNamespace(),
+			Name:       route.Source.GetName(),
+			Conditions: convertConditions(route.Conditions),
+			Parents:    summarizeParents(route.ParentRefs),
+			Valid:      route.Valid,
+		})
+	}
+
+	for _, route := range g.L4Routes {
+		resources = append(resources, resource{
+			Kind:       kinds.TLSRoute,
+			Namespace:  route.Source.GetNamespace(),
+			Name:       route.Source.GetName(),
+			Conditions: convertConditions(route.Conditions),
+			Parents:    summarizeParents(route.ParentRefs),
+			Valid:      route.Valid,
+		})
+	}
+
+	for key, policy := range g.NGFPolicies {
+		conds := policy.Conditions
+		for _, ancestor := range policy.Ancestors {
+			conds = append(conds, ancestor.Conditions...)
+		}
+
+		resources = append(resources, resource{
+			Kind:       key.GVK.Kind,
+			Namespace:  key.NsName.Namespace,
+			Name:       key.NsName.Name,
+			Conditions: convertConditions(conds),
+			Valid:      policy.Valid,
+		})
+	}
+
+	for nsname, policy := range g.BackendTLSPolicies {
+		resources = append(resources, resource{
+			Kind:       kinds.BackendTLSPolicy,
+			Namespace:  nsname.Namespace,
+			Name:       nsname.Name,
+			Conditions: convertConditions(policy.Conditions),
+			Valid:      policy.Valid,
+			Ignored:    policy.Ignored,
+		})
+	}
+
+	if g.NginxProxy != nil {
+		errs := make([]string, 0, len(g.NginxProxy.ErrMsgs))
+		for _, err := range g.NginxProxy.ErrMsgs {
+			errs = append(errs, err.Error())
+		}
+
+		resources = append(resources, resource{
+			Kind:   kinds.NginxProxy,
+			Name:   g.NginxProxy.Source.Name,
+			Errors: errs,
+			Valid:  g.NginxProxy.Valid,
+		})
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Kind != resources[j].Kind {
+			return resources[i].Kind < resources[j].Kind
+		}
+		if resources[i].Namespace != resources[j].Namespace {
+			return resources[i].Namespace < resources[j].Namespace
+		}
+		return resources[i].Name < resources[j].Name
+	})
+
+	return graphSummary{Resources: resources}
+}
+
+func summarizeGateway(gw *graph.Gateway) resource {
+	listeners := make([]listener, 0, len(gw.Listeners))
+	for _, l := range gw.Listeners {
+		listeners = append(listeners, listener{
+			Name:       l.Name,
+			Conditions: convertConditions(l.Conditions),
+			Valid:      l.Valid,
+		})
+	}
+
+	nsname := client.ObjectKeyFromObject(gw.Source)
+
+	return resource{
+		Kind:       kinds.Gateway,
+		Namespace:  nsname.Namespace,
+		Name:       nsname.Name,
+		Conditions: convertConditions(gw.Conditions),
+		Listeners:  listeners,
+		Valid:      gw.Valid,
+	}
+}
+
+func summarizeParents(refs []graph.ParentRef) []parent {
+	parents := make([]parent, 0, len(refs))
+
+	for _, ref := range refs {
+		var p parent
+		if ref.ParentRoute != nil {
+			p.Route = ref.ParentRoute.String()
+		} else {
+			p.Gateway = ref.Gateway.String()
+		}
+
+		if ref.Attachment != nil {
+			p.Attached = ref.Attachment.Attached
+
+			if ref.Attachment.Attached {
+				for name := range ref.Attachment.AcceptedHostnames {
+					p.Listeners = append(p.Listeners, name)
+				}
+				sort.Strings(p.Listeners)
+			} else {
+				failed := convertConditions([]conditions.Condition{ref.Attachment.FailedCondition})
+				p.FailedCondition = &failed[0]
+			}
+		}
+
+		parents = append(parents, p)
+	}
+
+	return parents
+}
+
+func convertConditions(conds []conditions.Condition) []condition {
+	if len(conds) == 0 {
+		return nil
+	}
+
+	converted := make([]condition, 0, len(conds))
+	for _, cond := range conditions.DeduplicateConditions(conds) {
+		converted = append(converted, condition{
+			Type:    cond.Type,
+			Status:  string(cond.Status),
+			Reason:  cond.Reason,
+			Message: cond.Message,
+		})
+	}
+
+	return converted
+}
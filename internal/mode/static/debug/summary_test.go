@@ -0,0 +1,181 @@
+package debug
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestSummarizeGraph(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	testGraph := &graph.Graph{
+		IgnoredGatewayClasses: map[types.NamespacedName]*gatewayv1.GatewayClass{
+			{Name: "ignored"}: {},
+		},
+		IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
+			{Namespace: "test", Name: "ignored"}: {},
+		},
+		NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "csp"},
+				GVK:    schema.GroupVersionKind{Kind: kinds.ClientSettingsPolicy},
+			}: {
+				Conditions: []conditions.Condition{
+					{Type: "Accepted", Status: metav1.ConditionFalse, Reason: "Conflicted", Message: "conflicts"},
+				},
+				Ancestors: []graph.PolicyAncestor{
+					{
+						Conditions: []conditions.Condition{
+							{Type: "Programmed", Status: metav1.ConditionFalse, Reason: "Invalid", Message: "invalid"},
+						},
+					},
+				},
+			},
+		},
+		BackendTLSPolicies: map[types.NamespacedName]*graph.BackendTLSPolicy{
+			{Namespace: "test", Name: "btp"}: {Ignored: true},
+		},
+		NginxProxy: &graph.NginxProxy{
+			Source:  &ngfAPI.NginxProxy{ObjectMeta: metav1.ObjectMeta{Name: "np"}},
+			ErrMsgs: field.ErrorList{field.Invalid(field.NewPath("spec"), "value", "invalid")},
+		},
+		GatewayClass: &graph.GatewayClass{
+			Source: &gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			Valid:  true,
+		},
+		Gateway: &graph.Gateway{
+			Source: &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}},
+			Listeners: []*graph.Listener{
+				{Name: "http", Valid: true},
+			},
+			Valid: true,
+		},
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "coffee"}}: {
+				Source:    &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "coffee"}},
+				RouteType: graph.RouteTypeHTTP,
+				ParentRefs: []graph.ParentRef{
+					{
+						Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+						Attachment: &graph.ParentRefAttachmentStatus{
+							AcceptedHostnames: map[string][]string{"http": {"cafe.example.com"}},
+							Attached:          true,
+						},
+					},
+					{
+						Gateway: types.NamespacedName{Namespace: "test", Name: "other"},
+						Attachment: &graph.ParentRefAttachmentStatus{
+							FailedCondition: conditions.Condition{
+								Type:    "Accepted",
+								Status:  metav1.ConditionFalse,
+								Reason:  "NoMatchingParent",
+								Message: "no matching parent",
+							},
+						},
+					},
+				},
+				Conditions: []conditions.Condition{
+					{
+						Type:    "ResolvedRefs",
+						Status:  metav1.ConditionFalse,
+						Reason:  "BackendNotFound",
+						Message: "backend not found",
+					},
+				},
+				Valid: true,
+			},
+		},
+	}
+
+	expGraphSummary := graphSummary{
+		Resources: []resource{
+			{
+				Kind:      "BackendTLSPolicy",
+				Namespace: "test",
+				Name:      "btp",
+				Ignored:   true,
+			},
+			{
+				Kind:      "ClientSettingsPolicy",
+				Namespace: "test",
+				Name:      "csp",
+				Conditions: []condition{
+					{Type: "Accepted", Status: "False", Reason: "Conflicted", Message: "conflicts"},
+					{Type: "Programmed", Status: "False", Reason: "Invalid", Message: "invalid"},
+				},
+			},
+			{
+				Kind:      "Gateway",
+				Namespace: "test",
+				Name:      "gateway",
+				Listeners: []listener{{Name: "http", Valid: true}},
+				Valid:     true,
+			},
+			{
+				Kind:      "Gateway",
+				Namespace: "test",
+				Name:      "ignored",
+				Ignored:   true,
+			},
+			{
+				Kind:    "GatewayClass",
+				Name:    "ignored",
+				Ignored: true,
+			},
+			{
+				Kind:  "GatewayClass",
+				Name:  "nginx",
+				Valid: true,
+			},
+			{
+				Kind:      "HTTPRoute",
+				Namespace: "test",
+				Name:      "coffee",
+				Conditions: []condition{
+					{
+						Type:    "ResolvedRefs",
+						Status:  "False",
+						Reason:  "BackendNotFound",
+						Message: "backend not found",
+					},
+				},
+				Parents: []parent{
+					{
+						Gateway:   "test/gateway",
+						Listeners: []string{"http"},
+						Attached:  true,
+					},
+					{
+						Gateway: "test/other",
+						FailedCondition: &condition{
+							Type:    "Accepted",
+							Status:  "False",
+							Reason:  "NoMatchingParent",
+							Message: "no matching parent",
+						},
+					},
+				},
+				Valid: true,
+			},
+			{
+				Kind:   "NginxProxy",
+				Name:   "np",
+				Errors: []string{`spec: Invalid value: "value": invalid`},
+			},
+		},
+	}
+
+	g.Expect(summarizeGraph(testGraph)).To(Equal(expGraphSummary))
+}
@@ -0,0 +1,299 @@
+package static
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// DescribeQuery selects the Routes to describe. Exactly one of the fields must be set.
+type DescribeQuery struct {
+	// Route is the NamespacedName of an HTTPRoute or GRPCRoute.
+	Route *types.NamespacedName
+	// Hostname is a hostname of a Listener or Route, for example, "cafe.example.com" or "*.example.com".
+	Hostname string
+}
+
+// DescribeRoutes writes a troubleshooting report to w for the Routes in the manifests read from r
+// that match the query. For every Route, the report shows the Listeners it is bound to, the Policies attached
+// to it after conflict resolution, and the NGINX server blocks generated for its hostnames.
+func DescribeRoutes(
+	ctx context.Context,
+	cfg config.GenerateConfig,
+	r io.Reader,
+	query DescribeQuery,
+	w io.Writer,
+) error {
+	if (query.Route == nil) == (query.Hostname == "") {
+		return errors.New("either a Route or a hostname must be specified")
+	}
+
+	g, conf, err := buildFromManifests(ctx, cfg, r)
+	if err != nil {
+		return err
+	}
+
+	routes := findRoutes(g, query)
+	if len(routes) == 0 {
+		if query.Route != nil {
+			return fmt.Errorf("no HTTPRoute or GRPCRoute %s found", query.Route)
+		}
+		return fmt.Errorf("no Route found for hostname %q", query.Hostname)
+	}
+
+	files := ngxcfg.NewGeneratorImpl(cfg.Plus).Generate(conf)
+	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
+
+	var buf bytes.Buffer
+
+	for _, route := range routes {
+		hostnames := []string{query.Hostname}
+		if query.Route != nil {
+			hostnames = acceptedHostnames(route)
+		}
+
+		describeRoute(&buf, route, g.Gateway, mustExtractGVK)
+		describeServers(&buf, files, hostnames)
+	}
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// findRoutes returns the Routes that match the query, sorted by kind and NamespacedName.
+func findRoutes(g *graph.Graph, query DescribeQuery) []*graph.L7Route {
+	var routes []*graph.L7Route
+
+	for key, route := range g.Routes {
+		if query.Route != nil {
+			if key.NamespacedName == *query.Route {
+				routes = append(routes, route)
+			}
+			continue
+		}
+
+		if slices.Contains(acceptedHostnames(route), query.Hostname) {
+			routes = append(routes, route)
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].RouteType != routes[j].RouteType {
+			return routes[i].RouteType < routes[j].RouteType
+		}
+		return client.ObjectKeyFromObject(routes[i].Source).String() <
+			client.ObjectKeyFromObject(routes[j].Source).String()
+	})
+
+	return routes
+}
+
+// acceptedHostnames returns the sorted, deduplicated hostnames that the Route is bound to across all Listeners.
+func acceptedHostnames(route *graph.L7Route) []string {
+	var hostnames []string
+
+	for _, ref := range route.ParentRefs {
+		if ref.Attachment == nil || !ref.Attachment.Attached {
+			continue
+		}
+
+		for _, hosts := range ref.Attachment.AcceptedHostnames {
+			hostnames = append(hostnames, hosts...)
+		}
+	}
+
+	slices.Sort(hostnames)
+
+	return slices.Compact(hostnames)
+}
+
+func describeRoute(w *bytes.Buffer, route *graph.L7Route, gw *graph.Gateway, mustExtractGVK kinds.MustExtractGVK) {
+	fmt.Fprintf(w, "%s %s\n", routeKind(route), client.ObjectKeyFromObject(route.Source))
+	fmt.Fprintf(w, "  Valid: %t\n", route.Valid)
+	describeConditions(w, "  ", route.Conditions)
+
+	fmt.Fprintln(w, "  Parents:")
+	for _, ref := range route.ParentRefs {
+		if ref.ParentRoute != nil {
+			fmt.Fprintf(w, "    HTTPRoute %s", ref.ParentRoute)
+		} else {
+			fmt.Fprintf(w, "    Gateway %s", ref.Gateway)
+		}
+		if ref.SectionName != nil {
+			fmt.Fprintf(w, " (sectionName: %s)", *ref.SectionName)
+		}
+		if ref.Port != nil {
+			fmt.Fprintf(w, " (port: %d)", *ref.Port)
+		}
+		fmt.Fprintln(w)
+
+		switch {
+		case ref.Attachment == nil:
+			fmt.Fprintln(w, "      Attached: false")
+		case !ref.Attachment.Attached:
+			fmt.Fprintf(
+				w,
+				"      Attached: false (%s: %s)\n",
+				ref.Attachment.FailedCondition.Reason,
+				ref.Attachment.FailedCondition.Message,
+			)
+		default:
+			fmt.Fprintln(w, "      Attached: true")
+
+			listeners := make([]string, 0, len(ref.Attachment.AcceptedHostnames))
+			for listener := range ref.Attachment.AcceptedHostnames {
+				listeners = append(listeners, listener)
+			}
+			slices.Sort(listeners)
+
+			for _, listener := range listeners {
+				fmt.Fprintf(
+					w,
+					"      Listener %s (port %d): %s\n",
+					listener,
+					ref.Attachment.ListenerPort,
+					strings.Join(ref.Attachment.AcceptedHostnames[listener], ", "),
+				)
+			}
+		}
+	}
+
+	fmt.Fprintln(w, "  Policies:")
+	describePolicies(w, route.Policies, mustExtractGVK)
+
+	if gw != nil {
+		fmt.Fprintln(w, "  Policies inherited from the Gateway:")
+		describePolicies(w, gw.Policies, mustExtractGVK)
+	}
+}
+
+func describePolicies(w *bytes.Buffer, pols []*graph.Policy, mustExtractGVK kinds.MustExtractGVK) {
+	if len(pols) == 0 {
+		fmt.Fprintln(w, "    <none>")
+		return
+	}
+
+	sorted := slices.Clone(pols)
+	sort.Slice(sorted, func(i, j int) bool {
+		return client.ObjectKeyFromObject(sorted[i].Source).String() <
+			client.ObjectKeyFromObject(sorted[j].Source).String()
+	})
+
+	for _, pol := range sorted {
+		status := "Accepted"
+		if !pol.Valid {
+			status = "Not accepted"
+		}
+
+		fmt.Fprintf(
+			w,
+			"    %s %s: %s\n",
+			mustExtractGVK(pol.Source).Kind,
+			client.ObjectKeyFromObject(pol.Source),
+			status,
+		)
+		describeConditions(w, "      ", pol.Conditions)
+	}
+}
+
+// describeConditions writes the conditions that are not True, which explain why a resource is not fully accepted.
+func describeConditions(w *bytes.Buffer, indent string, conds []conditions.Condition) {
+	for _, cond := range conditions.DeduplicateConditions(conds) {
+		if cond.Status == "True" {
+			continue
+		}
+
+		fmt.Fprintf(w, "%s%s: %s\n", indent, cond.Reason, cond.Message)
+	}
+}
+
+// describeServers writes the generated NGINX server blocks for the hostnames.
+func describeServers(w *bytes.Buffer, files []file.File, hostnames []string) {
+	fmt.Fprintln(w, "  NGINX servers:")
+
+	var found bool
+	for _, f := range files {
+		if f.Type != file.TypeRegular {
+			continue
+		}
+
+		for _, block := range serverBlocks(f.Content, hostnames) {
+			found = true
+			fmt.Fprintf(w, "    # %s\n", f.Path)
+			for _, line := range block {
+				fmt.Fprintf(w, "    %s\n", line)
+			}
+		}
+	}
+
+	if !found {
+		fmt.Fprintln(w, "    <none>")
+	}
+
+	fmt.Fprintln(w)
+}
+
+// serverBlocks returns the lines of the server blocks in the NGINX configuration that have
+// one of the hostnames as their server_name. Blank lines are omitted.
+func serverBlocks(content []byte, hostnames []string) [][]string {
+	var (
+		blocks  [][]string
+		current []string
+		matched bool
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "server {" {
+			current = []string{line}
+			matched = false
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		if strings.TrimSpace(line) != "" {
+			current = append(current, line)
+		}
+
+		if name, ok := strings.CutPrefix(strings.TrimSpace(line), "server_name "); ok {
+			matched = slices.Contains(hostnames, strings.TrimSuffix(name, ";"))
+		}
+
+		if line == "}" {
+			if matched {
+				blocks = append(blocks, current)
+			}
+			current = nil
+		}
+	}
+
+	return blocks
+}
+
+func routeKind(route *graph.L7Route) string {
+	if route.RouteType == graph.RouteTypeGRPC {
+		return kinds.GRPCRoute
+	}
+	return kinds.HTTPRoute
+}
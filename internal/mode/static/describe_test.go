@@ -0,0 +1,162 @@
+package static
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+)
+
+func TestDescribeRoutes(t *testing.T) {
+	t.Parallel()
+
+	const policiesManifest = `
+apiVersion: gateway.nginx.org/v1alpha1
+kind: ClientSettingsPolicy
+metadata:
+  name: csp-a
+  namespace: test
+spec:
+  targetRef:
+    group: gateway.networking.k8s.io
+    kind: HTTPRoute
+    name: coffee
+  body:
+    maxSize: 10m
+---
+apiVersion: gateway.nginx.org/v1alpha1
+kind: ClientSettingsPolicy
+metadata:
+  name: csp-b
+  namespace: test
+spec:
+  targetRef:
+    group: gateway.networking.k8s.io
+    kind: HTTPRoute
+    name: coffee
+  body:
+    maxSize: 20m
+`
+
+	cfg := config.GenerateConfig{
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+	}
+
+	manifests := strings.Join(
+		[]string{
+			gatewayClassManifest,
+			gatewayManifest,
+			routeManifest,
+			serviceManifest,
+			endpointSliceManifest,
+			policiesManifest,
+		},
+		"---",
+	)
+
+	expReport := []string{
+		"HTTPRoute test/coffee\n  Valid: true\n",
+		"    Gateway test/gateway\n      Attached: true\n      Listener http (port 80): cafe.example.com\n",
+		"  Policies:\n    ClientSettingsPolicy test/csp-a: Accepted\n",
+		"    ClientSettingsPolicy test/csp-b: Not accepted\n      Conflicted: Conflicts with another ClientSettingsPolicy\n",
+		"  Policies inherited from the Gateway:\n    <none>\n",
+		"  NGINX servers:\n    # /etc/nginx/conf.d/http.conf\n    server {\n",
+		"        server_name cafe.example.com;\n",
+		"        location /coffee/ {\n",
+	}
+
+	tests := []struct {
+		query           DescribeQuery
+		name            string
+		expErrSubstring string
+		expReport       []string
+	}{
+		{
+			name:      "route",
+			query:     DescribeQuery{Route: &types.NamespacedName{Namespace: "test", Name: "coffee"}},
+			expReport: expReport,
+		},
+		{
+			name:      "hostname",
+			query:     DescribeQuery{Hostname: "cafe.example.com"},
+			expReport: expReport,
+		},
+		{
+			name:            "route not found",
+			query:           DescribeQuery{Route: &types.NamespacedName{Namespace: "test", Name: "tea"}},
+			expErrSubstring: "no HTTPRoute or GRPCRoute test/tea found",
+		},
+		{
+			name:            "hostname not found",
+			query:           DescribeQuery{Hostname: "tea.example.com"},
+			expErrSubstring: `no Route found for hostname "tea.example.com"`,
+		},
+		{
+			name:            "empty query",
+			query:           DescribeQuery{},
+			expErrSubstring: "either a Route or a hostname must be specified",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var buf bytes.Buffer
+			err := DescribeRoutes(context.Background(), cfg, strings.NewReader(manifests), test.query, &buf)
+			if test.expErrSubstring != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErrSubstring)))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			for _, expected := range test.expReport {
+				g.Expect(buf.String()).To(ContainSubstring(expected))
+			}
+		})
+	}
+}
+
+func TestServerBlocks(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	content := `
+server {
+    listen 80 default_server;
+}
+
+server {
+    listen 80;
+
+    server_name foo.example.com;
+
+    location / {
+    }
+}
+
+server {
+    listen 80;
+    server_name bar.example.com;
+}
+`
+
+	blocks := serverBlocks([]byte(content), []string{"foo.example.com"})
+	g.Expect(blocks).To(Equal([][]string{
+		{
+			"server {",
+			"    listen 80;",
+			"    server_name foo.example.com;",
+			"    location / {",
+			"    }",
+			"}",
+		},
+	}))
+}
@@ -0,0 +1,126 @@
+package static
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	externalCertificateCertExt = ".crt"
+	externalCertificateKeyExt  = ".key"
+)
+
+// externalCertificatesEvent is sent to the event loop when the certificates of the certificate source changed,
+// so that the configuration is rebuilt with the new certificates.
+type externalCertificatesEvent struct {
+	// certificates are all certificates of the source by name.
+	certificates map[string]*graph.ExternalCertificate
+}
+
+// certificateSource is a source of the certificates that listeners reference as ExternalCertificates,
+// such as a HashiCorp Vault or a Secrets Store CSI driver volume.
+type certificateSource interface {
+	// load returns all certificates of the source by name.
+	load() (map[string]*graph.ExternalCertificate, error)
+}
+
+// fileCertificateSource loads the certificates from the files of a directory: the certificate and the key
+// of the ExternalCertificate <name> are the files <name>.crt and <name>.key. The directory is usually a volume that
+// a secret store keeps up to date, such as a Secrets Store CSI driver volume with the Vault provider,
+// or the directory that the Vault Agent renders the certificates to.
+type fileCertificateSource struct {
+	dir string
+}
+
+func (s fileCertificateSource) load() (map[string]*graph.ExternalCertificate, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the certificates directory %s: %w", s.dir, err)
+	}
+
+	certs := make(map[string]*graph.ExternalCertificate)
+
+	for _, entry := range entries {
+		// The atomic writer of the Kubernetes volumes, which the CSI drivers use, keeps the files
+		// in hidden directories, such as ..data, and links them to the directory.
+		if strings.HasPrefix(entry.Name(), ".") || !strings.HasSuffix(entry.Name(), externalCertificateCertExt) {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), externalCertificateCertExt)
+
+		cert, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the certificate of %s: %w", name, err)
+		}
+
+		// A certificate without a key is invalid, which is reported in the status of the listeners.
+		key, err := os.ReadFile(filepath.Join(s.dir, name+externalCertificateKeyExt))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read the key of %s: %w", name, err)
+		}
+
+		certs[name] = &graph.ExternalCertificate{
+			Cert: cert,
+			Key:  key,
+		}
+	}
+
+	return certs, nil
+}
+
+// externalCertificatesWatcher reloads the certificates of a certificate source, and notifies the event loop
+// when they changed, so that the rotated certificates are configured without a restart.
+type externalCertificatesWatcher struct {
+	source certificateSource
+	// certs are the certificates of the last load.
+	certs   map[string]*graph.ExternalCertificate
+	eventCh chan<- interface{}
+	logger  logr.Logger
+}
+
+// check reloads the certificates and sends an externalCertificatesEvent if they changed.
+func (w *externalCertificatesWatcher) check(ctx context.Context) {
+	certs, err := w.source.load()
+	if err != nil {
+		w.logger.Error(err, "Failed to load the external certificates")
+		return
+	}
+
+	if externalCertificatesEqual(w.certs, certs) {
+		return
+	}
+
+	w.logger.Info("External certificates changed", "count", len(certs))
+
+	select {
+	case w.eventCh <- &externalCertificatesEvent{certificates: certs}:
+		w.certs = certs
+	case <-ctx.Done():
+	}
+}
+
+func externalCertificatesEqual(certs1, certs2 map[string]*graph.ExternalCertificate) bool {
+	if len(certs1) != len(certs2) {
+		return false
+	}
+
+	for name, cert1 := range certs1 {
+		cert2, ok := certs2[name]
+		if !ok || !bytes.Equal(cert1.Cert, cert2.Cert) || !bytes.Equal(cert1.Key, cert2.Key) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,170 @@
+package static
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestFileCertificateSourceLoad(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+
+	writeFile := func(name, content string) {
+		g.Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)).To(Succeed())
+	}
+
+	writeFile("cafe.crt", "cafe-cert")
+	writeFile("cafe.key", "cafe-key")
+	writeFile("no-key.crt", "no-key-cert")
+	writeFile("tea.key", "tea-key") // no certificate
+	writeFile("README", "not a certificate")
+	g.Expect(os.Mkdir(filepath.Join(dir, "..data"), 0o700)).To(Succeed())
+	writeFile("..data/cafe.crt", "hidden-cert")
+
+	source := fileCertificateSource{dir: dir}
+
+	certs, err := source.load()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(certs).To(Equal(map[string]*graph.ExternalCertificate{
+		"cafe": {
+			Cert: []byte("cafe-cert"),
+			Key:  []byte("cafe-key"),
+		},
+		"no-key": {
+			Cert: []byte("no-key-cert"),
+		},
+	}))
+
+	source = fileCertificateSource{dir: filepath.Join(dir, "does-not-exist")}
+
+	certs, err = source.load()
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(certs).To(BeNil())
+}
+
+type fakeCertificateSource struct {
+	err   error
+	certs map[string]*graph.ExternalCertificate
+}
+
+func (s *fakeCertificateSource) load() (map[string]*graph.ExternalCertificate, error) {
+	return s.certs, s.err
+}
+
+func TestExternalCertificatesWatcherCheck(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	initialCerts := map[string]*graph.ExternalCertificate{
+		"cafe": {Cert: []byte("cert"), Key: []byte("key")},
+	}
+
+	source := &fakeCertificateSource{certs: initialCerts}
+	eventCh := make(chan interface{}, 1)
+
+	watcher := &externalCertificatesWatcher{
+		source:  source,
+		certs:   initialCerts,
+		eventCh: eventCh,
+		logger:  logr.Discard(),
+	}
+
+	// The certificates didn't change.
+	watcher.check(context.Background())
+	g.Expect(eventCh).ToNot(Receive())
+
+	// The certificate was rotated.
+	rotatedCerts := map[string]*graph.ExternalCertificate{
+		"cafe": {Cert: []byte("rotated-cert"), Key: []byte("rotated-key")},
+	}
+	source.certs = rotatedCerts
+
+	watcher.check(context.Background())
+	g.Expect(eventCh).To(Receive(Equal(&externalCertificatesEvent{certificates: rotatedCerts})))
+
+	watcher.check(context.Background())
+	g.Expect(eventCh).ToNot(Receive())
+
+	// The source failed to load, so the previous certificates stay.
+	source.certs = nil
+	source.err = os.ErrPermission
+
+	watcher.check(context.Background())
+	g.Expect(eventCh).ToNot(Receive())
+	g.Expect(watcher.certs).To(Equal(rotatedCerts))
+
+	// A certificate was removed.
+	source.certs = map[string]*graph.ExternalCertificate{}
+	source.err = nil
+
+	watcher.check(context.Background())
+	g.Expect(eventCh).To(Receive(Equal(&externalCertificatesEvent{certificates: source.certs})))
+}
+
+func TestExternalCertificatesEqual(t *testing.T) {
+	t.Parallel()
+
+	certs := map[string]*graph.ExternalCertificate{
+		"cafe": {Cert: []byte("cert"), Key: []byte("key")},
+	}
+
+	tests := []struct {
+		certs1 map[string]*graph.ExternalCertificate
+		certs2 map[string]*graph.ExternalCertificate
+		name   string
+		equal  bool
+	}{
+		{
+			name:  "both empty",
+			equal: true,
+		},
+		{
+			name:   "same certificates",
+			certs1: certs,
+			certs2: map[string]*graph.ExternalCertificate{
+				"cafe": {Cert: []byte("cert"), Key: []byte("key")},
+			},
+			equal: true,
+		},
+		{
+			name:   "different key",
+			certs1: certs,
+			certs2: map[string]*graph.ExternalCertificate{
+				"cafe": {Cert: []byte("cert"), Key: []byte("new-key")},
+			},
+			equal: false,
+		},
+		{
+			name:   "different name",
+			certs1: certs,
+			certs2: map[string]*graph.ExternalCertificate{
+				"tea": {Cert: []byte("cert"), Key: []byte("key")},
+			},
+			equal: false,
+		},
+		{
+			name:   "certificate added",
+			certs1: nil,
+			certs2: certs,
+			equal:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(externalCertificatesEqual(test.certs1, test.certs2)).To(Equal(test.equal))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package static
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller"
+)
+
+// externalDNSHostnameAnnotation is the annotation of a Service with the hostnames that external-dns publishes
+// DNS records for, which point to the addresses of the Service.
+const externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+
+// externalDNSAnnotator annotates the Service that fronts NGF with the hostnames of the Gateway, so that
+// external-dns maintains the DNS records of the hostnames. The annotation is written with server-side apply,
+// so that NGF only owns the annotation, and it is removed when there are no hostnames.
+// Like the status updater, it only writes the annotation when NGF is the leader. Before it is enabled, it saves
+// the latest hostnames, which it writes when it is enabled.
+type externalDNSAnnotator struct {
+	k8sClient client.Client
+	logger    logr.Logger
+	service   types.NamespacedName
+	// latest is the latest hostnames.
+	latest []string
+	// written is the latest hostnames that were written successfully.
+	written []string
+	lock    sync.Mutex
+	enabled bool
+	// initialized is true if the hostnames were written at least once.
+	initialized bool
+}
+
+// newExternalDNSAnnotator creates a new externalDNSAnnotator for the Service.
+func newExternalDNSAnnotator(
+	k8sClient client.Client,
+	service types.NamespacedName,
+	logger logr.Logger,
+) *externalDNSAnnotator {
+	return &externalDNSAnnotator{
+		k8sClient: k8sClient,
+		service:   service,
+		logger:    logger,
+	}
+}
+
+// Write writes the hostnames if the annotator is enabled, and saves them otherwise.
+func (a *externalDNSAnnotator) Write(ctx context.Context, hostnames []string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.latest = hostnames
+
+	if a.enabled {
+		a.write(ctx)
+	}
+}
+
+// Enable enables the annotator, writing the latest saved hostnames.
+func (a *externalDNSAnnotator) Enable(ctx context.Context) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.enabled = true
+	a.write(ctx)
+}
+
+func (a *externalDNSAnnotator) write(ctx context.Context) {
+	if a.initialized && slices.Equal(a.latest, a.written) {
+		return
+	}
+
+	svc := &apiv1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.service.Name,
+			Namespace: a.service.Namespace,
+		},
+	}
+
+	// Without the annotation in the applied object, server-side apply removes the annotation that we own.
+	if len(a.latest) > 0 {
+		svc.Annotations = map[string]string{
+			externalDNSHostnameAnnotation: strings.Join(a.latest, ","),
+		}
+	}
+
+	err := a.k8sClient.Patch(
+		ctx,
+		svc,
+		client.Apply,
+		client.FieldOwner(controller.FieldManager),
+		client.ForceOwnership,
+	)
+	if err != nil {
+		a.logger.Error(
+			err,
+			"Failed to annotate the Service with the hostnames for external-dns",
+			"namespace", a.service.Namespace,
+			"name", a.service.Name,
+		)
+		return
+	}
+
+	a.written = a.latest
+	a.initialized = true
+}
@@ -0,0 +1,82 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestExternalDNSAnnotator(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiv1.AddToScheme(scheme)).To(Succeed())
+
+	svcNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "nginx-gateway"}
+
+	applyFuncs := helpers.ApplyAsUpdateInterceptorFuncs()
+	patches := 0
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(&apiv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: svcNsName.Namespace, Name: svcNsName.Name},
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(
+				ctx context.Context,
+				c client.WithWatch,
+				obj client.Object,
+				patch client.Patch,
+				opts ...client.PatchOption,
+			) error {
+				patches++
+				return applyFuncs.Patch(ctx, c, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	getAnnotations := func() map[string]string {
+		var svc apiv1.Service
+		g.Expect(k8sClient.Get(context.Background(), svcNsName, &svc)).To(Succeed())
+
+		return svc.Annotations
+	}
+
+	annotator := newExternalDNSAnnotator(k8sClient, svcNsName, logr.Discard())
+
+	// The hostnames are saved until the annotator is enabled.
+	annotator.Write(context.Background(), []string{"cafe.example.com"})
+	g.Expect(patches).To(BeZero())
+
+	annotator.Enable(context.Background())
+	g.Expect(patches).To(Equal(1))
+	g.Expect(getAnnotations()).To(HaveKeyWithValue(externalDNSHostnameAnnotation, "cafe.example.com"))
+
+	// Hostnames that didn't change are not written again.
+	annotator.Write(context.Background(), []string{"cafe.example.com"})
+	g.Expect(patches).To(Equal(1))
+
+	annotator.Write(context.Background(), []string{"cafe.example.com", "tea.example.com"})
+	g.Expect(patches).To(Equal(2))
+	g.Expect(getAnnotations()).To(
+		HaveKeyWithValue(externalDNSHostnameAnnotation, "cafe.example.com,tea.example.com"),
+	)
+
+	// The annotation is removed when there are no hostnames.
+	annotator.Write(context.Background(), nil)
+	g.Expect(patches).To(Equal(3))
+	g.Expect(getAnnotations()).ToNot(HaveKey(externalDNSHostnameAnnotation))
+}
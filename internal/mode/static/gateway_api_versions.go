@@ -0,0 +1,185 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	frameworkStatus "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
+)
+
+// v1beta1OnlyKinds holds the kinds of the Gateway API resources that the API server serves in v1beta1, but not in
+// v1. It happens in the clusters in the middle of an upgrade from the Gateway API CRDs that predate v1.
+// NGF watches and writes the statuses of these resources in v1beta1, and converts them to v1 internally, so that
+// they are reconciled during the upgrade.
+type v1beta1OnlyKinds map[string]struct{}
+
+// gatewayAPIKindsWithV1beta1 are the kinds of the Gateway API resources that have both the v1beta1 and the v1
+// versions.
+var gatewayAPIKindsWithV1beta1 = []string{kinds.GatewayClass, kinds.Gateway, kinds.HTTPRoute}
+
+// detectV1beta1OnlyKinds returns the kinds of the Gateway API resources that are served in v1beta1, but not in v1.
+// If a kind is served in neither version, NGF keeps using v1 for it.
+func detectV1beta1OnlyKinds(mapper meta.RESTMapper, logger logr.Logger) (v1beta1OnlyKinds, error) {
+	detected := make(v1beta1OnlyKinds)
+
+	for _, kind := range gatewayAPIKindsWithV1beta1 {
+		gk := schema.GroupKind{Group: gatewayv1.GroupName, Kind: kind}
+
+		_, err := mapper.RESTMapping(gk, gatewayv1.GroupVersion.Version)
+		if err == nil {
+			continue
+		}
+
+		if !meta.IsNoMatchError(err) {
+			return nil, fmt.Errorf("cannot get the REST mapping of %s: %w", gk, err)
+		}
+
+		if _, err := mapper.RESTMapping(gk, gatewayv1beta1.GroupVersion.Version); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("cannot get the REST mapping of %s: %w", gk, err)
+		}
+
+		detected[kind] = struct{}{}
+	}
+
+	if len(detected) > 0 {
+		detectedKinds := make([]string, 0, len(detected))
+		for kind := range detected {
+			detectedKinds = append(detectedKinds, kind)
+		}
+		slices.Sort(detectedKinds)
+
+		logger.Info(
+			"Gateway API resources are not served in v1; watching them in v1beta1 until the Gateway API CRDs "+
+				"are upgraded. Restart NGINX Gateway Fabric after the upgrade to watch them in v1",
+			"kinds", detectedKinds,
+		)
+	}
+
+	return detected, nil
+}
+
+func (k v1beta1OnlyKinds) has(kind string) bool {
+	_, exists := k[kind]
+	return exists
+}
+
+// servedObject returns an object of the version that the API server serves for the v1 object obj. It returns obj
+// if the API server serves v1. The returned object has the namespace and the name of obj.
+func (k v1beta1OnlyKinds) servedObject(obj client.Object) client.Object {
+	var served client.Object
+
+	switch obj.(type) {
+	case *gatewayv1.GatewayClass:
+		if k.has(kinds.GatewayClass) {
+			served = &gatewayv1beta1.GatewayClass{}
+		}
+	case *gatewayv1.Gateway:
+		if k.has(kinds.Gateway) {
+			served = &gatewayv1beta1.Gateway{}
+		}
+	case *gatewayv1.HTTPRoute:
+		if k.has(kinds.HTTPRoute) {
+			served = &gatewayv1beta1.HTTPRoute{}
+		}
+	}
+
+	if served == nil {
+		return obj
+	}
+
+	served.SetNamespace(obj.GetNamespace())
+	served.SetName(obj.GetName())
+
+	return served
+}
+
+// servedObjectList returns a list of the version that the API server serves for the v1 list. It returns list
+// if the API server serves v1.
+func (k v1beta1OnlyKinds) servedObjectList(list client.ObjectList) client.ObjectList {
+	switch list.(type) {
+	case *gatewayv1.GatewayClassList:
+		if k.has(kinds.GatewayClass) {
+			return &gatewayv1beta1.GatewayClassList{}
+		}
+	case *gatewayv1.GatewayList:
+		if k.has(kinds.Gateway) {
+			return &gatewayv1beta1.GatewayList{}
+		}
+	case *gatewayv1.HTTPRouteList:
+		if k.has(kinds.HTTPRoute) {
+			return &gatewayv1beta1.HTTPRouteList{}
+		}
+	}
+
+	return list
+}
+
+// convertToV1 converts a v1beta1 Gateway API object to v1. The v1beta1 types are defined as the v1 types, so the
+// converted object shares the fields of obj. Other objects are returned as is.
+func convertToV1(obj client.Object) client.Object {
+	switch o := obj.(type) {
+	case *gatewayv1beta1.GatewayClass:
+		return (*gatewayv1.GatewayClass)(o)
+	case *gatewayv1beta1.Gateway:
+		return (*gatewayv1.Gateway)(o)
+	case *gatewayv1beta1.HTTPRoute:
+		return (*gatewayv1.HTTPRoute)(o)
+	default:
+		return obj
+	}
+}
+
+// v1beta1StatusUpdater writes the statuses of the Gateway API resources that are served only in v1beta1 with
+// their v1beta1 types. The status setters get the resources converted to v1.
+type v1beta1StatusUpdater struct {
+	updater frameworkStatus.GroupUpdater
+	kinds   v1beta1OnlyKinds
+}
+
+// newStatusUpdaterForServedVersions returns the updater, which writes the statuses of the resources in the
+// versions that the API server serves.
+func newStatusUpdaterForServedVersions(
+	updater frameworkStatus.GroupUpdater,
+	kinds v1beta1OnlyKinds,
+) frameworkStatus.GroupUpdater {
+	if len(kinds) == 0 {
+		return updater
+	}
+
+	return &v1beta1StatusUpdater{
+		updater: updater,
+		kinds:   kinds,
+	}
+}
+
+func (u *v1beta1StatusUpdater) UpdateGroup(ctx context.Context, name string, reqs ...frameworkStatus.UpdateRequest) {
+	served := make([]frameworkStatus.UpdateRequest, 0, len(reqs))
+
+	for _, r := range reqs {
+		resourceType := u.kinds.servedObject(r.ResourceType)
+		if resourceType != r.ResourceType {
+			setter := r.Setter
+			r.ResourceType = resourceType
+			r.Setter = func(obj client.Object) bool {
+				return setter(convertToV1(obj))
+			}
+		}
+
+		served = append(served, r)
+	}
+
+	u.updater.UpdateGroup(ctx, name, served...)
+}
@@ -0,0 +1,208 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	frameworkStatus "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/status/statusfakes"
+)
+
+func TestDetectV1beta1OnlyKinds(t *testing.T) {
+	t.Parallel()
+
+	v1GVK := func(kind string) schema.GroupVersionKind {
+		return gatewayv1.SchemeGroupVersion.WithKind(kind)
+	}
+	v1beta1GVK := func(kind string) schema.GroupVersionKind {
+		return gatewayv1beta1.SchemeGroupVersion.WithKind(kind)
+	}
+
+	tests := []struct {
+		expKinds      v1beta1OnlyKinds
+		name          string
+		installedGVKs []schema.GroupVersionKind
+	}{
+		{
+			name: "v1 served",
+			installedGVKs: []schema.GroupVersionKind{
+				v1GVK(kinds.GatewayClass), v1GVK(kinds.Gateway), v1GVK(kinds.HTTPRoute),
+				v1beta1GVK(kinds.GatewayClass), v1beta1GVK(kinds.Gateway), v1beta1GVK(kinds.HTTPRoute),
+			},
+			expKinds: v1beta1OnlyKinds{},
+		},
+		{
+			name: "only v1beta1 served",
+			installedGVKs: []schema.GroupVersionKind{
+				v1beta1GVK(kinds.GatewayClass), v1beta1GVK(kinds.Gateway), v1beta1GVK(kinds.HTTPRoute),
+			},
+			expKinds: v1beta1OnlyKinds{
+				kinds.GatewayClass: {},
+				kinds.Gateway:      {},
+				kinds.HTTPRoute:    {},
+			},
+		},
+		{
+			name: "mixed versions served",
+			installedGVKs: []schema.GroupVersionKind{
+				v1GVK(kinds.GatewayClass), v1GVK(kinds.Gateway), v1beta1GVK(kinds.HTTPRoute),
+			},
+			expKinds: v1beta1OnlyKinds{
+				kinds.HTTPRoute: {},
+			},
+		},
+		{
+			name:     "no versions served",
+			expKinds: v1beta1OnlyKinds{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			mapper := meta.NewDefaultRESTMapper(nil)
+			for _, gvk := range test.installedGVKs {
+				mapper.Add(gvk, meta.RESTScopeNamespace)
+			}
+
+			detected, err := detectV1beta1OnlyKinds(mapper, logr.Discard())
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(detected).To(Equal(test.expKinds))
+		})
+	}
+}
+
+func TestServedObject(t *testing.T) {
+	t.Parallel()
+
+	detected := v1beta1OnlyKinds{kinds.Gateway: {}, kinds.HTTPRoute: {}}
+
+	tests := []struct {
+		obj       client.Object
+		expObject client.Object
+		name      string
+	}{
+		{
+			name: "v1beta1 Gateway",
+			obj: &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+			},
+			expObject: &gatewayv1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+			},
+		},
+		{
+			name:      "v1beta1 HTTPRoute",
+			obj:       &gatewayv1.HTTPRoute{},
+			expObject: &gatewayv1beta1.HTTPRoute{},
+		},
+		{
+			name:      "v1 GatewayClass",
+			obj:       &gatewayv1.GatewayClass{},
+			expObject: &gatewayv1.GatewayClass{},
+		},
+		{
+			name:      "other kind",
+			obj:       &gatewayv1.GRPCRoute{},
+			expObject: &gatewayv1.GRPCRoute{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(detected.servedObject(test.obj)).To(Equal(test.expObject))
+		})
+	}
+}
+
+func TestServedObjectList(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	detected := v1beta1OnlyKinds{kinds.GatewayClass: {}}
+
+	g.Expect(detected.servedObjectList(&gatewayv1.GatewayClassList{})).To(Equal(&gatewayv1beta1.GatewayClassList{}))
+	g.Expect(detected.servedObjectList(&gatewayv1.GatewayList{})).To(Equal(&gatewayv1.GatewayList{}))
+	g.Expect(v1beta1OnlyKinds(nil).servedObjectList(&gatewayv1.HTTPRouteList{})).To(Equal(&gatewayv1.HTTPRouteList{}))
+}
+
+func TestConvertToV1(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gw := &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+	}
+
+	converted, ok := convertToV1(gw).(*gatewayv1.Gateway)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(client.ObjectKeyFromObject(converted)).To(Equal(client.ObjectKeyFromObject(gw)))
+
+	converted.Status.Conditions = []metav1.Condition{{Type: "Accepted"}}
+	g.Expect(gw.Status.Conditions).To(Equal(converted.Status.Conditions))
+
+	svc := &gatewayv1.GRPCRoute{}
+	g.Expect(convertToV1(svc)).To(BeIdenticalTo(svc))
+}
+
+func TestNewStatusUpdaterForServedVersions(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fakeUpdater := &statusfakes.FakeGroupUpdater{}
+
+	g.Expect(newStatusUpdaterForServedVersions(fakeUpdater, v1beta1OnlyKinds{})).To(BeIdenticalTo(fakeUpdater))
+
+	updater := newStatusUpdaterForServedVersions(fakeUpdater, v1beta1OnlyKinds{kinds.Gateway: {}})
+
+	var setObj client.Object
+	setter := func(obj client.Object) bool {
+		setObj = obj
+		return true
+	}
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	reqs := []frameworkStatus.UpdateRequest{
+		{
+			NsName:       gwNsName,
+			ResourceType: &gatewayv1.Gateway{},
+			Setter:       setter,
+		},
+		{
+			NsName:       types.NamespacedName{Namespace: "test", Name: "route"},
+			ResourceType: &gatewayv1.HTTPRoute{},
+			Setter:       setter,
+		},
+	}
+
+	updater.UpdateGroup(context.Background(), "test", reqs...)
+
+	g.Expect(fakeUpdater.UpdateGroupCallCount()).To(Equal(1))
+	_, name, served := fakeUpdater.UpdateGroupArgsForCall(0)
+	g.Expect(name).To(Equal("test"))
+	g.Expect(served).To(HaveLen(2))
+
+	g.Expect(served[0].NsName).To(Equal(gwNsName))
+	g.Expect(served[0].ResourceType).To(BeAssignableToTypeOf(&gatewayv1beta1.Gateway{}))
+
+	served[0].Setter(&gatewayv1beta1.Gateway{})
+	g.Expect(setObj).To(BeAssignableToTypeOf(&gatewayv1.Gateway{}))
+
+	g.Expect(served[1].ResourceType).To(BeAssignableToTypeOf(&gatewayv1.HTTPRoute{}))
+}
@@ -0,0 +1,212 @@
+package static
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+// Generate generates the NGINX configuration files for the resources in the YAML or JSON manifests read from r,
+// without connecting to a cluster. Upstream servers are resolved from the EndpointSlices in the manifests,
+// and ExternalCertificates from the files of the ExternalCertificatesDir of the config.
+// Resources of kinds that NGF doesn't process are ignored.
+func Generate(ctx context.Context, cfg config.GenerateConfig, r io.Reader) ([]file.File, error) {
+	_, conf, err := buildFromManifests(ctx, cfg, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return ngxcfg.NewGeneratorImpl(cfg.Plus).Generate(conf), nil
+}
+
+// buildFromManifests builds the Graph and the dataplane Configuration for the resources in the manifests read from r.
+func buildFromManifests(
+	ctx context.Context,
+	cfg config.GenerateConfig,
+	r io.Reader,
+) (*graph.Graph, dataplane.Configuration, error) {
+	registered := registeredPolicies(policies.Registered())
+	if err := registered.addToScheme(scheme); err != nil {
+		return nil, dataplane.Configuration{}, err
+	}
+
+	objs, err := decodeObjects(r)
+	if err != nil {
+		return nil, dataplane.Configuration{}, err
+	}
+
+	var externalCerts map[string]*graph.ExternalCertificate
+	if cfg.ExternalCertificatesDir != "" {
+		externalCerts, err = fileCertificateSource{dir: cfg.ExternalCertificatesDir}.load()
+		if err != nil {
+			return nil, dataplane.Configuration{}, fmt.Errorf("cannot load external certificates: %w", err)
+		}
+	}
+
+	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
+	clusterState, endpointSlices := buildClusterState(objs, externalCerts, mustExtractGVK)
+
+	g := graph.BuildGraph(
+		clusterState,
+		cfg.GatewayCtlrName,
+		cfg.GatewayClassName,
+		createValidators(mustExtractGVK, cfg.Plus, registered),
+		nil,
+		nil,
+	)
+
+	if g.GatewayClass == nil || !g.GatewayClass.Valid {
+		return nil, dataplane.Configuration{}, fmt.Errorf(
+			"no valid GatewayClass %q found for controller %q",
+			cfg.GatewayClassName,
+			cfg.GatewayCtlrName,
+		)
+	}
+
+	if g.Gateway == nil {
+		return nil, dataplane.Configuration{}, fmt.Errorf("no Gateway found for GatewayClass %q", cfg.GatewayClassName)
+	}
+
+	conf := dataplane.BuildConfiguration(ctx, g, resolver.NewStaticServiceResolver(endpointSlices), 1)
+
+	return g, conf, nil
+}
+
+func decodeObjects(r io.Reader) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+
+	var objs []client.Object
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading manifest: %w", err)
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		runtimeObj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error decoding manifest: %w", err)
+		}
+
+		if obj, ok := runtimeObj.(client.Object); ok {
+			objs = append(objs, obj)
+		}
+	}
+
+	return objs, nil
+}
+
+func buildClusterState(
+	objs []client.Object,
+	externalCerts map[string]*graph.ExternalCertificate,
+	mustExtractGVK kinds.MustExtractGVK,
+) (graph.ClusterState, []discoveryV1.EndpointSlice) {
+	state := graph.ClusterState{
+		GatewayClasses:        make(map[types.NamespacedName]*gatewayv1.GatewayClass),
+		Gateways:              make(map[types.NamespacedName]*gatewayv1.Gateway),
+		HTTPRoutes:            make(map[types.NamespacedName]*gatewayv1.HTTPRoute),
+		Services:              make(map[types.NamespacedName]*apiv1.Service),
+		Namespaces:            make(map[types.NamespacedName]*apiv1.Namespace),
+		ReferenceGrants:       make(map[types.NamespacedName]*gatewayv1beta1.ReferenceGrant),
+		Secrets:               make(map[types.NamespacedName]*apiv1.Secret),
+		CRDMetadata:           make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+		BackendTLSPolicies:    make(map[types.NamespacedName]*gatewayv1alpha3.BackendTLSPolicy),
+		ConfigMaps:            make(map[types.NamespacedName]*apiv1.ConfigMap),
+		NginxProxies:          make(map[types.NamespacedName]*ngfAPI.NginxProxy),
+		GRPCRoutes:            make(map[types.NamespacedName]*gatewayv1.GRPCRoute),
+		TLSRoutes:             make(map[types.NamespacedName]*gatewayv1alpha2.TLSRoute),
+		NGFPolicies:           make(map[graph.PolicyKey]policies.Policy),
+		SnippetsFilters:       make(map[types.NamespacedName]*ngfAPI.SnippetsFilter),
+		RateLimitFilters:      make(map[types.NamespacedName]*ngfAPI.RateLimitFilter),
+		ResponseHeaderFilters: make(map[types.NamespacedName]*ngfAPI.ResponseHeaderFilter),
+		QueryParameterFilters: make(map[types.NamespacedName]*ngfAPI.QueryParameterFilter),
+		ABTestFilters:         make(map[types.NamespacedName]*ngfAPI.ABTestFilter),
+		ExternalCertificates:  externalCerts,
+	}
+
+	var endpointSlices []discoveryV1.EndpointSlice
+
+	for _, obj := range objs {
+		nsname := client.ObjectKeyFromObject(obj)
+
+		switch o := obj.(type) {
+		case *gatewayv1.GatewayClass:
+			state.GatewayClasses[nsname] = o
+		case *gatewayv1.Gateway:
+			state.Gateways[nsname] = o
+		case *gatewayv1.HTTPRoute:
+			state.HTTPRoutes[nsname] = o
+		case *gatewayv1.GRPCRoute:
+			state.GRPCRoutes[nsname] = o
+		case *gatewayv1alpha2.TLSRoute:
+			state.TLSRoutes[nsname] = o
+		case *gatewayv1beta1.ReferenceGrant:
+			state.ReferenceGrants[nsname] = o
+		case *gatewayv1alpha3.BackendTLSPolicy:
+			state.BackendTLSPolicies[nsname] = o
+		case *apiv1.Service:
+			state.Services[nsname] = o
+		case *apiv1.Namespace:
+			state.Namespaces[nsname] = o
+		case *apiv1.Secret:
+			state.Secrets[nsname] = o
+		case *apiv1.ConfigMap:
+			state.ConfigMaps[nsname] = o
+		case *ngfAPI.NginxProxy:
+			state.NginxProxies[nsname] = o
+		case *ngfAPI.SnippetsFilter:
+			state.SnippetsFilters[nsname] = o
+		case *ngfAPI.RateLimitFilter:
+			state.RateLimitFilters[nsname] = o
+		case *ngfAPI.ResponseHeaderFilter:
+			state.ResponseHeaderFilters[nsname] = o
+		case *ngfAPI.QueryParameterFilter:
+			state.QueryParameterFilters[nsname] = o
+		case *ngfAPI.ABTestFilter:
+			state.ABTestFilters[nsname] = o
+		case *discoveryV1.EndpointSlice:
+			endpointSlices = append(endpointSlices, *o)
+		case policies.Policy:
+			key := graph.PolicyKey{NsName: nsname, GVK: mustExtractGVK(o)}
+			state.NGFPolicies[key] = o
+		}
+	}
+
+	return state, endpointSlices
+}
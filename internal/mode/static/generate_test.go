@@ -0,0 +1,252 @@
+package static
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	gatewayClassManifest = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: gateway.nginx.org/nginx-gateway-controller
+`
+	gatewayManifest = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: test
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+`
+	routeManifest = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: coffee
+  namespace: test
+spec:
+  parentRefs:
+  - name: gateway
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /coffee
+    backendRefs:
+    - name: coffee
+      port: 80
+`
+	filterRouteManifest = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: tea
+  namespace: test
+spec:
+  parentRefs:
+  - name: gateway
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /tea
+    filters:
+    - type: ExtensionRef
+      extensionRef:
+        group: gateway.nginx.org
+        kind: QueryParameterFilter
+        name: version
+    backendRefs:
+    - name: coffee
+      port: 80
+`
+	queryParameterFilterManifest = `
+apiVersion: gateway.nginx.org/v1alpha1
+kind: QueryParameterFilter
+metadata:
+  name: version
+  namespace: test
+spec:
+  set:
+  - name: version
+    value: v2
+`
+	serviceManifest = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: coffee
+  namespace: test
+spec:
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+`
+	endpointSliceManifest = `
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata:
+  name: coffee-abc
+  namespace: test
+  labels:
+    kubernetes.io/service-name: coffee
+addressType: IPv4
+ports:
+- name: http
+  port: 8080
+endpoints:
+- addresses:
+  - 10.0.0.1
+  conditions:
+    ready: true
+`
+	deploymentManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coffee
+  namespace: test
+`
+	unregisteredKindManifest = `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: cafe
+  namespace: test
+`
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.GenerateConfig{
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+	}
+
+	join := func(manifests ...string) string {
+		return strings.Join(manifests, "---")
+	}
+
+	tests := []struct {
+		name            string
+		manifests       string
+		expErrSubstring string
+		expHTTPConf     []string
+	}{
+		{
+			name: "valid manifests",
+			manifests: join(
+				gatewayClassManifest,
+				gatewayManifest,
+				routeManifest,
+				serviceManifest,
+				endpointSliceManifest,
+				deploymentManifest,
+				unregisteredKindManifest,
+				"",
+			),
+			expHTTPConf: []string{
+				"server_name cafe.example.com;",
+				"location /coffee/ {",
+				"server 10.0.0.1:8080;",
+			},
+		},
+		{
+			name: "route with an extensionRef filter",
+			manifests: join(
+				gatewayClassManifest,
+				gatewayManifest,
+				filterRouteManifest,
+				queryParameterFilterManifest,
+				serviceManifest,
+				endpointSliceManifest,
+			),
+			expHTTPConf: []string{
+				"location /tea/ {",
+				`set $ngf_query_parameter_modifications "`,
+				"server 10.0.0.1:8080;",
+			},
+		},
+		{
+			name:            "no GatewayClass",
+			manifests:       join(gatewayManifest, routeManifest),
+			expErrSubstring: `no valid GatewayClass "nginx"`,
+		},
+		{
+			name:            "no Gateway",
+			manifests:       join(gatewayClassManifest, routeManifest),
+			expErrSubstring: `no Gateway found for GatewayClass "nginx"`,
+		},
+		{
+			name:            "invalid manifest",
+			manifests:       "apiVersion: v1\nkind: Service\nspec: [",
+			expErrSubstring: "error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			files, err := Generate(context.Background(), cfg, strings.NewReader(test.manifests))
+			if test.expErrSubstring != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErrSubstring)))
+				g.Expect(files).To(BeNil())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+
+			// the upstreams are generated in a separate file from the rest of the HTTP configuration
+			var httpConf strings.Builder
+			for _, f := range files {
+				if f.Path == "/etc/nginx/conf.d/http.conf" || f.Path == "/etc/nginx/conf.d/upstreams.conf" {
+					httpConf.Write(f.Content)
+				}
+			}
+
+			g.Expect(httpConf.Len()).ToNot(BeZero())
+			for _, expected := range test.expHTTPConf {
+				g.Expect(httpConf.String()).To(ContainSubstring(expected))
+			}
+		})
+	}
+}
+
+func TestBuildClusterStateInitializesAllResources(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	state, _ := buildClusterState(nil, map[string]*graph.ExternalCertificate{}, kinds.NewMustExtractGKV(scheme))
+
+	// every resource of the ClusterState must be stored by buildClusterState, otherwise the resources
+	// of a new kind are silently dropped from the manifests
+	v := reflect.ValueOf(state)
+	for i := range v.NumField() {
+		g.Expect(v.Field(i).IsNil()).To(BeFalse(), "ClusterState.%s is not initialized", v.Type().Field(i).Name)
+	}
+}
@@ -3,6 +3,7 @@ package static
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,16 +12,19 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	frameworkStatus "github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
 
 	ngfConfig "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
 	ngxConfig "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
@@ -33,6 +37,12 @@ import (
 
 type handlerMetricsCollector interface {
 	ObserveLastEventBatchProcessTime(time.Duration)
+	SetCertificateExpiries(map[types.NamespacedName]time.Time)
+	SetAttachmentCounts(collectors.AttachmentCounts)
+	ObserveConfigGenerated(version int)
+	ObserveConfigApplied(version int)
+	IncFastPathReloads()
+	ObserveFirstConfigApplied(time.Duration)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -68,18 +78,36 @@ type eventHandlerConfig struct {
 	k8sClient client.Client
 	// logLevelSetter is used to update the logging level.
 	logLevelSetter logLevelSetter
+	// defaultLogLevel is the logging level to use if the NginxGateway resource is deleted.
+	defaultLogLevel ngfAPI.ControllerLogLevel
+	// eventBatchDelay is the delay of the event loop before it handles a batch.
+	eventBatchDelay *events.BatchDelay
 	// eventRecorder records events for Kubernetes resources.
 	eventRecorder record.EventRecorder
 	// usageReportConfig contains the configuration for NGINX Plus usage reporting.
 	usageReportConfig *ngfConfig.UsageReportConfig
 	// nginxConfiguredOnStartChecker sets the health of the Pod to Ready once we've written out our initial config.
 	nginxConfiguredOnStartChecker *nginxConfiguredOnStartChecker
+	// hostnameReportWriter writes the HostnameReport of the Gateway. It is nil if the HostnameReport feature
+	// is disabled.
+	hostnameReportWriter *hostnameReportWriter
+	// externalDNSAnnotator annotates the Service that fronts NGF with the hostnames of the Gateway.
+	// It is nil if the ExternalDNS feature is disabled.
+	externalDNSAnnotator *externalDNSAnnotator
+	// auditTrail records the generations of the NGINX configuration. If nil, the generations are not recorded.
+	auditTrail *auditTrail
+	// referencedObjects tracks the Secrets and the ConfigMaps that the Graph references. If nil, they are
+	// not tracked.
+	referencedObjects *referencedObjects
 	// gatewayPodConfig contains information about this Pod.
 	gatewayPodConfig ngfConfig.GatewayPodConfig
 	// controlConfigNSName is the NamespacedName of the NginxGateway config for this controller.
 	controlConfigNSName types.NamespacedName
 	// gatewayCtlrName is the name of the NGF controller.
 	gatewayCtlrName string
+	// certificateExpiryWarningWindow is the window before the expiry of a listener certificate in which
+	// warning Events are emitted for the Gateway.
+	certificateExpiryWarningWindow time.Duration
 	// updateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	updateGatewayClassStatus bool
 }
@@ -112,22 +140,64 @@ type eventHandlerImpl struct {
 	// latestConfiguration is the latest Configuration generation.
 	latestConfiguration *dataplane.Configuration
 
+	// latestGateway is the latest Gateway resource that NGF processes.
+	latestGateway *gatewayv1.Gateway
+
+	// latestCertificates are the latest certificates referenced by the listeners of the Gateway.
+	latestCertificates []listenerCertificate
+
 	// objectFilters contains all created objectFilters, with the key being a filterKey
 	objectFilters map[filterKey]objectFilter
 
 	latestReloadResult status.NginxReloadResult
 
+	// crdVersionsLogger logs the changes in the support of the installed Gateway API CRD versions.
+	crdVersionsLogger gatewayclass.CRDVersionsLogger
+
+	// upstreamDrainer keeps the upstreams that are no longer referenced in the configuration until they drained.
+	upstreamDrainer *upstreamDrainer
+
+	// reloadHealthCheckBackoff is the backoff of the health checks of NGINX after a reload.
+	reloadHealthCheckBackoff wait.Backoff
+
 	cfg  eventHandlerConfig
 	lock sync.Mutex
 
+	// nginxLock serializes the updates of the NGINX configuration by the event loop and by the verification of
+	// the NGINX configuration.
+	nginxLock sync.Mutex
+
 	// version is the current version number of the nginx config.
 	version int
+
+	// loadedVersion is the version of the nginx config that NGINX last loaded successfully.
+	// It is protected by nginxLock.
+	loadedVersion int
+
+	// nginxRestarted is true if NGINX was restarted because it was unhealthy, and the configuration that is not
+	// in the nginx conf files hasn't been restored since. It is protected by nginxLock.
+	nginxRestarted bool
+
+	// lastAppliedConfiguration is the last configuration that NGINX applied successfully. NGINX is rolled back to
+	// it if it fails to apply a new configuration. It is only used by the event loop.
+	lastAppliedConfiguration *dataplane.Configuration
+
+	// quarantinedResources are the Routes and the Policies that are excluded from the configuration, because NGINX
+	// failed to generate or apply the configuration with them, with the messages of their conditions.
+	// It is only used by the event loop.
+	quarantinedResources map[configResource]string
+
+	// statusesStale is true if the connection to the API server recovered, and the statuses that couldn't be
+	// written while NGF was disconnected must be written again, even if the resources didn't change.
+	statusesStale bool
 }
 
 // newEventHandlerImpl creates a new eventHandlerImpl.
 func newEventHandlerImpl(cfg eventHandlerConfig) *eventHandlerImpl {
 	handler := &eventHandlerImpl{
-		cfg: cfg,
+		cfg:                      cfg,
+		upstreamDrainer:          newUpstreamDrainer(),
+		reloadHealthCheckBackoff: defaultReloadHealthCheckBackoff,
 	}
 
 	handler.objectFilters = map[filterKey]objectFilter{
@@ -183,35 +253,73 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 
 	changeType, gr := h.cfg.processor.Process()
 
+	if changeType == state.ClusterStateChange && h.cfg.referencedObjects != nil {
+		gr = h.captureReferencedObjects(ctx, logger, gr)
+	}
+
+	// When the drain deadline of an upstream passed, the configuration is rebuilt without the upstream,
+	// even though the resources didn't change.
+	if changeType == state.NoChange && h.upstreamDrainer.expired(time.Now()) {
+		changeType, gr = state.ClusterStateChange, h.cfg.processor.GetLatestGraph()
+	}
+
+	prevCfg := h.GetLatestConfiguration()
+
 	var err error
+	var drained []string
 	switch changeType {
 	case state.NoChange:
 		logger.Info("Handling events didn't result into NGINX configuration changes")
 		if !h.cfg.nginxConfiguredOnStartChecker.ready && h.cfg.nginxConfiguredOnStartChecker.firstBatchError == nil {
-			h.cfg.nginxConfiguredOnStartChecker.setAsReady()
+			h.setAsReady()
+		}
+
+		// NGINX keeps running the last applied configuration, so only the statuses are written again.
+		if latestGraph := h.cfg.processor.GetLatestGraph(); h.statusesStale && latestGraph != nil {
+			h.updateStatuses(ctx, logger, latestGraph)
 		}
 		return
 	case state.EndpointsOnlyChange:
 		h.version++
-		cfg := dataplane.BuildConfiguration(ctx, gr, h.cfg.serviceResolver, h.version)
+		cfg := h.withoutQuarantinedResources(dataplane.BuildConfiguration(ctx, gr, h.cfg.serviceResolver, h.version))
+
+		drained = h.upstreamDrainer.retain(prevCfg, &cfg, time.Now())
+		h.cfg.metricsCollector.ObserveConfigGenerated(h.version)
+
+		// The locations, the stream servers and the maps are configured differently for upstreams without
+		// endpoints, so the whole configuration is regenerated when an upstream gets or loses all of its endpoints.
+		// Otherwise, only the upstreams are regenerated.
+		if prevCfg != nil && upstreamsOnlyChanged(*prevCfg, cfg) {
+			if err = h.updateUpstreamServers(ctx, logger, cfg); err == nil {
+				h.cfg.metricsCollector.IncFastPathReloads()
+				h.lastAppliedConfiguration = &cfg
+				h.setLatestConfiguration(&cfg)
+				break
+			}
 
-		h.setLatestConfiguration(&cfg)
+			logger.Error(err, "Failed to update the upstreams, applying the whole configuration instead")
+		}
 
-		err = h.updateUpstreamServers(
-			ctx,
-			logger,
-			cfg,
-		)
+		var applied dataplane.Configuration
+		applied, err = h.applyNginxConf(ctx, logger, gr, cfg)
+		h.setLatestConfiguration(&applied)
 	case state.ClusterStateChange:
+		if gr.GatewayClass != nil {
+			h.crdVersionsLogger.Log(logger, gr.GatewayClass.Conditions)
+		}
+
+		h.updateCertificates(gr)
+		h.cfg.metricsCollector.SetAttachmentCounts(getAttachmentCounts(gr))
+
 		h.version++
-		cfg := dataplane.BuildConfiguration(ctx, gr, h.cfg.serviceResolver, h.version)
+		cfg := h.withoutQuarantinedResources(dataplane.BuildConfiguration(ctx, gr, h.cfg.serviceResolver, h.version))
+		drained = h.upstreamDrainer.retain(prevCfg, &cfg, time.Now())
 
-		h.setLatestConfiguration(&cfg)
+		h.cfg.metricsCollector.ObserveConfigGenerated(h.version)
 
-		err = h.updateNginxConf(
-			ctx,
-			cfg,
-		)
+		var applied dataplane.Configuration
+		applied, err = h.applyNginxConf(ctx, logger, gr, cfg)
+		h.setLatestConfiguration(&applied)
 	}
 
 	var nginxReloadRes status.NginxReloadResult
@@ -222,18 +330,34 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 			h.cfg.nginxConfiguredOnStartChecker.firstBatchError = err
 		}
 	} else {
-		logger.Info("NGINX configuration was successfully updated")
+		logger.Info("NGINX configuration was successfully updated", "version", h.version)
+		h.cfg.metricsCollector.ObserveConfigApplied(h.version)
 		if !h.cfg.nginxConfiguredOnStartChecker.ready {
-			h.cfg.nginxConfiguredOnStartChecker.setAsReady()
+			h.setAsReady()
 		}
+		h.recordDrainedUpstreams(gr, drained)
 	}
 
 	h.latestReloadResult = nginxReloadRes
 
+	if h.cfg.auditTrail != nil {
+		h.cfg.auditTrail.Record(ctx, newAuditRecord(batch, prevCfg, h.GetLatestConfiguration(), err, time.Now()))
+	}
+
 	h.updateStatuses(ctx, logger, gr)
 }
 
+// setAsReady marks the Pod as ready once nginx is configured on start, and records how long it took since
+// NGF started.
+func (h *eventHandlerImpl) setAsReady() {
+	h.cfg.nginxConfiguredOnStartChecker.setAsReady()
+	h.cfg.metricsCollector.ObserveFirstConfigApplied(time.Since(h.cfg.nginxConfiguredOnStartChecker.start))
+}
+
 func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logger, gr *graph.Graph) {
+	h.statusesStale = false
+	h.setQuarantinedConditions(gr)
+
 	gwAddresses, err := getGatewayAddresses(ctx, h.cfg.k8sClient, nil, h.cfg.gatewayPodConfig)
 	if err != nil {
 		logger.Error(err, "Setting GatewayStatusAddress to Pod IP Address")
@@ -255,12 +379,48 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 
 	polReqs := status.PrepareBackendTLSPolicyRequests(gr.BackendTLSPolicies, transitionTime, h.cfg.gatewayCtlrName)
 	ngfPolReqs := status.PrepareNGFPolicyRequests(gr.NGFPolicies, transitionTime, h.cfg.gatewayCtlrName)
+	snippetsFilterReqs := status.PrepareSnippetsFilterRequests(
+		gr.SnippetsFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	rateLimitFilterReqs := status.PrepareRateLimitFilterRequests(
+		gr.RateLimitFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	responseHeaderFilterReqs := status.PrepareResponseHeaderFilterRequests(
+		gr.ResponseHeaderFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	queryParameterFilterReqs := status.PrepareQueryParameterFilterRequests(
+		gr.QueryParameterFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
+	abTestFilterReqs := status.PrepareABTestFilterRequests(
+		gr.ABTestFilters,
+		transitionTime,
+		h.cfg.gatewayCtlrName,
+	)
 
-	reqs := make([]frameworkStatus.UpdateRequest, 0, len(gcReqs)+len(routeReqs)+len(polReqs)+len(ngfPolReqs))
+	reqs := make(
+		[]frameworkStatus.UpdateRequest,
+		0,
+		len(gcReqs)+len(routeReqs)+len(polReqs)+len(ngfPolReqs)+
+			len(snippetsFilterReqs)+len(rateLimitFilterReqs)+len(responseHeaderFilterReqs)+
+			len(queryParameterFilterReqs)+len(abTestFilterReqs),
+	)
 	reqs = append(reqs, gcReqs...)
 	reqs = append(reqs, routeReqs...)
 	reqs = append(reqs, polReqs...)
 	reqs = append(reqs, ngfPolReqs...)
+	reqs = append(reqs, snippetsFilterReqs...)
+	reqs = append(reqs, rateLimitFilterReqs...)
+	reqs = append(reqs, responseHeaderFilterReqs...)
+	reqs = append(reqs, queryParameterFilterReqs...)
+	reqs = append(reqs, abTestFilterReqs...)
 
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupAllExceptGateways, reqs...)
 
@@ -274,23 +434,34 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 		h.latestReloadResult,
 	)
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gwReqs...)
+
+	if h.cfg.hostnameReportWriter != nil {
+		h.cfg.hostnameReportWriter.Write(ctx, status.PrepareHostnameReport(gr.Gateway, gr.L4Routes, gr.Routes))
+	}
+
+	if h.cfg.externalDNSAnnotator != nil {
+		h.cfg.externalDNSAnnotator.Write(ctx, status.PrepareExternalDNSHostnames(gr.Gateway, gr.L4Routes, gr.Routes))
+	}
 }
 
 func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr.Logger, event interface{}) {
 	switch e := event.(type) {
 	case *events.UpsertEvent:
-		upFilterKey := objectFilterKey(e.Resource, client.ObjectKeyFromObject(e.Resource))
+		// The Gateway API resources that are served only in v1beta1 are processed as v1.
+		resource := convertToV1(e.Resource)
+		upFilterKey := objectFilterKey(resource, client.ObjectKeyFromObject(resource))
 
 		if filter, ok := h.objectFilters[upFilterKey]; ok {
-			filter.upsert(ctx, logger, e.Resource)
+			filter.upsert(ctx, logger, resource)
 			if !filter.captureChangeInGraph {
 				return
 			}
 		}
 
-		h.cfg.processor.CaptureUpsertChange(e.Resource)
+		h.cfg.processor.CaptureUpsertChange(resource)
 	case *events.DeleteEvent:
-		delFilterKey := objectFilterKey(e.Type, e.NamespacedName)
+		resourceType := convertToV1(e.Type)
+		delFilterKey := objectFilterKey(resourceType, e.NamespacedName)
 
 		if filter, ok := h.objectFilters[delFilterKey]; ok {
 			filter.delete(ctx, logger, e.NamespacedName)
@@ -299,27 +470,102 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 			}
 		}
 
-		h.cfg.processor.CaptureDeleteChange(e.Type, e.NamespacedName)
+		h.cfg.processor.CaptureDeleteChange(resourceType, e.NamespacedName)
+	case *upstreamDrainExpiredEvent:
+		// The configuration is rebuilt without the drained upstreams after the batch is processed.
+	case *externalCertificatesEvent:
+		h.cfg.processor.CaptureExternalCertificates(e.certificates)
+	case *apiServerReconnectedEvent:
+		h.statusesStale = true
 	default:
 		panic(fmt.Errorf("unknown event type %T", e))
 	}
 }
 
-// updateNginxConf updates nginx conf files and reloads nginx.
-func (h *eventHandlerImpl) updateNginxConf(ctx context.Context, conf dataplane.Configuration) error {
-	files := h.cfg.generator.Generate(conf)
+// captureReferencedObjects captures the Secrets and the ConfigMaps that the Graph started referencing, and drops
+// the ones that it no longer references. If the Graph started referencing any, the changes are processed again,
+// so that the configuration is not built without them. It returns the latest Graph.
+func (h *eventHandlerImpl) captureReferencedObjects(
+	ctx context.Context,
+	logger logr.Logger,
+	gr *graph.Graph,
+) *graph.Graph {
+	batch, err := h.cfg.referencedObjects.update(ctx, gr)
+	if err != nil {
+		logger.Error(err, "Failed to get the referenced resources")
+	}
+
+	if len(batch) == 0 {
+		return gr
+	}
+
+	for _, event := range batch {
+		h.parseAndCaptureEvent(ctx, logger, event)
+	}
+
+	if changeType, reprocessed := h.cfg.processor.Process(); changeType == state.ClusterStateChange {
+		return reprocessed
+	}
+
+	return gr
+}
+
+// replaceNginxConf replaces nginx conf files and reloads nginx. The caller must hold nginxLock.
+func (h *eventHandlerImpl) replaceNginxConf(ctx context.Context, conf dataplane.Configuration) error {
+	files, err := h.generateNginxConf(conf)
+	if err != nil {
+		return err
+	}
+
 	if err := h.cfg.nginxFileMgr.ReplaceFiles(files); err != nil {
 		return fmt.Errorf("failed to replace NGINX configuration files: %w", err)
 	}
 
+	return h.reload(ctx, conf)
+}
+
+// generateNginxConf generates the nginx conf files. The generator panics if it fails to execute a template,
+// for example, because a resource has a value that the template doesn't expect, so the panic is returned as an
+// error, and the resource can be excluded from the configuration.
+func (h *eventHandlerImpl) generateNginxConf(conf dataplane.Configuration) (files []file.File, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to generate NGINX configuration: %v", r)
+		}
+	}()
+
+	return h.cfg.generator.Generate(conf), nil
+}
+
+// reload reloads nginx. If using NGINX Plus, it then sets the servers of the upstreams that store
+// their servers in a state file, because such upstreams are configured without servers.
+func (h *eventHandlerImpl) reload(ctx context.Context, conf dataplane.Configuration) error {
 	if err := h.cfg.nginxRuntimeMgr.Reload(ctx, conf.Version); err != nil {
 		return fmt.Errorf("failed to reload NGINX: %w", err)
 	}
 
+	h.loadedVersion = conf.Version
+
+	if !h.cfg.nginxRuntimeMgr.IsPlus() {
+		return nil
+	}
+
+	for _, u := range conf.Upstreams {
+		if !ngxConfig.UsesStateFile(u) {
+			continue
+		}
+
+		servers := ngxConfig.ConvertUpstream(u)
+		if err := h.cfg.nginxRuntimeMgr.UpdateHTTPServers(u.Name, servers); err != nil {
+			return fmt.Errorf("failed to update servers of upstream %q: %w", u.Name, err)
+		}
+	}
+
 	return nil
 }
 
-// updateUpstreamServers is called only when endpoints have changed. It updates nginx conf files and then:
+// updateUpstreamServers is called only when endpoints have changed. It updates only the nginx conf files of
+// the upstreams, leaving the files of the servers, the locations and the policies as is, and then:
 // - if using NGINX Plus, determines which servers have changed and uses the N+ API to update them;
 // - otherwise if not using NGINX Plus, or an error was returned from the API, reloads nginx.
 func (h *eventHandlerImpl) updateUpstreamServers(
@@ -327,19 +573,14 @@ func (h *eventHandlerImpl) updateUpstreamServers(
 	logger logr.Logger,
 	conf dataplane.Configuration,
 ) error {
-	isPlus := h.cfg.nginxRuntimeMgr.IsPlus()
-
-	files := h.cfg.generator.Generate(conf)
-	if err := h.cfg.nginxFileMgr.ReplaceFiles(files); err != nil {
-		return fmt.Errorf("failed to replace NGINX configuration files: %w", err)
-	}
+	h.nginxLock.Lock()
+	defer h.nginxLock.Unlock()
 
-	reload := func() error {
-		if err := h.cfg.nginxRuntimeMgr.Reload(ctx, conf.Version); err != nil {
-			return fmt.Errorf("failed to reload NGINX: %w", err)
-		}
+	isPlus := h.cfg.nginxRuntimeMgr.IsPlus()
 
-		return nil
+	files := h.cfg.generator.GenerateUpstreams(conf)
+	if err := h.cfg.nginxFileMgr.UpdateFiles(files); err != nil {
+		return fmt.Errorf("failed to update NGINX upstream configuration files: %w", err)
 	}
 
 	if isPlus {
@@ -352,13 +593,13 @@ func (h *eventHandlerImpl) updateUpstreamServers(
 		prevUpstreams, err := h.cfg.nginxRuntimeMgr.GetUpstreams()
 		if err != nil {
 			logger.Error(err, "failed to get upstreams from API, reloading configuration instead")
-			return reload()
+			return h.reload(ctx, conf)
 		}
 
 		for _, u := range conf.Upstreams {
 			confUpstream := upstream{
 				name:    u.Name,
-				servers: ngxConfig.ConvertEndpoints(u.Endpoints),
+				servers: ngxConfig.ConvertUpstream(u),
 			}
 
 			if u, ok := prevUpstreams[confUpstream.name]; ok {
@@ -384,7 +625,54 @@ func (h *eventHandlerImpl) updateUpstreamServers(
 		}
 	}
 
-	return reload()
+	return h.reload(ctx, conf)
+}
+
+// upstreamsOnlyChanged returns true if the configuration differs from the previous one only in the endpoints of
+// the upstreams, so that only the upstreams need to be regenerated. It returns false if an upstream was added or
+// removed, or an upstream gets or loses all of its endpoints.
+func upstreamsOnlyChanged(prevCfg, cfg dataplane.Configuration) bool {
+	return sameUpstreamNames(prevCfg.Upstreams, cfg.Upstreams) &&
+		sameUpstreamNames(prevCfg.StreamUpstreams, cfg.StreamUpstreams) &&
+		!upstreamsWithoutEndpointsChanged(prevCfg.Upstreams, cfg.Upstreams) &&
+		!upstreamsWithoutEndpointsChanged(prevCfg.StreamUpstreams, cfg.StreamUpstreams)
+}
+
+// sameUpstreamNames returns true if both lists have the upstreams with the same names.
+func sameUpstreamNames(prevUpstreams, upstreams []dataplane.Upstream) bool {
+	if len(prevUpstreams) != len(upstreams) {
+		return false
+	}
+
+	names := make(map[string]struct{}, len(prevUpstreams))
+	for _, u := range prevUpstreams {
+		names[u.Name] = struct{}{}
+	}
+
+	for _, u := range upstreams {
+		if _, exists := names[u.Name]; !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+// upstreamsWithoutEndpointsChanged returns true if an upstream that had no endpoints has endpoints now,
+// or the other way around.
+func upstreamsWithoutEndpointsChanged(prevUpstreams, upstreams []dataplane.Upstream) bool {
+	prevEmpty := make(map[string]bool, len(prevUpstreams))
+	for _, u := range prevUpstreams {
+		prevEmpty[u.Name] = len(u.Endpoints) == 0
+	}
+
+	for _, u := range upstreams {
+		if empty, exists := prevEmpty[u.Name]; exists && empty != (len(u.Endpoints) == 0) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func serversEqual(newServers []ngxclient.UpstreamServer, oldServers []ngxclient.Peer) bool {
@@ -421,6 +709,8 @@ func (h *eventHandlerImpl) updateControlPlaneAndSetStatus(
 		h.cfg.eventRecorder,
 		h.cfg.controlConfigNSName,
 		h.cfg.logLevelSetter,
+		h.cfg.defaultLogLevel,
+		h.cfg.eventBatchDelay,
 	); err != nil {
 		msg := "Failed to update control plane configuration"
 		logger.Error(err, msg)
@@ -517,6 +807,180 @@ func (h *eventHandlerImpl) setLatestConfiguration(cfg *dataplane.Configuration)
 	h.latestConfiguration = cfg
 }
 
+// verifyNginxConf verifies that the nginx conf files and the configuration that NGINX runs haven't drifted from
+// the latest configuration, for example, because the files were edited manually or the NGINX container restarted
+// with different files. If they have, it replaces the files with the latest configuration and reloads NGINX.
+func (h *eventHandlerImpl) verifyNginxConf(ctx context.Context, logger logr.Logger) {
+	conf := h.GetLatestConfiguration()
+	if conf == nil {
+		return
+	}
+
+	h.nginxLock.Lock()
+	defer h.nginxLock.Unlock()
+
+	// NGINX hasn't loaded any configuration successfully yet, so there is no configuration to restore.
+	if h.loadedVersion == 0 {
+		return
+	}
+
+	changedFiles, err := h.cfg.nginxFileMgr.ChangedFiles()
+	if err != nil {
+		logger.Error(err, "Failed to verify NGINX configuration files")
+		return
+	}
+
+	runningVersion, err := h.cfg.nginxRuntimeMgr.GetConfigVersion()
+	if err != nil {
+		logger.Error(err, "Failed to get the version of the running NGINX configuration")
+		return
+	}
+
+	if len(changedFiles) == 0 && runningVersion == h.loadedVersion {
+		return
+	}
+
+	logger.Info(
+		"NGINX configuration drifted from the latest configuration, restoring it",
+		"changedFiles", changedFiles,
+		"runningVersion", runningVersion,
+		"expectedVersion", h.loadedVersion,
+	)
+
+	if err := h.replaceNginxConf(ctx, *conf); err != nil {
+		logger.Error(err, "Failed to restore NGINX configuration")
+		return
+	}
+
+	logger.Info("NGINX configuration was successfully restored")
+}
+
+// checkNginxHealth checks the health of the NGINX processes. If the worker processes are gone or crash-loop,
+// or NGINX doesn't respond, it restarts NGINX and emits an Event for the NGF Pod, rather than letting NGINX
+// silently serve traffic with dead workers.
+func (h *eventHandlerImpl) checkNginxHealth(ctx context.Context, logger logr.Logger) {
+	conf := h.GetLatestConfiguration()
+	if conf == nil {
+		return
+	}
+
+	h.nginxLock.Lock()
+	defer h.nginxLock.Unlock()
+
+	// NGINX hasn't loaded any configuration successfully yet, so it doesn't serve the config version endpoint.
+	if h.loadedVersion == 0 {
+		return
+	}
+
+	if h.nginxRestarted {
+		// The restarted NGINX runs the nginx conf files, but not the configuration set through the NGINX Plus API,
+		// such as the servers of the upstreams, so the configuration is applied again.
+		if err := h.replaceNginxConf(ctx, *conf); err != nil {
+			logger.Error(err, "Failed to restore NGINX configuration after restarting NGINX")
+			return
+		}
+
+		h.nginxRestarted = false
+		logger.Info("NGINX configuration was successfully restored after restarting NGINX")
+
+		return
+	}
+
+	healthErr := h.cfg.nginxRuntimeMgr.CheckHealth(ctx)
+	if healthErr == nil {
+		return
+	}
+
+	logger.Error(healthErr, "NGINX is unhealthy, restarting it")
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      h.cfg.gatewayPodConfig.Name,
+			Namespace: h.cfg.gatewayPodConfig.Namespace,
+		},
+	}
+
+	if err := h.cfg.nginxRuntimeMgr.Restart(ctx); err != nil {
+		logger.Error(err, "Failed to restart NGINX")
+		h.cfg.eventRecorder.Eventf(
+			pod,
+			v1.EventTypeWarning,
+			"NginxRestartFailed",
+			"NGINX is unhealthy, but failed to restart it: %s: %s",
+			healthErr.Error(),
+			err.Error(),
+		)
+
+		return
+	}
+
+	h.nginxRestarted = true
+
+	h.cfg.eventRecorder.Eventf(
+		pod,
+		v1.EventTypeWarning,
+		"NginxRestarted",
+		"NGINX was restarted because it was unhealthy: %s",
+		healthErr.Error(),
+	)
+}
+
+// updateCertificates updates the certificates referenced by the listeners of the Gateway,
+// and the metrics of their expiry.
+func (h *eventHandlerImpl) updateCertificates(gr *graph.Graph) {
+	certs := getListenerCertificates(gr)
+
+	expiries := make(map[types.NamespacedName]time.Time, len(certs))
+	for _, cert := range certs {
+		expiries[cert.secret] = cert.expiry
+	}
+
+	h.cfg.metricsCollector.SetCertificateExpiries(expiries)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.latestCertificates = certs
+	h.latestGateway = nil
+	if gr.Gateway != nil {
+		h.latestGateway = gr.Gateway.Source
+	}
+}
+
+// warnExpiringCertificates emits a warning Event for the Gateway for every certificate referenced by its listeners
+// that expires within the warning window, or has already expired.
+func (h *eventHandlerImpl) warnExpiringCertificates(now time.Time) {
+	h.lock.Lock()
+	gw, certs := h.latestGateway, h.latestCertificates
+	h.lock.Unlock()
+
+	if gw == nil {
+		return
+	}
+
+	for _, cert := range certs {
+		if cert.expiry.Sub(now) > h.cfg.certificateExpiryWarningWindow {
+			continue
+		}
+
+		expires := "expires"
+		if !cert.expiry.After(now) {
+			expires = "expired"
+		}
+
+		h.cfg.eventRecorder.Eventf(
+			gw,
+			v1.EventTypeWarning,
+			"CertificateExpiring",
+			"The certificate of Secret %s referenced by listeners %s %s at %s",
+			cert.secret,
+			strings.Join(cert.listeners, ", "),
+			expires,
+			cert.expiry.UTC().Format(time.RFC3339),
+		)
+	}
+}
+
 func objectFilterKey(obj client.Object, nsName types.NamespacedName) filterKey {
 	return filterKey(fmt.Sprintf("%T_%s_%s", obj, nsName.Namespace, nsName.Name))
 }
@@ -3,6 +3,7 @@ package static
 import (
 	"context"
 	"errors"
+	"time"
 
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 	. "github.com/onsi/ginkgo/v2"
@@ -12,6 +13,7 @@ import (
 	discoveryV1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -21,16 +23,20 @@ import (
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/status/statusfakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file/filefakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/statefakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/staticfakes"
 )
@@ -102,6 +108,8 @@ var _ = Describe("eventHandler", func() {
 			processor:                     fakeProcessor,
 			generator:                     fakeGenerator,
 			logLevelSetter:                zapLogLevelSetter,
+			defaultLogLevel:               ngfAPI.ControllerLogLevelInfo,
+			eventBatchDelay:               &events.BatchDelay{},
 			nginxFileMgr:                  fakeNginxFileMgr,
 			nginxRuntimeMgr:               fakeNginxRuntimeMgr,
 			statusUpdater:                 fakeStatusUpdater,
@@ -197,6 +205,38 @@ var _ = Describe("eventHandler", func() {
 				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 2})).To(BeEmpty())
 			})
 		})
+
+		When("the Graph starts referencing a Secret", func() {
+			It("should get the Secret and process the changes again before building the configuration", func() {
+				secret := &v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "secret"},
+				}
+				Expect(fakeK8sClient.Create(context.Background(), secret)).To(Succeed())
+
+				handler.cfg.referencedObjects = newReferencedObjects(fakeK8sClient, nil, false)
+
+				referencingGraph := &graph.Graph{
+					ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+						client.ObjectKeyFromObject(secret): {},
+					},
+				}
+				fakeProcessor.ProcessReturnsOnCall(0, state.ClusterStateChange, referencingGraph)
+				fakeProcessor.ProcessReturnsOnCall(1, state.ClusterStateChange, &graph.Graph{})
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), []interface{}{e})
+
+				Expect(fakeProcessor.ProcessCallCount()).To(Equal(2))
+				Expect(fakeProcessor.CaptureUpsertChangeCallCount()).To(Equal(2))
+
+				captured, ok := fakeProcessor.CaptureUpsertChangeArgsForCall(1).(*v1.Secret)
+				Expect(ok).To(BeTrue())
+				Expect(client.ObjectKeyFromObject(captured)).To(Equal(client.ObjectKeyFromObject(secret)))
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+			})
+		})
 	})
 
 	DescribeTable(
@@ -512,27 +552,64 @@ var _ = Describe("eventHandler", func() {
 			fakeNginxRuntimeMgr.GetUpstreamsReturns(upstreams, nil)
 		})
 
-		When("running NGINX Plus", func() {
-			It("should call the NGINX Plus API", func() {
-				fakeNginxRuntimeMgr.IsPlusReturns(true)
+		When("NGINX runs a previous configuration", func() {
+			BeforeEach(func() {
+				handler.setLatestConfiguration(&dataplane.Configuration{})
+			})
+
+			When("running NGINX Plus", func() {
+				It("should only update the upstreams and call the NGINX Plus API", func() {
+					fakeNginxRuntimeMgr.IsPlusReturns(true)
+
+					handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+					Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 1})).To(BeEmpty())
+
+					Expect(fakeGenerator.GenerateCallCount()).To(Equal(0))
+					Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(0))
+					Expect(fakeGenerator.GenerateUpstreamsCallCount()).To(Equal(1))
+					Expect(fakeNginxFileMgr.UpdateFilesCallCount()).To(Equal(1))
+					Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(1))
+				})
+			})
+
+			When("not running NGINX Plus", func() {
+				It("should only update the upstreams and not call the NGINX Plus API", func() {
+					handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+					Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 1})).To(BeEmpty())
+
+					Expect(fakeGenerator.GenerateCallCount()).To(Equal(0))
+					Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(0))
+					Expect(fakeGenerator.GenerateUpstreamsCallCount()).To(Equal(1))
+					Expect(fakeNginxFileMgr.UpdateFilesCallCount()).To(Equal(1))
+					Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(0))
+					Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("the upstreams of the previous configuration are different", func() {
+			It("should update the whole configuration", func() {
+				handler.setLatestConfiguration(&dataplane.Configuration{
+					Upstreams: []dataplane.Upstream{{Name: "one"}},
+				})
 
 				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
-				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 1})).To(BeEmpty())
 
 				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
 				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
-				Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(1))
+				Expect(fakeGenerator.GenerateUpstreamsCallCount()).To(Equal(0))
+				Expect(fakeNginxFileMgr.UpdateFilesCallCount()).To(Equal(0))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
 			})
 		})
 
-		When("not running NGINX Plus", func() {
-			It("should not call the NGINX Plus API", func() {
+		When("there is no previous configuration", func() {
+			It("should update the whole configuration", func() {
 				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
-				Expect(helpers.Diff(handler.GetLatestConfiguration(), &dataplane.Configuration{Version: 1})).To(BeEmpty())
 
 				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
 				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
-				Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(0))
+				Expect(fakeGenerator.GenerateUpstreamsCallCount()).To(Equal(0))
 				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
 			})
 		})
@@ -554,8 +631,10 @@ var _ = Describe("eventHandler", func() {
 		}
 
 		assertCallCounts := func(cc callCounts) {
-			Expect(fakeGenerator.GenerateCallCount()).To(Equal(cc.generate))
-			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(cc.generate))
+			Expect(fakeGenerator.GenerateCallCount()).To(BeZero())
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(BeZero())
+			Expect(fakeGenerator.GenerateUpstreamsCallCount()).To(Equal(cc.generate))
+			Expect(fakeNginxFileMgr.UpdateFilesCallCount()).To(Equal(cc.generate))
 			Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(cc.update))
 			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(cc.reload))
 		}
@@ -595,6 +674,23 @@ var _ = Describe("eventHandler", func() {
 
 				assertCallCounts(callCounts{generate: 1, update: 1, reload: 1})
 			})
+
+			It("should set the servers of state file upstreams after reloading", func() {
+				stateFileConf := dataplane.Configuration{
+					Upstreams: []dataplane.Upstream{
+						{
+							Name:      "large",
+							Endpoints: make([]resolver.Endpoint, 501),
+						},
+					},
+				}
+				fakeNginxRuntimeMgr.GetUpstreamsReturns(nil, errors.New("error"))
+				Expect(handler.updateUpstreamServers(context.Background(), ctlrZap.New(), stateFileConf)).To(Succeed())
+
+				assertCallCounts(callCounts{generate: 1, update: 1, reload: 1})
+				name, _ := fakeNginxRuntimeMgr.UpdateHTTPServersArgsForCall(0)
+				Expect(name).To(Equal("large"))
+			})
 		})
 
 		When("not running NGINX Plus", func() {
@@ -613,6 +709,588 @@ var _ = Describe("eventHandler", func() {
 		})
 	})
 
+	When("warning about expiring certificates", func() {
+		now := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		secret1 := types.NamespacedName{Namespace: "test", Name: "secret1"}
+		secret2 := types.NamespacedName{Namespace: "test", Name: "secret2"}
+		secret3 := types.NamespacedName{Namespace: "test", Name: "secret3"}
+
+		createSecret := func(expiry time.Time) *graph.Secret {
+			cert, key := createKeyPairPEM(Default, expiry)
+
+			return &graph.Secret{
+				Source: &v1.Secret{
+					Data: map[string][]byte{
+						v1.TLSCertKey:       cert,
+						v1.TLSPrivateKeyKey: key,
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			fakeEventRecorder = record.NewFakeRecorder(10)
+			handler.cfg.eventRecorder = fakeEventRecorder
+			handler.cfg.certificateExpiryWarningWindow = 7 * 24 * time.Hour
+		})
+
+		It("emits warning Events for the certificates that expire within the window", func() {
+			handler.updateCertificates(&graph.Graph{
+				Gateway: &graph.Gateway{
+					Source: &gatewayv1.Gateway{},
+					Listeners: []*graph.Listener{
+						{Name: "https-1", ResolvedSecret: &secret1},
+						{Name: "https-2", ResolvedSecret: &secret2},
+						{Name: "https-3", ResolvedSecret: &secret3},
+					},
+				},
+				ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+					secret1: createSecret(now.Add(-time.Hour)),
+					secret2: createSecret(now.Add(24 * time.Hour)),
+					secret3: createSecret(now.Add(30 * 24 * time.Hour)),
+				},
+			})
+
+			handler.warnExpiringCertificates(now)
+
+			Expect(fakeEventRecorder.Events).To(HaveLen(2))
+			Expect(<-fakeEventRecorder.Events).To(Equal(
+				"Warning CertificateExpiring The certificate of Secret test/secret1 referenced by listeners https-1 " +
+					"expired at 2029-12-31T23:00:00Z",
+			))
+			Expect(<-fakeEventRecorder.Events).To(Equal(
+				"Warning CertificateExpiring The certificate of Secret test/secret2 referenced by listeners https-2 " +
+					"expires at 2030-01-02T00:00:00Z",
+			))
+		})
+
+		It("doesn't emit Events without a Gateway", func() {
+			handler.updateCertificates(&graph.Graph{})
+
+			handler.warnExpiringCertificates(now)
+
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+	})
+
+	When("verifying the NGINX configuration", func() {
+		batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+		BeforeEach(func() {
+			fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+
+			fakeNginxRuntimeMgr.GetConfigVersionReturns(1, nil)
+		})
+
+		It("doesn't restore the configuration if it didn't drift", func() {
+			handler.verifyNginxConf(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+		})
+
+		It("restores the configuration if the files changed", func() {
+			fakeNginxFileMgr.ChangedFilesReturns([]string{"/etc/nginx/conf.d/http.conf"}, nil)
+
+			handler.verifyNginxConf(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(2))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+			_, version := fakeNginxRuntimeMgr.ReloadArgsForCall(1)
+			Expect(version).To(Equal(1))
+		})
+
+		It("restores the configuration if NGINX runs a different version", func() {
+			fakeNginxRuntimeMgr.GetConfigVersionReturns(0, nil)
+
+			handler.verifyNginxConf(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(2))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+		})
+
+		It("doesn't restore the configuration if the verification fails", func() {
+			fakeNginxFileMgr.ChangedFilesReturns(nil, errors.New("read error"))
+
+			handler.verifyNginxConf(context.Background(), ctlrZap.New())
+
+			fakeNginxFileMgr.ChangedFilesReturns(nil, nil)
+			fakeNginxRuntimeMgr.GetConfigVersionReturns(0, errors.New("version error"))
+
+			handler.verifyNginxConf(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+		})
+
+		It("doesn't restore the configuration if NGINX never loaded it", func() {
+			fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+			handler = newEventHandlerImpl(handler.cfg)
+			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+			fakeNginxFileMgr.ChangedFilesReturns([]string{"/etc/nginx/conf.d/http.conf"}, nil)
+
+			handler.verifyNginxConf(context.Background(), ctlrZap.New())
+
+			// the failed configuration and its retry
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(3))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(3))
+		})
+	})
+
+	When("checking the health of NGINX", func() {
+		batch := []interface{}{&events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}}
+
+		BeforeEach(func() {
+			fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{})
+			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+			// the health is checked after the reload
+			Expect(fakeNginxRuntimeMgr.CheckHealthCallCount()).To(Equal(1))
+		})
+
+		It("doesn't restart NGINX if it is healthy", func() {
+			handler.checkNginxHealth(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxRuntimeMgr.CheckHealthCallCount()).To(Equal(2))
+			Expect(fakeNginxRuntimeMgr.RestartCallCount()).To(Equal(0))
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+
+		It("restarts NGINX if it is unhealthy, and restores the configuration afterwards", func() {
+			fakeNginxRuntimeMgr.CheckHealthReturns(errors.New("NGINX main process has no worker processes"))
+
+			handler.checkNginxHealth(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxRuntimeMgr.RestartCallCount()).To(Equal(1))
+			Expect(fakeEventRecorder.Events).To(HaveLen(1))
+			Expect(<-fakeEventRecorder.Events).To(Equal(
+				"Warning NginxRestarted NGINX was restarted because it was unhealthy: " +
+					"NGINX main process has no worker processes",
+			))
+
+			handler.checkNginxHealth(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxRuntimeMgr.CheckHealthCallCount()).To(Equal(2))
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(2))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+
+			fakeNginxRuntimeMgr.CheckHealthReturns(nil)
+			handler.checkNginxHealth(context.Background(), ctlrZap.New())
+
+			Expect(fakeNginxRuntimeMgr.CheckHealthCallCount()).To(Equal(3))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+		})
+
+		It("emits an Event if NGINX can't be restarted", func() {
+			fakeNginxRuntimeMgr.CheckHealthReturns(errors.New("health error"))
+			fakeNginxRuntimeMgr.RestartReturns(errors.New("restart error"))
+
+			handler.checkNginxHealth(context.Background(), ctlrZap.New())
+
+			Expect(fakeEventRecorder.Events).To(HaveLen(1))
+			Expect(<-fakeEventRecorder.Events).To(Equal(
+				"Warning NginxRestartFailed NGINX is unhealthy, but failed to restart it: health error: restart error",
+			))
+		})
+
+		It("doesn't check the health if NGINX never loaded the configuration", func() {
+			fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+			handler = newEventHandlerImpl(handler.cfg)
+			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+			handler.checkNginxHealth(context.Background(), ctlrZap.New())
+
+			// only the health check after the reload of the previous handler
+			Expect(fakeNginxRuntimeMgr.CheckHealthCallCount()).To(Equal(1))
+		})
+	})
+
+	When("NGINX fails to apply a new configuration", func() {
+		gateway := &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}}
+		goodRoute := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "good", Generation: 1}}
+		badRoute := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "bad", Generation: 2}}
+
+		gr := &graph.Graph{
+			Gateway: &graph.Gateway{Source: gateway},
+			Routes: map[graph.RouteKey]*graph.L7Route{
+				graph.CreateRouteKey(goodRoute): {Source: goodRoute},
+				graph.CreateRouteKey(badRoute):  {Source: badRoute},
+			},
+		}
+
+		createConf := func(version int, routes ...*gatewayv1.HTTPRoute) dataplane.Configuration {
+			matchRules := make([]dataplane.MatchRule, 0, len(routes))
+			for _, r := range routes {
+				matchRules = append(matchRules, dataplane.MatchRule{Source: &r.ObjectMeta, SourceKind: kinds.HTTPRoute})
+			}
+
+			return dataplane.Configuration{
+				Version: version,
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						Hostname:  "cafe.example.com",
+						PathRules: []dataplane.PathRule{{Path: "/", MatchRules: matchRules}},
+					},
+				},
+			}
+		}
+
+		// failReloadsWith makes NGINX fail to reload every configuration with any of the Routes.
+		failReloadsWith := func(routes ...*gatewayv1.HTTPRoute) {
+			fakeNginxRuntimeMgr.ReloadCalls(func(_ context.Context, _ int) error {
+				conf := fakeGenerator.GenerateArgsForCall(fakeGenerator.GenerateCallCount() - 1)
+				for _, mr := range conf.HTTPServers[0].PathRules[0].MatchRules {
+					for _, r := range routes {
+						if mr.Source.Name == r.Name {
+							return errors.New("reload error")
+						}
+					}
+				}
+
+				return nil
+			})
+		}
+
+		BeforeEach(func() {
+			fakeEventRecorder = record.NewFakeRecorder(10)
+			handler.cfg.eventRecorder = fakeEventRecorder
+			handler.reloadHealthCheckBackoff = wait.Backoff{Steps: 3}
+
+			lastApplied := createConf(1, goodRoute)
+			handler.lastAppliedConfiguration = &lastApplied
+		})
+
+		It("applies the configuration without the added Routes and quarantines them", func() {
+			failReloadsWith(badRoute)
+
+			applied, err := handler.applyNginxConf(
+				context.Background(),
+				ctlrZap.New(),
+				gr,
+				createConf(2, goodRoute, badRoute),
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(Equal(createConf(2, goodRoute)))
+			Expect(handler.lastAppliedConfiguration).To(Equal(&applied))
+
+			// the configuration, its retry, the configuration without the Route, the configuration with the Route
+			// to confirm that it fails, and the configuration without the Route again
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(5))
+			Expect(fakeGenerator.GenerateArgsForCall(1)).To(Equal(createConf(2, goodRoute, badRoute)))
+			Expect(fakeGenerator.GenerateArgsForCall(2)).To(Equal(createConf(2, goodRoute)))
+			Expect(fakeGenerator.GenerateArgsForCall(3)).To(Equal(createConf(2, goodRoute, badRoute)))
+			Expect(fakeGenerator.GenerateArgsForCall(4)).To(Equal(createConf(2, goodRoute)))
+
+			Expect(fakeEventRecorder.Events).To(HaveLen(1))
+			Expect(<-fakeEventRecorder.Events).To(Equal(
+				"Warning RouteQuarantined The resource was excluded from the NGINX configuration until it changes, " +
+					"because NGINX failed to generate or apply the configuration with it: " +
+					"failed to reload NGINX: reload error",
+			))
+
+			// the quarantined Route stays excluded until it changes
+			Expect(handler.withoutQuarantinedResources(createConf(3, goodRoute, badRoute))).To(
+				Equal(createConf(3, goodRoute)),
+			)
+
+			fixedRoute := badRoute.DeepCopy()
+			fixedRoute.Generation = 3
+
+			Expect(handler.withoutQuarantinedResources(createConf(4, goodRoute, fixedRoute))).To(
+				Equal(createConf(4, goodRoute, fixedRoute)),
+			)
+			Expect(handler.quarantinedResources).To(BeEmpty())
+		})
+
+		It("retries the configuration before isolating the offending resources", func() {
+			fakeNginxRuntimeMgr.ReloadReturnsOnCall(0, errors.New("reload error"))
+
+			applied, err := handler.applyNginxConf(
+				context.Background(),
+				ctlrZap.New(),
+				gr,
+				createConf(2, goodRoute, badRoute),
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(Equal(createConf(2, goodRoute, badRoute)))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+			Expect(handler.quarantinedResources).To(BeEmpty())
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+
+		It("doesn't quarantine a Route if the configuration with it is applied when it is included again", func() {
+			fakeNginxRuntimeMgr.ReloadReturnsOnCall(0, errors.New("reload error"))
+			fakeNginxRuntimeMgr.ReloadReturnsOnCall(1, errors.New("reload error"))
+
+			applied, err := handler.applyNginxConf(
+				context.Background(),
+				ctlrZap.New(),
+				gr,
+				createConf(2, goodRoute, badRoute),
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(Equal(createConf(2, goodRoute, badRoute)))
+			Expect(handler.lastAppliedConfiguration).To(Equal(&applied))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(4))
+			Expect(fakeGenerator.GenerateArgsForCall(3)).To(Equal(createConf(2, goodRoute, badRoute)))
+			Expect(handler.quarantinedResources).To(BeEmpty())
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+
+		It("caps the attempts to apply the configuration", func() {
+			otherRoute := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "other"}}
+			failReloadsWith(badRoute, otherRoute)
+
+			thirdRoute := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "third"}}
+
+			applied, err := handler.applyNginxConf(
+				context.Background(),
+				ctlrZap.New(),
+				gr,
+				createConf(2, goodRoute, badRoute, otherRoute, thirdRoute),
+			)
+
+			// excluding each Route alone fails, and there are not enough attempts left to exclude all of them
+			Expect(err).To(MatchError("failed to reload NGINX: reload error"))
+			Expect(applied).To(Equal(createConf(1, goodRoute)))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(BeNumerically("<=", maxNginxConfAttempts))
+			Expect(handler.quarantinedResources).To(BeEmpty())
+		})
+
+		It("rolls back to the last applied configuration", func() {
+			fakeNginxRuntimeMgr.ReloadCalls(func(_ context.Context, version int) error {
+				if version == 1 {
+					return nil
+				}
+
+				return errors.New("reload error")
+			})
+
+			applied, err := handler.applyNginxConf(
+				context.Background(),
+				ctlrZap.New(),
+				gr,
+				createConf(2, goodRoute, badRoute),
+			)
+
+			Expect(err).To(MatchError("failed to reload NGINX: reload error"))
+			Expect(applied).To(Equal(createConf(1, goodRoute)))
+
+			// the configuration, its retry, the configuration without the bad Route, and the rollback
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(4))
+			_, version := fakeNginxRuntimeMgr.ReloadArgsForCall(3)
+			Expect(version).To(Equal(1))
+			Expect(fakeGenerator.GenerateArgsForCall(3)).To(Equal(createConf(1, goodRoute)))
+
+			Expect(handler.quarantinedResources).To(BeEmpty())
+			Expect(fakeEventRecorder.Events).To(HaveLen(1))
+			Expect(<-fakeEventRecorder.Events).To(Equal(
+				"Warning ConfigurationRolledBack NGINX failed to apply configuration version 2 and was rolled back " +
+					"to version 1: failed to reload NGINX: reload error",
+			))
+		})
+
+		It("rolls back to the last applied configuration if NGINX is unhealthy after the reload", func() {
+			// all the health checks after the reload and its retry fail
+			for i := range 6 {
+				fakeNginxRuntimeMgr.CheckHealthReturnsOnCall(i, errors.New("NGINX main process has no worker processes"))
+			}
+
+			applied, err := handler.applyNginxConf(context.Background(), ctlrZap.New(), gr, createConf(2, goodRoute))
+
+			Expect(err).To(MatchError(
+				"NGINX is unhealthy after the reload: NGINX main process has no worker processes",
+			))
+			Expect(applied).To(Equal(createConf(1, goodRoute)))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(3))
+			Expect(fakeNginxRuntimeMgr.CheckHealthCallCount()).To(Equal(7))
+			Expect(fakeEventRecorder.Events).To(HaveLen(1))
+		})
+
+		It("retries the health check after the reload before considering the configuration bad", func() {
+			fakeNginxRuntimeMgr.CheckHealthReturnsOnCall(0, errors.New("NGINX main process has no worker processes"))
+			fakeNginxRuntimeMgr.CheckHealthReturnsOnCall(1, errors.New("NGINX doesn't respond"))
+
+			applied, err := handler.applyNginxConf(context.Background(), ctlrZap.New(), gr, createConf(2, goodRoute))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(Equal(createConf(2, goodRoute)))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+			Expect(fakeNginxRuntimeMgr.CheckHealthCallCount()).To(Equal(3))
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+
+		It("returns the errors if the rollback fails", func() {
+			fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+
+			applied, err := handler.applyNginxConf(context.Background(), ctlrZap.New(), gr, createConf(2, goodRoute))
+
+			Expect(err).To(MatchError(ContainSubstring("failed to roll back NGINX configuration")))
+			Expect(applied).To(Equal(createConf(2, goodRoute)))
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+
+		It("doesn't roll back if NGINX never applied a configuration", func() {
+			handler.lastAppliedConfiguration = nil
+			fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+
+			_, err := handler.applyNginxConf(context.Background(), ctlrZap.New(), gr, createConf(2, goodRoute))
+
+			Expect(err).To(HaveOccurred())
+			// the configuration, its retry, and the configuration without the only Route fail
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(3))
+			Expect(handler.quarantinedResources).To(BeEmpty())
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+
+		It("quarantines only the offending resource", func() {
+			otherRoute := &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "other"}}
+			failReloadsWith(otherRoute)
+
+			applied, err := handler.applyNginxConf(
+				context.Background(),
+				ctlrZap.New(),
+				gr,
+				createConf(2, goodRoute, badRoute, otherRoute),
+			)
+
+			// the Routes are excluded in order, so excluding the bad Route fails, and excluding the other one succeeds
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(Equal(createConf(2, goodRoute, badRoute)))
+			Expect(handler.quarantinedResources).To(HaveLen(1))
+			Expect(handler.quarantinedResources).To(HaveKey(routeResourceOf(&graph.L7Route{Source: otherRoute})))
+		})
+
+		It("doesn't exclude a GRPCRoute with the same name as a quarantined HTTPRoute", func() {
+			failReloadsWith(badRoute)
+
+			grpcRoute := &gatewayv1.GRPCRoute{ObjectMeta: badRoute.ObjectMeta}
+
+			grpcGraph := &graph.Graph{
+				Gateway: gr.Gateway,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(goodRoute): {Source: goodRoute, RouteType: graph.RouteTypeHTTP},
+					graph.CreateRouteKey(badRoute):  {Source: badRoute, RouteType: graph.RouteTypeHTTP},
+					graph.CreateRouteKey(grpcRoute): {Source: grpcRoute, RouteType: graph.RouteTypeGRPC},
+				},
+			}
+
+			grpcRule := dataplane.MatchRule{Source: &grpcRoute.ObjectMeta, SourceKind: kinds.GRPCRoute}
+			grpcPathRule := dataplane.PathRule{Path: "/grpc", GRPC: true, MatchRules: []dataplane.MatchRule{grpcRule}}
+
+			withGRPCRoute := func(conf dataplane.Configuration) dataplane.Configuration {
+				conf.HTTPServers[0].PathRules = append(conf.HTTPServers[0].PathRules, grpcPathRule)
+				return conf
+			}
+
+			// the last applied configuration has the GRPCRoute, so only the HTTPRoute is suspected
+			lastApplied := withGRPCRoute(createConf(1, goodRoute))
+			handler.lastAppliedConfiguration = &lastApplied
+
+			conf := withGRPCRoute(createConf(2, goodRoute, badRoute))
+
+			applied, err := handler.applyNginxConf(context.Background(), ctlrZap.New(), grpcGraph, conf)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(applied).To(Equal(withGRPCRoute(createConf(2, goodRoute))))
+
+			badHTTPRoute := grpcGraph.Routes[graph.CreateRouteKey(badRoute)]
+			Expect(handler.quarantinedResources).To(HaveLen(1))
+			Expect(handler.quarantinedResources).To(HaveKey(routeResourceOf(badHTTPRoute)))
+
+			handler.setQuarantinedConditions(grpcGraph)
+
+			Expect(badHTTPRoute.Conditions).To(HaveLen(1))
+			Expect(grpcGraph.Routes[graph.CreateRouteKey(grpcRoute)].Conditions).To(BeEmpty())
+
+			// only the HTTPRoute gets the Event
+			Expect(fakeEventRecorder.Events).To(HaveLen(1))
+		})
+
+		When("NGINX fails to generate the configuration", func() {
+			badPolicy := &ngfAPI.ClientSettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "bad-policy", Generation: 1},
+			}
+
+			var policyGraph *graph.Graph
+
+			BeforeEach(func() {
+				handler.lastAppliedConfiguration = nil
+
+				policyGraph = &graph.Graph{
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						graph.CreateRouteKey(goodRoute): {Source: goodRoute},
+					},
+					NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+						{NsName: types.NamespacedName{Namespace: "test", Name: "bad-policy"}}: {Source: badPolicy},
+					},
+				}
+
+				fakeGenerator.GenerateCalls(func(conf dataplane.Configuration) []file.File {
+					if len(conf.HTTPServers[0].Policies) > 0 {
+						panic("invalid policy")
+					}
+
+					return nil
+				})
+			})
+
+			It("quarantines the offending Policy and sets its condition", func() {
+				conf := createConf(1, goodRoute)
+				conf.HTTPServers[0].Policies = []policies.Policy{badPolicy}
+
+				applied, err := handler.applyNginxConf(context.Background(), ctlrZap.New(), policyGraph, conf)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(applied.HTTPServers[0].Policies).To(BeEmpty())
+				Expect(applied.HTTPServers[0].PathRules).To(Equal(createConf(1, goodRoute).HTTPServers[0].PathRules))
+				// the configuration without the Policy is applied before and after confirming that the Policy fails
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(2))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+
+				expMsg := "The resource was excluded from the NGINX configuration until it changes, " +
+					"because NGINX failed to generate or apply the configuration with it: " +
+					"failed to generate NGINX configuration: invalid policy"
+
+				Expect(fakeEventRecorder.Events).To(HaveLen(1))
+				Expect(<-fakeEventRecorder.Events).To(Equal("Warning PolicyQuarantined " + expMsg))
+
+				handler.setQuarantinedConditions(policyGraph)
+				handler.setQuarantinedConditions(policyGraph)
+
+				for _, pol := range policyGraph.NGFPolicies {
+					Expect(pol.Conditions).To(ConsistOf(staticConds.NewPolicyNotAcceptedQuarantined(expMsg)))
+				}
+
+				for _, route := range policyGraph.Routes {
+					Expect(route.Conditions).To(BeEmpty())
+				}
+			})
+
+			It("returns the error if the resources can't be isolated", func() {
+				fakeGenerator.GenerateCalls(func(_ dataplane.Configuration) []file.File {
+					panic("invalid template")
+				})
+
+				_, err := handler.applyNginxConf(context.Background(), ctlrZap.New(), policyGraph, createConf(1, goodRoute))
+
+				Expect(err).To(MatchError("failed to generate NGINX configuration: invalid template"))
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(0))
+				Expect(fakeEventRecorder.Events).To(BeEmpty())
+			})
+		})
+	})
+
 	It("should set the health checker status properly when there are changes", func() {
 		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
 		batch := []interface{}{e}
@@ -677,6 +1355,66 @@ var _ = Describe("eventHandler", func() {
 		Expect(handler.cfg.nginxConfiguredOnStartChecker.readyCheck(nil)).To(Succeed())
 	})
 
+	It("should rebuild the configuration when the drain deadline of an upstream passed", func() {
+		fakeEventRecorder = record.NewFakeRecorder(10)
+		handler.cfg.eventRecorder = fakeEventRecorder
+
+		fakeProcessor.ProcessReturns(state.NoChange, nil)
+		fakeProcessor.GetLatestGraphReturns(&graph.Graph{
+			Gateway: &graph.Gateway{Source: &gatewayv1.Gateway{}},
+		})
+
+		handler.upstreamDrainer.draining["test_blue_80"] = drainingUpstream{
+			deadline: time.Now().Add(-time.Second),
+			upstream: dataplane.Upstream{Name: "test_blue_80"},
+		}
+
+		batch := []interface{}{&upstreamDrainExpiredEvent{}}
+		handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+		Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+		Expect(fakeGenerator.GenerateArgsForCall(0).Upstreams).To(BeEmpty())
+		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+
+		Expect(fakeEventRecorder.Events).To(HaveLen(1))
+		Expect(<-fakeEventRecorder.Events).To(Equal(
+			"Normal UpstreamDrained The upstream test_blue_80 finished draining and was removed from the " +
+				"NGINX configuration",
+		))
+		Expect(handler.upstreamDrainer.expired(time.Now())).To(BeFalse())
+	})
+
+	It("should capture the external certificates of the certificate source", func() {
+		certs := map[string]*graph.ExternalCertificate{
+			"cafe": {Cert: []byte("cert"), Key: []byte("key")},
+		}
+
+		batch := []interface{}{&externalCertificatesEvent{certificates: certs}}
+		handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+		Expect(fakeProcessor.CaptureExternalCertificatesCallCount()).To(Equal(1))
+		Expect(fakeProcessor.CaptureExternalCertificatesArgsForCall(0)).To(Equal(certs))
+		Expect(fakeProcessor.ProcessCallCount()).To(Equal(1))
+	})
+
+	It("should write the statuses again without reloading NGINX when the API server connection recovered", func() {
+		fakeProcessor.ProcessReturns(state.NoChange, nil)
+		fakeProcessor.GetLatestGraphReturns(&graph.Graph{})
+
+		batch := []interface{}{&apiServerReconnectedEvent{}}
+		handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+		Expect(fakeGenerator.GenerateCallCount()).To(BeZero())
+		Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(BeZero())
+		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(BeZero())
+		Expect(fakeStatusUpdater.UpdateGroupCallCount()).To(Equal(2))
+		Expect(handler.statusesStale).To(BeFalse())
+
+		// the statuses are not written again on the next batch without changes
+		handler.HandleEventBatch(context.Background(), ctlrZap.New(), []interface{}{})
+		Expect(fakeStatusUpdater.UpdateGroupCallCount()).To(Equal(2))
+	})
+
 	It("should panic for an unknown event type", func() {
 		e := &struct{}{}
 
@@ -731,6 +1469,82 @@ var _ = Describe("serversEqual", func() {
 	)
 })
 
+var _ = Describe("upstreamsOnlyChanged", func() {
+	endpoints := []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}}
+
+	DescribeTable("determines if only the upstreams need to be regenerated",
+		func(prevCfg, cfg dataplane.Configuration, upstreamsOnly bool) {
+			Expect(upstreamsOnlyChanged(prevCfg, cfg)).To(Equal(upstreamsOnly))
+		},
+		Entry("upstream endpoints changed",
+			dataplane.Configuration{Upstreams: []dataplane.Upstream{{Name: "up", Endpoints: endpoints}}},
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{Name: "up", Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 80}}},
+				},
+			},
+			true,
+		),
+		Entry("upstream added",
+			dataplane.Configuration{Upstreams: []dataplane.Upstream{{Name: "up", Endpoints: endpoints}}},
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{{Name: "up", Endpoints: endpoints}, {Name: "new", Endpoints: endpoints}},
+			},
+			false,
+		),
+		Entry("upstream renamed",
+			dataplane.Configuration{Upstreams: []dataplane.Upstream{{Name: "up", Endpoints: endpoints}}},
+			dataplane.Configuration{Upstreams: []dataplane.Upstream{{Name: "renamed", Endpoints: endpoints}}},
+			false,
+		),
+		Entry("upstream lost all endpoints",
+			dataplane.Configuration{Upstreams: []dataplane.Upstream{{Name: "up", Endpoints: endpoints}}},
+			dataplane.Configuration{Upstreams: []dataplane.Upstream{{Name: "up"}}},
+			false,
+		),
+		Entry("stream upstream got endpoints",
+			dataplane.Configuration{StreamUpstreams: []dataplane.Upstream{{Name: "up"}}},
+			dataplane.Configuration{StreamUpstreams: []dataplane.Upstream{{Name: "up", Endpoints: endpoints}}},
+			false,
+		),
+		Entry("stream upstream removed",
+			dataplane.Configuration{StreamUpstreams: []dataplane.Upstream{{Name: "up", Endpoints: endpoints}}},
+			dataplane.Configuration{},
+			false,
+		),
+	)
+})
+
+var _ = Describe("upstreamsWithoutEndpointsChanged", func() {
+	endpoints := []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}}
+
+	DescribeTable("determines if an upstream got or lost all of its endpoints",
+		func(prevUpstreams, upstreams []dataplane.Upstream, changed bool) {
+			Expect(upstreamsWithoutEndpointsChanged(prevUpstreams, upstreams)).To(Equal(changed))
+		},
+		Entry("upstream got endpoints",
+			[]dataplane.Upstream{{Name: "up"}},
+			[]dataplane.Upstream{{Name: "up", Endpoints: endpoints}},
+			true,
+		),
+		Entry("upstream lost all endpoints",
+			[]dataplane.Upstream{{Name: "up", Endpoints: endpoints}},
+			[]dataplane.Upstream{{Name: "up"}},
+			true,
+		),
+		Entry("upstream endpoints changed",
+			[]dataplane.Upstream{{Name: "up", Endpoints: endpoints}},
+			[]dataplane.Upstream{{Name: "up", Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 80}}}},
+			false,
+		),
+		Entry("new upstream without endpoints",
+			[]dataplane.Upstream{{Name: "up", Endpoints: endpoints}},
+			[]dataplane.Upstream{{Name: "up", Endpoints: endpoints}, {Name: "new"}},
+			false,
+		),
+	)
+})
+
 var _ = Describe("getGatewayAddresses", func() {
 	It("gets gateway addresses from a Service", func() {
 		fakeClient := fake.NewFakeClient()
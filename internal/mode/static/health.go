@@ -4,12 +4,14 @@ import (
 	"errors"
 	"net/http"
 	"sync"
+	"time"
 )
 
 // newNginxConfiguredOnStartChecker creates a new nginxConfiguredOnStartChecker.
 func newNginxConfiguredOnStartChecker() *nginxConfiguredOnStartChecker {
 	return &nginxConfiguredOnStartChecker{
 		readyCh: make(chan struct{}),
+		start:   time.Now(),
 	}
 }
 
@@ -20,8 +22,10 @@ type nginxConfiguredOnStartChecker struct {
 	firstBatchError error
 	// readyCh is a channel that is initialized in newNginxConfiguredOnStartChecker and represents if the NGF Pod is ready.
 	readyCh chan struct{}
-	lock    sync.RWMutex
-	ready   bool
+	// start is the time when NGF started. It is used to measure how long it takes to configure nginx on start.
+	start time.Time
+	lock  sync.RWMutex
+	ready bool
 }
 
 // readyCheck returns the ready-state of the Pod. It satisfies the controller-runtime Checker type.
@@ -0,0 +1,87 @@
+package static
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller"
+)
+
+// hostnameReportWriter writes the HostnameReport of the Gateway with server-side apply.
+// Like the status updater, it only writes the report when NGF is the leader. Before it is enabled, it saves
+// the latest report, which it writes when it is enabled.
+type hostnameReportWriter struct {
+	k8sClient client.Client
+	// latest is the latest report.
+	latest *ngfAPI.HostnameReport
+	// written is the latest report that was written successfully.
+	written *ngfAPI.HostnameReport
+	logger  logr.Logger
+	lock    sync.Mutex
+	enabled bool
+}
+
+// newHostnameReportWriter creates a new hostnameReportWriter.
+func newHostnameReportWriter(k8sClient client.Client, logger logr.Logger) *hostnameReportWriter {
+	return &hostnameReportWriter{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+// Write writes the report if the writer is enabled, and saves it otherwise. A nil report is not written.
+func (w *hostnameReportWriter) Write(ctx context.Context, report *ngfAPI.HostnameReport) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.latest = report
+
+	if w.enabled {
+		w.write(ctx)
+	}
+}
+
+// Enable enables the writer, writing the latest saved report.
+func (w *hostnameReportWriter) Enable(ctx context.Context) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.enabled = true
+	w.write(ctx)
+}
+
+func (w *hostnameReportWriter) write(ctx context.Context) {
+	if w.latest == nil || equality.Semantic.DeepEqual(w.latest, w.written) {
+		return
+	}
+
+	// The client sets the fields of the object from the response, so we apply a copy to keep the latest report
+	// comparable to the next one.
+	report := w.latest.DeepCopy()
+
+	// Server-side apply creates the report or, if it already exists, updates it. The fields that are not in the
+	// report anymore are removed, because we own them.
+	err := w.k8sClient.Patch(
+		ctx,
+		report,
+		client.Apply,
+		client.FieldOwner(controller.FieldManager),
+		client.ForceOwnership,
+	)
+	if err != nil {
+		w.logger.Error(
+			err,
+			"Failed to write the HostnameReport",
+			"namespace", report.Namespace,
+			"name", report.Name,
+		)
+		return
+	}
+
+	w.written = w.latest
+}
@@ -0,0 +1,106 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func TestHostnameReportWriter(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(ngfAPI.AddToScheme(scheme)).To(Succeed())
+
+	applyFuncs := helpers.ApplyAsUpdateInterceptorFuncs()
+	patches := 0
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(
+				ctx context.Context,
+				c client.WithWatch,
+				obj client.Object,
+				patch client.Patch,
+				opts ...client.PatchOption,
+			) error {
+				patches++
+				return applyFuncs.Patch(ctx, c, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	createReport := func(hostnames ...string) *ngfAPI.HostnameReport {
+		report := &ngfAPI.HostnameReport{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: ngfAPI.SchemeGroupVersion.String(),
+				Kind:       kinds.HostnameReport,
+			},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+		}
+
+		for _, h := range hostnames {
+			report.Hostnames = append(report.Hostnames, ngfAPI.HostnameUsage{
+				Hostname:   h,
+				Namespaces: []string{"test"},
+				Routes: []ngfAPI.HostnameRoute{
+					{Kind: kinds.HTTPRoute, Namespace: "test", Name: "route", Listeners: []string{"http"}},
+				},
+			})
+		}
+
+		return report
+	}
+
+	getHostnames := func() []ngfAPI.HostnameUsage {
+		var report ngfAPI.HostnameReport
+		err := k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "test", Name: "gateway"}, &report)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return report.Hostnames
+	}
+
+	writer := newHostnameReportWriter(k8sClient, logr.Discard())
+
+	// The report is saved until the writer is enabled.
+	writer.Write(context.Background(), createReport("cafe.example.com"))
+
+	err := k8sClient.Get(
+		context.Background(),
+		types.NamespacedName{Namespace: "test", Name: "gateway"},
+		&ngfAPI.HostnameReport{},
+	)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	writer.Enable(context.Background())
+	g.Expect(patches).To(Equal(1))
+	g.Expect(getHostnames()).To(HaveLen(1))
+
+	// A report that didn't change is not written again.
+	writer.Write(context.Background(), createReport("cafe.example.com"))
+	g.Expect(patches).To(Equal(1))
+
+	writer.Write(context.Background(), createReport("cafe.example.com", "tea.example.com"))
+	g.Expect(patches).To(Equal(2))
+	g.Expect(getHostnames()).To(HaveLen(2))
+
+	// A nil report is not written.
+	writer.Write(context.Background(), nil)
+	g.Expect(patches).To(Equal(2))
+	g.Expect(getHostnames()).To(HaveLen(2))
+}
@@ -12,24 +12,30 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	appsv1 "k8s.io/api/apps/v1"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
 	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/tools/record"
 	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	k8spredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	ctlrwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
@@ -41,18 +47,32 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/index"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/predicate"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/featuregates"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/gatewayclass"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/profiling"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/runnables"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/status"
 	ngftypes "github.com/nginxinc/nginx-gateway-fabric/internal/framework/types"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/config"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/debug"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
 	ngxcfg "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/botmitigation"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/clientsettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/defaultcertificate"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/faultinjection"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/geoip"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/modsecurity"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/observability"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/requestheaders"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/securelink"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/staticcontent"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/waf"
 	ngxvalidation "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	ngxruntime "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
@@ -61,11 +81,24 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/telemetry"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/usage"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/webhook"
 )
 
 const (
 	// clusterTimeout is a timeout for connections to the Kubernetes API.
 	clusterTimeout = 10 * time.Second
+	// nginxConfigVerificationPeriod is the period of the verification of the NGINX configuration.
+	nginxConfigVerificationPeriod = 1 * time.Minute
+	// nginxHealthCheckPeriod is the period of the health checks of the NGINX processes.
+	nginxHealthCheckPeriod = 10 * time.Second
+	// upstreamDrainCheckPeriod is the period of the checks of the drain deadlines of the upstreams.
+	upstreamDrainCheckPeriod = 1 * time.Second
+	// externalCertificatesCheckPeriod is the period of the reloads of the external certificates.
+	externalCertificatesCheckPeriod = 30 * time.Second
+	// sessionTicketKeysCheckPeriod is the period of the checks of the rotation of the session ticket keys.
+	sessionTicketKeysCheckPeriod = 1 * time.Minute
+	// apiServerConnectivityCheckPeriod is the period of the checks of the connection to the API server.
+	apiServerConnectivityCheckPeriod = 10 * time.Second
 )
 
 var scheme = runtime.NewScheme()
@@ -80,16 +113,77 @@ func init() {
 	utilruntime.Must(ngfAPI.AddToScheme(scheme))
 	utilruntime.Must(apiext.AddToScheme(scheme))
 	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(authnv1.AddToScheme(scheme))
+	utilruntime.Must(authzv1.AddToScheme(scheme))
+}
+
+// featureCRDs are the kinds of the Gateway API experimental channel CRDs that the features require.
+var featureCRDs = map[featuregates.Feature]schema.GroupVersionKind{
+	config.FeatureTLSRoute:         gatewayv1alpha2.SchemeGroupVersion.WithKind(kinds.TLSRoute),
+	config.FeatureBackendTLSPolicy: gatewayv1alpha3.SchemeGroupVersion.WithKind(kinds.BackendTLSPolicy),
+}
+
+// disableFeaturesWithoutCRDs disables the enabled features whose CRDs are not installed in the cluster,
+// so that NGF can run without the Gateway API experimental channel CRDs instead of failing to watch the resources.
+func disableFeaturesWithoutCRDs(
+	mapper meta.RESTMapper,
+	featureGates *featuregates.FeatureGates,
+	logger logr.Logger,
+) error {
+	for _, feature := range config.GatewayAPIExperimentalFeatures {
+		gvk, ok := featureCRDs[feature]
+		if !ok || !featureGates.Enabled(feature) {
+			continue
+		}
+
+		_, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err == nil {
+			continue
+		}
+
+		if !meta.IsNoMatchError(err) {
+			return fmt.Errorf("cannot get the REST mapping of %s: %w", gvk, err)
+		}
+
+		logger.Error(
+			err,
+			"Disabling feature because its CRD is not installed; "+
+				"install the Gateway API experimental channel CRDs to use it",
+			"feature", feature,
+			"kind", gvk.Kind,
+			"version", gvk.Version,
+		)
+
+		if err := featureGates.SetEnabled(feature, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 //nolint:gocyclo
 func StartManager(cfg config.Config) error {
+	registered := registeredPolicies(policies.Registered())
+	if err := registered.addToScheme(scheme); err != nil {
+		return err
+	}
+
 	nginxChecker := newNginxConfiguredOnStartChecker()
 	mgr, err := createManager(cfg, nginxChecker)
 	if err != nil {
 		return fmt.Errorf("cannot build runtime manager: %w", err)
 	}
 
+	if err := disableFeaturesWithoutCRDs(mgr.GetRESTMapper(), cfg.FeatureGates, cfg.Logger); err != nil {
+		return fmt.Errorf("cannot verify the CRDs of the enabled features: %w", err)
+	}
+
+	v1beta1Kinds, err := detectV1beta1OnlyKinds(mgr.GetRESTMapper(), cfg.Logger)
+	if err != nil {
+		return fmt.Errorf("cannot detect the served versions of the Gateway API resources: %w", err)
+	}
+
 	recorderName := fmt.Sprintf("nginx-gateway-fabric-%s", cfg.GatewayClassName)
 	recorder := mgr.GetEventRecorderFor(recorderName)
 
@@ -99,6 +193,9 @@ func StartManager(cfg config.Config) error {
 	}
 
 	logLevelSetter := newMultiLogLevelSetter(newZapLogLevelSetter(cfg.AtomicLevel), newPromLogLevelSetter(promLogger))
+	if err := logLevelSetter.SetLevel(cfg.LogLevel); err != nil {
+		return fmt.Errorf("error setting initial log level: %w", err)
+	}
 
 	ctx := ctlr.SetupSignalHandler()
 
@@ -107,35 +204,99 @@ func StartManager(cfg config.Config) error {
 		Namespace: cfg.GatewayPodConfig.Namespace,
 		Name:      cfg.ConfigName,
 	}
-	err = registerControllers(ctx, cfg, mgr, recorder, logLevelSetter, eventCh, controlConfigNSName)
+	eventBatchDelay := &events.BatchDelay{}
+
+	var usageSecretNsName *types.NamespacedName
+	if cfg.UsageReportConfig != nil {
+		usageSecretNsName = &cfg.UsageReportConfig.SecretNsName
+	}
+
+	referenced := newReferencedObjects(
+		mgr.GetAPIReader(),
+		usageSecretNsName,
+		cfg.FeatureGates.Enabled(config.FeatureBackendTLSPolicy),
+	)
+
+	err = registerControllers(
+		ctx,
+		cfg,
+		mgr,
+		recorder,
+		logLevelSetter,
+		eventBatchDelay,
+		eventCh,
+		controlConfigNSName,
+		v1beta1Kinds,
+		registered,
+		referenced,
+	)
 	if err != nil {
 		return err
 	}
 
-	// protectedPorts is the map of ports that may not be configured by a listener, and the name of what it is used for
-	protectedPorts := map[int32]string{
-		int32(cfg.MetricsConfig.Port): "MetricsPort", //nolint:gosec // port will not overflow int32
-		int32(cfg.HealthConfig.Port):  "HealthPort",  //nolint:gosec // port will not overflow int32
-	}
+	protectedPorts := createProtectedPorts(cfg)
 
 	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
 
-	genericValidator := ngxvalidation.GenericValidator{}
-	policyManager := createPolicyManager(mustExtractGVK, genericValidator)
-
-	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
-		GatewayCtlrName:  cfg.GatewayCtlrName,
-		GatewayClassName: cfg.GatewayClassName,
-		Logger:           cfg.Logger.WithName("changeProcessor"),
-		Validators: validation.Validators{
-			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
-			GenericValidator:    genericValidator,
-			PolicyValidator:     policyManager,
-		},
-		EventRecorder:  recorder,
-		MustExtractGVK: mustExtractGVK,
-		ProtectedPorts: protectedPorts,
-	})
+	validators := createValidators(mustExtractGVK, cfg.Plus, registered)
+
+	if cfg.WebhookConfig.Enabled {
+		policyTypes := []policies.Policy{
+			&ngfAPI.ClientSettingsPolicy{},
+			&ngfAPI.ObservabilityPolicy{},
+			&ngfAPI.ProxySettingsPolicy{},
+			&ngfAPI.FaultInjectionPolicy{},
+			&ngfAPI.UpstreamSettingsPolicy{},
+			&ngfAPI.WAFPolicy{},
+			&ngfAPI.ModSecurityPolicy{},
+			&ngfAPI.BotMitigationPolicy{},
+			&ngfAPI.GeoIPPolicy{},
+			&ngfAPI.RequestHeadersPolicy{},
+			&ngfAPI.SecureLinkPolicy{},
+			&ngfAPI.StaticContentPolicy{},
+			&ngfAPI.DefaultCertificatePolicy{},
+		}
+		policyTypes = append(policyTypes, registered.objects()...)
+		if err := webhook.Register(mgr, validators, policyTypes); err != nil {
+			return fmt.Errorf("cannot register admission webhooks: %w", err)
+		}
+	}
+
+	processorCfg := state.ChangeProcessorConfig{
+		GatewayCtlrName:    cfg.GatewayCtlrName,
+		GatewayClassName:   cfg.GatewayClassName,
+		Logger:             cfg.Logger.WithName("changeProcessor"),
+		Validators:         validators,
+		EventRecorder:      recorder,
+		MustExtractGVK:     mustExtractGVK,
+		ProtectedPorts:     protectedPorts,
+		RegisteredPolicies: registered.objects(),
+	}
+
+	if cfg.SessionTicketKeysConfig != nil {
+		processorCfg.SessionTicketKeysSecret = &cfg.SessionTicketKeysConfig.SecretNsName
+	}
+
+	processor := state.NewChangeProcessorImpl(processorCfg)
+
+	var externalCertsWatcher *externalCertificatesWatcher
+	if cfg.ExternalCertificatesDir != "" {
+		source := fileCertificateSource{dir: cfg.ExternalCertificatesDir}
+
+		certs, err := source.load()
+		if err != nil {
+			return fmt.Errorf("cannot load external certificates: %w", err)
+		}
+
+		processor.CaptureExternalCertificates(certs)
+
+		externalCertsWatcher = &externalCertificatesWatcher{
+			source:  source,
+			certs:   certs,
+			eventCh: eventCh,
+			logger:  cfg.Logger.WithName("externalCertificatesJob"),
+		}
+	}
 
 	// Clear the configuration folders to ensure that no files are left over in case the control plane was restarted
 	// (this assumes the folders are in a shared volume).
@@ -159,6 +320,10 @@ func StartManager(cfg config.Config) error {
 	var (
 		ngxruntimeCollector ngxruntime.MetricsCollector = collectors.NewManagerNoopCollector()
 		handlerCollector    handlerMetricsCollector     = collectors.NewControllerNoopCollector()
+		// connectivityCollector is the same collector as handlerCollector.
+		connectivityCollector apiServerConnectivityCollector = collectors.NewControllerNoopCollector()
+		// errorLogCollector is the same collector as handlerCollector.
+		errorLogCollector nginxErrorLogCollector = collectors.NewControllerNoopCollector()
 	)
 
 	var ngxPlusClient ngxruntime.NginxPlusClient
@@ -199,7 +364,10 @@ func StartManager(cfg config.Config) error {
 		}
 
 		ngxruntimeCollector = collectors.NewManagerMetricsCollector(constLabels)
-		handlerCollector = collectors.NewControllerCollector(constLabels)
+		controllerCollector := collectors.NewControllerCollector(constLabels)
+		handlerCollector = controllerCollector
+		connectivityCollector = controllerCollector
+		errorLogCollector = controllerCollector
 
 		ngxruntimeCollector, ok := ngxruntimeCollector.(prometheus.Collector)
 		if !ok {
@@ -214,7 +382,17 @@ func StartManager(cfg config.Config) error {
 			ngxCollector,
 			ngxruntimeCollector,
 			handlerCollector,
+			collectors.NewRouteLatencyCollector(constLabels, promLogger),
+			collectors.NewRouteSLICollector(constLabels, promLogger),
+			collectors.NewListenerRequestsCollector(constLabels, promLogger),
+			collectors.NewStreamRouteCollector(constLabels, promLogger),
 		)
+
+		if cfg.Plus {
+			metrics.Registry.MustRegister(
+				collectors.NewUpstreamResponseTimeCollector(ngxPlusClient, constLabels, promLogger),
+			)
+		}
 	}
 
 	statusUpdater := status.NewUpdater(
@@ -224,12 +402,62 @@ func StartManager(cfg config.Config) error {
 
 	groupStatusUpdater := status.NewLeaderAwareGroupUpdater(statusUpdater)
 
+	var hostnameReports *hostnameReportWriter
+	if cfg.FeatureGates.Enabled(config.FeatureHostnameReport) {
+		hostnameReports = newHostnameReportWriter(mgr.GetClient(), cfg.Logger.WithName("hostnameReportWriter"))
+
+		if err = mgr.Add(runnables.NewEnableAfterBecameLeader(hostnameReports.Enable)); err != nil {
+			return fmt.Errorf("cannot register hostname report writer: %w", err)
+		}
+	}
+
+	var externalDNS *externalDNSAnnotator
+	if cfg.FeatureGates.Enabled(config.FeatureExternalDNS) {
+		externalDNS = newExternalDNSAnnotator(
+			mgr.GetClient(),
+			types.NamespacedName{Namespace: cfg.GatewayPodConfig.Namespace, Name: cfg.GatewayPodConfig.ServiceName},
+			cfg.Logger.WithName("externalDNSAnnotator"),
+		)
+
+		if err = mgr.Add(runnables.NewEnableAfterBecameLeader(externalDNS.Enable)); err != nil {
+			return fmt.Errorf("cannot register external-dns annotator: %w", err)
+		}
+	}
+
+	audit := newAuditTrail(mgr.GetClient(), cfg.AuditConfigMapNsName, cfg.Logger.WithName("audit"))
+	if cfg.AuditConfigMapNsName != nil {
+		if err = mgr.Add(runnables.NewEnableAfterBecameLeader(audit.Enable)); err != nil {
+			return fmt.Errorf("cannot register audit trail: %w", err)
+		}
+	}
+
+	generator := ngxcfg.NewGeneratorImpl(cfg.Plus)
+	if cfg.ConfigTemplateOverridesDir != "" {
+		overrides, err := ngxcfg.LoadTemplateOverrides(
+			cfg.ConfigTemplateOverridesDir,
+			cfg.Logger.WithName("templateOverrides"),
+		)
+		if err != nil {
+			// the built-in templates are used for the invalid overrides, so that NGINX is still configured
+			cfg.Logger.Error(err, "Invalid configuration template overrides; using the built-in templates instead")
+		}
+
+		generator = generator.WithTemplateOverrides(overrides)
+	}
+
+	generator = generator.WithUnprivilegedPortOffset(cfg.UnprivilegedPortOffset)
+	if cfg.MetricsConfig.Enabled {
+		generator = generator.WithListenerRequestsRecording()
+	}
+
 	eventHandler := newEventHandlerImpl(eventHandlerConfig{
 		k8sClient:       mgr.GetClient(),
 		processor:       processor,
 		serviceResolver: resolver.NewServiceResolverImpl(mgr.GetClient()),
-		generator:       ngxcfg.NewGeneratorImpl(cfg.Plus),
+		generator:       generator,
 		logLevelSetter:  logLevelSetter,
+		defaultLogLevel: ngfAPI.ControllerLogLevel(cfg.LogLevel),
+		eventBatchDelay: eventBatchDelay,
 		nginxFileMgr: file.NewManagerImpl(
 			cfg.Logger.WithName("nginxFileManager"),
 			file.NewStdLibOSFileManager(),
@@ -241,22 +469,50 @@ func StartManager(cfg config.Config) error {
 			processHandler,
 			ngxruntime.NewVerifyClient(ngxruntime.NginxReloadTimeout),
 		),
-		statusUpdater:                 groupStatusUpdater,
-		eventRecorder:                 recorder,
-		nginxConfiguredOnStartChecker: nginxChecker,
-		controlConfigNSName:           controlConfigNSName,
-		gatewayPodConfig:              cfg.GatewayPodConfig,
-		metricsCollector:              handlerCollector,
-		usageReportConfig:             cfg.UsageReportConfig,
-		usageSecret:                   usageSecret,
-		gatewayCtlrName:               cfg.GatewayCtlrName,
-		updateGatewayClassStatus:      cfg.UpdateGatewayClassStatus,
+		statusUpdater:                  newStatusUpdaterForServedVersions(groupStatusUpdater, v1beta1Kinds),
+		eventRecorder:                  recorder,
+		nginxConfiguredOnStartChecker:  nginxChecker,
+		controlConfigNSName:            controlConfigNSName,
+		gatewayPodConfig:               cfg.GatewayPodConfig,
+		metricsCollector:               handlerCollector,
+		usageReportConfig:              cfg.UsageReportConfig,
+		usageSecret:                    usageSecret,
+		gatewayCtlrName:                cfg.GatewayCtlrName,
+		updateGatewayClassStatus:       cfg.UpdateGatewayClassStatus,
+		certificateExpiryWarningWindow: cfg.CertificateExpiryWarningWindow,
+		hostnameReportWriter:           hostnameReports,
+		externalDNSAnnotator:           externalDNS,
+		auditTrail:                     audit,
+		referencedObjects:              referenced,
 	})
 
+	if cfg.MetricsConfig.DebugEndpoints {
+		err = debug.Register(mgr.AddMetricsServerExtraHandler, debug.Config{
+			GraphGetter:         processor,
+			ConfigurationGetter: eventHandler,
+			Generator:           generator,
+			Authorizer:          debug.NewKubernetesAuthorizer(mgr.GetClient()),
+			ChangeHistory:       audit,
+			Gatherer:            metrics.Registry,
+			Logger:              cfg.Logger.WithName("debug"),
+			BuildInfo: debug.BuildInfo{
+				Version:     cfg.Version,
+				ImageSource: cfg.ImageSource,
+				Plus:        cfg.Plus,
+				Flags:       debugFlags(cfg.Flags),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("cannot register debug endpoints: %w", err)
+		}
+	}
+
 	objects, objectLists := prepareFirstEventBatchPreparerArgs(
 		cfg.GatewayClassName,
 		cfg.GatewayNsName,
-		cfg.ExperimentalFeatures,
+		cfg.FeatureGates,
+		v1beta1Kinds,
+		registered,
 	)
 	firstBatchPreparer := events.NewFirstEventBatchPreparerImpl(mgr.GetCache(), objects, objectLists)
 	eventLoop := events.NewEventLoop(
@@ -264,6 +520,7 @@ func StartManager(cfg config.Config) error {
 		cfg.Logger.WithName("eventLoop"),
 		eventHandler,
 		firstBatchPreparer,
+		events.WithBatchDelay(eventBatchDelay),
 	)
 
 	if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: eventLoop}); err != nil {
@@ -274,6 +531,76 @@ func StartManager(cfg config.Config) error {
 		return fmt.Errorf("cannot register status updater: %w", err)
 	}
 
+	// Every replica configures and manages its own NGINX, so the jobs below that act on NGINX and the NGINX error
+	// log processor run on every replica, not only on the leader.
+	nginxConfigVerificationJob := createNginxConfigVerificationJob(cfg, eventHandler, nginxChecker.getReadyCh())
+	if err = mgr.Add(nginxConfigVerificationJob); err != nil {
+		return fmt.Errorf("cannot register NGINX configuration verification job: %w", err)
+	}
+
+	nginxHealthCheckJob := createNginxHealthCheckJob(cfg, eventHandler, nginxChecker.getReadyCh())
+	if err = mgr.Add(nginxHealthCheckJob); err != nil {
+		return fmt.Errorf("cannot register NGINX health check job: %w", err)
+	}
+
+	upstreamDrainJob := createUpstreamDrainJob(cfg, eventHandler, eventCh, nginxChecker.getReadyCh())
+	if err = mgr.Add(upstreamDrainJob); err != nil {
+		return fmt.Errorf("cannot register upstream drain job: %w", err)
+	}
+
+	apiServerJob, err := createAPIServerConnectivityJob(
+		mgr,
+		cfg,
+		connectivityCollector,
+		eventCh,
+		nginxChecker.getReadyCh(),
+	)
+	if err != nil {
+		return fmt.Errorf("cannot create API server connectivity job: %w", err)
+	}
+	if err = mgr.Add(apiServerJob); err != nil {
+		return fmt.Errorf("cannot register API server connectivity job: %w", err)
+	}
+
+	errorLogProcessor := &nginxErrorLogProcessor{
+		latestGraph:   processor.GetLatestGraph,
+		eventRecorder: recorder,
+		metrics:       errorLogCollector,
+		logger:        cfg.Logger.WithName("nginxErrorLogProcessor"),
+		socket:        ngxcfg.ErrorLogSocket,
+	}
+	if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: errorLogProcessor}); err != nil {
+		return fmt.Errorf("cannot register NGINX error log processor: %w", err)
+	}
+
+	if externalCertsWatcher != nil {
+		job := createExternalCertificatesJob(externalCertsWatcher, nginxChecker.getReadyCh())
+		if err = mgr.Add(job); err != nil {
+			return fmt.Errorf("cannot register external certificates job: %w", err)
+		}
+	}
+
+	if cfg.SessionTicketKeysConfig != nil {
+		job := createSessionTicketKeysJob(cfg, mgr.GetClient(), nginxChecker.getReadyCh())
+		if err = mgr.Add(job); err != nil {
+			return fmt.Errorf("cannot register session ticket keys job: %w", err)
+		}
+	}
+
+	if cfg.CertificateExpiryWarningWindow > 0 {
+		job := createCertificateExpiryJob(cfg, eventHandler, nginxChecker.getReadyCh())
+		if err = mgr.Add(job); err != nil {
+			return fmt.Errorf("cannot register certificate expiry job: %w", err)
+		}
+	}
+
+	if cfg.ProfilingConfig.Enabled {
+		profilingServer := profiling.NewServer(cfg.ProfilingConfig.Port, cfg.Logger.WithName("profiling"))
+		if err = mgr.Add(profilingServer); err != nil {
+			return fmt.Errorf("cannot register profiling server: %w", err)
+		}
+	}
+
 	if cfg.ProductTelemetryConfig.Enabled {
 		dataCollector := telemetry.NewDataCollectorImpl(telemetry.DataCollectorConfig{
 			K8sClientReader:     mgr.GetAPIReader(),
@@ -284,8 +611,10 @@ func StartManager(cfg config.Config) error {
 				Namespace: cfg.GatewayPodConfig.Namespace,
 				Name:      cfg.GatewayPodConfig.Name,
 			},
-			ImageSource: cfg.ImageSource,
-			Flags:       cfg.Flags,
+			ImageSource:  cfg.ImageSource,
+			Flags:        cfg.Flags,
+			FeatureGates: cfg.FeatureGates.EnabledFeatures(),
+			Plus:         cfg.Plus,
 		})
 
 		job, err := createTelemetryJob(cfg, dataCollector, nginxChecker.getReadyCh())
@@ -307,20 +636,123 @@ func StartManager(cfg config.Config) error {
 	return mgr.Start(ctx)
 }
 
+// createProtectedPorts returns the map of ports that may not be configured by a listener, and the name of what
+// it is used for.
+func createProtectedPorts(cfg config.Config) map[int32]string {
+	protectedPorts := map[int32]string{
+		int32(cfg.MetricsConfig.Port): "MetricsPort", //nolint:gosec // port will not overflow int32
+		int32(cfg.HealthConfig.Port):  "HealthPort",  //nolint:gosec // port will not overflow int32
+	}
+	if cfg.WebhookConfig.Enabled {
+		protectedPorts[int32(cfg.WebhookConfig.Port)] = "WebhookPort" //nolint:gosec // port will not overflow int32
+	}
+	if cfg.ProfilingConfig.Enabled {
+		protectedPorts[int32(cfg.ProfilingConfig.Port)] = "ProfilingPort" //nolint:gosec // port will not overflow int32
+	}
+
+	offset := cfg.UnprivilegedPortOffset
+	if offset == 0 {
+		return protectedPorts
+	}
+
+	// NGINX binds to the privileged ports of the listeners plus the offset, so a privileged port may not be
+	// configured if NGINX would bind it to a protected port, and the ports that NGINX binds to for the privileged
+	// ports may not be configured by another listener.
+	ports := make(map[int32]string, len(protectedPorts)+ngxcfg.PrivilegedPortLimit)
+	for port := int32(1); port < ngxcfg.PrivilegedPortLimit; port++ {
+		ports[port+offset] = "UnprivilegedPort"
+	}
+
+	for port, usage := range protectedPorts {
+		ports[port] = usage
+		if privileged := port - offset; privileged > 0 && privileged < ngxcfg.PrivilegedPortLimit {
+			ports[privileged] = usage
+		}
+	}
+
+	return ports
+}
+
+func createValidators(
+	mustExtractGVK kinds.MustExtractGVK,
+	plus bool,
+	registered registeredPolicies,
+) validation.Validators {
+	genericValidator := ngxvalidation.GenericValidator{Plus: plus}
+
+	return validation.Validators{
+		HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+		GenericValidator:    genericValidator,
+		PolicyValidator:     createPolicyManager(mustExtractGVK, genericValidator, plus, registered),
+	}
+}
+
 func createPolicyManager(
 	mustExtractGVK kinds.MustExtractGVK,
 	validator validation.GenericValidator,
+	plus bool,
+	registered registeredPolicies,
 ) *policies.CompositeValidator {
 	cfgs := []policies.ManagerConfig{
 		{
 			GVK:       mustExtractGVK(&ngfAPI.ClientSettingsPolicy{}),
 			Validator: clientsettings.NewValidator(validator),
+			Merger:    clientsettings.NewMerger(),
 		},
 		{
 			GVK:       mustExtractGVK(&ngfAPI.ObservabilityPolicy{}),
 			Validator: observability.NewValidator(validator),
 		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.ProxySettingsPolicy{}),
+			Validator: proxysettings.NewValidator(validator),
+			Merger:    proxysettings.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.FaultInjectionPolicy{}),
+			Validator: faultinjection.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.UpstreamSettingsPolicy{}),
+			Validator: upstreamsettings.NewValidator(validator, plus),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.WAFPolicy{}),
+			Validator: waf.NewValidator(validator, plus),
+			Merger:    waf.NewMerger(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.ModSecurityPolicy{}),
+			Validator: modsecurity.NewValidator(plus),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.BotMitigationPolicy{}),
+			Validator: botmitigation.NewValidator(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.GeoIPPolicy{}),
+			Validator: geoip.NewValidator(),
+		},
+		{
+			GVK:            mustExtractGVK(&ngfAPI.RequestHeadersPolicy{}),
+			Validator:      requestheaders.NewValidator(validator),
+			Merger:         requestheaders.NewMerger(),
+			LocationScoped: true,
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.SecureLinkPolicy{}),
+			Validator: securelink.NewValidator(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.StaticContentPolicy{}),
+			Validator: staticcontent.NewValidator(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPI.DefaultCertificatePolicy{}),
+			Validator: defaultcertificate.NewValidator(),
+		},
 	}
+	cfgs = append(cfgs, registered.managerConfigs(mustExtractGVK)...)
 
 	return policies.NewManager(mustExtractGVK, cfgs...)
 }
@@ -345,12 +777,29 @@ func createManager(cfg config.Config, nginxChecker *nginxConfiguredOnStartChecke
 			// All of our controllers still need to work in case of non-leader pods
 			NeedLeaderElection: helpers.GetPointer(false),
 		},
+		Cache: cache.Options{
+			DefaultTransform: stripUnusedMetadata,
+		},
+		// Only the metadata of the Secrets and the ConfigMaps is cached (see referencedObjects), so the client
+		// gets them from the API server, rather than caching all of them in full.
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				DisableFor: []client.Object{&apiv1.Secret{}, &apiv1.ConfigMap{}},
+			},
+		},
 	}
 
 	if cfg.HealthConfig.Enabled {
 		options.HealthProbeBindAddress = fmt.Sprintf(":%d", cfg.HealthConfig.Port)
 	}
 
+	if cfg.WebhookConfig.Enabled {
+		options.WebhookServer = ctlrwebhook.NewServer(ctlrwebhook.Options{
+			Port:    cfg.WebhookConfig.Port,
+			CertDir: cfg.WebhookConfig.CertDir,
+		})
+	}
+
 	clusterCfg := ctlr.GetConfigOrDie()
 	clusterCfg.Timeout = clusterTimeout
 
@@ -374,8 +823,12 @@ func registerControllers(
 	mgr manager.Manager,
 	recorder record.EventRecorder,
 	logLevelSetter logLevelSetter,
+	eventBatchDelay *events.BatchDelay,
 	eventCh chan interface{},
 	controlConfigNSName types.NamespacedName,
+	v1beta1Kinds v1beta1OnlyKinds,
+	registered registeredPolicies,
+	referenced *referencedObjects,
 ) error {
 	type ctlrCfg struct {
 		name       string
@@ -388,6 +841,15 @@ func registerControllers(
 		schema.GroupVersionKind{Group: apiext.GroupName, Version: "v1", Kind: "CustomResourceDefinition"},
 	)
 
+	namespaceWithGVK := apiv1.Namespace{}
+	namespaceWithGVK.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("Namespace"))
+
+	secretWithGVK := apiv1.Secret{}
+	secretWithGVK.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("Secret"))
+
+	configMapWithGVK := apiv1.ConfigMap{}
+	configMapWithGVK.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("ConfigMap"))
+
 	// Note: for any new object type or a change to the existing one,
 	// make sure to also update prepareFirstEventBatchPreparerArgs()
 	controllerRegCfgs := []ctlrCfg{
@@ -406,7 +868,13 @@ func registerControllers(
 			objectType: &gatewayv1.Gateway{},
 			options: func() []controller.Option {
 				options := []controller.Option{
-					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+					controller.WithK8sPredicate(
+						k8spredicate.Or(
+							k8spredicate.GenerationChangedPredicate{},
+							predicate.AnnotationPredicate{Annotation: ngfAPI.ErrorLogLevelAnnotation},
+							predicate.AnnotationPredicate{Annotation: ngfAPI.HTTPSRedirectListenersAnnotation},
+						),
+					),
 				}
 				if cfg.GatewayNsName != nil {
 					options = append(
@@ -427,7 +895,12 @@ func registerControllers(
 			objectType: &apiv1.Service{},
 			name:       "user-service", // unique controller names are needed and we have multiple Service ctlrs
 			options: []controller.Option{
-				controller.WithK8sPredicate(predicate.ServicePortsChangedPredicate{}),
+				controller.WithK8sPredicate(
+					k8spredicate.Or(
+						predicate.ServicePortsChangedPredicate{},
+						predicate.AnnotationPredicate{Annotation: ngfAPI.ScaleFromZeroAnnotation},
+					),
+				),
 			},
 		},
 		{
@@ -444,8 +917,11 @@ func registerControllers(
 			}(),
 		},
 		{
-			objectType: &apiv1.Secret{},
+			// Only the referenced Secrets are processed, so only the metadata of the Secrets is cached.
+			objectType: &secretWithGVK,
 			options: []controller.Option{
+				controller.WithOnlyMetadataCache(),
+				controller.WithNamespacedNameFilter(referenced.secrets.filter),
 				controller.WithK8sPredicate(k8spredicate.ResourceVersionChangedPredicate{}),
 			},
 		},
@@ -457,8 +933,10 @@ func registerControllers(
 			},
 		},
 		{
-			objectType: &apiv1.Namespace{},
+			// Only the names and the labels of the Namespaces are used, so we only watch their metadata.
+			objectType: &namespaceWithGVK,
 			options: []controller.Option{
+				controller.WithOnlyMetadata(),
 				controller.WithK8sPredicate(k8spredicate.LabelChangedPredicate{}),
 			},
 		},
@@ -501,29 +979,149 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPI.ProxySettingsPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.FaultInjectionPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.UpstreamSettingsPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.WAFPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.ModSecurityPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.BotMitigationPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.GeoIPPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.RequestHeadersPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.SecureLinkPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.StaticContentPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.DefaultCertificatePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.RateLimitFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.ResponseHeaderFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.QueryParameterFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPI.ABTestFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 	}
 
-	if cfg.ExperimentalFeatures {
-		gwExpFeatures := []ctlrCfg{
-			{
+	for _, pol := range registered.objects() {
+		controllerRegCfgs = append(controllerRegCfgs,
+			ctlrCfg{
+				objectType: pol,
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
+		)
+	}
+
+	if cfg.FeatureGates.Enabled(config.FeatureBackendTLSPolicy) {
+		controllerRegCfgs = append(controllerRegCfgs,
+			ctlrCfg{
 				objectType: &gatewayv1alpha3.BackendTLSPolicy{},
 				options: []controller.Option{
 					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 				},
 			},
-			{
-				// FIXME(ciarams87): If possible, use only metadata predicate
-				// https://github.com/nginxinc/nginx-gateway-fabric/issues/1545
-				objectType: &apiv1.ConfigMap{},
+			ctlrCfg{
+				// Only the referenced ConfigMaps are processed, so only the metadata of the ConfigMaps is cached.
+				objectType: &configMapWithGVK,
+				options: []controller.Option{
+					controller.WithOnlyMetadataCache(),
+					controller.WithNamespacedNameFilter(referenced.configMaps.filter),
+					controller.WithK8sPredicate(k8spredicate.ResourceVersionChangedPredicate{}),
+				},
 			},
-			{
+		)
+	}
+
+	if cfg.FeatureGates.Enabled(config.FeatureTLSRoute) {
+		controllerRegCfgs = append(controllerRegCfgs,
+			ctlrCfg{
 				objectType: &gatewayv1alpha2.TLSRoute{},
 				options: []controller.Option{
 					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 				},
 			},
-		}
-		controllerRegCfgs = append(controllerRegCfgs, gwExpFeatures...)
+		)
+	}
+
+	if cfg.FeatureGates.Enabled(config.FeatureSnippetsFilter) {
+		controllerRegCfgs = append(controllerRegCfgs,
+			ctlrCfg{
+				objectType: &ngfAPI.SnippetsFilter{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
+		)
 	}
 
 	if cfg.ConfigName != "" {
@@ -540,6 +1138,8 @@ func registerControllers(
 			recorder,
 			logLevelSetter,
 			controlConfigNSName,
+			ngfAPI.ControllerLogLevel(cfg.LogLevel),
+			eventBatchDelay,
 		); err != nil {
 			return fmt.Errorf("error setting initial control plane configuration: %w", err)
 		}
@@ -551,9 +1151,12 @@ func registerControllers(
 			name = regCfg.name
 		}
 
+		// The events of the resources that are served only in v1beta1 are converted to v1 by the event handler.
+		objectType := v1beta1Kinds.servedObject(regCfg.objectType)
+
 		if err := controller.Register(
 			ctx,
-			regCfg.objectType,
+			objectType,
 			name,
 			mgr,
 			eventCh,
@@ -662,10 +1265,189 @@ func createUsageWarningJob(cfg config.Config, readyCh <-chan struct{}) *runnable
 	}
 }
 
+// createCertificateExpiryJob creates a job that periodically warns about the expiring certificates of the listeners.
+// Only the leader emits the warning Events, so that they are not duplicated.
+func createCertificateExpiryJob(
+	cfg config.Config,
+	handler *eventHandlerImpl,
+	readyCh <-chan struct{},
+) *runnables.Leader {
+	worker := func(_ context.Context) {
+		handler.warnExpiringCertificates(time.Now())
+	}
+
+	return &runnables.Leader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  worker,
+			Logger:  cfg.Logger.WithName("certificateExpiryJob"),
+			Period:  1 * time.Hour,
+			ReadyCh: readyCh,
+		}),
+	}
+}
+
+// createUpstreamDrainJob creates a job that periodically checks the drain deadlines of the upstreams that are
+// no longer referenced, and notifies the event loop once a deadline passed, so that the upstream is removed
+// from the NGINX configuration.
+func createUpstreamDrainJob(
+	cfg config.Config,
+	handler *eventHandlerImpl,
+	eventCh chan<- interface{},
+	readyCh <-chan struct{},
+) *runnables.LeaderOrNonLeader {
+	worker := func(ctx context.Context) {
+		if !handler.upstreamDrainer.expired(time.Now()) {
+			return
+		}
+
+		select {
+		case eventCh <- &upstreamDrainExpiredEvent{}:
+		case <-ctx.Done():
+		}
+	}
+
+	return &runnables.LeaderOrNonLeader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  worker,
+			Logger:  cfg.Logger.WithName("upstreamDrainJob"),
+			Period:  upstreamDrainCheckPeriod,
+			ReadyCh: readyCh,
+		}),
+	}
+}
+
+// createAPIServerConnectivityJob creates a job that periodically probes the version endpoint of the API server.
+// It reports the disconnections in the metrics, and notifies the event loop once the connection recovers,
+// so that the statuses are written again.
+func createAPIServerConnectivityJob(
+	mgr manager.Manager,
+	cfg config.Config,
+	collector apiServerConnectivityCollector,
+	eventCh chan<- interface{},
+	readyCh <-chan struct{},
+) (*runnables.LeaderOrNonLeader, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	monitor := &apiServerConnectivityMonitor{
+		probe: func(ctx context.Context) error {
+			return discoveryClient.RESTClient().Get().AbsPath("/version").Do(ctx).Error()
+		},
+		metrics: collector,
+		eventCh: eventCh,
+		logger:  cfg.Logger.WithName("apiServerConnectivityJob"),
+	}
+
+	return &runnables.LeaderOrNonLeader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  monitor.check,
+			Logger:  monitor.logger,
+			Period:  apiServerConnectivityCheckPeriod,
+			ReadyCh: readyCh,
+		}),
+	}, nil
+}
+
+// createExternalCertificatesJob creates a job that periodically reads the external certificates from their
+// directory, and notifies the event loop when they changed, so that NGINX is configured with the rotated
+// certificates.
+func createExternalCertificatesJob(
+	watcher *externalCertificatesWatcher,
+	readyCh <-chan struct{},
+) *runnables.LeaderOrNonLeader {
+	return &runnables.LeaderOrNonLeader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  watcher.check,
+			Logger:  watcher.logger,
+			Period:  externalCertificatesCheckPeriod,
+			ReadyCh: readyCh,
+		}),
+	}
+}
+
+// createSessionTicketKeysJob creates a job that periodically checks if the session ticket keys must be rotated,
+// and rotates them.
+func createSessionTicketKeysJob(
+	cfg config.Config,
+	k8sClient client.Client,
+	readyCh <-chan struct{},
+) *runnables.Leader {
+	logger := cfg.Logger.WithName("sessionTicketKeysJob")
+
+	rotator := &sessionTicketKeysRotator{
+		k8sClient: k8sClient,
+		logger:    logger,
+		secret:    cfg.SessionTicketKeysConfig.SecretNsName,
+		period:    cfg.SessionTicketKeysConfig.RotationPeriod,
+	}
+
+	worker := func(ctx context.Context) {
+		rotator.rotate(ctx, time.Now())
+	}
+
+	return &runnables.Leader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  worker,
+			Logger:  logger,
+			Period:  sessionTicketKeysCheckPeriod,
+			ReadyCh: readyCh,
+		}),
+	}
+}
+
+// createNginxHealthCheckJob creates a job that periodically checks the health of the NGINX processes, and restarts
+// NGINX if it is unhealthy.
+func createNginxHealthCheckJob(
+	cfg config.Config,
+	handler *eventHandlerImpl,
+	readyCh <-chan struct{},
+) *runnables.LeaderOrNonLeader {
+	logger := cfg.Logger.WithName("nginxHealthCheckJob")
+	worker := func(ctx context.Context) {
+		handler.checkNginxHealth(ctx, logger)
+	}
+
+	return &runnables.LeaderOrNonLeader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  worker,
+			Logger:  logger,
+			Period:  nginxHealthCheckPeriod,
+			ReadyCh: readyCh,
+		}),
+	}
+}
+
+// createNginxConfigVerificationJob creates a job that periodically checks the configuration files and the version
+// of the configuration that NGINX runs against the latest loaded configuration, and writes the files again and
+// reloads NGINX if they drifted.
+func createNginxConfigVerificationJob(
+	cfg config.Config,
+	handler *eventHandlerImpl,
+	readyCh <-chan struct{},
+) *runnables.LeaderOrNonLeader {
+	logger := cfg.Logger.WithName("nginxConfigVerificationJob")
+	worker := func(ctx context.Context) {
+		handler.verifyNginxConf(ctx, logger)
+	}
+
+	return &runnables.LeaderOrNonLeader{
+		Runnable: runnables.NewCronJob(runnables.CronJobConfig{
+			Worker:  worker,
+			Logger:  logger,
+			Period:  nginxConfigVerificationPeriod,
+			ReadyCh: readyCh,
+		}),
+	}
+}
+
 func prepareFirstEventBatchPreparerArgs(
 	gcName string,
 	gwNsName *types.NamespacedName,
-	enableExperimentalFeatures bool,
+	featureGates *featuregates.FeatureGates,
+	v1beta1Kinds v1beta1OnlyKinds,
+	registered registeredPolicies,
 ) ([]client.Object, []client.ObjectList) {
 	objects := []client.Object{
 		&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: gcName}},
@@ -680,10 +1462,12 @@ func prepareFirstEventBatchPreparerArgs(
 		},
 	)
 
+	namespaceMetadataList := &metav1.PartialObjectMetadataList{}
+	namespaceMetadataList.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("Namespace"))
+
 	objectLists := []client.ObjectList{
 		&apiv1.ServiceList{},
-		&apiv1.SecretList{},
-		&apiv1.NamespaceList{},
+		namespaceMetadataList,
 		&discoveryV1.EndpointSliceList{},
 		&gatewayv1.HTTPRouteList{},
 		&gatewayv1beta1.ReferenceGrantList{},
@@ -691,16 +1475,35 @@ func prepareFirstEventBatchPreparerArgs(
 		&gatewayv1.GRPCRouteList{},
 		&ngfAPI.ClientSettingsPolicyList{},
 		&ngfAPI.ObservabilityPolicyList{},
+		&ngfAPI.ProxySettingsPolicyList{},
+		&ngfAPI.FaultInjectionPolicyList{},
+		&ngfAPI.UpstreamSettingsPolicyList{},
+		&ngfAPI.WAFPolicyList{},
+		&ngfAPI.ModSecurityPolicyList{},
+		&ngfAPI.BotMitigationPolicyList{},
+		&ngfAPI.GeoIPPolicyList{},
+		&ngfAPI.RequestHeadersPolicyList{},
+		&ngfAPI.SecureLinkPolicyList{},
+		&ngfAPI.StaticContentPolicyList{},
+		&ngfAPI.DefaultCertificatePolicyList{},
+		&ngfAPI.RateLimitFilterList{},
+		&ngfAPI.ResponseHeaderFilterList{},
+		&ngfAPI.QueryParameterFilterList{},
+		&ngfAPI.ABTestFilterList{},
 		partialObjectMetadataList,
 	}
+	objectLists = append(objectLists, registered.objectLists()...)
 
-	if enableExperimentalFeatures {
-		objectLists = append(
-			objectLists,
-			&gatewayv1alpha3.BackendTLSPolicyList{},
-			&apiv1.ConfigMapList{},
-			&gatewayv1alpha2.TLSRouteList{},
-		)
+	if featureGates.Enabled(config.FeatureBackendTLSPolicy) {
+		objectLists = append(objectLists, &gatewayv1alpha3.BackendTLSPolicyList{})
+	}
+
+	if featureGates.Enabled(config.FeatureTLSRoute) {
+		objectLists = append(objectLists, &gatewayv1alpha2.TLSRouteList{})
+	}
+
+	if featureGates.Enabled(config.FeatureSnippetsFilter) {
+		objectLists = append(objectLists, &ngfAPI.SnippetsFilterList{})
 	}
 
 	if gwNsName == nil {
@@ -712,6 +1515,14 @@ func prepareFirstEventBatchPreparerArgs(
 		)
 	}
 
+	for i := range objects {
+		objects[i] = v1beta1Kinds.servedObject(objects[i])
+	}
+
+	for i := range objectLists {
+		objectLists[i] = v1beta1Kinds.servedObjectList(objectLists[i])
+	}
+
 	return objects, objectLists
 }
 
@@ -721,6 +1532,8 @@ func setInitialConfig(
 	eventRecorder record.EventRecorder,
 	logLevelSetter logLevelSetter,
 	configName types.NamespacedName,
+	defaultLogLevel ngfAPI.ControllerLogLevel,
+	eventBatchDelay *events.BatchDelay,
 ) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -746,7 +1559,15 @@ func setInitialConfig(
 
 	// status is not updated until the status updater's cache is started and the
 	// resource is processed by the controller
-	return updateControlPlane(&conf, logger, eventRecorder, configName, logLevelSetter)
+	return updateControlPlane(
+		&conf,
+		logger,
+		eventRecorder,
+		configName,
+		logLevelSetter,
+		defaultLogLevel,
+		eventBatchDelay,
+	)
 }
 
 func getMetricsOptions(cfg config.MetricsConfig) metricsserver.Options {
@@ -761,3 +1582,13 @@ func getMetricsOptions(cfg config.MetricsConfig) metricsserver.Options {
 
 	return metricsOptions
 }
+
+// debugFlags maps the names of the flags to their values, as they are reported by the product telemetry.
+func debugFlags(flags config.Flags) map[string]string {
+	m := make(map[string]string, len(flags.Names))
+	for i, name := range flags.Names {
+		m[name] = flags.Values[i]
+	}
+
+	return m
+}
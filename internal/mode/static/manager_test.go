@@ -3,10 +3,12 @@ package static
 import (
 	"testing"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,12 +36,15 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 		},
 	)
 
+	namespaceMetadataList := &metav1.PartialObjectMetadataList{}
+	namespaceMetadataList.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("Namespace"))
+
 	tests := []struct {
-		name                string
 		gwNsName            *types.NamespacedName
+		name                string
+		featureGates        string
 		expectedObjects     []client.Object
 		expectedObjectLists []client.ObjectList
-		experimentalEnabled bool
 	}{
 		{
 			name:     "gwNsName is nil",
@@ -49,8 +54,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 			},
 			expectedObjectLists: []client.ObjectList{
 				&apiv1.ServiceList{},
-				&apiv1.SecretList{},
-				&apiv1.NamespaceList{},
+				namespaceMetadataList,
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1.GatewayList{},
@@ -60,6 +64,21 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				partialObjectMetadataList,
 				&ngfAPI.ClientSettingsPolicyList{},
 				&ngfAPI.ObservabilityPolicyList{},
+				&ngfAPI.ProxySettingsPolicyList{},
+				&ngfAPI.FaultInjectionPolicyList{},
+				&ngfAPI.UpstreamSettingsPolicyList{},
+				&ngfAPI.WAFPolicyList{},
+				&ngfAPI.ModSecurityPolicyList{},
+				&ngfAPI.BotMitigationPolicyList{},
+				&ngfAPI.GeoIPPolicyList{},
+				&ngfAPI.RequestHeadersPolicyList{},
+				&ngfAPI.SecureLinkPolicyList{},
+				&ngfAPI.StaticContentPolicyList{},
+				&ngfAPI.DefaultCertificatePolicyList{},
+				&ngfAPI.RateLimitFilterList{},
+				&ngfAPI.ResponseHeaderFilterList{},
+				&ngfAPI.QueryParameterFilterList{},
+				&ngfAPI.ABTestFilterList{},
 			},
 		},
 		{
@@ -74,8 +93,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 			},
 			expectedObjectLists: []client.ObjectList{
 				&apiv1.ServiceList{},
-				&apiv1.SecretList{},
-				&apiv1.NamespaceList{},
+				namespaceMetadataList,
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
@@ -84,10 +102,25 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				partialObjectMetadataList,
 				&ngfAPI.ClientSettingsPolicyList{},
 				&ngfAPI.ObservabilityPolicyList{},
+				&ngfAPI.ProxySettingsPolicyList{},
+				&ngfAPI.FaultInjectionPolicyList{},
+				&ngfAPI.UpstreamSettingsPolicyList{},
+				&ngfAPI.WAFPolicyList{},
+				&ngfAPI.ModSecurityPolicyList{},
+				&ngfAPI.BotMitigationPolicyList{},
+				&ngfAPI.GeoIPPolicyList{},
+				&ngfAPI.RequestHeadersPolicyList{},
+				&ngfAPI.SecureLinkPolicyList{},
+				&ngfAPI.StaticContentPolicyList{},
+				&ngfAPI.DefaultCertificatePolicyList{},
+				&ngfAPI.RateLimitFilterList{},
+				&ngfAPI.ResponseHeaderFilterList{},
+				&ngfAPI.QueryParameterFilterList{},
+				&ngfAPI.ABTestFilterList{},
 			},
 		},
 		{
-			name: "gwNsName is not nil and experimental enabled",
+			name: "gwNsName is not nil and experimental features enabled",
 			gwNsName: &types.NamespacedName{
 				Namespace: "test",
 				Name:      "my-gateway",
@@ -98,9 +131,7 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 			},
 			expectedObjectLists: []client.ObjectList{
 				&apiv1.ServiceList{},
-				&apiv1.SecretList{},
-				&apiv1.NamespaceList{},
-				&apiv1.ConfigMapList{},
+				namespaceMetadataList,
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
@@ -111,8 +142,61 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPI.ClientSettingsPolicyList{},
 				&ngfAPI.ObservabilityPolicyList{},
+				&ngfAPI.ProxySettingsPolicyList{},
+				&ngfAPI.FaultInjectionPolicyList{},
+				&ngfAPI.UpstreamSettingsPolicyList{},
+				&ngfAPI.WAFPolicyList{},
+				&ngfAPI.ModSecurityPolicyList{},
+				&ngfAPI.BotMitigationPolicyList{},
+				&ngfAPI.GeoIPPolicyList{},
+				&ngfAPI.RequestHeadersPolicyList{},
+				&ngfAPI.SecureLinkPolicyList{},
+				&ngfAPI.StaticContentPolicyList{},
+				&ngfAPI.DefaultCertificatePolicyList{},
+				&ngfAPI.RateLimitFilterList{},
+				&ngfAPI.ResponseHeaderFilterList{},
+				&ngfAPI.QueryParameterFilterList{},
+				&ngfAPI.ABTestFilterList{},
+				&ngfAPI.SnippetsFilterList{},
 			},
-			experimentalEnabled: true,
+			featureGates: "TLSRoute=true,BackendTLSPolicy=true,SnippetsFilter=true",
+		},
+		{
+			name:     "gwNsName is nil and TLSRoute enabled",
+			gwNsName: nil,
+			expectedObjects: []client.Object{
+				&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			},
+			expectedObjectLists: []client.ObjectList{
+				&apiv1.ServiceList{},
+				namespaceMetadataList,
+				&discoveryV1.EndpointSliceList{},
+				&gatewayv1.HTTPRouteList{},
+				&gatewayv1.GatewayList{},
+				&gatewayv1beta1.ReferenceGrantList{},
+				&ngfAPI.NginxProxyList{},
+				&gatewayv1.GRPCRouteList{},
+				partialObjectMetadataList,
+				&gatewayv1alpha2.TLSRouteList{},
+				&ngfAPI.ClientSettingsPolicyList{},
+				&ngfAPI.ObservabilityPolicyList{},
+				&ngfAPI.ProxySettingsPolicyList{},
+				&ngfAPI.FaultInjectionPolicyList{},
+				&ngfAPI.UpstreamSettingsPolicyList{},
+				&ngfAPI.WAFPolicyList{},
+				&ngfAPI.ModSecurityPolicyList{},
+				&ngfAPI.BotMitigationPolicyList{},
+				&ngfAPI.GeoIPPolicyList{},
+				&ngfAPI.RequestHeadersPolicyList{},
+				&ngfAPI.SecureLinkPolicyList{},
+				&ngfAPI.StaticContentPolicyList{},
+				&ngfAPI.DefaultCertificatePolicyList{},
+				&ngfAPI.RateLimitFilterList{},
+				&ngfAPI.ResponseHeaderFilterList{},
+				&ngfAPI.QueryParameterFilterList{},
+				&ngfAPI.ABTestFilterList{},
+			},
+			featureGates: "TLSRoute=true",
 		},
 	}
 
@@ -121,7 +205,10 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			objects, objectLists := prepareFirstEventBatchPreparerArgs(gcName, test.gwNsName, test.experimentalEnabled)
+			featureGates := config.NewFeatureGates()
+			g.Expect(featureGates.Set(test.featureGates)).To(Succeed())
+
+			objects, objectLists := prepareFirstEventBatchPreparerArgs(gcName, test.gwNsName, featureGates, nil, nil)
 
 			g.Expect(objects).To(ConsistOf(test.expectedObjects))
 			g.Expect(objectLists).To(ConsistOf(test.expectedObjectLists))
@@ -129,6 +216,105 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 	}
 }
 
+func TestDisableFeaturesWithoutCRDs(t *testing.T) {
+	t.Parallel()
+
+	tlsRouteGVK := gatewayv1alpha2.SchemeGroupVersion.WithKind("TLSRoute")
+	backendTLSPolicyGVK := gatewayv1alpha3.SchemeGroupVersion.WithKind("BackendTLSPolicy")
+
+	tests := []struct {
+		name            string
+		featureGates    string
+		installedCRDs   []schema.GroupVersionKind
+		expEnabledGates []string
+	}{
+		{
+			name:            "all CRDs installed",
+			featureGates:    "TLSRoute=true,BackendTLSPolicy=true",
+			installedCRDs:   []schema.GroupVersionKind{tlsRouteGVK, backendTLSPolicyGVK},
+			expEnabledGates: []string{"BackendTLSPolicy", "TLSRoute"},
+		},
+		{
+			name:            "TLSRoute CRD not installed",
+			featureGates:    "TLSRoute=true,BackendTLSPolicy=true",
+			installedCRDs:   []schema.GroupVersionKind{backendTLSPolicyGVK},
+			expEnabledGates: []string{"BackendTLSPolicy"},
+		},
+		{
+			name:            "no CRDs installed",
+			featureGates:    "TLSRoute=true,BackendTLSPolicy=true",
+			expEnabledGates: []string{},
+		},
+		{
+			name:            "features disabled",
+			featureGates:    "TLSRoute=false",
+			expEnabledGates: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			featureGates := config.NewFeatureGates()
+			g.Expect(featureGates.Set(test.featureGates)).To(Succeed())
+
+			mapper := meta.NewDefaultRESTMapper(nil)
+			for _, gvk := range test.installedCRDs {
+				mapper.Add(gvk, meta.RESTScopeNamespace)
+			}
+
+			g.Expect(disableFeaturesWithoutCRDs(mapper, featureGates, logr.Discard())).To(Succeed())
+			g.Expect(featureGates.EnabledFeatures()).To(ConsistOf(test.expEnabledGates))
+		})
+	}
+}
+
+func TestCreateProtectedPorts(t *testing.T) {
+	t.Parallel()
+
+	baseCfg := config.Config{
+		MetricsConfig:   config.MetricsConfig{Port: 9113},
+		HealthConfig:    config.HealthConfig{Port: 8081},
+		WebhookConfig:   config.WebhookConfig{Port: 9443},
+		ProfilingConfig: config.ProfilingConfig{Enabled: true, Port: 6060},
+	}
+
+	t.Run("without an unprivileged port offset", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		g.Expect(createProtectedPorts(baseCfg)).To(Equal(map[int32]string{
+			9113: "MetricsPort",
+			8081: "HealthPort",
+			6060: "ProfilingPort",
+		}))
+	})
+
+	t.Run("with an unprivileged port offset", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		cfg := baseCfg
+		cfg.UnprivilegedPortOffset = 8000
+
+		ports := createProtectedPorts(cfg)
+
+		// the ports 8001 - 9023, the metrics and profiling ports, and port 81
+		g.Expect(ports).To(HaveLen(1023 + 3))
+		g.Expect(ports).To(HaveKeyWithValue(int32(9113), "MetricsPort"))
+		g.Expect(ports).To(HaveKeyWithValue(int32(8081), "HealthPort"))
+		g.Expect(ports).To(HaveKeyWithValue(int32(6060), "ProfilingPort"))
+		// NGINX would bind a listener of port 81 to the health port
+		g.Expect(ports).To(HaveKeyWithValue(int32(81), "HealthPort"))
+		g.Expect(ports).To(HaveKeyWithValue(int32(8080), "UnprivilegedPort"))
+		g.Expect(ports).To(HaveKeyWithValue(int32(8443), "UnprivilegedPort"))
+		g.Expect(ports).ToNot(HaveKey(int32(8000)))
+		g.Expect(ports).ToNot(HaveKey(int32(9024)))
+	})
+}
+
 func TestGetMetricsOptions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1,18 +1,78 @@
 package collectors
 
 import (
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
 )
 
+// ListenerKey identifies a listener of a Gateway.
+type ListenerKey struct {
+	// Gateway is the NamespacedName of the Gateway.
+	Gateway types.NamespacedName
+	// Name is the name of the listener.
+	Name string
+}
+
+// GatewayRoutesKey identifies the Routes of a kind that reference a Gateway, and are accepted or rejected by it.
+type GatewayRoutesKey struct {
+	// Gateway is the NamespacedName of the Gateway.
+	Gateway types.NamespacedName
+	// Kind is the kind of the Routes.
+	Kind string
+	// Accepted is true for the Routes that are attached to the Gateway.
+	Accepted bool
+}
+
+// PolicyTargetKey identifies the Policies of a kind that target a resource.
+type PolicyTargetKey struct {
+	// Target is the NamespacedName of the target resource.
+	Target types.NamespacedName
+	// Kind is the kind of the Policies.
+	Kind string
+	// TargetKind is the kind of the target resource.
+	TargetKind string
+}
+
+// AttachmentCounts are the numbers of the Routes and the Policies attached to the resources.
+type AttachmentCounts struct {
+	// ListenerRoutes are the numbers of the Routes attached to the listeners.
+	ListenerRoutes map[ListenerKey]int
+	// GatewayRoutes are the numbers of the accepted and the rejected Routes of the Gateways.
+	GatewayRoutes map[GatewayRoutesKey]int
+	// TargetPolicies are the numbers of the Policies that target the resources.
+	TargetPolicies map[PolicyTargetKey]int
+}
+
 // ControllerCollector collects metrics for the NGF controller.
 // Implements the prometheus.Collector interface.
 type ControllerCollector struct {
+	// staleSince is the time when the oldest configuration that NGINX doesn't run yet was generated.
+	// It is zero if NGINX runs the latest configuration.
+	staleSince time.Time
+	// disconnectedSince is the time when NGF lost the connection to the API server.
+	// It is zero if NGF is connected.
+	disconnectedSince time.Time
 	// Metrics
 	eventBatchProcessDuration prometheus.Histogram
+	certificateExpiry         *prometheus.GaugeVec
+	listenerAttachedRoutes    *prometheus.GaugeVec
+	gatewayRoutes             *prometheus.GaugeVec
+	targetPolicies            *prometheus.GaugeVec
+	latestConfigVersion       prometheus.Gauge
+	appliedConfigVersion      prometheus.Gauge
+	configStaleness           prometheus.GaugeFunc
+	fastPathReloads           prometheus.Counter
+	firstConfigDuration       prometheus.Gauge
+	apiServerDisconnected     prometheus.GaugeFunc
+	nginxErrors               *prometheus.CounterVec
+	// latestVersion is the version of the latest generated configuration.
+	latestVersion int
+	lock          sync.Mutex
 }
 
 // NewControllerCollector creates a new ControllerCollector.
@@ -27,7 +87,109 @@ func NewControllerCollector(constLabels map[string]string) *ControllerCollector
 				Buckets:     []float64{500, 1000, 5000, 10000, 30000},
 			},
 		),
+		certificateExpiry: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "ssl_certificate_expiry_seconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Expiry time of the certificates referenced by the Gateway listeners, in seconds since epoch",
+				ConstLabels: constLabels,
+			},
+			[]string{"secret_namespace", "secret_name"},
+		),
+		listenerAttachedRoutes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "listener_attached_routes",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Routes attached to a Gateway listener",
+				ConstLabels: constLabels,
+			},
+			[]string{"gateway_namespace", "gateway_name", "listener"},
+		),
+		gatewayRoutes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "gateway_routes",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Routes that reference a Gateway, by kind and whether the Gateway accepted them",
+				ConstLabels: constLabels,
+			},
+			[]string{"gateway_namespace", "gateway_name", "kind", "status"},
+		),
+		targetPolicies: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:        "policies",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of Policies that target a resource, by kind",
+				ConstLabels: constLabels,
+			},
+			[]string{"kind", "target_kind", "target_namespace", "target_name"},
+		),
+		latestConfigVersion: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "config_latest_version",
+				Namespace:   metrics.Namespace,
+				Help:        "Version of the latest NGINX configuration generated by the controller",
+				ConstLabels: constLabels,
+			},
+		),
+		appliedConfigVersion: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "config_applied_version",
+				Namespace:   metrics.Namespace,
+				Help:        "Version of the latest NGINX configuration successfully applied to NGINX",
+				ConstLabels: constLabels,
+			},
+		),
+		fastPathReloads: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name:        "fast_path_reloads_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of endpoint changes applied to NGINX by updating only the upstreams",
+				ConstLabels: constLabels,
+			},
+		),
+		firstConfigDuration: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name:        "time_to_first_config_seconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Time in seconds from the start of the controller until NGINX ran the first configuration",
+				ConstLabels: constLabels,
+			},
+		),
+		nginxErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "nginx_errors_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of the messages of the NGINX error log that match a recurring error pattern",
+				ConstLabels: constLabels,
+			},
+			[]string{"pattern"},
+		),
 	}
+
+	nc.configStaleness = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name:        "config_staleness_seconds",
+			Namespace:   metrics.Namespace,
+			Help:        "Time in seconds since NGINX stopped running the latest generated configuration",
+			ConstLabels: constLabels,
+		},
+		func() float64 {
+			return nc.staleness(time.Now())
+		},
+	)
+
+	nc.apiServerDisconnected = prometheus.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name:        "api_server_disconnected_seconds",
+			Namespace:   metrics.Namespace,
+			Help:        "Time in seconds since the controller lost the connection to the Kubernetes API server",
+			ConstLabels: constLabels,
+		},
+		func() float64 {
+			return nc.disconnectedDuration(time.Now())
+		},
+	)
+
 	return nc
 }
 
@@ -36,14 +198,155 @@ func (c *ControllerCollector) ObserveLastEventBatchProcessTime(duration time.Dur
 	c.eventBatchProcessDuration.Observe(float64(duration / time.Millisecond))
 }
 
+// SetCertificateExpiries sets the expiry times of the certificates referenced by the Gateway listeners,
+// keyed by the Secrets that hold the certificates. The certificates that are not set are removed.
+func (c *ControllerCollector) SetCertificateExpiries(expiries map[types.NamespacedName]time.Time) {
+	c.certificateExpiry.Reset()
+
+	for secret, expiry := range expiries {
+		c.certificateExpiry.WithLabelValues(secret.Namespace, secret.Name).Set(float64(expiry.Unix()))
+	}
+}
+
+// SetAttachmentCounts sets the numbers of the Routes and the Policies attached to the resources.
+// The resources that are not set are removed.
+func (c *ControllerCollector) SetAttachmentCounts(counts AttachmentCounts) {
+	c.listenerAttachedRoutes.Reset()
+	c.gatewayRoutes.Reset()
+	c.targetPolicies.Reset()
+
+	for l, count := range counts.ListenerRoutes {
+		c.listenerAttachedRoutes.WithLabelValues(l.Gateway.Namespace, l.Gateway.Name, l.Name).Set(float64(count))
+	}
+
+	for r, count := range counts.GatewayRoutes {
+		status := "rejected"
+		if r.Accepted {
+			status = "accepted"
+		}
+
+		c.gatewayRoutes.WithLabelValues(r.Gateway.Namespace, r.Gateway.Name, r.Kind, status).Set(float64(count))
+	}
+
+	for p, count := range counts.TargetPolicies {
+		c.targetPolicies.WithLabelValues(p.Kind, p.TargetKind, p.Target.Namespace, p.Target.Name).Set(float64(count))
+	}
+}
+
+// ObserveConfigGenerated records that the controller generated the configuration with the version.
+// NGINX is stale until the configuration is applied.
+func (c *ControllerCollector) ObserveConfigGenerated(version int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.latestConfigVersion.Set(float64(version))
+	c.latestVersion = version
+
+	if c.staleSince.IsZero() {
+		c.staleSince = time.Now()
+	}
+}
+
+// ObserveConfigApplied records that NGINX runs the configuration with the version.
+// NGINX is no longer stale if the version is the latest one.
+func (c *ControllerCollector) ObserveConfigApplied(version int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.appliedConfigVersion.Set(float64(version))
+
+	if version >= c.latestVersion {
+		c.staleSince = time.Time{}
+	}
+}
+
+// IncFastPathReloads increments the number of endpoint changes applied to NGINX by updating only the upstreams,
+// without generating the rest of the configuration.
+func (c *ControllerCollector) IncFastPathReloads() {
+	c.fastPathReloads.Inc()
+}
+
+// ObserveFirstConfigApplied records the time from the start of the controller until NGINX ran the first
+// configuration, which was generated after the caches of all resources synced.
+func (c *ControllerCollector) ObserveFirstConfigApplied(duration time.Duration) {
+	c.firstConfigDuration.Set(duration.Seconds())
+}
+
+// ObserveAPIServerDisconnected records that the controller lost the connection to the API server at the time since.
+func (c *ControllerCollector) ObserveAPIServerDisconnected(since time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.disconnectedSince = since
+}
+
+// ObserveAPIServerConnected records that the connection to the API server recovered.
+func (c *ControllerCollector) ObserveAPIServerConnected() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.disconnectedSince = time.Time{}
+}
+
+// IncNginxErrors increments the number of the messages of the NGINX error log that match the error pattern.
+func (c *ControllerCollector) IncNginxErrors(pattern string) {
+	c.nginxErrors.WithLabelValues(pattern).Inc()
+}
+
+// disconnectedDuration returns the duration in seconds since the controller lost the connection to the API server.
+func (c *ControllerCollector) disconnectedDuration(now time.Time) float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.disconnectedSince.IsZero() {
+		return 0
+	}
+
+	return now.Sub(c.disconnectedSince).Seconds()
+}
+
+// staleness returns the duration in seconds since NGINX stopped running the latest configuration.
+func (c *ControllerCollector) staleness(now time.Time) float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.staleSince.IsZero() {
+		return 0
+	}
+
+	return now.Sub(c.staleSince).Seconds()
+}
+
 // Describe implements prometheus.Collector interface Describe method.
 func (c *ControllerCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.eventBatchProcessDuration.Describe(ch)
+	c.certificateExpiry.Describe(ch)
+	c.listenerAttachedRoutes.Describe(ch)
+	c.gatewayRoutes.Describe(ch)
+	c.targetPolicies.Describe(ch)
+	c.latestConfigVersion.Describe(ch)
+	c.appliedConfigVersion.Describe(ch)
+	c.configStaleness.Describe(ch)
+	c.fastPathReloads.Describe(ch)
+	c.firstConfigDuration.Describe(ch)
+	c.apiServerDisconnected.Describe(ch)
+	c.nginxErrors.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method.
 func (c *ControllerCollector) Collect(ch chan<- prometheus.Metric) {
 	c.eventBatchProcessDuration.Collect(ch)
+	c.certificateExpiry.Collect(ch)
+	c.listenerAttachedRoutes.Collect(ch)
+	c.gatewayRoutes.Collect(ch)
+	c.targetPolicies.Collect(ch)
+	c.latestConfigVersion.Collect(ch)
+	c.appliedConfigVersion.Collect(ch)
+	c.configStaleness.Collect(ch)
+	c.fastPathReloads.Collect(ch)
+	c.firstConfigDuration.Collect(ch)
+	c.apiServerDisconnected.Collect(ch)
+	c.nginxErrors.Collect(ch)
 }
 
 // ControllerNoopCollector used to initialize the ControllerCollector when metrics are disabled to avoid nil pointer
@@ -56,3 +359,21 @@ func NewControllerNoopCollector() *ControllerNoopCollector {
 }
 
 func (c *ControllerNoopCollector) ObserveLastEventBatchProcessTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) SetCertificateExpiries(_ map[types.NamespacedName]time.Time) {}
+
+func (c *ControllerNoopCollector) SetAttachmentCounts(_ AttachmentCounts) {}
+
+func (c *ControllerNoopCollector) ObserveConfigGenerated(_ int) {}
+
+func (c *ControllerNoopCollector) ObserveConfigApplied(_ int) {}
+
+func (c *ControllerNoopCollector) IncFastPathReloads() {}
+
+func (c *ControllerNoopCollector) ObserveFirstConfigApplied(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveAPIServerDisconnected(_ time.Time) {}
+
+func (c *ControllerNoopCollector) ObserveAPIServerConnected() {}
+
+func (c *ControllerNoopCollector) IncNginxErrors(_ string) {}
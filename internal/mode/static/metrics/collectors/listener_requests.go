@@ -0,0 +1,95 @@
+package collectors
+
+import (
+	"net/http"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+)
+
+const listenerRequestsURI = "http://config-status/listener_requests"
+
+// listenerCounters are the counters of the requests of a listener, as reported by NGINX.
+type listenerCounters struct {
+	// Rejected are the numbers of rejected requests, keyed by the reason of the rejection.
+	Rejected map[string]float64 `json:"rejected"`
+	// Oversized are the numbers of oversized requests, keyed by the part of the request that is too large.
+	Oversized map[string]float64 `json:"oversized"`
+	// Port is the port of the listener.
+	Port string `json:"port"`
+	// Hostname is the hostname of the listener. It is empty for the requests that don't match any listener hostname.
+	Hostname string `json:"hostname"`
+}
+
+// ListenerRequestsCollector collects the numbers of requests that NGINX rejects with a 4xx status code,
+// per listener. NGINX counts the requests, and the collector fetches the counters over a unix socket.
+// Implements the prometheus.Collector interface.
+type ListenerRequestsCollector struct {
+	logger        log.Logger
+	rejectedDesc  *prometheus.Desc
+	oversizedDesc *prometheus.Desc
+	httpClient    http.Client
+}
+
+// NewListenerRequestsCollector creates a new ListenerRequestsCollector.
+func NewListenerRequestsCollector(constLabels map[string]string, logger log.Logger) *ListenerRequestsCollector {
+	return &ListenerRequestsCollector{
+		logger:     logger,
+		httpClient: runtime.GetSocketClient(nginxStatusSock),
+		rejectedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "listener_rejected_requests_total"),
+			"Total number of requests rejected with a 4xx status code by a listener",
+			[]string{"listener_port", "listener_hostname", "reason"},
+			constLabels,
+		),
+		oversizedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "listener_oversized_requests_total"),
+			"Total number of requests rejected by a listener because their body, URI or header is too large",
+			[]string{"listener_port", "listener_hostname", "part"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *ListenerRequestsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.rejectedDesc
+	ch <- c.oversizedDesc
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *ListenerRequestsCollector) Collect(ch chan<- prometheus.Metric) {
+	var listeners []listenerCounters
+	if err := getNginxStatus(c.httpClient, listenerRequestsURI, &listeners); err != nil {
+		level.Error(c.logger).Log("msg", "error getting listener request counters", "error", err.Error())
+		return
+	}
+
+	for _, l := range listeners {
+		for reason, count := range l.Rejected {
+			ch <- prometheus.MustNewConstMetric(
+				c.rejectedDesc,
+				prometheus.CounterValue,
+				count,
+				l.Port,
+				l.Hostname,
+				reason,
+			)
+		}
+
+		for part, count := range l.Oversized {
+			ch <- prometheus.MustNewConstMetric(
+				c.oversizedDesc,
+				prometheus.CounterValue,
+				count,
+				l.Port,
+				l.Hostname,
+				part,
+			)
+		}
+	}
+}
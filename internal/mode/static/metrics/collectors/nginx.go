@@ -1,7 +1,11 @@
 package collectors
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/go-kit/log"
 	"github.com/nginxinc/nginx-plus-go-client/client"
@@ -14,8 +18,9 @@ import (
 )
 
 const (
-	nginxStatusSock = "/var/run/nginx/nginx-status.sock"
-	nginxStatusURI  = "http://config-status/stub_status"
+	nginxStatusSock    = "/var/run/nginx/nginx-status.sock"
+	nginxStatusURI     = "http://config-status/stub_status"
+	nginxStatusTimeout = 5 * time.Second
 )
 
 // NewNginxMetricsCollector creates an NginxCollector which fetches stats from NGINX over a unix socket.
@@ -46,3 +51,30 @@ func NewNginxPlusMetricsCollector(
 
 	return collector, nil
 }
+
+// getNginxStatus gets the JSON status of NGINX at the uri, and decodes it into v.
+func getNginxStatus(httpClient http.Client, uri string, v any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), nginxStatusTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", uri, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected %v response, got %v", http.StatusOK, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package collectors
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+)
+
+const routeLatencyURI = "http://config-status/route_latency"
+
+// routeHistogram is the latency histogram of a Route, as reported by NGINX.
+type routeHistogram struct {
+	// Buckets are the cumulative counts of the buckets, keyed by the upper bound of the bucket in seconds.
+	Buckets map[string]uint64 `json:"buckets"`
+	Count   uint64            `json:"count"`
+	Sum     float64           `json:"sum"`
+}
+
+// RouteLatencyCollector collects the request latency histograms of the Routes that enable them
+// with an ObservabilityPolicy. NGINX records the histograms, and the collector fetches them over a unix socket.
+// Implements the prometheus.Collector interface.
+type RouteLatencyCollector struct {
+	logger     log.Logger
+	desc       *prometheus.Desc
+	httpClient http.Client
+}
+
+// NewRouteLatencyCollector creates a new RouteLatencyCollector.
+func NewRouteLatencyCollector(constLabels map[string]string, logger log.Logger) *RouteLatencyCollector {
+	return &RouteLatencyCollector{
+		logger:     logger,
+		httpClient: runtime.GetSocketClient(nginxStatusSock),
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "route_request_duration_seconds"),
+			"Duration in seconds of the requests of a Route",
+			[]string{"route_namespace", "route_name"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *RouteLatencyCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *RouteLatencyCollector) Collect(ch chan<- prometheus.Metric) {
+	histograms, err := c.fetchHistograms()
+	if err != nil {
+		level.Error(c.logger).Log("msg", "error getting route latency histograms", "error", err.Error())
+		return
+	}
+
+	for route, histogram := range histograms {
+		nsname := strings.SplitN(route, "/", 2)
+		if len(nsname) != 2 {
+			continue
+		}
+
+		buckets := make(map[float64]uint64, len(histogram.Buckets))
+		for bound, count := range histogram.Buckets {
+			upperBound, err := strconv.ParseFloat(bound, 64)
+			if err != nil {
+				continue
+			}
+
+			buckets[upperBound] = count
+		}
+
+		metric, err := prometheus.NewConstHistogram(
+			c.desc,
+			histogram.Count,
+			histogram.Sum,
+			buckets,
+			nsname[0],
+			nsname[1],
+		)
+		if err != nil {
+			level.Error(c.logger).Log("msg", "error creating route latency histogram", "route", route, "error", err.Error())
+			continue
+		}
+
+		ch <- metric
+	}
+}
+
+func (c *RouteLatencyCollector) fetchHistograms() (map[string]routeHistogram, error) {
+	var histograms map[string]routeHistogram
+	if err := getNginxStatus(c.httpClient, routeLatencyURI, &histograms); err != nil {
+		return nil, err
+	}
+
+	return histograms, nil
+}
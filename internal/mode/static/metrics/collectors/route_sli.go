@@ -0,0 +1,135 @@
+package collectors
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+)
+
+const routeSLIURI = "http://config-status/route_sli"
+
+// routeSLICounters are the service level indicator counters of a Route, as reported by NGINX.
+type routeSLICounters struct {
+	Requests     float64 `json:"requests"`
+	Errors       float64 `json:"errors"`
+	SlowRequests float64 `json:"slow_requests"`
+	// LatencyThreshold is the latency threshold of the Route in seconds.
+	LatencyThreshold float64 `json:"latency_threshold"`
+}
+
+// RouteSLICollector collects the service level indicators of the Routes that enable them with
+// an ObservabilityPolicy: the total requests, the requests that failed with a 5xx status code, and the requests
+// that took longer than the latency threshold of the Route. The ratios of the counters are the error and latency
+// SLIs of the Route, from which the burn rates of the SLOs are calculated.
+// NGINX records the counters, and the collector fetches them over a unix socket.
+// Implements the prometheus.Collector interface.
+type RouteSLICollector struct {
+	logger               log.Logger
+	requestsDesc         *prometheus.Desc
+	errorsDesc           *prometheus.Desc
+	slowRequestsDesc     *prometheus.Desc
+	latencyThresholdDesc *prometheus.Desc
+	httpClient           http.Client
+}
+
+// NewRouteSLICollector creates a new RouteSLICollector.
+func NewRouteSLICollector(constLabels map[string]string, logger log.Logger) *RouteSLICollector {
+	labels := []string{"route_namespace", "route_name"}
+
+	return &RouteSLICollector{
+		logger:     logger,
+		httpClient: runtime.GetSocketClient(nginxStatusSock),
+		requestsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "route_sli_requests_total"),
+			"Total number of the requests of a Route",
+			labels,
+			constLabels,
+		),
+		errorsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "route_sli_error_requests_total"),
+			"Total number of the requests of a Route that failed with a 5xx status code",
+			labels,
+			constLabels,
+		),
+		slowRequestsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "route_sli_slow_requests_total"),
+			"Total number of the requests of a Route that took longer than the latency threshold of the Route",
+			labels,
+			constLabels,
+		),
+		latencyThresholdDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "route_sli_latency_threshold_seconds"),
+			"Latency threshold in seconds of the requests of a Route",
+			labels,
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *RouteSLICollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requestsDesc
+	ch <- c.errorsDesc
+	ch <- c.slowRequestsDesc
+	ch <- c.latencyThresholdDesc
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *RouteSLICollector) Collect(ch chan<- prometheus.Metric) {
+	routes, err := c.fetchCounters()
+	if err != nil {
+		level.Error(c.logger).Log("msg", "error getting route SLI counters", "error", err.Error())
+		return
+	}
+
+	for route, counters := range routes {
+		nsname := strings.SplitN(route, "/", 2)
+		if len(nsname) != 2 {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.requestsDesc,
+			prometheus.CounterValue,
+			counters.Requests,
+			nsname[0],
+			nsname[1],
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.errorsDesc,
+			prometheus.CounterValue,
+			counters.Errors,
+			nsname[0],
+			nsname[1],
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.slowRequestsDesc,
+			prometheus.CounterValue,
+			counters.SlowRequests,
+			nsname[0],
+			nsname[1],
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.latencyThresholdDesc,
+			prometheus.GaugeValue,
+			counters.LatencyThreshold,
+			nsname[0],
+			nsname[1],
+		)
+	}
+}
+
+func (c *RouteSLICollector) fetchCounters() (map[string]routeSLICounters, error) {
+	var routes map[string]routeSLICounters
+	if err := getNginxStatus(c.httpClient, routeSLIURI, &routes); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,147 @@
+package collectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
+)
+
+// nginxStreamStatusSock is the unix socket of the stream server of NGINX that responds with the counters of the
+// stream Routes. The server is a stream server, so it responds with the JSON counters without an HTTP response.
+const nginxStreamStatusSock = "/var/run/nginx/nginx-stream-status.sock"
+
+// streamRouteCounters are the counters of a stream Route, as reported by NGINX.
+type streamRouteCounters struct {
+	Connections   float64 `json:"connections"`
+	SentBytes     float64 `json:"sent_bytes"`
+	ReceivedBytes float64 `json:"received_bytes"`
+	// SessionTime is the total duration of the sessions in seconds.
+	SessionTime float64 `json:"session_time"`
+}
+
+// StreamRouteCollector collects the connections, bytes and session durations of the stream Routes, such as
+// TLSRoutes. NGINX records the counters when it logs the sessions, and the collector fetches them over
+// a unix socket.
+// Implements the prometheus.Collector interface.
+type StreamRouteCollector struct {
+	logger              log.Logger
+	connectionsDesc     *prometheus.Desc
+	sentBytesDesc       *prometheus.Desc
+	receivedBytesDesc   *prometheus.Desc
+	sessionDurationDesc *prometheus.Desc
+	socket              string
+}
+
+// NewStreamRouteCollector creates a new StreamRouteCollector.
+func NewStreamRouteCollector(constLabels map[string]string, logger log.Logger) *StreamRouteCollector {
+	labels := []string{"route_namespace", "route_name"}
+
+	return &StreamRouteCollector{
+		logger: logger,
+		socket: nginxStreamStatusSock,
+		connectionsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "stream_route_connections_total"),
+			"Total number of the connections of a stream Route",
+			labels,
+			constLabels,
+		),
+		sentBytesDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "stream_route_sent_bytes_total"),
+			"Total number of the bytes sent to the clients of a stream Route",
+			labels,
+			constLabels,
+		),
+		receivedBytesDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "stream_route_received_bytes_total"),
+			"Total number of the bytes received from the clients of a stream Route",
+			labels,
+			constLabels,
+		),
+		sessionDurationDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "stream_route_session_duration_seconds_total"),
+			"Total duration in seconds of the connections of a stream Route",
+			labels,
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *StreamRouteCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.connectionsDesc
+	ch <- c.sentBytesDesc
+	ch <- c.receivedBytesDesc
+	ch <- c.sessionDurationDesc
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *StreamRouteCollector) Collect(ch chan<- prometheus.Metric) {
+	routes, err := c.fetchCounters()
+	if err != nil {
+		level.Error(c.logger).Log("msg", "error getting stream route counters", "error", err.Error())
+		return
+	}
+
+	for route, counters := range routes {
+		nsname := strings.SplitN(route, "/", 2)
+		if len(nsname) != 2 {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.connectionsDesc,
+			prometheus.CounterValue,
+			counters.Connections,
+			nsname[0],
+			nsname[1],
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.sentBytesDesc,
+			prometheus.CounterValue,
+			counters.SentBytes,
+			nsname[0],
+			nsname[1],
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.receivedBytesDesc,
+			prometheus.CounterValue,
+			counters.ReceivedBytes,
+			nsname[0],
+			nsname[1],
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.sessionDurationDesc,
+			prometheus.CounterValue,
+			counters.SessionTime,
+			nsname[0],
+			nsname[1],
+		)
+	}
+}
+
+func (c *StreamRouteCollector) fetchCounters() (map[string]streamRouteCounters, error) {
+	conn, err := net.DialTimeout("unix", c.socket, nginxStatusTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", c.socket, err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(nginxStatusTimeout)); err != nil {
+		return nil, fmt.Errorf("failed to set the deadline of the connection: %w", err)
+	}
+
+	var routes map[string]streamRouteCounters
+	if err := json.NewDecoder(conn).Decode(&routes); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,89 @@
+package collectors
+
+import (
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/metrics"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+)
+
+// UpstreamResponseTimeCollector collects the average response times of the upstreams from the NGINX Plus API.
+// Unlike the response times of the upstream servers, which are exported by the NGINX Plus collector, they don't
+// change when the Pods of the Service are replaced, which makes them suitable for alerting on the latency
+// of a Service.
+// Implements the prometheus.Collector interface.
+type UpstreamResponseTimeCollector struct {
+	logger           log.Logger
+	plusClient       runtime.NginxPlusClient
+	headerTimeDesc   *prometheus.Desc
+	responseTimeDesc *prometheus.Desc
+}
+
+// NewUpstreamResponseTimeCollector creates a new UpstreamResponseTimeCollector.
+func NewUpstreamResponseTimeCollector(
+	plusClient runtime.NginxPlusClient,
+	constLabels map[string]string,
+	logger log.Logger,
+) *UpstreamResponseTimeCollector {
+	return &UpstreamResponseTimeCollector{
+		logger:     logger,
+		plusClient: plusClient,
+		headerTimeDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "upstream_header_time_seconds"),
+			"Average time in seconds to get the response header from the servers of an upstream",
+			[]string{"upstream"},
+			constLabels,
+		),
+		responseTimeDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "upstream_response_time_seconds"),
+			"Average time in seconds to get the full response from the servers of an upstream",
+			[]string{"upstream"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *UpstreamResponseTimeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.headerTimeDesc
+	ch <- c.responseTimeDesc
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *UpstreamResponseTimeCollector) Collect(ch chan<- prometheus.Metric) {
+	upstreams, err := c.plusClient.GetUpstreams()
+	if err != nil {
+		level.Error(c.logger).Log("msg", "error getting upstreams", "error", err.Error())
+		return
+	}
+
+	for name, upstream := range *upstreams {
+		// The NGINX Plus API reports the average times of each server in milliseconds. The average of the upstream
+		// is weighted by the number of the requests of the servers.
+		var requests, headerTime, responseTime float64
+		for _, peer := range upstream.Peers {
+			requests += float64(peer.Requests)
+			headerTime += float64(peer.HeaderTime) * float64(peer.Requests)
+			responseTime += float64(peer.ResponseTime) * float64(peer.Requests)
+		}
+
+		if requests == 0 {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.headerTimeDesc,
+			prometheus.GaugeValue,
+			headerTime/requests/1000,
+			name,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.responseTimeDesc,
+			prometheus.GaugeValue,
+			responseTime/requests/1000,
+			name,
+		)
+	}
+}
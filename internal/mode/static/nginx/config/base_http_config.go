@@ -1,19 +1,209 @@
 package config
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	gotemplate "text/template"
 
-	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
 var baseHTTPTemplate = gotemplate.Must(gotemplate.New("baseHttp").Parse(baseHTTPTemplateText))
 
-func executeBaseHTTPConfig(conf dataplane.Configuration) []executeResult {
+const (
+	// defaultServerConnectionsZone is the shared memory zone that counts the connections from each client
+	// IP address to the default servers.
+	defaultServerConnectionsZone = "ngf_default_server_connections"
+	// maxRequestRateZone is the shared memory zone that limits the rate of the requests to each server.
+	maxRequestRateZone = "ngf_max_request_rate"
+	// wafEnforcerAddress is the address of the NGINX App Protect enforcer, which runs in a container of the NGINX Pod.
+	wafEnforcerAddress = "127.0.0.1:50000"
+	// forwardedProtoVariable is the variable of the scheme of the client requests when trusted hops are configured.
+	forwardedProtoVariable = "ngf_forwarded_proto"
+	// trustedHopVariable is the variable that is 1 for the requests from the addresses of the trusted hops,
+	// otherwise 0.
+	trustedHopVariable = "ngf_trusted_hop"
+	// defaultAccessLogFormat is the predefined log format of NGINX that the access log uses by default.
+	defaultAccessLogFormat = "combined"
+)
+
+// forwardedProtoSchemes are the schemes that the proto headers of the trusted hops are matched against.
+// https comes first, since the maps check the regular expressions in order.
+var forwardedProtoSchemes = []string{"https", "http"}
+
+// forwardedProto holds the configuration of the variable of the scheme of the client requests.
+type forwardedProto struct {
+	Variable         string
+	TrustedVariable  string
+	Source           string
+	TrustedAddresses []string
+	Parameters       []shared.MapParameter
+}
+
+// kubernetesAccessLog holds the configuration of the access log format that includes the Kubernetes context
+// of the requests.
+type kubernetesAccessLog struct {
+	Format  string
+	Gateway string
+	// Services map the names of the upstreams to their Services.
+	Services []shared.MapParameter
+}
+
+type httpConfig struct {
+	GeoIP                        *dataplane.GeoIP
+	ForwardedProto               *forwardedProto
+	KubernetesAccessLog          *kubernetesAccessLog
+	AccessLogFormat              string
+	GeoIPAccessLogFormat         string
+	ServerTokens                 string
+	DefaultServerConnectionsZone string
+	MaxRequestRateZone           string
+	WAFEnforcerAddress           string
+	AccessLogRatios              []dataplane.Ratio
+	SessionTicketKeyFiles        []string
+	GatewayPorts                 []shared.MapParameter
+	MaxRequestRate               int32
+	HTTP2                        bool
+	RecordListenerRequests       bool
+}
+
+func (g GeneratorImpl) executeBaseHTTPConfig(conf dataplane.Configuration) []executeResult {
+	hc := httpConfig{
+		HTTP2:           conf.BaseHTTPConfig.HTTP2,
+		ServerTokens:    getServerTokens(conf.BaseHTTPConfig.ServerHeader, g.plus),
+		AccessLogRatios: conf.BaseHTTPConfig.AccessLogRatios,
+		GeoIP:           conf.BaseHTTPConfig.GeoIP,
+		ForwardedProto:  createForwardedProto(conf.BaseHTTPConfig.RewriteClientIPSettings.TrustedHops),
+		AccessLogFormat: defaultAccessLogFormat,
+		GatewayPorts:    createGatewayPorts(conf, g.unprivilegedPortOffset),
+
+		RecordListenerRequests: g.recordListenerRequests,
+	}
+
+	if conf.Logging.KubernetesAccessLog != nil {
+		hc.KubernetesAccessLog = createKubernetesAccessLog(*conf.Logging.KubernetesAccessLog, conf.Upstreams)
+		hc.AccessLogFormat = hc.KubernetesAccessLog.Format
+	}
+
+	if hc.GeoIP != nil {
+		hc.GeoIPAccessLogFormat = dataplane.GeoIPAccessLogFormat
+	}
+
+	if conf.ConnectionLimits.DefaultServerMaxConnections != 0 {
+		hc.DefaultServerConnectionsZone = defaultServerConnectionsZone
+	}
+
+	if conf.ConnectionLimits.MaxRequestRate != 0 {
+		hc.MaxRequestRateZone = maxRequestRateZone
+		hc.MaxRequestRate = conf.ConnectionLimits.MaxRequestRate
+	}
+
+	if len(conf.WAFBundles) > 0 {
+		hc.WAFEnforcerAddress = wafEnforcerAddress
+	}
+
+	for i := range conf.SessionTicketKeys {
+		hc.SessionTicketKeyFiles = append(hc.SessionTicketKeyFiles, generateSessionTicketKeyFileName(i))
+	}
+
 	result := executeResult{
 		dest: httpConfigFile,
-		data: helpers.MustExecuteTemplate(baseHTTPTemplate, conf.BaseHTTPConfig),
+		data: g.templates.execute(TemplateSectionHTTP, baseHTTPTemplate, hc),
 	}
 
 	return []executeResult{result}
 }
+
+// createKubernetesAccessLog returns the configuration of the Kubernetes access log format. The Services
+// of the upstreams are mapped from their names, which are in the <namespace>_<name>_<port> format unless
+// they are hashed.
+func createKubernetesAccessLog(
+	accessLog dataplane.KubernetesAccessLog,
+	upstreams []dataplane.Upstream,
+) *kubernetesAccessLog {
+	services := make([]shared.MapParameter, 0, len(upstreams))
+	for _, u := range upstreams {
+		var svc string
+		if u.HashedFrom != "" {
+			svc, _, _ = strings.Cut(u.HashedFrom, ":")
+		} else if parts := strings.SplitN(u.Name, "_", 3); len(parts) == 3 {
+			svc = parts[0] + "/" + parts[1]
+		}
+
+		if svc != "" {
+			services = append(services, shared.MapParameter{Value: u.Name, Result: svc})
+		}
+	}
+
+	return &kubernetesAccessLog{
+		Format:   dataplane.KubernetesAccessLogFormat,
+		Gateway:  accessLog.Gateway.String(),
+		Services: services,
+	}
+}
+
+// getServerTokens returns the value of the server_tokens directive.
+// An empty value means that the directive is not set. Only NGINX Plus supports setting the value of the Server header,
+// so an NginxProxy that sets it is invalid with NGINX OSS.
+func getServerTokens(header dataplane.ServerHeader, plus bool) string {
+	if header.Value != nil && plus {
+		return fmt.Sprintf(`"%s"`, *header.Value)
+	}
+
+	if header.HideVersion {
+		return "off"
+	}
+
+	return ""
+}
+
+// createForwardedProto returns the configuration of the variable of the scheme of the client requests
+// for the trusted hops, or nil if there are no trusted hops.
+// For the requests from the addresses of the hops, the variable is set to the value of the proto header of the first
+// hop, starting from the client, that is set to http or https. If a header has a list of values, the first value
+// is used. Otherwise, the variable is set to $scheme.
+func createForwardedProto(hops []dataplane.TrustedProxyHop) *forwardedProto {
+	if len(hops) == 0 {
+		return nil
+	}
+
+	var addresses, headers []string
+	for _, hop := range hops {
+		for _, address := range hop.Addresses {
+			if !slices.Contains(addresses, address) {
+				addresses = append(addresses, address)
+			}
+		}
+
+		if !slices.Contains(headers, hop.ProtoHeader) {
+			headers = append(headers, hop.ProtoHeader)
+		}
+	}
+
+	sourceVariables := make([]string, 0, len(headers)+1)
+	sourceVariables = append(sourceVariables, "$"+trustedHopVariable)
+	parameters := make([]shared.MapParameter, 0, len(headers)*len(forwardedProtoSchemes))
+
+	for i, header := range headers {
+		sourceVariables = append(sourceVariables, "$"+generateRequestHeaderVariableName(header))
+
+		// skip the values of the headers of the previous hops, which don't contain ':'
+		prefix := "^1:" + strings.Repeat("[^:]*:", i)
+		for _, scheme := range forwardedProtoSchemes {
+			parameters = append(parameters, shared.MapParameter{
+				Value:  fmt.Sprintf(`~*%s\s*%s\b`, prefix, scheme),
+				Result: scheme,
+			})
+		}
+	}
+
+	return &forwardedProto{
+		Variable:         forwardedProtoVariable,
+		TrustedVariable:  trustedHopVariable,
+		Source:           strings.Join(sourceVariables, ":"),
+		TrustedAddresses: addresses,
+		Parameters:       parameters,
+	}
+}
@@ -2,6 +2,9 @@ package config
 
 const baseHTTPTemplateText = `
 {{- if .HTTP2 }}http2 on;{{ end }}
+{{- if .ServerTokens }}
+server_tokens {{ .ServerTokens }};
+{{- end }}
 
 # Set $gw_api_compliant_host variable to the value of $http_host unless $http_host is empty, then set it to the value
 # of $host. We prefer $http_host because it contains the original value of the host header, which is required by the
@@ -12,15 +15,134 @@ map $http_host $gw_api_compliant_host {
     default $http_host;
 }
 
-# Set $connection_header variable to upgrade when the $http_upgrade header is set, otherwise, set it to close. This
+# Set $connection_upgrade variable to upgrade when the $http_upgrade header is set, otherwise, clear it. This
 # allows support for websocket connections. See https://nginx.org/en/docs/http/websocket.html.
+# The cleared Connection header allows keeping the connections to the upstreams alive.
+# See https://nginx.org/en/docs/http/ngx_http_upstream_module.html#keepalive.
 map $http_upgrade $connection_upgrade {
     default upgrade;
-    '' close;
+    '' "";
 }
 
+# Set $ngf_gateway_port variable to the port of the listener of the request. When NGINX binds to the privileged
+# ports of the listeners plus an offset, $server_port is the port that NGINX binds to, not the port of the listener.
+map $server_port $ngf_gateway_port {
+    default $server_port;
+    {{- range $p := .GatewayPorts }}
+    {{ $p.Value }} {{ $p.Result }};
+    {{- end }}
+}
+{{- if .GatewayPorts }}
+
+# The redirects of NGINX itself are relative, because the ports that NGINX binds to are not the ports of the listeners.
+absolute_redirect off;
+{{- end }}
+
 ## Returns just the path from the original request URI.
 map $request_uri $request_uri_path {
   "~^(?P<path>[^?]*)(\?.*)?$"  $path;
 }
+
+{{- if .DefaultServerConnectionsZone }}
+
+limit_conn_zone $binary_remote_addr zone={{ .DefaultServerConnectionsZone }}:10m;
+{{- end }}
+
+{{- if .MaxRequestRateZone }}
+
+# The requests are limited per server, so that the traffic spike of a single hostname can't exhaust NGINX.
+limit_req_zone $server_name zone={{ .MaxRequestRateZone }}:10m rate={{ .MaxRequestRate }}r/s;
+{{- end }}
+
+{{- if .SessionTicketKeyFiles }}
+
+# The session ticket keys are shared by the NGINX of all replicas, so that the clients can resume their TLS sessions
+# on any replica. The first key encrypts the tickets.
+{{- range $file := .SessionTicketKeyFiles }}
+ssl_session_ticket_key {{ $file }};
+{{- end }}
+{{- end }}
+
+{{- if .WAFEnforcerAddress }}
+
+app_protect_enforcer_address {{ .WAFEnforcerAddress }};
+{{- end }}
+
+{{- if .GeoIP }}
+
+# Look up the location of the client in the GeoIP database. The city fields are empty with a Country database.
+geoip2 {{ .GeoIP.DatabasePath }} {
+    {{- if .GeoIP.AutoReloadInterval }}
+    auto_reload {{ .GeoIP.AutoReloadInterval }};
+    {{- end }}
+    $geoip2_country_code country iso_code;
+    $geoip2_country_name country names en;
+    $geoip2_continent_code continent code;
+    $geoip2_city_name city names en;
+    $geoip2_subdivision_code subdivisions 0 iso_code;
+}
+
+log_format {{ .GeoIPAccessLogFormat }} '$remote_addr - $remote_user [$time_local] "$request" $status $body_bytes_sent '
+                     '"$http_referer" "$http_user_agent" "$geoip2_country_code" "$geoip2_city_name"';
+{{- end }}
+
+{{- if .ForwardedProto }}
+
+# Take the scheme of the client requests from the proto headers of the trusted hops, starting from the client.
+geo $realip_remote_addr ${{ .ForwardedProto.TrustedVariable }} {
+    default 0;
+    {{- range $address := .ForwardedProto.TrustedAddresses }}
+    {{ $address }} 1;
+    {{- end }}
+}
+
+map "{{ .ForwardedProto.Source }}" ${{ .ForwardedProto.Variable }} {
+    {{- range $p := .ForwardedProto.Parameters }}
+    "{{ $p.Value }}" {{ $p.Result }};
+    {{- end }}
+    default $scheme;
+}
+{{- end }}
+
+{{- range $ratio := .AccessLogRatios }}
+
+split_clients $request_id {{ $ratio.Name }} {
+    {{ $ratio.Value }}% 1;
+    * 0;
+}
+{{- end }}
+
+{{- if .KubernetesAccessLog }}
+
+# The locations of the Routes set the Kubernetes context of the requests. $ngf_upstream is the upstream
+# that the request is proxied to, which is mapped to its Service.
+js_var $ngf_route_namespace;
+js_var $ngf_route_name;
+js_var $ngf_route_rule;
+js_var $ngf_upstream;
+
+map $ngf_upstream $ngf_backend_service {
+    {{- range $s := .KubernetesAccessLog.Services }}
+    {{ $s.Value }} "{{ $s.Result }}";
+    {{- end }}
+    default "";
+}
+
+log_format {{ .KubernetesAccessLog.Format }} escape=json '{"time":"$time_iso8601","remote_addr":"$remote_addr",'
+    '"request":"$request","status":$status,"body_bytes_sent":$body_bytes_sent,"request_time":$request_time,'
+    '"http_referer":"$http_referer","http_user_agent":"$http_user_agent",'
+    '"gateway":"{{ .KubernetesAccessLog.Gateway }}","route_namespace":"$ngf_route_namespace",'
+    '"route_name":"$ngf_route_name","route_rule":"$ngf_route_rule","backend_service":"$ngf_backend_service",'
+    '"upstream_addr":"$upstream_addr"}';
+{{- end }}
+
+{{- if .RecordListenerRequests }}
+
+# $ngf_record_listener_request records the rejected requests of the listeners when the request is logged.
+# It evaluates to an empty string, so the request is always logged.
+access_log /dev/stdout {{ .AccessLogFormat }} if=${ngf_record_listener_request}1;
+{{- else }}
+
+access_log /dev/stdout {{ .AccessLogFormat }};
+{{- end }}
 `
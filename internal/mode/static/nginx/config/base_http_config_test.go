@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
@@ -47,12 +49,513 @@ func TestExecuteBaseHttp(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			res := executeBaseHTTPConfig(test.conf)
+			gen := GeneratorImpl{}
+			res := gen.executeBaseHTTPConfig(test.conf)
 			g.Expect(res).To(HaveLen(1))
 			g.Expect(test.expCount).To(Equal(strings.Count(string(res[0].data), expSubStr)))
 			g.Expect(strings.Count(string(res[0].data), "map $http_host $gw_api_compliant_host {")).To(Equal(1))
 			g.Expect(strings.Count(string(res[0].data), "map $http_upgrade $connection_upgrade {")).To(Equal(1))
 			g.Expect(strings.Count(string(res[0].data), "map $request_uri $request_uri_path {")).To(Equal(1))
+			g.Expect(strings.Count(string(res[0].data), "map $server_port $ngf_gateway_port {")).To(Equal(1))
+		})
+	}
+}
+
+func TestExecuteBaseHttp_ServerTokens(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		expSubStr    string
+		serverHeader dataplane.ServerHeader
+		plus         bool
+	}{
+		{
+			name:         "server tokens not set",
+			serverHeader: dataplane.ServerHeader{},
+			expSubStr:    "",
+		},
+		{
+			name:         "hide version",
+			serverHeader: dataplane.ServerHeader{HideVersion: true},
+			expSubStr:    "server_tokens off;",
+		},
+		{
+			name:         "custom value with NGINX Plus",
+			serverHeader: dataplane.ServerHeader{Value: helpers.GetPointer("my-server")},
+			plus:         true,
+			expSubStr:    `server_tokens "my-server";`,
+		},
+		{
+			name:         "empty value with NGINX Plus",
+			serverHeader: dataplane.ServerHeader{Value: helpers.GetPointer("")},
+			plus:         true,
+			expSubStr:    `server_tokens "";`,
+		},
+		{
+			name:         "custom value with NGINX OSS",
+			serverHeader: dataplane.ServerHeader{Value: helpers.GetPointer("my-server")},
+			expSubStr:    "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					ServerHeader: test.serverHeader,
+				},
+			}
+
+			gen := GeneratorImpl{plus: test.plus}
+			res := gen.executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			httpConf := string(res[0].data)
+			if test.expSubStr == "" {
+				g.Expect(httpConf).ToNot(ContainSubstring("server_tokens"))
+			} else {
+				g.Expect(strings.Count(httpConf, test.expSubStr)).To(Equal(1))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_AccessLogRatios(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			AccessLogRatios: []dataplane.Ratio{
+				{Name: "$ngf_access_log_ratio_10", Value: 10},
+				{Name: "$ngf_access_log_ratio_50", Value: 50},
+			},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	res := gen.executeBaseHTTPConfig(conf)
+	g.Expect(res).To(HaveLen(1))
+
+	httpConf := string(res[0].data)
+	g.Expect(httpConf).To(ContainSubstring(
+		"split_clients $request_id $ngf_access_log_ratio_10 {\n    10% 1;\n    * 0;\n}",
+	))
+	g.Expect(httpConf).To(ContainSubstring(
+		"split_clients $request_id $ngf_access_log_ratio_50 {\n    50% 1;\n    * 0;\n}",
+	))
+}
+
+func TestExecuteBaseHttp_ConnectionLimits(t *testing.T) {
+	t.Parallel()
+	connZone := "limit_conn_zone $binary_remote_addr zone=ngf_default_server_connections:10m;"
+	rateZone := "limit_req_zone $server_name zone=ngf_max_request_rate:10m rate=100r/s;"
+
+	tests := []struct {
+		name        string
+		limits      dataplane.ConnectionLimits
+		expConnZone int
+		expRateZone int
+	}{
+		{
+			name:        "no limits",
+			limits:      dataplane.ConnectionLimits{},
+			expConnZone: 0,
+			expRateZone: 0,
+		},
+		{
+			name: "default server connections limited",
+			limits: dataplane.ConnectionLimits{
+				DefaultServerMaxConnections: 10,
+			},
+			expConnZone: 1,
+			expRateZone: 0,
+		},
+		{
+			name: "request rate limited",
+			limits: dataplane.ConnectionLimits{
+				MaxRequestRate: 100,
+			},
+			expConnZone: 0,
+			expRateZone: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			res := gen.executeBaseHTTPConfig(dataplane.Configuration{ConnectionLimits: test.limits})
+			g.Expect(res).To(HaveLen(1))
+
+			httpConf := string(res[0].data)
+			g.Expect(strings.Count(httpConf, connZone)).To(Equal(test.expConnZone))
+			g.Expect(strings.Count(httpConf, rateZone)).To(Equal(test.expRateZone))
+		})
+	}
+}
+
+func TestExecuteBaseHttp_WAFEnforcerAddress(t *testing.T) {
+	t.Parallel()
+	enforcerAddress := "app_protect_enforcer_address 127.0.0.1:50000;"
+
+	tests := []struct {
+		bundles    map[dataplane.WAFBundleID]dataplane.WAFBundle
+		name       string
+		expAddress int
+	}{
+		{
+			name:       "no WAF bundles",
+			bundles:    nil,
+			expAddress: 0,
+		},
+		{
+			name: "WAF bundles",
+			bundles: map[dataplane.WAFBundleID]dataplane.WAFBundle{
+				"waf_bundle_test_policy": []byte("bundle"),
+			},
+			expAddress: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			res := gen.executeBaseHTTPConfig(dataplane.Configuration{WAFBundles: test.bundles})
+			g.Expect(res).To(HaveLen(1))
+
+			g.Expect(strings.Count(string(res[0].data), enforcerAddress)).To(Equal(test.expAddress))
+		})
+	}
+}
+
+func TestExecuteBaseHttp_SessionTicketKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expStrings map[string]int
+		name       string
+		keys       []dataplane.SessionTicketKey
+	}{
+		{
+			name: "no session ticket keys",
+			keys: nil,
+			expStrings: map[string]int{
+				"ssl_session_ticket_key": 0,
+			},
+		},
+		{
+			name: "session ticket keys",
+			keys: []dataplane.SessionTicketKey{[]byte("current"), []byte("next")},
+			expStrings: map[string]int{
+				"ssl_session_ticket_key /etc/nginx/secrets/session_ticket_key_0.key;": 1,
+				"ssl_session_ticket_key /etc/nginx/secrets/session_ticket_key_1.key;": 1,
+				"ssl_session_ticket_key": 2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			res := gen.executeBaseHTTPConfig(dataplane.Configuration{SessionTicketKeys: test.keys})
+			g.Expect(res).To(HaveLen(1))
+
+			for expSubStr, expCount := range test.expStrings {
+				g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_GeoIP(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		geoIP         *dataplane.GeoIP
+		name          string
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name:          "geoIP not configured",
+			geoIP:         nil,
+			notExpStrings: []string{"geoip2", "log_format"},
+		},
+		{
+			name: "geoIP configured",
+			geoIP: &dataplane.GeoIP{
+				DatabasePath: "/etc/nginx/geoip/GeoLite2-Country.mmdb",
+			},
+			expStrings: []string{
+				"geoip2 /etc/nginx/geoip/GeoLite2-Country.mmdb {\n    $geoip2_country_code country iso_code;",
+				"$geoip2_city_name city names en;",
+				"log_format ngf_geoip '$remote_addr - $remote_user [$time_local] \"$request\" $status ",
+				"\"$geoip2_country_code\" \"$geoip2_city_name\"';",
+			},
+			notExpStrings: []string{"auto_reload"},
+		},
+		{
+			name: "geoIP configured with auto reload",
+			geoIP: &dataplane.GeoIP{
+				DatabasePath:       "/etc/nginx/geoip/GeoLite2-City.mmdb",
+				AutoReloadInterval: "60m",
+			},
+			expStrings: []string{
+				"geoip2 /etc/nginx/geoip/GeoLite2-City.mmdb {\n    auto_reload 60m;\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					GeoIP: test.geoIP,
+				},
+			}
+
+			gen := GeneratorImpl{}
+			res := gen.executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			httpConf := string(res[0].data)
+			for _, str := range test.expStrings {
+				g.Expect(httpConf).To(ContainSubstring(str))
+			}
+
+			for _, str := range test.notExpStrings {
+				g.Expect(httpConf).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_ForwardedProto(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		hops          []dataplane.TrustedProxyHop
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name:          "trusted hops not configured",
+			notExpStrings: []string{"$ngf_trusted_hop", "$ngf_forwarded_proto"},
+		},
+		{
+			name: "trusted hops configured",
+			hops: []dataplane.TrustedProxyHop{
+				{
+					ProtoHeader: "CloudFront-Forwarded-Proto",
+					Addresses:   []string{"130.176.0.0/16", "2600:9000::/28"},
+				},
+				{
+					ProtoHeader: "X-Forwarded-Proto",
+					Addresses:   []string{"10.0.0.0/8"},
+				},
+				{
+					ProtoHeader: "X-Forwarded-Proto",
+					Addresses:   []string{"10.0.0.0/8", "172.16.0.0/12"},
+				},
+			},
+			expStrings: []string{
+				"geo $realip_remote_addr $ngf_trusted_hop {\n    default 0;\n    130.176.0.0/16 1;\n" +
+					"    2600:9000::/28 1;\n    10.0.0.0/8 1;\n    172.16.0.0/12 1;\n}",
+				"map \"$ngf_trusted_hop:$http_cloudfront_forwarded_proto:$http_x_forwarded_proto\" " +
+					"$ngf_forwarded_proto {\n" +
+					"    \"~*^1:\\s*https\\b\" https;\n" +
+					"    \"~*^1:\\s*http\\b\" http;\n" +
+					"    \"~*^1:[^:]*:\\s*https\\b\" https;\n" +
+					"    \"~*^1:[^:]*:\\s*http\\b\" http;\n" +
+					"    default $scheme;\n}",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					RewriteClientIPSettings: dataplane.RewriteClientIPSettings{
+						Mode:        dataplane.RewriteIPModeXForwardedFor,
+						TrustedHops: test.hops,
+					},
+				},
+			}
+
+			gen := GeneratorImpl{}
+			res := gen.executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			httpConf := string(res[0].data)
+			for _, str := range test.expStrings {
+				g.Expect(httpConf).To(ContainSubstring(str))
+			}
+
+			for _, str := range test.notExpStrings {
+				g.Expect(httpConf).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_KubernetesAccessLog(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		accessLog              *dataplane.KubernetesAccessLog
+		name                   string
+		expStrings             []string
+		notExpStrings          []string
+		recordListenerRequests bool
+	}{
+		{
+			name: "combined format",
+			expStrings: []string{
+				"access_log /dev/stdout combined;",
+			},
+			notExpStrings: []string{"log_format", "$ngf_backend_service", "js_var", "$ngf_record_listener_request"},
+		},
+		{
+			name: "combined format with the listener requests recorded",
+			expStrings: []string{
+				"access_log /dev/stdout combined if=${ngf_record_listener_request}1;",
+			},
+			notExpStrings:          []string{"access_log /dev/null"},
+			recordListenerRequests: true,
+		},
+		{
+			name: "kubernetes format",
+			accessLog: &dataplane.KubernetesAccessLog{
+				Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+			},
+			recordListenerRequests: true,
+			expStrings: []string{
+				"js_var $ngf_route_namespace;",
+				"js_var $ngf_upstream;",
+				"map $ngf_upstream $ngf_backend_service {\n" +
+					"    test_coffee_80 \"test/coffee\";\n" +
+					"    test_aaaaaaaaaaaaa_a1b2c3d4e5 \"test/aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\";\n" +
+					"    default \"\";\n}",
+				"log_format ngf_kubernetes escape=json '{\"time\":\"$time_iso8601\"",
+				"\"gateway\":\"test/gateway\",\"route_namespace\":\"$ngf_route_namespace\",",
+				"\"backend_service\":\"$ngf_backend_service\",",
+				"access_log /dev/stdout ngf_kubernetes if=${ngf_record_listener_request}1;",
+			},
+			notExpStrings: []string{"access_log /dev/stdout combined;"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				Logging: dataplane.Logging{
+					KubernetesAccessLog: test.accessLog,
+				},
+				Upstreams: []dataplane.Upstream{
+					{Name: "test_coffee_80"},
+					{
+						Name:       "test_aaaaaaaaaaaaa_a1b2c3d4e5",
+						HashedFrom: "test/aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa:80",
+					},
+				},
+			}
+
+			gen := GeneratorImpl{recordListenerRequests: test.recordListenerRequests}
+			res := gen.executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			httpConf := string(res[0].data)
+			for _, str := range test.expStrings {
+				g.Expect(httpConf).To(ContainSubstring(str))
+			}
+
+			for _, str := range test.notExpStrings {
+				g.Expect(httpConf).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
+
+func TestExecuteBaseHttp_UnprivilegedPorts(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "cafe.example.com", Port: 80},
+			{IsDefault: true, Port: 8081},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 443},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		expStrings    []string
+		notExpStrings []string
+		offset        int32
+	}{
+		{
+			name: "no offset",
+			expStrings: []string{
+				"map $server_port $ngf_gateway_port {\n    default $server_port;\n}",
+			},
+			notExpStrings: []string{
+				"absolute_redirect off;",
+			},
+		},
+		{
+			name:   "offset",
+			offset: 8000,
+			expStrings: []string{
+				"map $server_port $ngf_gateway_port {\n    default $server_port;\n    8080 80;\n    8443 443;\n}",
+				"absolute_redirect off;",
+			},
+			notExpStrings: []string{
+				"16081 8081;",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}.WithUnprivilegedPortOffset(test.offset)
+			res := gen.executeBaseHTTPConfig(conf)
+			g.Expect(res).To(HaveLen(1))
+
+			httpConf := string(res[0].data)
+			for _, str := range test.expStrings {
+				g.Expect(httpConf).To(ContainSubstring(str))
+			}
+
+			for _, str := range test.notExpStrings {
+				g.Expect(httpConf).ToNot(ContainSubstring(str))
+			}
 		})
 	}
 }
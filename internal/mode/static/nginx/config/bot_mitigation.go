@@ -0,0 +1,135 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	gotemplate "text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/botmitigation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var botMitigationTemplate = gotemplate.Must(gotemplate.New("botMitigation").Parse(botMitigationTemplateText))
+
+// botMitigation holds the configuration of the http context for a BotMitigationPolicy: the maps that classify
+// the requests as requests of bots.
+type botMitigation struct {
+	Variable             string
+	ChallengeCookie      string
+	ChallengeCookieValue string
+	UserAgentPatterns    []string
+	RequiredHeaders      []botRequiredHeader
+	Crawlers             []botCrawler
+	Challenge            bool
+}
+
+// botRequiredHeader holds the variable that evaluates to 1 if a request has the header.
+type botRequiredHeader struct {
+	Name     string
+	Variable string
+}
+
+// botCrawler holds the geo variable of a verified crawler, which evaluates to "allow" if a request comes
+// from one of the source CIDRs of the crawler, or to "bot" otherwise.
+type botCrawler struct {
+	Variable         string
+	UserAgentPattern string
+	SourceCIDRs      []string
+}
+
+// executeBotMitigations generates the maps of the BotMitigationPolicies that the rules of the servers reference.
+func executeBotMitigations(conf dataplane.Configuration) []executeResult {
+	servers := make([]dataplane.VirtualServer, 0, len(conf.HTTPServers)+len(conf.SSLServers))
+	servers = append(servers, conf.HTTPServers...)
+	servers = append(servers, conf.SSLServers...)
+
+	mitigations := buildBotMitigations(servers)
+	if len(mitigations) == 0 {
+		return nil
+	}
+
+	return []executeResult{
+		{
+			dest: httpConfigFile,
+			data: helpers.MustExecuteTemplate(botMitigationTemplate, mitigations),
+		},
+	}
+}
+
+func buildBotMitigations(servers []dataplane.VirtualServer) []botMitigation {
+	pols := make(map[types.NamespacedName]*ngfAPI.BotMitigationPolicy)
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, pol := range pr.Policies {
+				if bmp, ok := pol.(*ngfAPI.BotMitigationPolicy); ok {
+					pols[types.NamespacedName{Namespace: bmp.Namespace, Name: bmp.Name}] = bmp
+				}
+			}
+		}
+	}
+
+	nsNames := make([]types.NamespacedName, 0, len(pols))
+	for nsName := range pols {
+		nsNames = append(nsNames, nsName)
+	}
+
+	// The policies are sorted, so that the order of the generated config is stable.
+	slices.SortFunc(nsNames, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	mitigations := make([]botMitigation, 0, len(nsNames))
+	for _, nsName := range nsNames {
+		mitigations = append(mitigations, createBotMitigation(pols[nsName]))
+	}
+
+	return mitigations
+}
+
+func createBotMitigation(bmp *ngfAPI.BotMitigationPolicy) botMitigation {
+	variable := botmitigation.VariableName(bmp)
+
+	mitigation := botMitigation{
+		Variable:             variable,
+		ChallengeCookie:      botmitigation.ChallengeCookie,
+		ChallengeCookieValue: botmitigation.ChallengeCookieValue,
+		Challenge:            botmitigation.IsChallenge(bmp),
+	}
+
+	for i, crawler := range bmp.Spec.VerifiedCrawlers {
+		mitigation.Crawlers = append(mitigation.Crawlers, botCrawler{
+			Variable:         fmt.Sprintf("%s_crawler_%d", variable, i),
+			UserAgentPattern: escapeMapRegex(crawler.UserAgentPattern),
+			SourceCIDRs:      crawler.SourceCIDRs,
+		})
+	}
+
+	for _, pattern := range bmp.Spec.UserAgentPatterns {
+		mitigation.UserAgentPatterns = append(mitigation.UserAgentPatterns, escapeMapRegex(pattern))
+	}
+
+	if bmp.Spec.BlockKnownScanners != nil && *bmp.Spec.BlockKnownScanners {
+		mitigation.UserAgentPatterns = append(mitigation.UserAgentPatterns, botmitigation.KnownScannersPattern)
+	}
+
+	for i, header := range bmp.Spec.RequiredHeaders {
+		mitigation.RequiredHeaders = append(mitigation.RequiredHeaders, botRequiredHeader{
+			Name:     strings.ToLower(convertStringToSafeVariableName(header)),
+			Variable: fmt.Sprintf("%s_header_%d", variable, i),
+		})
+	}
+
+	return mitigation
+}
+
+// escapeMapRegex escapes the backslashes of a regular expression, so that NGINX passes them to the regular
+// expression of a quoted map parameter.
+func escapeMapRegex(pattern string) string {
+	return strings.ReplaceAll(pattern, `\`, `\\`)
+}
@@ -0,0 +1,41 @@
+package config
+
+const botMitigationTemplateText = `
+{{- range $b := . }}
+{{- range $c := $b.Crawlers }}
+geo ${{ $c.Variable }} {
+    default bot;
+    {{- range $cidr := $c.SourceCIDRs }}
+    {{ $cidr }} allow;
+    {{- end }}
+}
+{{ end }}
+map $http_user_agent ${{ $b.Variable }}_ua {
+    {{- range $c := $b.Crawlers }}
+    "~*{{ $c.UserAgentPattern }}" ${{ $c.Variable }};
+    {{- end }}
+    {{- range $p := $b.UserAgentPatterns }}
+    "~*{{ $p }}" bot;
+    {{- end }}
+    default check;
+}
+{{ range $h := $b.RequiredHeaders }}
+map $http_{{ $h.Name }} ${{ $h.Variable }} {
+    "" 0;
+    default 1;
+}
+{{ end }}
+map "${{ $b.Variable }}_ua:{{ range $h := $b.RequiredHeaders }}${{ $h.Variable }}{{ end }}:
+    {{- if $b.Challenge }}$cookie_{{ $b.ChallengeCookie }}{{ end }}" ${{ $b.Variable }} {
+    "~^allow:" 0;
+    {{- if $b.Challenge }}
+    "~:{{ $b.ChallengeCookieValue }}$" 0;
+    {{- end }}
+    "~^bot:" 1;
+    {{- if $b.RequiredHeaders }}
+    "~^check:[01]*0" 1;
+    {{- end }}
+    default 0;
+}
+{{ end }}
+`
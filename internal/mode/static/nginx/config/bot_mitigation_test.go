@@ -0,0 +1,132 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteBotMitigations(t *testing.T) {
+	t.Parallel()
+
+	blockPolicy := &ngfAPI.BotMitigationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "block-bots"},
+		Spec: ngfAPI.BotMitigationPolicySpec{
+			BlockKnownScanners: helpers.GetPointer(true),
+			UserAgentPatterns:  []string{`curl/\d+`},
+			RequiredHeaders:    []string{"Accept", "Accept-Language"},
+			VerifiedCrawlers: []ngfAPI.VerifiedCrawler{
+				{
+					UserAgentPattern: "googlebot",
+					SourceCIDRs:      []string{"66.249.64.0/19", "2001:4860:4801::/48"},
+				},
+			},
+		},
+	}
+
+	challengePolicy := &ngfAPI.BotMitigationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "challenge-bots"},
+		Spec: ngfAPI.BotMitigationPolicySpec{
+			Action:            helpers.GetPointer(ngfAPI.BotMitigationActionChallenge),
+			UserAgentPatterns: []string{"python-requests"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		servers       []dataplane.VirtualServer
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "block policy",
+			servers: []dataplane.VirtualServer{
+				{
+					PathRules: []dataplane.PathRule{
+						{Policies: []policies.Policy{blockPolicy}},
+						{Policies: []policies.Policy{blockPolicy}},
+					},
+				},
+			},
+			expStrings: []string{
+				"geo $ngf_bot_test_block_bots_crawler_0 {\n    default bot;\n" +
+					"    66.249.64.0/19 allow;\n    2001:4860:4801::/48 allow;\n}",
+				"map $http_user_agent $ngf_bot_test_block_bots_ua {\n" +
+					"    \"~*googlebot\" $ngf_bot_test_block_bots_crawler_0;\n" +
+					"    \"~*curl/\\\\d+\" bot;\n" +
+					"    \"~*(sqlmap|nikto|nmap|",
+				"map $http_accept $ngf_bot_test_block_bots_header_0 {\n    \"\" 0;\n    default 1;\n}",
+				"map $http_accept_language $ngf_bot_test_block_bots_header_1 {",
+				"map \"$ngf_bot_test_block_bots_ua:$ngf_bot_test_block_bots_header_0" +
+					"$ngf_bot_test_block_bots_header_1:\" $ngf_bot_test_block_bots {\n" +
+					"    \"~^allow:\" 0;\n    \"~^bot:\" 1;\n    \"~^check:[01]*0\" 1;\n    default 0;\n}",
+			},
+			notExpStrings: []string{"cookie"},
+		},
+		{
+			name: "challenge policy",
+			servers: []dataplane.VirtualServer{
+				{
+					PathRules: []dataplane.PathRule{
+						{Policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}}},
+					},
+				},
+				{
+					PathRules: []dataplane.PathRule{
+						{Policies: []policies.Policy{challengePolicy}},
+					},
+				},
+			},
+			expStrings: []string{
+				"map $http_user_agent $ngf_bot_test_challenge_bots_ua {\n" +
+					"    \"~*python-requests\" bot;\n    default check;\n}",
+				"map \"$ngf_bot_test_challenge_bots_ua::$cookie_ngf_bot_challenge\" $ngf_bot_test_challenge_bots {\n" +
+					"    \"~^allow:\" 0;\n    \"~:passed$\" 0;\n    \"~^bot:\" 1;\n    default 0;\n}",
+			},
+			notExpStrings: []string{"geo", "sqlmap", "$http_accept"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			results := executeBotMitigations(dataplane.Configuration{SSLServers: test.servers})
+			g.Expect(results).To(HaveLen(1))
+			g.Expect(results[0].dest).To(Equal(httpConfigFile))
+
+			conf := string(results[0].data)
+			for _, str := range test.expStrings {
+				g.Expect(conf).To(ContainSubstring(str))
+			}
+
+			for _, str := range test.notExpStrings {
+				g.Expect(conf).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
+
+func TestExecuteBotMitigations_NoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{
+					{Policies: []policies.Policy{&ngfAPI.ClientSettingsPolicy{}}},
+				},
+			},
+		},
+	}
+
+	g.Expect(executeBotMitigations(conf)).To(BeEmpty())
+}
@@ -21,6 +21,17 @@ type FakeGenerator struct {
 	generateReturnsOnCall map[int]struct {
 		result1 []file.File
 	}
+	GenerateUpstreamsStub        func(dataplane.Configuration) []file.File
+	generateUpstreamsMutex       sync.RWMutex
+	generateUpstreamsArgsForCall []struct {
+		arg1 dataplane.Configuration
+	}
+	generateUpstreamsReturns struct {
+		result1 []file.File
+	}
+	generateUpstreamsReturnsOnCall map[int]struct {
+		result1 []file.File
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +97,74 @@ func (fake *FakeGenerator) GenerateReturnsOnCall(i int, result1 []file.File) {
 	}{result1}
 }
 
+func (fake *FakeGenerator) GenerateUpstreams(arg1 dataplane.Configuration) []file.File {
+	fake.generateUpstreamsMutex.Lock()
+	ret, specificReturn := fake.generateUpstreamsReturnsOnCall[len(fake.generateUpstreamsArgsForCall)]
+	fake.generateUpstreamsArgsForCall = append(fake.generateUpstreamsArgsForCall, struct {
+		arg1 dataplane.Configuration
+	}{arg1})
+	stub := fake.GenerateUpstreamsStub
+	fakeReturns := fake.generateUpstreamsReturns
+	fake.recordInvocation("GenerateUpstreams", []interface{}{arg1})
+	fake.generateUpstreamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenerator) GenerateUpstreamsCallCount() int {
+	fake.generateUpstreamsMutex.RLock()
+	defer fake.generateUpstreamsMutex.RUnlock()
+	return len(fake.generateUpstreamsArgsForCall)
+}
+
+func (fake *FakeGenerator) GenerateUpstreamsCalls(stub func(dataplane.Configuration) []file.File) {
+	fake.generateUpstreamsMutex.Lock()
+	defer fake.generateUpstreamsMutex.Unlock()
+	fake.GenerateUpstreamsStub = stub
+}
+
+func (fake *FakeGenerator) GenerateUpstreamsArgsForCall(i int) dataplane.Configuration {
+	fake.generateUpstreamsMutex.RLock()
+	defer fake.generateUpstreamsMutex.RUnlock()
+	argsForCall := fake.generateUpstreamsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenerator) GenerateUpstreamsReturns(result1 []file.File) {
+	fake.generateUpstreamsMutex.Lock()
+	defer fake.generateUpstreamsMutex.Unlock()
+	fake.GenerateUpstreamsStub = nil
+	fake.generateUpstreamsReturns = struct {
+		result1 []file.File
+	}{result1}
+}
+
+func (fake *FakeGenerator) GenerateUpstreamsReturnsOnCall(i int, result1 []file.File) {
+	fake.generateUpstreamsMutex.Lock()
+	defer fake.generateUpstreamsMutex.Unlock()
+	fake.GenerateUpstreamsStub = nil
+	if fake.generateUpstreamsReturnsOnCall == nil {
+		fake.generateUpstreamsReturnsOnCall = make(map[int]struct {
+			result1 []file.File
+		})
+	}
+	fake.generateUpstreamsReturnsOnCall[i] = struct {
+		result1 []file.File
+	}{result1}
+}
+
 func (fake *FakeGenerator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.generateMutex.RLock()
 	defer fake.generateMutex.RUnlock()
+	fake.generateUpstreamsMutex.RLock()
+	defer fake.generateUpstreamsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -5,14 +5,20 @@ import (
 
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 
-	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
-// ConvertEndpoints converts a list of Endpoints into a list of NGINX Plus SDK UpstreamServers.
-func ConvertEndpoints(eps []resolver.Endpoint) []ngxclient.UpstreamServer {
-	servers := make([]ngxclient.UpstreamServer, 0, len(eps))
+// ConvertUpstream converts the Endpoints of an Upstream into a list of NGINX Plus SDK UpstreamServers.
+// The servers include the settings of the UpstreamSettingsPolicies of the Upstream, so that the servers
+// set through the NGINX Plus API match the servers in the configuration.
+func ConvertUpstream(up dataplane.Upstream) []ngxclient.UpstreamServer {
+	settings := upstreamsettings.ProcessPolicies(up.Policies)
 
-	for _, ep := range eps {
+	servers := make([]ngxclient.UpstreamServer, 0, len(up.Endpoints))
+
+	for _, ep := range up.Endpoints {
 		var port string
 		if ep.Port != 0 {
 			port = fmt.Sprintf(":%d", ep.Port)
@@ -27,6 +33,10 @@ func ConvertEndpoints(eps []resolver.Endpoint) []ngxclient.UpstreamServer {
 			Server: fmt.Sprintf(format, ep.Address, port),
 		}
 
+		if settings.MaxConns != 0 {
+			server.MaxConns = helpers.GetPointer(int(settings.MaxConns))
+		}
+
 		servers = append(servers, server)
 	}
 
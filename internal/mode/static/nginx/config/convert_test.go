@@ -5,11 +5,16 @@ import (
 
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
-func TestConvertEndpoints(t *testing.T) {
+func TestConvertUpstream(t *testing.T) {
 	t.Parallel()
 	endpoints := []resolver.Endpoint{
 		{
@@ -27,18 +32,54 @@ func TestConvertEndpoints(t *testing.T) {
 		},
 	}
 
-	expUpstreams := []ngxclient.UpstreamServer{
+	tests := []struct {
+		name         string
+		upstream     dataplane.Upstream
+		expUpstreams []ngxclient.UpstreamServer
+	}{
 		{
-			Server: "1.2.3.4:80",
+			name:     "no policies",
+			upstream: dataplane.Upstream{Endpoints: endpoints},
+			expUpstreams: []ngxclient.UpstreamServer{
+				{
+					Server: "1.2.3.4:80",
+				},
+				{
+					Server: "5.6.7.8",
+				},
+				{
+					Server: "[2001:db8::1]:443",
+				},
+			},
 		},
 		{
-			Server: "5.6.7.8",
-		},
-		{
-			Server: "[2001:db8::1]:443",
+			name: "upstream settings policy with max connections",
+			upstream: dataplane.Upstream{
+				Endpoints: endpoints[:1],
+				Policies: []policies.Policy{
+					&ngfAPI.UpstreamSettingsPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "usp", Namespace: "test"},
+						Spec: ngfAPI.UpstreamSettingsPolicySpec{
+							MaxConnections: helpers.GetPointer[int32](10),
+						},
+					},
+				},
+			},
+			expUpstreams: []ngxclient.UpstreamServer{
+				{
+					Server:   "1.2.3.4:80",
+					MaxConns: helpers.GetPointer(10),
+				},
+			},
 		},
 	}
 
-	g := NewWithT(t)
-	g.Expect(ConvertEndpoints(endpoints)).To(Equal(expUpstreams))
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(ConvertUpstream(test.upstream)).To(Equal(test.expUpstreams))
+		})
+	}
 }
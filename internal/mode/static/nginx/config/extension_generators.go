@@ -0,0 +1,75 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/extensions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// extensionsGenerator generates the configuration of the registered extensions.
+// It implements policies.Generator, so that the configuration of the extensions is included in the servers
+// and the locations like the configuration of the policies.
+type extensionsGenerator struct {
+	extensions []extensions.Extension
+}
+
+func newExtensionsGenerator(exts []extensions.Extension) extensionsGenerator {
+	return extensionsGenerator{extensions: exts}
+}
+
+func (g extensionsGenerator) GenerateForServer(_ []policies.Policy, server http.Server) policies.GenerateResultFiles {
+	return g.generate(func(generator extensions.Generator) []extensions.File {
+		return generator.GenerateForServer(server)
+	})
+}
+
+func (g extensionsGenerator) GenerateForLocation(
+	_ []policies.Policy,
+	location http.Location,
+) policies.GenerateResultFiles {
+	return g.generate(func(generator extensions.Generator) []extensions.File {
+		return generator.GenerateForLocation(location)
+	})
+}
+
+func (g extensionsGenerator) GenerateForInternalLocation(
+	_ []policies.Policy,
+	location http.Location,
+) policies.GenerateResultFiles {
+	return g.GenerateForLocation(nil, location)
+}
+
+// generateForUpstream returns the includes of the configuration of the extensions for the upstream.
+func (g extensionsGenerator) generateForUpstream(upstream http.Upstream) []http.Include {
+	return createIncludesFromPolicyGenerateResult(
+		g.generate(func(generator extensions.Generator) []extensions.File {
+			return generator.GenerateForUpstream(upstream)
+		}),
+	)
+}
+
+// generate calls the generators of the extensions in order. The names of the files are prefixed with
+// the names of the extensions, so that the files of different extensions don't collide. The files that have no
+// configuration are dropped.
+func (g extensionsGenerator) generate(
+	generate func(generator extensions.Generator) []extensions.File,
+) policies.GenerateResultFiles {
+	var result policies.GenerateResultFiles
+
+	for _, ext := range g.extensions {
+		for _, f := range generate(ext.Generator) {
+			if policies.IsEmpty(f.Content) {
+				continue
+			}
+
+			result = append(result, policies.File{
+				Name:    fmt.Sprintf("extension_%s_%s", ext.Name, f.Name),
+				Content: f.Content,
+			})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/extensions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+type testExtensionGenerator struct {
+	directive string
+}
+
+func (g testExtensionGenerator) GenerateForServer(server http.Server) []extensions.File {
+	return []extensions.File{
+		{Name: "server_" + server.ServerName + ".conf", Content: []byte(g.directive)},
+	}
+}
+
+func (g testExtensionGenerator) GenerateForLocation(location http.Location) []extensions.File {
+	return []extensions.File{
+		{Name: "location.conf", Content: []byte(g.directive + " " + location.Path)},
+	}
+}
+
+func (g testExtensionGenerator) GenerateForUpstream(upstream http.Upstream) []extensions.File {
+	return []extensions.File{
+		{Name: "upstream_" + upstream.Name + ".conf", Content: []byte(g.directive)},
+	}
+}
+
+// whitespaceExtensionGenerator generates files without configuration.
+type whitespaceExtensionGenerator struct{}
+
+func (g whitespaceExtensionGenerator) GenerateForServer(_ http.Server) []extensions.File {
+	return []extensions.File{{Name: "server.conf", Content: []byte("\n")}}
+}
+
+func (g whitespaceExtensionGenerator) GenerateForLocation(_ http.Location) []extensions.File {
+	return []extensions.File{{Name: "location.conf", Content: []byte(" \n\t")}}
+}
+
+func (g whitespaceExtensionGenerator) GenerateForUpstream(_ http.Upstream) []extensions.File {
+	return []extensions.File{{Name: "upstream.conf"}}
+}
+
+func TestExtensionsGenerator(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gen := newExtensionsGenerator([]extensions.Extension{
+		{Name: "first", Generator: testExtensionGenerator{directive: "first_directive"}},
+		{Name: "empty", Generator: extensions.UnimplementedGenerator{}},
+		{Name: "whitespace", Generator: whitespaceExtensionGenerator{}},
+		{Name: "second", Generator: testExtensionGenerator{directive: "second_directive"}},
+	})
+
+	g.Expect(gen.GenerateForServer(nil, http.Server{ServerName: "cafe.example.com"})).To(Equal(
+		policies.GenerateResultFiles{
+			{Name: "extension_first_server_cafe.example.com.conf", Content: []byte("first_directive")},
+			{Name: "extension_second_server_cafe.example.com.conf", Content: []byte("second_directive")},
+		},
+	))
+
+	expLocationFiles := policies.GenerateResultFiles{
+		{Name: "extension_first_location.conf", Content: []byte("first_directive /coffee")},
+		{Name: "extension_second_location.conf", Content: []byte("second_directive /coffee")},
+	}
+	g.Expect(gen.GenerateForLocation(nil, http.Location{Path: "/coffee"})).To(Equal(expLocationFiles))
+	g.Expect(gen.GenerateForInternalLocation(nil, http.Location{Path: "/coffee"})).To(Equal(expLocationFiles))
+
+	g.Expect(gen.generateForUpstream(http.Upstream{Name: "coffee"})).To(Equal([]http.Include{
+		{Name: includesFolder + "/extension_first_upstream_coffee.conf", Content: []byte("first_directive")},
+		{Name: includesFolder + "/extension_second_upstream_coffee.conf", Content: []byte("second_directive")},
+	}))
+
+	g.Expect(newExtensionsGenerator(nil).GenerateForServer(nil, http.Server{})).To(BeEmpty())
+}
+
+func TestExecuteUpstreams_Extensions(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{
+		extensions: newExtensionsGenerator([]extensions.Extension{
+			{Name: "test", Generator: testExtensionGenerator{directive: "keepalive 16;"}},
+		}),
+	}
+
+	results := gen.executeUpstreams(dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{
+				Name:      "coffee",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.0", Port: 80}},
+			},
+		},
+	})
+	g.Expect(results).To(HaveLen(2))
+
+	g.Expect(results[0].dest).To(Equal(includesFolder + "/extension_test_upstream_coffee.conf"))
+	g.Expect(string(results[0].data)).To(Equal("keepalive 16;"))
+
+	g.Expect(results[1].dest).To(Equal(httpUpstreamsFile))
+	g.Expect(string(results[1].data)).To(ContainSubstring(
+		"include " + includesFolder + "/extension_test_upstream_coffee.conf;",
+	))
+	g.Expect(string(results[1].data)).ToNot(ContainSubstring("extension_test_upstream_invalid-backend-ref"))
+}
@@ -0,0 +1,417 @@
+package config
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var extensionRefFiltersTemplate = gotemplate.Must(
+	gotemplate.New("extensionRefFilters").Parse(extensionRefFiltersTemplateText),
+)
+
+// httpExtensionRefFilters holds the configuration of the http context for the extensionRef filters of the routes.
+type httpExtensionRefFilters struct {
+	RateLimitZones []dataplane.RateLimitZone
+	Includes       []http.Include
+}
+
+// executeExtensionRefFilters generates the configuration of the http context for the extensionRef filters:
+// the shared memory zones of the rate limits and the includes of the http snippets.
+func executeExtensionRefFilters(conf dataplane.Configuration) []executeResult {
+	filters := createHTTPExtensionRefFilters(append(conf.HTTPServers, conf.SSLServers...))
+
+	results := make([]executeResult, 0, len(filters.Includes)+1)
+	results = append(results, executeResult{
+		dest: httpConfigFile,
+		data: helpers.MustExecuteTemplate(extensionRefFiltersTemplate, filters),
+	})
+
+	for _, include := range filters.Includes {
+		results = append(results, executeResult{
+			dest: include.Name,
+			data: include.Content,
+		})
+	}
+
+	return results
+}
+
+func createHTTPExtensionRefFilters(servers []dataplane.VirtualServer) httpExtensionRefFilters {
+	zones := make(map[string]dataplane.RateLimitZone)
+	snippets := make(map[string]*dataplane.Snippet)
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.Filters.RateLimit != nil {
+					zones[mr.Filters.RateLimit.Zone.Name] = mr.Filters.RateLimit.Zone
+				}
+
+				for _, sf := range mr.Filters.SnippetsFilters {
+					if sf.HTTPSnippet != nil {
+						snippets[sf.HTTPSnippet.Name] = sf.HTTPSnippet
+					}
+				}
+			}
+		}
+	}
+
+	var result httpExtensionRefFilters
+
+	for _, z := range zones {
+		result.RateLimitZones = append(result.RateLimitZones, z)
+	}
+
+	slices.SortFunc(result.RateLimitZones, func(a, b dataplane.RateLimitZone) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, snippet := range snippets {
+		result.Includes = append(result.Includes, createSnippetInclude(snippet))
+	}
+
+	slices.SortFunc(result.Includes, func(a, b http.Include) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+// createServerSnippetIncludes creates the includes of the server snippets of the SnippetsFilters that
+// the rules of the server reference.
+func createServerSnippetIncludes(pathRules []dataplane.PathRule) []http.Include {
+	var includes []http.Include
+	seen := make(map[string]struct{})
+
+	for _, pr := range pathRules {
+		for _, mr := range pr.MatchRules {
+			for _, sf := range mr.Filters.SnippetsFilters {
+				if sf.ServerSnippet == nil {
+					continue
+				}
+
+				if _, exists := seen[sf.ServerSnippet.Name]; exists {
+					continue
+				}
+
+				seen[sf.ServerSnippet.Name] = struct{}{}
+				includes = append(includes, createSnippetInclude(sf.ServerSnippet))
+			}
+		}
+	}
+
+	return includes
+}
+
+// createLocationSnippetIncludes creates the includes of the location snippets of the SnippetsFilters.
+func createLocationSnippetIncludes(snippetsFilters []dataplane.SnippetsFilter) []http.Include {
+	var includes []http.Include
+
+	for _, sf := range snippetsFilters {
+		if sf.LocationSnippet != nil {
+			includes = append(includes, createSnippetInclude(sf.LocationSnippet))
+		}
+	}
+
+	return includes
+}
+
+func createSnippetInclude(snippet *dataplane.Snippet) http.Include {
+	return http.Include{
+		Name:    includesFolder + "/" + snippet.Name + ".conf",
+		Content: []byte(snippet.Contents),
+	}
+}
+
+func createRateLimit(rateLimit *dataplane.RateLimit) *http.RateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &http.RateLimit{
+		Zone:       rateLimit.Zone.Name,
+		Burst:      rateLimit.Burst,
+		RejectCode: rateLimit.RejectCode,
+		NoDelay:    rateLimit.NoDelay,
+	}
+}
+
+// mapSpecialParameters are the names of the parameters of the map directive. A source value that is equal to one
+// of them, or that starts with '~', must be escaped with '\'.
+var mapSpecialParameters = map[string]struct{}{
+	"default":   {},
+	"hostnames": {},
+	"include":   {},
+	"volatile":  {},
+}
+
+// buildConditionalResponseHeaderMaps builds the maps of the conditional response headers of the ResponseHeaderFilters
+// that the rules of the servers reference. The map of a header evaluates to the value of the header if the response
+// matches the condition of the header, or to an empty string, in which case NGINX doesn't add the header.
+func buildConditionalResponseHeaderMaps(servers []dataplane.VirtualServer) []shared.Map {
+	var maps []shared.Map
+	seen := make(map[string]struct{})
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				for _, h := range mr.Filters.ConditionalResponseHeaders {
+					if _, exists := seen[h.ID]; exists {
+						continue
+					}
+
+					seen[h.ID] = struct{}{}
+					maps = append(maps, createConditionalResponseHeaderMaps(h)...)
+				}
+			}
+		}
+	}
+
+	return maps
+}
+
+func createConditionalResponseHeaderMaps(header dataplane.ConditionalResponseHeader) []shared.Map {
+	variable := "$" + generateConditionalResponseHeaderVariableName(header.ID)
+	value := `"` + header.Value + `"`
+
+	defaultParam := shared.MapParameter{Value: "default", Result: `""`}
+
+	if header.HeaderMatch == nil {
+		params := make([]shared.MapParameter, 0, len(header.StatusCodes)+1)
+		for _, code := range header.StatusCodes {
+			params = append(params, shared.MapParameter{Value: strconv.Itoa(code), Result: value})
+		}
+
+		return []shared.Map{
+			{
+				Source:     "$status",
+				Variable:   variable,
+				Parameters: append(params, defaultParam),
+			},
+		}
+	}
+
+	headerMatchValue := ""
+	if !header.HeaderMatch.Absent {
+		headerMatchValue = header.HeaderMatch.Value
+	}
+
+	headerSource := "$" + generateUpstreamHeaderVariableName(header.HeaderMatch.Name)
+
+	if len(header.StatusCodes) == 0 {
+		return []shared.Map{
+			{
+				Source:   headerSource,
+				Variable: variable,
+				Parameters: []shared.MapParameter{
+					{Value: escapeMapSourceValue(headerMatchValue), Result: value},
+					defaultParam,
+				},
+			},
+		}
+	}
+
+	// A response matches a condition with both a header match and status codes if it matches both of them,
+	// so the map of the header match evaluates to 1 if the header matches, and the map of the header is
+	// keyed by the status code and the result of the header match.
+	headerMatchVariable := variable + "_match"
+
+	params := make([]shared.MapParameter, 0, len(header.StatusCodes)+1)
+	for _, code := range header.StatusCodes {
+		params = append(params, shared.MapParameter{Value: `"` + strconv.Itoa(code) + `:1"`, Result: value})
+	}
+
+	return []shared.Map{
+		{
+			Source:   headerSource,
+			Variable: headerMatchVariable,
+			Parameters: []shared.MapParameter{
+				{Value: escapeMapSourceValue(headerMatchValue), Result: "1"},
+				{Value: "default", Result: "0"},
+			},
+		},
+		{
+			Source:     `"$status:` + headerMatchVariable + `"`,
+			Variable:   variable,
+			Parameters: append(params, defaultParam),
+		},
+	}
+}
+
+// escapeMapSourceValue escapes a source value of a map, so that NGINX matches it exactly.
+func escapeMapSourceValue(value string) string {
+	if _, special := mapSpecialParameters[value]; special || strings.HasPrefix(value, "~") {
+		value = `\` + value
+	}
+
+	return `"` + value + `"`
+}
+
+// createConditionalResponseHeaders creates the response headers that add the conditional response headers
+// of the ResponseHeaderFilters. The values of the headers are the variables of their maps.
+func createConditionalResponseHeaders(headers []dataplane.ConditionalResponseHeader) []http.Header {
+	result := make([]http.Header, 0, len(headers))
+
+	for _, h := range headers {
+		result = append(result, http.Header{
+			Name:  h.Name,
+			Value: "$" + generateConditionalResponseHeaderVariableName(h.ID),
+		})
+	}
+
+	return result
+}
+
+// requestPathVariable is the variable of the path of the original request URI, without the arguments.
+// Unlike $uri, it is not normalized, so that NGINX can pass it to the backend together with
+// the modified arguments in place of $request_uri.
+const requestPathVariable = "ngf_request_path"
+
+// queryParameterEscaper escapes the spaces of an escaped query parameter name or value as "%20" instead of "+",
+// because the queryparams njs module decodes them with decodeURIComponent.
+var queryParameterEscaper = strings.NewReplacer("+", "%20")
+
+// createQueryParameterModifications encodes the modifications of the QueryParameterFilters as a query string,
+// in which the name of each parameter is the operation and the name of the query parameter separated by '.',
+// for example, "remove.utm_source=&set.version=v2&add.tag=a". The queryparams njs module applies the
+// modifications to the arguments of the request in the order of the encoded string.
+func createQueryParameterModifications(modifiers []dataplane.QueryParameterModifier) string {
+	var modifications []string
+
+	escape := func(s string) string {
+		return queryParameterEscaper.Replace(url.QueryEscape(s))
+	}
+
+	for _, m := range modifiers {
+		for _, name := range m.Remove {
+			modifications = append(modifications, "remove."+escape(name)+"=")
+		}
+
+		for _, p := range m.Set {
+			modifications = append(modifications, "set."+escape(p.Name)+"="+escape(p.Value))
+		}
+
+		for _, p := range m.Add {
+			modifications = append(modifications, "add."+escape(p.Name)+"="+escape(p.Value))
+		}
+	}
+
+	return strings.Join(modifications, "&")
+}
+
+// buildQueryParameterMaps builds the map of the request path variable if any rule of the servers
+// references a QueryParameterFilter.
+func buildQueryParameterMaps(servers []dataplane.VirtualServer) []shared.Map {
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if len(mr.Filters.QueryParameterModifiers) > 0 {
+					return []shared.Map{
+						{
+							Source:   "$request_uri",
+							Variable: "$" + requestPathVariable,
+							Parameters: []shared.MapParameter{
+								{
+									Value:  `"~^(?<ngf_request_path_capture>[^?]*)"`,
+									Result: "$ngf_request_path_capture",
+								},
+							},
+						},
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// buildABTestMaps builds the maps of the backend groups with an ABTest that split the requests:
+// the map of the key that splits the requests, the map of the Set-Cookie header that stores a new key,
+// and the map of the override header, if any.
+func buildABTestMaps(backendGroups []dataplane.BackendGroup) []shared.Map {
+	var maps []shared.Map
+
+	for _, group := range backendGroups {
+		if group.ABTest == nil || !backendGroupNeedsSplit(group) {
+			continue
+		}
+
+		maps = append(maps, createABTestMaps(convertStringToSafeVariableName(group.Name()), *group.ABTest)...)
+	}
+
+	return maps
+}
+
+func createABTestMaps(groupVariable string, abTest dataplane.ABTest) []shared.Map {
+	cookieVariable := "$cookie_" + abTest.CookieName
+
+	// A client without the cookie gets the request ID as its key, which the Set-Cookie header stores.
+	cookie := abTest.CookieName + "=$request_id; Path=" + abTest.CookiePath
+	if abTest.CookieMaxAge > 0 {
+		cookie += "; Max-Age=" + strconv.FormatInt(abTest.CookieMaxAge, 10)
+	}
+	cookie += "; HttpOnly"
+
+	maps := []shared.Map{
+		{
+			Source:   cookieVariable,
+			Variable: "$" + generateABTestKeyVariableName(groupVariable),
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: cookieVariable},
+			},
+		},
+		{
+			Source:   cookieVariable,
+			Variable: "$" + generateABTestCookieVariableName(groupVariable),
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: `"` + cookie + `"`},
+				{Value: "default", Result: `""`},
+			},
+		},
+	}
+
+	if abTest.OverrideHeader == "" {
+		return maps
+	}
+
+	params := make([]shared.MapParameter, 0, len(abTest.Variants)+1)
+	for _, v := range abTest.Variants {
+		params = append(params, shared.MapParameter{Value: escapeMapSourceValue(v.ServiceName), Result: v.UpstreamName})
+	}
+
+	params = append(params, shared.MapParameter{
+		Value:  "default",
+		Result: "$" + generateABTestSplitVariableName(groupVariable),
+	})
+
+	return append(maps, shared.Map{
+		Source:     "$" + generateRequestHeaderVariableName(abTest.OverrideHeader),
+		Variable:   "$" + groupVariable,
+		Parameters: params,
+	})
+}
+
+// createABTestResponseHeaders creates the Set-Cookie response header that stores a new key of the backend group
+// with an ABTest. NGINX doesn't add the header if its value is empty, so it is only sent to the new clients.
+func createABTestResponseHeaders(group dataplane.BackendGroup) []http.Header {
+	if group.ABTest == nil || !backendGroupNeedsSplit(group) {
+		return nil
+	}
+
+	return []http.Header{
+		{
+			Name:  "Set-Cookie",
+			Value: "$" + generateABTestCookieVariableName(convertStringToSafeVariableName(group.Name())),
+		},
+	}
+}
@@ -0,0 +1,10 @@
+package config
+
+const extensionRefFiltersTemplateText = `
+{{- range $z := .RateLimitZones }}
+limit_req_zone {{ $z.Key }} zone={{ $z.Name }}:{{ $z.Size }} rate={{ $z.Rate }};
+{{- end }}
+{{- range $i := .Includes }}
+include {{ $i.Name }};
+{{- end }}
+`
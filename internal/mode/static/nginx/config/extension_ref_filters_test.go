@@ -0,0 +1,644 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var (
+	testHTTPSnippet = &dataplane.Snippet{
+		Name:     "SnippetsFilter_http_test_snippets",
+		Contents: "log_format custom '$request';",
+	}
+	testServerSnippet = &dataplane.Snippet{
+		Name:     "SnippetsFilter_server_test_snippets",
+		Contents: "add_header X-Server on;",
+	}
+	testLocationSnippet = &dataplane.Snippet{
+		Name:     "SnippetsFilter_location_test_snippets",
+		Contents: "add_header X-Location on;",
+	}
+	testRateLimit = &dataplane.RateLimit{
+		Zone: dataplane.RateLimitZone{
+			Name: "ratelimit_test_rate-limit",
+			Key:  "$binary_remote_addr",
+			Size: "10m",
+			Rate: "10r/s",
+		},
+		RejectCode: 429,
+		Burst:      5,
+		NoDelay:    true,
+	}
+	testExtensionRefFilters = dataplane.HTTPFilters{
+		RateLimit: testRateLimit,
+		SnippetsFilters: []dataplane.SnippetsFilter{
+			{
+				HTTPSnippet:     testHTTPSnippet,
+				ServerSnippet:   testServerSnippet,
+				LocationSnippet: testLocationSnippet,
+			},
+		},
+	}
+)
+
+func TestExecuteExtensionRefFilters(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{
+					{
+						MatchRules: []dataplane.MatchRule{
+							{Filters: testExtensionRefFilters},
+							{Filters: testExtensionRefFilters},
+						},
+					},
+				},
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{
+					{
+						MatchRules: []dataplane.MatchRule{
+							{Filters: testExtensionRefFilters},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	results := executeExtensionRefFilters(conf)
+	g.Expect(results).To(HaveLen(2))
+
+	g.Expect(results[0].dest).To(Equal(httpConfigFile))
+	httpConf := string(results[0].data)
+	g.Expect(strings.Count(
+		httpConf,
+		"limit_req_zone $binary_remote_addr zone=ratelimit_test_rate-limit:10m rate=10r/s;",
+	)).To(Equal(1))
+	g.Expect(strings.Count(
+		httpConf,
+		"include /etc/nginx/includes/SnippetsFilter_http_test_snippets.conf;",
+	)).To(Equal(1))
+
+	g.Expect(results[1].dest).To(Equal("/etc/nginx/includes/SnippetsFilter_http_test_snippets.conf"))
+	g.Expect(string(results[1].data)).To(Equal(testHTTPSnippet.Contents))
+}
+
+func TestExecuteExtensionRefFilters_Empty(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	results := executeExtensionRefFilters(dataplane.Configuration{})
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(strings.TrimSpace(string(results[0].data))).To(BeEmpty())
+}
+
+func TestCreateServerSnippetIncludes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pathRules := []dataplane.PathRule{
+		{
+			MatchRules: []dataplane.MatchRule{
+				{Filters: testExtensionRefFilters},
+				{},
+			},
+		},
+		{
+			MatchRules: []dataplane.MatchRule{
+				{Filters: testExtensionRefFilters},
+			},
+		},
+	}
+
+	g.Expect(createServerSnippetIncludes(pathRules)).To(Equal([]http.Include{
+		{
+			Name:    "/etc/nginx/includes/SnippetsFilter_server_test_snippets.conf",
+			Content: []byte(testServerSnippet.Contents),
+		},
+	}))
+}
+
+func TestCreateLocationsExtensionRefFilters(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	redirectFilters := testExtensionRefFilters
+	redirectFilters.RequestRedirect = &dataplane.HTTPRequestRedirectFilter{}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/filters",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{Filters: testExtensionRefFilters},
+			},
+		},
+		{
+			Path:     "/redirect",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{Filters: redirectFilters},
+			},
+		},
+	}
+
+	locs, _, _ := createLocations(
+		&dataplane.VirtualServer{PathRules: pathRules, Port: 80},
+		"1",
+		&policiesfakes.FakeGenerator{},
+		workerPool{},
+		nil,
+		false,
+		false,
+	)
+
+	locsByPath := make(map[string]http.Location, len(locs))
+	for _, loc := range locs {
+		locsByPath[loc.Path] = loc
+	}
+
+	filtersLoc := locsByPath["= /filters"]
+	g.Expect(filtersLoc.Includes).To(Equal([]http.Include{
+		{
+			Name:    "/etc/nginx/includes/SnippetsFilter_location_test_snippets.conf",
+			Content: []byte(testLocationSnippet.Contents),
+		},
+	}))
+	g.Expect(filtersLoc.RateLimit).To(Equal(&http.RateLimit{
+		Zone:       "ratelimit_test_rate-limit",
+		RejectCode: 429,
+		Burst:      5,
+		NoDelay:    true,
+	}))
+
+	// the redirect returns before the extensionRef filters apply
+	redirectLoc := locsByPath["= /redirect"]
+	g.Expect(redirectLoc.Includes).To(BeEmpty())
+	g.Expect(redirectLoc.RateLimit).To(BeNil())
+}
+
+func TestExecuteServers_ExtensionRefFilters(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{Filters: testExtensionRefFilters},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{})
+
+	includes := make(map[string]string)
+	var serverConf string
+	for _, res := range results {
+		if res.dest == httpConfigFile {
+			serverConf = string(res.data)
+			continue
+		}
+		includes[res.dest] = string(res.data)
+	}
+
+	g.Expect(serverConf).To(ContainSubstring(
+		"include /etc/nginx/includes/SnippetsFilter_server_test_snippets.conf;",
+	))
+	g.Expect(serverConf).To(ContainSubstring(
+		"include /etc/nginx/includes/SnippetsFilter_location_test_snippets.conf;",
+	))
+	g.Expect(serverConf).To(ContainSubstring("limit_req zone=ratelimit_test_rate-limit burst=5 nodelay;"))
+	g.Expect(serverConf).To(ContainSubstring("limit_req_status 429;"))
+
+	g.Expect(includes).To(HaveKeyWithValue(
+		"/etc/nginx/includes/SnippetsFilter_server_test_snippets.conf",
+		testServerSnippet.Contents,
+	))
+	g.Expect(includes).To(HaveKeyWithValue(
+		"/etc/nginx/includes/SnippetsFilter_location_test_snippets.conf",
+		testLocationSnippet.Contents,
+	))
+}
+
+func TestCreateConditionalResponseHeaderMaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   dataplane.ConditionalResponseHeader
+		expected []shared.Map
+	}{
+		{
+			name: "status codes",
+			header: dataplane.ConditionalResponseHeader{
+				ID:          "test_response_0",
+				Name:        "Cache-Control",
+				Value:       "max-age=60",
+				StatusCodes: []int{200, 204},
+			},
+			expected: []shared.Map{
+				{
+					Source:   "$status",
+					Variable: "$response_header_test_response_0",
+					Parameters: []shared.MapParameter{
+						{Value: "200", Result: `"max-age=60"`},
+						{Value: "204", Result: `"max-age=60"`},
+						{Value: "default", Result: `""`},
+					},
+				},
+			},
+		},
+		{
+			name: "absent header",
+			header: dataplane.ConditionalResponseHeader{
+				ID:          "test_response_1",
+				Name:        "Cache-Control",
+				Value:       "no-store",
+				HeaderMatch: &dataplane.ResponseHeaderMatch{Name: "Cache-Control", Absent: true},
+			},
+			expected: []shared.Map{
+				{
+					Source:   "$upstream_http_cache_control",
+					Variable: "$response_header_test_response_1",
+					Parameters: []shared.MapParameter{
+						{Value: `""`, Result: `"no-store"`},
+						{Value: "default", Result: `""`},
+					},
+				},
+			},
+		},
+		{
+			name: "exact header with special value",
+			header: dataplane.ConditionalResponseHeader{
+				ID:          "test_response_2",
+				Name:        "X-Special",
+				Value:       "true",
+				HeaderMatch: &dataplane.ResponseHeaderMatch{Name: "X-Upstream", Value: "~default"},
+			},
+			expected: []shared.Map{
+				{
+					Source:   "$upstream_http_x_upstream",
+					Variable: "$response_header_test_response_2",
+					Parameters: []shared.MapParameter{
+						{Value: `"\~default"`, Result: `"true"`},
+						{Value: "default", Result: `""`},
+					},
+				},
+			},
+		},
+		{
+			name: "status codes and header",
+			header: dataplane.ConditionalResponseHeader{
+				ID:          "test_response_3",
+				Name:        "X-Json",
+				Value:       "true",
+				StatusCodes: []int{200},
+				HeaderMatch: &dataplane.ResponseHeaderMatch{Name: "Content-Type", Value: "application/json"},
+			},
+			expected: []shared.Map{
+				{
+					Source:   "$upstream_http_content_type",
+					Variable: "$response_header_test_response_3_match",
+					Parameters: []shared.MapParameter{
+						{Value: `"application/json"`, Result: "1"},
+						{Value: "default", Result: "0"},
+					},
+				},
+				{
+					Source:   `"$status:$response_header_test_response_3_match"`,
+					Variable: "$response_header_test_response_3",
+					Parameters: []shared.MapParameter{
+						{Value: `"200:1"`, Result: `"true"`},
+						{Value: "default", Result: `""`},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createConditionalResponseHeaderMaps(test.header)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestExecuteMaps_ConditionalResponseHeaders(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	filters := dataplane.HTTPFilters{
+		ConditionalResponseHeaders: []dataplane.ConditionalResponseHeader{
+			{
+				ID:          "test_response_0",
+				Name:        "Cache-Control",
+				Value:       "max-age=60",
+				StatusCodes: []int{200},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{Filters: filters},
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{{PathRules: pathRules}},
+		SSLServers:  []dataplane.VirtualServer{{PathRules: pathRules}},
+	}
+
+	results := executeMaps(conf)
+	g.Expect(results).To(HaveLen(1))
+
+	mapsConf := string(results[0].data)
+	g.Expect(strings.Count(mapsConf, "map $status $response_header_test_response_0 {")).To(Equal(1))
+	g.Expect(mapsConf).To(ContainSubstring(`200 "max-age=60";`))
+
+	locs, _, _ := createLocations(
+		&dataplane.VirtualServer{PathRules: pathRules, Port: 80},
+		"1",
+		&policiesfakes.FakeGenerator{},
+		workerPool{},
+		nil,
+		false,
+		false,
+	)
+	g.Expect(locs).ToNot(BeEmpty())
+	for _, loc := range locs {
+		g.Expect(loc.ResponseHeaders.Add).To(ConsistOf(http.Header{
+			Name:  "Cache-Control",
+			Value: "$response_header_test_response_0",
+		}))
+	}
+}
+
+func TestCreateQueryParameterModifications(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		expected  string
+		modifiers []dataplane.QueryParameterModifier
+	}{
+		{
+			name: "no modifiers",
+		},
+		{
+			name: "one modifier",
+			modifiers: []dataplane.QueryParameterModifier{
+				{
+					Set:    []dataplane.QueryParameter{{Name: "version", Value: "v2"}},
+					Add:    []dataplane.QueryParameter{{Name: "tags[]", Value: "a b&c=d"}},
+					Remove: []string{"utm_source"},
+				},
+			},
+			expected: "remove.utm_source=&set.version=v2&add.tags%5B%5D=a%20b%26c%3Dd",
+		},
+		{
+			name: "multiple modifiers",
+			modifiers: []dataplane.QueryParameterModifier{
+				{Set: []dataplane.QueryParameter{{Name: "version", Value: "v2"}}},
+				{
+					Add:    []dataplane.QueryParameter{{Name: "debug", Value: ""}},
+					Remove: []string{"version"},
+				},
+			},
+			expected: "set.version=v2&remove.version=&add.debug=",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createQueryParameterModifications(test.modifiers)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestExecuteQueryParameterModifications(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	filters := dataplane.HTTPFilters{
+		QueryParameterModifiers: []dataplane.QueryParameterModifier{
+			{Set: []dataplane.QueryParameter{{Name: "version", Value: "v2"}}},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Filters: filters,
+								BackendGroup: dataplane.BackendGroup{
+									Backends: []dataplane.Backend{
+										{UpstreamName: "test_foo_80", Valid: true, Weight: 1},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	mapsResults := executeMaps(conf)
+	g.Expect(mapsResults).To(HaveLen(1))
+	g.Expect(string(mapsResults[0].data)).To(ContainSubstring(
+		`map $request_uri $ngf_request_path {` + "\n\t\n\t" +
+			`"~^(?<ngf_request_path_capture>[^?]*)" $ngf_request_path_capture;`,
+	))
+
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+
+	g.Expect(serverConf).To(ContainSubstring(`set $ngf_query_parameter_modifications "set.version=v2";`))
+	g.Expect(serverConf).To(ContainSubstring("set $args $ngf_modified_args;"))
+	g.Expect(serverConf).To(ContainSubstring("proxy_pass http://test_foo_80$ngf_request_path$is_args$args;"))
+}
+
+func TestCreateABTestMaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected []shared.Map
+		abTest   dataplane.ABTest
+	}{
+		{
+			name: "session cookie",
+			abTest: dataplane.ABTest{
+				CookieName: "variant",
+				CookiePath: "/",
+			},
+			expected: []shared.Map{
+				{
+					Source:   "$cookie_variant",
+					Variable: "$ngf_abtest_key_group",
+					Parameters: []shared.MapParameter{
+						{Value: `""`, Result: "$request_id"},
+						{Value: "default", Result: "$cookie_variant"},
+					},
+				},
+				{
+					Source:   "$cookie_variant",
+					Variable: "$ngf_abtest_cookie_group",
+					Parameters: []shared.MapParameter{
+						{Value: `""`, Result: `"variant=$request_id; Path=/; HttpOnly"`},
+						{Value: "default", Result: `""`},
+					},
+				},
+			},
+		},
+		{
+			name: "max age and override header",
+			abTest: dataplane.ABTest{
+				CookieName:     "variant",
+				CookiePath:     "/coffee",
+				CookieMaxAge:   86400,
+				OverrideHeader: "X-Variant",
+				Variants: []dataplane.ABTestVariant{
+					{ServiceName: "coffee-v1", UpstreamName: "test_coffee-v1_80"},
+					{ServiceName: "default", UpstreamName: "test_default_80"},
+				},
+			},
+			expected: []shared.Map{
+				{
+					Source:   "$cookie_variant",
+					Variable: "$ngf_abtest_key_group",
+					Parameters: []shared.MapParameter{
+						{Value: `""`, Result: "$request_id"},
+						{Value: "default", Result: "$cookie_variant"},
+					},
+				},
+				{
+					Source:   "$cookie_variant",
+					Variable: "$ngf_abtest_cookie_group",
+					Parameters: []shared.MapParameter{
+						{Value: `""`, Result: `"variant=$request_id; Path=/coffee; Max-Age=86400; HttpOnly"`},
+						{Value: "default", Result: `""`},
+					},
+				},
+				{
+					Source:   "$http_x_variant",
+					Variable: "$group",
+					Parameters: []shared.MapParameter{
+						{Value: `"coffee-v1"`, Result: "test_coffee-v1_80"},
+						{Value: `"\default"`, Result: "test_default_80"},
+						{Value: "default", Result: "$ngf_abtest_split_group"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createABTestMaps("group", test.abTest)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestExecuteABTest(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	backendGroup := dataplane.BackendGroup{
+		ABTest: &dataplane.ABTest{
+			CookieName:     "variant",
+			CookiePath:     "/",
+			OverrideHeader: "X-Variant",
+			Variants: []dataplane.ABTestVariant{
+				{ServiceName: "coffee-v1", UpstreamName: "test_coffee-v1_80"},
+				{ServiceName: "coffee-v2", UpstreamName: "test_coffee-v2_80"},
+			},
+		},
+		Source: types.NamespacedName{Namespace: "test", Name: "coffee"},
+		Backends: []dataplane.Backend{
+			{UpstreamName: "test_coffee-v1_80", Valid: true, Weight: 1},
+			{UpstreamName: "test_coffee-v2_80", Valid: true, Weight: 1},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{{BackendGroup: backendGroup}},
+					},
+				},
+			},
+		},
+		BackendGroups: []dataplane.BackendGroup{backendGroup},
+	}
+
+	mapsConf := string(executeMaps(conf)[0].data)
+	g.Expect(mapsConf).To(ContainSubstring("map $cookie_variant $ngf_abtest_key_test__coffee_rule0 {"))
+	g.Expect(mapsConf).To(ContainSubstring("map $cookie_variant $ngf_abtest_cookie_test__coffee_rule0 {"))
+	g.Expect(mapsConf).To(ContainSubstring("map $http_x_variant $test__coffee_rule0 {"))
+
+	splitClientsConf := string(executeSplitClients(conf)[0].data)
+	g.Expect(splitClientsConf).To(ContainSubstring(
+		"split_clients $ngf_abtest_key_test__coffee_rule0 $ngf_abtest_split_test__coffee_rule0 {",
+	))
+
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+
+	g.Expect(serverConf).To(ContainSubstring(
+		`add_header Set-Cookie "$ngf_abtest_cookie_test__coffee_rule0" always;`,
+	))
+	g.Expect(serverConf).To(ContainSubstring("proxy_pass http://$test__coffee_rule0$request_uri;"))
+}
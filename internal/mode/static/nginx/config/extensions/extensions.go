@@ -0,0 +1,113 @@
+// Package extensions contains the registry of the extensions of the NGINX configuration generator.
+//
+// An extension adds directives to the generated server, location, and upstream blocks, so that a distribution of
+// NGINX Gateway Fabric can configure NGINX features that NGINX Gateway Fabric doesn't support without changing the
+// generator. An extension registers itself in the init function of its package, which is imported by the main
+// package of the distribution:
+//
+//	func init() {
+//		extensions.Register("my-extension", myGenerator{})
+//	}
+//
+// The generator includes the files generated by the extensions in the blocks, in the order of the names of the
+// extensions. The directives in the files must be valid in the blocks, otherwise NGINX fails to reload.
+package extensions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+)
+
+// Generator generates the NGINX configuration of an extension.
+// The generated files are included in the blocks, so they can only contain the directives valid in the blocks.
+// The methods are called concurrently for different blocks, so they must be safe for concurrent use.
+type Generator interface {
+	// GenerateForServer generates the configuration for an HTTP server block.
+	GenerateForServer(server http.Server) []File
+	// GenerateForLocation generates the configuration for an HTTP location block, both the normal and
+	// the internal ones.
+	GenerateForLocation(location http.Location) []File
+	// GenerateForUpstream generates the configuration for an HTTP upstream block.
+	GenerateForUpstream(upstream http.Upstream) []File
+}
+
+// File is the contents of a generated file. Name must be unique among the files of the extension.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// UnimplementedGenerator can be inherited by any extension generator that doesn't need to implement all of
+// the generations, in order to satisfy the Generator interface.
+type UnimplementedGenerator struct{}
+
+func (u UnimplementedGenerator) GenerateForServer(_ http.Server) []File {
+	return nil
+}
+
+func (u UnimplementedGenerator) GenerateForLocation(_ http.Location) []File {
+	return nil
+}
+
+func (u UnimplementedGenerator) GenerateForUpstream(_ http.Upstream) []File {
+	return nil
+}
+
+// Extension is a registered extension.
+type Extension struct {
+	Generator Generator
+	Name      string
+}
+
+// nameRegexp validates the names of the extensions, which are used in the names of the generated files.
+var nameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+var (
+	registry     = make(map[string]Generator)
+	registryLock sync.RWMutex
+)
+
+// Register registers the generator of an extension with the name.
+// The name must consist of lower case alphanumeric characters or '-', and start and end with an alphanumeric
+// character. Register panics if the name is invalid, the generator is nil, or an extension with the name is
+// already registered.
+func Register(name string, generator Generator) {
+	if !nameRegexp.MatchString(name) {
+		panic(fmt.Sprintf("invalid extension name %q: must match %s", name, nameRegexp))
+	}
+
+	if generator == nil {
+		panic(fmt.Sprintf("generator of extension %q is nil", name))
+	}
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("extension %q is already registered", name))
+	}
+
+	registry[name] = generator
+}
+
+// Registered returns the registered extensions sorted by name, so that the generated configuration is stable.
+func Registered() []Extension {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	registered := make([]Extension, 0, len(registry))
+	for name, generator := range registry {
+		registered = append(registered, Extension{Name: name, Generator: generator})
+	}
+
+	slices.SortFunc(registered, func(a, b Extension) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return registered
+}
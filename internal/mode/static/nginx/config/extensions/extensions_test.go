@@ -0,0 +1,84 @@
+package extensions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+// The tests aren't parallel, because they modify the registry.
+
+func resetRegistry(t *testing.T) {
+	t.Helper()
+
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	registry = make(map[string]Generator)
+}
+
+func TestRegister(t *testing.T) {
+	resetRegistry(t)
+	t.Cleanup(func() { resetRegistry(t) })
+	g := NewWithT(t)
+
+	g.Expect(Registered()).To(BeEmpty())
+
+	g.Expect(func() { Register("second", UnimplementedGenerator{}) }).ToNot(Panic())
+	g.Expect(func() { Register("first-1", UnimplementedGenerator{}) }).ToNot(Panic())
+
+	g.Expect(Registered()).To(Equal([]Extension{
+		{Name: "first-1", Generator: UnimplementedGenerator{}},
+		{Name: "second", Generator: UnimplementedGenerator{}},
+	}))
+}
+
+func TestRegisterPanics(t *testing.T) {
+	resetRegistry(t)
+	t.Cleanup(func() { resetRegistry(t) })
+
+	Register("existing", UnimplementedGenerator{})
+
+	tests := []struct {
+		generator Generator
+		name      string
+		extension string
+	}{
+		{
+			name:      "empty name",
+			extension: "",
+			generator: UnimplementedGenerator{},
+		},
+		{
+			name:      "invalid name",
+			extension: "My_Extension",
+			generator: UnimplementedGenerator{},
+		},
+		{
+			name:      "name ends with a dash",
+			extension: "extension-",
+			generator: UnimplementedGenerator{},
+		},
+		{
+			name:      "nil generator",
+			extension: "nil",
+			generator: nil,
+		},
+		{
+			name:      "duplicate name",
+			extension: "existing",
+			generator: UnimplementedGenerator{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(func() { Register(test.extension, test.generator) }).To(Panic())
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(Registered()).To(HaveLen(1))
+}
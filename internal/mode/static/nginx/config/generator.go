@@ -1,11 +1,25 @@
 package config
 
 import (
+	"fmt"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
 
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/extensions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/botmitigation"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/clientsettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/faultinjection"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/geoip"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/modsecurity"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/observability"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/requestheaders"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/securelink"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/staticcontent"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/waf"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
@@ -23,9 +37,13 @@ const (
 	// streamFolder is the folder where NGINX Stream configuration files are stored.
 	streamFolder = configFolder + "/stream-conf.d"
 
-	// modulesIncludesFolder is the folder where the included "load_module" file is stored.
+	// modulesIncludesFolder is the folder where the included files with the main context directives are stored,
+	// such as the "load_module" file.
 	modulesIncludesFolder = configFolder + "/module-includes"
 
+	// eventsIncludesFolder is the folder where the included file with the events context directives is stored.
+	eventsIncludesFolder = configFolder + "/events-includes"
+
 	// secretsFolder is the folder where secrets (like TLS certs/keys) are stored.
 	secretsFolder = configFolder + "/secrets"
 
@@ -38,6 +56,12 @@ const (
 	// streamConfigFile is the path to the configuration file with Stream configuration.
 	streamConfigFile = streamFolder + "/stream.conf"
 
+	// httpUpstreamsFile is the path to the configuration file with the HTTP upstreams.
+	httpUpstreamsFile = httpFolder + "/upstreams.conf"
+
+	// streamUpstreamsFile is the path to the configuration file with the Stream upstreams.
+	streamUpstreamsFile = streamFolder + "/upstreams.conf"
+
 	// configVersionFile is the path to the config version configuration file.
 	configVersionFile = httpFolder + "/config-version.conf"
 
@@ -46,17 +70,44 @@ const (
 
 	// loadModulesFile is the path to the file containing any load_module directives.
 	loadModulesFile = modulesIncludesFolder + "/load-modules.conf"
+
+	// eventsFile is the path to the file containing the events context directives.
+	eventsFile = eventsIncludesFolder + "/events.conf"
+
+	// errorLogFile is the path to the file containing the error_log directives.
+	errorLogFile = modulesIncludesFolder + "/error-log.conf"
+
+	// defaultErrorLogLevel is the default level of the NGINX error log.
+	defaultErrorLogLevel = "info"
+
+	// ErrorLogSocket is the unix socket where NGINX sends a copy of the messages of its error log at the warn
+	// level and above, so that the control plane detects the recurring errors.
+	ErrorLogSocket = "/var/run/nginx/nginx-error-log.sock"
+
+	// defaultWorkerConnections is the default maximum number of simultaneous connections of a worker process.
+	defaultWorkerConnections = 1024
 )
 
 // ConfigFolders is a list of folders where NGINX configuration files are stored.
 // Volumes here also need to be added to our crossplane ephemeral test container.
-var ConfigFolders = []string{httpFolder, secretsFolder, includesFolder, modulesIncludesFolder, streamFolder}
+var ConfigFolders = []string{
+	httpFolder,
+	secretsFolder,
+	includesFolder,
+	modulesIncludesFolder,
+	eventsIncludesFolder,
+	streamFolder,
+}
 
 // Generator generates NGINX configuration files.
 // This interface is used for testing purposes only.
 type Generator interface {
 	// Generate generates NGINX configuration files from internal representation.
 	Generate(configuration dataplane.Configuration) []file.File
+	// GenerateUpstreams generates only the NGINX configuration files of the upstreams from internal
+	// representation. The files of the rest of the configuration must be already generated by Generate for
+	// a configuration that differs only in the endpoints of the upstreams.
+	GenerateUpstreams(configuration dataplane.Configuration) []file.File
 }
 
 // GeneratorImpl is an implementation of Generator.
@@ -68,12 +119,56 @@ type Generator interface {
 // It also expects that the main NGINX configuration file nginx.conf is located in configFolder and nginx.conf
 // includes (https://nginx.org/en/docs/ngx_core_module.html#include) the files from httpFolder.
 type GeneratorImpl struct {
-	plus bool
+	extensions extensionsGenerator
+	// registeredPolicyGenerators are the generators of the Policies registered in the policies package.
+	registeredPolicyGenerators []policies.Generator
+	// templates are the templates that override the built-in templates of the sections of the configuration.
+	templates TemplateOverrides
+	// pool runs the generation of the sections, the servers, and the policies of the locations in parallel.
+	pool workerPool
+	// unprivilegedPortOffset is added to the privileged ports of the listeners. See listenPort.
+	unprivilegedPortOffset int32
+	plus                   bool
+	// recordListenerRequests is true if NGINX records the rejected requests of the listeners for the metrics.
+	recordListenerRequests bool
 }
 
-// NewGeneratorImpl creates a new GeneratorImpl.
+// NewGeneratorImpl creates a new GeneratorImpl. The GeneratorImpl generates the configuration of the extensions
+// registered in the extensions package and of the Policies registered in the policies package.
 func NewGeneratorImpl(plus bool) GeneratorImpl {
-	return GeneratorImpl{plus: plus}
+	registered := policies.Registered()
+	policyGenerators := make([]policies.Generator, 0, len(registered))
+	for _, reg := range registered {
+		policyGenerators = append(policyGenerators, reg.Generator)
+	}
+
+	return GeneratorImpl{
+		plus:                       plus,
+		extensions:                 newExtensionsGenerator(extensions.Registered()),
+		registeredPolicyGenerators: policyGenerators,
+		pool:                       newWorkerPool(runtime.GOMAXPROCS(0)),
+	}
+}
+
+// WithUnprivilegedPortOffset returns a copy of the GeneratorImpl that makes NGINX bind to the privileged ports
+// of the listeners plus the offset.
+func (g GeneratorImpl) WithUnprivilegedPortOffset(offset int32) GeneratorImpl {
+	g.unprivilegedPortOffset = offset
+	return g
+}
+
+// WithListenerRequestsRecording returns a copy of the GeneratorImpl that makes NGINX record the rejected requests
+// of the listeners for the metrics.
+func (g GeneratorImpl) WithListenerRequestsRecording() GeneratorImpl {
+	g.recordListenerRequests = true
+	return g
+}
+
+// WithTemplateOverrides returns a copy of the GeneratorImpl that generates the overridden sections of
+// the configuration with the override templates.
+func (g GeneratorImpl) WithTemplateOverrides(overrides TemplateOverrides) GeneratorImpl {
+	g.templates = overrides
+	return g
 }
 
 type executeResult struct {
@@ -91,28 +186,82 @@ type executeFunc func(configuration dataplane.Configuration) []executeResult
 func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
 	files := make([]file.File, 0, len(conf.SSLKeyPairs)+1 /* http config */)
 
-	for id, pair := range conf.SSLKeyPairs {
+	// The files are generated in a stable order, so that the generated config is stable.
+	for _, id := range sortedKeys(conf.SSLKeyPairs) {
+		pair := conf.SSLKeyPairs[id]
 		files = append(files, generatePEM(id, pair.Cert, pair.Key))
 	}
 
-	policyGenerator := policies.NewCompositeGenerator(
+	policyGenerators := []policies.Generator{
 		clientsettings.NewGenerator(),
-		observability.NewGenerator(conf.Telemetry),
-	)
+		observability.NewGenerator(conf.Telemetry, conf.Logging, g.recordListenerRequests),
+		proxysettings.NewGenerator(),
+		faultinjection.NewGenerator(),
+		waf.NewGenerator(includesFolder),
+		modsecurity.NewGenerator(),
+		botmitigation.NewGenerator(),
+		geoip.NewGenerator(),
+		requestheaders.NewGenerator(),
+		securelink.NewGenerator(secretsFolder),
+		staticcontent.NewGenerator(includesFolder),
+	}
+	policyGenerators = append(policyGenerators, g.registeredPolicyGenerators...)
+	// the extensions are last, so that they can build on the configuration of NGF
+	policyGenerators = append(policyGenerators, g.extensions)
+
+	policyGenerator := policies.NewCompositeGenerator(policyGenerators...)
 
 	files = append(files, g.generateHTTPConfig(conf, policyGenerator)...)
 
 	files = append(files, generateConfigVersion(conf.Version))
 
-	for id, bundle := range conf.CertBundles {
-		files = append(files, generateCertBundle(id, bundle))
+	for _, id := range sortedKeys(conf.CertBundles) {
+		files = append(files, generateCertBundle(id, conf.CertBundles[id]))
+	}
+
+	for _, id := range sortedKeys(conf.WAFBundles) {
+		files = append(files, generateWAFBundle(id, conf.WAFBundles[id]))
+	}
+
+	for _, id := range sortedKeys(conf.SecureLinkSecrets) {
+		files = append(files, generateSecureLinkSecret(id, conf.SecureLinkSecrets[id]))
+	}
+
+	for _, id := range sortedKeys(conf.StaticFiles) {
+		files = append(files, generateStaticFile(id, conf.StaticFiles[id]))
+	}
+
+	for i, key := range conf.SessionTicketKeys {
+		files = append(files, generateSessionTicketKey(i, key))
 	}
 
 	files = append(files, generateLoadModulesConf(conf))
 
+	files = append(files, generateEventsConf(conf))
+
+	files = append(files, generateErrorLogConf(conf))
+
 	return files
 }
 
+// GenerateUpstreams generates the NGINX configuration files of the HTTP and the Stream upstreams and the config
+// version file. It is used when only the endpoints of the upstreams change, so that the servers, the locations
+// and the policies are not generated again.
+func (g GeneratorImpl) GenerateUpstreams(conf dataplane.Configuration) []file.File {
+	results := slices.Concat(g.executeUpstreams(conf), g.executeStreamUpstreams(conf))
+
+	files := make([]file.File, 0, len(results)+1 /* config version */)
+	for _, res := range results {
+		files = append(files, file.File{
+			Path:    res.dest,
+			Content: res.data,
+			Type:    file.TypeRegular,
+		})
+	}
+
+	return append(files, generateConfigVersion(conf.Version))
+}
+
 func generatePEM(id dataplane.SSLKeyPairID, cert []byte, key []byte) file.File {
 	c := make([]byte, 0, len(cert)+len(key)+1)
 	c = append(c, cert...)
@@ -142,24 +291,73 @@ func generateCertBundleFileName(id dataplane.CertBundleID) string {
 	return filepath.Join(secretsFolder, string(id)+".crt")
 }
 
+// generateWAFBundle writes the policy bundle of a WAFPolicy to the includes folder, which is shared with the
+// NGINX App Protect enforcer.
+func generateWAFBundle(id dataplane.WAFBundleID, bundle []byte) file.File {
+	return file.File{
+		Content: bundle,
+		Path:    waf.BundlePath(includesFolder, id),
+		Type:    file.TypeRegular,
+	}
+}
+
+// generateSecureLinkSecret writes the file that sets the secret of a SecureLinkPolicy, so that the secret
+// is not written to the config of the policy.
+func generateSecureLinkSecret(id dataplane.SecureLinkSecretID, secret dataplane.SecureLinkSecret) file.File {
+	return file.File{
+		Content: securelink.SecretFileContent(secret),
+		Path:    securelink.SecretPath(secretsFolder, id),
+		Type:    file.TypeSecret,
+	}
+}
+
+// generateStaticFile writes a file of a StaticContentPolicy to the includes folder, from which NGINX serves it.
+func generateStaticFile(id dataplane.StaticFileID, content []byte) file.File {
+	return file.File{
+		Content: content,
+		Path:    staticcontent.FilePath(includesFolder, id),
+		Type:    file.TypeRegular,
+	}
+}
+
+// generateSessionTicketKey writes a TLS session ticket key. The files are named by the position of the keys,
+// because the order of the keys matters: NGINX encrypts the tickets with the first key.
+func generateSessionTicketKey(idx int, key dataplane.SessionTicketKey) file.File {
+	return file.File{
+		Content: key,
+		Path:    generateSessionTicketKeyFileName(idx),
+		Type:    file.TypeSecret,
+	}
+}
+
+func generateSessionTicketKeyFileName(idx int) string {
+	return filepath.Join(secretsFolder, fmt.Sprintf("session_ticket_key_%d.key", idx))
+}
+
 func (g GeneratorImpl) generateHTTPConfig(
 	conf dataplane.Configuration,
 	generator policies.Generator,
 ) []file.File {
-	fileBytes := make(map[string][]byte)
-
-	for _, execute := range g.getExecuteFuncs(generator) {
-		results := execute(conf)
-		for _, res := range results {
-			fileBytes[res.dest] = append(fileBytes[res.dest], res.data...)
-		}
+	executeFuncs := g.getExecuteFuncs(generator)
+
+	// The sections are generated in parallel, because generating the servers, the upstreams and the split clients
+	// of large configurations takes the most time. The results are combined in the order of the functions,
+	// so that the generated config is stable. A panic of a function, for example, of a template or of a policy
+	// generator, is raised on this goroutine, so that the caller of Generate can recover from it.
+	results := runInPool(g.pool, executeFuncs, func(_ int, execute executeFunc) []executeResult {
+		return execute(conf)
+	})
+
+	fileResults := make(map[string][]executeResult)
+	for _, res := range slices.Concat(results...) {
+		fileResults[res.dest] = append(fileResults[res.dest], res)
 	}
 
-	files := make([]file.File, 0, len(fileBytes))
-	for fp, bytes := range fileBytes {
+	files := make([]file.File, 0, len(fileResults))
+	for _, fp := range sortedKeys(fileResults) {
 		files = append(files, file.File{
 			Path:    fp,
-			Content: bytes,
+			Content: concatResults(fileResults[fp]),
 			Type:    file.TypeRegular,
 		})
 	}
@@ -167,13 +365,47 @@ func (g GeneratorImpl) generateHTTPConfig(
 	return files
 }
 
+// concatResults returns the data of the results of a file. The data of a single result is returned as is,
+// so that the large sections, like the servers, are not copied.
+func concatResults(results []executeResult) []byte {
+	if len(results) == 1 {
+		return results[0].data
+	}
+
+	var size int
+	for _, res := range results {
+		size += len(res.data)
+	}
+
+	data := make([]byte, 0, size)
+	for _, res := range results {
+		data = append(data, res.data...)
+	}
+
+	return data
+}
+
+// sortedKeys returns the keys of the map in ascending order.
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
 func (g GeneratorImpl) getExecuteFuncs(generator policies.Generator) []executeFunc {
 	return []executeFunc{
-		executeBaseHTTPConfig,
+		g.executeBaseHTTPConfig,
+		executeExtensionRefFilters,
 		g.newExecuteServersFunc(generator),
 		g.executeUpstreams,
 		executeSplitClients,
 		executeMaps,
+		executeBotMitigations,
 		executeTelemetry,
 		g.executeStreamServers,
 		g.executeStreamUpstreams,
@@ -193,14 +425,61 @@ func generateConfigVersion(configVersion int) file.File {
 }
 
 func generateLoadModulesConf(conf dataplane.Configuration) file.File {
-	var c []byte
+	var modules []string
 	if conf.Telemetry.Endpoint != "" {
-		c = []byte("load_module modules/ngx_otel_module.so;")
+		modules = append(modules, "load_module modules/ngx_otel_module.so;")
+	}
+
+	if len(conf.WAFBundles) > 0 {
+		modules = append(modules, "load_module modules/ngx_http_app_protect_module.so;")
+	}
+
+	if conf.ModSecurity {
+		modules = append(modules, "load_module modules/ngx_http_modsecurity_module.so;")
+	}
+
+	if conf.BaseHTTPConfig.GeoIP != nil {
+		modules = append(modules, "load_module modules/ngx_http_geoip2_module.so;")
 	}
 
 	return file.File{
-		Content: c,
+		Content: []byte(strings.Join(modules, "\n")),
 		Path:    loadModulesFile,
 		Type:    file.TypeRegular,
 	}
 }
+
+// generateErrorLogConf writes the file with the error_log directives. NGINX logs to stderr at the configured level,
+// and sends the messages at the warn level and above to the control plane.
+func generateErrorLogConf(conf dataplane.Configuration) file.File {
+	level := conf.Logging.ErrorLevel
+	if level == "" {
+		level = defaultErrorLogLevel
+	}
+
+	content := fmt.Sprintf(
+		"error_log stderr %s;\nerror_log syslog:server=unix:%s,nohostname warn;",
+		level,
+		ErrorLogSocket,
+	)
+
+	return file.File{
+		Content: []byte(content),
+		Path:    errorLogFile,
+		Type:    file.TypeRegular,
+	}
+}
+
+// generateEventsConf writes the file with the directives of the events context.
+func generateEventsConf(conf dataplane.Configuration) file.File {
+	workerConnections := conf.ConnectionLimits.WorkerConnections
+	if workerConnections == 0 {
+		workerConnections = defaultWorkerConnections
+	}
+
+	return file.File{
+		Content: []byte(fmt.Sprintf("worker_connections %d;", workerConnections)),
+		Path:    eventsFile,
+		Type:    file.TypeRegular,
+	}
+}
@@ -101,7 +101,7 @@ func TestGenerate(t *testing.T) {
 
 	files := generator.Generate(conf)
 
-	g.Expect(files).To(HaveLen(7))
+	g.Expect(files).To(HaveLen(11))
 	arrange := func(i, j int) bool {
 		return files[i].Path < files[j].Path
 	}
@@ -115,11 +115,10 @@ func TestGenerate(t *testing.T) {
 	g.Expect(files[1].Type).To(Equal(file.TypeRegular))
 	g.Expect(files[1].Path).To(Equal("/etc/nginx/conf.d/http.conf"))
 	httpCfg := string(files[1].Content) // converting to string so that on failure gomega prints strings not byte arrays
-	// Note: this only verifies that Generate() returns a byte array with upstream, server, and split_client blocks.
+	// Note: this only verifies that Generate() returns a byte array with server and split_client blocks.
 	// It does not test the correctness of those blocks. That functionality is covered by other tests in this package.
 	g.Expect(httpCfg).To(ContainSubstring("listen 80"))
 	g.Expect(httpCfg).To(ContainSubstring("listen unix:/var/run/nginx/https443.sock"))
-	g.Expect(httpCfg).To(ContainSubstring("upstream"))
 	g.Expect(httpCfg).To(ContainSubstring("split_clients"))
 
 	g.Expect(httpCfg).To(ContainSubstring("endpoint 1.2.3.4:123;"))
@@ -135,24 +134,473 @@ func TestGenerate(t *testing.T) {
 	expString := "{}"
 	g.Expect(string(files[2].Content)).To(Equal(expString))
 
-	g.Expect(files[3].Path).To(Equal("/etc/nginx/module-includes/load-modules.conf"))
-	g.Expect(files[3].Content).To(Equal([]byte("load_module modules/ngx_otel_module.so;")))
+	g.Expect(files[3].Path).To(Equal("/etc/nginx/conf.d/upstreams.conf"))
+	g.Expect(files[3].Type).To(Equal(file.TypeRegular))
+	g.Expect(string(files[3].Content)).To(ContainSubstring("upstream up"))
 
-	g.Expect(files[4].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
-	certBundle := string(files[4].Content)
+	g.Expect(files[4].Path).To(Equal("/etc/nginx/events-includes/events.conf"))
+	g.Expect(files[4].Content).To(Equal([]byte("worker_connections 1024;")))
+
+	g.Expect(files[5].Path).To(Equal("/etc/nginx/module-includes/error-log.conf"))
+	g.Expect(string(files[5].Content)).To(Equal(
+		"error_log stderr info;\nerror_log syslog:server=unix:/var/run/nginx/nginx-error-log.sock,nohostname warn;",
+	))
+
+	g.Expect(files[6].Path).To(Equal("/etc/nginx/module-includes/load-modules.conf"))
+	g.Expect(files[6].Content).To(Equal([]byte("load_module modules/ngx_otel_module.so;")))
+
+	g.Expect(files[7].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
+	certBundle := string(files[7].Content)
 	g.Expect(certBundle).To(Equal("test-cert"))
 
-	g.Expect(files[5]).To(Equal(file.File{
+	g.Expect(files[8]).To(Equal(file.File{
 		Type:    file.TypeSecret,
 		Path:    "/etc/nginx/secrets/test-keypair.pem",
 		Content: []byte("test-cert\ntest-key"),
 	}))
 
-	g.Expect(files[6].Path).To(Equal("/etc/nginx/stream-conf.d/stream.conf"))
-	g.Expect(files[6].Type).To(Equal(file.TypeRegular))
-	streamCfg := string(files[6].Content)
+	g.Expect(files[9].Path).To(Equal("/etc/nginx/stream-conf.d/stream.conf"))
+	g.Expect(files[9].Type).To(Equal(file.TypeRegular))
+	streamCfg := string(files[9].Content)
 	g.Expect(streamCfg).To(ContainSubstring("listen unix:/var/run/nginx/app.example.com-443.sock"))
 	g.Expect(streamCfg).To(ContainSubstring("listen 443"))
 	g.Expect(streamCfg).To(ContainSubstring("app.example.com unix:/var/run/nginx/app.example.com-443.sock"))
 	g.Expect(streamCfg).To(ContainSubstring("example.com unix:/var/run/nginx/https443.sock"))
+
+	g.Expect(files[10].Path).To(Equal("/etc/nginx/stream-conf.d/upstreams.conf"))
+	g.Expect(files[10].Type).To(Equal(file.TypeRegular))
+	g.Expect(string(files[10].Content)).To(ContainSubstring("upstream stream_up"))
+}
+
+func TestGenerateUpstreams(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		Version: 3,
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     80,
+			},
+		},
+		Upstreams: []dataplane.Upstream{
+			{
+				Name:      "up",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "stream_up",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 443}},
+			},
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.GenerateUpstreams(conf)
+
+	g.Expect(files).To(HaveLen(3))
+
+	g.Expect(files[0].Path).To(Equal("/etc/nginx/conf.d/upstreams.conf"))
+	g.Expect(files[0].Type).To(Equal(file.TypeRegular))
+	g.Expect(string(files[0].Content)).To(ContainSubstring("server 10.0.0.1:80;"))
+
+	g.Expect(files[1].Path).To(Equal("/etc/nginx/stream-conf.d/upstreams.conf"))
+	g.Expect(files[1].Type).To(Equal(file.TypeRegular))
+	g.Expect(string(files[1].Content)).To(ContainSubstring("server 10.0.0.2:443;"))
+
+	g.Expect(files[2].Path).To(Equal("/etc/nginx/conf.d/config-version.conf"))
+	g.Expect(string(files[2].Content)).To(ContainSubstring("return 200 3"))
+
+	// the upstream files are the same as the ones of the full configuration
+	all := generator.Generate(conf)
+	for _, f := range files {
+		g.Expect(all).To(ContainElement(f))
+	}
+}
+
+func TestGenerateIsStable(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	createServer := func(hostname string, port int32, addHeader string) dataplane.VirtualServer {
+		return dataplane.VirtualServer{
+			Hostname: hostname,
+			Port:     port,
+			PathRules: []dataplane.PathRule{
+				{
+					Path:     "/",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							BackendGroup: dataplane.BackendGroup{
+								Source:   types.NamespacedName{Namespace: "test", Name: hostname},
+								Backends: []dataplane.Backend{{UpstreamName: "up", Valid: true, Weight: 1}},
+							},
+							Filters: dataplane.HTTPFilters{
+								RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+									Add: []dataplane.HTTPHeader{{Name: addHeader, Value: "value"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	keyPairs := make(map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair)
+	certBundles := make(map[dataplane.CertBundleID]dataplane.CertBundle)
+	passthroughServers := make([]dataplane.Layer4VirtualServer, 0)
+
+	for i := range 10 {
+		keyPairs[dataplane.SSLKeyPairID(fmt.Sprintf("keypair-%d", i))] = dataplane.SSLKeyPair{
+			Cert: []byte("cert"),
+			Key:  []byte("key"),
+		}
+		certBundles[dataplane.CertBundleID(fmt.Sprintf("bundle-%d", i))] = []byte("bundle")
+		passthroughServers = append(passthroughServers, dataplane.Layer4VirtualServer{
+			Hostname:     fmt.Sprintf("app-%d.example.com", i),
+			Port:         int32(8443 + i),
+			UpstreamName: "stream_up",
+		})
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			createServer("bar.example.com", 80, "X-Bar"),
+			createServer("baz.example.com", 80, "X-Baz"),
+			createServer("foo.example.com", 80, "X-Foo"),
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 8443},
+			{IsDefault: true, Port: 8444},
+		},
+		TLSPassthroughServers: passthroughServers,
+		Upstreams: []dataplane.Upstream{
+			{
+				Name:      "up",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:      "stream_up",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 443}},
+			},
+		},
+		SSLKeyPairs: keyPairs,
+		CertBundles: certBundles,
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			HTTP2: true,
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	expected := generator.Generate(conf)
+
+	// the maps of the configuration are iterated in a random order, so the config is generated several times
+	for range 20 {
+		g.Expect(generator.Generate(conf)).To(Equal(expected))
+	}
+}
+
+func TestGenerate_WorkerConnections(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		ConnectionLimits: dataplane.ConnectionLimits{
+			WorkerConnections: 4096,
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElement(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/events-includes/events.conf",
+		Content: []byte("worker_connections 4096;"),
+	}))
+}
+
+func TestGenerate_ErrorLogLevel(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		Logging: dataplane.Logging{
+			ErrorLevel: "warn",
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElement(file.File{
+		Type: file.TypeRegular,
+		Path: "/etc/nginx/module-includes/error-log.conf",
+		Content: []byte(
+			"error_log stderr warn;\nerror_log syslog:server=unix:/var/run/nginx/nginx-error-log.sock,nohostname warn;",
+		),
+	}))
+}
+
+func TestGenerate_WAFBundles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		WAFBundles: map[dataplane.WAFBundleID]dataplane.WAFBundle{
+			"waf_bundle_test_policy": []byte("bundle"),
+		},
+	}
+
+	generator := config.NewGeneratorImpl(true)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElement(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/includes/waf_bundle_test_policy.tgz",
+		Content: []byte("bundle"),
+	}))
+	g.Expect(files).To(ContainElement(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/module-includes/load-modules.conf",
+		Content: []byte("load_module modules/ngx_http_app_protect_module.so;"),
+	}))
+}
+
+func TestGenerate_SecureLinkSecrets(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		SecureLinkSecrets: map[dataplane.SecureLinkSecretID]dataplane.SecureLinkSecret{
+			"secure_link_secret_test_policy": []byte("0123456789abcdef"),
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElement(file.File{
+		Type:    file.TypeSecret,
+		Path:    "/etc/nginx/secrets/secure_link_secret_test_policy.conf",
+		Content: []byte("set $ngf_secure_link_secret \"0123456789abcdef\";\n"),
+	}))
+}
+
+func TestGenerate_StaticFiles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		StaticFiles: map[dataplane.StaticFileID]dataplane.StaticFile{
+			"static_content_test_policy_security.txt": []byte("Contact: mailto:security@example.com"),
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElement(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/includes/static_content_test_policy_security.txt",
+		Content: []byte("Contact: mailto:security@example.com"),
+	}))
+}
+
+func TestGenerate_SessionTicketKeys(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		SessionTicketKeys: []dataplane.SessionTicketKey{[]byte("current"), []byte("next")},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElements(
+		file.File{
+			Type:    file.TypeSecret,
+			Path:    "/etc/nginx/secrets/session_ticket_key_0.key",
+			Content: []byte("current"),
+		},
+		file.File{
+			Type:    file.TypeSecret,
+			Path:    "/etc/nginx/secrets/session_ticket_key_1.key",
+			Content: []byte("next"),
+		},
+	))
+}
+
+func TestGenerate_ModSecurity(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		ModSecurity: true,
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElement(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/module-includes/load-modules.conf",
+		Content: []byte("load_module modules/ngx_http_modsecurity_module.so;"),
+	}))
+}
+
+func TestGenerate_GeoIP(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			GeoIP: &dataplane.GeoIP{DatabasePath: "/etc/nginx/geoip/GeoLite2-Country.mmdb"},
+		},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	g.Expect(files).To(ContainElement(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/module-includes/load-modules.conf",
+		Content: []byte("load_module modules/ngx_http_geoip2_module.so;"),
+	}))
+}
+
+// createLargeConfiguration returns a Configuration of a Gateway with the routeCount HTTPRoutes, each with
+// a hostname, a path rule with a header match, and the backendsPerRoute backends with an endpoint each.
+func createLargeConfiguration(routeCount, backendsPerRoute int) dataplane.Configuration {
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{{IsDefault: true, Port: 80}},
+		SSLServers:  []dataplane.VirtualServer{{IsDefault: true, Port: 443}},
+		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+			"test-keypair": {Cert: []byte("cert"), Key: []byte("key")},
+		},
+		Upstreams:     make([]dataplane.Upstream, 0, routeCount*backendsPerRoute),
+		BackendGroups: make([]dataplane.BackendGroup, 0, routeCount),
+	}
+
+	for r := range routeCount {
+		bg := dataplane.BackendGroup{
+			Source:   types.NamespacedName{Namespace: "test", Name: fmt.Sprintf("route-%d", r)},
+			Backends: make([]dataplane.Backend, 0, backendsPerRoute),
+		}
+
+		for b := range backendsPerRoute {
+			upstreamName := fmt.Sprintf("test_route-%d-backend-%d_80", r, b)
+
+			bg.Backends = append(bg.Backends, dataplane.Backend{UpstreamName: upstreamName, Valid: true, Weight: 1})
+			conf.Upstreams = append(conf.Upstreams, dataplane.Upstream{
+				Name: upstreamName,
+				Endpoints: []resolver.Endpoint{
+					{Address: fmt.Sprintf("10.%d.%d.%d", r/256, r%256, b), Port: 8080},
+				},
+			})
+		}
+
+		conf.BackendGroups = append(conf.BackendGroups, bg)
+
+		pathRules := []dataplane.PathRule{
+			{
+				Path:     "/",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Headers: []dataplane.HTTPHeaderMatch{{Name: "version", Value: "v1"}},
+						},
+						BackendGroup: bg,
+					},
+				},
+			},
+		}
+
+		hostname := fmt.Sprintf("route-%d.example.com", r)
+
+		conf.HTTPServers = append(conf.HTTPServers, dataplane.VirtualServer{
+			Hostname:  hostname,
+			PathRules: pathRules,
+			Port:      80,
+		})
+		conf.SSLServers = append(conf.SSLServers, dataplane.VirtualServer{
+			Hostname:  hostname,
+			PathRules: pathRules,
+			SSL:       &dataplane.SSL{KeyPairID: "test-keypair"},
+			Port:      443,
+		})
+	}
+
+	return conf
+}
+
+func TestGenerate_LargeConfiguration(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := createLargeConfiguration(1000, 5)
+	generator := config.NewGeneratorImpl(false)
+
+	files := generator.Generate(conf)
+
+	var httpConf, upstreamsConf string
+	for _, f := range files {
+		switch f.Path {
+		case "/etc/nginx/conf.d/http.conf":
+			httpConf = string(f.Content)
+		case "/etc/nginx/conf.d/upstreams.conf":
+			upstreamsConf = string(f.Content)
+		}
+	}
+
+	g.Expect(httpConf).To(ContainSubstring("server_name route-999.example.com;"))
+	g.Expect(upstreamsConf).To(ContainSubstring("upstream test_route-999-backend-4_80 {"))
+
+	// the configuration is generated in the same way on every call
+	g.Expect(generator.Generate(conf)).To(Equal(files))
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	sizes := []struct {
+		routes           int
+		backendsPerRoute int
+	}{
+		{routes: 10, backendsPerRoute: 5},
+		{routes: 100, backendsPerRoute: 5},
+		{routes: 1000, backendsPerRoute: 5},
+	}
+
+	generator := config.NewGeneratorImpl(false)
+
+	for _, size := range sizes {
+		conf := createLargeConfiguration(size.routes, size.backendsPerRoute)
+
+		b.Run(fmt.Sprintf("%d routes with %d backends", size.routes, size.routes*size.backendsPerRoute), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for range b.N {
+				generator.Generate(conf)
+			}
+		})
+	}
 }
@@ -9,15 +9,23 @@ const (
 
 // Server holds all configuration for an HTTP server.
 type Server struct {
-	SSL           *SSL
-	ServerName    string
-	Listen        string
-	Locations     []Location
-	Includes      []Include
-	IsDefaultHTTP bool
-	IsDefaultSSL  bool
-	GRPC          bool
-	IsSocket      bool
+	SSL         *SSL
+	Return      *Return
+	ServerName  string
+	DefaultType string
+	Listen      string
+	// FaultDelayLocation is the path of the internal location that delays the requests of the locations
+	// with a fault delay. It is empty if no location has a fault delay.
+	FaultDelayLocation string
+	// BotChallengeLocation is the path of the internal location that responds with the challenge page to the
+	// requests of bots of the locations with a bot challenge. It is empty if no location has a bot challenge.
+	BotChallengeLocation string
+	Locations            []Location
+	Includes             []Include
+	IsDefaultHTTP        bool
+	IsDefaultSSL         bool
+	GRPC                 bool
+	IsSocket             bool
 }
 
 type LocationType string
@@ -30,17 +38,59 @@ const (
 
 // Location holds all configuration for an HTTP location.
 type Location struct {
+	// QueryParameterModifications are the encoded modifications of the query parameters of the request,
+	// which the queryparams njs module applies.
+	QueryParameterModifications string
+
 	Path            string
 	ProxyPass       string
 	HTTPMatchKey    string
 	Type            LocationType
+	Route           string
 	ProxySetHeaders []Header
 	ProxySSLVerify  *ProxySSLVerify
 	Return          *Return
+	RateLimit       *RateLimit
 	ResponseHeaders ResponseHeaders
 	Rewrites        []string
 	Includes        []Include
 	GRPC            bool
+	// NoEndpoints is true if none of the backends that the location proxies to have ready endpoints.
+	NoEndpoints bool
+	// Hedging is the hedging of the requests of the location. If set, the location doesn't proxy the requests
+	// itself, but with subrequests to the internal location of the Hedging.
+	Hedging *Hedging
+	// HedgingProxy is true if the location proxies the subrequests of a location with Hedging.
+	HedgingProxy bool
+	// KubernetesContext is the Kubernetes context of the requests of the location, which the location sets
+	// in the variables of the Kubernetes access log format. It is nil if the format is not used.
+	KubernetesContext *KubernetesContext
+}
+
+// KubernetesContext holds the Kubernetes context of the requests of a location.
+type KubernetesContext struct {
+	RouteNamespace string
+	RouteName      string
+	// Upstream is the upstream that the location proxies the requests to, or the variable whose value is
+	// the upstream. It is empty if the location doesn't proxy the requests.
+	Upstream  string
+	RouteRule int
+}
+
+// Hedging holds the configuration of a location that hedges its idempotent requests.
+type Hedging struct {
+	// Location is the path of the internal location that proxies the subrequests.
+	Location string
+	// DelayMilliseconds is the time after which the request is sent again if it is not answered yet.
+	DelayMilliseconds int64
+}
+
+// RateLimit holds the configuration of the rate limit of a location.
+type RateLimit struct {
+	Zone       string
+	RejectCode int
+	Burst      int32
+	NoDelay    bool
 }
 
 // Header defines an HTTP header to be passed to the proxied server.
@@ -82,18 +132,69 @@ const (
 
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
-	Name     string
-	ZoneSize string // format: 512k, 1m
-	Servers  []UpstreamServer
+	// Queue is the queue of the requests to the upstream. It is only supported by NGINX Plus.
+	Queue *UpstreamQueue
+	// HealthCheck is the active health check of the servers of the upstream. It is only supported by NGINX Plus.
+	HealthCheck *UpstreamHealthCheck
+	// KeepAlive is the keep-alive connections to the servers of the upstream. Nil means disabled.
+	KeepAlive *UpstreamKeepAlive
+	// LoadBalancingMethod is the load balancing directive of the upstream, for example, "least_time header".
+	// Empty means the default "random two least_conn".
+	LoadBalancingMethod string
+	Name                string
+	ZoneSize            string // format: 512k, 1m
+	// StateFile is the file that stores the servers of the upstream. If set, Servers are ignored and
+	// the servers are managed through the NGINX Plus API.
+	StateFile string
+	Servers   []UpstreamServer
+	// Includes are the files included in the upstream, which are generated by the extensions.
+	Includes []Include
 }
 
 // UpstreamServer holds all configuration for an HTTP upstream server.
 type UpstreamServer struct {
 	Address string
+	// MaxConns is the maximum number of simultaneous active connections to the server. 0 means no limit.
+	MaxConns int32
+}
+
+// UpstreamQueue holds the configuration of the queue of an HTTP upstream.
+type UpstreamQueue struct {
+	Timeout string
+	Size    int32
+}
+
+// UpstreamHealthCheck holds the configuration of the active health checks of the servers of an HTTP upstream.
+type UpstreamHealthCheck struct {
+	// Location is the named location that sends the health checks.
+	Location string
+	// URI is the URI of the HTTP health checks. Empty means the NGINX default.
+	URI string
+	// GRPCService is the service of the gRPC health checks.
+	GRPCService string
+	Interval    string
+	// Fails and Passes are the numbers of consecutive failed and passed health checks after which a server is
+	// considered unhealthy and healthy. 0 means the NGINX default.
+	Fails  int32
+	Passes int32
+	// GRPC is true if the health checks use the gRPC health checking protocol.
+	GRPC bool
+}
+
+// UpstreamKeepAlive holds the configuration of the keep-alive connections to the servers of an HTTP upstream.
+type UpstreamKeepAlive struct {
+	Time    string
+	Timeout string
+	// Connections is the maximum number of idle keep-alive connections preserved in each worker process.
+	Connections int32
+	// Requests is the maximum number of requests through one connection. 0 means the NGINX default.
+	Requests int32
 }
 
 // SplitClient holds all configuration for an HTTP split client.
 type SplitClient struct {
+	// Key is the variable that splits the requests. Default is $request_id.
+	Key           string
 	VariableName  string
 	Distributions []SplitClientDistribution
 }
@@ -112,10 +213,21 @@ type ProxySSLVerify struct {
 
 // ServerConfig holds configuration for an HTTP server and IP family to be used by NGINX.
 type ServerConfig struct {
-	Servers         []Server
-	RewriteClientIP shared.RewriteClientIPSettings
-	IPFamily        shared.IPFamily
-	Plus            bool
+	// DefaultServerConnectionLimit limits the connections from each client IP address to the default HTTP servers.
+	// Nil if the connections are not limited.
+	DefaultServerConnectionLimit *ConnectionLimit
+	// RequestRateLimit limits the rate of the requests to each server. Nil if the rate is not limited.
+	RequestRateLimit *RateLimit
+	Servers          []Server
+	RewriteClientIP  shared.RewriteClientIPSettings
+	IPFamily         shared.IPFamily
+	Plus             bool
+}
+
+// ConnectionLimit limits the number of simultaneous connections per key of a shared memory zone.
+type ConnectionLimit struct {
+	Zone           string
+	MaxConnections int32
 }
 
 // Include defines a file that's included via the include directive.
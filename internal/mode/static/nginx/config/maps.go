@@ -1,6 +1,7 @@
 package config
 
 import (
+	"slices"
 	"strings"
 	gotemplate "text/template"
 
@@ -25,7 +26,13 @@ const (
 )
 
 func executeMaps(conf dataplane.Configuration) []executeResult {
-	maps := buildAddHeaderMaps(append(conf.HTTPServers, conf.SSLServers...))
+	servers := make([]dataplane.VirtualServer, 0, len(conf.HTTPServers)+len(conf.SSLServers))
+	servers = append(servers, conf.HTTPServers...)
+	servers = append(servers, conf.SSLServers...)
+
+	maps := append(buildAddHeaderMaps(servers), buildConditionalResponseHeaderMaps(servers)...)
+	maps = append(maps, buildQueryParameterMaps(servers)...)
+	maps = append(maps, buildABTestMaps(conf.BackendGroups)...)
 	result := executeResult{
 		dest: httpConfigFile,
 		data: helpers.MustExecuteTemplate(mapsTemplate, maps),
@@ -111,9 +118,18 @@ func createStreamMaps(conf dataplane.Configuration) []shared.Map {
 		}
 	}
 
+	ports := make([]int32, 0, len(portsToMap))
+	for p := range portsToMap {
+		ports = append(ports, p)
+	}
+
+	// The maps are generated in a stable order, so that the generated config is stable.
+	slices.Sort(ports)
+
 	maps := make([]shared.Map, 0, len(portsToMap))
 
-	for p, m := range portsToMap {
+	for _, p := range ports {
+		m := portsToMap[p]
 		if _, ok := portHasDefault[p]; !ok {
 			m.Parameters = append(m.Parameters, shared.MapParameter{
 				Value:  "default",
@@ -145,7 +161,7 @@ func buildAddHeaderMaps(servers []dataplane.VirtualServer) []shared.Map {
 	}
 
 	maps := make([]shared.Map, 0, len(addHeaderNames))
-	for m := range addHeaderNames {
+	for _, m := range sortedKeys(addHeaderNames) {
 		maps = append(maps, createAddHeadersMap(m))
 	}
 	return maps
@@ -0,0 +1,122 @@
+package botmitigation
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+const (
+	// ChallengeLocationPath is the path of the internal location that responds with the challenge page.
+	// The locations with the Challenge action rewrite the requests of bots to it.
+	ChallengeLocationPath = http.InternalRoutePathPrefix + "-bot-challenge"
+	// ChallengeCookie is the name of the cookie that the challenge page of the servers template sets.
+	ChallengeCookie = "ngf_bot_challenge"
+	// ChallengeCookieValue is the value of the cookie that the challenge page of the servers template sets.
+	ChallengeCookieValue = "passed"
+	// KnownScannersPattern matches the User-Agent of well-known vulnerability scanners.
+	KnownScannersPattern = "(sqlmap|nikto|nmap|masscan|zgrab|nuclei|wpscan|dirbuster|gobuster|ffuf|feroxbuster|" +
+		"acunetix|nessus|openvas|w3af|whatweb|arachni|skipfish|netsparker)"
+)
+
+var tmpl = template.Must(template.New("bot mitigation policy").Parse(botMitigationTemplate))
+
+const botMitigationTemplate = `
+if (${{ .Variable }}) {
+{{- if .ChallengeLocation }}
+    rewrite ^ {{ .ChallengeLocation }} last;
+{{- else }}
+    return 403;
+{{- end }}
+}
+`
+
+// botMitigation holds the data for the bot mitigation policy template.
+type botMitigation struct {
+	Variable          string
+	ChallengeLocation string
+}
+
+// Generator generates nginx configuration based on a bot mitigation policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+// BotMitigationPolicies only target Routes, so no configuration is generated for the server block.
+func (g Generator) GenerateForServer(_ []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return nil
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// When a normal location redirects to internal locations, the requests are checked in the internal locations,
+// so that the requests are not checked twice.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	_ http.Location,
+) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		bmp, ok := pol.(*ngfAPI.BotMitigationPolicy)
+		if !ok {
+			continue
+		}
+
+		data := botMitigation{Variable: VariableName(bmp)}
+		if IsChallenge(bmp) {
+			data.ChallengeLocation = ChallengeLocationPath
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("BotMitigationPolicy_%s_%s.conf", bmp.Namespace, bmp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, data),
+		})
+	}
+
+	return files
+}
+
+// VariableName returns the name of the NGINX variable that evaluates to 1 if a request is a request of a bot
+// according to the BotMitigationPolicy.
+func VariableName(bmp *ngfAPI.BotMitigationPolicy) string {
+	return strings.NewReplacer("-", "_", ".", "_").Replace(fmt.Sprintf("ngf_bot_%s_%s", bmp.Namespace, bmp.Name))
+}
+
+// IsChallenge returns true if the BotMitigationPolicy challenges the requests of bots instead of blocking them.
+func IsChallenge(bmp *ngfAPI.BotMitigationPolicy) bool {
+	return bmp.Spec.Action != nil && *bmp.Spec.Action == ngfAPI.BotMitigationActionChallenge
+}
+
+// HasChallenge returns true if any of the policies is a BotMitigationPolicy that challenges the requests of bots.
+// The servers with such policies need the internal location at ChallengeLocationPath.
+func HasChallenge(pols []policies.Policy) bool {
+	for _, pol := range pols {
+		if bmp, ok := pol.(*ngfAPI.BotMitigationPolicy); ok && IsChallenge(bmp) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package botmitigation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/botmitigation"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		expContent string
+		spec       ngfAPI.BotMitigationPolicySpec
+	}{
+		{
+			name: "default action",
+			spec: ngfAPI.BotMitigationPolicySpec{
+				BlockKnownScanners: helpers.GetPointer(true),
+			},
+			expContent: "\nif ($ngf_bot_test_my_policy) {\n    return 403;\n}\n",
+		},
+		{
+			name: "block",
+			spec: ngfAPI.BotMitigationPolicySpec{
+				Action:            helpers.GetPointer(ngfAPI.BotMitigationActionBlock),
+				UserAgentPatterns: []string{"curl"},
+			},
+			expContent: "\nif ($ngf_bot_test_my_policy) {\n    return 403;\n}\n",
+		},
+		{
+			name: "challenge",
+			spec: ngfAPI.BotMitigationPolicySpec{
+				Action:          helpers.GetPointer(ngfAPI.BotMitigationActionChallenge),
+				RequiredHeaders: []string{"Accept"},
+			},
+			expContent: "\nif ($ngf_bot_test_my_policy) {\n    rewrite ^ /_ngf-internal-bot-challenge last;\n}\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.BotMitigationPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-policy",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+
+			generator := botmitigation.NewGenerator()
+
+			g.Expect(generator.GenerateForServer([]policies.Policy{policy}, http.Server{})).To(BeEmpty())
+
+			redirectLocation := http.Location{Type: http.RedirectLocationType}
+			g.Expect(generator.GenerateForLocation([]policies.Policy{policy}, redirectLocation)).To(BeEmpty())
+
+			externalLocation := http.Location{Type: http.ExternalLocationType}
+			internalLocation := http.Location{Type: http.InternalLocationType}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForLocation([]policies.Policy{policy}, externalLocation),
+				generator.GenerateForInternalLocation([]policies.Policy{policy}, internalLocation),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("BotMitigationPolicy_test_my-policy.conf"))
+				g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+			}
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := botmitigation.NewGenerator()
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestVariableName(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	policy := &ngfAPI.BotMitigationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my.bot-policy",
+			Namespace: "my-ns",
+		},
+	}
+
+	g.Expect(botmitigation.VariableName(policy)).To(Equal("ngf_bot_my_ns_my_bot_policy"))
+}
+
+func TestHasChallenge(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		pols   []policies.Policy
+		expect bool
+	}{
+		{
+			name:   "no policies",
+			expect: false,
+		},
+		{
+			name: "policy that blocks",
+			pols: []policies.Policy{
+				&ngfAPI.ClientSettingsPolicy{},
+				&ngfAPI.BotMitigationPolicy{},
+			},
+			expect: false,
+		},
+		{
+			name: "policy that challenges",
+			pols: []policies.Policy{
+				&ngfAPI.BotMitigationPolicy{
+					Spec: ngfAPI.BotMitigationPolicySpec{
+						Action: helpers.GetPointer(ngfAPI.BotMitigationActionChallenge),
+					},
+				},
+			},
+			expect: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(botmitigation.HasChallenge(test.pols)).To(Equal(test.expect))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package botmitigation
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var headerNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+// Validator validates a BotMitigationPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a BotMitigationPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	bmp := helpers.MustCastObject[*ngfAPI.BotMitigationPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute, kinds.GRPCRoute}
+	for _, ref := range bmp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := validateSettings(bmp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two BotMitigationPolicies conflict. BotMitigationPolicies always conflict, because
+// a request can only be classified by one policy.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.BotMitigationPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Action != nil {
+		supportedActions := []string{string(ngfAPI.BotMitigationActionBlock), string(ngfAPI.BotMitigationActionChallenge)}
+		if !slices.Contains(supportedActions, string(*spec.Action)) {
+			allErrs = append(allErrs, field.NotSupported(fieldPath.Child("action"), *spec.Action, supportedActions))
+		}
+	}
+
+	blockKnownScanners := spec.BlockKnownScanners != nil && *spec.BlockKnownScanners
+	if !blockKnownScanners && len(spec.UserAgentPatterns) == 0 && len(spec.RequiredHeaders) == 0 {
+		allErrs = append(
+			allErrs,
+			field.Required(
+				fieldPath,
+				"at least one of blockKnownScanners, userAgentPatterns, or requiredHeaders must be specified",
+			),
+		)
+	}
+
+	for i, pattern := range spec.UserAgentPatterns {
+		if err := validatePattern(pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("userAgentPatterns").Index(i), pattern, err.Error()))
+		}
+	}
+
+	for i, header := range spec.RequiredHeaders {
+		if !headerNameRegexp.MatchString(header) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					fieldPath.Child("requiredHeaders").Index(i),
+					header,
+					"must contain only alphanumeric characters or '-'",
+				),
+			)
+		}
+	}
+
+	for i, crawler := range spec.VerifiedCrawlers {
+		crawlerPath := fieldPath.Child("verifiedCrawlers").Index(i)
+
+		if err := validatePattern(crawler.UserAgentPattern); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(crawlerPath.Child("userAgentPattern"), crawler.UserAgentPattern, err.Error()),
+			)
+		}
+
+		if len(crawler.SourceCIDRs) == 0 {
+			allErrs = append(allErrs, field.Required(crawlerPath.Child("sourceCIDRs"), "must specify at least one CIDR"))
+		}
+
+		for j, cidr := range crawler.SourceCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(crawlerPath.Child("sourceCIDRs").Index(j), cidr, "must be a valid CIDR"),
+				)
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validatePattern validates a User-Agent regular expression, which is written in a quoted map parameter.
+func validatePattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("must not be empty")
+	}
+
+	if strings.ContainsAny(pattern, "\"\r\n") {
+		return errors.New("must not contain double quotes or line breaks")
+	}
+
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("must be a valid regular expression: %w", err)
+	}
+
+	return nil
+}
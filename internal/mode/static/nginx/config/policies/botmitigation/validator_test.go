@@ -0,0 +1,198 @@
+package botmitigation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/botmitigation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy
+
+func createValidPolicy() *ngfAPI.BotMitigationPolicy {
+	return &ngfAPI.BotMitigationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.BotMitigationPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.GRPCRoute,
+					Name:  "route",
+				},
+			},
+			Action:             helpers.GetPointer(ngfAPI.BotMitigationActionChallenge),
+			BlockKnownScanners: helpers.GetPointer(true),
+			UserAgentPatterns:  []string{`curl/\d+`},
+			RequiredHeaders:    []string{"Accept-Language"},
+			VerifiedCrawlers: []ngfAPI.VerifiedCrawler{
+				{
+					UserAgentPattern: "Googlebot",
+					SourceCIDRs:      []string{"66.249.64.0/19", "2001:4860:4801::/48"},
+				},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.BotMitigationPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.BotMitigationPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.Gateway
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"Gateway\": " +
+					"supported values: \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid action",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec.Action = helpers.GetPointer[ngfAPI.BotMitigationAction]("Tarpit")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.action: Unsupported value: \"Tarpit\": " +
+					"supported values: \"Block\", \"Challenge\""),
+			},
+		},
+		{
+			name: "invalid; no rules",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec.BlockKnownScanners = helpers.GetPointer(false)
+				p.Spec.UserAgentPatterns = nil
+				p.Spec.RequiredHeaders = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec: Required value: at least one of blockKnownScanners, " +
+					"userAgentPatterns, or requiredHeaders must be specified"),
+			},
+		},
+		{
+			name: "invalid user agent patterns",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec.UserAgentPatterns = []string{`bot" 0; default`, "(unclosed", ""}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.userAgentPatterns[0]: Invalid value: \"bot\\\" 0; default\": " +
+					"must not contain double quotes or line breaks, spec.userAgentPatterns[1]: Invalid value: " +
+					"\"(unclosed\": must be a valid regular expression: error parsing regexp: " +
+					"missing closing ): `(unclosed`, spec.userAgentPatterns[2]: Invalid value: \"\": must not be empty]"),
+			},
+		},
+		{
+			name: "invalid required header",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec.RequiredHeaders = []string{"Accept Language"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.requiredHeaders[0]: Invalid value: \"Accept Language\": " +
+					"must contain only alphanumeric characters or '-'"),
+			},
+		},
+		{
+			name: "invalid verified crawler",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec.VerifiedCrawlers = []ngfAPI.VerifiedCrawler{
+					{UserAgentPattern: "bot\n", SourceCIDRs: []string{"66.249.64.1"}},
+					{UserAgentPattern: "bot"},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.verifiedCrawlers[0].userAgentPattern: Invalid value: " +
+					"\"bot\\n\": must not contain double quotes or line breaks, " +
+					"spec.verifiedCrawlers[0].sourceCIDRs[0]: Invalid value: \"66.249.64.1\": must be a valid CIDR, " +
+					"spec.verifiedCrawlers[1].sourceCIDRs: Required value: must specify at least one CIDR]"),
+			},
+		},
+		{
+			name: "valid; only known scanners",
+			policy: createModifiedPolicy(func(p *ngfAPI.BotMitigationPolicy) *ngfAPI.BotMitigationPolicy {
+				p.Spec = ngfAPI.BotMitigationPolicySpec{
+					TargetRefs:         p.Spec.TargetRefs,
+					BlockKnownScanners: helpers.GetPointer(true),
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := botmitigation.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := botmitigation.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := botmitigation.NewValidator()
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
@@ -13,6 +13,25 @@ import (
 var tmpl = template.Must(template.New("client settings policy").Parse(clientSettingsTemplate))
 
 const clientSettingsTemplate = `
+{{- if and .Server .Header }}
+	{{- if .Header.BufferSize }}
+client_header_buffer_size {{ .Header.BufferSize }};
+	{{- end }}
+	{{- if .Header.LargeBuffers }}
+large_client_header_buffers {{ .Header.LargeBuffers.Number }} {{ .Header.LargeBuffers.Size }};
+	{{- end }}
+	{{- if .Header.Timeout }}
+client_header_timeout {{ .Header.Timeout }};
+	{{- end }}
+{{- end }}
+{{- if .Bandwidth }}
+	{{- if .Bandwidth.Rate }}
+limit_rate {{ .Bandwidth.Rate }};
+	{{- end }}
+	{{- if .Bandwidth.RateAfter }}
+limit_rate_after {{ .Bandwidth.RateAfter }};
+	{{- end }}
+{{- end }}
 {{- if .Body }}
 	{{- if .Body.MaxSize }}
 client_max_body_size {{ .Body.MaxSize }};
@@ -38,6 +57,14 @@ keepalive_timeout {{ .KeepAlive.Timeout.Server }};
 {{- end }}
 `
 
+// clientSettings holds the data for the client settings template.
+type clientSettings struct {
+	ngfAPI.ClientSettingsPolicySpec
+	// Server indicates whether the configuration is generated for a server block.
+	// The client header directives are only allowed in the server block.
+	Server bool
+}
+
 // Generator generates nginx configuration based on a clientsettings policy.
 type Generator struct{}
 
@@ -48,20 +75,23 @@ func NewGenerator() *Generator {
 
 // GenerateForServer generates policy configuration for the server block.
 func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
-	return generate(pols)
+	return generate(pols, true)
 }
 
 // GenerateForLocation generates policy configuration for a normal location block.
 func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
-	return generate(pols)
+	return generate(pols, false)
 }
 
 // GenerateForInternalLocation generates policy configuration for an internal location block.
-func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
-	return generate(pols)
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	_ http.Location,
+) policies.GenerateResultFiles {
+	return generate(pols, false)
 }
 
-func generate(pols []policies.Policy) policies.GenerateResultFiles {
+func generate(pols []policies.Policy, server bool) policies.GenerateResultFiles {
 	files := make(policies.GenerateResultFiles, 0, len(pols))
 
 	for _, pol := range pols {
@@ -70,9 +100,17 @@ func generate(pols []policies.Policy) policies.GenerateResultFiles {
 			continue
 		}
 
+		content := helpers.MustExecuteTemplate(tmpl, clientSettings{ClientSettingsPolicySpec: csp.Spec, Server: server})
+
+		// The settings of the policy might not apply to the block, such as the client header settings in
+		// a location block.
+		if policies.IsEmpty(content) {
+			continue
+		}
+
 		files = append(files, policies.File{
 			Name:    fmt.Sprintf("ClientSettingsPolicy_%s_%s.conf", csp.Namespace, csp.Name),
-			Content: helpers.MustExecuteTemplate(tmpl, csp.Spec),
+			Content: content,
 		})
 	}
 
@@ -20,12 +20,40 @@ func TestGenerate(t *testing.T) {
 	keepaliveTime := helpers.GetPointer[ngfAPI.Duration]("50s")
 	keepaliveServerTimeout := helpers.GetPointer[ngfAPI.Duration]("30s")
 	keepaliveHeaderTimeout := helpers.GetPointer[ngfAPI.Duration]("60s")
+	rate := helpers.GetPointer[ngfAPI.Size]("500k")
+	rateAfter := helpers.GetPointer[ngfAPI.Size]("1m")
 
 	tests := []struct {
 		name       string
 		policy     policies.Policy
 		expStrings []string
 	}{
+		{
+			name: "bandwidth rate populated",
+			policy: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Bandwidth: &ngfAPI.ClientBandwidth{
+						Rate: rate,
+					},
+				},
+			},
+			expStrings: []string{
+				"limit_rate 500k;",
+			},
+		},
+		{
+			name: "bandwidth rate after populated",
+			policy: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Bandwidth: &ngfAPI.ClientBandwidth{
+						RateAfter: rateAfter,
+					},
+				},
+			},
+			expStrings: []string{
+				"limit_rate_after 1m;",
+			},
+		},
 		{
 			name: "body max size populated",
 			policy: &ngfAPI.ClientSettingsPolicy{
@@ -120,12 +148,16 @@ func TestGenerate(t *testing.T) {
 					},
 				},
 			},
-			expStrings: []string{}, // header timeout is ignored if server timeout is not populated
+			expStrings: nil, // header timeout is ignored if server timeout is not populated, so no file is generated
 		},
 		{
 			name: "all fields populated",
 			policy: &ngfAPI.ClientSettingsPolicy{
 				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Bandwidth: &ngfAPI.ClientBandwidth{
+						Rate:      rate,
+						RateAfter: rateAfter,
+					},
 					Body: &ngfAPI.ClientBody{
 						MaxSize: maxSize,
 						Timeout: bodyTimeout,
@@ -141,6 +173,8 @@ func TestGenerate(t *testing.T) {
 				},
 			},
 			expStrings: []string{
+				"limit_rate 500k;",
+				"limit_rate_after 1m;",
 				"client_max_body_size 10m;",
 				"client_body_timeout 600ms",
 				"keepalive_requests 900;",
@@ -153,6 +187,12 @@ func TestGenerate(t *testing.T) {
 	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings []string) {
 		t.Helper()
 		g := NewWithT(t)
+
+		if len(expStrings) == 0 {
+			g.Expect(resFiles).To(BeEmpty())
+			return
+		}
+
 		g.Expect(resFiles).To(HaveLen(1))
 
 		for _, str := range expStrings {
@@ -171,12 +211,51 @@ func TestGenerate(t *testing.T) {
 			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
 			checkResults(t, resFiles, test.expStrings)
 
-			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy}, http.Location{})
 			checkResults(t, resFiles, test.expStrings)
 		})
 	}
 }
 
+func TestGenerateHeader(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	policy := &ngfAPI.ClientSettingsPolicy{
+		Spec: ngfAPI.ClientSettingsPolicySpec{
+			Header: &ngfAPI.ClientHeader{
+				BufferSize: helpers.GetPointer[ngfAPI.Size]("4k"),
+				LargeBuffers: &ngfAPI.ClientLargeHeaderBuffers{
+					Number: 8,
+					Size:   "32k",
+				},
+				Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+			},
+		},
+	}
+
+	expStrings := []string{
+		"client_header_buffer_size 4k;",
+		"large_client_header_buffers 8 32k;",
+		"client_header_timeout 30s;",
+	}
+
+	generator := clientsettings.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{policy}, http.Server{})
+	g.Expect(resFiles).To(HaveLen(1))
+	for _, str := range expStrings {
+		g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+	}
+
+	// the client header directives are not allowed in a location block, so no file is generated
+	resFiles = generator.GenerateForLocation([]policies.Policy{policy}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{policy}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
 func TestGenerateNoPolicies(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -195,9 +274,9 @@ func TestGenerateNoPolicies(t *testing.T) {
 	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ObservabilityPolicy{}}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
 
-	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
 
-	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ObservabilityPolicy{}})
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ObservabilityPolicy{}}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
 }
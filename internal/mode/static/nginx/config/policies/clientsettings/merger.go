@@ -0,0 +1,78 @@
+package clientsettings
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// Merger merges ClientSettingsPolicies.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge returns a copy of the child ClientSettingsPolicy, in which the settings that are not set are inherited
+// from the parent ClientSettingsPolicy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentCSP := helpers.MustCastObject[*ngfAPI.ClientSettingsPolicy](parent)
+	childCSP := helpers.MustCastObject[*ngfAPI.ClientSettingsPolicy](child)
+
+	merged := childCSP.DeepCopy()
+	merged.Spec = merge(parentCSP.Spec, merged.Spec)
+
+	return merged
+}
+
+func merge(parent, child ngfAPI.ClientSettingsPolicySpec) ngfAPI.ClientSettingsPolicySpec {
+	if parent.Bandwidth != nil {
+		if child.Bandwidth == nil {
+			child.Bandwidth = &ngfAPI.ClientBandwidth{}
+		}
+
+		child.Bandwidth.Rate = policies.Inherit(parent.Bandwidth.Rate, child.Bandwidth.Rate)
+		child.Bandwidth.RateAfter = policies.Inherit(parent.Bandwidth.RateAfter, child.Bandwidth.RateAfter)
+	}
+
+	if parent.Body != nil {
+		if child.Body == nil {
+			child.Body = &ngfAPI.ClientBody{}
+		}
+
+		child.Body.MaxSize = policies.Inherit(parent.Body.MaxSize, child.Body.MaxSize)
+		child.Body.Timeout = policies.Inherit(parent.Body.Timeout, child.Body.Timeout)
+	}
+
+	if parent.Header != nil {
+		if child.Header == nil {
+			child.Header = &ngfAPI.ClientHeader{}
+		}
+
+		child.Header.BufferSize = policies.Inherit(parent.Header.BufferSize, child.Header.BufferSize)
+		child.Header.LargeBuffers = policies.Inherit(parent.Header.LargeBuffers, child.Header.LargeBuffers)
+		child.Header.Timeout = policies.Inherit(parent.Header.Timeout, child.Header.Timeout)
+	}
+
+	if parent.KeepAlive != nil {
+		if child.KeepAlive == nil {
+			child.KeepAlive = &ngfAPI.ClientKeepAlive{}
+		}
+
+		child.KeepAlive.Requests = policies.Inherit(parent.KeepAlive.Requests, child.KeepAlive.Requests)
+		child.KeepAlive.Time = policies.Inherit(parent.KeepAlive.Time, child.KeepAlive.Time)
+
+		if parent.KeepAlive.Timeout != nil {
+			if child.KeepAlive.Timeout == nil {
+				child.KeepAlive.Timeout = &ngfAPI.ClientKeepAliveTimeout{}
+			}
+
+			child.KeepAlive.Timeout.Server = policies.Inherit(parent.KeepAlive.Timeout.Server, child.KeepAlive.Timeout.Server)
+			child.KeepAlive.Timeout.Header = policies.Inherit(parent.KeepAlive.Timeout.Header, child.KeepAlive.Timeout.Header)
+		}
+	}
+
+	return child
+}
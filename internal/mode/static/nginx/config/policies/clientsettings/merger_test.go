@@ -0,0 +1,128 @@
+package clientsettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/clientsettings"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(name string, spec ngfAPI.ClientSettingsPolicySpec) *ngfAPI.ClientSettingsPolicy {
+		return &ngfAPI.ClientSettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: spec,
+		}
+	}
+
+	gatewaySpec := ngfAPI.ClientSettingsPolicySpec{
+		Bandwidth: &ngfAPI.ClientBandwidth{
+			Rate:      helpers.GetPointer[ngfAPI.Size]("1m"),
+			RateAfter: helpers.GetPointer[ngfAPI.Size]("10m"),
+		},
+		Body: &ngfAPI.ClientBody{
+			MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
+			Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+		},
+		Header: &ngfAPI.ClientHeader{
+			BufferSize: helpers.GetPointer[ngfAPI.Size]("1k"),
+			LargeBuffers: &ngfAPI.ClientLargeHeaderBuffers{
+				Number: 4,
+				Size:   "8k",
+			},
+			Timeout: helpers.GetPointer[ngfAPI.Duration]("60s"),
+		},
+		KeepAlive: &ngfAPI.ClientKeepAlive{
+			Requests: helpers.GetPointer[int32](100),
+			Time:     helpers.GetPointer[ngfAPI.Duration]("1h"),
+			Timeout: &ngfAPI.ClientKeepAliveTimeout{
+				Server: helpers.GetPointer[ngfAPI.Duration]("75s"),
+				Header: helpers.GetPointer[ngfAPI.Duration]("60s"),
+			},
+		},
+	}
+
+	tests := []struct {
+		expSpec ngfAPI.ClientSettingsPolicySpec
+		parent  *ngfAPI.ClientSettingsPolicy
+		child   *ngfAPI.ClientSettingsPolicy
+		name    string
+	}{
+		{
+			name:    "nothing set",
+			parent:  createPolicy("parent", ngfAPI.ClientSettingsPolicySpec{}),
+			child:   createPolicy("child", ngfAPI.ClientSettingsPolicySpec{}),
+			expSpec: ngfAPI.ClientSettingsPolicySpec{},
+		},
+		{
+			name:    "all settings inherited",
+			parent:  createPolicy("parent", gatewaySpec),
+			child:   createPolicy("child", ngfAPI.ClientSettingsPolicySpec{}),
+			expSpec: gatewaySpec,
+		},
+		{
+			name:   "child settings override parent settings",
+			parent: createPolicy("parent", gatewaySpec),
+			child: createPolicy("child", ngfAPI.ClientSettingsPolicySpec{
+				Bandwidth: &ngfAPI.ClientBandwidth{
+					Rate: helpers.GetPointer[ngfAPI.Size]("100k"),
+				},
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+				},
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Timeout: &ngfAPI.ClientKeepAliveTimeout{
+						Server: helpers.GetPointer[ngfAPI.Duration]("10s"),
+					},
+				},
+			}),
+			expSpec: ngfAPI.ClientSettingsPolicySpec{
+				Bandwidth: &ngfAPI.ClientBandwidth{
+					Rate:      helpers.GetPointer[ngfAPI.Size]("100k"),
+					RateAfter: helpers.GetPointer[ngfAPI.Size]("10m"),
+				},
+				Body: &ngfAPI.ClientBody{
+					MaxSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+					Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+				Header: gatewaySpec.Header,
+				KeepAlive: &ngfAPI.ClientKeepAlive{
+					Requests: helpers.GetPointer[int32](100),
+					Time:     helpers.GetPointer[ngfAPI.Duration]("1h"),
+					Timeout: &ngfAPI.ClientKeepAliveTimeout{
+						Server: helpers.GetPointer[ngfAPI.Duration]("10s"),
+						Header: helpers.GetPointer[ngfAPI.Duration]("60s"),
+					},
+				},
+			},
+		},
+	}
+
+	merger := clientsettings.NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			child := test.child.DeepCopy()
+
+			merged := merger.Merge(test.parent, test.child)
+
+			csp, ok := merged.(*ngfAPI.ClientSettingsPolicy)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(csp.Name).To(Equal("child"))
+			g.Expect(csp.Spec).To(Equal(test.expSpec))
+			g.Expect(test.child).To(Equal(child))
+		})
+	}
+}
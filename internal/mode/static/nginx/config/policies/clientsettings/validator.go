@@ -30,7 +30,19 @@ func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings)
 
 	targetRefPath := field.NewPath("spec").Child("targetRef")
 	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute, kinds.GRPCRoute}
-	if err := policies.ValidateTargetRef(csp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+	targetRef := csp.Spec.TargetRef.LocalPolicyTargetReference
+	if err := policies.ValidateTargetRef(targetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := policies.ValidateTargetRefSectionName(csp.Spec.TargetRef, targetRefPath); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if csp.Spec.Header != nil && csp.Spec.TargetRef.Kind != kinds.Gateway {
+		path := field.NewPath("spec").Child("header")
+		err := field.Forbidden(path, "header can only be specified if the targetRef kind is Gateway")
+
 		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
 	}
 
@@ -50,6 +62,16 @@ func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
 }
 
 func conflicts(a, b ngfAPI.ClientSettingsPolicySpec) bool {
+	if a.Bandwidth != nil && b.Bandwidth != nil {
+		if a.Bandwidth.Rate != nil && b.Bandwidth.Rate != nil {
+			return true
+		}
+
+		if a.Bandwidth.RateAfter != nil && b.Bandwidth.RateAfter != nil {
+			return true
+		}
+	}
+
 	if a.Body != nil && b.Body != nil {
 		if a.Body.Timeout != nil && b.Body.Timeout != nil {
 			return true
@@ -60,6 +82,20 @@ func conflicts(a, b ngfAPI.ClientSettingsPolicySpec) bool {
 		}
 	}
 
+	if a.Header != nil && b.Header != nil {
+		if a.Header.BufferSize != nil && b.Header.BufferSize != nil {
+			return true
+		}
+
+		if a.Header.LargeBuffers != nil && b.Header.LargeBuffers != nil {
+			return true
+		}
+
+		if a.Header.Timeout != nil && b.Header.Timeout != nil {
+			return true
+		}
+	}
+
 	if a.KeepAlive != nil && b.KeepAlive != nil {
 		if a.KeepAlive.Requests != nil && b.KeepAlive.Requests != nil {
 			return true
@@ -83,10 +119,18 @@ func (v *Validator) validateSettings(spec ngfAPI.ClientSettingsPolicySpec) error
 	var allErrs field.ErrorList
 	fieldPath := field.NewPath("spec")
 
+	if spec.Bandwidth != nil {
+		allErrs = append(allErrs, v.validateClientBandwidth(*spec.Bandwidth, fieldPath.Child("bandwidth"))...)
+	}
+
 	if spec.Body != nil {
 		allErrs = append(allErrs, v.validateClientBody(*spec.Body, fieldPath.Child("body"))...)
 	}
 
+	if spec.Header != nil {
+		allErrs = append(allErrs, v.validateClientHeader(*spec.Header, fieldPath.Child("header"))...)
+	}
+
 	if spec.KeepAlive != nil {
 		allErrs = append(allErrs, v.validateClientKeepAlive(*spec.KeepAlive, fieldPath.Child("keepAlive"))...)
 	}
@@ -94,6 +138,31 @@ func (v *Validator) validateSettings(spec ngfAPI.ClientSettingsPolicySpec) error
 	return allErrs.ToAggregate()
 }
 
+func (v *Validator) validateClientBandwidth(
+	bandwidth ngfAPI.ClientBandwidth,
+	fieldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if bandwidth.Rate != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*bandwidth.Rate)); err != nil {
+			path := fieldPath.Child("rate")
+
+			allErrs = append(allErrs, field.Invalid(path, *bandwidth.Rate, err.Error()))
+		}
+	}
+
+	if bandwidth.RateAfter != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*bandwidth.RateAfter)); err != nil {
+			path := fieldPath.Child("rateAfter")
+
+			allErrs = append(allErrs, field.Invalid(path, *bandwidth.RateAfter, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func (v *Validator) validateClientBody(body ngfAPI.ClientBody, fieldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	if body.Timeout != nil {
@@ -115,6 +184,42 @@ func (v *Validator) validateClientBody(body ngfAPI.ClientBody, fieldPath *field.
 	return allErrs
 }
 
+func (v *Validator) validateClientHeader(header ngfAPI.ClientHeader, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if header.BufferSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*header.BufferSize)); err != nil {
+			path := fieldPath.Child("bufferSize")
+
+			allErrs = append(allErrs, field.Invalid(path, *header.BufferSize, err.Error()))
+		}
+	}
+
+	if header.LargeBuffers != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(header.LargeBuffers.Size)); err != nil {
+			path := fieldPath.Child("largeBuffers").Child("size")
+
+			allErrs = append(allErrs, field.Invalid(path, header.LargeBuffers.Size, err.Error()))
+		}
+
+		if header.LargeBuffers.Number < 1 {
+			path := fieldPath.Child("largeBuffers").Child("number")
+
+			allErrs = append(allErrs, field.Invalid(path, header.LargeBuffers.Number, "must be greater than 0"))
+		}
+	}
+
+	if header.Timeout != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*header.Timeout)); err != nil {
+			path := fieldPath.Child("timeout")
+
+			allErrs = append(allErrs, field.Invalid(path, *header.Timeout, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func (v *Validator) validateClientKeepAlive(keepAlive ngfAPI.ClientKeepAlive, fieldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -26,15 +26,29 @@ func createValidPolicy() *ngfAPI.ClientSettingsPolicy {
 			Namespace: "default",
 		},
 		Spec: ngfAPI.ClientSettingsPolicySpec{
-			TargetRef: v1alpha2.LocalPolicyTargetReference{
-				Group: v1.GroupName,
-				Kind:  kinds.Gateway,
-				Name:  "gateway",
+			TargetRef: v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			Bandwidth: &ngfAPI.ClientBandwidth{
+				Rate:      helpers.GetPointer[ngfAPI.Size]("1m"),
+				RateAfter: helpers.GetPointer[ngfAPI.Size]("5m"),
 			},
 			Body: &ngfAPI.ClientBody{
 				MaxSize: helpers.GetPointer[ngfAPI.Size]("10m"),
 				Timeout: helpers.GetPointer[ngfAPI.Duration]("600ms"),
 			},
+			Header: &ngfAPI.ClientHeader{
+				BufferSize: helpers.GetPointer[ngfAPI.Size]("4k"),
+				LargeBuffers: &ngfAPI.ClientLargeHeaderBuffers{
+					Number: 8,
+					Size:   "32k",
+				},
+				Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+			},
 			KeepAlive: &ngfAPI.ClientKeepAlive{
 				Requests: helpers.GetPointer[int32](900),
 				Time:     helpers.GetPointer[ngfAPI.Duration]("50s"),
@@ -81,6 +95,43 @@ func TestValidator_Validate(t *testing.T) {
 					"supported values: \"Gateway\", \"HTTPRoute\", \"GRPCRoute\""),
 			},
 		},
+		{
+			name: "invalid target ref; sectionName for a route",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("rule-1")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.sectionName: Forbidden: " +
+					"sectionName can only be specified if the targetRef kind is Gateway"),
+			},
+		},
+		{
+			name: "valid target ref; sectionName for a gateway listener",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("listener")
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "invalid bandwidth sizes",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Bandwidth.Rate = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Bandwidth.RateAfter = helpers.GetPointer[ngfAPI.Size]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.bandwidth.rate: Invalid value: \"invalid\": ^\\d{1,4}(k|m|g)?$ " +
+						"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is 'must contain a number. " +
+						"May be followed by 'k', 'm', or 'g', otherwise bytes are assumed'), " +
+						"spec.bandwidth.rateAfter: Invalid value: \"invalid\": ^\\d{1,4}(k|m|g)?$ " +
+						"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is 'must contain a number. " +
+						"May be followed by 'k', 'm', or 'g', otherwise bytes are assumed')]"),
+			},
+		},
 		{
 			name: "invalid client max body size",
 			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
@@ -93,10 +144,41 @@ func TestValidator_Validate(t *testing.T) {
 					"May be followed by 'k', 'm', or 'g', otherwise bytes are assumed')"),
 			},
 		},
+		{
+			name: "invalid header; targetRef is not a Gateway",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.header: Forbidden: " +
+					"header can only be specified if the targetRef kind is Gateway"),
+			},
+		},
+		{
+			name: "invalid header sizes and number",
+			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
+				p.Spec.Header.BufferSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Header.LargeBuffers.Size = "invalid"
+				p.Spec.Header.LargeBuffers.Number = 0
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.header.bufferSize: Invalid value: \"invalid\": ^\\d{1,4}(k|m|g)?$ " +
+						"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is 'must contain a number. " +
+						"May be followed by 'k', 'm', or 'g', otherwise bytes are assumed'), " +
+						"spec.header.largeBuffers.size: Invalid value: \"invalid\": ^\\d{1,4}(k|m|g)?$ " +
+						"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is 'must contain a number. " +
+						"May be followed by 'k', 'm', or 'g', otherwise bytes are assumed'), " +
+						"spec.header.largeBuffers.number: Invalid value: 0: must be greater than 0]"),
+			},
+		},
 		{
 			name: "invalid durations",
 			policy: createModifiedPolicy(func(p *ngfAPI.ClientSettingsPolicy) *ngfAPI.ClientSettingsPolicy {
 				p.Spec.Body.Timeout = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.Header.Timeout = helpers.GetPointer[ngfAPI.Duration]("invalid")
 				p.Spec.KeepAlive.Time = helpers.GetPointer[ngfAPI.Duration]("invalid")
 				p.Spec.KeepAlive.Timeout.Server = helpers.GetPointer[ngfAPI.Duration]("invalid")
 				p.Spec.KeepAlive.Timeout.Header = helpers.GetPointer[ngfAPI.Duration]("invalid")
@@ -105,6 +187,9 @@ func TestValidator_Validate(t *testing.T) {
 			expConditions: []conditions.Condition{
 				staticConds.NewPolicyInvalid(
 					"[spec.body.timeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
+						"spec.header.timeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
 						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
 						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
 						"spec.keepAlive.time: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
@@ -198,6 +283,30 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: false,
 		},
+		{
+			name: "bandwidth rate conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Bandwidth: &ngfAPI.ClientBandwidth{
+						Rate: helpers.GetPointer[ngfAPI.Size]("1m"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "bandwidth rate after conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Bandwidth: &ngfAPI.ClientBandwidth{
+						RateAfter: helpers.GetPointer[ngfAPI.Size]("5m"),
+					},
+				},
+			},
+			conflicts: true,
+		},
 		{
 			name: "body max size conflicts",
 			polA: createValidPolicy(),
@@ -222,6 +331,45 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: true,
 		},
+		{
+			name: "header buffer size conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Header: &ngfAPI.ClientHeader{
+						BufferSize: helpers.GetPointer[ngfAPI.Size]("4k"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "header large buffers conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Header: &ngfAPI.ClientHeader{
+						LargeBuffers: &ngfAPI.ClientLargeHeaderBuffers{
+							Number: 4,
+							Size:   "16k",
+						},
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "header timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ClientSettingsPolicy{
+				Spec: ngfAPI.ClientSettingsPolicySpec{
+					Header: &ngfAPI.ClientHeader{
+						Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+					},
+				},
+			},
+			conflicts: true,
+		},
 		{
 			name: "keepalive requests conflicts",
 			polA: createValidPolicy(),
@@ -0,0 +1,82 @@
+package defaultcertificate
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// Validator validates a DefaultCertificatePolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a DefaultCertificatePolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	dcp := helpers.MustCastObject[*ngfAPI.DefaultCertificatePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway}
+	if err := policies.ValidateTargetRef(dcp.Spec.TargetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := validateCertificateRef(dcp.Spec.CertificateRef); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true, because a Gateway can only have one default certificate.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	_ = helpers.MustCastObject[*ngfAPI.DefaultCertificatePolicy](polA)
+	_ = helpers.MustCastObject[*ngfAPI.DefaultCertificatePolicy](polB)
+
+	return true
+}
+
+// validateCertificateRef validates that the group of the certificateRef matches its kind.
+// The kind is validated by the CRD.
+func validateCertificateRef(ref ngfAPI.DefaultCertificateReference) error {
+	var kind gatewayv1.Kind = kinds.Secret
+	if ref.Kind != nil {
+		kind = *ref.Kind
+	}
+
+	var group gatewayv1.Group
+	if ref.Group != nil {
+		group = *ref.Group
+	}
+
+	groupPath := field.NewPath("spec").Child("certificateRef").Child("group")
+
+	switch kind {
+	case kinds.Secret:
+		if group != "" {
+			return field.NotSupported(groupPath, group, []string{""})
+		}
+	case kinds.ExternalCertificate:
+		if group != ngfAPI.GroupName {
+			return field.NotSupported(groupPath, group, []string{ngfAPI.GroupName})
+		}
+	default:
+		return field.NotSupported(
+			field.NewPath("spec").Child("certificateRef").Child("kind"),
+			kind,
+			[]string{kinds.Secret, kinds.ExternalCertificate},
+		)
+	}
+
+	return nil
+}
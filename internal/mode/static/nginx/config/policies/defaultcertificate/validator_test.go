@@ -0,0 +1,168 @@
+package defaultcertificate_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/defaultcertificate"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.DefaultCertificatePolicy) *ngfAPI.DefaultCertificatePolicy
+
+func createValidPolicy() *ngfAPI.DefaultCertificatePolicy {
+	return &ngfAPI.DefaultCertificatePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.DefaultCertificatePolicySpec{
+			TargetRef: v1alpha2.LocalPolicyTargetReference{
+				Group: v1.GroupName,
+				Kind:  kinds.Gateway,
+				Name:  "gateway",
+			},
+			CertificateRef: ngfAPI.DefaultCertificateReference{
+				Group: helpers.GetPointer[v1.Group](""),
+				Kind:  helpers.GetPointer[v1.Kind](kinds.Secret),
+				Name:  "default-cert",
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.DefaultCertificatePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.DefaultCertificatePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.DefaultCertificatePolicy) *ngfAPI.DefaultCertificatePolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.kind: Unsupported value: \"HTTPRoute\": " +
+					"supported values: \"Gateway\""),
+			},
+		},
+		{
+			name: "invalid certificate ref; Secret with a group",
+			policy: createModifiedPolicy(func(p *ngfAPI.DefaultCertificatePolicy) *ngfAPI.DefaultCertificatePolicy {
+				p.Spec.CertificateRef.Group = helpers.GetPointer[v1.Group](ngfAPI.GroupName)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.certificateRef.group: Unsupported value: \"gateway.nginx.org\": " +
+					"supported values: \"\""),
+			},
+		},
+		{
+			name: "invalid certificate ref; ExternalCertificate without a group",
+			policy: createModifiedPolicy(func(p *ngfAPI.DefaultCertificatePolicy) *ngfAPI.DefaultCertificatePolicy {
+				p.Spec.CertificateRef.Kind = helpers.GetPointer[v1.Kind](kinds.ExternalCertificate)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.certificateRef.group: Unsupported value: \"\": " +
+					"supported values: \"gateway.nginx.org\""),
+			},
+		},
+		{
+			name: "invalid certificate ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.DefaultCertificatePolicy) *ngfAPI.DefaultCertificatePolicy {
+				p.Spec.CertificateRef.Kind = helpers.GetPointer[v1.Kind]("ConfigMap")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.certificateRef.kind: Unsupported value: \"ConfigMap\": " +
+					"supported values: \"Secret\", \"ExternalCertificate\""),
+			},
+		},
+		{
+			name: "valid; defaults",
+			policy: createModifiedPolicy(func(p *ngfAPI.DefaultCertificatePolicy) *ngfAPI.DefaultCertificatePolicy {
+				p.Spec.CertificateRef.Group = nil
+				p.Spec.CertificateRef.Kind = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid; ExternalCertificate",
+			policy: createModifiedPolicy(func(p *ngfAPI.DefaultCertificatePolicy) *ngfAPI.DefaultCertificatePolicy {
+				p.Spec.CertificateRef.Group = helpers.GetPointer[v1.Group](ngfAPI.GroupName)
+				p.Spec.CertificateRef.Kind = helpers.GetPointer[v1.Kind](kinds.ExternalCertificate)
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := defaultcertificate.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := defaultcertificate.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	v := defaultcertificate.NewValidator()
+	g := NewWithT(t)
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := defaultcertificate.NewValidator()
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -0,0 +1,158 @@
+package faultinjection
+
+import (
+	"fmt"
+	"text/template"
+	"time"
+	"unicode"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// DelayLocationPath is the path of the internal location that delays the requests. The locations with a delay
+// send an auth subrequest to it, which the faults njs module holds for the duration of the delay.
+const DelayLocationPath = http.InternalRoutePathPrefix + "-fault-delay"
+
+var tmpl = template.Must(template.New("fault injection policy").Parse(faultInjectionTemplate))
+
+// The abort directives run in the rewrite phase, before the delay of the access phase, so the aborted requests
+// are not delayed.
+const faultInjectionTemplate = `
+{{- if .Abort }}
+set $ngf_fault_abort_percentage {{ .Abort.Percentage }};
+if ($ngf_fault_abort) {
+    return {{ .Abort.StatusCode }};
+}
+{{- end }}
+{{- if .Delay }}
+set $ngf_fault_delay_percentage {{ .Delay.Percentage }};
+set $ngf_fault_delay_ms {{ .Delay.Milliseconds }};
+auth_request {{ .Delay.Location }};
+{{- end }}
+`
+
+// faults holds the faults of a FaultInjectionPolicy for the template.
+type faults struct {
+	Abort *abort
+	Delay *delay
+}
+
+type abort struct {
+	Percentage int32
+	StatusCode int32
+}
+
+type delay struct {
+	Location     string
+	Milliseconds int64
+	Percentage   int32
+}
+
+// Generator generates nginx configuration based on a faultinjection policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+// FaultInjectionPolicies only target Routes, so no configuration is generated for the server block.
+func (g Generator) GenerateForServer(_ []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return nil
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// When a normal location redirects to internal locations, the faults are injected in the internal locations,
+// so that the requests are not faulted twice.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	_ http.Location,
+) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		fip, ok := pol.(*ngfAPI.FaultInjectionPolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("FaultInjectionPolicy_%s_%s.conf", fip.Namespace, fip.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, newFaults(fip.Spec)),
+		})
+	}
+
+	return files
+}
+
+func newFaults(spec ngfAPI.FaultInjectionPolicySpec) faults {
+	var f faults
+
+	if spec.Abort != nil {
+		f.Abort = &abort{
+			Percentage: percentageOrDefault(spec.Abort.Percentage),
+			StatusCode: spec.Abort.StatusCode,
+		}
+	}
+
+	if spec.Delay != nil {
+		// the duration is validated by the Validator
+		duration, _ := parseDuration(spec.Delay.Duration)
+
+		f.Delay = &delay{
+			Location:     DelayLocationPath,
+			Milliseconds: duration.Milliseconds(),
+			Percentage:   percentageOrDefault(spec.Delay.Percentage),
+		}
+	}
+
+	return f
+}
+
+// HasDelay returns true if any of the policies is a FaultInjectionPolicy that delays requests.
+// The servers with such policies need the internal location at DelayLocationPath.
+func HasDelay(pols []policies.Policy) bool {
+	for _, pol := range pols {
+		if fip, ok := pol.(*ngfAPI.FaultInjectionPolicy); ok && fip.Spec.Delay != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// percentageOrDefault returns the percentage of the requests to fault. By default, all requests are faulted.
+func percentageOrDefault(percentage *int32) int32 {
+	if percentage == nil {
+		return 100
+	}
+
+	return *percentage
+}
+
+// parseDuration parses the Duration. A Duration without a unit is in seconds.
+func parseDuration(d ngfAPI.Duration) (time.Duration, error) {
+	value := string(d)
+	if value != "" && unicode.IsDigit(rune(value[len(value)-1])) {
+		value += "s"
+	}
+
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,203 @@
+package faultinjection_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/faultinjection"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		spec       ngfAPI.FaultInjectionPolicySpec
+		expStrings []string
+	}{
+		{
+			name: "abort with default percentage",
+			spec: ngfAPI.FaultInjectionPolicySpec{
+				Abort: &ngfAPI.FaultAbort{
+					StatusCode: 503,
+				},
+			},
+			expStrings: []string{
+				"set $ngf_fault_abort_percentage 100;",
+				"if ($ngf_fault_abort) {",
+				"return 503;",
+			},
+		},
+		{
+			name: "abort with percentage",
+			spec: ngfAPI.FaultInjectionPolicySpec{
+				Abort: &ngfAPI.FaultAbort{
+					Percentage: helpers.GetPointer[int32](10),
+					StatusCode: 429,
+				},
+			},
+			expStrings: []string{
+				"set $ngf_fault_abort_percentage 10;",
+				"return 429;",
+			},
+		},
+		{
+			name: "delay with default percentage",
+			spec: ngfAPI.FaultInjectionPolicySpec{
+				Delay: &ngfAPI.FaultDelay{
+					Duration: "2s",
+				},
+			},
+			expStrings: []string{
+				"set $ngf_fault_delay_percentage 100;",
+				"set $ngf_fault_delay_ms 2000;",
+				"auth_request /_ngf-internal-fault-delay;",
+			},
+		},
+		{
+			name: "delay with percentage and duration without unit",
+			spec: ngfAPI.FaultInjectionPolicySpec{
+				Delay: &ngfAPI.FaultDelay{
+					Percentage: helpers.GetPointer[int32](50),
+					Duration:   "3",
+				},
+			},
+			expStrings: []string{
+				"set $ngf_fault_delay_percentage 50;",
+				"set $ngf_fault_delay_ms 3000;",
+			},
+		},
+		{
+			name: "delay and abort",
+			spec: ngfAPI.FaultInjectionPolicySpec{
+				Delay: &ngfAPI.FaultDelay{
+					Duration: "500ms",
+				},
+				Abort: &ngfAPI.FaultAbort{
+					Percentage: helpers.GetPointer[int32](0),
+					StatusCode: 500,
+				},
+			},
+			expStrings: []string{
+				"set $ngf_fault_abort_percentage 0;",
+				"return 500;",
+				"set $ngf_fault_delay_ms 500;",
+				"auth_request /_ngf-internal-fault-delay;",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.FaultInjectionPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-policy",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+
+			generator := faultinjection.NewGenerator()
+
+			g.Expect(generator.GenerateForServer([]policies.Policy{policy}, http.Server{})).To(BeEmpty())
+
+			redirectLocation := http.Location{Type: http.RedirectLocationType}
+			g.Expect(generator.GenerateForLocation([]policies.Policy{policy}, redirectLocation)).To(BeEmpty())
+
+			externalLocation := http.Location{Type: http.ExternalLocationType}
+			internalLocation := http.Location{Type: http.InternalLocationType}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForLocation([]policies.Policy{policy}, externalLocation),
+				generator.GenerateForInternalLocation([]policies.Policy{policy}, internalLocation),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("FaultInjectionPolicy_test_my-policy.conf"))
+
+				content := string(resFiles[0].Content)
+
+				for _, str := range test.expStrings {
+					g.Expect(content).To(ContainSubstring(str))
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := faultinjection.NewGenerator()
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestHasDelay(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		pols   []policies.Policy
+		expect bool
+	}{
+		{
+			name:   "no policies",
+			expect: false,
+		},
+		{
+			name: "policy without delay",
+			pols: []policies.Policy{
+				&ngfAPI.ClientSettingsPolicy{},
+				&ngfAPI.FaultInjectionPolicy{
+					Spec: ngfAPI.FaultInjectionPolicySpec{
+						Abort: &ngfAPI.FaultAbort{StatusCode: 503},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "policy with delay",
+			pols: []policies.Policy{
+				&ngfAPI.FaultInjectionPolicy{
+					Spec: ngfAPI.FaultInjectionPolicySpec{
+						Abort: &ngfAPI.FaultAbort{StatusCode: 503},
+					},
+				},
+				&ngfAPI.FaultInjectionPolicy{
+					Spec: ngfAPI.FaultInjectionPolicySpec{
+						Delay: &ngfAPI.FaultDelay{Duration: "1s"},
+					},
+				},
+			},
+			expect: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(faultinjection.HasDelay(test.pols)).To(Equal(test.expect))
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package faultinjection
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// maxDelay is the max delay that a FaultInjectionPolicy can inject.
+const maxDelay = time.Hour
+
+// Validator validates a FaultInjectionPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a FaultInjectionPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	fip := helpers.MustCastObject[*ngfAPI.FaultInjectionPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute}
+	for _, ref := range fip.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(fip.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two FaultInjectionPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	a := helpers.MustCastObject[*ngfAPI.FaultInjectionPolicy](polA)
+	b := helpers.MustCastObject[*ngfAPI.FaultInjectionPolicy](polB)
+
+	return (a.Spec.Delay != nil && b.Spec.Delay != nil) ||
+		(a.Spec.Abort != nil && b.Spec.Abort != nil)
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.FaultInjectionPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Delay == nil && spec.Abort == nil {
+		allErrs = append(allErrs, field.Required(fieldPath, "at least one of delay or abort must be specified"))
+	}
+
+	if spec.Delay != nil {
+		durationPath := fieldPath.Child("delay").Child("duration")
+		value := string(spec.Delay.Duration)
+
+		if err := v.genericValidator.ValidateNginxDuration(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(durationPath, value, err.Error()))
+		} else if d, err := parseDuration(spec.Delay.Duration); err != nil || d > maxDelay {
+			allErrs = append(allErrs, field.Invalid(durationPath, value, "must not exceed 1h"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
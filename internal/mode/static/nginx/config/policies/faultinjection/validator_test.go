@@ -0,0 +1,223 @@
+package faultinjection_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/faultinjection"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.FaultInjectionPolicy) *ngfAPI.FaultInjectionPolicy
+
+func createValidPolicy() *ngfAPI.FaultInjectionPolicy {
+	return &ngfAPI.FaultInjectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.FaultInjectionPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Delay: &ngfAPI.FaultDelay{
+				Percentage: helpers.GetPointer[int32](50),
+				Duration:   "5s",
+			},
+			Abort: &ngfAPI.FaultAbort{
+				Percentage: helpers.GetPointer[int32](10),
+				StatusCode: 503,
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.FaultInjectionPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.FaultInjectionPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.FaultInjectionPolicy) *ngfAPI.FaultInjectionPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.FaultInjectionPolicy) *ngfAPI.FaultInjectionPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.Gateway
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"Gateway\": " +
+					"supported values: \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid; no faults",
+			policy: createModifiedPolicy(func(p *ngfAPI.FaultInjectionPolicy) *ngfAPI.FaultInjectionPolicy {
+				p.Spec.Delay = nil
+				p.Spec.Abort = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec: Required value: at least one of delay or abort must be specified"),
+			},
+		},
+		{
+			name: "invalid delay duration",
+			policy: createModifiedPolicy(func(p *ngfAPI.FaultInjectionPolicy) *ngfAPI.FaultInjectionPolicy {
+				p.Spec.Delay.Duration = "invalid"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.delay.duration: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')"),
+			},
+		},
+		{
+			name: "invalid delay duration; exceeds max delay",
+			policy: createModifiedPolicy(func(p *ngfAPI.FaultInjectionPolicy) *ngfAPI.FaultInjectionPolicy {
+				p.Spec.Delay.Duration = "61m"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.delay.duration: Invalid value: \"61m\": must not exceed 1h"),
+			},
+		},
+		{
+			name: "valid; max delay",
+			policy: createModifiedPolicy(func(p *ngfAPI.FaultInjectionPolicy) *ngfAPI.FaultInjectionPolicy {
+				p.Spec.Delay.Duration = "1h"
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := faultinjection.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := faultinjection.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.FaultInjectionPolicy
+		polB      *ngfAPI.FaultInjectionPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.FaultInjectionPolicy{
+				Spec: ngfAPI.FaultInjectionPolicySpec{
+					Delay: &ngfAPI.FaultDelay{Duration: "1s"},
+				},
+			},
+			polB: &ngfAPI.FaultInjectionPolicy{
+				Spec: ngfAPI.FaultInjectionPolicySpec{
+					Abort: &ngfAPI.FaultAbort{StatusCode: 503},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "delay conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.FaultInjectionPolicy{
+				Spec: ngfAPI.FaultInjectionPolicySpec{
+					Delay: &ngfAPI.FaultDelay{Duration: "1s"},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "abort conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.FaultInjectionPolicy{
+				Spec: ngfAPI.FaultInjectionPolicySpec{
+					Abort: &ngfAPI.FaultAbort{StatusCode: 503},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := faultinjection.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := faultinjection.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -1,10 +1,13 @@
 package policies
 
 import (
+	"bytes"
+
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 )
 
 // Generator defines an interface for a policy to implement its appropriate generator functions.
+// The functions are called concurrently for different servers, so they must be safe for concurrent use.
 //
 //counterfeiter:generate . Generator
 type Generator interface {
@@ -13,7 +16,7 @@ type Generator interface {
 	// GenerateForServer generates policy configuration for a normal location block.
 	GenerateForLocation(policies []Policy, location http.Location) GenerateResultFiles
 	// GenerateForInternalLocation generates policy configuration for an internal location block.
-	GenerateForInternalLocation(policies []Policy) GenerateResultFiles
+	GenerateForInternalLocation(policies []Policy, location http.Location) GenerateResultFiles
 }
 
 // GenerateResultFiles is a list of files generated for inclusion by policy generators.
@@ -25,7 +28,27 @@ type File struct {
 	Content []byte
 }
 
+// IsEmpty returns true if the content has no configuration, meaning it is empty or consists of whitespace only.
+// Generators must not return files with such content, so that no empty files are written and included.
+func IsEmpty(content []byte) bool {
+	return len(bytes.TrimSpace(content)) == 0
+}
+
+// PruneEmptyFiles returns the files without the files that have no configuration.
+func PruneEmptyFiles(files GenerateResultFiles) GenerateResultFiles {
+	var pruned GenerateResultFiles
+
+	for _, file := range files {
+		if !IsEmpty(file.Content) {
+			pruned = append(pruned, file)
+		}
+	}
+
+	return pruned
+}
+
 // CompositeGenerator contains all policy generators.
+// It drops the files that have no configuration from the results of the generators.
 type CompositeGenerator struct {
 	generators []Generator
 }
@@ -40,7 +63,7 @@ func (g *CompositeGenerator) GenerateForServer(policies []Policy, server http.Se
 	var compositeResult GenerateResultFiles
 
 	for _, generator := range g.generators {
-		compositeResult = append(compositeResult, generator.GenerateForServer(policies, server)...)
+		compositeResult = append(compositeResult, PruneEmptyFiles(generator.GenerateForServer(policies, server))...)
 	}
 
 	return compositeResult
@@ -51,18 +74,21 @@ func (g *CompositeGenerator) GenerateForLocation(policies []Policy, location htt
 	var compositeResult GenerateResultFiles
 
 	for _, generator := range g.generators {
-		compositeResult = append(compositeResult, generator.GenerateForLocation(policies, location)...)
+		compositeResult = append(compositeResult, PruneEmptyFiles(generator.GenerateForLocation(policies, location))...)
 	}
 
 	return compositeResult
 }
 
 // GenerateForInternalLocation calls all policy generators for an internal location block.
-func (g *CompositeGenerator) GenerateForInternalLocation(policies []Policy) GenerateResultFiles {
+func (g *CompositeGenerator) GenerateForInternalLocation(
+	policies []Policy,
+	location http.Location,
+) GenerateResultFiles {
 	var compositeResult GenerateResultFiles
 
 	for _, generator := range g.generators {
-		compositeResult = append(compositeResult, generator.GenerateForInternalLocation(policies)...)
+		compositeResult = append(compositeResult, PruneEmptyFiles(generator.GenerateForInternalLocation(policies, location))...)
 	}
 
 	return compositeResult
@@ -80,6 +106,6 @@ func (u UnimplementedGenerator) GenerateForLocation(_ []Policy, _ http.Location)
 	return nil
 }
 
-func (u UnimplementedGenerator) GenerateForInternalLocation(_ []Policy) GenerateResultFiles {
+func (u UnimplementedGenerator) GenerateForInternalLocation(_ []Policy, _ http.Location) GenerateResultFiles {
 	return nil
 }
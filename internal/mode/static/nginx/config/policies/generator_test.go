@@ -60,10 +60,53 @@ var _ = Describe("Policy Generator", func() {
 				{Name: "gen2IntLocation", Content: []byte("gen2IntLocation-content")},
 			}
 
-			Expect(generator.GenerateForInternalLocation(nil)).To(BeEquivalentTo(expFiles))
+			Expect(generator.GenerateForInternalLocation(nil, http.Location{})).To(BeEquivalentTo(expFiles))
 		})
 	})
 
+	Context("Composite Generator with empty results", func() {
+		fakeGen := &policiesfakes.FakeGenerator{}
+
+		fakeGen.GenerateForServerReturns(policies.GenerateResultFiles{
+			{Name: "empty"},
+			{Name: "whitespace", Content: []byte("\n\t  \n")},
+			{Name: "server", Content: []byte("server-content")},
+		})
+		fakeGen.GenerateForLocationReturns(policies.GenerateResultFiles{
+			{Name: "whitespace", Content: []byte(" \n")},
+		})
+		fakeGen.GenerateForInternalLocationReturns(policies.GenerateResultFiles{
+			{Name: "empty", Content: []byte{}},
+		})
+
+		generator := policies.NewCompositeGenerator(fakeGen)
+
+		It("drops the files without configuration for the server", func() {
+			expFiles := policies.GenerateResultFiles{
+				{Name: "server", Content: []byte("server-content")},
+			}
+
+			Expect(generator.GenerateForServer(nil, http.Server{})).To(BeEquivalentTo(expFiles))
+		})
+
+		It("returns no files for the location", func() {
+			Expect(generator.GenerateForLocation(nil, http.Location{})).To(BeEmpty())
+		})
+
+		It("returns no files for the internal location", func() {
+			Expect(generator.GenerateForInternalLocation(nil, http.Location{})).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("IsEmpty",
+		func(content []byte, expEmpty bool) {
+			Expect(policies.IsEmpty(content)).To(Equal(expEmpty))
+		},
+		Entry("nil content", nil, true),
+		Entry("whitespace only content", []byte("\n  \t\n"), true),
+		Entry("content with a directive", []byte("\nlimit_rate 10k;\n"), false),
+	)
+
 	Context("Unimplemented Generator", func() {
 		generator := policies.UnimplementedGenerator{}
 
@@ -76,7 +119,7 @@ var _ = Describe("Policy Generator", func() {
 		})
 
 		It("returns nil for GenerateForInternalLocation", func() {
-			Expect(generator.GenerateForInternalLocation(nil)).To(BeNil())
+			Expect(generator.GenerateForInternalLocation(nil, http.Location{})).To(BeNil())
 		})
 	})
 })
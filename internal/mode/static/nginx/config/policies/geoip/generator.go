@@ -0,0 +1,139 @@
+package geoip
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// CountryCodeVariable is the variable of the geoip2 block of the http context that holds the country code
+// of the client.
+const CountryCodeVariable = "$geoip2_country_code"
+
+// fieldVariables maps the fields of the location of the client to the variables of the geoip2 block
+// of the http context.
+var fieldVariables = map[ngfAPI.GeoIPField]string{
+	ngfAPI.GeoIPFieldCountryCode:     CountryCodeVariable,
+	ngfAPI.GeoIPFieldCountryName:     "$geoip2_country_name",
+	ngfAPI.GeoIPFieldContinentCode:   "$geoip2_continent_code",
+	ngfAPI.GeoIPFieldCityName:        "$geoip2_city_name",
+	ngfAPI.GeoIPFieldSubdivisionCode: "$geoip2_subdivision_code",
+}
+
+// FieldVariable returns the variable of the geoip2 block of the http context that holds the field
+// of the location of the client, and false if the field is not supported.
+func FieldVariable(field ngfAPI.GeoIPField) (string, bool) {
+	variable, ok := fieldVariables[field]
+	return variable, ok
+}
+
+var tmpl = template.Must(template.New("geoip policy").Parse(geoIPTemplate))
+
+const geoIPTemplate = `
+{{- if .AllowCountries }}
+if ({{ .CountryCodeVariable }} !~ "^({{ .AllowCountries }})$") {
+    return 403;
+}
+{{- end }}
+{{- if .BlockCountries }}
+if ({{ .CountryCodeVariable }} ~ "^({{ .BlockCountries }})$") {
+    return 403;
+}
+{{- end }}
+{{- range $h := .RequestHeaders }}
+{{ $.HeaderDirective }} {{ $h.Name }} {{ $h.Value }};
+{{- end }}
+`
+
+// geoIP holds the data for the GeoIP policy template.
+type geoIP struct {
+	CountryCodeVariable string
+	AllowCountries      string
+	BlockCountries      string
+	HeaderDirective     string
+	RequestHeaders      []http.Header
+}
+
+// Generator generates nginx configuration based on a GeoIP policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+// GeoIPPolicies only target Routes, so no configuration is generated for the server block.
+func (g Generator) GenerateForServer(_ []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return nil
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// When a normal location redirects to internal locations, the requests are checked, and the headers are set,
+// in the internal locations that proxy the requests.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols, location)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	location http.Location,
+) policies.GenerateResultFiles {
+	return generate(pols, location)
+}
+
+func generate(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	headerDirective := "proxy_set_header"
+	if location.GRPC {
+		headerDirective = "grpc_set_header"
+	}
+
+	for _, pol := range pols {
+		gp, ok := pol.(*ngfAPI.GeoIPPolicy)
+		if !ok {
+			continue
+		}
+
+		data := geoIP{
+			CountryCodeVariable: CountryCodeVariable,
+			AllowCountries:      joinCountries(gp.Spec.AllowCountries),
+			BlockCountries:      joinCountries(gp.Spec.BlockCountries),
+			HeaderDirective:     headerDirective,
+		}
+
+		for _, header := range gp.Spec.RequestHeaders {
+			data.RequestHeaders = append(data.RequestHeaders, http.Header{
+				Name:  header.Name,
+				Value: fieldVariables[header.Field],
+			})
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("GeoIPPolicy_%s_%s.conf", gp.Namespace, gp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, data),
+		})
+	}
+
+	return files
+}
+
+func joinCountries(countries []ngfAPI.CountryCode) string {
+	codes := make([]string, 0, len(countries))
+	for _, country := range countries {
+		codes = append(codes, string(country))
+	}
+
+	return strings.Join(codes, "|")
+}
@@ -0,0 +1,114 @@
+package geoip_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/geoip"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		expContent string
+		spec       ngfAPI.GeoIPPolicySpec
+		grpc       bool
+	}{
+		{
+			name: "allow countries",
+			spec: ngfAPI.GeoIPPolicySpec{
+				AllowCountries: []ngfAPI.CountryCode{"US", "CA"},
+			},
+			expContent: "\nif ($geoip2_country_code !~ \"^(US|CA)$\") {\n    return 403;\n}\n",
+		},
+		{
+			name: "block countries",
+			spec: ngfAPI.GeoIPPolicySpec{
+				BlockCountries: []ngfAPI.CountryCode{"KP"},
+			},
+			expContent: "\nif ($geoip2_country_code ~ \"^(KP)$\") {\n    return 403;\n}\n",
+		},
+		{
+			name: "request headers",
+			spec: ngfAPI.GeoIPPolicySpec{
+				BlockCountries: []ngfAPI.CountryCode{"KP"},
+				RequestHeaders: []ngfAPI.GeoIPRequestHeader{
+					{Name: "X-Country", Field: ngfAPI.GeoIPFieldCountryCode},
+					{Name: "X-City", Field: ngfAPI.GeoIPFieldCityName},
+				},
+			},
+			expContent: "\nif ($geoip2_country_code ~ \"^(KP)$\") {\n    return 403;\n}\n" +
+				"proxy_set_header X-Country $geoip2_country_code;\n" +
+				"proxy_set_header X-City $geoip2_city_name;\n",
+		},
+		{
+			name: "request headers for gRPC",
+			spec: ngfAPI.GeoIPPolicySpec{
+				RequestHeaders: []ngfAPI.GeoIPRequestHeader{
+					{Name: "X-Continent", Field: ngfAPI.GeoIPFieldContinentCode},
+				},
+			},
+			grpc:       true,
+			expContent: "\ngrpc_set_header X-Continent $geoip2_continent_code;\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.GeoIPPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-policy",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+
+			generator := geoip.NewGenerator()
+
+			g.Expect(generator.GenerateForServer([]policies.Policy{policy}, http.Server{})).To(BeEmpty())
+
+			redirectLocation := http.Location{Type: http.RedirectLocationType, GRPC: test.grpc}
+			g.Expect(generator.GenerateForLocation([]policies.Policy{policy}, redirectLocation)).To(BeEmpty())
+
+			externalLocation := http.Location{Type: http.ExternalLocationType, GRPC: test.grpc}
+			internalLocation := http.Location{Type: http.InternalLocationType, GRPC: test.grpc}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForLocation([]policies.Policy{policy}, externalLocation),
+				generator.GenerateForInternalLocation([]policies.Policy{policy}, internalLocation),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("GeoIPPolicy_test_my-policy.conf"))
+				g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+			}
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := geoip.NewGenerator()
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,138 @@
+package geoip
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	countryCodeRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+	headerNameRegexp  = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+)
+
+// Validator validates a GeoIPPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a GeoIPPolicy.
+func (v *Validator) Validate(policy policies.Policy, globalSettings *policies.GlobalSettings) []conditions.Condition {
+	gp := helpers.MustCastObject[*ngfAPI.GeoIPPolicy](policy)
+
+	if globalSettings == nil || !globalSettings.NginxProxyValid {
+		return []conditions.Condition{
+			staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageNginxProxyInvalid),
+		}
+	}
+
+	if !globalSettings.GeoIPEnabled {
+		return []conditions.Condition{
+			staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageGeoIPNotEnabled),
+		}
+	}
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute, kinds.GRPCRoute}
+	for _, ref := range gp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := validateSettings(gp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two GeoIPPolicies conflict. GeoIPPolicies always conflict, because the countries
+// of one policy would reject the requests that the countries of another policy allow.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.GeoIPPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if len(spec.AllowCountries) > 0 && len(spec.BlockCountries) > 0 {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(fieldPath.Child("blockCountries"), "cannot be specified with allowCountries"),
+		)
+	}
+
+	if len(spec.AllowCountries) == 0 && len(spec.BlockCountries) == 0 && len(spec.RequestHeaders) == 0 {
+		allErrs = append(
+			allErrs,
+			field.Required(fieldPath, "at least one of allowCountries, blockCountries, or requestHeaders must be specified"),
+		)
+	}
+
+	allErrs = append(allErrs, validateCountries(fieldPath.Child("allowCountries"), spec.AllowCountries)...)
+	allErrs = append(allErrs, validateCountries(fieldPath.Child("blockCountries"), spec.BlockCountries)...)
+
+	headersPath := fieldPath.Child("requestHeaders")
+	for i, header := range spec.RequestHeaders {
+		if !headerNameRegexp.MatchString(header.Name) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					headersPath.Index(i).Child("name"),
+					header.Name,
+					"must contain only alphanumeric characters or '-'",
+				),
+			)
+		}
+
+		if _, ok := fieldVariables[header.Field]; !ok {
+			allErrs = append(
+				allErrs,
+				field.NotSupported(
+					headersPath.Index(i).Child("field"),
+					header.Field,
+					[]ngfAPI.GeoIPField{
+						ngfAPI.GeoIPFieldCountryCode,
+						ngfAPI.GeoIPFieldCountryName,
+						ngfAPI.GeoIPFieldContinentCode,
+						ngfAPI.GeoIPFieldCityName,
+						ngfAPI.GeoIPFieldSubdivisionCode,
+					},
+				),
+			)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateCountries(path *field.Path, countries []ngfAPI.CountryCode) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, country := range countries {
+		if !countryCodeRegexp.MatchString(string(country)) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(path.Index(i), country, "must be an ISO 3166-1 alpha-2 country code, such as US"),
+			)
+		}
+	}
+
+	return allErrs
+}
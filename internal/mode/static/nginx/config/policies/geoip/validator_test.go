@@ -0,0 +1,181 @@
+package geoip_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/geoip"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.GeoIPPolicy) *ngfAPI.GeoIPPolicy
+
+func createValidPolicy() *ngfAPI.GeoIPPolicy {
+	return &ngfAPI.GeoIPPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.GeoIPPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			AllowCountries: []ngfAPI.CountryCode{"US", "CA"},
+			RequestHeaders: []ngfAPI.GeoIPRequestHeader{
+				{Name: "X-Country", Field: ngfAPI.GeoIPFieldCountryCode},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.GeoIPPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	globalSettings := &policies.GlobalSettings{
+		NginxProxyValid: true,
+		GeoIPEnabled:    true,
+	}
+
+	tests := []struct {
+		name           string
+		policy         *ngfAPI.GeoIPPolicy
+		globalSettings *policies.GlobalSettings
+		expConditions  []conditions.Condition
+	}{
+		{
+			name:   "validation context is nil",
+			policy: createValidPolicy(),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageNginxProxyInvalid),
+			},
+		},
+		{
+			name:           "geoIP is not enabled",
+			policy:         createValidPolicy(),
+			globalSettings: &policies.GlobalSettings{NginxProxyValid: true},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageGeoIPNotEnabled),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.GeoIPPolicy) *ngfAPI.GeoIPPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.Gateway
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"Gateway\": " +
+					"supported values: \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid; allow and block countries",
+			policy: createModifiedPolicy(func(p *ngfAPI.GeoIPPolicy) *ngfAPI.GeoIPPolicy {
+				p.Spec.BlockCountries = []ngfAPI.CountryCode{"KP"}
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.blockCountries: Forbidden: cannot be specified with allowCountries"),
+			},
+		},
+		{
+			name: "invalid; no settings",
+			policy: createModifiedPolicy(func(p *ngfAPI.GeoIPPolicy) *ngfAPI.GeoIPPolicy {
+				p.Spec.AllowCountries = nil
+				p.Spec.RequestHeaders = nil
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec: Required value: at least one of allowCountries, " +
+					"blockCountries, or requestHeaders must be specified"),
+			},
+		},
+		{
+			name: "invalid countries and headers",
+			policy: createModifiedPolicy(func(p *ngfAPI.GeoIPPolicy) *ngfAPI.GeoIPPolicy {
+				p.Spec.AllowCountries = []ngfAPI.CountryCode{"US", `US|.*`}
+				p.Spec.RequestHeaders = []ngfAPI.GeoIPRequestHeader{
+					{Name: "X-Country $host", Field: "Latitude"},
+				}
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.allowCountries[1]: Invalid value: \"US|.*\": " +
+					"must be an ISO 3166-1 alpha-2 country code, such as US, spec.requestHeaders[0].name: " +
+					"Invalid value: \"X-Country $host\": must contain only alphanumeric characters or '-', " +
+					"spec.requestHeaders[0].field: Unsupported value: \"Latitude\": supported values: " +
+					"\"CountryCode\", \"CountryName\", \"ContinentCode\", \"CityName\", \"SubdivisionCode\"]"),
+			},
+		},
+		{
+			name: "valid; block countries",
+			policy: createModifiedPolicy(func(p *ngfAPI.GeoIPPolicy) *ngfAPI.GeoIPPolicy {
+				p.Spec.AllowCountries = nil
+				p.Spec.BlockCountries = []ngfAPI.CountryCode{"KP"}
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions:  nil,
+		},
+		{
+			name:           "valid",
+			policy:         createValidPolicy(),
+			globalSettings: globalSettings,
+			expConditions:  nil,
+		},
+	}
+
+	v := geoip.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, test.globalSettings)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := geoip.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := geoip.NewValidator()
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
@@ -0,0 +1,128 @@
+package policies
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
+)
+
+// Merger merges the Policies of an Inherited Policy kind.
+//
+//counterfeiter:generate . Merger
+type Merger interface {
+	// Merge returns a copy of the child Policy, in which the fields that are not set are inherited from the parent
+	// Policy. The parent Policy is attached to a less specific target than the child Policy, or to the same target.
+	// Merge must not modify the Policies.
+	Merge(parent, child Policy) Policy
+}
+
+// EffectivePolicies returns the effective Policies of a target, computed from the Policies attached to the levels
+// of the hierarchy of the target. The levels are ordered from the least specific one, such as the Gateway,
+// to the most specific one, such as a Route. The Policies of a level must be valid and must not conflict.
+//
+// For every kind of Policy attached to the most specific level, EffectivePolicies returns:
+//   - for an Inherited Policy kind (with a registered Merger), a single Policy in which the fields set at a more
+//     specific level override the fields set at a less specific level. The Policy is the newest Policy of the most
+//     specific level, with the inherited fields merged into it.
+//   - for any other kind, the Policies of the most specific level, which are not inherited.
+//
+// The kinds of Policies that are not attached to the most specific level are not returned, because the target
+// inherits them as they are, except for the location scoped kinds, which the target doesn't inherit from its
+// server block. They are returned as for the kinds attached to the most specific level.
+func (m *CompositeValidator) EffectivePolicies(levels ...[]Policy) []Policy {
+	if len(levels) == 0 {
+		return nil
+	}
+
+	attached := levels[len(levels)-1]
+
+	// The GVKs are kept in the order in which they are first attached, so that the result is stable.
+	// The location scoped GVKs that are only attached to the less specific levels follow them.
+	gvks := make([]schema.GroupVersionKind, 0, len(attached))
+	for _, pol := range attached {
+		if gvk := m.mustExtractGVK(pol); !slices.Contains(gvks, gvk) {
+			gvks = append(gvks, gvk)
+		}
+	}
+
+	for _, level := range levels[:len(levels)-1] {
+		for _, pol := range level {
+			gvk := m.mustExtractGVK(pol)
+			if _, scoped := m.locationScoped[gvk]; scoped && !slices.Contains(gvks, gvk) {
+				gvks = append(gvks, gvk)
+			}
+		}
+	}
+
+	if len(gvks) == 0 {
+		return nil
+	}
+
+	effective := make([]Policy, 0, len(gvks))
+
+	for _, gvk := range gvks {
+		merger, inherited := m.mergers[gvk]
+		if !inherited {
+			for _, pol := range attached {
+				if m.mustExtractGVK(pol) == gvk {
+					effective = append(effective, pol)
+				}
+			}
+
+			continue
+		}
+
+		var result Policy
+
+		for _, level := range levels {
+			for _, pol := range m.sortedPoliciesOfKind(level, gvk) {
+				if result == nil {
+					result = pol
+					continue
+				}
+
+				result = merger.Merge(result, pol)
+			}
+		}
+
+		effective = append(effective, result)
+	}
+
+	return effective
+}
+
+// sortedPoliciesOfKind returns the Policies of the kind from oldest to newest.
+func (m *CompositeValidator) sortedPoliciesOfKind(pols []Policy, gvk schema.GroupVersionKind) []Policy {
+	var result []Policy
+
+	for _, pol := range pols {
+		if m.mustExtractGVK(pol) == gvk {
+			result = append(result, pol)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b Policy) int {
+		switch {
+		case ngfsort.LessClientObject(a, b):
+			return -1
+		case ngfsort.LessClientObject(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return result
+}
+
+// Inherit returns the child setting if it is set, and the parent setting otherwise.
+// Mergers use it to merge the settings of the Policies.
+func Inherit[T any](parent, child *T) *T {
+	if child != nil {
+		return child
+	}
+
+	return parent
+}
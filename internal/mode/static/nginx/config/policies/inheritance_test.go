@@ -0,0 +1,128 @@
+package policies_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+)
+
+var _ = Describe("Policy inheritance", func() {
+	appleGVK := schema.GroupVersionKind{Group: "fruit", Version: "1", Kind: "apple"}
+	orangeGVK := schema.GroupVersionKind{Group: "fruit", Version: "1", Kind: "orange"}
+	pearGVK := schema.GroupVersionKind{Group: "fruit", Version: "1", Kind: "pear"}
+
+	createPolicy := func(kind, name string) *policiesfakes.FakePolicy {
+		return &policiesfakes.FakePolicy{
+			GetNameStub: func() string {
+				return name
+			},
+			GetNamespaceStub: func() string {
+				return kind
+			},
+		}
+	}
+
+	mustExtractGVK := func(object client.Object) schema.GroupVersionKind {
+		switch object.GetNamespace() {
+		case "orange":
+			return orangeGVK
+		case "pear":
+			return pearGVK
+		default:
+			return appleGVK
+		}
+	}
+
+	// The merged Policy is named after the Policies it merges, from the least specific to the most specific.
+	createMerger := func(kind string) *policiesfakes.FakeMerger {
+		return &policiesfakes.FakeMerger{
+			MergeStub: func(parent, child policies.Policy) policies.Policy {
+				return createPolicy(kind, parent.GetName()+"+"+child.GetName())
+			},
+		}
+	}
+
+	mgr := policies.NewManager(
+		mustExtractGVK,
+		policies.ManagerConfig{
+			Validator: &policiesfakes.FakeValidator{},
+			Merger:    createMerger("apple"),
+			GVK:       appleGVK,
+		},
+		policies.ManagerConfig{
+			Validator: &policiesfakes.FakeValidator{},
+			GVK:       orangeGVK,
+		},
+		policies.ManagerConfig{
+			Validator:      &policiesfakes.FakeValidator{},
+			Merger:         createMerger("pear"),
+			GVK:            pearGVK,
+			LocationScoped: true,
+		},
+	)
+
+	names := func(pols []policies.Policy) []string {
+		result := make([]string, 0, len(pols))
+		for _, pol := range pols {
+			result = append(result, pol.GetName())
+		}
+
+		return result
+	}
+
+	gwApple1 := createPolicy("apple", "gw-apple-1")
+	gwApple2 := createPolicy("apple", "gw-apple-2")
+	gwOrange := createPolicy("orange", "gw-orange")
+	routeApple := createPolicy("apple", "route-apple")
+	routeOrange1 := createPolicy("orange", "route-orange-1")
+	routeOrange2 := createPolicy("orange", "route-orange-2")
+	gwPear := createPolicy("pear", "gw-pear")
+	listenerPear := createPolicy("pear", "listener-pear")
+	routePear := createPolicy("pear", "route-pear")
+
+	DescribeTable("EffectivePolicies",
+		func(levels [][]policies.Policy, expNames []string) {
+			Expect(names(mgr.EffectivePolicies(levels...))).To(Equal(expNames))
+		},
+		Entry("no levels", nil, []string{}),
+		Entry(
+			"no policies attached to the most specific level",
+			[][]policies.Policy{{gwApple1, gwOrange}, nil},
+			[]string{},
+		),
+		Entry(
+			"single level; policies of the same inherited kind are merged",
+			[][]policies.Policy{{gwOrange, gwApple2, gwApple1}},
+			[]string{"gw-orange", "gw-apple-1+gw-apple-2"},
+		),
+		Entry(
+			"inherited kind; the most specific policy overrides the less specific ones",
+			[][]policies.Policy{{gwApple1, gwApple2}, {routeApple}},
+			[]string{"gw-apple-1+gw-apple-2+route-apple"},
+		),
+		Entry(
+			"kinds that are not inherited are returned from the most specific level only",
+			[][]policies.Policy{{gwApple1, gwOrange}, {routeOrange1, routeOrange2}},
+			[]string{"route-orange-1", "route-orange-2"},
+		),
+		Entry(
+			"location scoped kinds are returned even if they are not attached to the most specific level",
+			[][]policies.Policy{{gwApple1, gwPear}, {listenerPear}, nil},
+			[]string{"gw-pear+listener-pear"},
+		),
+		Entry(
+			"location scoped kinds follow the kinds attached to the most specific level",
+			[][]policies.Policy{{gwPear}, {routeApple}},
+			[]string{"route-apple", "gw-pear"},
+		),
+		Entry(
+			"location scoped kind attached to the most specific level",
+			[][]policies.Policy{{gwPear}, {routeOrange1, routePear}},
+			[]string{"route-orange-1", "gw-pear+route-pear"},
+		),
+	)
+})
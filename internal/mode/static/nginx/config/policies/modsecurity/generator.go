@@ -0,0 +1,166 @@
+package modsecurity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// rulesFile is the file of the NGINX image that configures ModSecurity and includes the OWASP Core Rule Set.
+const rulesFile = "/etc/nginx/modsecurity/main.conf"
+
+var tmpl = template.Must(template.New("modsecurity policy").Parse(modSecurityTemplate))
+
+// The paranoia level must be set before the Core Rule Set is loaded, and the rules can only be removed
+// after they are loaded.
+const modSecurityTemplate = `
+modsecurity on;
+{{- if .ParanoiaLevel }}
+modsecurity_rules 'SecAction "id:900000,phase:1,pass,nolog,setvar:tx.blocking_paranoia_level={{ .ParanoiaLevel }}"';
+{{- end }}
+modsecurity_rules_file {{ .RulesFile }};
+modsecurity_rules 'SecRuleEngine {{ .RuleEngine }}';
+{{- if .RemovedRuleIDs }}
+modsecurity_rules 'SecRuleRemoveById {{ .RemovedRuleIDs }}';
+{{- end }}
+{{- range $tag := .RemovedTags }}
+modsecurity_rules 'SecRuleRemoveByTag "{{ $tag }}"';
+{{- end }}
+{{- if .AuditLog }}
+modsecurity_rules 'SecAuditEngine {{ .AuditLog.Engine }}';
+modsecurity_rules 'SecAuditLogFormat {{ .AuditLog.Format }}';
+modsecurity_rules 'SecAuditLogType {{ .AuditLog.Type }}';
+modsecurity_rules 'SecAuditLog {{ .AuditLog.Destination }}';
+{{- else }}
+modsecurity_rules 'SecAuditEngine Off';
+{{- end }}
+`
+
+// modSecuritySettings holds the data for the ModSecurity policy template.
+type modSecuritySettings struct {
+	AuditLog       *auditLog
+	RulesFile      string
+	RuleEngine     string
+	RemovedRuleIDs string
+	RemovedTags    []string
+	ParanoiaLevel  int32
+}
+
+type auditLog struct {
+	Engine      string
+	Format      string
+	Type        string
+	Destination string
+}
+
+// Generator generates nginx configuration based on a ModSecurity policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+// ModSecurityPolicies only target Routes, so no configuration is generated for the server block.
+func (g Generator) GenerateForServer(_ []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return nil
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// When a normal location redirects to internal locations, the requests are inspected in the internal locations,
+// so that the requests are not inspected twice.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	_ http.Location,
+) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		msp, ok := pol.(*ngfAPI.ModSecurityPolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ModSecurityPolicy_%s_%s.conf", msp.Namespace, msp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, newSettings(msp.Spec)),
+		})
+	}
+
+	return files
+}
+
+func newSettings(spec ngfAPI.ModSecurityPolicySpec) modSecuritySettings {
+	settings := modSecuritySettings{
+		RulesFile:  rulesFile,
+		RuleEngine: "On",
+	}
+
+	if spec.Mode != nil && *spec.Mode == ngfAPI.ModSecurityModeDetect {
+		settings.RuleEngine = "DetectionOnly"
+	}
+
+	if spec.ParanoiaLevel != nil {
+		settings.ParanoiaLevel = *spec.ParanoiaLevel
+	}
+
+	if spec.RuleExclusions != nil {
+		ids := make([]string, 0, len(spec.RuleExclusions.RuleIDs))
+		for _, id := range spec.RuleExclusions.RuleIDs {
+			ids = append(ids, strconv.Itoa(int(id)))
+		}
+
+		settings.RemovedRuleIDs = strings.Join(ids, " ")
+		settings.RemovedTags = spec.RuleExclusions.Tags
+	}
+
+	if spec.AuditLog != nil {
+		settings.AuditLog = newAuditLog(*spec.AuditLog)
+	}
+
+	return settings
+}
+
+func newAuditLog(spec ngfAPI.ModSecurityAuditLog) *auditLog {
+	log := &auditLog{
+		Engine:      "RelevantOnly",
+		Format:      "JSON",
+		Type:        "Serial",
+		Destination: "/dev/stdout",
+	}
+
+	if spec.Relevance != nil && *spec.Relevance == ngfAPI.ModSecurityAuditLogRelevanceAll {
+		log.Engine = "On"
+	}
+
+	if spec.Format != nil && *spec.Format == ngfAPI.ModSecurityAuditLogFormatNative {
+		log.Format = "Native"
+	}
+
+	if spec.Destination == ngfAPI.ModSecurityAuditLogDestinationHTTPS && spec.URL != nil {
+		log.Type = "HTTPS"
+		log.Destination = *spec.URL
+	}
+
+	return log
+}
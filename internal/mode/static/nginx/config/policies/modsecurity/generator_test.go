@@ -0,0 +1,161 @@
+package modsecurity_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/modsecurity"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		spec          ngfAPI.ModSecurityPolicySpec
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "defaults",
+			spec: ngfAPI.ModSecurityPolicySpec{},
+			expStrings: []string{
+				"modsecurity on;",
+				"modsecurity_rules_file /etc/nginx/modsecurity/main.conf;",
+				"modsecurity_rules 'SecRuleEngine On';",
+				"modsecurity_rules 'SecAuditEngine Off';",
+			},
+			notExpStrings: []string{
+				"blocking_paranoia_level",
+				"SecRuleRemoveById",
+				"SecRuleRemoveByTag",
+				"SecAuditLog",
+			},
+		},
+		{
+			name: "detect mode and paranoia level",
+			spec: ngfAPI.ModSecurityPolicySpec{
+				Mode:          helpers.GetPointer(ngfAPI.ModSecurityModeDetect),
+				ParanoiaLevel: helpers.GetPointer[int32](3),
+			},
+			expStrings: []string{
+				"modsecurity_rules 'SecAction \"id:900000,phase:1,pass,nolog," +
+					"setvar:tx.blocking_paranoia_level=3\"';\n" +
+					"modsecurity_rules_file /etc/nginx/modsecurity/main.conf;",
+				"modsecurity_rules 'SecRuleEngine DetectionOnly';",
+			},
+		},
+		{
+			name: "rule exclusions",
+			spec: ngfAPI.ModSecurityPolicySpec{
+				RuleExclusions: &ngfAPI.ModSecurityRuleExclusions{
+					RuleIDs: []int32{942100, 920350},
+					Tags:    []string{"attack-sqli", "OWASP_CRS/ATTACK-XSS"},
+				},
+			},
+			expStrings: []string{
+				"modsecurity_rules 'SecRuleRemoveById 942100 920350';",
+				"modsecurity_rules 'SecRuleRemoveByTag \"attack-sqli\"';",
+				"modsecurity_rules 'SecRuleRemoveByTag \"OWASP_CRS/ATTACK-XSS\"';",
+			},
+		},
+		{
+			name: "audit log to stdout with defaults",
+			spec: ngfAPI.ModSecurityPolicySpec{
+				AuditLog: &ngfAPI.ModSecurityAuditLog{
+					Destination: ngfAPI.ModSecurityAuditLogDestinationStdout,
+				},
+			},
+			expStrings: []string{
+				"modsecurity_rules 'SecAuditEngine RelevantOnly';",
+				"modsecurity_rules 'SecAuditLogFormat JSON';",
+				"modsecurity_rules 'SecAuditLogType Serial';",
+				"modsecurity_rules 'SecAuditLog /dev/stdout';",
+			},
+		},
+		{
+			name: "audit log to HTTPS collector",
+			spec: ngfAPI.ModSecurityPolicySpec{
+				AuditLog: &ngfAPI.ModSecurityAuditLog{
+					Relevance:   helpers.GetPointer(ngfAPI.ModSecurityAuditLogRelevanceAll),
+					Format:      helpers.GetPointer(ngfAPI.ModSecurityAuditLogFormatNative),
+					Destination: ngfAPI.ModSecurityAuditLogDestinationHTTPS,
+					URL:         helpers.GetPointer("https://collector.example.com:8443/audit"),
+				},
+			},
+			expStrings: []string{
+				"modsecurity_rules 'SecAuditEngine On';",
+				"modsecurity_rules 'SecAuditLogFormat Native';",
+				"modsecurity_rules 'SecAuditLogType HTTPS';",
+				"modsecurity_rules 'SecAuditLog https://collector.example.com:8443/audit';",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.ModSecurityPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-policy",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+
+			generator := modsecurity.NewGenerator()
+
+			g.Expect(generator.GenerateForServer([]policies.Policy{policy}, http.Server{})).To(BeEmpty())
+
+			redirectLocation := http.Location{Type: http.RedirectLocationType}
+			g.Expect(generator.GenerateForLocation([]policies.Policy{policy}, redirectLocation)).To(BeEmpty())
+
+			externalLocation := http.Location{Type: http.ExternalLocationType}
+			internalLocation := http.Location{Type: http.InternalLocationType}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForLocation([]policies.Policy{policy}, externalLocation),
+				generator.GenerateForInternalLocation([]policies.Policy{policy}, internalLocation),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("ModSecurityPolicy_test_my-policy.conf"))
+
+				content := string(resFiles[0].Content)
+
+				for _, str := range test.expStrings {
+					g.Expect(content).To(ContainSubstring(str))
+				}
+
+				for _, str := range test.notExpStrings {
+					g.Expect(content).ToNot(ContainSubstring(str))
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := modsecurity.NewGenerator()
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
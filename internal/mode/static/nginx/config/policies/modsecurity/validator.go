@@ -0,0 +1,170 @@
+package modsecurity
+
+import (
+	"regexp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// The tags and the URL are written into quoted ModSecurity rules, so they are restricted to characters
+// that can't break out of the quotes.
+var (
+	tagRegexp = regexp.MustCompile(`^[a-zA-Z0-9._/-]+$`)
+	urlRegexp = regexp.MustCompile(`^https://[a-zA-Z0-9.-]+(:[0-9]{1,5})?(/[a-zA-Z0-9._~/-]*)?$`)
+)
+
+// Validator validates a ModSecurityPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	plus bool
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(plus bool) *Validator {
+	return &Validator{plus: plus}
+}
+
+// Validate validates the spec of a ModSecurityPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	msp := helpers.MustCastObject[*ngfAPI.ModSecurityPolicy](policy)
+
+	if v.plus {
+		return []conditions.Condition{
+			staticConds.NewPolicyInvalid("ModSecurityPolicy is not supported by NGINX Plus; use a WAFPolicy instead"),
+		}
+	}
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute, kinds.GRPCRoute}
+	for _, ref := range msp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := validateSettings(msp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ModSecurityPolicies conflict. ModSecurityPolicies always conflict, because
+// the rules of the Core Rule Set can only be loaded once per location.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.ModSecurityPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Mode != nil {
+		supportedModes := []string{string(ngfAPI.ModSecurityModeBlock), string(ngfAPI.ModSecurityModeDetect)}
+		if !slices.Contains(supportedModes, string(*spec.Mode)) {
+			allErrs = append(allErrs, field.NotSupported(fieldPath.Child("mode"), *spec.Mode, supportedModes))
+		}
+	}
+
+	if spec.RuleExclusions != nil {
+		exclusionsPath := fieldPath.Child("ruleExclusions")
+
+		for i, id := range spec.RuleExclusions.RuleIDs {
+			if id < 1 {
+				allErrs = append(
+					allErrs,
+					field.Invalid(exclusionsPath.Child("ruleIDs").Index(i), id, "must be greater than 0"),
+				)
+			}
+		}
+
+		for i, tag := range spec.RuleExclusions.Tags {
+			if !tagRegexp.MatchString(tag) {
+				allErrs = append(
+					allErrs,
+					field.Invalid(
+						exclusionsPath.Child("tags").Index(i),
+						tag,
+						"must contain only alphanumeric characters or '.', '_', '/', or '-'",
+					),
+				)
+			}
+		}
+	}
+
+	if spec.AuditLog != nil {
+		allErrs = append(allErrs, validateAuditLog(*spec.AuditLog, fieldPath.Child("auditLog"))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateAuditLog(auditLog ngfAPI.ModSecurityAuditLog, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if auditLog.Relevance != nil {
+		supportedRelevances := []string{
+			string(ngfAPI.ModSecurityAuditLogRelevanceRelevantOnly),
+			string(ngfAPI.ModSecurityAuditLogRelevanceAll),
+		}
+		if !slices.Contains(supportedRelevances, string(*auditLog.Relevance)) {
+			allErrs = append(
+				allErrs,
+				field.NotSupported(fieldPath.Child("relevance"), *auditLog.Relevance, supportedRelevances),
+			)
+		}
+	}
+
+	if auditLog.Format != nil {
+		supportedFormats := []string{
+			string(ngfAPI.ModSecurityAuditLogFormatJSON),
+			string(ngfAPI.ModSecurityAuditLogFormatNative),
+		}
+		if !slices.Contains(supportedFormats, string(*auditLog.Format)) {
+			allErrs = append(allErrs, field.NotSupported(fieldPath.Child("format"), *auditLog.Format, supportedFormats))
+		}
+	}
+
+	urlPath := fieldPath.Child("url")
+
+	switch auditLog.Destination {
+	case ngfAPI.ModSecurityAuditLogDestinationStdout:
+		if auditLog.URL != nil {
+			allErrs = append(allErrs, field.Forbidden(urlPath, "url can only be specified for destination HTTPS"))
+		}
+	case ngfAPI.ModSecurityAuditLogDestinationHTTPS:
+		if auditLog.URL == nil {
+			allErrs = append(allErrs, field.Required(urlPath, "url must be specified for destination HTTPS"))
+		} else if !urlRegexp.MatchString(*auditLog.URL) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(urlPath, *auditLog.URL, "must be an https URL, such as 'https://collector:8443/audit'"),
+			)
+		}
+	default:
+		allErrs = append(
+			allErrs,
+			field.NotSupported(
+				fieldPath.Child("destination"),
+				auditLog.Destination,
+				[]string{
+					string(ngfAPI.ModSecurityAuditLogDestinationStdout),
+					string(ngfAPI.ModSecurityAuditLogDestinationHTTPS),
+				},
+			),
+		)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,217 @@
+package modsecurity_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/modsecurity"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy
+
+func createValidPolicy() *ngfAPI.ModSecurityPolicy {
+	return &ngfAPI.ModSecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ModSecurityPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Mode:          helpers.GetPointer(ngfAPI.ModSecurityModeBlock),
+			ParanoiaLevel: helpers.GetPointer[int32](2),
+			RuleExclusions: &ngfAPI.ModSecurityRuleExclusions{
+				RuleIDs: []int32{942100},
+				Tags:    []string{"attack-sqli"},
+			},
+			AuditLog: &ngfAPI.ModSecurityAuditLog{
+				Relevance:   helpers.GetPointer(ngfAPI.ModSecurityAuditLogRelevanceRelevantOnly),
+				Format:      helpers.GetPointer(ngfAPI.ModSecurityAuditLogFormatJSON),
+				Destination: ngfAPI.ModSecurityAuditLogDestinationHTTPS,
+				URL:         helpers.GetPointer("https://collector:8443/audit"),
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ModSecurityPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ModSecurityPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.Gateway
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"Gateway\": " +
+					"supported values: \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid mode",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.Mode = helpers.GetPointer[ngfAPI.ModSecurityMode]("Off")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.mode: Unsupported value: \"Off\": " +
+					"supported values: \"Block\", \"Detect\""),
+			},
+		},
+		{
+			name: "invalid rule exclusions",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.RuleExclusions.RuleIDs = []int32{0}
+				p.Spec.RuleExclusions.Tags = []string{"attack'; SecRuleEngine Off"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.ruleExclusions.ruleIDs[0]: Invalid value: 0: " +
+					"must be greater than 0, spec.ruleExclusions.tags[0]: Invalid value: " +
+					"\"attack'; SecRuleEngine Off\": must contain only alphanumeric characters or '.', '_', '/', or '-']"),
+			},
+		},
+		{
+			name: "invalid audit log URL",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.AuditLog.URL = helpers.GetPointer("http://collector'")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.auditLog.url: Invalid value: \"http://collector'\": " +
+					"must be an https URL, such as 'https://collector:8443/audit'"),
+			},
+		},
+		{
+			name: "invalid audit log; missing URL",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.AuditLog.URL = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.auditLog.url: Required value: " +
+					"url must be specified for destination HTTPS"),
+			},
+		},
+		{
+			name: "invalid audit log; URL with stdout",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.AuditLog.Destination = ngfAPI.ModSecurityAuditLogDestinationStdout
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.auditLog.url: Forbidden: " +
+					"url can only be specified for destination HTTPS"),
+			},
+		},
+		{
+			name: "invalid audit log; unsupported values",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec.AuditLog.Relevance = helpers.GetPointer[ngfAPI.ModSecurityAuditLogRelevance]("None")
+				p.Spec.AuditLog.Format = helpers.GetPointer[ngfAPI.ModSecurityAuditLogFormat]("XML")
+				p.Spec.AuditLog.Destination = "File"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.auditLog.relevance: Unsupported value: \"None\": " +
+					"supported values: \"RelevantOnly\", \"All\", spec.auditLog.format: Unsupported value: \"XML\": " +
+					"supported values: \"JSON\", \"Native\", spec.auditLog.destination: Unsupported value: \"File\": " +
+					"supported values: \"Stdout\", \"HTTPS\"]"),
+			},
+		},
+		{
+			name: "valid; only target refs",
+			policy: createModifiedPolicy(func(p *ngfAPI.ModSecurityPolicy) *ngfAPI.ModSecurityPolicy {
+				p.Spec = ngfAPI.ModSecurityPolicySpec{TargetRefs: p.Spec.TargetRefs}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := modsecurity.NewValidator(false)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePlus(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := modsecurity.NewValidator(true)
+
+	conds := v.Validate(createValidPolicy(), nil)
+	g.Expect(conds).To(Equal([]conditions.Condition{
+		staticConds.NewPolicyInvalid("ModSecurityPolicy is not supported by NGINX Plus; use a WAFPolicy instead"),
+	}))
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := modsecurity.NewValidator(false)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := modsecurity.NewValidator(false)
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
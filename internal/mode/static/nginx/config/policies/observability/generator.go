@@ -2,7 +2,10 @@ package observability
 
 import (
 	"fmt"
+	"strconv"
 	"text/template"
+	"time"
+	"unicode"
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
@@ -11,6 +14,10 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
+// defaultSLILatencyThreshold is the latency above which a request counts as slow for the service level indicators,
+// unless the policy sets it.
+const defaultSLILatencyThreshold = 500 * time.Millisecond
+
 var (
 	tmpl            = template.Must(template.New("observability policy").Parse(observabilityTemplate))
 	tmplInternal    = template.Must(template.New("observability policy internal").Parse(internalTemplate))
@@ -32,8 +39,7 @@ otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
   {{- range $attr := .GlobalSpanAttributes }}
 otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
   {{- end }}
-{{- end }}
-`
+{{- end }}` + accessLogTemplate
 
 const internalTemplate = `
 {{- if .Tracing }}
@@ -48,8 +54,7 @@ otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
   {{- range $attr := .GlobalSpanAttributes }}
 otel_span_attr "{{ $attr.Key }}" "{{ $attr.Value }}";
   {{- end }}
-{{- end }}
-`
+{{- end }}` + accessLogTemplate
 
 const externalRedirectTemplate = `
 {{- if .Tracing }}
@@ -60,83 +65,196 @@ otel_trace_context {{ .Tracing.Context }};
 {{- end }}
 `
 
+// accessLogTemplate configures the access logs of a location. The latency of the requests of a Route is recorded
+// by the $ngf_record_route_latency variable, which is evaluated by the access_log directive when the request is
+// logged, and never enables the logging to /dev/null. The same goes for the $ngf_record_route_sli variable,
+// which records the service level indicators of the Route.
+// The rejected requests of the listener are recorded by the $ngf_record_listener_request variable, which is
+// prepended to the condition of the access log and evaluates to an empty string, so that it doesn't change
+// the condition. The requests of a location with access logging turned off are not recorded.
+// Since the access_log directives of a location override the directives of the http context, the default access log
+// is set again in the location, unless access logging is turned off.
+const accessLogTemplate = `
+{{- if or .LatencyHistogramRoute .SLIRoute }}
+set $ngf_route "{{ or .LatencyHistogramRoute .SLIRoute }}";
+{{- end }}
+{{- if .SLIRoute }}
+set $ngf_sli_latency_threshold {{ .SLILatencyThreshold }};
+{{- end }}
+{{- if .AccessLogOff }}
+  {{- if not (or .LatencyHistogramRoute .SLIRoute) }}
+access_log off;
+  {{- end }}
+{{- else if or .AccessLogCondition .AccessLogFormat .LatencyHistogramRoute .SLIRoute }}
+access_log /dev/stdout {{ or .AccessLogFormat .DefaultAccessLogFormat "combined" }}
+  {{- if .RecordListenerRequests }} if=${ngf_record_listener_request}{{ or .AccessLogCondition "1" }}
+  {{- else if .AccessLogCondition }} if={{ .AccessLogCondition }}{{ end }};
+{{- end }}
+{{- if .LatencyHistogramRoute }}
+access_log /dev/null combined if=$ngf_record_route_latency;
+{{- end }}
+{{- if .SLIRoute }}
+access_log /dev/null combined if=$ngf_record_route_sli;
+{{- end }}
+`
+
 // Generator generates nginx configuration based on an observability policy.
 type Generator struct {
 	policies.UnimplementedGenerator
 
 	telemetryConf dataplane.Telemetry
+	// defaultAccessLogFormat is the format of the access log of the http context. It is empty if the access log
+	// uses the combined format.
+	defaultAccessLogFormat string
+	// recordListenerRequests is true if the access logs record the rejected requests of the listeners
+	// for the metrics.
+	recordListenerRequests bool
 }
 
-// NewGenerator returns a new instance of Generator.
-func NewGenerator(telemetry dataplane.Telemetry) *Generator {
-	return &Generator{telemetryConf: telemetry}
+// NewGenerator returns a new instance of Generator. If recordListenerRequests is true, the access logs of the
+// locations record the rejected requests of the listeners for the metrics.
+func NewGenerator(telemetry dataplane.Telemetry, logging dataplane.Logging, recordListenerRequests bool) *Generator {
+	g := &Generator{telemetryConf: telemetry, recordListenerRequests: recordListenerRequests}
+
+	if logging.KubernetesAccessLog != nil {
+		g.defaultAccessLogFormat = dataplane.KubernetesAccessLogFormat
+	}
+
+	return g
 }
 
 // GenerateForLocation generates policy configuration for a normal location block.
 // For a normal location, all directives are applied.
 // When the configuration involves a normal location redirecting to an internal location,
-// only otel_trace and otel_trace_context are applied to the normal location.
+// only otel_trace and otel_trace_context are applied to the normal location, since the requests
+// are logged in the internal location.
 func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
-	buildTemplate := func(
-		tmplate *template.Template,
-		fileSuffix string,
-		includeGlobalAttrs bool,
-	) policies.GenerateResultFiles {
-		for _, pol := range pols {
-			obs, ok := pol.(*ngfAPI.ObservabilityPolicy)
-			if !ok {
-				continue
-			}
-
-			fields := map[string]interface{}{
-				"Tracing":  obs.Spec.Tracing,
-				"Strategy": getStrategy(obs),
-			}
-			if includeGlobalAttrs {
-				fields["GlobalSpanAttributes"] = g.telemetryConf.SpanAttributes
-			}
-
-			return policies.GenerateResultFiles{
-				{
-					Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_%s.conf", obs.Namespace, obs.Name, fileSuffix),
-					Content: helpers.MustExecuteTemplate(tmplate, fields),
-				},
-			}
-		}
-		return nil
-	}
-
 	if location.Type == http.ExternalLocationType {
-		return buildTemplate(tmpl, "ext", true)
+		return g.generate(pols, location, tmpl, "ext")
 	}
 
-	return buildTemplate(tmplExtRedirect, "redirect", false)
+	return g.generate(pols, location, tmplExtRedirect, "redirect")
 }
 
 // GenerateForInternalLocation generates policy configuration for an internal location block.
 // otel_span_attr and otel_span_name are set in the internal location, with otel_trace and otel_trace_context
 // being specified in the external location that redirects to the internal location.
-func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	location http.Location,
+) policies.GenerateResultFiles {
+	return g.generate(pols, location, tmplInternal, "int")
+}
+
+// generate generates the configuration of the ObservabilityPolicies of a location. Multiple ObservabilityPolicies
+// can apply to a location if they don't conflict, so the settings of the first policy that sets them are used.
+// The configuration is written to a single file that is named after the first policy.
+func (g Generator) generate(
+	pols []policies.Policy,
+	location http.Location,
+	tmplate *template.Template,
+	fileSuffix string,
+) policies.GenerateResultFiles {
+	var (
+		first      *ngfAPI.ObservabilityPolicy
+		tracing    *ngfAPI.ObservabilityPolicy
+		logging    *ngfAPI.AccessLog
+		sli        *ngfAPI.RouteSLI
+		latency    bool
+		metricsSet bool
+	)
+
 	for _, pol := range pols {
 		obs, ok := pol.(*ngfAPI.ObservabilityPolicy)
 		if !ok {
 			continue
 		}
 
-		fields := map[string]interface{}{
-			"Tracing":              obs.Spec.Tracing,
-			"GlobalSpanAttributes": g.telemetryConf.SpanAttributes,
+		if first == nil {
+			first = obs
 		}
 
-		return policies.GenerateResultFiles{
-			{
-				Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_int.conf", obs.Namespace, obs.Name),
-				Content: helpers.MustExecuteTemplate(tmplInternal, fields),
-			},
+		if tracing == nil && obs.Spec.Tracing != nil {
+			tracing = obs
 		}
+
+		if logging == nil && obs.Spec.AccessLog != nil {
+			logging = obs.Spec.AccessLog
+		}
+
+		if !metricsSet && obs.Spec.Metrics != nil {
+			metricsSet = true
+			latency = obs.Spec.Metrics.LatencyHistogram != nil && *obs.Spec.Metrics.LatencyHistogram
+			sli = obs.Spec.Metrics.SLI
+		}
+	}
+
+	if first == nil {
+		return nil
 	}
 
-	return nil
+	fields := map[string]interface{}{
+		"GlobalSpanAttributes":   g.telemetryConf.SpanAttributes,
+		"DefaultAccessLogFormat": g.defaultAccessLogFormat,
+		"RecordListenerRequests": g.recordListenerRequests,
+	}
+
+	if tracing != nil {
+		fields["Tracing"] = tracing.Spec.Tracing
+		fields["Strategy"] = getStrategy(tracing)
+	}
+
+	if logging != nil {
+		off, condition := getAccessLogSettings(logging)
+		fields["AccessLogOff"] = off
+		fields["AccessLogCondition"] = condition
+
+		if logging.GeoIP != nil && *logging.GeoIP {
+			fields["AccessLogFormat"] = dataplane.GeoIPAccessLogFormat
+		}
+	}
+
+	if latency {
+		fields["LatencyHistogramRoute"] = location.Route
+	}
+
+	if sli != nil {
+		fields["SLIRoute"] = location.Route
+		fields["SLILatencyThreshold"] = getSLILatencyThreshold(sli)
+	}
+
+	content := helpers.MustExecuteTemplate(tmplate, fields)
+
+	// The settings of the policies might not apply to the location, such as access logging in a location
+	// that redirects to an internal location.
+	if policies.IsEmpty(content) {
+		return nil
+	}
+
+	return policies.GenerateResultFiles{
+		{
+			Name:    fmt.Sprintf("ObservabilityPolicy_%s_%s_%s.conf", first.Namespace, first.Name, fileSuffix),
+			Content: content,
+		},
+	}
+}
+
+// getAccessLogSettings returns whether access logging is turned off, and the variable that conditions
+// access logging on the sampling of the request, if any.
+func getAccessLogSettings(accessLog *ngfAPI.AccessLog) (bool, string) {
+	if accessLog.Disable != nil && *accessLog.Disable {
+		return true, ""
+	}
+
+	if accessLog.SampleRatio == nil || *accessLog.SampleRatio >= 100 {
+		return false, ""
+	}
+
+	if *accessLog.SampleRatio <= 0 {
+		return true, ""
+	}
+
+	return false, dataplane.CreateAccessLogRatioVarName(*accessLog.SampleRatio)
 }
 
 func getStrategy(obs *ngfAPI.ObservabilityPolicy) string {
@@ -161,3 +279,27 @@ func getStrategy(obs *ngfAPI.ObservabilityPolicy) string {
 
 	return strategy
 }
+
+// getSLILatencyThreshold returns the latency threshold of the service level indicators in seconds.
+func getSLILatencyThreshold(sli *ngfAPI.RouteSLI) string {
+	threshold := defaultSLILatencyThreshold
+
+	// the threshold is validated by the Validator
+	if sli.LatencyThreshold != nil {
+		if d, err := parseDuration(*sli.LatencyThreshold); err == nil {
+			threshold = d
+		}
+	}
+
+	return strconv.FormatFloat(threshold.Seconds(), 'f', -1, 64)
+}
+
+// parseDuration parses the Duration into a time.Duration. NGINX treats a Duration without a unit as seconds.
+func parseDuration(d ngfAPI.Duration) (time.Duration, error) {
+	value := string(d)
+	if value != "" && unicode.IsDigit(rune(value[len(value)-1])) {
+		value += "s"
+	}
+
+	return time.ParseDuration(value)
+}
@@ -22,12 +22,14 @@ func TestGenerate(t *testing.T) {
 	spanName := helpers.GetPointer("my-span")
 
 	tests := []struct {
-		name               string
-		expExternalStrings []string
-		expRedirectStrings []string
-		expInternalStrings []string
-		policy             policies.Policy
-		telemetryConf      dataplane.Telemetry
+		name                   string
+		expExternalStrings     []string
+		expRedirectStrings     []string
+		expInternalStrings     []string
+		policy                 policies.Policy
+		telemetryConf          dataplane.Telemetry
+		loggingConf            dataplane.Logging
+		recordListenerRequests bool
 	}{
 		{
 			name: "strategy set to default ratio",
@@ -239,6 +241,247 @@ func TestGenerate(t *testing.T) {
 				"otel_span_attr \"test-global-key\" \"test-global-value\";",
 			},
 		},
+		{
+			name: "access logging disabled",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						Disable: helpers.GetPointer(true),
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"access_log off;",
+			},
+			expInternalStrings: []string{
+				"access_log off;",
+			},
+		},
+		{
+			name: "access logging sample ratio set",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						SampleRatio: ratio,
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"access_log /dev/stdout combined if=$ngf_access_log_ratio_25;",
+			},
+			expInternalStrings: []string{
+				"access_log /dev/stdout combined if=$ngf_access_log_ratio_25;",
+			},
+		},
+		{
+			name: "access logging with geoIP and sample ratio",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						SampleRatio: ratio,
+						GeoIP:       helpers.GetPointer(true),
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"access_log /dev/stdout ngf_geoip if=$ngf_access_log_ratio_25;",
+			},
+			expInternalStrings: []string{
+				"access_log /dev/stdout ngf_geoip if=$ngf_access_log_ratio_25;",
+			},
+		},
+		{
+			name: "access logging with geoIP",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						GeoIP: helpers.GetPointer(true),
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"access_log /dev/stdout ngf_geoip;",
+			},
+			expInternalStrings: []string{
+				"access_log /dev/stdout ngf_geoip;",
+			},
+		},
+		{
+			name: "access logging enabled with the kubernetes format",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						SampleRatio: helpers.GetPointer[int32](25),
+					},
+				},
+			},
+			loggingConf: dataplane.Logging{
+				KubernetesAccessLog: &dataplane.KubernetesAccessLog{},
+			},
+			expExternalStrings: []string{
+				"access_log /dev/stdout ngf_kubernetes if=$ngf_access_log_ratio_25;",
+			},
+			expInternalStrings: []string{
+				"access_log /dev/stdout ngf_kubernetes if=$ngf_access_log_ratio_25;",
+			},
+		},
+		{
+			name: "access logging sample ratio set to zero",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						SampleRatio: zeroRatio,
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"access_log off;",
+			},
+			expInternalStrings: []string{
+				"access_log off;",
+			},
+		},
+		{
+			name: "access logging sample ratio set with the listener requests recorded",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						SampleRatio: ratio,
+					},
+				},
+			},
+			recordListenerRequests: true,
+			expExternalStrings: []string{
+				"access_log /dev/stdout combined if=${ngf_record_listener_request}$ngf_access_log_ratio_25;",
+			},
+			expInternalStrings: []string{
+				"access_log /dev/stdout combined if=${ngf_record_listener_request}$ngf_access_log_ratio_25;",
+			},
+		},
+		{
+			name: "access logging disabled with the listener requests recorded",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						Disable: helpers.GetPointer(true),
+					},
+				},
+			},
+			recordListenerRequests: true,
+			expExternalStrings: []string{
+				"access_log off;",
+			},
+			expInternalStrings: []string{
+				"access_log off;",
+			},
+		},
+		{
+			name: "latency histogram enabled",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Metrics: &ngfAPI.RouteMetrics{
+						LatencyHistogram: helpers.GetPointer(true),
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"access_log /dev/stdout combined;",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+			},
+			expInternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"access_log /dev/stdout combined;",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+			},
+		},
+		{
+			name: "latency histogram enabled with the listener requests recorded",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Metrics: &ngfAPI.RouteMetrics{
+						LatencyHistogram: helpers.GetPointer(true),
+					},
+				},
+			},
+			recordListenerRequests: true,
+			expExternalStrings: []string{
+				"access_log /dev/stdout combined if=${ngf_record_listener_request}1;",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+			},
+			expInternalStrings: []string{
+				"access_log /dev/stdout combined if=${ngf_record_listener_request}1;",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+			},
+		},
+		{
+			name: "sli enabled",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Metrics: &ngfAPI.RouteMetrics{
+						SLI: &ngfAPI.RouteSLI{},
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"set $ngf_sli_latency_threshold 0.5;",
+				"access_log /dev/stdout combined;",
+				"access_log /dev/null combined if=$ngf_record_route_sli;",
+			},
+			expInternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"set $ngf_sli_latency_threshold 0.5;",
+				"access_log /dev/stdout combined;",
+				"access_log /dev/null combined if=$ngf_record_route_sli;",
+			},
+		},
+		{
+			name: "sli and latency histogram enabled with a latency threshold",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Metrics: &ngfAPI.RouteMetrics{
+						LatencyHistogram: helpers.GetPointer(true),
+						SLI: &ngfAPI.RouteSLI{
+							LatencyThreshold: helpers.GetPointer[ngfAPI.Duration]("2"),
+						},
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"set $ngf_sli_latency_threshold 2;",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+				"access_log /dev/null combined if=$ngf_record_route_sli;",
+			},
+			expInternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"set $ngf_sli_latency_threshold 2;",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+				"access_log /dev/null combined if=$ngf_record_route_sli;",
+			},
+		},
+		{
+			name: "latency histogram enabled with access logging disabled",
+			policy: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{
+						Disable: helpers.GetPointer(true),
+					},
+					Metrics: &ngfAPI.RouteMetrics{
+						LatencyHistogram: helpers.GetPointer(true),
+					},
+				},
+			},
+			expExternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+			},
+			expInternalStrings: []string{
+				"set $ngf_route \"test-namespace/test-route\";",
+				"access_log /dev/null combined if=$ngf_record_route_latency;",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -246,33 +489,35 @@ func TestGenerate(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			generator := observability.NewGenerator(test.telemetryConf)
+			generator := observability.NewGenerator(test.telemetryConf, test.loggingConf, test.recordListenerRequests)
 
 			for _, locType := range []http.LocationType{
 				http.ExternalLocationType, http.RedirectLocationType, http.InternalLocationType,
 			} {
 				var expStrings []string
 				var resFiles policies.GenerateResultFiles
+				location := http.Location{Type: locType, Route: "test-namespace/test-route"}
 				switch locType {
 				case http.ExternalLocationType:
 					expStrings = test.expExternalStrings
-					resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{Type: locType})
+					resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, location)
 				case http.RedirectLocationType:
 					expStrings = test.expRedirectStrings
-					resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{Type: locType})
+					resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, location)
 				case http.InternalLocationType:
 					expStrings = test.expInternalStrings
-					resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+					resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy}, location)
+				}
+
+				if len(expStrings) == 0 {
+					g.Expect(resFiles).To(BeEmpty())
+					continue
 				}
 
 				g.Expect(resFiles).To(HaveLen(1))
 
 				content := string(resFiles[0].Content)
 
-				if len(expStrings) == 0 {
-					g.Expect(content).To(Equal(""))
-				}
-
 				for _, str := range expStrings {
 					g.Expect(content).To(ContainSubstring(str))
 				}
@@ -285,7 +530,7 @@ func TestGenerateNoPolicies(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	generator := observability.NewGenerator(dataplane.Telemetry{})
+	generator := observability.NewGenerator(dataplane.Telemetry{}, dataplane.Logging{}, false)
 
 	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
@@ -293,9 +538,9 @@ func TestGenerateNoPolicies(t *testing.T) {
 	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
 
-	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
 
-	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}})
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
 	g.Expect(resFiles).To(BeEmpty())
 }
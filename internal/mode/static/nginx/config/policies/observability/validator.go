@@ -31,18 +31,28 @@ func (v *Validator) Validate(
 ) []conditions.Condition {
 	obs := helpers.MustCastObject[*ngfAPI.ObservabilityPolicy](policy)
 
-	if globalSettings == nil || !globalSettings.NginxProxyValid {
-		return []conditions.Condition{
-			staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageNginxProxyInvalid),
+	// Only tracing and the GeoIP fields of the access log rely on the settings of the NginxProxy.
+	geoIPAccessLog := obs.Spec.AccessLog != nil && obs.Spec.AccessLog.GeoIP != nil && *obs.Spec.AccessLog.GeoIP
+	if obs.Spec.Tracing != nil || geoIPAccessLog {
+		if globalSettings == nil || !globalSettings.NginxProxyValid {
+			return []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageNginxProxyInvalid),
+			}
 		}
 	}
 
-	if !globalSettings.TelemetryEnabled {
+	if obs.Spec.Tracing != nil && !globalSettings.TelemetryEnabled {
 		return []conditions.Condition{
 			staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageTelemetryNotEnabled),
 		}
 	}
 
+	if geoIPAccessLog && !globalSettings.GeoIPEnabled {
+		return []conditions.Condition{
+			staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageGeoIPNotEnabled),
+		}
+	}
+
 	targetRefPath := field.NewPath("spec").Child("targetRefs")
 	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute, kinds.GRPCRoute}
 	for _, ref := range obs.Spec.TargetRefs {
@@ -63,7 +73,9 @@ func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
 	a := helpers.MustCastObject[*ngfAPI.ObservabilityPolicy](polA)
 	b := helpers.MustCastObject[*ngfAPI.ObservabilityPolicy](polB)
 
-	return a.Spec.Tracing != nil && b.Spec.Tracing != nil
+	return (a.Spec.Tracing != nil && b.Spec.Tracing != nil) ||
+		(a.Spec.AccessLog != nil && b.Spec.AccessLog != nil) ||
+		(a.Spec.Metrics != nil && b.Spec.Metrics != nil)
 }
 
 func (v *Validator) validateSettings(spec ngfAPI.ObservabilityPolicySpec) error {
@@ -133,5 +145,40 @@ func (v *Validator) validateSettings(spec ngfAPI.ObservabilityPolicySpec) error
 		}
 	}
 
+	if spec.AccessLog != nil {
+		accessLogPath := fieldPath.Child("accessLog")
+
+		if spec.AccessLog.SampleRatio != nil && spec.AccessLog.Disable != nil && *spec.AccessLog.Disable {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(
+					accessLogPath.Child("sampleRatio"),
+					"sampleRatio cannot be specified if access logging is disabled",
+				),
+			)
+		}
+
+		geoIP := spec.AccessLog.GeoIP != nil && *spec.AccessLog.GeoIP
+		if geoIP && spec.AccessLog.Disable != nil && *spec.AccessLog.Disable {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(
+					accessLogPath.Child("geoIP"),
+					"geoIP cannot be enabled if access logging is disabled",
+				),
+			)
+		}
+	}
+
+	if spec.Metrics != nil && spec.Metrics.SLI != nil && spec.Metrics.SLI.LatencyThreshold != nil {
+		threshold := string(*spec.Metrics.SLI.LatencyThreshold)
+		if err := v.genericValidator.ValidateNginxDuration(threshold); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(fieldPath.Child("metrics", "sli", "latencyThreshold"), threshold, err.Error()),
+			)
+		}
+	}
+
 	return allErrs.ToAggregate()
 }
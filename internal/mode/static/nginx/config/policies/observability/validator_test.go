@@ -56,6 +56,7 @@ func TestValidator_Validate(t *testing.T) {
 	globalSettings := &policies.GlobalSettings{
 		NginxProxyValid:  true,
 		TelemetryEnabled: true,
+		GeoIPEnabled:     true,
 	}
 
 	tests := []struct {
@@ -87,6 +88,28 @@ func TestValidator_Validate(t *testing.T) {
 				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageTelemetryNotEnabled),
 			},
 		},
+		{
+			name: "geoIP is not enabled",
+			policy: createModifiedPolicy(func(p *ngfAPI.ObservabilityPolicy) *ngfAPI.ObservabilityPolicy {
+				p.Spec.AccessLog = &ngfAPI.AccessLog{GeoIP: helpers.GetPointer(true)}
+				return p
+			}),
+			globalSettings: &policies.GlobalSettings{NginxProxyValid: true, TelemetryEnabled: true},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageGeoIPNotEnabled),
+			},
+		},
+		{
+			name: "geoIP access log without NginxProxy",
+			policy: createModifiedPolicy(func(p *ngfAPI.ObservabilityPolicy) *ngfAPI.ObservabilityPolicy {
+				p.Spec.Tracing = nil
+				p.Spec.AccessLog = &ngfAPI.AccessLog{GeoIP: helpers.GetPointer(true)}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageNginxProxyInvalid),
+			},
+		},
 		{
 			name: "invalid target ref; unsupported group",
 			policy: createModifiedPolicy(func(p *ngfAPI.ObservabilityPolicy) *ngfAPI.ObservabilityPolicy {
@@ -174,12 +197,70 @@ func TestValidator_Validate(t *testing.T) {
 					"unescaped '\\' (regex used for validation is '([^\"$\\\\]|\\\\[^$])*')"),
 			},
 		},
+		{
+			name: "invalid access log; sample ratio with access logging disabled",
+			policy: createModifiedPolicy(func(p *ngfAPI.ObservabilityPolicy) *ngfAPI.ObservabilityPolicy {
+				p.Spec.AccessLog = &ngfAPI.AccessLog{
+					Disable:     helpers.GetPointer(true),
+					SampleRatio: helpers.GetPointer[int32](10),
+				}
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.accessLog.sampleRatio: Forbidden: " +
+					"sampleRatio cannot be specified if access logging is disabled"),
+			},
+		},
+		{
+			name: "invalid access log; geoIP with access logging disabled",
+			policy: createModifiedPolicy(func(p *ngfAPI.ObservabilityPolicy) *ngfAPI.ObservabilityPolicy {
+				p.Spec.AccessLog = &ngfAPI.AccessLog{
+					Disable: helpers.GetPointer(true),
+					GeoIP:   helpers.GetPointer(true),
+				}
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.accessLog.geoIP: Forbidden: " +
+					"geoIP cannot be enabled if access logging is disabled"),
+			},
+		},
+		{
+			name: "invalid sli latency threshold",
+			policy: createModifiedPolicy(func(p *ngfAPI.ObservabilityPolicy) *ngfAPI.ObservabilityPolicy {
+				p.Spec.Metrics = &ngfAPI.RouteMetrics{
+					SLI: &ngfAPI.RouteSLI{LatencyThreshold: helpers.GetPointer[ngfAPI.Duration]("1d")},
+				}
+				return p
+			}),
+			globalSettings: globalSettings,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.metrics.sli.latencyThreshold: Invalid value: \"1d\": " +
+					"^[0-9]{1,4}(ms|s|m|h)? (e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for " +
+					"validation is 'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')"),
+			},
+		},
 		{
 			name:           "valid",
 			policy:         createValidPolicy(),
 			globalSettings: globalSettings,
 			expConditions:  nil,
 		},
+		{
+			name: "valid; access log and metrics without tracing do not require telemetry",
+			policy: createModifiedPolicy(func(p *ngfAPI.ObservabilityPolicy) *ngfAPI.ObservabilityPolicy {
+				p.Spec.Tracing = nil
+				p.Spec.AccessLog = &ngfAPI.AccessLog{SampleRatio: helpers.GetPointer[int32](10)}
+				p.Spec.Metrics = &ngfAPI.RouteMetrics{
+					LatencyHistogram: helpers.GetPointer(true),
+					SLI:              &ngfAPI.RouteSLI{LatencyThreshold: helpers.GetPointer[ngfAPI.Duration]("300ms")},
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
 	}
 
 	v := observability.NewValidator(validation.GenericValidator{})
@@ -242,6 +323,49 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: true,
 		},
+		{
+			name: "no conflicts; different settings",
+			polA: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Tracing:   &ngfAPI.Tracing{},
+					AccessLog: &ngfAPI.AccessLog{},
+				},
+			},
+			polB: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Metrics: &ngfAPI.RouteMetrics{},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "conflicts; access log",
+			polA: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{},
+				},
+			},
+			polB: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: &ngfAPI.AccessLog{},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "conflicts; metrics",
+			polA: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Metrics: &ngfAPI.RouteMetrics{},
+				},
+			},
+			polB: &ngfAPI.ObservabilityPolicy{
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					Metrics: &ngfAPI.RouteMetrics{},
+				},
+			},
+			conflicts: true,
+		},
 	}
 
 	v := observability.NewValidator(nil)
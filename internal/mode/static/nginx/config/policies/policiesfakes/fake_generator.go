@@ -9,10 +9,11 @@ import (
 )
 
 type FakeGenerator struct {
-	GenerateForInternalLocationStub        func([]policies.Policy) policies.GenerateResultFiles
+	GenerateForInternalLocationStub        func([]policies.Policy, http.Location) policies.GenerateResultFiles
 	generateForInternalLocationMutex       sync.RWMutex
 	generateForInternalLocationArgsForCall []struct {
 		arg1 []policies.Policy
+		arg2 http.Location
 	}
 	generateForInternalLocationReturns struct {
 		result1 policies.GenerateResultFiles
@@ -48,7 +49,7 @@ type FakeGenerator struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeGenerator) GenerateForInternalLocation(arg1 []policies.Policy) policies.GenerateResultFiles {
+func (fake *FakeGenerator) GenerateForInternalLocation(arg1 []policies.Policy, arg2 http.Location) policies.GenerateResultFiles {
 	var arg1Copy []policies.Policy
 	if arg1 != nil {
 		arg1Copy = make([]policies.Policy, len(arg1))
@@ -58,13 +59,14 @@ func (fake *FakeGenerator) GenerateForInternalLocation(arg1 []policies.Policy) p
 	ret, specificReturn := fake.generateForInternalLocationReturnsOnCall[len(fake.generateForInternalLocationArgsForCall)]
 	fake.generateForInternalLocationArgsForCall = append(fake.generateForInternalLocationArgsForCall, struct {
 		arg1 []policies.Policy
-	}{arg1Copy})
+		arg2 http.Location
+	}{arg1Copy, arg2})
 	stub := fake.GenerateForInternalLocationStub
 	fakeReturns := fake.generateForInternalLocationReturns
-	fake.recordInvocation("GenerateForInternalLocation", []interface{}{arg1Copy})
+	fake.recordInvocation("GenerateForInternalLocation", []interface{}{arg1Copy, arg2})
 	fake.generateForInternalLocationMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -78,17 +80,17 @@ func (fake *FakeGenerator) GenerateForInternalLocationCallCount() int {
 	return len(fake.generateForInternalLocationArgsForCall)
 }
 
-func (fake *FakeGenerator) GenerateForInternalLocationCalls(stub func([]policies.Policy) policies.GenerateResultFiles) {
+func (fake *FakeGenerator) GenerateForInternalLocationCalls(stub func([]policies.Policy, http.Location) policies.GenerateResultFiles) {
 	fake.generateForInternalLocationMutex.Lock()
 	defer fake.generateForInternalLocationMutex.Unlock()
 	fake.GenerateForInternalLocationStub = stub
 }
 
-func (fake *FakeGenerator) GenerateForInternalLocationArgsForCall(i int) []policies.Policy {
+func (fake *FakeGenerator) GenerateForInternalLocationArgsForCall(i int) ([]policies.Policy, http.Location) {
 	fake.generateForInternalLocationMutex.RLock()
 	defer fake.generateForInternalLocationMutex.RUnlock()
 	argsForCall := fake.generateForInternalLocationArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeGenerator) GenerateForInternalLocationReturns(result1 policies.GenerateResultFiles) {
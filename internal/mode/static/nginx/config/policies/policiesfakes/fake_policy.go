@@ -183,15 +183,15 @@ type FakePolicy struct {
 	getSelfLinkReturnsOnCall map[int]struct {
 		result1 string
 	}
-	GetTargetRefsStub        func() []v1alpha2.LocalPolicyTargetReference
+	GetTargetRefsStub        func() []v1alpha2.LocalPolicyTargetReferenceWithSectionName
 	getTargetRefsMutex       sync.RWMutex
 	getTargetRefsArgsForCall []struct {
 	}
 	getTargetRefsReturns struct {
-		result1 []v1alpha2.LocalPolicyTargetReference
+		result1 []v1alpha2.LocalPolicyTargetReferenceWithSectionName
 	}
 	getTargetRefsReturnsOnCall map[int]struct {
-		result1 []v1alpha2.LocalPolicyTargetReference
+		result1 []v1alpha2.LocalPolicyTargetReferenceWithSectionName
 	}
 	GetUIDStub        func() types.UID
 	getUIDMutex       sync.RWMutex
@@ -1188,7 +1188,7 @@ func (fake *FakePolicy) GetSelfLinkReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
-func (fake *FakePolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReference {
+func (fake *FakePolicy) GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
 	fake.getTargetRefsMutex.Lock()
 	ret, specificReturn := fake.getTargetRefsReturnsOnCall[len(fake.getTargetRefsArgsForCall)]
 	fake.getTargetRefsArgsForCall = append(fake.getTargetRefsArgsForCall, struct {
@@ -1212,32 +1212,32 @@ func (fake *FakePolicy) GetTargetRefsCallCount() int {
 	return len(fake.getTargetRefsArgsForCall)
 }
 
-func (fake *FakePolicy) GetTargetRefsCalls(stub func() []v1alpha2.LocalPolicyTargetReference) {
+func (fake *FakePolicy) GetTargetRefsCalls(stub func() []v1alpha2.LocalPolicyTargetReferenceWithSectionName) {
 	fake.getTargetRefsMutex.Lock()
 	defer fake.getTargetRefsMutex.Unlock()
 	fake.GetTargetRefsStub = stub
 }
 
-func (fake *FakePolicy) GetTargetRefsReturns(result1 []v1alpha2.LocalPolicyTargetReference) {
+func (fake *FakePolicy) GetTargetRefsReturns(result1 []v1alpha2.LocalPolicyTargetReferenceWithSectionName) {
 	fake.getTargetRefsMutex.Lock()
 	defer fake.getTargetRefsMutex.Unlock()
 	fake.GetTargetRefsStub = nil
 	fake.getTargetRefsReturns = struct {
-		result1 []v1alpha2.LocalPolicyTargetReference
+		result1 []v1alpha2.LocalPolicyTargetReferenceWithSectionName
 	}{result1}
 }
 
-func (fake *FakePolicy) GetTargetRefsReturnsOnCall(i int, result1 []v1alpha2.LocalPolicyTargetReference) {
+func (fake *FakePolicy) GetTargetRefsReturnsOnCall(i int, result1 []v1alpha2.LocalPolicyTargetReferenceWithSectionName) {
 	fake.getTargetRefsMutex.Lock()
 	defer fake.getTargetRefsMutex.Unlock()
 	fake.GetTargetRefsStub = nil
 	if fake.getTargetRefsReturnsOnCall == nil {
 		fake.getTargetRefsReturnsOnCall = make(map[int]struct {
-			result1 []v1alpha2.LocalPolicyTargetReference
+			result1 []v1alpha2.LocalPolicyTargetReferenceWithSectionName
 		})
 	}
 	fake.getTargetRefsReturnsOnCall[i] = struct {
-		result1 []v1alpha2.LocalPolicyTargetReference
+		result1 []v1alpha2.LocalPolicyTargetReferenceWithSectionName
 	}{result1}
 }
 
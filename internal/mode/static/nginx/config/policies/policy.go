@@ -7,6 +7,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -15,7 +17,7 @@ import (
 //
 //counterfeiter:generate . Policy
 type Policy interface {
-	GetTargetRefs() []v1alpha2.LocalPolicyTargetReference
+	GetTargetRefs() []v1alpha2.LocalPolicyTargetReferenceWithSectionName
 	GetPolicyStatus() v1alpha2.PolicyStatus
 	SetPolicyStatus(status v1alpha2.PolicyStatus)
 	client.Object
@@ -28,6 +30,8 @@ type GlobalSettings struct {
 	NginxProxyValid bool
 	// TelemetryEnabled is whether or not telemetry is enabled in the NginxProxy resource.
 	TelemetryEnabled bool
+	// GeoIPEnabled is whether or not GeoIP is configured in the NginxProxy resource.
+	GeoIPEnabled bool
 }
 
 // ValidateTargetRef validates a policy's targetRef for the proper group and kind.
@@ -59,5 +63,18 @@ func ValidateTargetRef(
 	return nil
 }
 
+// ValidateTargetRefSectionName validates that a policy's targetRef only sets the sectionName for a Gateway,
+// where it targets a Listener. Other sections, such as the rules of Routes, are not supported.
+func ValidateTargetRefSectionName(ref v1alpha2.LocalPolicyTargetReferenceWithSectionName, basePath *field.Path) error {
+	if ref.SectionName != nil && ref.Kind != kinds.Gateway {
+		return field.Forbidden(
+			basePath.Child("sectionName"),
+			"sectionName can only be specified if the targetRef kind is Gateway",
+		)
+	}
+
+	return nil
+}
+
 // We generate a mock of ObjectKind so that we can create fake policies and set their GVKs.
 //counterfeiter:generate k8s.io/apimachinery/pkg/runtime/schema.ObjectKind
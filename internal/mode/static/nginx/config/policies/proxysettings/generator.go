@@ -0,0 +1,156 @@
+package proxysettings
+
+import (
+	"fmt"
+	"strconv"
+	"text/template"
+	"time"
+	"unicode"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+var tmpl = template.Must(template.New("proxy settings policy").Parse(proxySettingsTemplate))
+
+// The grpc directives are set alongside the proxy directives, so that the timeouts apply to
+// both HTTPRoutes and GRPCRoutes when the policy is inherited from the Gateway.
+const proxySettingsTemplate = `
+{{- if .Timeouts }}
+	{{- if .Timeouts.Connect }}
+proxy_connect_timeout {{ .Timeouts.Connect }};
+grpc_connect_timeout {{ .Timeouts.Connect }};
+	{{- end }}
+	{{- if .Timeouts.Send }}
+proxy_send_timeout {{ .Timeouts.Send }};
+grpc_send_timeout {{ .Timeouts.Send }};
+	{{- end }}
+	{{- if .Timeouts.Read }}
+proxy_read_timeout {{ .Timeouts.Read }};
+grpc_read_timeout {{ .Timeouts.Read }};
+	{{- end }}
+{{- end }}
+{{- if .NoEndpointsReturn }}
+	{{- if .NoEndpointsReturn.RetryAfter }}
+add_header Retry-After {{ .NoEndpointsReturn.RetryAfter }} always;
+	{{- end }}
+return {{ .NoEndpointsReturn.Code }};
+{{- end }}
+`
+
+// proxySettings holds the settings of a ProxySettingsPolicy for the template.
+type proxySettings struct {
+	Timeouts *ngfAPI.ProxyTimeouts
+	// NoEndpointsReturn is the response to the requests of a location that proxies to backends without endpoints.
+	// It is nil if the location has endpoints, or if the settings are not for a location.
+	NoEndpointsReturn *noEndpointsReturn
+}
+
+// noEndpointsReturn is the response to the requests of a location that proxies to backends without endpoints.
+type noEndpointsReturn struct {
+	// RetryAfter is the value of the Retry-After header. It is empty if the header is not set.
+	RetryAfter string
+	Code       int
+}
+
+// Generator generates nginx configuration based on a proxysettings policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols, false)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	return generate(pols, location.NoEndpoints)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	location http.Location,
+) policies.GenerateResultFiles {
+	return generate(pols, location.NoEndpoints)
+}
+
+// generate generates the configuration of the policies. noEndpoints is true if the configuration is for a location
+// that proxies to backends without endpoints.
+func generate(pols []policies.Policy, noEndpoints bool) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		psp, ok := pol.(*ngfAPI.ProxySettingsPolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ProxySettingsPolicy_%s_%s.conf", psp.Namespace, psp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, newProxySettings(psp.Spec, noEndpoints)),
+		})
+	}
+
+	return files
+}
+
+func newProxySettings(spec ngfAPI.ProxySettingsPolicySpec, noEndpoints bool) proxySettings {
+	settings := proxySettings{
+		Timeouts: spec.Timeouts,
+	}
+
+	if !noEndpoints || spec.NoEndpoints == nil {
+		return settings
+	}
+
+	switch spec.NoEndpoints.Action {
+	case ngfAPI.NoEndpointsActionReturn503:
+		settings.NoEndpointsReturn = &noEndpointsReturn{Code: 503}
+		if spec.NoEndpoints.RetryAfterSeconds != nil {
+			settings.NoEndpointsReturn.RetryAfter = strconv.Itoa(int(*spec.NoEndpoints.RetryAfterSeconds))
+		}
+	default:
+		settings.NoEndpointsReturn = &noEndpointsReturn{Code: 502}
+	}
+
+	return settings
+}
+
+// HedgingDelay returns the hedging delay of the ProxySettingsPolicy of the policies, and false if none of
+// the policies hedges the requests. The locations with a hedging delay proxy their idempotent requests with
+// subrequests, which are duplicated after the delay.
+func HedgingDelay(pols []policies.Policy) (time.Duration, bool) {
+	for _, pol := range pols {
+		psp, ok := pol.(*ngfAPI.ProxySettingsPolicy)
+		if !ok || psp.Spec.Hedging == nil {
+			continue
+		}
+
+		// the delay is validated by the Validator
+		delay, err := parseDuration(psp.Spec.Hedging.Delay)
+		if err != nil {
+			continue
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// parseDuration parses the Duration into a time.Duration. NGINX treats a Duration without a unit as seconds.
+func parseDuration(d ngfAPI.Duration) (time.Duration, error) {
+	value := string(d)
+	if value != "" && unicode.IsDigit(rune(value[len(value)-1])) {
+		value += "s"
+	}
+
+	return time.ParseDuration(value)
+}
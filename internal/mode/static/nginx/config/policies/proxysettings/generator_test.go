@@ -0,0 +1,273 @@
+package proxysettings_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	connectTimeout := helpers.GetPointer[ngfAPI.Duration]("5s")
+	sendTimeout := helpers.GetPointer[ngfAPI.Duration]("30s")
+	readTimeout := helpers.GetPointer[ngfAPI.Duration]("2m")
+
+	tests := []struct {
+		name       string
+		policy     policies.Policy
+		expStrings []string
+	}{
+		{
+			name: "connect timeout populated",
+			policy: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Connect: connectTimeout,
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_connect_timeout 5s;",
+				"grpc_connect_timeout 5s;",
+			},
+		},
+		{
+			name: "send timeout populated",
+			policy: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Send: sendTimeout,
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_send_timeout 30s;",
+				"grpc_send_timeout 30s;",
+			},
+		},
+		{
+			name: "read timeout populated",
+			policy: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Read: readTimeout,
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_read_timeout 2m;",
+				"grpc_read_timeout 2m;",
+			},
+		},
+		{
+			name: "all fields populated",
+			policy: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Connect: connectTimeout,
+						Send:    sendTimeout,
+						Read:    readTimeout,
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_connect_timeout 5s;",
+				"grpc_connect_timeout 5s;",
+				"proxy_send_timeout 30s;",
+				"grpc_send_timeout 30s;",
+				"proxy_read_timeout 2m;",
+				"grpc_read_timeout 2m;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			generator := proxysettings.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings)
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings)
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings)
+		})
+	}
+}
+
+func TestGenerateNoEndpoints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		noEndpoints   *ngfAPI.NoEndpoints
+		name          string
+		expContent    string
+		locationEmpty bool
+	}{
+		{
+			name: "return 503 with Retry-After",
+			noEndpoints: &ngfAPI.NoEndpoints{
+				Action:            ngfAPI.NoEndpointsActionReturn503,
+				RetryAfterSeconds: helpers.GetPointer[int32](10),
+			},
+			locationEmpty: true,
+			expContent:    "\nadd_header Retry-After 10 always;\nreturn 503;\n",
+		},
+		{
+			name:          "return 503",
+			noEndpoints:   &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn503},
+			locationEmpty: true,
+			expContent:    "\nreturn 503;\n",
+		},
+		{
+			name:          "return 502",
+			noEndpoints:   &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn502},
+			locationEmpty: true,
+			expContent:    "\nreturn 502;\n",
+		},
+		{
+			name:          "location has endpoints",
+			noEndpoints:   &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn503},
+			locationEmpty: false,
+			expContent:    "\n",
+		},
+		{
+			name:          "noEndpoints not set",
+			locationEmpty: true,
+			expContent:    "\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := proxysettings.NewGenerator()
+			pols := []policies.Policy{
+				&ngfAPI.ProxySettingsPolicy{
+					Spec: ngfAPI.ProxySettingsPolicySpec{NoEndpoints: test.noEndpoints},
+				},
+			}
+			location := http.Location{NoEndpoints: test.locationEmpty}
+
+			resFiles := generator.GenerateForLocation(pols, location)
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+
+			resFiles = generator.GenerateForInternalLocation(pols, location)
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+
+			resFiles = generator.GenerateForServer(pols, http.Server{})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("return"))
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := proxysettings.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestHedgingDelay(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		policies []policies.Policy
+		expDelay time.Duration
+		expOK    bool
+	}{
+		{
+			name: "no policies",
+		},
+		{
+			name: "no hedging",
+			policies: []policies.Policy{
+				&ngfAPI.ProxySettingsPolicy{
+					Spec: ngfAPI.ProxySettingsPolicySpec{
+						Timeouts: &ngfAPI.ProxyTimeouts{Read: helpers.GetPointer[ngfAPI.Duration]("10s")},
+					},
+				},
+				&ngfAPI.ClientSettingsPolicy{},
+			},
+		},
+		{
+			name: "hedging",
+			policies: []policies.Policy{
+				&ngfAPI.ClientSettingsPolicy{},
+				&ngfAPI.ProxySettingsPolicy{
+					Spec: ngfAPI.ProxySettingsPolicySpec{
+						Hedging: &ngfAPI.ProxyHedging{Delay: "150ms"},
+					},
+				},
+			},
+			expDelay: 150 * time.Millisecond,
+			expOK:    true,
+		},
+		{
+			name: "hedging delay without a unit",
+			policies: []policies.Policy{
+				&ngfAPI.ProxySettingsPolicy{
+					Spec: ngfAPI.ProxySettingsPolicySpec{
+						Hedging: &ngfAPI.ProxyHedging{Delay: "2"},
+					},
+				},
+			},
+			expDelay: 2 * time.Second,
+			expOK:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			delay, ok := proxysettings.HedgingDelay(test.policies)
+			g.Expect(ok).To(Equal(test.expOK))
+			g.Expect(delay).To(Equal(test.expDelay))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package proxysettings
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// Merger merges ProxySettingsPolicies.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge returns a copy of the child ProxySettingsPolicy, in which the settings that are not set are inherited
+// from the parent ProxySettingsPolicy.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentPSP := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](parent)
+	childPSP := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](child)
+
+	merged := childPSP.DeepCopy()
+
+	if parentPSP.Spec.Timeouts != nil {
+		if merged.Spec.Timeouts == nil {
+			merged.Spec.Timeouts = &ngfAPI.ProxyTimeouts{}
+		}
+
+		merged.Spec.Timeouts.Connect = policies.Inherit(parentPSP.Spec.Timeouts.Connect, merged.Spec.Timeouts.Connect)
+		merged.Spec.Timeouts.Send = policies.Inherit(parentPSP.Spec.Timeouts.Send, merged.Spec.Timeouts.Send)
+		merged.Spec.Timeouts.Read = policies.Inherit(parentPSP.Spec.Timeouts.Read, merged.Spec.Timeouts.Read)
+	}
+
+	merged.Spec.NoEndpoints = policies.Inherit(parentPSP.Spec.NoEndpoints, merged.Spec.NoEndpoints)
+
+	return merged
+}
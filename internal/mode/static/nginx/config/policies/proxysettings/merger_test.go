@@ -0,0 +1,122 @@
+package proxysettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(name string, timeouts *ngfAPI.ProxyTimeouts) *ngfAPI.ProxySettingsPolicy {
+		return &ngfAPI.ProxySettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: ngfAPI.ProxySettingsPolicySpec{
+				Timeouts: timeouts,
+			},
+		}
+	}
+
+	tests := []struct {
+		parent      *ngfAPI.ProxySettingsPolicy
+		child       *ngfAPI.ProxySettingsPolicy
+		expTimeouts *ngfAPI.ProxyTimeouts
+		name        string
+	}{
+		{
+			name:        "nothing set",
+			parent:      createPolicy("parent", nil),
+			child:       createPolicy("child", nil),
+			expTimeouts: nil,
+		},
+		{
+			name: "all settings inherited",
+			parent: createPolicy("parent", &ngfAPI.ProxyTimeouts{
+				Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Send:    helpers.GetPointer[ngfAPI.Duration]("30s"),
+				Read:    helpers.GetPointer[ngfAPI.Duration]("2m"),
+			}),
+			child: createPolicy("child", nil),
+			expTimeouts: &ngfAPI.ProxyTimeouts{
+				Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Send:    helpers.GetPointer[ngfAPI.Duration]("30s"),
+				Read:    helpers.GetPointer[ngfAPI.Duration]("2m"),
+			},
+		},
+		{
+			name: "child settings override parent settings",
+			parent: createPolicy("parent", &ngfAPI.ProxyTimeouts{
+				Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Read:    helpers.GetPointer[ngfAPI.Duration]("2m"),
+			}),
+			child: createPolicy("child", &ngfAPI.ProxyTimeouts{
+				Read: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				Send: helpers.GetPointer[ngfAPI.Duration]("1s"),
+			}),
+			expTimeouts: &ngfAPI.ProxyTimeouts{
+				Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Send:    helpers.GetPointer[ngfAPI.Duration]("1s"),
+				Read:    helpers.GetPointer[ngfAPI.Duration]("10s"),
+			},
+		},
+	}
+
+	merger := proxysettings.NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			child := test.child.DeepCopy()
+
+			merged := merger.Merge(test.parent, test.child)
+
+			psp, ok := merged.(*ngfAPI.ProxySettingsPolicy)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(psp.Name).To(Equal("child"))
+			g.Expect(psp.Spec.Timeouts).To(Equal(test.expTimeouts))
+			g.Expect(test.child).To(Equal(child))
+		})
+	}
+}
+
+func TestMerger_MergeNoEndpoints(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	parent := &ngfAPI.ProxySettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "parent", Namespace: "default"},
+		Spec: ngfAPI.ProxySettingsPolicySpec{
+			NoEndpoints: &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn503},
+		},
+	}
+	child := &ngfAPI.ProxySettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: "default"},
+	}
+
+	merger := proxysettings.NewMerger()
+
+	merged := merger.Merge(parent, child)
+
+	psp, ok := merged.(*ngfAPI.ProxySettingsPolicy)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(psp.Spec.NoEndpoints).To(Equal(&ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn503}))
+
+	child.Spec.NoEndpoints = &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn502}
+
+	merged = merger.Merge(parent, child)
+
+	psp, ok = merged.(*ngfAPI.ProxySettingsPolicy)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(psp.Spec.NoEndpoints).To(Equal(&ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn502}))
+}
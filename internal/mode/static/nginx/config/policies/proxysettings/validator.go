@@ -0,0 +1,139 @@
+package proxysettings
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates a ProxySettingsPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a ProxySettingsPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	psp := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute, kinds.GRPCRoute}
+	targetRef := psp.Spec.TargetRef.LocalPolicyTargetReference
+	if err := policies.ValidateTargetRef(targetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := policies.ValidateTargetRefSectionName(psp.Spec.TargetRef, targetRefPath); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if psp.Spec.NoEndpoints != nil && targetRef.Kind == kinds.Gateway {
+		path := field.NewPath("spec").Child("noEndpoints")
+		err := field.Forbidden(path, "noEndpoints is only supported for HTTPRoute and GRPCRoute")
+
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if psp.Spec.Hedging != nil && targetRef.Kind != kinds.HTTPRoute {
+		path := field.NewPath("spec").Child("hedging")
+		err := field.Forbidden(path, "hedging is only supported for HTTPRoute")
+
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := v.validateSettings(psp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ProxySettingsPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	pspA := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](polA)
+	pspB := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](polB)
+
+	return conflicts(pspA.Spec, pspB.Spec)
+}
+
+func conflicts(a, b ngfAPI.ProxySettingsPolicySpec) bool {
+	if a.Timeouts != nil && b.Timeouts != nil {
+		if a.Timeouts.Connect != nil && b.Timeouts.Connect != nil {
+			return true
+		}
+
+		if a.Timeouts.Send != nil && b.Timeouts.Send != nil {
+			return true
+		}
+
+		if a.Timeouts.Read != nil && b.Timeouts.Read != nil {
+			return true
+		}
+	}
+
+	if a.Hedging != nil && b.Hedging != nil {
+		return true
+	}
+
+	return a.NoEndpoints != nil && b.NoEndpoints != nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ProxySettingsPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Timeouts != nil {
+		allErrs = append(allErrs, v.validateTimeouts(*spec.Timeouts, fieldPath.Child("timeouts"))...)
+	}
+
+	if spec.Hedging != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(spec.Hedging.Delay)); err != nil {
+			path := fieldPath.Child("hedging").Child("delay")
+
+			allErrs = append(allErrs, field.Invalid(path, spec.Hedging.Delay, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateTimeouts(timeouts ngfAPI.ProxyTimeouts, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	durations := []struct {
+		value *ngfAPI.Duration
+		name  string
+	}{
+		{name: "connect", value: timeouts.Connect},
+		{name: "send", value: timeouts.Send},
+		{name: "read", value: timeouts.Read},
+	}
+
+	for _, d := range durations {
+		if d.value == nil {
+			continue
+		}
+
+		if err := v.genericValidator.ValidateNginxDuration(string(*d.value)); err != nil {
+			path := fieldPath.Child(d.name)
+
+			allErrs = append(allErrs, field.Invalid(path, *d.value, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,324 @@
+package proxysettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy
+
+func createValidPolicy() *ngfAPI.ProxySettingsPolicy {
+	return &ngfAPI.ProxySettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ProxySettingsPolicySpec{
+			TargetRef: v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			Timeouts: &ngfAPI.ProxyTimeouts{
+				Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Send:    helpers.GetPointer[ngfAPI.Duration]("30s"),
+				Read:    helpers.GetPointer[ngfAPI.Duration]("2m"),
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ProxySettingsPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ProxySettingsPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.kind: Unsupported value: \"Unsupported\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid target ref; sectionName for a route",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("rule-1")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.sectionName: Forbidden: " +
+					"sectionName can only be specified if the targetRef kind is Gateway"),
+			},
+		},
+		{
+			name: "valid target ref; sectionName for a gateway listener",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("listener")
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "invalid durations",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Timeouts.Connect = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.Timeouts.Send = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.Timeouts.Read = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.timeouts.connect: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
+						"spec.timeouts.send: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
+						"spec.timeouts.read: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
+			},
+		},
+		{
+			name: "invalid noEndpoints; gateway target",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.NoEndpoints = &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn503}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.noEndpoints: Forbidden: noEndpoints is only supported for HTTPRoute and GRPCRoute",
+				),
+			},
+		},
+		{
+			name: "valid noEndpoints; route target",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.NoEndpoints = &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn503}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "invalid hedging; grpcroute target",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.GRPCRoute
+				p.Spec.Hedging = &ngfAPI.ProxyHedging{Delay: "50ms"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.hedging: Forbidden: hedging is only supported for HTTPRoute"),
+			},
+		},
+		{
+			name: "invalid hedging delay",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.Hedging = &ngfAPI.ProxyHedging{Delay: "invalid"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.hedging.delay: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')"),
+			},
+		},
+		{
+			name: "valid hedging; httproute target",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.Hedging = &ngfAPI.ProxyHedging{Delay: "50ms"}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := proxysettings.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := proxysettings.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.ProxySettingsPolicy
+		polB      *ngfAPI.ProxySettingsPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Send: helpers.GetPointer[ngfAPI.Duration]("30s"),
+						Read: helpers.GetPointer[ngfAPI.Duration]("2m"),
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "connect timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "send timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Send: helpers.GetPointer[ngfAPI.Duration]("30s"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "read timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeouts: &ngfAPI.ProxyTimeouts{
+						Read: helpers.GetPointer[ngfAPI.Duration]("2m"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "noEndpoints conflicts",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					NoEndpoints: &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn503},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					NoEndpoints: &ngfAPI.NoEndpoints{Action: ngfAPI.NoEndpointsActionReturn502},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "hedging conflicts",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Hedging: &ngfAPI.ProxyHedging{Delay: "50ms"},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Hedging: &ngfAPI.ProxyHedging{Delay: "100ms"},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := proxysettings.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := proxysettings.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
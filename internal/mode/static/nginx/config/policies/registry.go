@@ -0,0 +1,74 @@
+package policies
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Registration contains everything NGF needs to support a Policy that is not built into NGF.
+// NGF watches the registered Policies, validates them with their Validator, attaches them to their targets,
+// writes their statuses, and generates their configuration with their Generator, like the built-in Policies.
+type Registration struct {
+	// Object is an empty object of the Policy. For example, &v1alpha1.MyPolicy{}.
+	Object Policy
+	// ObjectList is an empty list of the Policy. For example, &v1alpha1.MyPolicyList{}.
+	ObjectList client.ObjectList
+	// AddToScheme adds the types of the Policy to the scheme of NGF.
+	AddToScheme func(scheme *runtime.Scheme) error
+	// Validator is the Validator for the Policy.
+	Validator Validator
+	// Generator is the Generator for the Policy. It gets the Policies of all kinds, so it must ignore the Policies
+	// of the other kinds.
+	Generator Generator
+	// Merger is the Merger for the Policy. It is only set for Inherited Policies.
+	Merger Merger
+	// LocationScoped is true if the configuration of the Policy must be generated in every location.
+	// See ManagerConfig.LocationScoped. It is only set for Inherited Policies.
+	LocationScoped bool
+}
+
+var (
+	registrations     []Registration
+	registrationsLock sync.RWMutex
+)
+
+// Register registers a Policy with NGF. It must be called before NGF starts, for example, in the init function
+// of the package of the Policy, which is imported by the main package of a distribution of NGF.
+// Register panics if any of the required fields of the Registration is nil, or the Policy is already registered.
+func Register(reg Registration) {
+	switch {
+	case reg.Object == nil:
+		panic("policy registration has no object")
+	case reg.ObjectList == nil:
+		panic(fmt.Sprintf("policy registration of %T has no object list", reg.Object))
+	case reg.AddToScheme == nil:
+		panic(fmt.Sprintf("policy registration of %T has no AddToScheme function", reg.Object))
+	case reg.Validator == nil:
+		panic(fmt.Sprintf("policy registration of %T has no validator", reg.Object))
+	case reg.Generator == nil:
+		panic(fmt.Sprintf("policy registration of %T has no generator", reg.Object))
+	}
+
+	registrationsLock.Lock()
+	defer registrationsLock.Unlock()
+
+	policyType := fmt.Sprintf("%T", reg.Object)
+	for _, r := range registrations {
+		if fmt.Sprintf("%T", r.Object) == policyType {
+			panic(fmt.Sprintf("policy %s is already registered", policyType))
+		}
+	}
+
+	registrations = append(registrations, reg)
+}
+
+// Registered returns the registered Policies in the order of their registration.
+func Registered() []Registration {
+	registrationsLock.RLock()
+	defer registrationsLock.RUnlock()
+
+	return append([]Registration(nil), registrations...)
+}
@@ -0,0 +1,60 @@
+package policies_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+)
+
+var _ = Describe("Policy registry", Ordered, func() {
+	addToScheme := func(_ *runtime.Scheme) error { return nil }
+
+	validRegistration := func() policies.Registration {
+		return policies.Registration{
+			Object:      &policiesfakes.FakePolicy{},
+			ObjectList:  &ngfAPI.ClientSettingsPolicyList{},
+			AddToScheme: addToScheme,
+			Validator:   &policiesfakes.FakeValidator{},
+			Generator:   &policiesfakes.FakeGenerator{},
+		}
+	}
+
+	It("registers a Policy", func() {
+		Expect(policies.Registered()).To(BeEmpty())
+
+		reg := validRegistration()
+		Expect(func() { policies.Register(reg) }).ToNot(Panic())
+
+		registered := policies.Registered()
+		Expect(registered).To(HaveLen(1))
+		Expect(registered[0].Object).To(Equal(reg.Object))
+		Expect(registered[0].Validator).To(BeIdenticalTo(reg.Validator))
+		Expect(registered[0].Generator).To(BeIdenticalTo(reg.Generator))
+	})
+
+	It("panics if the Policy is already registered", func() {
+		Expect(func() { policies.Register(validRegistration()) }).To(Panic())
+		Expect(policies.Registered()).To(HaveLen(1))
+	})
+
+	DescribeTable(
+		"panics if a required field is not set",
+		func(modify func(reg *policies.Registration)) {
+			reg := validRegistration()
+			reg.Object = &ngfAPI.ClientSettingsPolicy{}
+			modify(&reg)
+
+			Expect(func() { policies.Register(reg) }).To(Panic())
+			Expect(policies.Registered()).To(HaveLen(1))
+		},
+		Entry("object", func(reg *policies.Registration) { reg.Object = nil }),
+		Entry("object list", func(reg *policies.Registration) { reg.ObjectList = nil }),
+		Entry("AddToScheme", func(reg *policies.Registration) { reg.AddToScheme = nil }),
+		Entry("validator", func(reg *policies.Registration) { reg.Validator = nil }),
+		Entry("generator", func(reg *policies.Registration) { reg.Generator = nil }),
+	)
+})
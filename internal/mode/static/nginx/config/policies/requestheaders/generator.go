@@ -0,0 +1,106 @@
+package requestheaders
+
+import (
+	"fmt"
+	"text/template"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/geoip"
+)
+
+var tmpl = template.Must(template.New("request headers policy").Parse(requestHeadersTemplate))
+
+const requestHeadersTemplate = `
+{{- range $h := .Headers }}
+{{ $.HeaderDirective }} {{ $h.Name }} "{{ $h.Value }}";
+{{- end }}
+`
+
+// requestHeaders holds the data for the RequestHeadersPolicy template.
+type requestHeaders struct {
+	HeaderDirective string
+	Headers         []http.Header
+}
+
+// Generator generates nginx configuration based on a RequestHeadersPolicy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+// The locations don't inherit the proxy_set_header directives of the server block, because they have their own,
+// so the headers are set in the locations. RequestHeadersPolicies are location scoped, so that the locations get
+// the policies of the Gateway.
+func (g Generator) GenerateForServer(_ []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return nil
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// When a normal location redirects to internal locations, the headers are set in the internal locations
+// that proxy the requests.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return generate(pols, location)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	location http.Location,
+) policies.GenerateResultFiles {
+	return generate(pols, location)
+}
+
+func generate(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	headerDirective := "proxy_set_header"
+	if location.GRPC {
+		headerDirective = "grpc_set_header"
+	}
+
+	for _, pol := range pols {
+		rhp, ok := pol.(*ngfAPI.RequestHeadersPolicy)
+		if !ok {
+			continue
+		}
+
+		if rhp.Spec.Disable != nil && *rhp.Spec.Disable {
+			continue
+		}
+
+		data := requestHeaders{HeaderDirective: headerDirective}
+
+		for _, header := range rhp.Spec.Headers {
+			var value string
+			switch {
+			case header.Value != nil:
+				value = *header.Value
+			case header.GeoIPField != nil:
+				value, _ = geoip.FieldVariable(*header.GeoIPField)
+			}
+
+			data.Headers = append(data.Headers, http.Header{Name: header.Name, Value: value})
+		}
+
+		if len(data.Headers) == 0 {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("RequestHeadersPolicy_%s_%s.conf", rhp.Namespace, rhp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, data),
+		})
+	}
+
+	return files
+}
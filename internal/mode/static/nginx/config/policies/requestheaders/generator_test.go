@@ -0,0 +1,125 @@
+package requestheaders_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/requestheaders"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		expContent string
+		spec       ngfAPI.RequestHeadersPolicySpec
+		grpc       bool
+	}{
+		{
+			name: "static and GeoIP headers",
+			spec: ngfAPI.RequestHeadersPolicySpec{
+				Headers: []ngfAPI.DefaultRequestHeader{
+					{Name: "X-Environment", Value: helpers.GetPointer("production")},
+					{Name: "X-Country", GeoIPField: helpers.GetPointer(ngfAPI.GeoIPFieldCountryCode)},
+				},
+			},
+			expContent: "\nproxy_set_header X-Environment \"production\";\n" +
+				"proxy_set_header X-Country \"$geoip2_country_code\";\n",
+		},
+		{
+			name: "headers for gRPC",
+			spec: ngfAPI.RequestHeadersPolicySpec{
+				Headers: []ngfAPI.DefaultRequestHeader{
+					{Name: "X-Gateway", Value: helpers.GetPointer("gateway-eu-1")},
+				},
+			},
+			grpc:       true,
+			expContent: "\ngrpc_set_header X-Gateway \"gateway-eu-1\";\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.RequestHeadersPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-policy",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+
+			generator := requestheaders.NewGenerator()
+
+			g.Expect(generator.GenerateForServer([]policies.Policy{policy}, http.Server{})).To(BeEmpty())
+
+			redirectLocation := http.Location{Type: http.RedirectLocationType, GRPC: test.grpc}
+			g.Expect(generator.GenerateForLocation([]policies.Policy{policy}, redirectLocation)).To(BeEmpty())
+
+			externalLocation := http.Location{Type: http.ExternalLocationType, GRPC: test.grpc}
+			internalLocation := http.Location{Type: http.InternalLocationType, GRPC: test.grpc}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForLocation([]policies.Policy{policy}, externalLocation),
+				generator.GenerateForInternalLocation([]policies.Policy{policy}, internalLocation),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("RequestHeadersPolicy_test_my-policy.conf"))
+				g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+			}
+		})
+	}
+}
+
+func TestGenerateDisabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	policy := &ngfAPI.RequestHeadersPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-policy",
+			Namespace: "test",
+		},
+		Spec: ngfAPI.RequestHeadersPolicySpec{
+			Disable: helpers.GetPointer(true),
+			Headers: []ngfAPI.DefaultRequestHeader{
+				{Name: "X-Environment", Value: helpers.GetPointer("production")},
+			},
+		},
+	}
+
+	generator := requestheaders.NewGenerator()
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{policy}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{policy}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := requestheaders.NewGenerator()
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
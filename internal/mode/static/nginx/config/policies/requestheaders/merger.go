@@ -0,0 +1,44 @@
+package requestheaders
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// Merger merges RequestHeadersPolicies.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge returns a copy of the child RequestHeadersPolicy, in which the headers of the parent RequestHeadersPolicy
+// that the child doesn't set are inherited, and disable is inherited if the child doesn't set it.
+// The inherited headers come before the headers of the child.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentRHP := helpers.MustCastObject[*ngfAPI.RequestHeadersPolicy](parent)
+	childRHP := helpers.MustCastObject[*ngfAPI.RequestHeadersPolicy](child)
+
+	merged := childRHP.DeepCopy()
+
+	merged.Spec.Disable = policies.Inherit(parentRHP.Spec.Disable, merged.Spec.Disable)
+
+	childHeaders := make(map[string]struct{}, len(childRHP.Spec.Headers))
+	for _, header := range childRHP.Spec.Headers {
+		childHeaders[header.Name] = struct{}{}
+	}
+
+	headers := make([]ngfAPI.DefaultRequestHeader, 0, len(parentRHP.Spec.Headers)+len(merged.Spec.Headers))
+	for _, header := range parentRHP.Spec.Headers {
+		if _, overridden := childHeaders[header.Name]; !overridden {
+			headers = append(headers, *header.DeepCopy())
+		}
+	}
+
+	merged.Spec.Headers = append(headers, merged.Spec.Headers...)
+
+	return merged
+}
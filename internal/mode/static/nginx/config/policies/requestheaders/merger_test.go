@@ -0,0 +1,92 @@
+package requestheaders_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/requestheaders"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(name string, spec ngfAPI.RequestHeadersPolicySpec) *ngfAPI.RequestHeadersPolicy {
+		return &ngfAPI.RequestHeadersPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: spec,
+		}
+	}
+
+	environment := ngfAPI.DefaultRequestHeader{Name: "X-Environment", Value: helpers.GetPointer("production")}
+	country := ngfAPI.DefaultRequestHeader{
+		Name:       "X-Country",
+		GeoIPField: helpers.GetPointer(ngfAPI.GeoIPFieldCountryCode),
+	}
+	staging := ngfAPI.DefaultRequestHeader{Name: "X-Environment", Value: helpers.GetPointer("staging")}
+	team := ngfAPI.DefaultRequestHeader{Name: "X-Team", Value: helpers.GetPointer("coffee")}
+
+	parent := createPolicy("parent", ngfAPI.RequestHeadersPolicySpec{
+		Headers: []ngfAPI.DefaultRequestHeader{environment, country},
+	})
+
+	tests := []struct {
+		child   *ngfAPI.RequestHeadersPolicy
+		name    string
+		expSpec ngfAPI.RequestHeadersPolicySpec
+	}{
+		{
+			name:  "child inherits the headers",
+			child: createPolicy("child", ngfAPI.RequestHeadersPolicySpec{}),
+			expSpec: ngfAPI.RequestHeadersPolicySpec{
+				Headers: []ngfAPI.DefaultRequestHeader{environment, country},
+			},
+		},
+		{
+			name: "child overrides and adds headers",
+			child: createPolicy("child", ngfAPI.RequestHeadersPolicySpec{
+				Headers: []ngfAPI.DefaultRequestHeader{team, staging},
+			}),
+			expSpec: ngfAPI.RequestHeadersPolicySpec{
+				Headers: []ngfAPI.DefaultRequestHeader{country, team, staging},
+			},
+		},
+		{
+			name: "child disables the headers",
+			child: createPolicy("child", ngfAPI.RequestHeadersPolicySpec{
+				Disable: helpers.GetPointer(true),
+			}),
+			expSpec: ngfAPI.RequestHeadersPolicySpec{
+				Disable: helpers.GetPointer(true),
+				Headers: []ngfAPI.DefaultRequestHeader{environment, country},
+			},
+		},
+	}
+
+	merger := requestheaders.NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			child := test.child.DeepCopy()
+			parentCopy := parent.DeepCopy()
+
+			merged := merger.Merge(parent, child)
+
+			g.Expect(merged.GetName()).To(Equal("child"))
+			g.Expect(merged.(*ngfAPI.RequestHeadersPolicy).Spec).To(Equal(test.expSpec))
+
+			// the policies are not modified
+			g.Expect(child).To(Equal(test.child))
+			g.Expect(parent).To(Equal(parentCopy))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package requestheaders
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/geoip"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+var headerNameRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+// Validator validates a RequestHeadersPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a RequestHeadersPolicy.
+func (v *Validator) Validate(policy policies.Policy, globalSettings *policies.GlobalSettings) []conditions.Condition {
+	rhp := helpers.MustCastObject[*ngfAPI.RequestHeadersPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute, kinds.GRPCRoute}
+	targetRef := rhp.Spec.TargetRef.LocalPolicyTargetReference
+	if err := policies.ValidateTargetRef(targetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := policies.ValidateTargetRefSectionName(rhp.Spec.TargetRef, targetRefPath); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if rhp.Spec.Disable != nil && targetRef.Kind == kinds.Gateway {
+		path := field.NewPath("spec").Child("disable")
+		err := field.Forbidden(path, "disable is only supported for HTTPRoute and GRPCRoute")
+
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := v.validateSettings(rhp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if usesGeoIP(rhp.Spec) && (globalSettings == nil || !globalSettings.GeoIPEnabled) {
+		return []conditions.Condition{
+			staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageGeoIPNotEnabled),
+		}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two RequestHeadersPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	rhpA := helpers.MustCastObject[*ngfAPI.RequestHeadersPolicy](polA)
+	rhpB := helpers.MustCastObject[*ngfAPI.RequestHeadersPolicy](polB)
+
+	return conflicts(rhpA.Spec, rhpB.Spec)
+}
+
+func conflicts(a, b ngfAPI.RequestHeadersPolicySpec) bool {
+	if a.Disable != nil && b.Disable != nil {
+		return true
+	}
+
+	for _, headerA := range a.Headers {
+		for _, headerB := range b.Headers {
+			if headerA.Name == headerB.Name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.RequestHeadersPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if len(spec.Headers) == 0 && spec.Disable == nil {
+		allErrs = append(allErrs, field.Required(fieldPath, "at least one of headers or disable must be specified"))
+	}
+
+	headersPath := fieldPath.Child("headers")
+	for i, header := range spec.Headers {
+		allErrs = append(allErrs, v.validateHeader(header, headersPath.Index(i))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateHeader(header ngfAPI.DefaultRequestHeader, headerPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !headerNameRegexp.MatchString(header.Name) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(headerPath.Child("name"), header.Name, "must contain only alphanumeric characters or '-'"),
+		)
+	}
+
+	if (header.Value == nil) == (header.GeoIPField == nil) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(headerPath, header.Name, "exactly one of value or geoIPField must be specified"),
+		)
+	}
+
+	if header.Value != nil {
+		if err := v.genericValidator.ValidateEscapedStringNoVarExpansion(*header.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(headerPath.Child("value"), *header.Value, err.Error()))
+		}
+	}
+
+	if header.GeoIPField != nil {
+		if _, ok := geoip.FieldVariable(*header.GeoIPField); !ok {
+			allErrs = append(
+				allErrs,
+				field.NotSupported(
+					headerPath.Child("geoIPField"),
+					*header.GeoIPField,
+					[]ngfAPI.GeoIPField{
+						ngfAPI.GeoIPFieldCountryCode,
+						ngfAPI.GeoIPFieldCountryName,
+						ngfAPI.GeoIPFieldContinentCode,
+						ngfAPI.GeoIPFieldCityName,
+						ngfAPI.GeoIPFieldSubdivisionCode,
+					},
+				),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func usesGeoIP(spec ngfAPI.RequestHeadersPolicySpec) bool {
+	for _, header := range spec.Headers {
+		if header.GeoIPField != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,257 @@
+package requestheaders_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/requestheaders"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy
+
+func createValidPolicy() *ngfAPI.RequestHeadersPolicy {
+	return &ngfAPI.RequestHeadersPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.RequestHeadersPolicySpec{
+			TargetRef: v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			Headers: []ngfAPI.DefaultRequestHeader{
+				{Name: "X-Environment", Value: helpers.GetPointer("production")},
+				{Name: "X-Country", GeoIPField: helpers.GetPointer(ngfAPI.GeoIPFieldCountryCode)},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.RequestHeadersPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		policy         *ngfAPI.RequestHeadersPolicy
+		globalSettings *policies.GlobalSettings
+		expConditions  []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy {
+				p.Spec.TargetRef.Kind = "Unsupported"
+				return p
+			}),
+			globalSettings: &policies.GlobalSettings{GeoIPEnabled: true},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.kind: Unsupported value: \"Unsupported\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid target ref; sectionName for a route",
+			policy: createModifiedPolicy(func(p *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("rule-1")
+				return p
+			}),
+			globalSettings: &policies.GlobalSettings{GeoIPEnabled: true},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.sectionName: Forbidden: " +
+					"sectionName can only be specified if the targetRef kind is Gateway"),
+			},
+		},
+		{
+			name: "invalid disable; gateway target",
+			policy: createModifiedPolicy(func(p *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy {
+				p.Spec.Disable = helpers.GetPointer(true)
+				return p
+			}),
+			globalSettings: &policies.GlobalSettings{GeoIPEnabled: true},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.disable: Forbidden: disable is only supported for HTTPRoute and GRPCRoute",
+				),
+			},
+		},
+		{
+			name: "valid disable; route target",
+			policy: createModifiedPolicy(func(p *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.Disable = helpers.GetPointer(true)
+				p.Spec.Headers = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "invalid headers",
+			policy: createModifiedPolicy(func(p *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy {
+				p.Spec.Headers = []ngfAPI.DefaultRequestHeader{
+					{Name: "X_Environment", Value: helpers.GetPointer("production")},
+					{Name: "X-Value", Value: helpers.GetPointer("$host")},
+					{Name: "X-Both", Value: helpers.GetPointer("a"), GeoIPField: helpers.GetPointer(ngfAPI.GeoIPFieldCityName)},
+					{Name: "X-Field", GeoIPField: helpers.GetPointer[ngfAPI.GeoIPField]("Unsupported")},
+				}
+				return p
+			}),
+			globalSettings: &policies.GlobalSettings{GeoIPEnabled: true},
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.headers[0].name: Invalid value: \"X_Environment\": " +
+						"must contain only alphanumeric characters or '-', " +
+						"spec.headers[1].value: Invalid value: \"$host\": " +
+						"a valid value must have all '\"' escaped and must not contain any '$' or end with an " +
+						"unescaped '\\' (regex used for validation is '([^\"$\\\\]|\\\\[^$])*'), " +
+						"spec.headers[2]: Invalid value: \"X-Both\": exactly one of value or geoIPField must be specified, " +
+						"spec.headers[3].geoIPField: Unsupported value: \"Unsupported\": supported values: " +
+						"\"CountryCode\", \"CountryName\", \"ContinentCode\", \"CityName\", \"SubdivisionCode\"]",
+				),
+			},
+		},
+		{
+			name: "no headers",
+			policy: createModifiedPolicy(func(p *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy {
+				p.Spec.Headers = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec: Required value: at least one of headers or disable must be specified"),
+			},
+		},
+		{
+			name:   "geoIP field; GeoIP not enabled",
+			policy: createValidPolicy(),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageGeoIPNotEnabled),
+			},
+		},
+		{
+			name: "valid; static values without GeoIP",
+			policy: createModifiedPolicy(func(p *ngfAPI.RequestHeadersPolicy) *ngfAPI.RequestHeadersPolicy {
+				p.Spec.Headers = p.Spec.Headers[:1]
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:           "valid",
+			policy:         createValidPolicy(),
+			globalSettings: &policies.GlobalSettings{GeoIPEnabled: true},
+			expConditions:  nil,
+		},
+	}
+
+	v := requestheaders.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, test.globalSettings)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := requestheaders.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(disable *bool, names ...string) *ngfAPI.RequestHeadersPolicy {
+		policy := &ngfAPI.RequestHeadersPolicy{
+			Spec: ngfAPI.RequestHeadersPolicySpec{Disable: disable},
+		}
+
+		for _, name := range names {
+			policy.Spec.Headers = append(policy.Spec.Headers, ngfAPI.DefaultRequestHeader{
+				Name:  name,
+				Value: helpers.GetPointer("value"),
+			})
+		}
+
+		return policy
+	}
+
+	tests := []struct {
+		polA      *ngfAPI.RequestHeadersPolicy
+		polB      *ngfAPI.RequestHeadersPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name:      "no conflicts",
+			polA:      createPolicy(nil, "X-Environment"),
+			polB:      createPolicy(helpers.GetPointer(true), "X-Gateway"),
+			conflicts: false,
+		},
+		{
+			name:      "same header",
+			polA:      createPolicy(nil, "X-Environment", "X-Gateway"),
+			polB:      createPolicy(nil, "X-Gateway"),
+			conflicts: true,
+		},
+		{
+			name:      "both disable",
+			polA:      createPolicy(helpers.GetPointer(true)),
+			polB:      createPolicy(helpers.GetPointer(false)),
+			conflicts: true,
+		},
+	}
+
+	v := requestheaders.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := requestheaders.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
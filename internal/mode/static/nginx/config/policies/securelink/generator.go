@@ -0,0 +1,134 @@
+package securelink
+
+import (
+	"fmt"
+	"path/filepath"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+const (
+	// secretVariable is the variable that the secret file of a policy sets to the secret.
+	secretVariable = "$ngf_secure_link_secret"
+	// defaultSignatureParameter is the default query parameter of the signature of the URLs.
+	defaultSignatureParameter = "md5"
+)
+
+var tmpl = template.Must(template.New("secure link policy").Parse(secureLinkTemplate))
+
+// The signed string uses $request_uri_path rather than $uri, because $uri is the path of the internal location
+// in the internal locations.
+const secureLinkTemplate = `
+include {{ .SecretPath }};
+secure_link $arg_{{ .SignatureParameter }}{{ if .ExpiresParameter }},$arg_{{ .ExpiresParameter }}{{ end }};
+secure_link_md5 "$secure_link_expires$request_uri_path
+{{- if .BindClientIP }}$remote_addr{{ end }} {{ .SecretVariable }}";
+if ($secure_link = "") {
+    return 403;
+}
+{{- if .ExpiresParameter }}
+if ($secure_link = "0") {
+    return 410;
+}
+{{- end }}
+`
+
+// secureLink holds the data for the SecureLink policy template.
+type secureLink struct {
+	SecretPath         string
+	SecretVariable     string
+	SignatureParameter string
+	ExpiresParameter   string
+	BindClientIP       bool
+}
+
+// Generator generates nginx configuration based on a SecureLink policy.
+type Generator struct {
+	secretsFolder string
+}
+
+// NewGenerator returns a new instance of Generator. The secretsFolder is the folder where the secret files
+// of the policies are written.
+func NewGenerator(secretsFolder string) *Generator {
+	return &Generator{secretsFolder: secretsFolder}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+// SecureLinkPolicies only target HTTPRoutes, so no configuration is generated for the server block.
+func (g Generator) GenerateForServer(_ []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return nil
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// When a normal location redirects to internal locations, the URLs are checked in the internal locations,
+// so that the URLs are not checked twice.
+func (g Generator) GenerateForLocation(pols []policies.Policy, location http.Location) policies.GenerateResultFiles {
+	if location.Type == http.RedirectLocationType {
+		return nil
+	}
+
+	return g.generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	_ http.Location,
+) policies.GenerateResultFiles {
+	return g.generate(pols)
+}
+
+func (g Generator) generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		sp, ok := pol.(*ngfAPI.SecureLinkPolicy)
+		if !ok {
+			continue
+		}
+
+		id := dataplane.GenerateSecureLinkSecretID(types.NamespacedName{Namespace: sp.Namespace, Name: sp.Name})
+		data := secureLink{
+			SecretPath:         SecretPath(g.secretsFolder, id),
+			SecretVariable:     secretVariable,
+			SignatureParameter: defaultSignatureParameter,
+		}
+
+		if sp.Spec.SignatureParameter != nil {
+			data.SignatureParameter = *sp.Spec.SignatureParameter
+		}
+
+		if sp.Spec.ExpiresParameter != nil {
+			data.ExpiresParameter = *sp.Spec.ExpiresParameter
+		}
+
+		if sp.Spec.BindClientIP != nil {
+			data.BindClientIP = *sp.Spec.BindClientIP
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("SecureLinkPolicy_%s_%s.conf", sp.Namespace, sp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, data),
+		})
+	}
+
+	return files
+}
+
+// SecretPath returns the path of the secret file of a policy in the secrets folder.
+func SecretPath(secretsFolder string, id dataplane.SecureLinkSecretID) string {
+	return filepath.Join(secretsFolder, string(id)+".conf")
+}
+
+// SecretFileContent returns the content of the secret file of a policy, which sets the variable that
+// the configuration of the policy signs the URLs with.
+func SecretFileContent(secret dataplane.SecureLinkSecret) []byte {
+	return []byte(fmt.Sprintf("set %s \"%s\";\n", secretVariable, secret))
+}
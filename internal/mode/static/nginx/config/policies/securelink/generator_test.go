@@ -0,0 +1,116 @@
+package securelink_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/securelink"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		expContent string
+		spec       ngfAPI.SecureLinkPolicySpec
+	}{
+		{
+			name: "defaults",
+			spec: ngfAPI.SecureLinkPolicySpec{},
+			expContent: `
+include /etc/nginx/secrets/secure_link_secret_test_my-policy.conf;
+secure_link $arg_md5;
+secure_link_md5 "$secure_link_expires$request_uri_path $ngf_secure_link_secret";
+if ($secure_link = "") {
+    return 403;
+}
+`,
+		},
+		{
+			name: "all fields",
+			spec: ngfAPI.SecureLinkPolicySpec{
+				SignatureParameter: helpers.GetPointer("token"),
+				ExpiresParameter:   helpers.GetPointer("expires"),
+				BindClientIP:       helpers.GetPointer(true),
+			},
+			expContent: `
+include /etc/nginx/secrets/secure_link_secret_test_my-policy.conf;
+secure_link $arg_token,$arg_expires;
+secure_link_md5 "$secure_link_expires$request_uri_path$remote_addr $ngf_secure_link_secret";
+if ($secure_link = "") {
+    return 403;
+}
+if ($secure_link = "0") {
+    return 410;
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := &ngfAPI.SecureLinkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-policy",
+					Namespace: "test",
+				},
+				Spec: test.spec,
+			}
+
+			generator := securelink.NewGenerator("/etc/nginx/secrets")
+
+			g.Expect(generator.GenerateForServer([]policies.Policy{policy}, http.Server{})).To(BeEmpty())
+
+			redirectLocation := http.Location{Type: http.RedirectLocationType}
+			g.Expect(generator.GenerateForLocation([]policies.Policy{policy}, redirectLocation)).To(BeEmpty())
+
+			externalLocation := http.Location{Type: http.ExternalLocationType}
+			internalLocation := http.Location{Type: http.InternalLocationType}
+
+			for _, resFiles := range []policies.GenerateResultFiles{
+				generator.GenerateForLocation([]policies.Policy{policy}, externalLocation),
+				generator.GenerateForInternalLocation([]policies.Policy{policy}, internalLocation),
+			} {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal("SecureLinkPolicy_test_my-policy.conf"))
+				g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+			}
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := securelink.NewGenerator("/etc/nginx/secrets")
+
+	resFiles := generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestSecretFileContent(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(string(securelink.SecretFileContent([]byte("0123456789abcdef")))).
+		To(Equal("set $ngf_secure_link_secret \"0123456789abcdef\";\n"))
+}
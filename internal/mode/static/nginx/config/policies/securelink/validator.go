@@ -0,0 +1,91 @@
+package securelink
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// parameterRegexp matches the names of the query parameters, which are used in NGINX variable names.
+var parameterRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// Validator validates a SecureLinkPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a SecureLinkPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	sp := helpers.MustCastObject[*ngfAPI.SecureLinkPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute}
+	for _, ref := range sp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := validateSettings(sp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two SecureLinkPolicies conflict. SecureLinkPolicies always conflict, because a URL
+// can only be signed with the secret of one policy.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.SecureLinkPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.SignatureParameter != nil {
+		allErrs = append(allErrs, validateParameter(fieldPath.Child("signatureParameter"), *spec.SignatureParameter)...)
+	}
+
+	if spec.ExpiresParameter != nil {
+		allErrs = append(allErrs, validateParameter(fieldPath.Child("expiresParameter"), *spec.ExpiresParameter)...)
+	}
+
+	if spec.SignatureParameter != nil && spec.ExpiresParameter != nil &&
+		*spec.SignatureParameter == *spec.ExpiresParameter {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				fieldPath.Child("expiresParameter"),
+				*spec.ExpiresParameter,
+				"must be different from signatureParameter",
+			),
+		)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateParameter(path *field.Path, parameter string) field.ErrorList {
+	if !parameterRegexp.MatchString(parameter) {
+		return field.ErrorList{
+			field.Invalid(path, parameter, "must contain only alphanumeric characters or '_'"),
+		}
+	}
+
+	return nil
+}
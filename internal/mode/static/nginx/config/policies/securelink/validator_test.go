@@ -0,0 +1,145 @@
+package securelink_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/securelink"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.SecureLinkPolicy) *ngfAPI.SecureLinkPolicy
+
+func createValidPolicy() *ngfAPI.SecureLinkPolicy {
+	return &ngfAPI.SecureLinkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.SecureLinkPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			SignatureParameter: helpers.GetPointer("token"),
+			ExpiresParameter:   helpers.GetPointer("expires"),
+			SecretRef: ngfAPI.SecureLinkSecretKeyReference{
+				Name: "secret",
+				Key:  "key",
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.SecureLinkPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.SecureLinkPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.SecureLinkPolicy) *ngfAPI.SecureLinkPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.Gateway
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"Gateway\": " +
+					"supported values: \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid parameters",
+			policy: createModifiedPolicy(func(p *ngfAPI.SecureLinkPolicy) *ngfAPI.SecureLinkPolicy {
+				p.Spec.SignatureParameter = helpers.GetPointer("to-ken")
+				p.Spec.ExpiresParameter = helpers.GetPointer("expires;")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.signatureParameter: Invalid value: \"to-ken\": " +
+						"must contain only alphanumeric characters or '_', " +
+						"spec.expiresParameter: Invalid value: \"expires;\": " +
+						"must contain only alphanumeric characters or '_']",
+				),
+			},
+		},
+		{
+			name: "same parameters",
+			policy: createModifiedPolicy(func(p *ngfAPI.SecureLinkPolicy) *ngfAPI.SecureLinkPolicy {
+				p.Spec.ExpiresParameter = helpers.GetPointer("token")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.expiresParameter: Invalid value: \"token\": must be different from signatureParameter",
+				),
+			},
+		},
+		{
+			name: "valid; defaults",
+			policy: createModifiedPolicy(func(p *ngfAPI.SecureLinkPolicy) *ngfAPI.SecureLinkPolicy {
+				p.Spec.SignatureParameter = nil
+				p.Spec.ExpiresParameter = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := securelink.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := securelink.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := securelink.NewValidator()
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
@@ -0,0 +1,108 @@
+package staticcontent
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var tmpl = template.Must(template.New("static content policy").Parse(staticContentTemplate))
+
+// The files are served by regular expression locations rather than exact locations, so that a Route rule
+// with the same exact path doesn't duplicate the location, and takes precedence over the file.
+// The regular expression locations are checked before the prefix locations of the Routes, because they
+// are included before them in the server block.
+const staticContentTemplate = `
+{{- range $f := .Files }}
+location ~ ^{{ $f.PathRegex }}$ {
+    {{- if $f.ContentType }}
+    types {}
+    default_type "{{ $f.ContentType }}";
+    {{- end }}
+    alias {{ $f.FilePath }};
+}
+{{- end }}
+`
+
+// staticContent holds the data for the StaticContent policy template.
+type staticContent struct {
+	Files []staticFile
+}
+
+type staticFile struct {
+	PathRegex   string
+	FilePath    string
+	ContentType string
+}
+
+// Generator generates nginx configuration based on a StaticContent policy.
+type Generator struct {
+	filesFolder string
+}
+
+// NewGenerator returns a new instance of Generator. The filesFolder is the folder where the files
+// of the policies are written.
+func NewGenerator(filesFolder string) *Generator {
+	return &Generator{filesFolder: filesFolder}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		scp, ok := pol.(*ngfAPI.StaticContentPolicy)
+		if !ok {
+			continue
+		}
+
+		policyNsName := types.NamespacedName{Namespace: scp.Namespace, Name: scp.Name}
+		data := staticContent{Files: make([]staticFile, 0, len(scp.Spec.Files))}
+
+		for _, file := range scp.Spec.Files {
+			f := staticFile{
+				PathRegex: regexp.QuoteMeta(file.Path),
+				FilePath:  FilePath(g.filesFolder, dataplane.GenerateStaticFileID(policyNsName, file.Key)),
+			}
+
+			if file.ContentType != nil {
+				f.ContentType = *file.ContentType
+			}
+
+			data.Files = append(data.Files, f)
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("StaticContentPolicy_%s_%s.conf", scp.Namespace, scp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, data),
+		})
+	}
+
+	return files
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+// StaticContentPolicies only generate configuration for the server block.
+func (g Generator) GenerateForLocation(_ []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return nil
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+// StaticContentPolicies only generate configuration for the server block.
+func (g Generator) GenerateForInternalLocation(_ []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return nil
+}
+
+// FilePath returns the path of a static file in the files folder.
+func FilePath(filesFolder string, id dataplane.StaticFileID) string {
+	return filepath.Join(filesFolder, string(id))
+}
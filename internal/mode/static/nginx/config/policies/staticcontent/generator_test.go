@@ -0,0 +1,71 @@
+package staticcontent_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/staticcontent"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	policy := &ngfAPI.StaticContentPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-policy",
+			Namespace: "test",
+		},
+		Spec: ngfAPI.StaticContentPolicySpec{
+			Files: []ngfAPI.StaticFile{
+				{
+					Path:        "/.well-known/security.txt",
+					Key:         "security.txt",
+					ContentType: helpers.GetPointer("text/plain; charset=utf-8"),
+				},
+				{
+					Path: "/maintenance.html",
+					Key:  "maintenance.html",
+				},
+			},
+		},
+	}
+
+	generator := staticcontent.NewGenerator("/etc/nginx/includes")
+
+	resFiles := generator.GenerateForServer([]policies.Policy{policy}, http.Server{})
+	g.Expect(resFiles).To(HaveLen(1))
+	g.Expect(resFiles[0].Name).To(Equal("StaticContentPolicy_test_my-policy.conf"))
+	g.Expect(string(resFiles[0].Content)).To(Equal(`
+location ~ ^/\.well-known/security\.txt$ {
+    types {}
+    default_type "text/plain; charset=utf-8";
+    alias /etc/nginx/includes/static_content_test_my-policy_security.txt;
+}
+location ~ ^/maintenance\.html$ {
+    alias /etc/nginx/includes/static_content_test_my-policy_maintenance.html;
+}
+`))
+
+	g.Expect(generator.GenerateForLocation([]policies.Policy{policy}, http.Location{})).To(BeEmpty())
+	g.Expect(generator.GenerateForInternalLocation([]policies.Policy{policy}, http.Location{})).To(BeEmpty())
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := staticcontent.NewGenerator("/etc/nginx/includes")
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,117 @@
+package staticcontent
+
+import (
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+var (
+	pathRegexp        = regexp.MustCompile(`^/[A-Za-z0-9\-._~/]*$`)
+	contentTypeRegexp = regexp.MustCompile(`^[A-Za-z0-9.+-]+/[A-Za-z0-9.+-]+(; ?[A-Za-z0-9.+-]+=[A-Za-z0-9.+-]+)?$`)
+	keyRegexp         = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+)
+
+// Validator validates a StaticContentPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of a StaticContentPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	scp := helpers.MustCastObject[*ngfAPI.StaticContentPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway}
+	targetRef := scp.Spec.TargetRef.LocalPolicyTargetReference
+	if err := policies.ValidateTargetRef(targetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := validateSettings(scp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two StaticContentPolicies serve a file at the same path.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	scpA := helpers.MustCastObject[*ngfAPI.StaticContentPolicy](polA)
+	scpB := helpers.MustCastObject[*ngfAPI.StaticContentPolicy](polB)
+
+	for _, fileA := range scpA.Spec.Files {
+		for _, fileB := range scpB.Spec.Files {
+			if fileA.Path == fileB.Path {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.StaticContentPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("files")
+
+	paths := make(map[string]struct{}, len(spec.Files))
+
+	for i, file := range spec.Files {
+		filePath := fieldPath.Index(i)
+
+		switch {
+		case !pathRegexp.MatchString(file.Path):
+			allErrs = append(allErrs, field.Invalid(
+				filePath.Child("path"),
+				file.Path,
+				"must start with '/' and contain only alphanumeric characters, '-', '.', '_', '~', or '/'",
+			))
+		case strings.HasPrefix(file.Path, http.InternalRoutePathPrefix):
+			allErrs = append(allErrs, field.Invalid(
+				filePath.Child("path"),
+				file.Path,
+				"must not start with the reserved prefix "+http.InternalRoutePathPrefix,
+			))
+		}
+
+		if _, exists := paths[file.Path]; exists {
+			allErrs = append(allErrs, field.Duplicate(filePath.Child("path"), file.Path))
+		}
+		paths[file.Path] = struct{}{}
+
+		if !keyRegexp.MatchString(file.Key) {
+			allErrs = append(allErrs, field.Invalid(
+				filePath.Child("key"),
+				file.Key,
+				"must contain only alphanumeric characters, '-', '_', or '.'",
+			))
+		}
+
+		if file.ContentType != nil && !contentTypeRegexp.MatchString(*file.ContentType) {
+			allErrs = append(allErrs, field.Invalid(
+				filePath.Child("contentType"),
+				*file.ContentType,
+				"must be a media type with an optional parameter, such as text/plain; charset=utf-8",
+			))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
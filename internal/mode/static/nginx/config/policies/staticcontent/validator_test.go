@@ -0,0 +1,189 @@
+package staticcontent_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/staticcontent"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy
+
+func createValidPolicy() *ngfAPI.StaticContentPolicy {
+	return &ngfAPI.StaticContentPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.StaticContentPolicySpec{
+			TargetRef: v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			ConfigMapRef: ngfAPI.StaticContentConfigMapReference{Name: "static"},
+			Files: []ngfAPI.StaticFile{
+				{
+					Path:        "/.well-known/security.txt",
+					Key:         "security.txt",
+					ContentType: helpers.GetPointer("text/plain; charset=utf-8"),
+				},
+				{
+					Path: "/maintenance.html",
+					Key:  "maintenance.html",
+				},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.StaticContentPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.StaticContentPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.kind: Unsupported value: \"HTTPRoute\": " +
+					"supported values: \"Gateway\""),
+			},
+		},
+		{
+			name: "invalid files",
+			policy: createModifiedPolicy(func(p *ngfAPI.StaticContentPolicy) *ngfAPI.StaticContentPolicy {
+				p.Spec.Files = []ngfAPI.StaticFile{
+					{Path: "/security.txt; return 200", Key: "security.txt"},
+					{Path: "/_ngf-internal-file", Key: "file"},
+					{Path: "/file", Key: "file"},
+					{Path: "/file", Key: "../file"},
+					{Path: "/page", Key: "page", ContentType: helpers.GetPointer("text/$html")},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.files[0].path: Invalid value: \"/security.txt; return 200\": must start with '/' " +
+						"and contain only alphanumeric characters, '-', '.', '_', '~', or '/', " +
+						"spec.files[1].path: Invalid value: \"/_ngf-internal-file\": " +
+						"must not start with the reserved prefix /_ngf-internal, " +
+						"spec.files[3].path: Duplicate value: \"/file\", " +
+						"spec.files[3].key: Invalid value: \"../file\": " +
+						"must contain only alphanumeric characters, '-', '_', or '.', " +
+						"spec.files[4].contentType: Invalid value: \"text/$html\": " +
+						"must be a media type with an optional parameter, such as text/plain; charset=utf-8]",
+				),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := staticcontent.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := staticcontent.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(paths ...string) *ngfAPI.StaticContentPolicy {
+		policy := &ngfAPI.StaticContentPolicy{}
+
+		for _, path := range paths {
+			policy.Spec.Files = append(policy.Spec.Files, ngfAPI.StaticFile{Path: path, Key: "key"})
+		}
+
+		return policy
+	}
+
+	tests := []struct {
+		polA      *ngfAPI.StaticContentPolicy
+		polB      *ngfAPI.StaticContentPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name:      "no conflicts",
+			polA:      createPolicy("/.well-known/security.txt"),
+			polB:      createPolicy("/maintenance.html", "/robots.txt"),
+			conflicts: false,
+		},
+		{
+			name:      "same path",
+			polA:      createPolicy("/.well-known/security.txt", "/robots.txt"),
+			polB:      createPolicy("/robots.txt"),
+			conflicts: true,
+		},
+	}
+
+	v := staticcontent.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := staticcontent.NewValidator()
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
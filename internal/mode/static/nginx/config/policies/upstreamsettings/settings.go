@@ -0,0 +1,139 @@
+package upstreamsettings
+
+import (
+	"fmt"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// DefaultKeepAliveConnections is the number of the idle keep-alive connections to the servers of an upstream
+// that each NGINX worker process preserves, unless an UpstreamSettingsPolicy sets it.
+const DefaultKeepAliveConnections int32 = 16
+
+// healthCheckLocationPrefix is the prefix of the named locations that send the health checks of the upstreams.
+const healthCheckLocationPrefix = "@ngf-health-check-"
+
+// loadBalancingDirectives are the NGINX load balancing directives of the load balancing methods.
+var loadBalancingDirectives = map[ngfAPI.LoadBalancingMethod]string{
+	ngfAPI.LoadBalancingMethodRandomTwoLeastConnections: "random two least_conn",
+	ngfAPI.LoadBalancingMethodLeastTimeHeader:           "least_time header",
+	ngfAPI.LoadBalancingMethodLeastTimeLastByte:         "least_time last_byte",
+}
+
+// UpstreamSettings holds the settings of an upstream from the UpstreamSettingsPolicies that target its Service.
+type UpstreamSettings struct {
+	// Queue is the queue of the requests to the upstream. It is nil if no policy sets a queue.
+	Queue *http.UpstreamQueue
+	// HealthCheck is the active health check of the upstream. It is nil if no policy sets it.
+	// Its Location is not set, because it depends on the name of the upstream.
+	HealthCheck *http.UpstreamHealthCheck
+	// KeepAlive is the keep-alive connections to the upstream. It is nil if no policy sets them, and its Connections
+	// is DefaultKeepAliveConnections if the policy doesn't set them.
+	KeepAlive *http.UpstreamKeepAlive
+	// LoadBalancingMethod is the load balancing directive of the upstream. It is empty if no policy sets it.
+	LoadBalancingMethod string
+	// MaxConns is the maximum number of simultaneous active connections to each server of the upstream.
+	// It is 0 if no policy sets a limit.
+	MaxConns int32
+}
+
+// ProcessPolicies returns the UpstreamSettings of the UpstreamSettingsPolicies of an upstream.
+// The settings of all policies are combined, because the policies that set the same settings conflict,
+// and only one of them is valid.
+func ProcessPolicies(pols []policies.Policy) UpstreamSettings {
+	var settings UpstreamSettings
+
+	for _, pol := range pols {
+		usp, ok := pol.(*ngfAPI.UpstreamSettingsPolicy)
+		if !ok {
+			continue
+		}
+
+		if usp.Spec.MaxConnections != nil {
+			settings.MaxConns = *usp.Spec.MaxConnections
+		}
+
+		if usp.Spec.KeepAlive != nil {
+			settings.KeepAlive = convertKeepAlive(*usp.Spec.KeepAlive)
+		}
+
+		if usp.Spec.LoadBalancingMethod != nil {
+			settings.LoadBalancingMethod = loadBalancingDirectives[*usp.Spec.LoadBalancingMethod]
+		}
+
+		if usp.Spec.HealthCheck != nil {
+			settings.HealthCheck = convertHealthCheck(*usp.Spec.HealthCheck)
+		}
+
+		if usp.Spec.Queue != nil {
+			settings.Queue = &http.UpstreamQueue{
+				Size: usp.Spec.Queue.Size,
+			}
+
+			if usp.Spec.Queue.Timeout != nil {
+				settings.Queue.Timeout = string(*usp.Spec.Queue.Timeout)
+			}
+		}
+	}
+
+	return settings
+}
+
+// HealthCheckLocation returns the name of the named location that sends the health checks of the upstream.
+func HealthCheckLocation(upstreamName string) string {
+	return fmt.Sprintf("%s%s", healthCheckLocationPrefix, upstreamName)
+}
+
+func convertHealthCheck(healthCheck ngfAPI.UpstreamHealthCheck) *http.UpstreamHealthCheck {
+	converted := &http.UpstreamHealthCheck{
+		GRPC: healthCheck.Type != nil && *healthCheck.Type == ngfAPI.HealthCheckTypeGRPC,
+	}
+
+	if healthCheck.Path != nil {
+		converted.URI = *healthCheck.Path
+	}
+
+	if healthCheck.GRPCService != nil {
+		converted.GRPCService = *healthCheck.GRPCService
+	}
+
+	if healthCheck.Interval != nil {
+		converted.Interval = string(*healthCheck.Interval)
+	}
+
+	if healthCheck.Fails != nil {
+		converted.Fails = *healthCheck.Fails
+	}
+
+	if healthCheck.Passes != nil {
+		converted.Passes = *healthCheck.Passes
+	}
+
+	return converted
+}
+
+func convertKeepAlive(keepAlive ngfAPI.UpstreamKeepAlive) *http.UpstreamKeepAlive {
+	converted := &http.UpstreamKeepAlive{
+		Connections: DefaultKeepAliveConnections,
+	}
+
+	if keepAlive.Connections != nil {
+		converted.Connections = *keepAlive.Connections
+	}
+
+	if keepAlive.Requests != nil {
+		converted.Requests = *keepAlive.Requests
+	}
+
+	if keepAlive.Time != nil {
+		converted.Time = string(*keepAlive.Time)
+	}
+
+	if keepAlive.Timeout != nil {
+		converted.Timeout = string(*keepAlive.Timeout)
+	}
+
+	return converted
+}
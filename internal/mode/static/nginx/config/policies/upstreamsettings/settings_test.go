@@ -0,0 +1,153 @@
+package upstreamsettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
+)
+
+func TestProcessPolicies(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		expSettings upstreamsettings.UpstreamSettings
+		name        string
+		policies    []policies.Policy
+	}{
+		{
+			name:        "no policies",
+			expSettings: upstreamsettings.UpstreamSettings{},
+		},
+		{
+			name: "all settings",
+			policies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						HealthCheck: &ngfAPI.UpstreamHealthCheck{
+							Type:        helpers.GetPointer(ngfAPI.HealthCheckTypeGRPC),
+							GRPCService: helpers.GetPointer("helloworld.Greeter"),
+							Interval:    helpers.GetPointer[ngfAPI.Duration]("10s"),
+							Fails:       helpers.GetPointer[int32](3),
+							Passes:      helpers.GetPointer[int32](2),
+						},
+						KeepAlive: &ngfAPI.UpstreamKeepAlive{
+							Connections: helpers.GetPointer[int32](32),
+							Requests:    helpers.GetPointer[int32](1000),
+							Time:        helpers.GetPointer[ngfAPI.Duration]("1h"),
+							Timeout:     helpers.GetPointer[ngfAPI.Duration]("60s"),
+						},
+						LoadBalancingMethod: helpers.GetPointer(ngfAPI.LoadBalancingMethodLeastTimeHeader),
+						MaxConnections:      helpers.GetPointer[int32](10),
+						Queue: &ngfAPI.UpstreamQueue{
+							Size:    20,
+							Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+						},
+					},
+				},
+			},
+			expSettings: upstreamsettings.UpstreamSettings{
+				HealthCheck: &http.UpstreamHealthCheck{
+					GRPC:        true,
+					GRPCService: "helloworld.Greeter",
+					Interval:    "10s",
+					Fails:       3,
+					Passes:      2,
+				},
+				KeepAlive: &http.UpstreamKeepAlive{
+					Connections: 32,
+					Requests:    1000,
+					Time:        "1h",
+					Timeout:     "60s",
+				},
+				LoadBalancingMethod: "least_time header",
+				MaxConns:            10,
+				Queue: &http.UpstreamQueue{
+					Size:    20,
+					Timeout: "30s",
+				},
+			},
+		},
+		{
+			name: "settings combined from multiple policies",
+			policies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						MaxConnections: helpers.GetPointer[int32](10),
+					},
+				},
+				&ngfAPI.UpstreamSettingsPolicy{
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						Queue: &ngfAPI.UpstreamQueue{Size: 20},
+					},
+				},
+			},
+			expSettings: upstreamsettings.UpstreamSettings{
+				MaxConns: 10,
+				Queue:    &http.UpstreamQueue{Size: 20},
+			},
+		},
+		{
+			name: "keepalive connections default",
+			policies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						KeepAlive: &ngfAPI.UpstreamKeepAlive{
+							Requests: helpers.GetPointer[int32](100),
+						},
+					},
+				},
+			},
+			expSettings: upstreamsettings.UpstreamSettings{
+				KeepAlive: &http.UpstreamKeepAlive{
+					Connections: upstreamsettings.DefaultKeepAliveConnections,
+					Requests:    100,
+				},
+			},
+		},
+		{
+			name: "http health check",
+			policies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						HealthCheck: &ngfAPI.UpstreamHealthCheck{
+							Type: helpers.GetPointer(ngfAPI.HealthCheckTypeHTTP),
+							Path: helpers.GetPointer("/healthz"),
+						},
+					},
+				},
+			},
+			expSettings: upstreamsettings.UpstreamSettings{
+				HealthCheck: &http.UpstreamHealthCheck{URI: "/healthz"},
+			},
+		},
+		{
+			name: "other policies are ignored",
+			policies: []policies.Policy{
+				&policiesfakes.FakePolicy{},
+			},
+			expSettings: upstreamsettings.UpstreamSettings{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(upstreamsettings.ProcessPolicies(test.policies)).To(Equal(test.expSettings))
+		})
+	}
+}
+
+func TestHealthCheckLocation(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(upstreamsettings.HealthCheckLocation("test_foo_80")).To(Equal("@ngf-health-check-test_foo_80"))
+}
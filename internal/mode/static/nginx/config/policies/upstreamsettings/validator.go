@@ -0,0 +1,212 @@
+package upstreamsettings
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+var (
+	// healthCheckPathRegexp matches the paths of the HTTP health checks, which are not quoted in the configuration.
+	healthCheckPathRegexp = regexp.MustCompile(`^/[A-Za-z0-9\-._~%/]*$`)
+	// grpcServiceRegexp matches the names of the services of the gRPC health checks.
+	grpcServiceRegexp = regexp.MustCompile(`^[A-Za-z0-9_.]+$`)
+)
+
+// Validator validates an UpstreamSettingsPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+	plus             bool
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator, plus bool) *Validator {
+	return &Validator{genericValidator: genericValidator, plus: plus}
+}
+
+// Validate validates the spec of an UpstreamSettingsPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	usp := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	for _, ref := range usp.Spec.TargetRefs {
+		if err := validateTargetRef(ref, targetRefPath); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(usp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two UpstreamSettingsPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	a := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](polA)
+	b := helpers.MustCastObject[*ngfAPI.UpstreamSettingsPolicy](polB)
+
+	return (a.Spec.MaxConnections != nil && b.Spec.MaxConnections != nil) ||
+		(a.Spec.Queue != nil && b.Spec.Queue != nil) ||
+		(a.Spec.KeepAlive != nil && b.Spec.KeepAlive != nil) ||
+		(a.Spec.LoadBalancingMethod != nil && b.Spec.LoadBalancingMethod != nil) ||
+		(a.Spec.HealthCheck != nil && b.Spec.HealthCheck != nil)
+}
+
+// validateTargetRef validates that the targetRef is a Service. Unlike the targetRefs of the other policies,
+// it belongs to the core group instead of the Gateway API group.
+func validateTargetRef(ref v1alpha2.LocalPolicyTargetReference, basePath *field.Path) error {
+	if ref.Group != "" {
+		return field.NotSupported(basePath.Child("group"), ref.Group, []string{""})
+	}
+
+	if ref.Kind != kinds.Service {
+		return field.NotSupported(basePath.Child("kind"), ref.Kind, []string{kinds.Service})
+	}
+
+	return nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.UpstreamSettingsPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Queue != nil {
+		queuePath := fieldPath.Child("queue")
+
+		if !v.plus {
+			allErrs = append(allErrs, field.Forbidden(queuePath, "queue is only supported by NGINX Plus"))
+		}
+
+		if spec.Queue.Size < 1 {
+			allErrs = append(allErrs, field.Invalid(queuePath.Child("size"), spec.Queue.Size, "must be greater than 0"))
+		}
+
+		if spec.Queue.Timeout != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*spec.Queue.Timeout)); err != nil {
+				path := queuePath.Child("timeout")
+
+				allErrs = append(allErrs, field.Invalid(path, *spec.Queue.Timeout, err.Error()))
+			}
+		}
+	}
+
+	if spec.LoadBalancingMethod != nil {
+		methodPath := fieldPath.Child("loadBalancingMethod")
+
+		switch method := *spec.LoadBalancingMethod; method {
+		case ngfAPI.LoadBalancingMethodRandomTwoLeastConnections:
+		case ngfAPI.LoadBalancingMethodLeastTimeHeader, ngfAPI.LoadBalancingMethodLeastTimeLastByte:
+			if !v.plus {
+				allErrs = append(
+					allErrs,
+					field.Forbidden(methodPath, "least time load balancing is only supported by NGINX Plus"),
+				)
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(methodPath, method, []ngfAPI.LoadBalancingMethod{
+				ngfAPI.LoadBalancingMethodRandomTwoLeastConnections,
+				ngfAPI.LoadBalancingMethodLeastTimeHeader,
+				ngfAPI.LoadBalancingMethodLeastTimeLastByte,
+			}))
+		}
+	}
+
+	if spec.HealthCheck != nil {
+		allErrs = append(allErrs, v.validateHealthCheck(*spec.HealthCheck, fieldPath.Child("healthCheck"))...)
+	}
+
+	if spec.KeepAlive != nil {
+		keepAlivePath := fieldPath.Child("keepAlive")
+
+		if spec.KeepAlive.Time != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*spec.KeepAlive.Time)); err != nil {
+				path := keepAlivePath.Child("time")
+
+				allErrs = append(allErrs, field.Invalid(path, *spec.KeepAlive.Time, err.Error()))
+			}
+		}
+
+		if spec.KeepAlive.Timeout != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*spec.KeepAlive.Timeout)); err != nil {
+				path := keepAlivePath.Child("timeout")
+
+				allErrs = append(allErrs, field.Invalid(path, *spec.KeepAlive.Timeout, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateHealthCheck(healthCheck ngfAPI.UpstreamHealthCheck, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !v.plus {
+		allErrs = append(allErrs, field.Forbidden(path, "health checks are only supported by NGINX Plus"))
+	}
+
+	healthCheckType := ngfAPI.HealthCheckTypeHTTP
+	if healthCheck.Type != nil {
+		healthCheckType = *healthCheck.Type
+	}
+
+	switch healthCheckType {
+	case ngfAPI.HealthCheckTypeHTTP:
+		if healthCheck.GRPCService != nil {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(path.Child("grpcService"), "grpcService is only supported for GRPC health checks"),
+			)
+		}
+	case ngfAPI.HealthCheckTypeGRPC:
+		if healthCheck.Path != nil {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(path.Child("path"), "path is only supported for HTTP health checks"),
+			)
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(path.Child("type"), healthCheckType, []ngfAPI.HealthCheckType{
+			ngfAPI.HealthCheckTypeHTTP,
+			ngfAPI.HealthCheckTypeGRPC,
+		}))
+	}
+
+	if healthCheck.Path != nil && !healthCheckPathRegexp.MatchString(*healthCheck.Path) {
+		allErrs = append(allErrs, field.Invalid(
+			path.Child("path"),
+			*healthCheck.Path,
+			"must start with / and contain only letters, digits and the characters -._~%/",
+		))
+	}
+
+	if healthCheck.GRPCService != nil && !grpcServiceRegexp.MatchString(*healthCheck.GRPCService) {
+		allErrs = append(allErrs, field.Invalid(
+			path.Child("grpcService"),
+			*healthCheck.GRPCService,
+			"must contain only letters, digits and the characters _.",
+		))
+	}
+
+	if healthCheck.Interval != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*healthCheck.Interval)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("interval"), *healthCheck.Interval, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,434 @@
+package upstreamsettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy
+
+func createValidPolicy() *ngfAPI.UpstreamSettingsPolicy {
+	return &ngfAPI.UpstreamSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.UpstreamSettingsPolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: "",
+					Kind:  kinds.Service,
+					Name:  "svc",
+				},
+			},
+			KeepAlive: &ngfAPI.UpstreamKeepAlive{
+				Connections: helpers.GetPointer[int32](32),
+				Requests:    helpers.GetPointer[int32](1000),
+				Time:        helpers.GetPointer[ngfAPI.Duration]("1h"),
+				Timeout:     helpers.GetPointer[ngfAPI.Duration]("60s"),
+			},
+			MaxConnections: helpers.GetPointer[int32](10),
+			Queue: &ngfAPI.UpstreamQueue{
+				Size:    20,
+				Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.UpstreamSettingsPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.UpstreamSettingsPolicy
+		expConditions []conditions.Condition
+		plus          bool
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.TargetRefs[0].Group = v1.GroupName
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"gateway.networking.k8s.io\": " +
+					"supported values: \"\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.TargetRefs[0].Kind = kinds.HTTPRoute
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"HTTPRoute\": " +
+					"supported values: \"Service\""),
+			},
+		},
+		{
+			name:   "invalid; queue is not supported by nginx oss",
+			policy: createValidPolicy(),
+			plus:   false,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.queue: Forbidden: queue is only supported by NGINX Plus"),
+			},
+		},
+		{
+			name: "invalid queue size",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.Queue.Size = 0
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.queue.size: Invalid value: 0: must be greater than 0"),
+			},
+		},
+		{
+			name: "invalid queue timeout",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.Queue.Timeout = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.queue.timeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')"),
+			},
+		},
+		{
+			name: "invalid keepalive time and timeout",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.KeepAlive.Time = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.KeepAlive.Timeout = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.keepAlive.time: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
+					"spec.keepAlive.timeout: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
+			},
+		},
+		{
+			name: "invalid; least time load balancing is not supported by nginx oss",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.Queue = nil
+				p.Spec.LoadBalancingMethod = helpers.GetPointer(ngfAPI.LoadBalancingMethodLeastTimeHeader)
+				return p
+			}),
+			plus: false,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.loadBalancingMethod: Forbidden: least time load balancing " +
+					"is only supported by NGINX Plus"),
+			},
+		},
+		{
+			name: "invalid load balancing method",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.LoadBalancingMethod = helpers.GetPointer[ngfAPI.LoadBalancingMethod]("RoundRobin")
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.loadBalancingMethod: Unsupported value: \"RoundRobin\": " +
+					"supported values: \"RandomTwoLeastConnections\", \"LeastTimeHeader\", \"LeastTimeLastByte\""),
+			},
+		},
+		{
+			name: "invalid; health check is not supported by nginx oss",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.Queue = nil
+				p.Spec.HealthCheck = &ngfAPI.UpstreamHealthCheck{}
+				return p
+			}),
+			plus: false,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.healthCheck: Forbidden: health checks are only supported by NGINX Plus"),
+			},
+		},
+		{
+			name: "invalid; grpc health check with path",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.HealthCheck = &ngfAPI.UpstreamHealthCheck{
+					Type: helpers.GetPointer(ngfAPI.HealthCheckTypeGRPC),
+					Path: helpers.GetPointer("/healthz"),
+				}
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.healthCheck.path: Forbidden: path is only supported " +
+					"for HTTP health checks"),
+			},
+		},
+		{
+			name: "invalid; http health check with grpc service",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.HealthCheck = &ngfAPI.UpstreamHealthCheck{
+					GRPCService: helpers.GetPointer("helloworld.Greeter"),
+				}
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.healthCheck.grpcService: Forbidden: grpcService is only supported " +
+					"for GRPC health checks"),
+			},
+		},
+		{
+			name: "invalid health check type",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.HealthCheck = &ngfAPI.UpstreamHealthCheck{
+					Type: helpers.GetPointer[ngfAPI.HealthCheckType]("TCP"),
+				}
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.healthCheck.type: Unsupported value: \"TCP\": " +
+					"supported values: \"HTTP\", \"GRPC\""),
+			},
+		},
+		{
+			name: "invalid health check path, grpc service and interval",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.HealthCheck = &ngfAPI.UpstreamHealthCheck{
+					Type:        helpers.GetPointer(ngfAPI.HealthCheckTypeGRPC),
+					GRPCService: helpers.GetPointer("svc; return 200"),
+					Interval:    helpers.GetPointer[ngfAPI.Duration]("invalid"),
+				}
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.healthCheck.grpcService: Invalid value: \"svc; return 200\": " +
+					"must contain only letters, digits and the characters _., " +
+					"spec.healthCheck.interval: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
+			},
+		},
+		{
+			name: "invalid http health check path",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.HealthCheck = &ngfAPI.UpstreamHealthCheck{
+					Path: helpers.GetPointer("/healthz;"),
+				}
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.healthCheck.path: Invalid value: \"/healthz;\": " +
+					"must start with / and contain only letters, digits and the characters -._~%/"),
+			},
+		},
+		{
+			name: "valid; grpc health check",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.HealthCheck = &ngfAPI.UpstreamHealthCheck{
+					Type:        helpers.GetPointer(ngfAPI.HealthCheckTypeGRPC),
+					GRPCService: helpers.GetPointer("helloworld.Greeter"),
+					Interval:    helpers.GetPointer[ngfAPI.Duration]("10s"),
+				}
+				return p
+			}),
+			plus:          true,
+			expConditions: nil,
+		},
+		{
+			name: "valid; nginx oss with random two least connections load balancing",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.Queue = nil
+				p.Spec.LoadBalancingMethod = helpers.GetPointer(ngfAPI.LoadBalancingMethodRandomTwoLeastConnections)
+				return p
+			}),
+			plus:          false,
+			expConditions: nil,
+		},
+		{
+			name: "valid; least time load balancing",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.LoadBalancingMethod = helpers.GetPointer(ngfAPI.LoadBalancingMethodLeastTimeLastByte)
+				return p
+			}),
+			plus:          true,
+			expConditions: nil,
+		},
+		{
+			name: "valid; nginx oss without queue",
+			policy: createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.Queue = nil
+				return p
+			}),
+			plus:          false,
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			plus:          true,
+			expConditions: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			v := upstreamsettings.NewValidator(validation.GenericValidator{}, test.plus)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := upstreamsettings.NewValidator(nil, true)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.UpstreamSettingsPolicy
+		polB      *ngfAPI.UpstreamSettingsPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					MaxConnections: helpers.GetPointer[int32](10),
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					Queue: &ngfAPI.UpstreamQueue{Size: 10},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "max connections conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					MaxConnections: helpers.GetPointer[int32](5),
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "queue conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					Queue: &ngfAPI.UpstreamQueue{Size: 10},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "keepalive conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					KeepAlive: &ngfAPI.UpstreamKeepAlive{
+						Connections: helpers.GetPointer[int32](0),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "load balancing method conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					LoadBalancingMethod: helpers.GetPointer(ngfAPI.LoadBalancingMethodLeastTimeHeader),
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					LoadBalancingMethod: helpers.GetPointer(ngfAPI.LoadBalancingMethodLeastTimeLastByte),
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "health check conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					HealthCheck: &ngfAPI.UpstreamHealthCheck{},
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					HealthCheck: &ngfAPI.UpstreamHealthCheck{
+						Type: helpers.GetPointer(ngfAPI.HealthCheckTypeGRPC),
+					},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := upstreamsettings.NewValidator(nil, true)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := upstreamsettings.NewValidator(nil, true)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
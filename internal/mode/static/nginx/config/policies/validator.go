@@ -24,6 +24,8 @@ type Validator interface {
 // CompositeValidator manages the validators for NGF Policies.
 type CompositeValidator struct {
 	validators     map[schema.GroupVersionKind]Validator
+	mergers        map[schema.GroupVersionKind]Merger
+	locationScoped map[schema.GroupVersionKind]struct{}
 	mustExtractGVK kinds.MustExtractGVK
 }
 
@@ -31,8 +33,15 @@ type CompositeValidator struct {
 type ManagerConfig struct {
 	// Validator is the Validator for the Policy.
 	Validator Validator
+	// Merger is the Merger for the Policy. It is only set for Inherited Policies.
+	Merger Merger
 	// GVK is the GroupVersionKind of the Policy.
 	GVK schema.GroupVersionKind
+	// LocationScoped is true if the configuration of the Policy must be generated in every location, because
+	// the locations don't inherit its directives from the server block. For example, a location with
+	// a proxy_set_header directive doesn't inherit the proxy_set_header directives of the server block.
+	// It is only set for Inherited Policies.
+	LocationScoped bool
 }
 
 // NewManager returns a new CompositeValidator.
@@ -43,11 +52,21 @@ func NewManager(
 ) *CompositeValidator {
 	v := &CompositeValidator{
 		validators:     make(map[schema.GroupVersionKind]Validator),
+		mergers:        make(map[schema.GroupVersionKind]Merger),
+		locationScoped: make(map[schema.GroupVersionKind]struct{}),
 		mustExtractGVK: mustExtractGVK,
 	}
 
 	for _, cfg := range configs {
 		v.validators[cfg.GVK] = cfg.Validator
+
+		if cfg.Merger != nil {
+			v.mergers[cfg.GVK] = cfg.Merger
+		}
+
+		if cfg.LocationScoped {
+			v.locationScoped[cfg.GVK] = struct{}{}
+		}
 	}
 
 	return v
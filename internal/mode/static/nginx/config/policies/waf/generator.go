@@ -0,0 +1,130 @@
+package waf
+
+import (
+	"fmt"
+	"path/filepath"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var tmpl = template.Must(template.New("waf policy").Parse(wafTemplate))
+
+const wafTemplate = `
+app_protect_enable on;
+app_protect_policy_file "{{ .BundlePath }}";
+{{- if .SecurityLogs }}
+app_protect_security_log_enable on;
+  {{- range $log := .SecurityLogs }}
+app_protect_security_log {{ $log.LogProfile }} {{ $log.Destination }};
+  {{- end }}
+{{- end }}
+`
+
+// wafSettings holds the data for the WAF policy template.
+type wafSettings struct {
+	BundlePath   string
+	SecurityLogs []securityLog
+}
+
+type securityLog struct {
+	LogProfile  string
+	Destination string
+}
+
+// Generator generates nginx configuration based on a WAF policy.
+type Generator struct {
+	bundlesFolder string
+}
+
+// NewGenerator returns a new instance of Generator. The bundlesFolder is the folder where the policy bundles
+// are written.
+func NewGenerator(bundlesFolder string) *Generator {
+	return &Generator{bundlesFolder: bundlesFolder}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return g.generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return g.generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(
+	pols []policies.Policy,
+	_ http.Location,
+) policies.GenerateResultFiles {
+	return g.generate(pols)
+}
+
+func (g Generator) generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		wp, ok := pol.(*ngfAPI.WAFPolicy)
+		if !ok {
+			continue
+		}
+
+		id := dataplane.GenerateWAFBundleID(types.NamespacedName{Namespace: wp.Namespace, Name: wp.Name})
+		settings := wafSettings{
+			BundlePath:   BundlePath(g.bundlesFolder, id),
+			SecurityLogs: make([]securityLog, 0, len(wp.Spec.SecurityLogs)),
+		}
+
+		for _, log := range wp.Spec.SecurityLogs {
+			settings.SecurityLogs = append(settings.SecurityLogs, securityLog{
+				LogProfile:  string(log.LogProfile),
+				Destination: destination(log.Destination),
+			})
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("WAFPolicy_%s_%s.conf", wp.Namespace, wp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, settings),
+		})
+	}
+
+	return files
+}
+
+// GenerateDisabled generates the configuration that disables the WAF in a location block of a server
+// with a WAF policy, so that the location is not protected by the policies of the server.
+func GenerateDisabled(serverPols []policies.Policy) policies.GenerateResultFiles {
+	for _, pol := range serverPols {
+		if _, ok := pol.(*ngfAPI.WAFPolicy); ok {
+			return policies.GenerateResultFiles{
+				{
+					Name:    "WAFPolicy_disabled.conf",
+					Content: []byte("app_protect_enable off;\n"),
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+// destination returns the destination of a security log in the format of the app_protect_security_log directive.
+func destination(dest ngfAPI.WAFSecurityLogDestination) string {
+	if dest.Type == ngfAPI.WAFSecurityLogDestinationTypeSyslog && dest.Syslog != nil {
+		return "syslog:server=" + dest.Syslog.Server
+	}
+
+	return "stderr"
+}
+
+// BundlePath returns the path of the file of a policy bundle in the bundles folder.
+func BundlePath(bundlesFolder string, id dataplane.WAFBundleID) string {
+	return filepath.Join(bundlesFolder, string(id)+".tgz")
+}
@@ -0,0 +1,132 @@
+package waf_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/waf"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(logs []ngfAPI.WAFSecurityLog) *ngfAPI.WAFPolicy {
+		return &ngfAPI.WAFPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-policy",
+				Namespace: "my-ns",
+			},
+			Spec: ngfAPI.WAFPolicySpec{
+				SecurityLogs: logs,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		policy     policies.Policy
+		expStrings []string
+		notExp     []string
+	}{
+		{
+			name:   "no security logs",
+			policy: createPolicy(nil),
+			expStrings: []string{
+				"app_protect_enable on;",
+				`app_protect_policy_file "/etc/nginx/includes/waf_bundle_my-ns_my-policy.tgz";`,
+			},
+			notExp: []string{
+				"app_protect_security_log",
+			},
+		},
+		{
+			name: "security logs",
+			policy: createPolicy([]ngfAPI.WAFSecurityLog{
+				{
+					LogProfile:  ngfAPI.WAFLogProfileAll,
+					Destination: ngfAPI.WAFSecurityLogDestination{Type: ngfAPI.WAFSecurityLogDestinationTypeStderr},
+				},
+				{
+					LogProfile: ngfAPI.WAFLogProfileBlocked,
+					Destination: ngfAPI.WAFSecurityLogDestination{
+						Type:   ngfAPI.WAFSecurityLogDestinationTypeSyslog,
+						Syslog: &ngfAPI.WAFSyslogDestination{Server: "syslog.example.com:514"},
+					},
+				},
+			}),
+			expStrings: []string{
+				"app_protect_enable on;",
+				`app_protect_policy_file "/etc/nginx/includes/waf_bundle_my-ns_my-policy.tgz";`,
+				"app_protect_security_log_enable on;",
+				"app_protect_security_log log_all stderr;",
+				"app_protect_security_log log_blocked syslog:server=syslog.example.com:514;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExp []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("WAFPolicy_my-ns_my-policy.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExp {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := waf.NewGenerator("/etc/nginx/includes")
+
+			resFiles := generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExp)
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings, test.notExp)
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings, test.notExp)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := waf.NewGenerator("/etc/nginx/includes")
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestGenerateDisabled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(waf.GenerateDisabled(nil)).To(BeEmpty())
+	g.Expect(waf.GenerateDisabled([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}})).To(BeEmpty())
+
+	resFiles := waf.GenerateDisabled([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}, &ngfAPI.WAFPolicy{}})
+	g.Expect(resFiles).To(Equal(policies.GenerateResultFiles{
+		{
+			Name:    "WAFPolicy_disabled.conf",
+			Content: []byte("app_protect_enable off;\n"),
+		},
+	}))
+}
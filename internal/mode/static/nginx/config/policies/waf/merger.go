@@ -0,0 +1,31 @@
+package waf
+
+import (
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// Merger merges WAFPolicies.
+// Implements policies.Merger interface.
+type Merger struct{}
+
+// NewMerger returns a new instance of Merger.
+func NewMerger() *Merger {
+	return &Merger{}
+}
+
+// Merge returns a copy of the child WAFPolicy, which inherits the security logs of the parent WAFPolicy if it
+// doesn't define any. The policy bundle of the child WAFPolicy always overrides the bundle of the parent.
+func (m *Merger) Merge(parent, child policies.Policy) policies.Policy {
+	parentWP := helpers.MustCastObject[*ngfAPI.WAFPolicy](parent)
+	childWP := helpers.MustCastObject[*ngfAPI.WAFPolicy](child)
+
+	merged := childWP.DeepCopy()
+
+	if merged.Spec.SecurityLogs == nil {
+		merged.Spec.SecurityLogs = parentWP.Spec.SecurityLogs
+	}
+
+	return merged
+}
@@ -0,0 +1,85 @@
+package waf_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/waf"
+)
+
+func TestMerger_Merge(t *testing.T) {
+	t.Parallel()
+
+	stderrLog := ngfAPI.WAFSecurityLog{
+		LogProfile:  ngfAPI.WAFLogProfileAll,
+		Destination: ngfAPI.WAFSecurityLogDestination{Type: ngfAPI.WAFSecurityLogDestinationTypeStderr},
+	}
+	syslogLog := ngfAPI.WAFSecurityLog{
+		LogProfile: ngfAPI.WAFLogProfileBlocked,
+		Destination: ngfAPI.WAFSecurityLogDestination{
+			Type:   ngfAPI.WAFSecurityLogDestinationTypeSyslog,
+			Syslog: &ngfAPI.WAFSyslogDestination{Server: "syslog:514"},
+		},
+	}
+
+	createPolicy := func(name string, logs []ngfAPI.WAFSecurityLog) *ngfAPI.WAFPolicy {
+		return &ngfAPI.WAFPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: ngfAPI.WAFPolicySpec{
+				PolicyBundle: ngfAPI.WAFPolicyBundle{
+					ConfigMapRef: ngfAPI.WAFConfigMapKeyReference{Name: name, Key: "policy.tgz"},
+				},
+				SecurityLogs: logs,
+			},
+		}
+	}
+
+	tests := []struct {
+		parent  *ngfAPI.WAFPolicy
+		child   *ngfAPI.WAFPolicy
+		name    string
+		expLogs []ngfAPI.WAFSecurityLog
+	}{
+		{
+			name:    "no security logs",
+			parent:  createPolicy("parent", nil),
+			child:   createPolicy("child", nil),
+			expLogs: nil,
+		},
+		{
+			name:    "security logs inherited",
+			parent:  createPolicy("parent", []ngfAPI.WAFSecurityLog{stderrLog}),
+			child:   createPolicy("child", nil),
+			expLogs: []ngfAPI.WAFSecurityLog{stderrLog},
+		},
+		{
+			name:    "child security logs override parent security logs",
+			parent:  createPolicy("parent", []ngfAPI.WAFSecurityLog{stderrLog}),
+			child:   createPolicy("child", []ngfAPI.WAFSecurityLog{syslogLog}),
+			expLogs: []ngfAPI.WAFSecurityLog{syslogLog},
+		},
+	}
+
+	m := waf.NewMerger()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			merged := m.Merge(test.parent, test.child)
+
+			mergedWP, ok := merged.(*ngfAPI.WAFPolicy)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(mergedWP.Name).To(Equal("child"))
+			g.Expect(mergedWP.Spec.PolicyBundle).To(Equal(test.child.Spec.PolicyBundle))
+			g.Expect(mergedWP.Spec.SecurityLogs).To(Equal(test.expLogs))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package waf
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates a WAFPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+	plus             bool
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator, plus bool) *Validator {
+	return &Validator{genericValidator: genericValidator, plus: plus}
+}
+
+// Validate validates the spec of a WAFPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	wp := helpers.MustCastObject[*ngfAPI.WAFPolicy](policy)
+
+	if !v.plus {
+		return []conditions.Condition{
+			staticConds.NewPolicyInvalid("WAFPolicy is only supported by NGINX Plus with NGINX App Protect"),
+		}
+	}
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute, kinds.GRPCRoute}
+	targetRef := wp.Spec.TargetRef.LocalPolicyTargetReference
+	if err := policies.ValidateTargetRef(targetRef, targetRefPath, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := policies.ValidateTargetRefSectionName(wp.Spec.TargetRef, targetRefPath); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := v.validateSettings(wp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two WAFPolicies conflict. WAFPolicies always conflict, because NGINX enforces
+// a single policy bundle.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.WAFPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("securityLogs")
+
+	supportedProfiles := []string{
+		string(ngfAPI.WAFLogProfileDefault),
+		string(ngfAPI.WAFLogProfileAll),
+		string(ngfAPI.WAFLogProfileIllegal),
+		string(ngfAPI.WAFLogProfileBlocked),
+	}
+
+	for i, log := range spec.SecurityLogs {
+		logPath := fieldPath.Index(i)
+
+		if !slices.Contains(supportedProfiles, string(log.LogProfile)) {
+			allErrs = append(
+				allErrs,
+				field.NotSupported(logPath.Child("logProfile"), log.LogProfile, supportedProfiles),
+			)
+		}
+
+		destPath := logPath.Child("destination")
+
+		switch log.Destination.Type {
+		case ngfAPI.WAFSecurityLogDestinationTypeStderr:
+		case ngfAPI.WAFSecurityLogDestinationTypeSyslog:
+			if log.Destination.Syslog == nil {
+				allErrs = append(allErrs, field.Required(destPath.Child("syslog"), "syslog must be specified"))
+				continue
+			}
+
+			server := log.Destination.Syslog.Server
+			if err := v.genericValidator.ValidateEndpoint(server); err != nil {
+				allErrs = append(allErrs, field.Invalid(destPath.Child("syslog").Child("server"), server, err.Error()))
+			}
+		default:
+			allErrs = append(
+				allErrs,
+				field.NotSupported(
+					destPath.Child("type"),
+					log.Destination.Type,
+					[]string{
+						string(ngfAPI.WAFSecurityLogDestinationTypeStderr),
+						string(ngfAPI.WAFSecurityLogDestinationTypeSyslog),
+					},
+				),
+			)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,173 @@
+package waf_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/waf"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.WAFPolicy) *ngfAPI.WAFPolicy
+
+func createValidPolicy() *ngfAPI.WAFPolicy {
+	return &ngfAPI.WAFPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.WAFPolicySpec{
+			TargetRef: v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+				LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			PolicyBundle: ngfAPI.WAFPolicyBundle{
+				ConfigMapRef: ngfAPI.WAFConfigMapKeyReference{
+					Name: "bundles",
+					Key:  "policy.tgz",
+				},
+			},
+			SecurityLogs: []ngfAPI.WAFSecurityLog{
+				{
+					LogProfile: ngfAPI.WAFLogProfileAll,
+					Destination: ngfAPI.WAFSecurityLogDestination{
+						Type: ngfAPI.WAFSecurityLogDestinationTypeStderr,
+					},
+				},
+				{
+					LogProfile: ngfAPI.WAFLogProfileBlocked,
+					Destination: ngfAPI.WAFSecurityLogDestination{
+						Type:   ngfAPI.WAFSecurityLogDestinationTypeSyslog,
+						Syslog: &ngfAPI.WAFSyslogDestination{Server: "syslog.example.com:514"},
+					},
+				},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.WAFPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.WAFPolicy
+		expConditions []conditions.Condition
+		plus          bool
+	}{
+		{
+			name:   "not plus",
+			policy: createValidPolicy(),
+			plus:   false,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("WAFPolicy is only supported by NGINX Plus with NGINX App Protect"),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.WAFPolicy) *ngfAPI.WAFPolicy {
+				p.Spec.TargetRef.Kind = "Unsupported"
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.kind: Unsupported value: \"Unsupported\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid target ref; sectionName for a route",
+			policy: createModifiedPolicy(func(p *ngfAPI.WAFPolicy) *ngfAPI.WAFPolicy {
+				p.Spec.TargetRef.Kind = kinds.HTTPRoute
+				p.Spec.TargetRef.SectionName = helpers.GetPointer[v1.SectionName]("rule-1")
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.sectionName: Forbidden: " +
+					"sectionName can only be specified if the targetRef kind is Gateway"),
+			},
+		},
+		{
+			name: "invalid security logs",
+			policy: createModifiedPolicy(func(p *ngfAPI.WAFPolicy) *ngfAPI.WAFPolicy {
+				p.Spec.SecurityLogs[0].LogProfile = "log_invalid"
+				p.Spec.SecurityLogs[1].Destination.Syslog.Server = "syslog;"
+				p.Spec.SecurityLogs = append(p.Spec.SecurityLogs, ngfAPI.WAFSecurityLog{
+					LogProfile:  ngfAPI.WAFLogProfileIllegal,
+					Destination: ngfAPI.WAFSecurityLogDestination{Type: ngfAPI.WAFSecurityLogDestinationTypeSyslog},
+				})
+				return p
+			}),
+			plus: true,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.securityLogs[0].logProfile: Unsupported value: \"log_invalid\": supported values: " +
+						"\"log_default\", \"log_all\", \"log_illegal\", \"log_blocked\", " +
+						"spec.securityLogs[1].destination.syslog.server: Invalid value: \"syslog;\": " +
+						"(?:http?:\\/\\/)?[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*" +
+						"(?::\\d{1,5})? (e.g. 'my-endpoint',  or 'my.endpoint:5678',  or 'http://my-endpoint', " +
+						"regex used for validation is 'must be an alphanumeric hostname with optional http scheme " +
+						"and optional port'), " +
+						"spec.securityLogs[2].destination.syslog: Required value: syslog must be specified]",
+				),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			plus:          true,
+			expConditions: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			v := waf.NewValidator(validation.GenericValidator{}, test.plus)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := waf.NewValidator(nil, true)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := waf.NewValidator(nil, true)
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// PrivilegedPortLimit is the lowest port that NGINX can bind to without the NET_BIND_SERVICE capability.
+const PrivilegedPortLimit = 1024
+
+// listenPort returns the port that NGINX binds to for the port of a listener. With an unprivileged port offset,
+// NGINX binds to the privileged ports plus the offset, so that it can run without any capabilities and with
+// an arbitrary user ID.
+func listenPort(port, unprivilegedPortOffset int32) int32 {
+	if unprivilegedPortOffset > 0 && port < PrivilegedPortLimit {
+		return port + unprivilegedPortOffset
+	}
+
+	return port
+}
+
+// createGatewayPorts maps the ports that NGINX binds to back to the ports of the listeners, so that the requests
+// report the ports of the listeners, for example, in the X-Forwarded-Port header.
+func createGatewayPorts(conf dataplane.Configuration, unprivilegedPortOffset int32) []shared.MapParameter {
+	if unprivilegedPortOffset == 0 {
+		return nil
+	}
+
+	var ports []int32
+	for _, servers := range [][]dataplane.VirtualServer{conf.HTTPServers, conf.SSLServers} {
+		for _, s := range servers {
+			if s.Port < PrivilegedPortLimit && !slices.Contains(ports, s.Port) {
+				ports = append(ports, s.Port)
+			}
+		}
+	}
+
+	slices.Sort(ports)
+
+	params := make([]shared.MapParameter, 0, len(ports))
+	for _, port := range ports {
+		params = append(params, shared.MapParameter{
+			Value:  strconv.Itoa(int(listenPort(port, unprivilegedPortOffset))),
+			Result: strconv.Itoa(int(port)),
+		})
+	}
+
+	return params
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestListenPort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		port    int32
+		offset  int32
+		expPort int32
+	}{
+		{
+			name:    "no offset",
+			port:    80,
+			expPort: 80,
+		},
+		{
+			name:    "privileged port",
+			port:    443,
+			offset:  8000,
+			expPort: 8443,
+		},
+		{
+			name:    "unprivileged port",
+			port:    8443,
+			offset:  8000,
+			expPort: 8443,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(listenPort(test.port, test.offset)).To(Equal(test.expPort))
+		})
+	}
+}
+
+func TestCreateGatewayPorts(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "cafe.example.com", Port: 80},
+			{IsDefault: true, Port: 8080},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 443},
+			{IsDefault: true, Port: 8443},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		expPorts []shared.MapParameter
+		offset   int32
+	}{
+		{
+			name:     "no offset",
+			expPorts: nil,
+		},
+		{
+			name:   "offset",
+			offset: 10000,
+			expPorts: []shared.MapParameter{
+				{Value: "10080", Result: "80"},
+				{Value: "10443", Result: "443"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createGatewayPorts(conf, test.offset)).To(Equal(test.expPorts))
+		})
+	}
+}
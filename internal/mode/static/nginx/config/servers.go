@@ -4,13 +4,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	gotemplate "text/template"
+	"time"
 
-	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/botmitigation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/faultinjection"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/waf"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
@@ -55,7 +61,7 @@ var httpBaseHeaders = []http.Header{
 	},
 	{
 		Name:  "X-Forwarded-Port",
-		Value: "$server_port",
+		Value: "$ngf_gateway_port",
 	},
 }
 
@@ -87,7 +93,7 @@ var grpcBaseHeaders = []http.Header{
 	},
 	{
 		Name:  "X-Forwarded-Port",
-		Value: "$server_port",
+		Value: "$ngf_gateway_port",
 	},
 }
 
@@ -98,18 +104,20 @@ func (g GeneratorImpl) newExecuteServersFunc(generator policies.Generator) execu
 }
 
 func (g GeneratorImpl) executeServers(conf dataplane.Configuration, generator policies.Generator) []executeResult {
-	servers, httpMatchPairs := createServers(conf, generator)
+	servers, httpMatchPairs := createServers(conf, generator, g.pool, g.unprivilegedPortOffset)
 
 	serverConfig := http.ServerConfig{
-		Servers:         servers,
-		IPFamily:        getIPFamily(conf.BaseHTTPConfig),
-		Plus:            g.plus,
-		RewriteClientIP: getRewriteClientIPSettings(conf.BaseHTTPConfig.RewriteClientIPSettings),
+		Servers:                      servers,
+		IPFamily:                     getIPFamily(conf.BaseHTTPConfig),
+		Plus:                         g.plus,
+		RewriteClientIP:              getRewriteClientIPSettings(conf.BaseHTTPConfig.RewriteClientIPSettings),
+		DefaultServerConnectionLimit: createDefaultServerConnectionLimit(conf.ConnectionLimits),
+		RequestRateLimit:             createRequestRateLimit(conf.ConnectionLimits),
 	}
 
 	serverResult := executeResult{
 		dest: httpConfigFile,
-		data: helpers.MustExecuteTemplate(serversTemplate, serverConfig),
+		data: g.templates.execute(TemplateSectionServers, serversTemplate, serverConfig),
 	}
 
 	// create httpMatchPair conf
@@ -133,6 +141,34 @@ func (g GeneratorImpl) executeServers(conf dataplane.Configuration, generator po
 	return allResults
 }
 
+// createDefaultServerConnectionLimit returns the limit of the connections from each client IP address
+// to the default servers, or nil if the connections are not limited.
+func createDefaultServerConnectionLimit(limits dataplane.ConnectionLimits) *http.ConnectionLimit {
+	if limits.DefaultServerMaxConnections == 0 {
+		return nil
+	}
+
+	return &http.ConnectionLimit{
+		Zone:           defaultServerConnectionsZone,
+		MaxConnections: limits.DefaultServerMaxConnections,
+	}
+}
+
+// createRequestRateLimit returns the limit of the rate of the requests to each server, or nil if the rate
+// is not limited. The burst allows one second of requests, so that the requests that arrive at the same time
+// are not rejected.
+func createRequestRateLimit(limits dataplane.ConnectionLimits) *http.RateLimit {
+	if limits.MaxRequestRate == 0 {
+		return nil
+	}
+
+	return &http.RateLimit{
+		Zone:    maxRequestRateZone,
+		Burst:   limits.MaxRequestRate,
+		NoDelay: true,
+	}
+}
+
 // getIPFamily returns whether the server should be configured for IPv4, IPv6, or both.
 func getIPFamily(baseHTTPConfig dataplane.BaseHTTPConfig) shared.IPFamily {
 	switch baseHTTPConfig.IPFamily {
@@ -162,17 +198,28 @@ func createIncludeFileResults(servers []http.Server) []executeResult {
 
 	results := make([]executeResult, 0, len(uniqueIncludes))
 
-	for filename, contents := range uniqueIncludes {
+	for _, filename := range sortedKeys(uniqueIncludes) {
 		results = append(results, executeResult{
 			dest: filename,
-			data: contents,
+			data: uniqueIncludes[filename],
 		})
 	}
 
 	return results
 }
 
-func createServers(conf dataplane.Configuration, generator policies.Generator) ([]http.Server, httpMatchPairs) {
+// createdServer is a server created from a VirtualServer with the match pairs of its locations.
+type createdServer struct {
+	matchPairs httpMatchPairs
+	server     http.Server
+}
+
+func createServers(
+	conf dataplane.Configuration,
+	generator policies.Generator,
+	pool workerPool,
+	portOffset int32,
+) ([]http.Server, httpMatchPairs) {
 	servers := make([]http.Server, 0, len(conf.HTTPServers)+len(conf.SSLServers))
 	finalMatchPairs := make(httpMatchPairs)
 	sharedTLSPorts := make(map[int32]struct{})
@@ -181,23 +228,51 @@ func createServers(conf dataplane.Configuration, generator policies.Generator) (
 		sharedTLSPorts[passthroughServer.Port] = struct{}{}
 	}
 
-	for idx, s := range conf.HTTPServers {
-		serverID := fmt.Sprintf("%d", idx)
-		httpServer, matchPairs := createServer(s, serverID, generator)
-		servers = append(servers, httpServer)
-		maps.Copy(finalMatchPairs, matchPairs)
-	}
+	noEndpoints := getUpstreamsWithoutEndpoints(conf.Upstreams)
+	forwardedProto := len(conf.BaseHTTPConfig.RewriteClientIPSettings.TrustedHops) > 0
+	kubernetesContext := conf.Logging.KubernetesAccessLog != nil
 
-	for idx, s := range conf.SSLServers {
+	// The servers are created in parallel, because creating the locations of large configurations takes time.
+	httpServers := runInPool(pool, conf.HTTPServers, func(idx int, s dataplane.VirtualServer) createdServer {
+		serverID := fmt.Sprintf("%d", idx)
+		httpServer, matchPairs := createServer(
+			s,
+			serverID,
+			generator,
+			pool,
+			noEndpoints,
+			forwardedProto,
+			kubernetesContext,
+			portOffset,
+		)
+
+		return createdServer{server: httpServer, matchPairs: matchPairs}
+	})
+
+	sslServers := runInPool(pool, conf.SSLServers, func(idx int, s dataplane.VirtualServer) createdServer {
 		serverID := fmt.Sprintf("SSL_%d", idx)
 
-		sslServer, matchPairs := createSSLServer(s, serverID, generator)
+		sslServer, matchPairs := createSSLServer(
+			s,
+			serverID,
+			generator,
+			pool,
+			noEndpoints,
+			forwardedProto,
+			kubernetesContext,
+			portOffset,
+		)
 		if _, portInUse := sharedTLSPorts[s.Port]; portInUse {
 			sslServer.Listen = getSocketNameHTTPS(s.Port)
 			sslServer.IsSocket = true
 		}
-		servers = append(servers, sslServer)
-		maps.Copy(finalMatchPairs, matchPairs)
+
+		return createdServer{server: sslServer, matchPairs: matchPairs}
+	})
+
+	for _, created := range slices.Concat(httpServers, sslServers) {
+		servers = append(servers, created.server)
+		maps.Copy(finalMatchPairs, created.matchPairs)
 	}
 
 	return servers, finalMatchPairs
@@ -207,16 +282,38 @@ func createSSLServer(
 	virtualServer dataplane.VirtualServer,
 	serverID string,
 	generator policies.Generator,
+	pool workerPool,
+	noEndpoints map[string]struct{},
+	forwardedProto bool,
+	kubernetesContext bool,
+	portOffset int32,
 ) (http.Server, httpMatchPairs) {
-	listen := fmt.Sprint(virtualServer.Port)
+	listen := fmt.Sprint(listenPort(virtualServer.Port, portOffset))
 	if virtualServer.IsDefault {
-		return http.Server{
+		server := http.Server{
 			IsDefaultSSL: true,
 			Listen:       listen,
-		}, nil
+		}
+
+		if virtualServer.SSL != nil {
+			server.SSL = &http.SSL{
+				Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
+				CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
+			}
+		}
+
+		return server, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator)
+	locs, matchPairs, grpc := createLocations(
+		&virtualServer,
+		serverID,
+		generator,
+		pool,
+		noEndpoints,
+		forwardedProto,
+		kubernetesContext,
+	)
 
 	server := http.Server{
 		ServerName: virtualServer.Hostname,
@@ -224,13 +321,16 @@ func createSSLServer(
 			Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
 			CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
 		},
-		Locations: locs,
-		GRPC:      grpc,
-		Listen:    listen,
+		Locations:            locs,
+		GRPC:                 grpc,
+		Listen:               listen,
+		FaultDelayLocation:   createFaultDelayLocation(virtualServer.PathRules),
+		BotChallengeLocation: createBotChallengeLocation(virtualServer.PathRules),
 	}
 
-	server.Includes = createIncludesFromPolicyGenerateResult(
-		generator.GenerateForServer(virtualServer.Policies, server),
+	server.Includes = append(
+		createIncludesFromPolicyGenerateResult(generator.GenerateForServer(virtualServer.Policies, server)),
+		createServerSnippetIncludes(virtualServer.PathRules)...,
 	)
 	return server, matchPairs
 }
@@ -239,32 +339,91 @@ func createServer(
 	virtualServer dataplane.VirtualServer,
 	serverID string,
 	generator policies.Generator,
+	pool workerPool,
+	noEndpoints map[string]struct{},
+	forwardedProto bool,
+	kubernetesContext bool,
+	portOffset int32,
 ) (http.Server, httpMatchPairs) {
-	listen := fmt.Sprint(virtualServer.Port)
+	listen := fmt.Sprint(listenPort(virtualServer.Port, portOffset))
 
 	if virtualServer.IsDefault {
-		return http.Server{
+		server := http.Server{
 			IsDefaultHTTP: true,
 			Listen:        listen,
+		}
+
+		if resp := virtualServer.DefaultResponse; resp != nil {
+			server.Return = &http.Return{
+				Code: http.StatusCode(resp.StatusCode),
+				Body: resp.Body,
+			}
+			server.DefaultType = resp.ContentType
+		}
+
+		return server, nil
+	}
+
+	// a redirect server with PathRules serves the ACME challenges, and redirects the other requests
+	if virtualServer.HTTPSRedirect != nil && len(virtualServer.PathRules) == 0 {
+		return http.Server{
+			ServerName: virtualServer.Hostname,
+			Listen:     listen,
+			Return:     createReturnValForHTTPSRedirect(virtualServer.HTTPSRedirect),
 		}, nil
 	}
 
-	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator)
+	locs, matchPairs, grpc := createLocations(
+		&virtualServer,
+		serverID,
+		generator,
+		pool,
+		noEndpoints,
+		forwardedProto,
+		kubernetesContext,
+	)
 
 	server := http.Server{
-		ServerName: virtualServer.Hostname,
-		Locations:  locs,
-		Listen:     listen,
-		GRPC:       grpc,
+		ServerName:           virtualServer.Hostname,
+		Locations:            locs,
+		Listen:               listen,
+		GRPC:                 grpc,
+		FaultDelayLocation:   createFaultDelayLocation(virtualServer.PathRules),
+		BotChallengeLocation: createBotChallengeLocation(virtualServer.PathRules),
 	}
 
-	server.Includes = createIncludesFromPolicyGenerateResult(
-		generator.GenerateForServer(virtualServer.Policies, server),
+	server.Includes = append(
+		createIncludesFromPolicyGenerateResult(generator.GenerateForServer(virtualServer.Policies, server)),
+		createServerSnippetIncludes(virtualServer.PathRules)...,
 	)
 
 	return server, matchPairs
 }
 
+// createFaultDelayLocation returns the path of the internal location that delays requests, if any of the path rules
+// has a FaultInjectionPolicy with a delay. Otherwise, it returns an empty string.
+func createFaultDelayLocation(pathRules []dataplane.PathRule) string {
+	for _, rule := range pathRules {
+		if faultinjection.HasDelay(rule.Policies) {
+			return faultinjection.DelayLocationPath
+		}
+	}
+
+	return ""
+}
+
+// createBotChallengeLocation returns the path of the internal location that responds with the bot challenge page,
+// if any of the path rules has a BotMitigationPolicy with the Challenge action. Otherwise, it returns an empty string.
+func createBotChallengeLocation(pathRules []dataplane.PathRule) string {
+	for _, rule := range pathRules {
+		if botmitigation.HasChallenge(rule.Policies) {
+			return botmitigation.ChallengeLocationPath
+		}
+	}
+
+	return ""
+}
+
 // rewriteConfig contains the configuration for a location to rewrite paths,
 // as specified in a URLRewrite filter.
 type rewriteConfig struct {
@@ -276,10 +435,17 @@ type rewriteConfig struct {
 
 type httpMatchPairs map[string][]routeMatch
 
+// createLocations creates the locations of a server. noEndpoints contains the names of the upstreams
+// that have no endpoints. If kubernetesContext is true, the locations set the variables of the Kubernetes context
+// of the requests, which the Kubernetes access log format includes.
 func createLocations(
 	server *dataplane.VirtualServer,
 	serverID string,
 	generator policies.Generator,
+	pool workerPool,
+	noEndpoints map[string]struct{},
+	forwardedProto bool,
+	kubernetesContext bool,
 ) ([]http.Location, httpMatchPairs, bool) {
 	maxLocs, pathsAndTypes := getMaxLocationCountAndPathMap(server.PathRules)
 	locs := make([]http.Location, 0, maxLocs)
@@ -287,11 +453,22 @@ func createLocations(
 
 	var rootPathExists bool
 	var grpc bool
+	var policyTasks []locationPolicyTask
+	// the locations that proxy the subrequests of the hedging locations are added after the other locations
+	var hedgingLocs []http.Location
+	var hedgingPolicyTasks []locationPolicyTask
+
+	listener := serverListener{
+		port:              server.Port,
+		ssl:               server.SSL != nil,
+		forwardedProto:    forwardedProto,
+		kubernetesContext: kubernetesContext,
+	}
 
 	for pathRuleIdx, rule := range server.PathRules {
 		matches := make([]routeMatch, 0, len(rule.MatchRules))
 
-		if rule.Path == rootPath {
+		if rule.Path == rootPath && rule.PathType != dataplane.PathTypeRegularExpression {
 			rootPathExists = true
 		}
 
@@ -299,16 +476,61 @@ func createLocations(
 			grpc = true
 		}
 
+		hedgingDelay, hedging := proxysettings.HedgingDelay(rule.Policies)
+		hedging = hedging && !rule.GRPC
+
+		addHedgingLocation := func(path string, matchRule dataplane.MatchRule) http.Location {
+			proxyLocation := http.Location{
+				Path:        path,
+				Type:        http.InternalLocationType,
+				NoEndpoints: proxiesToNoEndpoints(matchRule, noEndpoints),
+			}
+			hedgingPolicyTasks = append(hedgingPolicyTasks, locationPolicyTask{
+				location: proxyLocation,
+				policies: rule.Policies,
+				idx:      len(hedgingLocs),
+				internal: true,
+			})
+
+			proxyLocation = updateLocation(matchRule.Filters, proxyLocation, matchRule, listener, rule)
+			proxyLocation.HedgingProxy = true
+			// the requests are rate limited by the hedging location
+			proxyLocation.RateLimit = nil
+
+			hedgingLocs = append(hedgingLocs, proxyLocation)
+
+			return proxyLocation
+		}
+
 		extLocations := initializeExternalLocations(rule, pathsAndTypes)
 		for i := range extLocations {
-			extLocations[i].Includes = createIncludesFromPolicyGenerateResult(
-				generator.GenerateForLocation(rule.Policies, extLocations[i]),
-			)
+			if !needsInternalLocations(rule) {
+				extLocations[i].NoEndpoints = proxiesToNoEndpoints(rule.MatchRules[0], noEndpoints)
+			}
+			policyTasks = append(policyTasks, locationPolicyTask{
+				location: extLocations[i],
+				policies: rule.Policies,
+				idx:      len(locs) + i,
+			})
+			if rule.ACMEChallenge {
+				extLocations[i].Includes = append(
+					extLocations[i].Includes,
+					createIncludesFromPolicyGenerateResult(waf.GenerateDisabled(server.Policies))...,
+				)
+			}
 		}
 
 		if !needsInternalLocations(rule) {
 			for _, r := range rule.MatchRules {
-				extLocations = updateLocations(r.Filters, extLocations, r, server.Port, rule.Path, rule.GRPC)
+				extLocations = updateLocations(r.Filters, extLocations, r, listener, rule)
+			}
+
+			if hedging && canHedge(extLocations) {
+				path := fmt.Sprintf("%s-rule%d-hedging", http.InternalRoutePathPrefix, pathRuleIdx)
+				proxyLocation := addHedgingLocation(path, rule.MatchRules[0])
+				for i := range extLocations {
+					extLocations[i] = hedgeLocation(extLocations[i], proxyLocation.Path, hedgingDelay)
+				}
 			}
 
 			locs = append(locs, extLocations...)
@@ -319,19 +541,34 @@ func createLocations(
 
 		for matchRuleIdx, r := range rule.MatchRules {
 			intLocation, match := initializeInternalLocation(pathRuleIdx, matchRuleIdx, r.Match, grpc)
-			intLocation.Includes = createIncludesFromPolicyGenerateResult(
-				generator.GenerateForInternalLocation(rule.Policies),
-			)
+			intLocation.Route = getRoute(r)
+			intLocation.NoEndpoints = proxiesToNoEndpoints(r, noEndpoints)
+			policyTasks = append(policyTasks, locationPolicyTask{
+				location: intLocation,
+				policies: rule.Policies,
+				idx:      len(locs) + len(extLocations) + matchRuleIdx,
+				internal: true,
+			})
+			if rule.ACMEChallenge {
+				intLocation.Includes = append(
+					intLocation.Includes,
+					createIncludesFromPolicyGenerateResult(waf.GenerateDisabled(server.Policies))...,
+				)
+			}
 
 			intLocation = updateLocation(
 				r.Filters,
 				intLocation,
 				r,
-				server.Port,
-				rule.Path,
-				rule.GRPC,
+				listener,
+				rule,
 			)
 
+			if hedging && canHedge([]http.Location{intLocation}) {
+				proxyLocation := addHedgingLocation(intLocation.Path+"-hedging", r)
+				intLocation = hedgeLocation(intLocation, proxyLocation.Path, hedgingDelay)
+			}
+
 			internalLocations = append(internalLocations, intLocation)
 			matches = append(matches, match)
 		}
@@ -349,13 +586,173 @@ func createLocations(
 		locs = append(locs, internalLocations...)
 	}
 
+	for _, task := range hedgingPolicyTasks {
+		task.idx += len(locs)
+		policyTasks = append(policyTasks, task)
+	}
+	locs = append(locs, hedgingLocs...)
+
+	addPolicyIncludes(locs, policyTasks, generator, pool)
+
 	if !rootPathExists {
-		locs = append(locs, createDefaultRootLocation())
+		locs = append(locs, createDefaultRootLocation(server.HTTPSRedirect))
+	}
+
+	if server.CaseInsensitivePaths {
+		locs = caseInsensitiveLocations(locs)
 	}
 
 	return locs, matchPairs, grpc
 }
 
+// caseInsensitiveLocations returns the locations of a server with case-insensitive paths. NGINX matches the exact
+// and prefix locations case-sensitively, so they are replaced with case-insensitive regular expression locations,
+// which match the URI of the request without changing it, so that the backends receive the URI in its original case.
+// NGINX uses the first regular expression location that matches, so the locations are ordered to keep the precedence
+// of the exact and prefix locations: the exact locations come first, then the regular expression locations
+// of the rules, and then the prefix locations from the longest to the shortest. The root location, the internal
+// locations, and the locations of the ACME challenges, which take precedence over the regular expression locations,
+// are kept.
+func caseInsensitiveLocations(locs []http.Location) []http.Location {
+	var exact, regex, prefix, other []http.Location
+
+	for _, loc := range locs {
+		switch {
+		case loc.Type == http.InternalLocationType, loc.Path == rootPath, strings.HasPrefix(loc.Path, "^~ "):
+			other = append(other, loc)
+		case strings.HasPrefix(loc.Path, "= "):
+			loc.Path = regexPath("^"+regexp.QuoteMeta(strings.TrimPrefix(loc.Path, "= "))+"$", true)
+			exact = append(exact, loc)
+		case strings.HasPrefix(loc.Path, "~"):
+			regex = append(regex, loc)
+		default:
+			prefix = append(prefix, loc)
+		}
+	}
+
+	slices.SortStableFunc(prefix, func(a, b http.Location) int {
+		return len(b.Path) - len(a.Path)
+	})
+
+	for i := range prefix {
+		prefix[i].Path = regexPath("^"+regexp.QuoteMeta(prefix[i].Path), true)
+	}
+
+	result := make([]http.Location, 0, len(locs))
+	result = append(result, exact...)
+	result = append(result, regex...)
+	result = append(result, prefix...)
+
+	return append(result, other...)
+}
+
+// canHedge returns true if the locations proxy their requests, so that they can hedge them.
+func canHedge(locations []http.Location) bool {
+	for _, loc := range locations {
+		if loc.ProxyPass == "" || loc.Return != nil {
+			return false
+		}
+	}
+
+	return len(locations) > 0
+}
+
+// hedgeLocation makes the location hedge its requests: instead of proxying the requests, the location sends them
+// as subrequests to the proxy location, and sends them again if they are not answered after the delay.
+// The proxy location proxies the requests in place of the location.
+func hedgeLocation(location http.Location, proxyLocationPath string, delay time.Duration) http.Location {
+	location.Hedging = &http.Hedging{
+		Location:          proxyLocationPath,
+		DelayMilliseconds: delay.Milliseconds(),
+	}
+	location.ProxyPass = ""
+	location.ProxySetHeaders = nil
+	location.ProxySSLVerify = nil
+	location.Rewrites = nil
+	location.QueryParameterModifications = ""
+
+	return location
+}
+
+// locationPolicyTask is the generation of the configuration of the policies for a location.
+type locationPolicyTask struct {
+	// policies are the policies of the location.
+	policies []policies.Policy
+	// location is the location, as it is before the filters are applied, for which the configuration
+	// is generated.
+	location http.Location
+	// idx is the index of the location among the locations of the server.
+	idx int
+	// internal indicates whether the location is an internal location.
+	internal bool
+}
+
+// addPolicyIncludes generates the configuration of the policies of the locations in parallel and adds
+// the includes of the configuration before the other includes of the locations.
+func addPolicyIncludes(
+	locs []http.Location,
+	tasks []locationPolicyTask,
+	generator policies.Generator,
+	pool workerPool,
+) {
+	includes := runInPool(pool, tasks, func(_ int, task locationPolicyTask) []http.Include {
+		if task.internal {
+			return createIncludesFromPolicyGenerateResult(
+				generator.GenerateForInternalLocation(task.policies, task.location),
+			)
+		}
+
+		return createIncludesFromPolicyGenerateResult(generator.GenerateForLocation(task.policies, task.location))
+	})
+
+	for i, task := range tasks {
+		if len(includes[i]) > 0 {
+			locs[task.idx].Includes = append(includes[i], locs[task.idx].Includes...)
+		}
+	}
+}
+
+// getUpstreamsWithoutEndpoints returns the names of the upstreams that have no endpoints.
+func getUpstreamsWithoutEndpoints(upstreams []dataplane.Upstream) map[string]struct{} {
+	noEndpoints := make(map[string]struct{})
+
+	for _, u := range upstreams {
+		if len(u.Endpoints) == 0 {
+			noEndpoints[u.Name] = struct{}{}
+		}
+	}
+
+	return noEndpoints
+}
+
+// proxiesToNoEndpoints returns true if the location of the match rule proxies requests, and none of the backends
+// that it proxies to have endpoints. noEndpoints contains the names of the upstreams that have no endpoints.
+func proxiesToNoEndpoints(matchRule dataplane.MatchRule, noEndpoints map[string]struct{}) bool {
+	if matchRule.Filters.InvalidFilter != nil || matchRule.Filters.RequestRedirect != nil {
+		return false
+	}
+
+	var proxies bool
+
+	for _, b := range matchRule.BackendGroup.Backends {
+		if b.Weight == 0 {
+			continue
+		}
+
+		if !b.Valid {
+			return false
+		}
+
+		if _, ok := noEndpoints[b.UpstreamName]; !ok {
+			return false
+		}
+
+		proxies = true
+	}
+
+	return proxies
+}
+
 func needsInternalLocations(rule dataplane.PathRule) bool {
 	if len(rule.MatchRules) > 1 {
 		return true
@@ -414,6 +811,13 @@ func initializeExternalLocations(
 	locType := getLocationTypeForPathRule(rule)
 	externalLocPath := createPath(rule)
 
+	// An external location only serves the requests of a single Route if it doesn't redirect them
+	// to internal locations.
+	var route string
+	if locType == http.ExternalLocationType && len(rule.MatchRules) == 1 {
+		route = getRoute(rule.MatchRules[0])
+	}
+
 	// If the path type is Prefix and doesn't contain a trailing slash, then we need a second location
 	// that handles the Exact prefix case (if it doesn't already exist), and the first location is updated
 	// to handle the trailing slash prefix case (if it doesn't already exist)
@@ -433,29 +837,50 @@ func initializeExternalLocations(
 
 		if !trailingSlashPrefixPathExists {
 			externalLocTrailing := http.Location{
-				Path: externalLocPath + "/",
-				Type: locType,
+				Path:  externalLocPath + "/",
+				Type:  locType,
+				Route: route,
 			}
 			extLocations = append(extLocations, externalLocTrailing)
 		}
 		if !exactPathExists {
 			externalLocExact := http.Location{
-				Path: exactPath(externalLocPath),
-				Type: locType,
+				Path:  exactPath(externalLocPath),
+				Type:  locType,
+				Route: route,
 			}
 			extLocations = append(extLocations, externalLocExact)
 		}
 	} else {
 		externalLoc := http.Location{
-			Path: externalLocPath,
-			Type: locType,
+			Path:  externalLocPath,
+			Type:  locType,
+			Route: route,
 		}
 		extLocations = []http.Location{externalLoc}
 	}
 
+	// the prefix locations of the ACME challenges take precedence over the regular expression locations
+	if rule.ACMEChallenge {
+		for i := range extLocations {
+			if !strings.HasPrefix(extLocations[i].Path, "= ") {
+				extLocations[i].Path = "^~ " + extLocations[i].Path
+			}
+		}
+	}
+
 	return extLocations
 }
 
+// getRoute returns the namespaced name of the Route of the match rule in the "namespace/name" format.
+func getRoute(matchRule dataplane.MatchRule) string {
+	if matchRule.Source == nil {
+		return ""
+	}
+
+	return matchRule.Source.Namespace + "/" + matchRule.Source.Name
+}
+
 func getLocationTypeForPathRule(rule dataplane.PathRule) http.LocationType {
 	if needsInternalLocations(rule) {
 		return http.RedirectLocationType
@@ -479,24 +904,44 @@ func updateLocation(
 	filters dataplane.HTTPFilters,
 	location http.Location,
 	matchRule dataplane.MatchRule,
-	listenerPort int32,
-	path string,
-	grpc bool,
+	listener serverListener,
+	rule dataplane.PathRule,
 ) http.Location {
+	path := createRewritePath(rule)
+	grpc := rule.GRPC
+
+	if listener.kubernetesContext {
+		location.KubernetesContext = createKubernetesContext(matchRule)
+	}
+
 	if filters.InvalidFilter != nil {
 		location.Return = &http.Return{Code: http.StatusInternalServerError}
 		return location
 	}
 
 	if filters.RequestRedirect != nil {
-		ret := createReturnValForRedirectFilter(filters.RequestRedirect, listenerPort)
-		location.Return = ret
+		location.Rewrites = append(location.Rewrites, createRewritesForRedirectFilter(filters.RequestRedirect, path)...)
+		location.Return = createReturnValForRedirectFilter(filters.RequestRedirect, listener)
 		return location
 	}
 
+	// the extensionRef filters are applied after the core filters
+	if snippetIncludes := createLocationSnippetIncludes(filters.SnippetsFilters); len(snippetIncludes) > 0 {
+		includes := make([]http.Include, 0, len(location.Includes)+len(snippetIncludes))
+		includes = append(includes, location.Includes...)
+		location.Includes = append(includes, snippetIncludes...)
+	}
+	location.RateLimit = createRateLimit(filters.RateLimit)
+	location.QueryParameterModifications = createQueryParameterModifications(filters.QueryParameterModifiers)
+
 	rewrites := createRewritesValForRewriteFilter(filters.RequestURLRewrite, path)
-	proxySetHeaders := generateProxySetHeaders(&matchRule.Filters, grpc)
+	proxySetHeaders := generateProxySetHeaders(&matchRule.Filters, grpc, listener.schemeVariable())
 	responseHeaders := generateResponseHeaders(&matchRule.Filters)
+	responseHeaders.Add = append(
+		responseHeaders.Add,
+		createConditionalResponseHeaders(filters.ConditionalResponseHeaders)...,
+	)
+	responseHeaders.Add = append(responseHeaders.Add, createABTestResponseHeaders(matchRule.BackendGroup)...)
 
 	if rewrites != nil {
 		if location.Type == http.InternalLocationType && rewrites.InternalRewrite != "" {
@@ -514,29 +959,47 @@ func updateLocation(
 		matchRule.Filters.RequestURLRewrite,
 		generateProtocolString(location.ProxySSLVerify, grpc),
 		grpc,
+		location.QueryParameterModifications != "",
 	)
 
 	location.ResponseHeaders = responseHeaders
 	location.ProxyPass = proxyPass
 	location.GRPC = grpc
 
+	if location.KubernetesContext != nil {
+		location.KubernetesContext.Upstream = proxyUpstream(matchRule.BackendGroup)
+	}
+
 	return location
 }
 
+// createKubernetesContext returns the Kubernetes context of the requests of the match rule, or nil if the rule
+// doesn't belong to a Route.
+func createKubernetesContext(matchRule dataplane.MatchRule) *http.KubernetesContext {
+	if matchRule.Source == nil {
+		return nil
+	}
+
+	return &http.KubernetesContext{
+		RouteNamespace: matchRule.Source.Namespace,
+		RouteName:      matchRule.Source.Name,
+		RouteRule:      matchRule.BackendGroup.RuleIdx,
+	}
+}
+
 // updateLocations updates the existing locations with any relevant configurations, like proxy_pass,
 // filters, tls settings, etc.
 func updateLocations(
 	filters dataplane.HTTPFilters,
 	buildLocations []http.Location,
 	matchRule dataplane.MatchRule,
-	listenerPort int32,
-	path string,
-	grpc bool,
+	listener serverListener,
+	rule dataplane.PathRule,
 ) []http.Location {
 	updatedLocations := make([]http.Location, len(buildLocations))
 
 	for i, loc := range buildLocations {
-		updatedLocations[i] = updateLocation(filters, loc, matchRule, listenerPort, path, grpc)
+		updatedLocations[i] = updateLocation(filters, loc, matchRule, listener, rule)
 	}
 
 	return updatedLocations
@@ -587,7 +1050,45 @@ func createProxySSLVerify(v *dataplane.VerifyTLS) *http.ProxySSLVerify {
 	}
 }
 
-func createReturnValForRedirectFilter(filter *dataplane.HTTPRequestRedirectFilter, listenerPort int32) *http.Return {
+// wellKnownPorts are the well-known ports of the schemes of a redirect.
+var wellKnownPorts = map[string]int32{
+	"http":  80,
+	"https": 443,
+}
+
+// serverListener is the listener of a server.
+type serverListener struct {
+	port int32
+	ssl  bool
+	// forwardedProto specifies whether the scheme of the client requests is taken from the proto headers
+	// of the trusted hops.
+	forwardedProto bool
+	// kubernetesContext specifies whether the locations set the variables of the Kubernetes context
+	// of the requests.
+	kubernetesContext bool
+}
+
+func (l serverListener) scheme() string {
+	if l.ssl {
+		return "https"
+	}
+
+	return "http"
+}
+
+// schemeVariable returns the NGINX variable of the scheme of the client requests.
+func (l serverListener) schemeVariable() string {
+	if l.forwardedProto {
+		return "$" + forwardedProtoVariable
+	}
+
+	return "$scheme"
+}
+
+func createReturnValForRedirectFilter(
+	filter *dataplane.HTTPRequestRedirectFilter,
+	listener serverListener,
+) *http.Return {
 	if filter == nil {
 		return nil
 	}
@@ -602,33 +1103,75 @@ func createReturnValForRedirectFilter(filter *dataplane.HTTPRequestRedirectFilte
 		code = http.StatusCode(*filter.StatusCode)
 	}
 
-	port := listenerPort
+	// If the scheme is not set, the redirect uses the scheme and the port of the listener.
+	// If the scheme is set, the redirect uses the well-known port of the scheme.
+	scheme := listener.schemeVariable()
+	redirectScheme := listener.scheme()
+	port := listener.port
+
+	if filter.Scheme != nil {
+		scheme = *filter.Scheme
+		redirectScheme = *filter.Scheme
+
+		if wellKnownPort, ok := wellKnownPorts[redirectScheme]; ok {
+			port = wellKnownPort
+		}
+	}
+
 	if filter.Port != nil {
 		port = *filter.Port
 	}
 
 	hostnamePort := fmt.Sprintf("%s:%d", hostname, port)
 
-	scheme := "$scheme"
-	if filter.Scheme != nil {
-		scheme = *filter.Scheme
-		// Don't specify the port in the return url if the scheme is
-		// well known and the port is already set to the correct well known port
-		if (port == 80 && scheme == "http") || (port == 443 && scheme == "https") {
-			hostnamePort = hostname
-		}
-		if filter.Port == nil {
-			// Don't specify the port in the return url if the scheme is
-			// well known and the port is not specified by the user
-			if scheme == "http" || scheme == "https" {
-				hostnamePort = hostname
-			}
+	// Don't specify the port in the return url if it is the well-known port of the scheme
+	if wellKnownPort, ok := wellKnownPorts[redirectScheme]; ok && port == wellKnownPort {
+		hostnamePort = hostname
+	}
+
+	path := "$request_uri"
+	if filter.Path != nil {
+		switch filter.Path.Type {
+		case dataplane.ReplaceFullPath:
+			path = filter.Path.Replacement + "$is_args$args"
+		case dataplane.ReplacePrefixMatch:
+			// the rewrites of the redirect replace the prefix in the URI, see createRewritesForRedirectFilter
+			path = "$uri"
 		}
 	}
 
 	return &http.Return{
 		Code: code,
-		Body: fmt.Sprintf("%s://%s$request_uri", scheme, hostnamePort),
+		Body: fmt.Sprintf("%s://%s%s", scheme, hostnamePort, path),
+	}
+}
+
+// createRewritesForRedirectFilter creates the rewrites that replace the prefix match in the URI of a redirect.
+// The rewrites don't have a flag, so that NGINX returns the redirect after the rewrites.
+func createRewritesForRedirectFilter(filter *dataplane.HTTPRequestRedirectFilter, path string) []string {
+	if filter == nil || filter.Path == nil || filter.Path.Type != dataplane.ReplacePrefixMatch {
+		return nil
+	}
+
+	regex, replacement := createPrefixMatchRewrite(path, filter.Path.Replacement)
+
+	return []string{
+		// the URI of an internal location is not the original URI, and the original URI also includes the arguments
+		"^ $request_uri",
+		fmt.Sprintf("%s %s", regex, replacement),
+	}
+}
+
+func createReturnValForHTTPSRedirect(redirect *dataplane.HTTPSRedirect) *http.Return {
+	hostnamePort := "$host"
+	// Don't specify the port in the return url if it is the well known HTTPS port
+	if redirect.Port != 443 {
+		hostnamePort = fmt.Sprintf("$host:%d", redirect.Port)
+	}
+
+	return &http.Return{
+		Code: http.StatusCode(redirect.StatusCode),
+		Body: fmt.Sprintf("%s://%s$request_uri", http.HTTPSScheme, hostnamePort),
 	}
 }
 
@@ -645,34 +1188,40 @@ func createRewritesValForRewriteFilter(filter *dataplane.HTTPURLRewriteFilter, p
 		case dataplane.ReplaceFullPath:
 			rewrites.MainRewrite = fmt.Sprintf("^ %s break", filter.Path.Replacement)
 		case dataplane.ReplacePrefixMatch:
-			filterPrefix := filter.Path.Replacement
-			if filterPrefix == "" {
-				filterPrefix = "/"
-			}
+			regex, replacement := createPrefixMatchRewrite(path, filter.Path.Replacement)
+			rewrites.MainRewrite = fmt.Sprintf("%s %s break", regex, replacement)
+		}
+	}
 
-			// capture everything after the configured prefix
-			regex := fmt.Sprintf("^%s(.*)$", path)
-			// replace the configured prefix with the filter prefix and append what was captured
-			replacement := fmt.Sprintf("%s$1", filterPrefix)
+	return rewrites
+}
 
-			// if configured prefix does not end in /, but replacement prefix does end in /,
-			// then make sure that we *require* but *don't capture* a trailing slash in the request,
-			// otherwise we'll get duplicate slashes in the full replacement
-			if strings.HasSuffix(filterPrefix, "/") && !strings.HasSuffix(path, "/") {
-				regex = fmt.Sprintf("^%s(?:/(.*))?$", path)
-			}
+// createPrefixMatchRewrite creates the regex and the replacement of a rewrite that replaces the prefix match path
+// with the filter prefix.
+func createPrefixMatchRewrite(path, filterPrefix string) (regex, replacement string) {
+	if filterPrefix == "" {
+		filterPrefix = "/"
+	}
 
-			// if configured prefix ends in / we won't capture it for a request (since it's not in the regex),
-			// so append it to the replacement prefix if the replacement prefix doesn't already end in /
-			if strings.HasSuffix(path, "/") && !strings.HasSuffix(filterPrefix, "/") {
-				replacement = fmt.Sprintf("%s/$1", filterPrefix)
-			}
+	// capture everything after the configured prefix
+	regex = fmt.Sprintf("^%s(.*)$", path)
+	// replace the configured prefix with the filter prefix and append what was captured
+	replacement = fmt.Sprintf("%s$1", filterPrefix)
 
-			rewrites.MainRewrite = fmt.Sprintf("%s %s break", regex, replacement)
-		}
+	// if configured prefix does not end in /, but replacement prefix does end in /,
+	// then make sure that we *require* but *don't capture* a trailing slash in the request,
+	// otherwise we'll get duplicate slashes in the full replacement
+	if strings.HasSuffix(filterPrefix, "/") && !strings.HasSuffix(path, "/") {
+		regex = fmt.Sprintf("^%s(?:/(.*))?$", path)
 	}
 
-	return rewrites
+	// if configured prefix ends in / we won't capture it for a request (since it's not in the regex),
+	// so append it to the replacement prefix if the replacement prefix doesn't already end in /
+	if strings.HasSuffix(path, "/") && !strings.HasSuffix(filterPrefix, "/") {
+		replacement = fmt.Sprintf("%s/$1", filterPrefix)
+	}
+
+	return regex, replacement
 }
 
 // routeMatch is an internal representation of an HTTPRouteMatch.
@@ -759,20 +1308,31 @@ func createProxyPass(
 	filter *dataplane.HTTPURLRewriteFilter,
 	protocol string,
 	grpc bool,
+	modifiedArgs bool,
 ) string {
 	var requestURI string
 	if !grpc {
 		if filter == nil || filter.Path == nil {
 			requestURI = "$request_uri"
+			if modifiedArgs {
+				// $request_uri includes the original arguments
+				requestURI = "$" + requestPathVariable + "$is_args$args"
+			}
 		}
 	}
 
+	return protocol + "://" + proxyUpstream(backendGroup) + requestURI
+}
+
+// proxyUpstream returns the upstream that the requests of the backend group are proxied to. If the requests are
+// split between the backends, it is the variable of the split_clients directive, whose value is the upstream.
+func proxyUpstream(backendGroup dataplane.BackendGroup) string {
 	backendName := backendGroupName(backendGroup)
 	if backendGroupNeedsSplit(backendGroup) {
-		return protocol + "://$" + convertStringToSafeVariableName(backendName) + requestURI
+		return "$" + convertStringToSafeVariableName(backendName)
 	}
 
-	return protocol + "://" + backendName + requestURI
+	return backendName
 }
 
 func createMatchLocation(path string, grpc bool) http.Location {
@@ -790,7 +1350,7 @@ func createMatchLocation(path string, grpc bool) http.Location {
 	return loc
 }
 
-func generateProxySetHeaders(filters *dataplane.HTTPFilters, grpc bool) []http.Header {
+func generateProxySetHeaders(filters *dataplane.HTTPFilters, grpc bool, scheme string) []http.Header {
 	var headers []http.Header
 	if !grpc {
 		headers = make([]http.Header, len(httpBaseHeaders))
@@ -800,6 +1360,13 @@ func generateProxySetHeaders(filters *dataplane.HTTPFilters, grpc bool) []http.H
 		copy(headers, grpcBaseHeaders)
 	}
 
+	for i, header := range headers {
+		if header.Name == "X-Forwarded-Proto" {
+			headers[i].Value = scheme
+			break
+		}
+	}
+
 	if filters != nil && filters.RequestURLRewrite != nil && filters.RequestURLRewrite.Hostname != nil {
 		for i, header := range headers {
 			if header.Name == "Host" {
@@ -881,17 +1448,53 @@ func exactPath(path string) string {
 	return fmt.Sprintf("= %s", path)
 }
 
+// regexPathEscaper escapes a regular expression in a quoted NGINX string, in which NGINX unescapes '\\' and '\"'.
+var regexPathEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// regexPath returns the path of a regular expression location. The expression is quoted, because
+// it can contain characters like '{' and ';'.
+func regexPath(regex string, caseInsensitive bool) string {
+	modifier := "~"
+	if caseInsensitive {
+		modifier = "~*"
+	}
+
+	return fmt.Sprintf(`%s "%s"`, modifier, regexPathEscaper.Replace(regex))
+}
+
 // createPath builds the location path depending on the path type.
 func createPath(rule dataplane.PathRule) string {
 	switch rule.PathType {
 	case dataplane.PathTypeExact:
 		return exactPath(rule.Path)
+	case dataplane.PathTypeRegularExpression:
+		return regexPath(rule.Path, rule.CaseInsensitive)
 	default:
 		return rule.Path
 	}
 }
 
-func createDefaultRootLocation() http.Location {
+// createRewritePath returns the path that the prefix match rewrites of the rule capture.
+// The URI of the request keeps its original case, so the prefix of a case-insensitive rule
+// is captured with the case-insensitive option of PCRE.
+func createRewritePath(rule dataplane.PathRule) string {
+	if rule.CaseInsensitive {
+		return "(?i)" + rule.Path
+	}
+
+	return rule.Path
+}
+
+// createDefaultRootLocation returns the location for the requests that no rule of the server matches.
+// The location of a server that redirects to HTTPS redirects the requests, otherwise it returns 404.
+func createDefaultRootLocation(redirect *dataplane.HTTPSRedirect) http.Location {
+	if redirect != nil {
+		return http.Location{
+			Path:   "/",
+			Return: createReturnValForHTTPSRedirect(redirect),
+		}
+	}
+
 	return http.Location{
 		Path:   "/",
 		Return: &http.Return{Code: http.StatusNotFound},
@@ -910,10 +1513,20 @@ func getRewriteClientIPSettings(rewriteIPConfig dataplane.RewriteClientIPSetting
 		proxyProtocol = shared.ProxyProtocolDirective
 	}
 
+	realIPFrom := slices.Clone(rewriteIPConfig.TrustedAddresses)
+	for _, hop := range rewriteIPConfig.TrustedHops {
+		for _, address := range hop.Addresses {
+			if !slices.Contains(realIPFrom, address) {
+				realIPFrom = append(realIPFrom, address)
+			}
+		}
+	}
+
 	return shared.RewriteClientIPSettings{
-		RealIPHeader:  string(rewriteIPConfig.Mode),
-		RealIPFrom:    rewriteIPConfig.TrustedAddresses,
-		Recursive:     rewriteIPConfig.IPRecursive,
+		RealIPHeader: string(rewriteIPConfig.Mode),
+		RealIPFrom:   realIPFrom,
+		// the client IP is behind the addresses of all hops in the X-Forwarded-For header
+		Recursive:     rewriteIPConfig.IPRecursive || len(rewriteIPConfig.TrustedHops) > 0,
 		ProxyProtocol: proxyProtocol,
 	}
 }
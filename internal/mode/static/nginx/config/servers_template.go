@@ -12,7 +12,12 @@ server {
         {{- if and ($.IPFamily.IPv6) (not $s.IsSocket) }}
     listen [::]:{{ $s.Listen }} ssl default_server{{ $.RewriteClientIP.ProxyProtocol }};
         {{- end }}
+        {{- if $s.SSL }}
+    ssl_certificate {{ $s.SSL.Certificate }};
+    ssl_certificate_key {{ $s.SSL.CertificateKey }};
+        {{- else }}
     ssl_reject_handshake on;
+        {{- end }}
         {{- range $address := $.RewriteClientIP.RealIPFrom }}
     set_real_ip_from {{ $address }};
         {{- end}}
@@ -22,6 +27,10 @@ server {
         {{- if $.RewriteClientIP.Recursive}}
     real_ip_recursive on;
         {{- end }}
+        {{- if $s.SSL }}
+    default_type text/html;
+    return 404;
+        {{- end }}
 }
     {{- else if $s.IsDefaultHTTP }}
 server {
@@ -40,8 +49,18 @@ server {
         {{- if $.RewriteClientIP.Recursive}}
     real_ip_recursive on;
         {{- end }}
+        {{- if $.DefaultServerConnectionLimit }}
+    limit_conn {{ $.DefaultServerConnectionLimit.Zone }} {{ $.DefaultServerConnectionLimit.MaxConnections }};
+        {{- end }}
+        {{- if $s.Return }}
+            {{- if $s.DefaultType }}
+    default_type "{{ $s.DefaultType }}";
+            {{- end }}
+    return {{ $s.Return.Code }} "{{ $s.Return.Body }}";
+        {{- else }}
     default_type text/html;
     return 404;
+        {{- end }}
 }
     {{- else }}
 server {
@@ -69,6 +88,10 @@ server {
 
     server_name {{ $s.ServerName }};
 
+        {{- if $s.Return }}
+    return {{ $s.Return.Code }} "{{ $s.Return.Body }}";
+        {{- end }}
+
         {{- if $.Plus }}
     status_zone {{ $s.ServerName }};
         {{- end }}
@@ -88,7 +111,8 @@ server {
         {{- end }}
 
         {{ range $l := $s.Locations }}
-    location {{ $l.Path }} {
+        {{- /* the ^~ modifier prevents the regular expression locations from matching the internal redirects */}}
+    location {{ if eq $l.Type "internal" }}^~ {{ end }}{{ $l.Path }} {
         {{ if eq $l.Type "internal" -}}
         internal;
         {{ end }}
@@ -97,6 +121,31 @@ server {
         include {{ $i.Name }};
         {{- end -}}
 
+        {{- if $l.RateLimit }}
+        limit_req zone={{ $l.RateLimit.Zone }}
+            {{- if $l.RateLimit.Burst }} burst={{ $l.RateLimit.Burst }}{{ end }}
+            {{- if $l.RateLimit.NoDelay }} nodelay{{ end }};
+        limit_req_status {{ $l.RateLimit.RejectCode }};
+        {{- end }}
+
+        {{- if and $.RequestRateLimit (ne $l.Type "redirect") }}
+        limit_req zone={{ $.RequestRateLimit.Zone }} burst={{ $.RequestRateLimit.Burst }} nodelay;
+        {{- end }}
+
+        {{- if $l.KubernetesContext }}
+        set $ngf_route_namespace "{{ $l.KubernetesContext.RouteNamespace }}";
+        set $ngf_route_name "{{ $l.KubernetesContext.RouteName }}";
+        set $ngf_route_rule "{{ $l.KubernetesContext.RouteRule }}";
+            {{- if $l.KubernetesContext.Upstream }}
+        set $ngf_upstream "{{ $l.KubernetesContext.Upstream }}";
+            {{- end }}
+        {{- end }}
+
+        {{- if $l.QueryParameterModifications }}
+        set $ngf_query_parameter_modifications "{{ $l.QueryParameterModifications }}";
+        set $args $ngf_modified_args;
+        {{- end }}
+
         {{ range $r := $l.Rewrites }}
         rewrite {{ $r }};
         {{- end }}
@@ -110,6 +159,22 @@ server {
         js_content httpmatches.redirect;
         {{- end }}
 
+        {{- if $l.Hedging }}
+        set $ngf_hedging_location {{ $l.Hedging.Location }};
+        set $ngf_hedging_delay_ms {{ $l.Hedging.DelayMilliseconds }};
+        js_content hedging.hedge;
+            {{- range $h := $l.ResponseHeaders.Add }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+            {{- range $h := $l.ResponseHeaders.Set }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+        {{- end }}
+
+        {{- if $l.HedgingProxy }}
+        subrequest_output_buffer_size 1m;
+        {{- end }}
+
         {{ $proxyOrGRPC := "proxy" }}{{ if $l.GRPC }}{{ $proxyOrGRPC = "grpc" }}{{ end }}
 
         {{- if $l.GRPC }}
@@ -142,6 +207,27 @@ server {
     }
         {{- end }}
 
+        {{- if $s.FaultDelayLocation }}
+
+    location = {{ $s.FaultDelayLocation }} {
+        internal;
+        js_content faults.delay;
+    }
+        {{- end }}
+
+        {{- if $s.BotChallengeLocation }}
+
+    location = {{ $s.BotChallengeLocation }} {
+        internal;
+        default_type text/html;
+        add_header Cache-Control "no-store" always;
+        return 403 '<html><head><script>
+document.cookie = "ngf_bot_challenge=passed; path=/; SameSite=Lax";
+window.location.reload();
+</script></head><body>Checking your browser...</body></html>';
+    }
+        {{- end }}
+
         {{- if $s.GRPC }}
         include /etc/nginx/grpc-error-locations.conf;
         {{- end }}
@@ -7,14 +7,17 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 func TestExecuteServers(t *testing.T) {
@@ -265,6 +268,32 @@ func TestExecuteServers_IPFamily(t *testing.T) {
 				"real_ip_recursive on;":                                    0,
 			},
 		},
+		{
+			msg: "default ssl server with a default certificate",
+			config: dataplane.Configuration{
+				SSLServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						SSL: &dataplane.SSL{
+							KeyPairID: "default-keypair",
+						},
+						Port: 8443,
+					},
+					sslServers[1],
+				},
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.IPv4,
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"listen 8443 ssl default_server;":                             1,
+				"ssl_certificate /etc/nginx/secrets/default-keypair.pem;":     1,
+				"ssl_certificate_key /etc/nginx/secrets/default-keypair.pem;": 1,
+				"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":        1,
+				"ssl_reject_handshake on;":                                    0,
+				"return 404;":                                                 1,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -383,6 +412,37 @@ func TestExecuteServers_RewriteClientIP(t *testing.T) {
 				"listen [::]:8443 ssl;":                                    1,
 			},
 		},
+		{
+			msg: "rewrite client IP settings configured with trusted hops",
+			config: dataplane.Configuration{
+				HTTPServers: httpServers,
+				SSLServers:  sslServers,
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.Dual,
+					RewriteClientIPSettings: dataplane.RewriteClientIPSettings{
+						Mode:             dataplane.RewriteIPModeXForwardedFor,
+						TrustedAddresses: []string{"10.1.1.3/32"},
+						TrustedHops: []dataplane.TrustedProxyHop{
+							{
+								ProtoHeader: "CloudFront-Forwarded-Proto",
+								Addresses:   []string{"130.176.0.0/16"},
+							},
+							{
+								ProtoHeader: "X-Forwarded-Proto",
+								Addresses:   []string{"10.1.1.3/32", "10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			},
+			expectedHTTPConfig: map[string]int{
+				"set_real_ip_from 10.1.1.3/32;":    4,
+				"set_real_ip_from 130.176.0.0/16;": 4,
+				"set_real_ip_from 10.0.0.0/8;":     4,
+				"real_ip_header X-Forwarded-For;":  4,
+				"real_ip_recursive on;":            4,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -443,108 +503,63 @@ func TestExecuteServers_Plus(t *testing.T) {
 	}
 }
 
-func TestExecuteForDefaultServers(t *testing.T) {
+func TestExecuteServers_HTTPSRedirect(t *testing.T) {
 	t.Parallel()
-	testcases := []struct {
-		msg       string
-		httpPorts []int
-		sslPorts  []int
-		conf      dataplane.Configuration
-	}{
-		{
-			conf: dataplane.Configuration{},
-			msg:  "no default servers",
-		},
-		{
-			conf: dataplane.Configuration{
-				HTTPServers: []dataplane.VirtualServer{
-					{
-						IsDefault: true,
-						Port:      80,
-					},
-				},
+	config := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
 			},
-			httpPorts: []int{80},
-			msg:       "only HTTP default server",
-		},
-		{
-			conf: dataplane.Configuration{
-				SSLServers: []dataplane.VirtualServer{
-					{
-						IsDefault: true,
-						Port:      443,
-					},
+			{
+				Hostname: "example.com",
+				Port:     80,
+				HTTPSRedirect: &dataplane.HTTPSRedirect{
+					Port:       443,
+					StatusCode: 301,
 				},
 			},
-			sslPorts: []int{443},
-			msg:      "only HTTPS default server",
-		},
-		{
-			conf: dataplane.Configuration{
-				HTTPServers: []dataplane.VirtualServer{
-					{
-						IsDefault: true,
-						Port:      80,
-					},
-					{
-						IsDefault: true,
-						Port:      8080,
-					},
-				},
-				SSLServers: []dataplane.VirtualServer{
-					{
-						IsDefault: true,
-						Port:      443,
-					},
-					{
-						IsDefault: true,
-						Port:      8443,
-					},
+			{
+				Hostname: "example2.com",
+				Port:     80,
+				HTTPSRedirect: &dataplane.HTTPSRedirect{
+					Port:       8443,
+					StatusCode: 308,
 				},
 			},
-			httpPorts: []int{80, 8080},
-			sslPorts:  []int{443, 8443},
-			msg:       "multiple HTTP and HTTPS default servers",
 		},
 	}
 
-	sslDefaultFmt := "listen %d ssl default_server"
-	httpDefaultFmt := "listen %d default_server"
+	expectedHTTPConfig := map[string]int{
+		"server_name example.com;":                     1,
+		`return 301 "https://$host$request_uri";`:      1,
+		"server_name example2.com;":                    1,
+		`return 308 "https://$host:8443$request_uri";`: 1,
+		"location ": 0,
+		"include /etc/nginx/grpc-error-locations.conf;": 0,
+	}
 
-	for _, tc := range testcases {
-		t.Run(tc.msg, func(t *testing.T) {
-			t.Parallel()
-			g := NewWithT(t)
+	g := NewWithT(t)
 
-			gen := GeneratorImpl{}
-			serverResults := gen.executeServers(tc.conf, &policiesfakes.FakeGenerator{})
-			g.Expect(serverResults).To(HaveLen(2))
-			serverConf := string(serverResults[0].data)
-			httpMatchConf := string(serverResults[1].data)
-			g.Expect(httpMatchConf).To(Equal("{}"))
+	gen := GeneratorImpl{}
+	results := gen.executeServers(config, &policiesfakes.FakeGenerator{})
+	g.Expect(results).To(HaveLen(2))
 
-			for _, expPort := range tc.httpPorts {
-				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(httpDefaultFmt, expPort)))
-			}
+	serverConf := string(results[0].data)
+	httpMatchConf := string(results[1].data)
+	g.Expect(httpMatchConf).To(Equal("{}"))
 
-			for _, expPort := range tc.sslPorts {
-				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(sslDefaultFmt, expPort)))
-			}
-		})
+	for expSubStr, expCount := range expectedHTTPConfig {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount))
 	}
 }
 
-func TestCreateServers(t *testing.T) {
+func TestExecuteServers_FaultDelayLocation(t *testing.T) {
 	t.Parallel()
-	const (
-		sslKeyPairID = "test-keypair"
-	)
-
-	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
+	g := NewWithT(t)
 
 	fooGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 0,
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
 		Backends: []dataplane.Backend{
 			{
 				UpstreamName: "test_foo_80",
@@ -554,278 +569,512 @@ func TestCreateServers(t *testing.T) {
 		},
 	}
 
-	// barGroup has two backends, which should generate a proxy pass with a variable.
-	barGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 1,
-		Backends: []dataplane.Backend{
+	delayPolicy := &ngfAPI.FaultInjectionPolicy{
+		Spec: ngfAPI.FaultInjectionPolicySpec{
+			Delay: &ngfAPI.FaultDelay{Duration: "1s"},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				UpstreamName: "test_bar_80",
-				Valid:        true,
-				Weight:       50,
+				Hostname: "delay.example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{{BackendGroup: fooGroup}},
+						Policies:   []policies.Policy{delayPolicy},
+					},
+				},
+				Port: 80,
 			},
 			{
-				UpstreamName: "test_bar2_80",
-				Valid:        true,
-				Weight:       50,
+				Hostname: "cafe.example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{{BackendGroup: fooGroup}},
+					},
+				},
+				Port: 80,
 			},
 		},
 	}
 
-	// baz group has an invalid backend, which should generate a proxy pass to the invalid ref backend.
-	bazGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 2,
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+
+	delayLocation := "location = /_ngf-internal-fault-delay {\n        internal;\n        js_content faults.delay;\n    }"
+	g.Expect(strings.Count(serverConf, delayLocation)).To(Equal(1))
+}
+
+func TestExecuteServers_Hedging(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fooGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
 		Backends: []dataplane.Backend{
 			{
-				UpstreamName: "test_baz_80",
-				Valid:        false,
+				UpstreamName: "test_foo_80",
+				Valid:        true,
 				Weight:       1,
 			},
 		},
 	}
 
-	btpGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 3,
-		Backends: []dataplane.Backend{
+	hedgingPolicy := &ngfAPI.ProxySettingsPolicy{
+		Spec: ngfAPI.ProxySettingsPolicySpec{
+			Hedging: &ngfAPI.ProxyHedging{Delay: "1s"},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				UpstreamName: "test_btp_80",
-				Valid:        true,
-				Weight:       1,
-				VerifyTLS: &dataplane.VerifyTLS{
-					CertBundleID: "test-btp",
-					Hostname:     "test-btp.example.com",
+				Hostname: "hedging.example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: fooGroup,
+								Filters: dataplane.HTTPFilters{
+									ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+										Add: []dataplane.HTTPHeader{{Name: "X-Hedged", Value: "true"}},
+									},
+								},
+							},
+						},
+						Policies: []policies.Policy{hedgingPolicy},
+					},
 				},
+				Port: 80,
 			},
 		},
 	}
 
-	filterGroup1 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 3}
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+
+	g.Expect(serverConf).To(ContainSubstring("set $ngf_hedging_location /_ngf-internal-rule0-hedging;"))
+	g.Expect(serverConf).To(ContainSubstring("set $ngf_hedging_delay_ms 1000;"))
+	g.Expect(strings.Count(serverConf, "js_content hedging.hedge;")).To(Equal(1))
+	g.Expect(strings.Count(serverConf, "subrequest_output_buffer_size 1m;")).To(Equal(1))
+	g.Expect(strings.Count(serverConf, "proxy_pass http://test_foo_80$request_uri;")).To(Equal(1))
+	g.Expect(strings.Count(serverConf, `add_header X-Hedged "true" always;`)).To(Equal(2))
+}
 
-	filterGroup2 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 4}
+func TestExecuteServers_KubernetesContext(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
 
-	invalidFilterGroup := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 5}
+	route := &metav1.ObjectMeta{Namespace: "test", Name: "route1"}
 
-	cafePathRules := []dataplane.PathRule{
-		{
-			Path:     "/",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{
-						Method: helpers.GetPointer("POST"),
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "cafe.example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Source: route,
+								BackendGroup: dataplane.BackendGroup{
+									Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+									Backends: []dataplane.Backend{
+										{UpstreamName: "test_coffee_80", Valid: true, Weight: 1},
+									},
+								},
+							},
+						},
 					},
-					BackendGroup: fooGroup,
-				},
-				{
-					Match: dataplane.Match{
-						Method: helpers.GetPointer("PATCH"),
+					{
+						Path:     "/tea",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Source: route,
+								BackendGroup: dataplane.BackendGroup{
+									Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+									Backends: []dataplane.Backend{
+										{UpstreamName: "test_tea_80", Valid: true, Weight: 1},
+										{UpstreamName: "test_tea-v2_80", Valid: true, Weight: 1},
+									},
+									RuleIdx: 1,
+								},
+							},
+						},
 					},
-					BackendGroup: fooGroup,
-				},
-				{
-					// should generate an "any" httpmatch since other matches exists for /
-					Match:        dataplane.Match{},
-					BackendGroup: fooGroup,
 				},
+				Port: 80,
 			},
 		},
-		{
-			Path:     "/test",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					// A match with all possible fields set
-					Match: dataplane.Match{
-						Method: helpers.GetPointer("GET"),
-						Headers: []dataplane.HTTPHeaderMatch{
-							{
-								Name:  "Version",
-								Value: "V1",
-							},
-							{
-								Name:  "test",
-								Value: "foo",
-							},
-							{
-								Name:  "my-header",
-								Value: "my-value",
-							},
-						},
-						QueryParams: []dataplane.HTTPQueryParamMatch{
-							{
-								// query names and values should not be normalized to lowercase
-								Name:  "GrEat",
-								Value: "EXAMPLE",
-							},
-							{
-								Name:  "test",
-								Value: "foo=bar",
-							},
-						},
+	}
+
+	gen := GeneratorImpl{}
+
+	serverConf := string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+	g.Expect(serverConf).ToNot(ContainSubstring("$ngf_route_namespace"))
+
+	conf.Logging.KubernetesAccessLog = &dataplane.KubernetesAccessLog{
+		Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+	}
+
+	serverConf = string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+	g.Expect(strings.Count(serverConf, `set $ngf_route_namespace "test";`)).To(Equal(2))
+	g.Expect(strings.Count(serverConf, `set $ngf_route_name "route1";`)).To(Equal(2))
+	g.Expect(serverConf).To(ContainSubstring(`set $ngf_route_rule "0";`))
+	g.Expect(serverConf).To(ContainSubstring(`set $ngf_upstream "test_coffee_80";`))
+	g.Expect(serverConf).To(ContainSubstring(`set $ngf_route_rule "1";`))
+	g.Expect(serverConf).To(ContainSubstring(`set $ngf_upstream "$test__route1_rule1";`))
+}
+
+func TestExecuteServers_BotChallengeLocation(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fooGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	challengePolicy := &ngfAPI.BotMitigationPolicy{
+		Spec: ngfAPI.BotMitigationPolicySpec{
+			Action: helpers.GetPointer(ngfAPI.BotMitigationActionChallenge),
+		},
+	}
+
+	blockPolicy := &ngfAPI.BotMitigationPolicy{
+		Spec: ngfAPI.BotMitigationPolicySpec{
+			Action: helpers.GetPointer(ngfAPI.BotMitigationActionBlock),
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "challenge.example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{{BackendGroup: fooGroup}},
+						Policies:   []policies.Policy{challengePolicy},
 					},
-					BackendGroup: barGroup,
 				},
+				Port: 80,
 			},
-		},
-		{
-			Path:     "/path-only",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match:        dataplane.Match{},
-					BackendGroup: bazGroup,
+			{
+				Hostname: "block.example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{{BackendGroup: fooGroup}},
+						Policies:   []policies.Policy{blockPolicy},
+					},
 				},
+				Port: 80,
 			},
 		},
-		{
-			Path:     "/backend-tls-policy",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match:        dataplane.Match{},
-					BackendGroup: btpGroup,
-				},
+	}
+
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+
+	g.Expect(strings.Count(serverConf, "location = /_ngf-internal-bot-challenge {")).To(Equal(1))
+	g.Expect(serverConf).To(ContainSubstring(`document.cookie = "ngf_bot_challenge=passed; path=/; SameSite=Lax"`))
+}
+
+func TestExecuteServers_ConnectionLimits(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fooGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
 			},
 		},
-		{
-			Path:     "/redirect-implicit-port",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
-							Hostname: helpers.GetPointer("foo.example.com"),
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+			},
+			{
+				Hostname: "cafe.example.com",
+				PathRules: []dataplane.PathRule{
+					{
+						Path:       "/",
+						PathType:   dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{{BackendGroup: fooGroup}},
+					},
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{
+									Method: helpers.GetPointer("GET"),
+								},
+								BackendGroup: fooGroup,
+							},
 						},
 					},
-					BackendGroup: filterGroup1,
 				},
+				Port: 80,
 			},
 		},
+		ConnectionLimits: dataplane.ConnectionLimits{
+			DefaultServerMaxConnections: 10,
+			MaxRequestRate:              100,
+		},
+	}
+
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(conf, &policiesfakes.FakeGenerator{})[0].data)
+
+	g.Expect(strings.Count(serverConf, "limit_conn ngf_default_server_connections 10;")).To(Equal(1))
+	// the redirect locations of /coffee are not limited, so that their internal location can apply the limits
+	g.Expect(strings.Count(serverConf, "limit_req zone=ngf_max_request_rate burst=100 nodelay;")).To(Equal(2))
+}
+
+func TestExecuteForDefaultServers(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		msg        string
+		httpPorts  []int
+		sslPorts   []int
+		expStrings []string
+		conf       dataplane.Configuration
+	}{
+		{
+			conf: dataplane.Configuration{},
+			msg:  "no default servers",
+		},
 		{
-			Path:     "/redirect-explicit-port",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
-							Hostname: helpers.GetPointer("bar.example.com"),
-							Port:     helpers.GetPointer[int32](8080),
-						},
+			conf: dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
 					},
-					BackendGroup: filterGroup2,
 				},
 			},
+			httpPorts: []int{80},
+			msg:       "only HTTP default server",
 		},
 		{
-			Path:     "/redirect-with-headers",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{
-						Headers: []dataplane.HTTPHeaderMatch{
-							{
-								Name:  "redirect",
-								Value: "this",
-							},
-						},
-					},
-					Filters: dataplane.HTTPFilters{
-						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
-							Hostname: helpers.GetPointer("foo.example.com"),
-							Port:     helpers.GetPointer[int32](8080),
-						},
+			conf: dataplane.Configuration{
+				SSLServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      443,
 					},
-					BackendGroup: filterGroup1,
 				},
 			},
+			sslPorts: []int{443},
+			msg:      "only HTTPS default server",
 		},
 		{
-			Path:     "/rewrite",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
-							Hostname: helpers.GetPointer("new.example.com"),
-							Path: &dataplane.HTTPPathModifier{
-								Type:        dataplane.ReplaceFullPath,
-								Replacement: "/replacement",
-							},
-						},
+			conf: dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						IsDefault: true,
+						Port:      8080,
+					},
+				},
+				SSLServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      443,
+					},
+					{
+						IsDefault: true,
+						Port:      8443,
 					},
-					BackendGroup: fooGroup,
 				},
 			},
+			httpPorts: []int{80, 8080},
+			sslPorts:  []int{443, 8443},
+			msg:       "multiple HTTP and HTTPS default servers",
 		},
 		{
-			Path:     "/rewrite-with-headers",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{
-						Headers: []dataplane.HTTPHeaderMatch{
-							{
-								Name:  "rewrite",
-								Value: "this",
-							},
+			conf: dataplane.Configuration{
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+						DefaultResponse: &dataplane.DefaultServerResponse{
+							StatusCode: 301,
+							Body:       "https://example.com$request_uri",
 						},
 					},
-					Filters: dataplane.HTTPFilters{
-						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
-							Hostname: helpers.GetPointer("new.example.com"),
-							Path: &dataplane.HTTPPathModifier{
-								Type:        dataplane.ReplacePrefixMatch,
-								Replacement: "/prefix-replacement",
-							},
+					{
+						IsDefault: true,
+						Port:      8080,
+						DefaultResponse: &dataplane.DefaultServerResponse{
+							StatusCode:  503,
+							Body:        "unknown host $host",
+							ContentType: "text/plain",
 						},
 					},
-					BackendGroup: fooGroup,
 				},
 			},
-		},
-		{
-			Path:     "/invalid-filter",
-			PathType: dataplane.PathTypePrefix,
-			MatchRules: []dataplane.MatchRule{
-				{
-					Match: dataplane.Match{},
-					Filters: dataplane.HTTPFilters{
-						InvalidFilter: &dataplane.InvalidHTTPFilter{},
-					},
-					BackendGroup: invalidFilterGroup,
-				},
+			httpPorts: []int{80, 8080},
+			expStrings: []string{
+				`return 301 "https://example.com$request_uri";`,
+				`default_type "text/plain";`,
+				`return 503 "unknown host $host";`,
 			},
+			msg: "HTTP default servers with configured responses",
 		},
+	}
+
+	sslDefaultFmt := "listen %d ssl default_server"
+	httpDefaultFmt := "listen %d default_server"
+
+	for _, tc := range testcases {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{}
+			serverResults := gen.executeServers(tc.conf, &policiesfakes.FakeGenerator{})
+			g.Expect(serverResults).To(HaveLen(2))
+			serverConf := string(serverResults[0].data)
+			httpMatchConf := string(serverResults[1].data)
+			g.Expect(httpMatchConf).To(Equal("{}"))
+
+			for _, expPort := range tc.httpPorts {
+				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(httpDefaultFmt, expPort)))
+			}
+
+			for _, expPort := range tc.sslPorts {
+				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(sslDefaultFmt, expPort)))
+			}
+
+			for _, expString := range tc.expStrings {
+				g.Expect(serverConf).To(ContainSubstring(expString))
+			}
+		})
+	}
+}
+
+func TestCreateServers(t *testing.T) {
+	t.Parallel()
+	const (
+		sslKeyPairID = "test-keypair"
+	)
+
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
+
+	fooGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	// barGroup has two backends, which should generate a proxy pass with a variable.
+	barGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 1,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_bar_80",
+				Valid:        true,
+				Weight:       50,
+			},
+			{
+				UpstreamName: "test_bar2_80",
+				Valid:        true,
+				Weight:       50,
+			},
+		},
+	}
+
+	// baz group has an invalid backend, which should generate a proxy pass to the invalid ref backend.
+	bazGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 2,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_baz_80",
+				Valid:        false,
+				Weight:       1,
+			},
+		},
+	}
+
+	btpGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 3,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_btp_80",
+				Valid:        true,
+				Weight:       1,
+				VerifyTLS: &dataplane.VerifyTLS{
+					CertBundleID: "test-btp",
+					Hostname:     "test-btp.example.com",
+				},
+			},
+		},
+	}
+
+	filterGroup1 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 3}
+
+	filterGroup2 := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 4}
+
+	invalidFilterGroup := dataplane.BackendGroup{Source: hrNsName, RuleIdx: 5}
+
+	cafePathRules := []dataplane.PathRule{
 		{
-			Path:     "/invalid-filter-with-headers",
+			Path:     "/",
 			PathType: dataplane.PathTypePrefix,
 			MatchRules: []dataplane.MatchRule{
 				{
 					Match: dataplane.Match{
-						Headers: []dataplane.HTTPHeaderMatch{
-							{
-								Name:  "filter",
-								Value: "this",
-							},
-						},
+						Method: helpers.GetPointer("POST"),
 					},
-					Filters: dataplane.HTTPFilters{
-						InvalidFilter: &dataplane.InvalidHTTPFilter{},
+					BackendGroup: fooGroup,
+				},
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("PATCH"),
 					},
-					BackendGroup: invalidFilterGroup,
+					BackendGroup: fooGroup,
 				},
-			},
-		},
-		{
-			Path:     "/exact",
-			PathType: dataplane.PathTypeExact,
-			MatchRules: []dataplane.MatchRule{
 				{
+					// should generate an "any" httpmatch since other matches exists for /
 					Match:        dataplane.Match{},
 					BackendGroup: fooGroup,
 				},
@@ -833,109 +1082,309 @@ func TestCreateServers(t *testing.T) {
 		},
 		{
 			Path:     "/test",
-			PathType: dataplane.PathTypeExact,
+			PathType: dataplane.PathTypePrefix,
 			MatchRules: []dataplane.MatchRule{
 				{
+					// A match with all possible fields set
 					Match: dataplane.Match{
 						Method: helpers.GetPointer("GET"),
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "Version",
+								Value: "V1",
+							},
+							{
+								Name:  "test",
+								Value: "foo",
+							},
+							{
+								Name:  "my-header",
+								Value: "my-value",
+							},
+						},
+						QueryParams: []dataplane.HTTPQueryParamMatch{
+							{
+								// query names and values should not be normalized to lowercase
+								Name:  "GrEat",
+								Value: "EXAMPLE",
+							},
+							{
+								Name:  "test",
+								Value: "foo=bar",
+							},
+						},
 					},
-					BackendGroup: fooGroup,
+					BackendGroup: barGroup,
 				},
 			},
 		},
 		{
-			Path:     "/proxy-set-headers",
+			Path:     "/path-only",
 			PathType: dataplane.PathTypePrefix,
 			MatchRules: []dataplane.MatchRule{
 				{
 					Match:        dataplane.Match{},
-					BackendGroup: fooGroup,
-					Filters: dataplane.HTTPFilters{
-						RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
-							Add: []dataplane.HTTPHeader{
-								{
-									Name:  "my-header",
-									Value: "some-value-123",
-								},
-							},
-						},
-						ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
-							Add: []dataplane.HTTPHeader{
-								{
-									Name:  "my-header-response",
-									Value: "some-value-response-123",
-								},
-							},
-						},
-					},
+					BackendGroup: bazGroup,
 				},
 			},
 		},
 		{
-			Path:     "/grpc/method",
-			PathType: dataplane.PathTypeExact,
+			Path:     "/backend-tls-policy",
+			PathType: dataplane.PathTypePrefix,
 			MatchRules: []dataplane.MatchRule{
 				{
 					Match:        dataplane.Match{},
-					BackendGroup: fooGroup,
+					BackendGroup: btpGroup,
 				},
 			},
-			GRPC: true,
 		},
 		{
-			Path:     "/grpc-with-backend-tls-policy/method",
-			PathType: dataplane.PathTypeExact,
+			Path:     "/redirect-implicit-port",
+			PathType: dataplane.PathTypePrefix,
 			MatchRules: []dataplane.MatchRule{
 				{
-					Match:        dataplane.Match{},
-					BackendGroup: btpGroup,
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
+							Hostname: helpers.GetPointer("foo.example.com"),
+						},
+					},
+					BackendGroup: filterGroup1,
 				},
 			},
-			GRPC: true,
 		},
 		{
-			Path:     "/include-path-only-match",
-			PathType: dataplane.PathTypeExact,
-			Policies: []policies.Policy{
-				&policiesfakes.FakePolicy{},
-			},
+			Path:     "/redirect-explicit-port",
+			PathType: dataplane.PathTypePrefix,
 			MatchRules: []dataplane.MatchRule{
 				{
-					Match:        dataplane.Match{},
-					BackendGroup: fooGroup,
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
+							Hostname: helpers.GetPointer("bar.example.com"),
+							Port:     helpers.GetPointer[int32](8080),
+						},
+					},
+					BackendGroup: filterGroup2,
 				},
 			},
 		},
 		{
-			Path:     "/include-header-match",
-			PathType: dataplane.PathTypeExact,
-			Policies: []policies.Policy{
-				&policiesfakes.FakePolicy{},
-			},
+			Path:     "/redirect-with-headers",
+			PathType: dataplane.PathTypePrefix,
 			MatchRules: []dataplane.MatchRule{
 				{
 					Match: dataplane.Match{
-						Method: helpers.GetPointer("GET"),
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "redirect",
+								Value: "this",
+							},
+						},
 					},
-					BackendGroup: fooGroup,
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{
+							Hostname: helpers.GetPointer("foo.example.com"),
+							Port:     helpers.GetPointer[int32](8080),
+						},
+					},
+					BackendGroup: filterGroup1,
 				},
 			},
 		},
-	}
-
-	conf := dataplane.Configuration{
-		HTTPServers: []dataplane.VirtualServer{
-			{
-				IsDefault: true,
-				Port:      8080,
-			},
-			{
-				Hostname:  "cafe.example.com",
-				PathRules: cafePathRules,
-				Port:      8080,
-				Policies: []policies.Policy{
-					&policiesfakes.FakePolicy{},
-					&policiesfakes.FakePolicy{},
+		{
+			Path:     "/rewrite",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
+							Hostname: helpers.GetPointer("new.example.com"),
+							Path: &dataplane.HTTPPathModifier{
+								Type:        dataplane.ReplaceFullPath,
+								Replacement: "/replacement",
+							},
+						},
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/rewrite-with-headers",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "rewrite",
+								Value: "this",
+							},
+						},
+					},
+					Filters: dataplane.HTTPFilters{
+						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
+							Hostname: helpers.GetPointer("new.example.com"),
+							Path: &dataplane.HTTPPathModifier{
+								Type:        dataplane.ReplacePrefixMatch,
+								Replacement: "/prefix-replacement",
+							},
+						},
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/invalid-filter",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{},
+					Filters: dataplane.HTTPFilters{
+						InvalidFilter: &dataplane.InvalidHTTPFilter{},
+					},
+					BackendGroup: invalidFilterGroup,
+				},
+			},
+		},
+		{
+			Path:     "/invalid-filter-with-headers",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Headers: []dataplane.HTTPHeaderMatch{
+							{
+								Name:  "filter",
+								Value: "this",
+							},
+						},
+					},
+					Filters: dataplane.HTTPFilters{
+						InvalidFilter: &dataplane.InvalidHTTPFilter{},
+					},
+					BackendGroup: invalidFilterGroup,
+				},
+			},
+		},
+		{
+			Path:     "/exact",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/test",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("GET"),
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/proxy-set-headers",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+					Filters: dataplane.HTTPFilters{
+						RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+							Add: []dataplane.HTTPHeader{
+								{
+									Name:  "my-header",
+									Value: "some-value-123",
+								},
+							},
+						},
+						ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+							Add: []dataplane.HTTPHeader{
+								{
+									Name:  "my-header-response",
+									Value: "some-value-response-123",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Path:     "/grpc/method",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+				},
+			},
+			GRPC: true,
+		},
+		{
+			Path:     "/grpc-with-backend-tls-policy/method",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: btpGroup,
+				},
+			},
+			GRPC: true,
+		},
+		{
+			Path:     "/include-path-only-match",
+			PathType: dataplane.PathTypeExact,
+			Policies: []policies.Policy{
+				&policiesfakes.FakePolicy{},
+			},
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+		{
+			Path:     "/include-header-match",
+			PathType: dataplane.PathTypeExact,
+			Policies: []policies.Policy{
+				&policiesfakes.FakePolicy{},
+			},
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("GET"),
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8080,
+			},
+			{
+				Hostname:  "cafe.example.com",
+				PathRules: cafePathRules,
+				Port:      8080,
+				Policies: []policies.Policy{
+					&policiesfakes.FakePolicy{},
+					&policiesfakes.FakePolicy{},
 				},
 			},
 		},
@@ -1046,7 +1495,7 @@ func TestCreateServers(t *testing.T) {
 		},
 		{
 			Name:  "X-Forwarded-Port",
-			Value: "$server_port",
+			Value: "$ngf_gateway_port",
 		},
 	}
 
@@ -1329,7 +1778,7 @@ func TestCreateServers(t *testing.T) {
 					},
 					{
 						Name:  "X-Forwarded-Port",
-						Value: "$server_port",
+						Value: "$ngf_gateway_port",
 					},
 				},
 				ResponseHeaders: http.ResponseHeaders{
@@ -1383,7 +1832,7 @@ func TestCreateServers(t *testing.T) {
 					},
 					{
 						Name:  "X-Forwarded-Port",
-						Value: "$server_port",
+						Value: "$ngf_gateway_port",
 					},
 				},
 				ResponseHeaders: http.ResponseHeaders{
@@ -1490,7 +1939,7 @@ func TestCreateServers(t *testing.T) {
 		},
 	})
 
-	result, httpMatchPair := createServers(conf, fakeGenerator)
+	result, httpMatchPair := createServers(conf, fakeGenerator, newWorkerPool(4), 0)
 
 	g.Expect(httpMatchPair).To(Equal(allExpMatchPair))
 	g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
@@ -1513,369 +1962,1036 @@ func TestCreateServersConflicts(t *testing.T) {
 		RuleIdx: 0,
 		Backends: []dataplane.Backend{
 			{
-				UpstreamName: "test_foo_80",
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+	barGroup := dataplane.BackendGroup{
+		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_bar_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+	bazGroup := dataplane.BackendGroup{
+		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_baz_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		rules   []dataplane.PathRule
+		expLocs []http.Location
+	}{
+		{
+			name: "/coffee prefix, /coffee exact",
+			rules: []dataplane.PathRule{
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypeExact,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: barGroup,
+						},
+					},
+				},
+			},
+			expLocs: []http.Location{
+				{
+					Path:            "/coffee/",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "= /coffee",
+					ProxyPass:       "http://test_bar_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				createDefaultRootLocation(nil),
+			},
+		},
+		{
+			name: "/coffee prefix, /coffee/ prefix",
+			rules: []dataplane.PathRule{
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee/",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: barGroup,
+						},
+					},
+				},
+			},
+			expLocs: []http.Location{
+				{
+					Path:            "= /coffee",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "/coffee/",
+					ProxyPass:       "http://test_bar_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				createDefaultRootLocation(nil),
+			},
+		},
+		{
+			name: "/coffee prefix, /coffee/ prefix, /coffee exact",
+			rules: []dataplane.PathRule{
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: fooGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee/",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: barGroup,
+						},
+					},
+				},
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypeExact,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Match:        dataplane.Match{},
+							BackendGroup: bazGroup,
+						},
+					},
+				},
+			},
+			expLocs: []http.Location{
+				{
+					Path:            "/coffee/",
+					ProxyPass:       "http://test_bar_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "= /coffee",
+					ProxyPass:       "http://test_baz_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				createDefaultRootLocation(nil),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			httpServers := []dataplane.VirtualServer{
+				{
+					IsDefault: true,
+					Port:      8080,
+				},
+				{
+					Hostname:  "cafe.example.com",
+					PathRules: test.rules,
+					Port:      8080,
+				},
+			}
+			expectedServers := []http.Server{
+				{
+					IsDefaultHTTP: true,
+					Listen:        "8080",
+				},
+				{
+					ServerName: "cafe.example.com",
+					Locations:  test.expLocs,
+					Listen:     "8080",
+				},
+			}
+
+			g := NewWithT(t)
+
+			result, _ := createServers(
+				dataplane.Configuration{HTTPServers: httpServers},
+				&policiesfakes.FakeGenerator{},
+				newWorkerPool(4),
+				0,
+			)
+			g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
+		})
+	}
+}
+
+func TestCreateLocationsRootPath(t *testing.T) {
+	t.Parallel()
+	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
+
+	fooGroup := dataplane.BackendGroup{
+		Source:  hrNsName,
+		RuleIdx: 0,
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	getPathRules := func(rootPath bool, grpc bool) []dataplane.PathRule {
+		rules := []dataplane.PathRule{
+			{
+				Path: "/path-1",
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						BackendGroup: fooGroup,
+					},
+				},
+			},
+			{
+				Path: "/path-2",
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						BackendGroup: fooGroup,
+					},
+				},
+			},
+		}
+
+		if rootPath {
+			rules = append(rules, dataplane.PathRule{
+				Path: "/",
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						BackendGroup: fooGroup,
+					},
+				},
+			})
+		}
+
+		if grpc {
+			rules = append(rules, dataplane.PathRule{
+				Path: "/grpc",
+				GRPC: true,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						BackendGroup: fooGroup,
+					},
+				},
+			})
+		}
+
+		return rules
+	}
+
+	tests := []struct {
+		name         string
+		pathRules    []dataplane.PathRule
+		expLocations []http.Location
+		grpc         bool
+	}{
+		{
+			name:      "path rules with no root path should generate a default 404 root location",
+			pathRules: getPathRules(false /* rootPath */, false /* grpc */),
+			expLocations: []http.Location{
+				{
+					Path:            "/path-1",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "/path-2",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path: "/",
+					Return: &http.Return{
+						Code: http.StatusNotFound,
+					},
+				},
+			},
+		},
+		{
+			name:      "path rules with grpc & with no root path should generate a default 404 root location and GRPC true",
+			pathRules: getPathRules(false /* rootPath */, true /* grpc */),
+			grpc:      true,
+			expLocations: []http.Location{
+				{
+					Path:            "/path-1",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "/path-2",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "/grpc",
+					ProxyPass:       "grpc://test_foo_80",
+					GRPC:            true,
+					ProxySetHeaders: grpcBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path: "/",
+					Return: &http.Return{
+						Code: http.StatusNotFound,
+					},
+				},
+			},
+		},
+		{
+			name:      "path rules with a root path should not generate a default 404 root path",
+			pathRules: getPathRules(true /* rootPath */, false /* grpc */),
+			expLocations: []http.Location{
+				{
+					Path:            "/path-1",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "/path-2",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+				{
+					Path:            "/",
+					ProxyPass:       "http://test_foo_80$request_uri",
+					ProxySetHeaders: httpBaseHeaders,
+					Type:            http.ExternalLocationType,
+				},
+			},
+		},
+		{
+			name:      "nil path rules should generate a default 404 root path",
+			pathRules: nil,
+			expLocations: []http.Location{
+				{
+					Path: "/",
+					Return: &http.Return{
+						Code: http.StatusNotFound,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			locs, httpMatchPair, grpc := createLocations(&dataplane.VirtualServer{
+				PathRules: test.pathRules,
+				Port:      80,
+			}, "1", &policiesfakes.FakeGenerator{}, workerPool{}, nil, false, false)
+			g.Expect(locs).To(Equal(test.expLocations))
+			g.Expect(httpMatchPair).To(BeEmpty())
+			g.Expect(grpc).To(Equal(test.grpc))
+		})
+	}
+}
+
+func TestCreateLocationsRegularExpression(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fooGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypeRegularExpression,
+			MatchRules: []dataplane.MatchRule{
+				{BackendGroup: fooGroup},
+			},
+		},
+		{
+			Path:     `/v[0-9]{1,2}/\w+/"quoted"`,
+			PathType: dataplane.PathTypeRegularExpression,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match: dataplane.Match{
+						Method: helpers.GetPointer("GET"),
+					},
+					BackendGroup: fooGroup,
+				},
+			},
+		},
+	}
+
+	server := &dataplane.VirtualServer{PathRules: pathRules, Port: 80}
+
+	locs, _, _ := createLocations(server, "1", &policiesfakes.FakeGenerator{}, workerPool{}, nil, false, false)
+
+	paths := make([]string, 0, len(locs))
+	for _, loc := range locs {
+		paths = append(paths, loc.Path)
+	}
+
+	// a regular expression path rule for "/" doesn't match all requests, so the default root location is still added
+	g.Expect(paths).To(Equal([]string{
+		`~ "/"`,
+		`~ "/v[0-9]{1,2}/\\w+/\"quoted\""`,
+		"/_ngf-internal-rule1-route0",
+		"/",
+	}))
+
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(
+		dataplane.Configuration{HTTPServers: []dataplane.VirtualServer{*server}},
+		&policiesfakes.FakeGenerator{},
+	)[0].data)
+
+	g.Expect(serverConf).To(ContainSubstring(`location ~ "/v[0-9]{1,2}/\\w+/\"quoted\"" {`))
+	g.Expect(serverConf).To(ContainSubstring("location ^~ /_ngf-internal-rule1-route0 {"))
+}
+
+func TestCreateLocationsCaseInsensitive(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fooGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/coffee",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					BackendGroup: fooGroup,
+					Filters: dataplane.HTTPFilters{
+						RequestURLRewrite: &dataplane.HTTPURLRewriteFilter{
+							Path: &dataplane.HTTPPathModifier{
+								Type:        dataplane.ReplacePrefixMatch,
+								Replacement: "/beans",
+							},
+						},
+					},
+				},
+			},
+			CaseInsensitive: true,
+		},
+		{
+			Path:     "/tea/[a-z]+",
+			PathType: dataplane.PathTypeRegularExpression,
+			MatchRules: []dataplane.MatchRule{
+				{BackendGroup: fooGroup},
+			},
+			CaseInsensitive: true,
+		},
+	}
+
+	server := &dataplane.VirtualServer{
+		Hostname:             "cafe.example.com",
+		PathRules:            pathRules,
+		Port:                 80,
+		CaseInsensitivePaths: true,
+	}
+
+	locs, _, _ := createLocations(server, "1", &policiesfakes.FakeGenerator{}, workerPool{}, nil, false, false)
+
+	g.Expect(locs).To(HaveLen(4))
+	// the exact location comes first, and the prefix location comes after the regular expression location
+	g.Expect(locs[0].Path).To(Equal(`~* "^/coffee$"`))
+	g.Expect(locs[1].Path).To(Equal(`~* "/tea/[a-z]+"`))
+	g.Expect(locs[2].Path).To(Equal(`~* "^/coffee/"`))
+	g.Expect(locs[3].Path).To(Equal("/"))
+
+	// the rewrites of the external locations start from the URI of the request in its original case
+	for _, loc := range []http.Location{locs[0], locs[2]} {
+		g.Expect(loc.Rewrites).To(Equal([]string{"^(?i)/coffee(.*)$ /beans$1 break"}))
+	}
+
+	g.Expect(locs[1].Rewrites).To(BeEmpty())
+	g.Expect(locs[1].ProxyPass).To(Equal("http://test_foo_80$request_uri"))
+
+	gen := GeneratorImpl{}
+	serverConf := string(gen.executeServers(
+		dataplane.Configuration{HTTPServers: []dataplane.VirtualServer{*server}},
+		&policiesfakes.FakeGenerator{},
+	)[0].data)
+
+	// the URI is not rewritten before the locations are matched, so the backends receive the original path
+	g.Expect(serverConf).ToNot(MatchRegexp(`(?m)^    rewrite `))
+	g.Expect(serverConf).To(ContainSubstring(`location ~* "^/coffee/" {`))
+	g.Expect(serverConf).To(ContainSubstring(`location ~* "/tea/[a-z]+" {`))
+}
+
+func TestCaseInsensitiveLocations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		locs     []http.Location
+		expected []http.Location
+	}{
+		{
+			name: "exact locations come first",
+			locs: []http.Location{
+				{Path: "/tea/"},
+				{Path: "= /tea"},
+				{Path: "= /coffee.latte"},
+			},
+			expected: []http.Location{
+				{Path: `~* "^/tea$"`},
+				{Path: `~* "^/coffee\\.latte$"`},
+				{Path: `~* "^/tea/"`},
+			},
+		},
+		{
+			name: "regular expression locations come after the exact locations and before the prefix locations",
+			locs: []http.Location{
+				{Path: "/tea/"},
+				{Path: `~* "/coffee/[a-z]+"`},
+				{Path: "= /tea"},
+				{Path: `~ "/tea/[0-9]+"`},
+			},
+			expected: []http.Location{
+				{Path: `~* "^/tea$"`},
+				{Path: `~* "/coffee/[a-z]+"`},
+				{Path: `~ "/tea/[0-9]+"`},
+				{Path: `~* "^/tea/"`},
+			},
+		},
+		{
+			name: "prefix locations are ordered from the longest to the shortest",
+			locs: []http.Location{
+				{Path: "/tea/"},
+				{Path: "/coffee/"},
+				{Path: "/tea/green/"},
+				{Path: "/tea"},
+			},
+			expected: []http.Location{
+				{Path: `~* "^/tea/green/"`},
+				{Path: `~* "^/coffee/"`},
+				{Path: `~* "^/tea/"`},
+				{Path: `~* "^/tea"`},
+			},
+		},
+		{
+			name: "root, internal and ACME challenge locations come last",
+			locs: []http.Location{
+				{Path: "/"},
+				{Path: "/_ngf-internal-rule0-route0", Type: http.InternalLocationType},
+				{Path: "^~ /.well-known/acme-challenge/"},
+				{Path: "/tea/"},
+			},
+			expected: []http.Location{
+				{Path: `~* "^/tea/"`},
+				{Path: "/"},
+				{Path: "/_ngf-internal-rule0-route0", Type: http.InternalLocationType},
+				{Path: "^~ /.well-known/acme-challenge/"},
+			},
+		},
+		{
+			name: "all kinds of locations",
+			locs: []http.Location{
+				{Path: "/tea/"},
+				{Path: "= /tea"},
+				{Path: "/tea/green.tea/"},
+				{Path: `~* "/coffee/[a-z]+"`},
+				{Path: "/_ngf-internal-rule0-route0", Type: http.InternalLocationType},
+				{Path: "= /tea/green.tea"},
+				{Path: "^~ /.well-known/acme-challenge/"},
+				{Path: "/"},
+			},
+			expected: []http.Location{
+				{Path: `~* "^/tea$"`},
+				{Path: `~* "^/tea/green\\.tea$"`},
+				{Path: `~* "/coffee/[a-z]+"`},
+				{Path: `~* "^/tea/green\\.tea/"`},
+				{Path: `~* "^/tea/"`},
+				{Path: "/_ngf-internal-rule0-route0", Type: http.InternalLocationType},
+				{Path: "^~ /.well-known/acme-challenge/"},
+				{Path: "/"},
+			},
+		},
+		{
+			name:     "no locations",
+			expected: []http.Location{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(caseInsensitiveLocations(test.locs)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateLocationsACMEChallenge(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	solverGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "solver"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_solver_8089",
 				Valid:        true,
 				Weight:       1,
 			},
 		},
 	}
-	barGroup := dataplane.BackendGroup{
-		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
+
+	server := &dataplane.VirtualServer{
+		Hostname: "cafe.example.com",
+		PathRules: []dataplane.PathRule{
 			{
-				UpstreamName: "test_bar_80",
-				Valid:        true,
-				Weight:       1,
+				Path:          "/.well-known/acme-challenge",
+				PathType:      dataplane.PathTypePrefix,
+				MatchRules:    []dataplane.MatchRule{{BackendGroup: solverGroup}},
+				ACMEChallenge: true,
 			},
-		},
-	}
-	bazGroup := dataplane.BackendGroup{
-		Source:  types.NamespacedName{Namespace: "test", Name: "route"},
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
 			{
-				UpstreamName: "test_baz_80",
-				Valid:        true,
-				Weight:       1,
+				Path:          "/.well-known/acme-challenge/token",
+				PathType:      dataplane.PathTypeExact,
+				MatchRules:    []dataplane.MatchRule{{BackendGroup: solverGroup}},
+				ACMEChallenge: true,
 			},
 		},
+		Policies: []policies.Policy{
+			&ngfAPI.WAFPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "waf"}},
+		},
+		HTTPSRedirect: &dataplane.HTTPSRedirect{
+			Port:       443,
+			StatusCode: 301,
+		},
+		Port: 80,
 	}
 
-	tests := []struct {
-		name    string
-		rules   []dataplane.PathRule
-		expLocs []http.Location
-	}{
+	locs, _, _ := createLocations(server, "1", &policiesfakes.FakeGenerator{}, workerPool{}, nil, false, false)
+
+	g.Expect(locs).To(HaveLen(4))
+
+	// the prefix locations take precedence over the regular expression locations
+	g.Expect(locs[0].Path).To(Equal("^~ /.well-known/acme-challenge/"))
+	g.Expect(locs[1].Path).To(Equal("= /.well-known/acme-challenge"))
+	g.Expect(locs[2].Path).To(Equal("= /.well-known/acme-challenge/token"))
+
+	// the locations are not protected by the WAF policy of the server
+	for _, loc := range locs[:3] {
+		g.Expect(loc.Includes).To(ConsistOf(http.Include{
+			Name:    includesFolder + "/WAFPolicy_disabled.conf",
+			Content: []byte("app_protect_enable off;\n"),
+		}))
+	}
+
+	// the other requests are redirected to HTTPS
+	g.Expect(locs[3]).To(Equal(http.Location{
+		Path: "/",
+		Return: &http.Return{
+			Code: 301,
+			Body: "https://$host$request_uri",
+		},
+	}))
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(
+		dataplane.Configuration{HTTPServers: []dataplane.VirtualServer{*server}},
+		&policiesfakes.FakeGenerator{},
+	)
+	g.Expect(results).To(HaveLen(3))
+
+	// the include file of the WAF comes before the server config
+	serverConf := string(results[1].data)
+
+	g.Expect(serverConf).To(ContainSubstring("location ^~ /.well-known/acme-challenge/ {"))
+	g.Expect(serverConf).To(ContainSubstring(`return 301 "https://$host$request_uri";`))
+}
+
+func TestCreateLocationsRoute(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pathRules := []dataplane.PathRule{
 		{
-			name: "/coffee prefix, /coffee exact",
-			rules: []dataplane.PathRule{
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: fooGroup,
-						},
-					},
-				},
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypeExact,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: barGroup,
-						},
-					},
-				},
-			},
-			expLocs: []http.Location{
-				{
-					Path:            "/coffee/",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
+			Path:     "/path-only",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
 				{
-					Path:            "= /coffee",
-					ProxyPass:       "http://test_bar_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
+					Source: &metav1.ObjectMeta{Namespace: "test", Name: "route1"},
 				},
-				createDefaultRootLocation(),
 			},
 		},
 		{
-			name: "/coffee prefix, /coffee/ prefix",
-			rules: []dataplane.PathRule{
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: fooGroup,
-						},
-					},
-				},
-				{
-					Path:     "/coffee/",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: barGroup,
-						},
-					},
-				},
-			},
-			expLocs: []http.Location{
+			Path:     "/match",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
 				{
-					Path:            "= /coffee",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
+					Source: &metav1.ObjectMeta{Namespace: "test", Name: "route2"},
+					Match:  dataplane.Match{Method: helpers.GetPointer("GET")},
 				},
 				{
-					Path:            "/coffee/",
-					ProxyPass:       "http://test_bar_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
+					Source: &metav1.ObjectMeta{Namespace: "test", Name: "route3"},
+					Match:  dataplane.Match{Method: helpers.GetPointer("POST")},
 				},
-				createDefaultRootLocation(),
 			},
 		},
+	}
+
+	fakeGenerator := &policiesfakes.FakeGenerator{}
+
+	locs, _, _ := createLocations(
+		&dataplane.VirtualServer{PathRules: pathRules, Port: 80},
+		"1",
+		fakeGenerator,
+		workerPool{},
+		nil,
+		false,
+		false,
+	)
+
+	routes := make(map[string]string, len(locs))
+	for _, loc := range locs {
+		routes[loc.Path] = loc.Route
+	}
+
+	g.Expect(routes).To(Equal(map[string]string{
+		"= /path-only":                "test/route1",
+		"= /match":                    "",
+		"/_ngf-internal-rule1-route0": "test/route2",
+		"/_ngf-internal-rule1-route1": "test/route3",
+		"/":                           "",
+	}))
+
+	g.Expect(fakeGenerator.GenerateForLocationCallCount()).To(Equal(2))
+	_, extLoc := fakeGenerator.GenerateForLocationArgsForCall(0)
+	g.Expect(extLoc.Route).To(Equal("test/route1"))
+
+	g.Expect(fakeGenerator.GenerateForInternalLocationCallCount()).To(Equal(2))
+	_, intLoc := fakeGenerator.GenerateForInternalLocationArgsForCall(1)
+	g.Expect(intLoc.Route).To(Equal("test/route3"))
+}
+
+func TestCreateLocationsNoEndpoints(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	emptyGroup := dataplane.BackendGroup{
+		Backends: []dataplane.Backend{{UpstreamName: "empty", Valid: true, Weight: 1}},
+	}
+	fooGroup := dataplane.BackendGroup{
+		Backends: []dataplane.Backend{{UpstreamName: "foo", Valid: true, Weight: 1}},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:       "/path-only",
+			PathType:   dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{{BackendGroup: emptyGroup}},
+		},
 		{
-			name: "/coffee prefix, /coffee/ prefix, /coffee exact",
-			rules: []dataplane.PathRule{
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: fooGroup,
-						},
-					},
-				},
-				{
-					Path:     "/coffee/",
-					PathType: dataplane.PathTypePrefix,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: barGroup,
-						},
-					},
-				},
-				{
-					Path:     "/coffee",
-					PathType: dataplane.PathTypeExact,
-					MatchRules: []dataplane.MatchRule{
-						{
-							Match:        dataplane.Match{},
-							BackendGroup: bazGroup,
-						},
-					},
-				},
-			},
-			expLocs: []http.Location{
+			Path:     "/match",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
 				{
-					Path:            "/coffee/",
-					ProxyPass:       "http://test_bar_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
+					Match:        dataplane.Match{Method: helpers.GetPointer("GET")},
+					BackendGroup: emptyGroup,
 				},
 				{
-					Path:            "= /coffee",
-					ProxyPass:       "http://test_baz_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
+					Match:        dataplane.Match{Method: helpers.GetPointer("POST")},
+					BackendGroup: fooGroup,
 				},
-				createDefaultRootLocation(),
 			},
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-			httpServers := []dataplane.VirtualServer{
-				{
-					IsDefault: true,
-					Port:      8080,
-				},
-				{
-					Hostname:  "cafe.example.com",
-					PathRules: test.rules,
-					Port:      8080,
-				},
-			}
-			expectedServers := []http.Server{
-				{
-					IsDefaultHTTP: true,
-					Listen:        "8080",
-				},
-				{
-					ServerName: "cafe.example.com",
-					Locations:  test.expLocs,
-					Listen:     "8080",
-				},
-			}
+	noEndpoints := getUpstreamsWithoutEndpoints([]dataplane.Upstream{
+		{Name: "empty"},
+		{Name: "foo", Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}}},
+	})
 
-			g := NewWithT(t)
+	fakeGenerator := &policiesfakes.FakeGenerator{}
 
-			result, _ := createServers(dataplane.Configuration{HTTPServers: httpServers}, &policiesfakes.FakeGenerator{})
-			g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
-		})
+	locs, _, _ := createLocations(
+		&dataplane.VirtualServer{PathRules: pathRules, Port: 80},
+		"1",
+		fakeGenerator,
+		workerPool{},
+		noEndpoints,
+		false,
+		false,
+	)
+
+	locsNoEndpoints := make(map[string]bool, len(locs))
+	for _, loc := range locs {
+		locsNoEndpoints[loc.Path] = loc.NoEndpoints
 	}
+
+	g.Expect(locsNoEndpoints).To(Equal(map[string]bool{
+		"= /path-only":                true,
+		"= /match":                    false,
+		"/_ngf-internal-rule1-route0": true,
+		"/_ngf-internal-rule1-route1": false,
+		"/":                           false,
+	}))
+
+	_, extLoc := fakeGenerator.GenerateForLocationArgsForCall(0)
+	g.Expect(extLoc.NoEndpoints).To(BeTrue())
+
+	_, intLoc := fakeGenerator.GenerateForInternalLocationArgsForCall(0)
+	g.Expect(intLoc.NoEndpoints).To(BeTrue())
 }
 
-func TestCreateLocationsRootPath(t *testing.T) {
+func TestCreateLocationsHedging(t *testing.T) {
 	t.Parallel()
-	hrNsName := types.NamespacedName{Namespace: "test", Name: "route1"}
+	g := NewWithT(t)
 
 	fooGroup := dataplane.BackendGroup{
-		Source:  hrNsName,
-		RuleIdx: 0,
-		Backends: []dataplane.Backend{
-			{
-				UpstreamName: "test_foo_80",
-				Valid:        true,
-				Weight:       1,
-			},
+		Backends: []dataplane.Backend{{UpstreamName: "foo", Valid: true, Weight: 1}},
+	}
+
+	hedgingPolicy := &ngfAPI.ProxySettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "hedging", Namespace: "test"},
+		Spec: ngfAPI.ProxySettingsPolicySpec{
+			Hedging: &ngfAPI.ProxyHedging{Delay: "250ms"},
 		},
 	}
 
-	getPathRules := func(rootPath bool, grpc bool) []dataplane.PathRule {
-		rules := []dataplane.PathRule{
-			{
-				Path: "/path-1",
-				MatchRules: []dataplane.MatchRule{
-					{
-						Match:        dataplane.Match{},
-						BackendGroup: fooGroup,
-					},
+	pathRules := []dataplane.PathRule{
+		{
+			Path:       "/path-only",
+			PathType:   dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{{BackendGroup: fooGroup}},
+			Policies:   []policies.Policy{hedgingPolicy},
+		},
+		{
+			Path:     "/match",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Match:        dataplane.Match{Method: helpers.GetPointer("GET")},
+					BackendGroup: fooGroup,
 				},
 			},
-			{
-				Path: "/path-2",
-				MatchRules: []dataplane.MatchRule{
-					{
-						Match:        dataplane.Match{},
-						BackendGroup: fooGroup,
+			Policies: []policies.Policy{hedgingPolicy},
+		},
+		{
+			Path:     "/redirect",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Filters: dataplane.HTTPFilters{
+						RequestRedirect: &dataplane.HTTPRequestRedirectFilter{StatusCode: helpers.GetPointer(301)},
 					},
+					BackendGroup: fooGroup,
 				},
 			},
-		}
+			Policies: []policies.Policy{hedgingPolicy},
+		},
+		{
+			Path:       "/not-hedged",
+			PathType:   dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{{BackendGroup: fooGroup}},
+		},
+	}
 
-		if rootPath {
-			rules = append(rules, dataplane.PathRule{
-				Path: "/",
-				MatchRules: []dataplane.MatchRule{
-					{
-						Match:        dataplane.Match{},
-						BackendGroup: fooGroup,
-					},
-				},
-			})
-		}
+	fakeGenerator := &policiesfakes.FakeGenerator{}
 
-		if grpc {
-			rules = append(rules, dataplane.PathRule{
-				Path: "/grpc",
-				GRPC: true,
-				MatchRules: []dataplane.MatchRule{
-					{
-						Match:        dataplane.Match{},
-						BackendGroup: fooGroup,
-					},
-				},
-			})
-		}
+	locs, _, _ := createLocations(
+		&dataplane.VirtualServer{PathRules: pathRules, Port: 80},
+		"1",
+		fakeGenerator,
+		workerPool{},
+		nil,
+		false,
+		false,
+	)
 
-		return rules
+	locsByPath := make(map[string]http.Location, len(locs))
+	for _, loc := range locs {
+		locsByPath[loc.Path] = loc
 	}
 
+	g.Expect(locsByPath).To(HaveLen(8))
+
+	pathOnly := locsByPath["= /path-only"]
+	g.Expect(pathOnly.Hedging).To(Equal(&http.Hedging{
+		Location:          "/_ngf-internal-rule0-hedging",
+		DelayMilliseconds: 250,
+	}))
+	g.Expect(pathOnly.ProxyPass).To(BeEmpty())
+	g.Expect(pathOnly.ProxySetHeaders).To(BeEmpty())
+
+	pathOnlyProxy := locsByPath["/_ngf-internal-rule0-hedging"]
+	g.Expect(pathOnlyProxy.Type).To(Equal(http.InternalLocationType))
+	g.Expect(pathOnlyProxy.HedgingProxy).To(BeTrue())
+	g.Expect(pathOnlyProxy.ProxyPass).To(Equal("http://foo$request_uri"))
+	g.Expect(pathOnlyProxy.ProxySetHeaders).ToNot(BeEmpty())
+
+	match := locsByPath["/_ngf-internal-rule1-route0"]
+	g.Expect(match.Hedging).To(Equal(&http.Hedging{
+		Location:          "/_ngf-internal-rule1-route0-hedging",
+		DelayMilliseconds: 250,
+	}))
+	g.Expect(match.ProxyPass).To(BeEmpty())
+	g.Expect(locsByPath["= /match"].Hedging).To(BeNil())
+
+	matchProxy := locsByPath["/_ngf-internal-rule1-route0-hedging"]
+	g.Expect(matchProxy.HedgingProxy).To(BeTrue())
+	g.Expect(matchProxy.ProxyPass).To(Equal("http://foo$request_uri"))
+
+	g.Expect(locsByPath["= /redirect"].Hedging).To(BeNil())
+	g.Expect(locsByPath["= /redirect"].Return).ToNot(BeNil())
+
+	g.Expect(locsByPath["= /not-hedged"].Hedging).To(BeNil())
+	g.Expect(locsByPath["= /not-hedged"].ProxyPass).To(Equal("http://foo$request_uri"))
+
+	// the policies of the proxy locations are generated for internal locations
+	g.Expect(fakeGenerator.GenerateForInternalLocationCallCount()).To(Equal(3))
+	internalPaths := make([]string, 0, fakeGenerator.GenerateForInternalLocationCallCount())
+	for i := range fakeGenerator.GenerateForInternalLocationCallCount() {
+		_, loc := fakeGenerator.GenerateForInternalLocationArgsForCall(i)
+		internalPaths = append(internalPaths, loc.Path)
+	}
+	g.Expect(internalPaths).To(ConsistOf(
+		"/_ngf-internal-rule1-route0",
+		"/_ngf-internal-rule0-hedging",
+		"/_ngf-internal-rule1-route0-hedging",
+	))
+}
+
+func TestProxiesToNoEndpoints(t *testing.T) {
+	t.Parallel()
+
+	noEndpoints := map[string]struct{}{"empty-1": {}, "empty-2": {}}
+
 	tests := []struct {
-		name         string
-		pathRules    []dataplane.PathRule
-		expLocations []http.Location
-		grpc         bool
+		name      string
+		matchRule dataplane.MatchRule
+		expected  bool
 	}{
 		{
-			name:      "path rules with no root path should generate a default 404 root location",
-			pathRules: getPathRules(false /* rootPath */, false /* grpc */),
-			expLocations: []http.Location{
-				{
-					Path:            "/path-1",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
-				{
-					Path:            "/path-2",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
-				{
-					Path: "/",
-					Return: &http.Return{
-						Code: http.StatusNotFound,
+			name: "all backends have no endpoints",
+			matchRule: dataplane.MatchRule{
+				BackendGroup: dataplane.BackendGroup{
+					Backends: []dataplane.Backend{
+						{UpstreamName: "empty-1", Valid: true, Weight: 1},
+						{UpstreamName: "empty-2", Valid: true, Weight: 1},
+						{UpstreamName: "foo", Valid: true, Weight: 0},
 					},
 				},
 			},
+			expected: true,
 		},
 		{
-			name:      "path rules with grpc & with no root path should generate a default 404 root location and GRPC true",
-			pathRules: getPathRules(false /* rootPath */, true /* grpc */),
-			grpc:      true,
-			expLocations: []http.Location{
-				{
-					Path:            "/path-1",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
-				{
-					Path:            "/path-2",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
-				{
-					Path:            "/grpc",
-					ProxyPass:       "grpc://test_foo_80",
-					GRPC:            true,
-					ProxySetHeaders: grpcBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
-				{
-					Path: "/",
-					Return: &http.Return{
-						Code: http.StatusNotFound,
+			name: "a backend has endpoints",
+			matchRule: dataplane.MatchRule{
+				BackendGroup: dataplane.BackendGroup{
+					Backends: []dataplane.Backend{
+						{UpstreamName: "empty-1", Valid: true, Weight: 1},
+						{UpstreamName: "foo", Valid: true, Weight: 1},
 					},
 				},
 			},
+			expected: false,
 		},
 		{
-			name:      "path rules with a root path should not generate a default 404 root path",
-			pathRules: getPathRules(true /* rootPath */, false /* grpc */),
-			expLocations: []http.Location{
-				{
-					Path:            "/path-1",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
-				{
-					Path:            "/path-2",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
-				},
-				{
-					Path:            "/",
-					ProxyPass:       "http://test_foo_80$request_uri",
-					ProxySetHeaders: httpBaseHeaders,
-					Type:            http.ExternalLocationType,
+			name: "a backend is invalid",
+			matchRule: dataplane.MatchRule{
+				BackendGroup: dataplane.BackendGroup{
+					Backends: []dataplane.Backend{
+						{UpstreamName: "empty-1", Valid: true, Weight: 1},
+						{Weight: 1},
+					},
 				},
 			},
+			expected: false,
 		},
 		{
-			name:      "nil path rules should generate a default 404 root path",
-			pathRules: nil,
-			expLocations: []http.Location{
-				{
-					Path: "/",
-					Return: &http.Return{
-						Code: http.StatusNotFound,
-					},
+			name:      "no backends",
+			matchRule: dataplane.MatchRule{},
+			expected:  false,
+		},
+		{
+			name: "redirect filter",
+			matchRule: dataplane.MatchRule{
+				Filters: dataplane.HTTPFilters{RequestRedirect: &dataplane.HTTPRequestRedirectFilter{}},
+				BackendGroup: dataplane.BackendGroup{
+					Backends: []dataplane.Backend{{UpstreamName: "empty-1", Valid: true, Weight: 1}},
 				},
 			},
+			expected: false,
 		},
 	}
 
@@ -1884,38 +3000,32 @@ func TestCreateLocationsRootPath(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			locs, httpMatchPair, grpc := createLocations(&dataplane.VirtualServer{
-				PathRules: test.pathRules,
-				Port:      80,
-			}, "1", &policiesfakes.FakeGenerator{})
-			g.Expect(locs).To(Equal(test.expLocations))
-			g.Expect(httpMatchPair).To(BeEmpty())
-			g.Expect(grpc).To(Equal(test.grpc))
+			g.Expect(proxiesToNoEndpoints(test.matchRule, noEndpoints)).To(Equal(test.expected))
 		})
 	}
 }
 
 func TestCreateReturnValForRedirectFilter(t *testing.T) {
 	t.Parallel()
-	const listenerPortCustom = 123
-	const listenerPortHTTP = 80
-	const listenerPortHTTPS = 443
+	listenerCustom := serverListener{port: 123}
+	listenerHTTP := serverListener{port: 80}
+	listenerHTTPS := serverListener{port: 443, ssl: true}
 
 	tests := []struct {
-		filter       *dataplane.HTTPRequestRedirectFilter
-		expected     *http.Return
-		msg          string
-		listenerPort int32
+		filter   *dataplane.HTTPRequestRedirectFilter
+		expected *http.Return
+		msg      string
+		listener serverListener
 	}{
 		{
-			filter:       nil,
-			expected:     nil,
-			listenerPort: listenerPortCustom,
-			msg:          "filter is nil",
+			filter:   nil,
+			expected: nil,
+			listener: listenerCustom,
+			msg:      "filter is nil",
 		},
 		{
-			filter:       &dataplane.HTTPRequestRedirectFilter{},
-			listenerPort: listenerPortCustom,
+			filter:   &dataplane.HTTPRequestRedirectFilter{},
+			listener: listenerCustom,
 			expected: &http.Return{
 				Code: http.StatusFound,
 				Body: "$scheme://$host:123$request_uri",
@@ -1929,7 +3039,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Port:       helpers.GetPointer[int32](2022),
 				StatusCode: helpers.GetPointer(301),
 			},
-			listenerPort: listenerPortCustom,
+			listener: listenerCustom,
 			expected: &http.Return{
 				Code: 301,
 				Body: "https://foo.example.com:2022$request_uri",
@@ -1942,7 +3052,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Hostname:   helpers.GetPointer("foo.example.com"),
 				StatusCode: helpers.GetPointer(301),
 			},
-			listenerPort: listenerPortCustom,
+			listener: listenerCustom,
 			expected: &http.Return{
 				Code: 301,
 				Body: "https://foo.example.com$request_uri",
@@ -1954,12 +3064,12 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Hostname:   helpers.GetPointer("foo.example.com"),
 				StatusCode: helpers.GetPointer(301),
 			},
-			listenerPort: listenerPortHTTPS,
+			listener: listenerHTTPS,
 			expected: &http.Return{
 				Code: 301,
-				Body: "$scheme://foo.example.com:443$request_uri",
+				Body: "$scheme://foo.example.com$request_uri",
 			},
-			msg: "no scheme, listenerPort https, no port is set",
+			msg: "no scheme, listener https, no port is set",
 		},
 		{
 			filter: &dataplane.HTTPRequestRedirectFilter{
@@ -1967,7 +3077,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Hostname:   helpers.GetPointer("foo.example.com"),
 				StatusCode: helpers.GetPointer(301),
 			},
-			listenerPort: listenerPortHTTPS,
+			listener: listenerHTTPS,
 			expected: &http.Return{
 				Code: 301,
 				Body: "https://foo.example.com$request_uri",
@@ -1980,7 +3090,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Hostname:   helpers.GetPointer("foo.example.com"),
 				StatusCode: helpers.GetPointer(301),
 			},
-			listenerPort: listenerPortHTTP,
+			listener: listenerHTTP,
 			expected: &http.Return{
 				Code: 301,
 				Body: "http://foo.example.com$request_uri",
@@ -1994,7 +3104,7 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Port:       helpers.GetPointer[int32](80),
 				StatusCode: helpers.GetPointer(301),
 			},
-			listenerPort: listenerPortCustom,
+			listener: listenerCustom,
 			expected: &http.Return{
 				Code: 301,
 				Body: "http://foo.example.com$request_uri",
@@ -2008,13 +3118,93 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 				Port:       helpers.GetPointer[int32](443),
 				StatusCode: helpers.GetPointer(301),
 			},
-			listenerPort: listenerPortCustom,
+			listener: listenerCustom,
 			expected: &http.Return{
 				Code: 301,
 				Body: "https://foo.example.com$request_uri",
 			},
 			msg: "scheme is https, port https",
 		},
+		{
+			filter:   &dataplane.HTTPRequestRedirectFilter{},
+			listener: listenerHTTP,
+			expected: &http.Return{
+				Code: http.StatusFound,
+				Body: "$scheme://$host$request_uri",
+			},
+			msg: "no scheme, listener http, no port is set",
+		},
+		{
+			filter:   &dataplane.HTTPRequestRedirectFilter{},
+			listener: serverListener{port: 80, ssl: true},
+			expected: &http.Return{
+				Code: http.StatusFound,
+				Body: "$scheme://$host:80$request_uri",
+			},
+			msg: "no scheme, listener https with http port, no port is set",
+		},
+		{
+			filter:   &dataplane.HTTPRequestRedirectFilter{},
+			listener: serverListener{port: 80, forwardedProto: true},
+			expected: &http.Return{
+				Code: http.StatusFound,
+				Body: "$ngf_forwarded_proto://$host$request_uri",
+			},
+			msg: "no scheme, listener http with forwarded proto, no port is set",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme: helpers.GetPointer("http"),
+			},
+			listener: listenerHTTPS,
+			expected: &http.Return{
+				Code: http.StatusFound,
+				Body: "http://$host$request_uri",
+			},
+			msg: "scheme is http, listener https, no port is set",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Port: helpers.GetPointer[int32](443),
+			},
+			listener: listenerHTTP,
+			expected: &http.Return{
+				Code: http.StatusFound,
+				Body: "$scheme://$host:443$request_uri",
+			},
+			msg: "no scheme, listener http, port https",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Scheme:     helpers.GetPointer("https"),
+				StatusCode: helpers.GetPointer(308),
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplaceFullPath,
+					Replacement: "/full",
+				},
+			},
+			listener: listenerHTTP,
+			expected: &http.Return{
+				Code: 308,
+				Body: "https://$host/full$is_args$args",
+			},
+			msg: "full path replacement",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				StatusCode: helpers.GetPointer(307),
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/prefix",
+				},
+			},
+			listener: listenerCustom,
+			expected: &http.Return{
+				Code: 307,
+				Body: "$scheme://$host:123$uri",
+			},
+			msg: "prefix match replacement",
+		},
 	}
 
 	for _, test := range tests {
@@ -2022,12 +3212,69 @@ func TestCreateReturnValForRedirectFilter(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			result := createReturnValForRedirectFilter(test.filter, test.listenerPort)
+			result := createReturnValForRedirectFilter(test.filter, test.listener)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
 	}
 }
 
+func TestCreateRewritesForRedirectFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter   *dataplane.HTTPRequestRedirectFilter
+		msg      string
+		expected []string
+	}{
+		{
+			filter: nil,
+			msg:    "filter is nil",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{},
+			msg:    "no path",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplaceFullPath,
+					Replacement: "/full",
+				},
+			},
+			msg: "full path replacement",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/new",
+				},
+			},
+			expected: []string{"^ $request_uri", "^/original(.*)$ /new$1"},
+			msg:      "prefix match replacement",
+		},
+		{
+			filter: &dataplane.HTTPRequestRedirectFilter{
+				Path: &dataplane.HTTPPathModifier{
+					Type:        dataplane.ReplacePrefixMatch,
+					Replacement: "/new/",
+				},
+			},
+			expected: []string{"^ $request_uri", "^/original(?:/(.*))?$ /new/$1"},
+			msg:      "prefix match replacement with trailing slash",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createRewritesForRedirectFilter(test.filter, "/original")).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestCreateRewritesValForRewriteFilter(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -2420,10 +3667,11 @@ func TestCreateProxyPass(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		rewrite  *dataplane.HTTPURLRewriteFilter
-		expected string
-		grp      dataplane.BackendGroup
-		GRPC     bool
+		rewrite      *dataplane.HTTPURLRewriteFilter
+		expected     string
+		grp          dataplane.BackendGroup
+		GRPC         bool
+		modifiedArgs bool
 	}{
 		{
 			expected: "http://10.0.0.1:80$request_uri",
@@ -2483,13 +3731,26 @@ func TestCreateProxyPass(t *testing.T) {
 			},
 			GRPC: true,
 		},
+		{
+			expected: "http://10.0.0.1:80$ngf_request_path$is_args$args",
+			grp: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName: "10.0.0.1:80",
+						Valid:        true,
+						Weight:       1,
+					},
+				},
+			},
+			modifiedArgs: true,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.expected, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			result := createProxyPass(tc.grp, tc.rewrite, generateProtocolString(nil, tc.GRPC), tc.GRPC)
+			result := createProxyPass(tc.grp, tc.rewrite, generateProtocolString(nil, tc.GRPC), tc.GRPC, tc.modifiedArgs)
 			g.Expect(result).To(Equal(tc.expected))
 		})
 	}
@@ -2589,7 +3850,7 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 				},
 				{
 					Name:  "X-Forwarded-Port",
-					Value: "$server_port",
+					Value: "$ngf_gateway_port",
 				},
 			},
 		},
@@ -2643,7 +3904,7 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 				},
 				{
 					Name:  "X-Forwarded-Port",
-					Value: "$server_port",
+					Value: "$ngf_gateway_port",
 				},
 			},
 		},
@@ -2706,7 +3967,7 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 				},
 				{
 					Name:  "X-Forwarded-Port",
-					Value: "$server_port",
+					Value: "$ngf_gateway_port",
 				},
 			},
 		},
@@ -2717,12 +3978,26 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			headers := generateProxySetHeaders(tc.filters, tc.GRPC)
+			headers := generateProxySetHeaders(tc.filters, tc.GRPC, "$scheme")
 			g.Expect(headers).To(Equal(tc.expectedHeaders))
 		})
 	}
 }
 
+func TestGenerateProxySetHeaders_ForwardedProto(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	for _, grpc := range []bool{false, true} {
+		headers := generateProxySetHeaders(&dataplane.HTTPFilters{}, grpc, "$ngf_forwarded_proto")
+		g.Expect(headers).To(ContainElement(http.Header{Name: "X-Forwarded-Proto", Value: "$ngf_forwarded_proto"}))
+		g.Expect(headers).ToNot(ContainElement(http.Header{Name: "X-Forwarded-Proto", Value: "$scheme"}))
+	}
+
+	// the base headers are not modified
+	g.Expect(httpBaseHeaders).To(ContainElement(http.Header{Name: "X-Forwarded-Proto", Value: "$scheme"}))
+}
+
 func TestConvertBackendTLSFromGroup(t *testing.T) {
 	t.Parallel()
 
@@ -3066,3 +4341,31 @@ func TestGetIPFamily(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateHTTPConfig_PolicyGeneratorPanic(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fakeGenerator := &policiesfakes.FakeGenerator{}
+	fakeGenerator.GenerateForServerStub = func(_ []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+		panic("failed to generate policy")
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			{Hostname: "cafe.example.com", Port: 80},
+			{Hostname: "tea.example.com", Port: 80},
+		},
+	}
+
+	gen := GeneratorImpl{pool: newWorkerPool(4)}
+
+	// the sections are generated on the goroutines of the pool, but the panic reaches the caller, which recovers
+	// from the panics of the generation
+	generate := func() {
+		gen.generateHTTPConfig(conf, fakeGenerator)
+	}
+
+	g.Expect(generate).To(PanicWith("failed to generate policy"))
+}
@@ -43,10 +43,23 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 			continue
 		}
 
-		splitClients = append(splitClients, http.SplitClient{
+		splitClient := http.SplitClient{
 			VariableName:  convertStringToSafeVariableName(group.Name()),
 			Distributions: distributions,
-		})
+		}
+
+		if group.ABTest != nil {
+			// The requests are split by the key of the cookie, so that a client gets the same backend
+			// as long as the weights don't change.
+			splitClient.Key = "$" + generateABTestKeyVariableName(splitClient.VariableName)
+
+			// With an override header, the backend group variable is set by the map of the header.
+			if group.ABTest.OverrideHeader != "" {
+				splitClient.VariableName = generateABTestSplitVariableName(splitClient.VariableName)
+			}
+		}
+
+		splitClients = append(splitClients, splitClient)
 	}
 
 	return splitClients
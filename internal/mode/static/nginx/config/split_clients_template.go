@@ -2,7 +2,7 @@ package config
 
 const splitClientsTemplateText = `
 {{ range $sc := . }}
-split_clients $request_id ${{ $sc.VariableName }} {
+split_clients {{ if $sc.Key }}{{ $sc.Key }}{{ else }}$request_id{{ end }} ${{ $sc.VariableName }} {
     {{- range $d := $sc.Distributions }}
         {{- if eq $d.Percent "0.00" }}
     # {{ $d.Percent }}% {{ $d.Value }};
@@ -8,6 +8,7 @@ type Server struct {
 	StatusZone      string
 	ProxyPass       string
 	Pass            string
+	Route           string
 	RewriteClientIP shared.RewriteClientIPSettings
 	SSLPreread      bool
 	IsSocket        bool
@@ -17,7 +18,10 @@ type Server struct {
 type Upstream struct {
 	Name     string
 	ZoneSize string // format: 512k, 1m
-	Servers  []UpstreamServer
+	// StateFile is the file that stores the servers of the upstream. If set, Servers are ignored and
+	// the servers are managed through the NGINX Plus API.
+	StateFile string
+	Servers   []UpstreamServer
 }
 
 // UpstreamServer holds all configuration for a stream upstream server.
@@ -27,7 +31,16 @@ type UpstreamServer struct {
 
 // ServerConfig holds configuration for a stream server and IP family to be used by NGINX.
 type ServerConfig struct {
-	Servers  []Server
-	IPFamily shared.IPFamily
-	Plus     bool
+	AccessLog AccessLog
+	Servers   []Server
+	IPFamily  shared.IPFamily
+	Plus      bool
+}
+
+// AccessLog holds the configuration of the access log of the stream servers.
+type AccessLog struct {
+	// Format is the format of the entries of the access log.
+	Format string
+	// Disabled specifies whether the access log is turned off.
+	Disabled bool
 }
@@ -12,13 +12,18 @@ import (
 
 var streamServersTemplate = gotemplate.Must(gotemplate.New("streamServers").Parse(streamServersTemplateText))
 
+// defaultStreamLogFormat is the format of the stream access log if the NginxProxy doesn't configure it.
+const defaultStreamLogFormat = `$remote_addr [$time_local] $protocol $status $bytes_sent $bytes_received ` +
+	`$session_time "$ssl_preread_server_name"`
+
 func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []executeResult {
-	streamServers := createStreamServers(conf)
+	streamServers := createStreamServers(conf, g.unprivilegedPortOffset)
 
 	streamServerConfig := stream.ServerConfig{
-		Servers:  streamServers,
-		IPFamily: getIPFamily(conf.BaseHTTPConfig),
-		Plus:     g.plus,
+		AccessLog: createStreamAccessLog(conf.Logging.StreamAccessLog),
+		Servers:   streamServers,
+		IPFamily:  getIPFamily(conf.BaseHTTPConfig),
+		Plus:      g.plus,
 	}
 
 	streamServerResult := executeResult{
@@ -31,7 +36,7 @@ func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []exec
 	}
 }
 
-func createStreamServers(conf dataplane.Configuration) []stream.Server {
+func createStreamServers(conf dataplane.Configuration, portOffset int32) []stream.Server {
 	if len(conf.TLSPassthroughServers) == 0 {
 		return nil
 	}
@@ -53,6 +58,9 @@ func createStreamServers(conf dataplane.Configuration) []stream.Server {
 					ProxyPass:  server.UpstreamName,
 					IsSocket:   true,
 				}
+				if server.Route.Name != "" {
+					streamServer.Route = server.Route.String()
+				}
 				// set rewriteClientIP settings as this is a socket stream server
 				streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
 					conf.BaseHTTPConfig.RewriteClientIPSettings,
@@ -69,7 +77,7 @@ func createStreamServers(conf dataplane.Configuration) []stream.Server {
 
 		// we do not evaluate rewriteClientIP settings for non-socket stream servers
 		streamServer := stream.Server{
-			Listen:     fmt.Sprint(server.Port),
+			Listen:     fmt.Sprint(listenPort(server.Port, portOffset)),
 			StatusZone: server.Hostname,
 			Pass:       getTLSPassthroughVarName(server.Port),
 			SSLPreread: true,
@@ -79,6 +87,18 @@ func createStreamServers(conf dataplane.Configuration) []stream.Server {
 	return streamServers
 }
 
+func createStreamAccessLog(accessLog dataplane.StreamAccessLog) stream.AccessLog {
+	format := accessLog.Format
+	if format == "" {
+		format = defaultStreamLogFormat
+	}
+
+	return stream.AccessLog{
+		Format:   format,
+		Disabled: accessLog.Disabled,
+	}
+}
+
 func getRewriteClientIPSettingsForStream(
 	rewriteConfig dataplane.RewriteClientIPSettings,
 ) shared.RewriteClientIPSettings {
@@ -1,7 +1,12 @@
 package config
 
 const streamServersTemplateText = `
-{{- range $s := .Servers }}
+log_format stream-main '{{ .AccessLog.Format }}';
+{{- if not .AccessLog.Disabled }}
+access_log /dev/stdout stream-main;
+{{- end }}
+access_log /dev/null stream-main if=$ngf_record_stream_session;
+{{ range $s := .Servers }}
 server {
 	{{- if or ($.IPFamily.IPv4) ($s.IsSocket) }}
     listen {{ $s.Listen }}{{ $s.RewriteClientIP.ProxyProtocol }};
@@ -10,6 +15,10 @@ server {
     listen [::]:{{ $s.Listen }};
 	{{- end }}
 
+    {{- if $s.Route }}
+    set $ngf_stream_route "{{ $s.Route }}";
+    {{- end }}
+
     {{- range $address := $s.RewriteClientIP.RealIPFrom }}
     set_real_ip_from {{ $address }};
     {{- end}}
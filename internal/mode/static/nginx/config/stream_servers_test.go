@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -113,6 +114,93 @@ func TestExecuteStreamServers_Plus(t *testing.T) {
 	}
 }
 
+func TestExecuteStreamServers_Logging(t *testing.T) {
+	t.Parallel()
+	passthroughServers := []dataplane.Layer4VirtualServer{
+		{
+			Hostname:     "example.com",
+			Port:         8443,
+			UpstreamName: "backend1",
+			Route:        types.NamespacedName{Namespace: "default", Name: "secure-app"},
+		},
+	}
+	streamUpstreams := []dataplane.Upstream{
+		{
+			Name: "backend1",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "1.1.1.1",
+					Port:    80,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		msg           string
+		logging       dataplane.Logging
+		expSubStrings map[string]int
+	}{
+		{
+			msg: "default access log",
+			expSubStrings: map[string]int{
+				"log_format stream-main '" + defaultStreamLogFormat + "';":        1,
+				"access_log /dev/stdout stream-main;":                             1,
+				"access_log /dev/null stream-main if=$ngf_record_stream_session;": 1,
+				`set $ngf_stream_route "default/secure-app";`:                     1,
+			},
+		},
+		{
+			msg: "custom format",
+			logging: dataplane.Logging{
+				StreamAccessLog: dataplane.StreamAccessLog{
+					Format: `$remote_addr $ngf_stream_route $session_time`,
+				},
+			},
+			expSubStrings: map[string]int{
+				"log_format stream-main '$remote_addr $ngf_stream_route $session_time';": 1,
+				"access_log /dev/stdout stream-main;":                                    1,
+				"access_log /dev/null stream-main if=$ngf_record_stream_session;":        1,
+			},
+		},
+		{
+			msg: "disabled access log",
+			logging: dataplane.Logging{
+				StreamAccessLog: dataplane.StreamAccessLog{
+					Disabled: true,
+				},
+			},
+			expSubStrings: map[string]int{
+				"access_log /dev/stdout stream-main;":                             0,
+				"access_log /dev/null stream-main if=$ngf_record_stream_session;": 1,
+				`set $ngf_stream_route "default/secure-app";`:                     1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				TLSPassthroughServers: passthroughServers,
+				StreamUpstreams:       streamUpstreams,
+				Logging:               test.logging,
+			}
+
+			gen := GeneratorImpl{}
+			results := gen.executeStreamServers(conf)
+			g.Expect(results).To(HaveLen(1))
+
+			serverConf := string(results[0].data)
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+			}
+		})
+	}
+}
+
 func TestCreateStreamServers(t *testing.T) {
 	t.Parallel()
 	conf := dataplane.Configuration{
@@ -121,6 +209,7 @@ func TestCreateStreamServers(t *testing.T) {
 				Hostname:     "example.com",
 				Port:         8081,
 				UpstreamName: "backend1",
+				Route:        types.NamespacedName{Namespace: "default", Name: "secure-app"},
 			},
 			{
 				Hostname:     "example.com",
@@ -174,7 +263,7 @@ func TestCreateStreamServers(t *testing.T) {
 		},
 	}
 
-	streamServers := createStreamServers(conf)
+	streamServers := createStreamServers(conf, 0)
 
 	g := NewWithT(t)
 
@@ -183,6 +272,7 @@ func TestCreateStreamServers(t *testing.T) {
 			Listen:     getSocketNameTLS(conf.TLSPassthroughServers[0].Port, conf.TLSPassthroughServers[0].Hostname),
 			ProxyPass:  conf.TLSPassthroughServers[0].UpstreamName,
 			StatusZone: conf.TLSPassthroughServers[0].Hostname,
+			Route:      "default/secure-app",
 			SSLPreread: false,
 			IsSocket:   true,
 		},
@@ -405,7 +495,7 @@ func TestCreateStreamServersWithNone(t *testing.T) {
 		TLSPassthroughServers: nil,
 	}
 
-	streamServers := createStreamServers(conf)
+	streamServers := createStreamServers(conf, 0)
 
 	g := NewWithT(t)
 
@@ -0,0 +1,237 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+// TemplateSection is a section of the NGINX configuration whose built-in template can be overridden.
+type TemplateSection string
+
+const (
+	// TemplateSectionHTTP is the section with the directives of the http context.
+	TemplateSectionHTTP TemplateSection = "http"
+	// TemplateSectionServers is the section with the HTTP servers.
+	TemplateSectionServers TemplateSection = "servers"
+	// TemplateSectionUpstreams is the section with the HTTP upstreams.
+	TemplateSectionUpstreams TemplateSection = "upstreams"
+)
+
+// templateFileExtension is the extension of the files of the override templates.
+const templateFileExtension = ".tmpl"
+
+// templateSections are the sections whose templates can be overridden.
+var templateSections = []TemplateSection{TemplateSectionHTTP, TemplateSectionServers, TemplateSectionUpstreams}
+
+// TemplateOverrides are the templates that override the built-in templates of the sections of the NGINX
+// configuration. The zero value overrides no sections.
+type TemplateOverrides struct {
+	templates map[TemplateSection]*gotemplate.Template
+	// onError is called when an override template fails to execute and the built-in template is used instead.
+	onError func(section TemplateSection, err error)
+}
+
+// LoadTemplateOverrides loads the override templates from the files <section>.tmpl in dir, for example,
+// servers.tmpl. The templates get the same data as the built-in templates of the sections, which are in the
+// *_template.go files of this package.
+//
+// A template is only used if it parses and executes for a sample configuration. LoadTemplateOverrides returns
+// an error for every invalid template and for every file that isn't a template of a section, and uses the built-in
+// templates for these sections. If an override template fails to execute later, the built-in template is used
+// for the configuration, and the error is logged.
+func LoadTemplateOverrides(dir string, logger logr.Logger) (TemplateOverrides, error) {
+	overrides := TemplateOverrides{
+		templates: make(map[TemplateSection]*gotemplate.Template),
+		onError: func(section TemplateSection, err error) {
+			logger.Error(err, "Failed to execute the override template; using the built-in template", "section", section)
+		},
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return TemplateOverrides{}, fmt.Errorf("cannot read the template overrides directory: %w", err)
+	}
+
+	var errs []error
+
+	for _, entry := range entries {
+		// a directory mounted from a ConfigMap contains hidden files and directories that start with ".."
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		section := TemplateSection(strings.TrimSuffix(entry.Name(), templateFileExtension))
+		if !strings.HasSuffix(entry.Name(), templateFileExtension) || !slices.Contains(templateSections, section) {
+			errs = append(errs, fmt.Errorf(
+				"file %q is not an override template; supported files: %s",
+				entry.Name(),
+				supportedTemplateFiles(),
+			))
+			continue
+		}
+
+		// the ConfigMap files are symbolic links, so the file is read by its path
+		text, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cannot read the override template of section %q: %w", section, err))
+			continue
+		}
+
+		tmpl, err := parseTemplateOverride(section, string(text))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		overrides.templates[section] = tmpl
+		logger.Info("Using the override template", "section", section)
+	}
+
+	return overrides, errors.Join(errs...)
+}
+
+// parseTemplateOverride parses the override template of the section, and validates it by generating the
+// configuration of sample configurations with it.
+func parseTemplateOverride(section TemplateSection, text string) (*gotemplate.Template, error) {
+	tmpl, err := gotemplate.New(string(section)).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid override template of section %q: %w", section, err)
+	}
+
+	var execErr error
+	candidate := TemplateOverrides{
+		templates: map[TemplateSection]*gotemplate.Template{section: tmpl},
+		onError: func(_ TemplateSection, err error) {
+			execErr = err
+		},
+	}
+
+	for _, plus := range []bool{false, true} {
+		GeneratorImpl{plus: plus, templates: candidate}.Generate(sampleTemplateConfiguration)
+		if execErr != nil {
+			return nil, fmt.Errorf("invalid override template of section %q: %w", section, execErr)
+		}
+	}
+
+	return tmpl, nil
+}
+
+func supportedTemplateFiles() string {
+	files := make([]string, 0, len(templateSections))
+	for _, section := range templateSections {
+		files = append(files, string(section)+templateFileExtension)
+	}
+
+	return strings.Join(files, ", ")
+}
+
+// Sections returns the overridden sections in order.
+func (o TemplateOverrides) Sections() []TemplateSection {
+	sections := make([]TemplateSection, 0, len(o.templates))
+	for _, section := range templateSections {
+		if _, ok := o.templates[section]; ok {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
+
+// execute executes the override template of the section, or the built-in template if the section isn't overridden
+// or its override template fails to execute.
+func (o TemplateOverrides) execute(section TemplateSection, builtIn *gotemplate.Template, data any) []byte {
+	if tmpl, ok := o.templates[section]; ok {
+		var buf bytes.Buffer
+
+		err := tmpl.Execute(&buf, data)
+		if err == nil {
+			return buf.Bytes()
+		}
+
+		if o.onError != nil {
+			o.onError(section, err)
+		}
+	}
+
+	return helpers.MustExecuteTemplate(builtIn, data)
+}
+
+// sampleTemplateConfiguration is the configuration with which the override templates are validated.
+// It has a default server, a server with a location and an SSL server, so that the templates are executed
+// for the common data.
+var sampleTemplateConfiguration = dataplane.Configuration{
+	HTTPServers: []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      80,
+		},
+		{
+			Hostname:  "example.com",
+			Port:      80,
+			PathRules: sampleTemplatePathRules,
+		},
+	},
+	SSLServers: []dataplane.VirtualServer{
+		{
+			Hostname:  "example.com",
+			Port:      443,
+			SSL:       &dataplane.SSL{KeyPairID: "sample"},
+			PathRules: sampleTemplatePathRules,
+		},
+	},
+	Upstreams: []dataplane.Upstream{
+		{
+			Name:      "sample",
+			Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+		},
+		{
+			Name: "sample-no-endpoints",
+		},
+	},
+	BaseHTTPConfig: dataplane.BaseHTTPConfig{
+		HTTP2: true,
+	},
+}
+
+var sampleTemplatePathRules = []dataplane.PathRule{
+	{
+		Path:     "/",
+		PathType: dataplane.PathTypePrefix,
+		MatchRules: []dataplane.MatchRule{
+			{
+				Source: &metav1.ObjectMeta{Namespace: "sample", Name: "sample"},
+				BackendGroup: dataplane.BackendGroup{
+					Backends: []dataplane.Backend{{UpstreamName: "sample", Valid: true, Weight: 1}},
+				},
+			},
+		},
+	},
+	{
+		Path:     "/match",
+		PathType: dataplane.PathTypeExact,
+		MatchRules: []dataplane.MatchRule{
+			{
+				Source: &metav1.ObjectMeta{Namespace: "sample", Name: "sample"},
+				Match: dataplane.Match{
+					Method: helpers.GetPointer("GET"),
+				},
+				BackendGroup: dataplane.BackendGroup{
+					Backends: []dataplane.Backend{{UpstreamName: "sample", Valid: true, Weight: 1}},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	gotemplate "text/template"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+func TestLoadTemplateOverrides(t *testing.T) {
+	t.Parallel()
+
+	complianceDirective := "add_header X-Compliance enforced always;"
+
+	tests := []struct {
+		files       map[string]string
+		name        string
+		expErrs     []string
+		expSections []TemplateSection
+	}{
+		{
+			name:        "no files",
+			expSections: []TemplateSection{},
+		},
+		{
+			name: "valid overrides",
+			files: map[string]string{
+				"http.tmpl":      baseHTTPTemplateText + complianceDirective,
+				"upstreams.tmpl": upstreamsTemplateText,
+				"..data":         "ignored",
+			},
+			expSections: []TemplateSection{TemplateSectionHTTP, TemplateSectionUpstreams},
+		},
+		{
+			name: "invalid overrides",
+			files: map[string]string{
+				"http.tmpl":      "{{ if .HTTP2 }}",
+				"servers.tmpl":   "{{ range $s := .Servers }}{{ $s.Unknown }}{{ end }}",
+				"upstreams.tmpl": upstreamsTemplateText,
+				"stream.tmpl":    "",
+				"servers.conf":   "",
+			},
+			expSections: []TemplateSection{TemplateSectionUpstreams},
+			expErrs: []string{
+				`invalid override template of section "http"`,
+				`invalid override template of section "servers"`,
+				`file "stream.tmpl" is not an override template`,
+				`file "servers.conf" is not an override template`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			dir := t.TempDir()
+			for name, content := range test.files {
+				g.Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)).To(Succeed())
+			}
+
+			overrides, err := LoadTemplateOverrides(dir, logr.Discard())
+			if len(test.expErrs) == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				for _, expErr := range test.expErrs {
+					g.Expect(err).To(MatchError(ContainSubstring(expErr)))
+				}
+			}
+
+			g.Expect(overrides.Sections()).To(Equal(test.expSections))
+		})
+	}
+}
+
+func TestLoadTemplateOverrides_NoDirectory(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	_, err := LoadTemplateOverrides(filepath.Join(t.TempDir(), "missing"), logr.Discard())
+	g.Expect(err).To(MatchError(ContainSubstring("cannot read the template overrides directory")))
+}
+
+func TestGenerate_TemplateOverrides(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	override := upstreamsTemplateText + "# compliance\n"
+	g.Expect(os.WriteFile(filepath.Join(dir, "upstreams.tmpl"), []byte(override), 0o600)).To(Succeed())
+
+	overrides, err := LoadTemplateOverrides(dir, logr.Discard())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	gen := NewGeneratorImpl(false).WithTemplateOverrides(overrides)
+
+	results := gen.executeUpstreams(dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{
+				Name:      "up",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.0", Port: 80}},
+			},
+		},
+	})
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(string(results[0].data)).To(ContainSubstring("server 10.0.0.0:80;"))
+	g.Expect(string(results[0].data)).To(HaveSuffix("# compliance\n"))
+}
+
+func TestTemplateOverridesExecuteFallback(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	var failedSection TemplateSection
+	var execErr error
+
+	overrides := TemplateOverrides{
+		templates: map[TemplateSection]*gotemplate.Template{
+			TemplateSectionServers: gotemplate.Must(gotemplate.New("servers").Parse("{{ .Missing }}")),
+		},
+		onError: func(section TemplateSection, err error) {
+			failedSection = section
+			execErr = err
+		},
+	}
+	builtIn := gotemplate.Must(gotemplate.New("builtIn").Parse("built-in {{ .Name }}"))
+	data := struct{ Name string }{Name: "test"}
+
+	g.Expect(string(overrides.execute(TemplateSectionServers, builtIn, data))).To(Equal("built-in test"))
+	g.Expect(failedSection).To(Equal(TemplateSectionServers))
+	g.Expect(execErr).To(HaveOccurred())
+
+	g.Expect(string(overrides.execute(TemplateSectionHTTP, builtIn, data))).To(Equal("built-in test"))
+	g.Expect(string(TemplateOverrides{}.execute(TemplateSectionServers, builtIn, data))).To(Equal("built-in test"))
+}
@@ -2,15 +2,20 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
 	gotemplate "text/template"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
-var upstreamsTemplate = gotemplate.Must(gotemplate.New("upstreams").Parse(upstreamsTemplateText))
+var (
+	upstreamsTemplate       = gotemplate.Must(gotemplate.New("upstreams").Parse(upstreamsTemplateText))
+	streamUpstreamsTemplate = gotemplate.Must(gotemplate.New("streamUpstreams").Parse(streamUpstreamsTemplateText))
+)
 
 const (
 	// nginx502Server is used as a backend for services that cannot be resolved (have no IP address).
@@ -19,54 +24,93 @@ const (
 	nginx500Server = "unix:/var/run/nginx/nginx-500-server.sock"
 	// invalidBackendRef is used as an upstream name for invalid backend references.
 	invalidBackendRef = "invalid-backend-ref"
-	// ossZoneSize is the upstream zone size for nginx open source.
-	ossZoneSize = "512k"
-	// plusZoneSize is the upstream zone size for nginx plus.
-	plusZoneSize = "1m"
-	// ossZoneSize is the upstream zone size for nginx open source.
-	ossZoneSizeStream = "512k"
-	// plusZoneSize is the upstream zone size for nginx plus.
-	plusZoneSizeStream = "1m"
+	// stateFileEndpointsThreshold is the number of endpoints above which the servers of an NGINX Plus upstream
+	// are stored in a state file instead of the configuration.
+	stateFileEndpointsThreshold = 500
+	// stateFolder is the folder where NGINX Plus writes the upstream state files.
+	stateFolder = "/var/cache/nginx"
 )
 
+// upstreamZone describes the base shared memory zone of an upstream and the number of upstream servers it fits.
+type upstreamZone struct {
+	sizeKB     int
+	maxServers int
+}
+
+var (
+	// ossZone is the base upstream zone for nginx open source.
+	ossZone = upstreamZone{sizeKB: 512, maxServers: 648}
+	// plusZone is the base upstream zone for nginx plus.
+	plusZone = upstreamZone{sizeKB: 1024, maxServers: 556}
+	// ossZoneStream is the base stream upstream zone for nginx open source.
+	ossZoneStream = upstreamZone{sizeKB: 512, maxServers: 576}
+	// plusZoneStream is the base stream upstream zone for nginx plus.
+	plusZoneStream = upstreamZone{sizeKB: 1024, maxServers: 991}
+)
+
+// size returns the zone size for an upstream with the given number of servers.
+// The base size is multiplied until the zone fits all servers. The result is in the NGINX size format,
+// for example, 512k or 1m.
+func (z upstreamZone) size(servers int) string {
+	multiplier := 1
+	if servers > z.maxServers {
+		multiplier = (servers + z.maxServers - 1) / z.maxServers
+	}
+
+	sizeKB := z.sizeKB * multiplier
+	if sizeKB%1024 == 0 {
+		return fmt.Sprintf("%dm", sizeKB/1024)
+	}
+
+	return fmt.Sprintf("%dk", sizeKB)
+}
+
 func (g GeneratorImpl) executeUpstreams(conf dataplane.Configuration) []executeResult {
-	upstreams := g.createUpstreams(conf.Upstreams)
+	upstreams := g.createUpstreams(conf.Upstreams, conf.UpstreamZoneSize)
 
 	result := executeResult{
-		dest: httpConfigFile,
-		data: helpers.MustExecuteTemplate(upstreamsTemplate, upstreams),
+		dest: httpUpstreamsFile,
+		data: g.templates.execute(TemplateSectionUpstreams, upstreamsTemplate, upstreams),
 	}
 
-	return []executeResult{result}
+	return append(createUpstreamIncludeFileResults(upstreams), result)
 }
 
 func (g GeneratorImpl) executeStreamUpstreams(conf dataplane.Configuration) []executeResult {
-	upstreams := g.createStreamUpstreams(conf.StreamUpstreams)
+	upstreams := g.createStreamUpstreams(conf.StreamUpstreams, conf.UpstreamZoneSize)
 
 	result := executeResult{
-		dest: streamConfigFile,
-		data: helpers.MustExecuteTemplate(upstreamsTemplate, upstreams),
+		dest: streamUpstreamsFile,
+		data: helpers.MustExecuteTemplate(streamUpstreamsTemplate, upstreams),
 	}
 
 	return []executeResult{result}
 }
 
-func (g GeneratorImpl) createStreamUpstreams(upstreams []dataplane.Upstream) []stream.Upstream {
+func (g GeneratorImpl) createStreamUpstreams(
+	upstreams []dataplane.Upstream,
+	zoneSizeOverride string,
+) []stream.Upstream {
 	ups := make([]stream.Upstream, 0, len(upstreams))
 
 	for _, u := range upstreams {
 		if len(u.Endpoints) != 0 {
-			ups = append(ups, g.createStreamUpstream(u))
+			ups = append(ups, g.createStreamUpstream(u, zoneSizeOverride))
 		}
 	}
 
 	return ups
 }
 
-func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstream {
-	zoneSize := ossZoneSizeStream
+func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream, zoneSizeOverride string) stream.Upstream {
+	zone := ossZoneStream
 	if g.plus {
-		zoneSize = plusZoneSizeStream
+		zone = plusZoneStream
+	}
+
+	zoneSize := zoneSizeOverride
+	if zoneSize == "" {
+		zoneSize = zone.size(len(up.Endpoints))
 	}
 
 	upstreamServers := make([]stream.UpstreamServer, len(up.Endpoints))
@@ -87,12 +131,14 @@ func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstre
 	}
 }
 
-func (g GeneratorImpl) createUpstreams(upstreams []dataplane.Upstream) []http.Upstream {
+func (g GeneratorImpl) createUpstreams(upstreams []dataplane.Upstream, zoneSizeOverride string) []http.Upstream {
 	// capacity is the number of upstreams + 1 for the invalid backend ref upstream
 	ups := make([]http.Upstream, 0, len(upstreams)+1)
 
 	for _, u := range upstreams {
-		ups = append(ups, g.createUpstream(u))
+		up := g.createUpstream(u, zoneSizeOverride)
+		up.Includes = g.extensions.generateForUpstream(up)
+		ups = append(ups, up)
 	}
 
 	ups = append(ups, createInvalidBackendRefUpstream())
@@ -100,10 +146,15 @@ func (g GeneratorImpl) createUpstreams(upstreams []dataplane.Upstream) []http.Up
 	return ups
 }
 
-func (g GeneratorImpl) createUpstream(up dataplane.Upstream) http.Upstream {
-	zoneSize := ossZoneSize
+func (g GeneratorImpl) createUpstream(up dataplane.Upstream, zoneSizeOverride string) http.Upstream {
+	zone := ossZone
 	if g.plus {
-		zoneSize = plusZoneSize
+		zone = plusZone
+	}
+
+	zoneSize := zoneSizeOverride
+	if zoneSize == "" {
+		zoneSize = zone.size(len(up.Endpoints))
 	}
 
 	if len(up.Endpoints) == 0 {
@@ -118,6 +169,35 @@ func (g GeneratorImpl) createUpstream(up dataplane.Upstream) http.Upstream {
 		}
 	}
 
+	settings := upstreamsettings.ProcessPolicies(up.Policies)
+
+	var queue *http.UpstreamQueue
+	var healthCheck *http.UpstreamHealthCheck
+	var loadBalancingMethod string
+	if g.plus {
+		queue = settings.Queue
+		loadBalancingMethod = settings.LoadBalancingMethod
+
+		if settings.HealthCheck != nil {
+			healthCheck = settings.HealthCheck
+			healthCheck.Location = upstreamsettings.HealthCheckLocation(up.Name)
+		}
+	}
+
+	keepAlive := createUpstreamKeepAlive(settings)
+
+	if g.plus && UsesStateFile(up) {
+		return http.Upstream{
+			Name:                up.Name,
+			ZoneSize:            zoneSize,
+			StateFile:           generateStateFileName(up.Name),
+			Queue:               queue,
+			HealthCheck:         healthCheck,
+			KeepAlive:           keepAlive,
+			LoadBalancingMethod: loadBalancingMethod,
+		}
+	}
+
 	upstreamServers := make([]http.UpstreamServer, len(up.Endpoints))
 	for idx, ep := range up.Endpoints {
 		format := "%s:%d"
@@ -125,17 +205,71 @@ func (g GeneratorImpl) createUpstream(up dataplane.Upstream) http.Upstream {
 			format = "[%s]:%d"
 		}
 		upstreamServers[idx] = http.UpstreamServer{
-			Address: fmt.Sprintf(format, ep.Address, ep.Port),
+			Address:  fmt.Sprintf(format, ep.Address, ep.Port),
+			MaxConns: settings.MaxConns,
 		}
 	}
 
 	return http.Upstream{
-		Name:     up.Name,
-		ZoneSize: zoneSize,
-		Servers:  upstreamServers,
+		Name:                up.Name,
+		ZoneSize:            zoneSize,
+		Servers:             upstreamServers,
+		Queue:               queue,
+		HealthCheck:         healthCheck,
+		KeepAlive:           keepAlive,
+		LoadBalancingMethod: loadBalancingMethod,
 	}
 }
 
+// createUpstreamKeepAlive returns the keep-alive connections to the servers of an upstream. They are enabled with
+// the default number of connections, unless the UpstreamSettingsPolicies of the upstream set them, so that NGINX
+// doesn't open a new connection for every proxied request. It returns nil if the policies disable them.
+func createUpstreamKeepAlive(settings upstreamsettings.UpstreamSettings) *http.UpstreamKeepAlive {
+	if settings.KeepAlive == nil {
+		return &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections}
+	}
+
+	if settings.KeepAlive.Connections == 0 {
+		return nil
+	}
+
+	return settings.KeepAlive
+}
+
+// createUpstreamIncludeFileResults returns the files included in the upstreams.
+func createUpstreamIncludeFileResults(upstreams []http.Upstream) []executeResult {
+	uniqueIncludes := make(map[string][]byte)
+
+	for _, up := range upstreams {
+		for _, include := range up.Includes {
+			uniqueIncludes[include.Name] = include.Content
+		}
+	}
+
+	results := make([]executeResult, 0, len(uniqueIncludes))
+
+	for _, filename := range sortedKeys(uniqueIncludes) {
+		results = append(results, executeResult{
+			dest: filename,
+			data: uniqueIncludes[filename],
+		})
+	}
+
+	return results
+}
+
+// UsesStateFile returns whether the servers of an NGINX Plus HTTP upstream are stored in a state file.
+// Such an upstream is configured without servers, so that endpoint changes of large Services
+// don't require rendering and reloading all of their servers. Its servers must be set
+// through the NGINX Plus API after every reload.
+func UsesStateFile(up dataplane.Upstream) bool {
+	return len(up.Endpoints) > stateFileEndpointsThreshold
+}
+
+func generateStateFileName(upstreamName string) string {
+	return filepath.Join(stateFolder, upstreamName+".state")
+}
+
 func createInvalidBackendRefUpstream() http.Upstream {
 	// ZoneSize is omitted since we will only ever proxy to one destination/backend.
 	return http.Upstream{
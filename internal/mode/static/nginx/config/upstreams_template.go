@@ -1,20 +1,87 @@
 package config
 
-// FIXME(kate-osborn): Dynamically calculate upstream zone size based on the number of upstreams.
-// 512k will support up to 648 http upstream servers for OSS.
-// NGINX Plus needs 1m to support roughly the same amount of http servers (556 upstream servers).
-// For stream upstream servers, 512k will support 576 in OSS and 1m will support 991 in NGINX Plus
-// https://github.com/nginxinc/nginx-gateway-fabric/issues/483
 const upstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
+    {{ if $u.LoadBalancingMethod -}}
+    {{ $u.LoadBalancingMethod }};
+    {{ else -}}
     random two least_conn;
+    {{ end -}}
     {{ if $u.ZoneSize -}}
     zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{ end -}}
+    {{ if $u.Queue -}}
+    queue {{ $u.Queue.Size }}{{ if $u.Queue.Timeout }} timeout={{ $u.Queue.Timeout }}{{ end }};
+    {{ end -}}
+    {{ if $u.KeepAlive -}}
+    keepalive {{ $u.KeepAlive.Connections }};
+    {{ if $u.KeepAlive.Requests -}}
+    keepalive_requests {{ $u.KeepAlive.Requests }};
+    {{ end -}}
+    {{ if $u.KeepAlive.Time -}}
+    keepalive_time {{ $u.KeepAlive.Time }};
+    {{ end -}}
+    {{ if $u.KeepAlive.Timeout -}}
+    keepalive_timeout {{ $u.KeepAlive.Timeout }};
+    {{ end -}}
+    {{ end -}}
+    {{ if $u.StateFile -}}
+    state {{ $u.StateFile }};
+    {{- else -}}
+    {{ range $server := $u.Servers }}
+    server {{ $server.Address }}{{ if $server.MaxConns }} max_conns={{ $server.MaxConns }}{{ end }};
+    {{- end }}
+    {{- end }}
+    {{- range $i := $u.Includes }}
+    include {{ $i.Name }};
+    {{- end }}
+}
+{{ end -}}
+
+{{- $healthChecks := false }}
+{{- range $u := . }}{{ if $u.HealthCheck }}{{ $healthChecks = true }}{{ end }}{{ end }}
+{{- if $healthChecks }}
+{{- /* the health checks are sent from the named locations of a server that doesn't receive requests */}}
+server {
+    listen unix:/var/run/nginx/nginx-health-check-server.sock;
+    access_log off;
+    {{ range $u := . }}
+        {{- if $u.HealthCheck }}
+    location {{ $u.HealthCheck.Location }} {
+            {{- if $u.HealthCheck.GRPC }}
+        grpc_pass grpc://{{ $u.Name }};
+        health_check type=grpc
+                {{- if $u.HealthCheck.GRPCService }} grpc_service={{ $u.HealthCheck.GRPCService }}{{ end }}
+            {{- else }}
+        proxy_pass http://{{ $u.Name }};
+        health_check
+                {{- if $u.HealthCheck.URI }} uri={{ $u.HealthCheck.URI }}{{ end }}
+            {{- end }}
+            {{- if $u.HealthCheck.Interval }} interval={{ $u.HealthCheck.Interval }}{{ end }}
+            {{- if $u.HealthCheck.Fails }} fails={{ $u.HealthCheck.Fails }}{{ end }}
+            {{- if $u.HealthCheck.Passes }} passes={{ $u.HealthCheck.Passes }}{{ end }};
+    }
+        {{- end }}
+    {{- end }}
+}
+{{ end -}}
+`
+
+const streamUpstreamsTemplateText = `
+{{ range $u := . }}
+upstream {{ $u.Name }} {
+    random two least_conn;
+    {{ if $u.ZoneSize -}}
+    zone {{ $u.Name }} {{ $u.ZoneSize }};
+    {{ end -}}
+    {{ if $u.StateFile -}}
+    state {{ $u.StateFile }};
+    {{- else -}}
     {{ range $server := $u.Servers }}
     server {{ $server.Address }};
     {{- end }}
+    {{- end }}
 }
 {{ end -}}
 `
@@ -1,11 +1,18 @@
 package config
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
@@ -66,7 +73,7 @@ func TestExecuteUpstreams(t *testing.T) {
 	g.Expect(upstreamResults).To(HaveLen(1))
 	upstreams := string(upstreamResults[0].data)
 
-	g.Expect(upstreamResults[0].dest).To(Equal(httpConfigFile))
+	g.Expect(upstreamResults[0].dest).To(Equal(httpUpstreamsFile))
 	for _, expSubString := range expectedSubStrings {
 		g.Expect(upstreams).To(ContainSubstring(expSubString))
 	}
@@ -120,8 +127,9 @@ func TestCreateUpstreams(t *testing.T) {
 
 	expUpstreams := []http.Upstream{
 		{
-			Name:     "up1",
-			ZoneSize: ossZoneSize,
+			Name:      "up1",
+			ZoneSize:  "512k",
+			KeepAlive: &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
 			Servers: []http.UpstreamServer{
 				{
 					Address: "10.0.0.0:80",
@@ -135,8 +143,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     "up2",
-			ZoneSize: ossZoneSize,
+			Name:      "up2",
+			ZoneSize:  "512k",
+			KeepAlive: &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
 			Servers: []http.UpstreamServer{
 				{
 					Address: "11.0.0.0:80",
@@ -145,7 +154,7 @@ func TestCreateUpstreams(t *testing.T) {
 		},
 		{
 			Name:     "up3",
-			ZoneSize: ossZoneSize,
+			ZoneSize: "512k",
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx502Server,
@@ -153,8 +162,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name:     "up4-ipv6",
-			ZoneSize: ossZoneSize,
+			Name:      "up4-ipv6",
+			ZoneSize:  "512k",
+			KeepAlive: &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
 			Servers: []http.UpstreamServer{
 				{
 					Address: "[fd00:10:244:1::7]:80",
@@ -172,7 +182,7 @@ func TestCreateUpstreams(t *testing.T) {
 	}
 
 	g := NewWithT(t)
-	result := gen.createUpstreams(stateUpstreams)
+	result := gen.createUpstreams(stateUpstreams, "")
 	g.Expect(result).To(Equal(expUpstreams))
 }
 
@@ -191,7 +201,7 @@ func TestCreateUpstream(t *testing.T) {
 			},
 			expectedUpstream: http.Upstream{
 				Name:     "nil-endpoints",
-				ZoneSize: ossZoneSize,
+				ZoneSize: "512k",
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -207,7 +217,7 @@ func TestCreateUpstream(t *testing.T) {
 			},
 			expectedUpstream: http.Upstream{
 				Name:     "no-endpoints",
-				ZoneSize: ossZoneSize,
+				ZoneSize: "512k",
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -235,8 +245,9 @@ func TestCreateUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "multiple-endpoints",
-				ZoneSize: ossZoneSize,
+				Name:      "multiple-endpoints",
+				ZoneSize:  "512k",
+				KeepAlive: &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
 				Servers: []http.UpstreamServer{
 					{
 						Address: "10.0.0.1:80",
@@ -263,8 +274,9 @@ func TestCreateUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: http.Upstream{
-				Name:     "endpoint-ipv6",
-				ZoneSize: ossZoneSize,
+				Name:      "endpoint-ipv6",
+				ZoneSize:  "512k",
+				KeepAlive: &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
 				Servers: []http.UpstreamServer{
 					{
 						Address: "[fd00:10:244:1::7]:80",
@@ -279,7 +291,7 @@ func TestCreateUpstream(t *testing.T) {
 		t.Run(test.msg, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			result := gen.createUpstream(test.stateUpstream)
+			result := gen.createUpstream(test.stateUpstream, "")
 			g.Expect(result).To(Equal(test.expectedUpstream))
 		})
 	}
@@ -299,8 +311,9 @@ func TestCreateUpstreamPlus(t *testing.T) {
 		},
 	}
 	expectedUpstream := http.Upstream{
-		Name:     "multiple-endpoints",
-		ZoneSize: plusZoneSize,
+		Name:      "multiple-endpoints",
+		ZoneSize:  "1m",
+		KeepAlive: &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
 		Servers: []http.UpstreamServer{
 			{
 				Address: "10.0.0.1:80",
@@ -308,12 +321,362 @@ func TestCreateUpstreamPlus(t *testing.T) {
 		},
 	}
 
-	result := gen.createUpstream(stateUpstream)
+	result := gen.createUpstream(stateUpstream, "")
 
 	g := NewWithT(t)
 	g.Expect(result).To(Equal(expectedUpstream))
 }
 
+func TestCreateUpstreamPlus_StateFile(t *testing.T) {
+	t.Parallel()
+
+	stateUpstream := dataplane.Upstream{
+		Name:      "large",
+		Endpoints: make([]resolver.Endpoint, 501),
+	}
+
+	tests := []struct {
+		msg          string
+		expStateFile string
+		expServers   int
+		plus         bool
+	}{
+		{
+			msg:          "nginx plus",
+			plus:         true,
+			expStateFile: "/var/cache/nginx/large.state",
+			expServers:   0,
+		},
+		{
+			msg:        "nginx oss",
+			plus:       false,
+			expServers: 501,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			gen := GeneratorImpl{plus: test.plus}
+
+			result := gen.createUpstream(stateUpstream, "")
+			g.Expect(result.StateFile).To(Equal(test.expStateFile))
+			g.Expect(result.Servers).To(HaveLen(test.expServers))
+			g.Expect(result.ZoneSize).ToNot(BeEmpty())
+		})
+	}
+}
+
+func TestExecuteUpstreams_StateFile(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	gen := GeneratorImpl{plus: true}
+
+	endpoints := make([]resolver.Endpoint, 501)
+	for i := range endpoints {
+		endpoints[i] = resolver.Endpoint{Address: fmt.Sprintf("10.0.%d.%d", i/256, i%256), Port: 80}
+	}
+
+	results := gen.executeUpstreams(dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{
+				Name:      "large",
+				Endpoints: endpoints,
+			},
+		},
+	})
+	g.Expect(results).To(HaveLen(1))
+
+	upstreams := string(results[0].data)
+	g.Expect(upstreams).To(ContainSubstring("state /var/cache/nginx/large.state;"))
+	g.Expect(upstreams).ToNot(ContainSubstring("server 10.0.0.0:80;"))
+}
+
+func TestCreateUpstream_UpstreamSettings(t *testing.T) {
+	t.Parallel()
+
+	stateUpstream := dataplane.Upstream{
+		Name: "up",
+		Endpoints: []resolver.Endpoint{
+			{
+				Address: "10.0.0.1",
+				Port:    80,
+			},
+		},
+		Policies: []policies.Policy{
+			&ngfAPI.UpstreamSettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "usp", Namespace: "test"},
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					LoadBalancingMethod: helpers.GetPointer(ngfAPI.LoadBalancingMethodLeastTimeLastByte),
+					MaxConnections:      helpers.GetPointer[int32](10),
+					Queue: &ngfAPI.UpstreamQueue{
+						Size:    20,
+						Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		msg              string
+		expectedUpstream http.Upstream
+		plus             bool
+	}{
+		{
+			msg:  "nginx plus",
+			plus: true,
+			expectedUpstream: http.Upstream{
+				Name:                "up",
+				ZoneSize:            "1m",
+				KeepAlive:           &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
+				LoadBalancingMethod: "least_time last_byte",
+				Servers: []http.UpstreamServer{
+					{
+						Address:  "10.0.0.1:80",
+						MaxConns: 10,
+					},
+				},
+				Queue: &http.UpstreamQueue{
+					Size:    20,
+					Timeout: "30s",
+				},
+			},
+		},
+		{
+			msg:  "nginx oss; queue and least time load balancing are not supported",
+			plus: false,
+			expectedUpstream: http.Upstream{
+				Name:      "up",
+				ZoneSize:  "512k",
+				KeepAlive: &http.UpstreamKeepAlive{Connections: upstreamsettings.DefaultKeepAliveConnections},
+				Servers: []http.UpstreamServer{
+					{
+						Address:  "10.0.0.1:80",
+						MaxConns: 10,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			gen := GeneratorImpl{plus: test.plus}
+
+			result := gen.createUpstream(stateUpstream, "")
+			g.Expect(result).To(Equal(test.expectedUpstream))
+		})
+	}
+}
+
+func TestExecuteUpstreams_UpstreamSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	gen := GeneratorImpl{plus: true}
+
+	results := gen.executeUpstreams(dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{
+				Name: "up",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+				Policies: []policies.Policy{
+					&ngfAPI.UpstreamSettingsPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "usp", Namespace: "test"},
+						Spec: ngfAPI.UpstreamSettingsPolicySpec{
+							MaxConnections: helpers.GetPointer[int32](10),
+							Queue: &ngfAPI.UpstreamQueue{
+								Size:    20,
+								Timeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "up-no-timeout",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.2",
+						Port:    80,
+					},
+				},
+				Policies: []policies.Policy{
+					&ngfAPI.UpstreamSettingsPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "usp-no-timeout", Namespace: "test"},
+						Spec: ngfAPI.UpstreamSettingsPolicySpec{
+							Queue: &ngfAPI.UpstreamQueue{Size: 5},
+						},
+					},
+				},
+			},
+			{
+				Name: "up-tuned-keepalive",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.3",
+						Port:    80,
+					},
+				},
+				Policies: []policies.Policy{
+					&ngfAPI.UpstreamSettingsPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "usp-keepalive", Namespace: "test"},
+						Spec: ngfAPI.UpstreamSettingsPolicySpec{
+							LoadBalancingMethod: helpers.GetPointer(ngfAPI.LoadBalancingMethodLeastTimeHeader),
+							KeepAlive: &ngfAPI.UpstreamKeepAlive{
+								Connections: helpers.GetPointer[int32](32),
+								Requests:    helpers.GetPointer[int32](1000),
+								Time:        helpers.GetPointer[ngfAPI.Duration]("1h"),
+								Timeout:     helpers.GetPointer[ngfAPI.Duration]("60s"),
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "up-no-keepalive",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.4",
+						Port:    80,
+					},
+				},
+				Policies: []policies.Policy{
+					&ngfAPI.UpstreamSettingsPolicy{
+						ObjectMeta: metav1.ObjectMeta{Name: "usp-no-keepalive", Namespace: "test"},
+						Spec: ngfAPI.UpstreamSettingsPolicySpec{
+							KeepAlive: &ngfAPI.UpstreamKeepAlive{
+								Connections: helpers.GetPointer[int32](0),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(results).To(HaveLen(1))
+
+	upstreams := string(results[0].data)
+	g.Expect(upstreams).To(ContainSubstring("queue 20 timeout=30s;"))
+	g.Expect(upstreams).To(ContainSubstring("server 10.0.0.1:80 max_conns=10;"))
+	g.Expect(upstreams).To(ContainSubstring("queue 5;"))
+	g.Expect(upstreams).To(ContainSubstring("server 10.0.0.2:80;"))
+	g.Expect(upstreams).To(ContainSubstring("keepalive 16;"))
+	g.Expect(upstreams).To(ContainSubstring("keepalive 32;"))
+	g.Expect(upstreams).To(ContainSubstring("least_time header;"))
+	g.Expect(strings.Count(upstreams, "random two least_conn;")).To(Equal(4))
+	g.Expect(upstreams).To(ContainSubstring("keepalive_requests 1000;"))
+	g.Expect(upstreams).To(ContainSubstring("keepalive_time 1h;"))
+	g.Expect(upstreams).To(ContainSubstring("keepalive_timeout 60s;"))
+	g.Expect(strings.Count(upstreams, "    keepalive ")).To(Equal(3))
+}
+
+func TestExecuteUpstreams_HealthChecks(t *testing.T) {
+	t.Parallel()
+
+	upstreams := []dataplane.Upstream{
+		{
+			Name:      "up-grpc",
+			Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 50051}},
+			Policies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "usp-grpc", Namespace: "test"},
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						HealthCheck: &ngfAPI.UpstreamHealthCheck{
+							Type:        helpers.GetPointer(ngfAPI.HealthCheckTypeGRPC),
+							GRPCService: helpers.GetPointer("helloworld.Greeter"),
+							Interval:    helpers.GetPointer[ngfAPI.Duration]("10s"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:      "up-http",
+			Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 80}},
+			Policies: []policies.Policy{
+				&ngfAPI.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "usp-http", Namespace: "test"},
+					Spec: ngfAPI.UpstreamSettingsPolicySpec{
+						HealthCheck: &ngfAPI.UpstreamHealthCheck{
+							Path:   helpers.GetPointer("/healthz"),
+							Fails:  helpers.GetPointer[int32](3),
+							Passes: helpers.GetPointer[int32](2),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:      "up-no-health-check",
+			Endpoints: []resolver.Endpoint{{Address: "10.0.0.3", Port: 80}},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		expectedSubstrings   []string
+		expectedHealthChecks int
+		plus                 bool
+	}{
+		{
+			name: "NGINX Plus",
+			expectedSubstrings: []string{
+				"listen unix:/var/run/nginx/nginx-health-check-server.sock;",
+				"location @ngf-health-check-up-grpc {\n" +
+					"        grpc_pass grpc://up-grpc;\n" +
+					"        health_check type=grpc grpc_service=helloworld.Greeter interval=10s;\n" +
+					"    }",
+				"location @ngf-health-check-up-http {\n" +
+					"        proxy_pass http://up-http;\n" +
+					"        health_check uri=/healthz fails=3 passes=2;\n" +
+					"    }",
+			},
+			expectedHealthChecks: 2,
+			plus:                 true,
+		},
+		{
+			name: "NGINX OSS",
+			plus: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{plus: test.plus}
+			results := gen.executeUpstreams(dataplane.Configuration{Upstreams: upstreams})
+			g.Expect(results).To(HaveLen(1))
+
+			conf := string(results[0].data)
+			for _, expSubString := range test.expectedSubstrings {
+				g.Expect(conf).To(ContainSubstring(expSubString))
+			}
+
+			g.Expect(strings.Count(conf, "health_check ")).To(Equal(test.expectedHealthChecks))
+			g.Expect(conf).ToNot(ContainSubstring("@ngf-health-check-up-no-health-check"))
+		})
+	}
+}
+
+func TestUsesStateFile(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(UsesStateFile(dataplane.Upstream{Endpoints: make([]resolver.Endpoint, 500)})).To(BeFalse())
+	g.Expect(UsesStateFile(dataplane.Upstream{Endpoints: make([]resolver.Endpoint, 501)})).To(BeTrue())
+}
+
 func TestExecuteStreamUpstreams(t *testing.T) {
 	t.Parallel()
 	gen := GeneratorImpl{}
@@ -354,7 +717,7 @@ func TestExecuteStreamUpstreams(t *testing.T) {
 	g.Expect(upstreamResults).To(HaveLen(1))
 	upstreams := string(upstreamResults[0].data)
 
-	g.Expect(upstreamResults[0].dest).To(Equal(streamConfigFile))
+	g.Expect(upstreamResults[0].dest).To(Equal(streamUpstreamsFile))
 	for _, expSubString := range expectedSubStrings {
 		g.Expect(upstreams).To(ContainSubstring(expSubString))
 	}
@@ -403,7 +766,7 @@ func TestCreateStreamUpstreams(t *testing.T) {
 	expUpstreams := []stream.Upstream{
 		{
 			Name:     "up1",
-			ZoneSize: ossZoneSize,
+			ZoneSize: "512k",
 			Servers: []stream.UpstreamServer{
 				{
 					Address: "10.0.0.0:80",
@@ -421,7 +784,7 @@ func TestCreateStreamUpstreams(t *testing.T) {
 		},
 		{
 			Name:     "up2",
-			ZoneSize: ossZoneSize,
+			ZoneSize: "512k",
 			Servers: []stream.UpstreamServer{
 				{
 					Address: "11.0.0.0:80",
@@ -431,7 +794,7 @@ func TestCreateStreamUpstreams(t *testing.T) {
 	}
 
 	g := NewWithT(t)
-	result := gen.createStreamUpstreams(stateUpstreams)
+	result := gen.createStreamUpstreams(stateUpstreams, "")
 	g.Expect(result).To(Equal(expUpstreams))
 }
 
@@ -458,7 +821,7 @@ func TestCreateStreamUpstream(t *testing.T) {
 
 	expectedUpstream := stream.Upstream{
 		Name:     "multiple-endpoints",
-		ZoneSize: ossZoneSize,
+		ZoneSize: "512k",
 		Servers: []stream.UpstreamServer{
 			{
 				Address: "10.0.0.1:80",
@@ -473,7 +836,7 @@ func TestCreateStreamUpstream(t *testing.T) {
 	}
 
 	g := NewWithT(t)
-	result := gen.createStreamUpstream(up)
+	result := gen.createStreamUpstream(up, "")
 	g.Expect(result).To(Equal(expectedUpstream))
 }
 
@@ -492,7 +855,7 @@ func TestCreateStreamUpstreamPlus(t *testing.T) {
 	}
 	expectedUpstream := stream.Upstream{
 		Name:     "multiple-endpoints",
-		ZoneSize: plusZoneSize,
+		ZoneSize: "1m",
 		Servers: []stream.UpstreamServer{
 			{
 				Address: "10.0.0.1:80",
@@ -500,8 +863,131 @@ func TestCreateStreamUpstreamPlus(t *testing.T) {
 		},
 	}
 
-	result := gen.createStreamUpstream(stateUpstream)
+	result := gen.createStreamUpstream(stateUpstream, "")
 
 	g := NewWithT(t)
 	g.Expect(result).To(Equal(expectedUpstream))
 }
+
+func TestUpstreamZoneSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		msg     string
+		expSize string
+		zone    upstreamZone
+		servers int
+	}{
+		{
+			msg:     "no servers",
+			zone:    ossZone,
+			servers: 0,
+			expSize: "512k",
+		},
+		{
+			msg:     "servers fit the base zone",
+			zone:    ossZone,
+			servers: 648,
+			expSize: "512k",
+		},
+		{
+			msg:     "servers overflow the base zone",
+			zone:    ossZone,
+			servers: 649,
+			expSize: "1m",
+		},
+		{
+			msg:     "servers overflow the base zone multiple times",
+			zone:    ossZone,
+			servers: 1945,
+			expSize: "2m",
+		},
+		{
+			msg:     "servers overflow the base zone with an odd multiplier",
+			zone:    ossZoneStream,
+			servers: 1153,
+			expSize: "1536k",
+		},
+		{
+			msg:     "plus servers overflow the base zone",
+			zone:    plusZone,
+			servers: 1000,
+			expSize: "2m",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(test.zone.size(test.servers)).To(Equal(test.expSize))
+		})
+	}
+}
+
+func TestExecuteUpstreams_ZoneSize(t *testing.T) {
+	t.Parallel()
+
+	endpoints := make([]resolver.Endpoint, 700)
+	for i := range endpoints {
+		endpoints[i] = resolver.Endpoint{Address: fmt.Sprintf("10.0.%d.%d", i/256, i%256), Port: 80}
+	}
+
+	upstreams := []dataplane.Upstream{
+		{
+			Name:      "small",
+			Endpoints: endpoints[:1],
+		},
+		{
+			Name:      "large",
+			Endpoints: endpoints,
+		},
+	}
+
+	tests := []struct {
+		msg              string
+		zoneSizeOverride string
+		expStrings       []string
+	}{
+		{
+			msg: "calculated zone size",
+			expStrings: []string{
+				"zone small 512k;",
+				"zone large 1m;",
+			},
+		},
+		{
+			msg:              "zone size override",
+			zoneSizeOverride: "4m",
+			expStrings: []string{
+				"zone small 4m;",
+				"zone large 4m;",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			gen := GeneratorImpl{}
+
+			conf := dataplane.Configuration{
+				Upstreams:        upstreams,
+				StreamUpstreams:  upstreams,
+				UpstreamZoneSize: test.zoneSizeOverride,
+			}
+
+			results := gen.executeUpstreams(conf)
+			g.Expect(results).To(HaveLen(1))
+			for _, expString := range test.expStrings {
+				g.Expect(string(results[0].data)).To(ContainSubstring(expString))
+			}
+
+			streamResults := gen.executeStreamUpstreams(conf)
+			g.Expect(streamResults).To(HaveLen(1))
+			for _, expString := range test.expStrings {
+				g.Expect(string(streamResults[0].data)).To(ContainSubstring(expString))
+			}
+		})
+	}
+}
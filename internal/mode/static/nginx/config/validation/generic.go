@@ -2,13 +2,17 @@ package validation
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 // GenericValidator validates values for generic cases in the nginx conf.
-type GenericValidator struct{}
+type GenericValidator struct {
+	// Plus indicates whether NGINX Plus is used.
+	Plus bool
+}
 
 // ValidateEscapedStringNoVarExpansion ensures that no invalid characters are included in the string value that
 // could lead to unwanted nginx behavior.
@@ -106,3 +110,37 @@ func (GenericValidator) ValidateEndpoint(endpoint string) error {
 
 	return nil
 }
+
+// returnBodyAllowedVars are the variables that can be referenced in the body of a return directive.
+var returnBodyAllowedVars = map[string]struct{}{
+	"host":        {},
+	"request_uri": {},
+}
+
+var returnBodyVarRegexp = regexp.MustCompile(`\$\{?([a-zA-Z0-9_]*)`)
+
+// ValidateServerHeaderValue validates the value of the Server response header. Only NGINX Plus can set the value,
+// which must be an escaped string without variables.
+func (v GenericValidator) ValidateServerHeaderValue(value string) error {
+	if !v.Plus {
+		return errors.New("setting the value of the Server header is only supported by NGINX Plus")
+	}
+
+	return v.ValidateEscapedStringNoVarExpansion(value)
+}
+
+// ValidateReturnBody validates the body of a return directive. The body must be an escaped string and can only
+// reference the $host and $request_uri variables.
+func (GenericValidator) ValidateReturnBody(body string) error {
+	if err := validateEscapedString(body, []string{"Not found", "No route for $host"}); err != nil {
+		return err
+	}
+
+	for _, match := range returnBodyVarRegexp.FindAllStringSubmatch(body, -1) {
+		if _, ok := returnBodyAllowedVars[match[1]]; !ok {
+			return fmt.Errorf("unsupported variable %q; supported variables are: $host, $request_uri", match[0])
+		}
+	}
+
+	return nil
+}
@@ -112,3 +112,53 @@ func TestValidateEndpoint(t *testing.T) {
 		`my$endpoint`,
 	)
 }
+
+func TestValidateServerHeaderValue(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{Plus: true}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateServerHeaderValue,
+		`my-server`,
+		`My Server 1.0`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateServerHeaderValue,
+		`"my-server"`,
+		`$hostname`,
+	)
+
+	// only NGINX Plus can set the value of the Server header
+	testInvalidValuesForSimpleValidator(
+		t,
+		GenericValidator{}.ValidateServerHeaderValue,
+		`my-server`,
+	)
+}
+
+func TestValidateReturnBody(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateReturnBody,
+		``,
+		`Not found`,
+		`No route for $host$request_uri`,
+		`No route for ${host}`,
+		`\"escaped\"`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateReturnBody,
+		`"unescaped"`,
+		`trailing\`,
+		`$remote_addr`,
+		`$`,
+	)
+}
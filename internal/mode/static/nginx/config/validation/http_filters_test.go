@@ -44,7 +44,9 @@ func TestValidateRedirectStatusCode(t *testing.T) {
 		t,
 		validator.ValidateRedirectStatusCode,
 		301,
-		302)
+		302,
+		307,
+		308)
 
 	testInvalidValuesForSupportedValuesValidator(
 		t,
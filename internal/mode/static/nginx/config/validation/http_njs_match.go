@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
@@ -29,6 +30,20 @@ func (HTTPNJSMatchValidator) ValidatePathInMatch(path string) error {
 	return nil
 }
 
+// ValidatePathRegexInMatch validates a regular expression path used in the location directive.
+// NGINX uses PCRE, so the expression must be valid in both RE2 and PCRE, which is true for most expressions.
+func (HTTPNJSMatchValidator) ValidatePathRegexInMatch(regex string) error {
+	if regex == "" {
+		return errors.New("cannot be empty")
+	}
+
+	if _, err := regexp.Compile(regex); err != nil {
+		return fmt.Errorf("must be a valid regular expression: %w", err)
+	}
+
+	return nil
+}
+
 func (HTTPNJSMatchValidator) ValidateHeaderNameInMatch(name string) error {
 	if err := k8svalidation.IsHTTPHeaderName(name); err != nil {
 		return errors.New(err[0])
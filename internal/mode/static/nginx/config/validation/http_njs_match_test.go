@@ -28,6 +28,27 @@ func TestValidatePathInMatch(t *testing.T) {
 	)
 }
 
+func TestValidatePathRegexInMatch(t *testing.T) {
+	t.Parallel()
+	validator := HTTPNJSMatchValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"/v[0-9]+/.*",
+		"^/api/(users|orders)/[a-z]{2,4}$",
+		`/files/.*\.(jpg|png)`,
+		`/say/"hello"`,
+	)
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidatePathRegexInMatch,
+		"/v[0-9+/",
+		"/(api",
+		"",
+	)
+}
+
 func TestValidateHeaderNameInMatch(t *testing.T) {
 	t.Parallel()
 	validator := HTTPNJSMatchValidator{}
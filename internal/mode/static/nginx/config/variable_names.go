@@ -19,3 +19,37 @@ func convertStringToSafeVariableName(s string) string {
 func generateAddHeaderMapVariableName(name string) string {
 	return strings.ToLower(convertStringToSafeVariableName(name)) + "_header_var"
 }
+
+// generateConditionalResponseHeaderVariableName generates the variable name of the map that evaluates to the value
+// of a conditional response header if the response matches the condition of the header, or to an empty string.
+func generateConditionalResponseHeaderVariableName(id string) string {
+	return "response_header_" + id
+}
+
+// generateUpstreamHeaderVariableName generates the name of the NGINX variable of a header of the upstream response.
+func generateUpstreamHeaderVariableName(name string) string {
+	return "upstream_http_" + strings.ToLower(convertStringToSafeVariableName(name))
+}
+
+// generateRequestHeaderVariableName generates the name of the NGINX variable of a header of the request.
+func generateRequestHeaderVariableName(name string) string {
+	return "http_" + strings.ToLower(convertStringToSafeVariableName(name))
+}
+
+// generateABTestKeyVariableName generates the variable name of the map that evaluates to the key that splits
+// the requests of a backend group with an ABTest: the value of the cookie, or a new key if the cookie is not set.
+func generateABTestKeyVariableName(groupVariable string) string {
+	return "ngf_abtest_key_" + groupVariable
+}
+
+// generateABTestCookieVariableName generates the variable name of the map that evaluates to the Set-Cookie header
+// that stores a new key of a backend group with an ABTest, or to an empty string if the cookie is set.
+func generateABTestCookieVariableName(groupVariable string) string {
+	return "ngf_abtest_cookie_" + groupVariable
+}
+
+// generateABTestSplitVariableName generates the variable name of the split client of a backend group with an ABTest
+// with an override header. The backend group variable is then set by the map of the override header.
+func generateABTestSplitVariableName(groupVariable string) string {
+	return "ngf_abtest_split_" + groupVariable
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// workerPool runs the tasks of the generation of the configuration on a bounded number of goroutines, so that
+// the generation of large configurations uses the cores of the host without starting a goroutine per task.
+// The zero value runs the tasks on the calling goroutine.
+type workerPool struct {
+	// slots limits the number of the goroutines that run the tasks in addition to the calling goroutines.
+	slots chan struct{}
+}
+
+// newWorkerPool returns a workerPool that runs the tasks on up to the workers goroutines at a time.
+func newWorkerPool(workers int) workerPool {
+	if workers <= 1 {
+		return workerPool{}
+	}
+
+	// the calling goroutine is one of the workers
+	return workerPool{slots: make(chan struct{}, workers-1)}
+}
+
+// runInPool calls run for every item on the goroutines of the pool and returns the results in the order of
+// the items, so that the generated configuration is stable.
+//
+// The calling goroutine runs the items too, and the items are only run on other goroutines while the pool has
+// free slots. Because of that, the items can call runInPool themselves without waiting for each other.
+//
+// If run panics, the remaining items are not run, and runInPool panics with the same value on the calling goroutine
+// after all the goroutines finish, so that the caller can recover from the panic.
+func runInPool[T, R any](pool workerPool, items []T, run func(idx int, item T) R) []R {
+	results := make([]R, len(items))
+
+	var (
+		next atomic.Int64
+
+		panicOnce  sync.Once
+		panicked   bool
+		panicValue any
+	)
+
+	work := func() {
+		defer func() {
+			if r := recover(); r != nil {
+				panicOnce.Do(func() {
+					panicked = true
+					panicValue = r
+				})
+				// skip the remaining items
+				next.Store(int64(len(items)))
+			}
+		}()
+
+		for idx := int(next.Add(1) - 1); idx < len(items); idx = int(next.Add(1) - 1) {
+			results[idx] = run(idx, items[idx])
+		}
+	}
+
+	var wg sync.WaitGroup
+
+acquire:
+	for range len(items) - 1 {
+		select {
+		case pool.slots <- struct{}{}:
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-pool.slots
+					wg.Done()
+				}()
+				work()
+			}()
+		default:
+			break acquire
+		}
+	}
+
+	work()
+	wg.Wait()
+
+	if panicked {
+		panic(panicValue)
+	}
+
+	return results
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestRunInPool(t *testing.T) {
+	t.Parallel()
+
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+
+	expResults := make([]int, len(items))
+	for i := range expResults {
+		expResults[i] = i * 2
+	}
+
+	tests := []struct {
+		name string
+		pool workerPool
+	}{
+		{
+			name: "zero value",
+			pool: workerPool{},
+		},
+		{
+			name: "single worker",
+			pool: newWorkerPool(1),
+		},
+		{
+			name: "multiple workers",
+			pool: newWorkerPool(4),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			results := runInPool(test.pool, items, func(idx int, item int) int {
+				g.Expect(idx).To(Equal(item))
+				return item * 2
+			})
+
+			g.Expect(results).To(Equal(expResults))
+		})
+	}
+}
+
+func TestRunInPool_Nested(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pool := newWorkerPool(2)
+
+	var runs atomic.Int64
+
+	results := runInPool(pool, []int{1, 2, 3, 4}, func(_ int, item int) int {
+		nested := runInPool(pool, []int{item, item}, func(_ int, nestedItem int) int {
+			runs.Add(1)
+			return nestedItem
+		})
+
+		return nested[0] + nested[1]
+	})
+
+	g.Expect(results).To(Equal([]int{2, 4, 6, 8}))
+	g.Expect(runs.Load()).To(Equal(int64(8)))
+}
+
+func TestRunInPool_NoItems(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	results := runInPool(newWorkerPool(4), nil, func(_ int, _ int) int {
+		return 0
+	})
+
+	g.Expect(results).To(BeEmpty())
+}
+
+func TestRunInPool_Panic(t *testing.T) {
+	t.Parallel()
+
+	execute := func(dataplane.Configuration) []executeResult {
+		return []executeResult{{dest: httpConfigFile}}
+	}
+	panicking := func(dataplane.Configuration) []executeResult {
+		panic("failed to execute template")
+	}
+
+	tests := []struct {
+		name string
+		pool workerPool
+	}{
+		{
+			name: "zero value",
+			pool: workerPool{},
+		},
+		{
+			name: "multiple workers",
+			pool: newWorkerPool(4),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			executeFuncs := []executeFunc{execute, execute, panicking, execute, execute, panicking, execute}
+
+			runExecuteFuncs := func() {
+				runInPool(test.pool, executeFuncs, func(_ int, f executeFunc) []executeResult {
+					return f(dataplane.Configuration{})
+				})
+			}
+
+			// the panic of a worker goroutine is raised on the calling goroutine, so that the caller can recover
+			g.Expect(runExecuteFuncs).To(PanicWith("failed to execute template"))
+		})
+	}
+}
+
+func TestRunInPool_NestedPanic(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pool := newWorkerPool(2)
+
+	run := func() {
+		runInPool(pool, []int{1, 2, 3, 4}, func(_ int, item int) int {
+			nested := runInPool(pool, []int{item, item}, func(_ int, nestedItem int) int {
+				if nestedItem == 3 {
+					panic("nested panic")
+				}
+
+				return nestedItem
+			})
+
+			return nested[0] + nested[1]
+		})
+	}
+
+	g.Expect(run).To(PanicWith("nested panic"))
+
+	// the slots of the pool are released
+	g.Expect(pool.slots).To(BeEmpty())
+}
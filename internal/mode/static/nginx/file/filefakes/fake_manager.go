@@ -8,6 +8,18 @@ import (
 )
 
 type FakeManager struct {
+	ChangedFilesStub        func() ([]string, error)
+	changedFilesMutex       sync.RWMutex
+	changedFilesArgsForCall []struct {
+	}
+	changedFilesReturns struct {
+		result1 []string
+		result2 error
+	}
+	changedFilesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	ReplaceFilesStub        func([]file.File) error
 	replaceFilesMutex       sync.RWMutex
 	replaceFilesArgsForCall []struct {
@@ -19,10 +31,77 @@ type FakeManager struct {
 	replaceFilesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateFilesStub        func([]file.File) error
+	updateFilesMutex       sync.RWMutex
+	updateFilesArgsForCall []struct {
+		arg1 []file.File
+	}
+	updateFilesReturns struct {
+		result1 error
+	}
+	updateFilesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeManager) ChangedFiles() ([]string, error) {
+	fake.changedFilesMutex.Lock()
+	ret, specificReturn := fake.changedFilesReturnsOnCall[len(fake.changedFilesArgsForCall)]
+	fake.changedFilesArgsForCall = append(fake.changedFilesArgsForCall, struct {
+	}{})
+	stub := fake.ChangedFilesStub
+	fakeReturns := fake.changedFilesReturns
+	fake.recordInvocation("ChangedFiles", []interface{}{})
+	fake.changedFilesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) ChangedFilesCallCount() int {
+	fake.changedFilesMutex.RLock()
+	defer fake.changedFilesMutex.RUnlock()
+	return len(fake.changedFilesArgsForCall)
+}
+
+func (fake *FakeManager) ChangedFilesCalls(stub func() ([]string, error)) {
+	fake.changedFilesMutex.Lock()
+	defer fake.changedFilesMutex.Unlock()
+	fake.ChangedFilesStub = stub
+}
+
+func (fake *FakeManager) ChangedFilesReturns(result1 []string, result2 error) {
+	fake.changedFilesMutex.Lock()
+	defer fake.changedFilesMutex.Unlock()
+	fake.ChangedFilesStub = nil
+	fake.changedFilesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ChangedFilesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.changedFilesMutex.Lock()
+	defer fake.changedFilesMutex.Unlock()
+	fake.ChangedFilesStub = nil
+	if fake.changedFilesReturnsOnCall == nil {
+		fake.changedFilesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.changedFilesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) ReplaceFiles(arg1 []file.File) error {
 	var arg1Copy []file.File
 	if arg1 != nil {
@@ -89,11 +168,81 @@ func (fake *FakeManager) ReplaceFilesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) UpdateFiles(arg1 []file.File) error {
+	var arg1Copy []file.File
+	if arg1 != nil {
+		arg1Copy = make([]file.File, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.updateFilesMutex.Lock()
+	ret, specificReturn := fake.updateFilesReturnsOnCall[len(fake.updateFilesArgsForCall)]
+	fake.updateFilesArgsForCall = append(fake.updateFilesArgsForCall, struct {
+		arg1 []file.File
+	}{arg1Copy})
+	stub := fake.UpdateFilesStub
+	fakeReturns := fake.updateFilesReturns
+	fake.recordInvocation("UpdateFiles", []interface{}{arg1Copy})
+	fake.updateFilesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManager) UpdateFilesCallCount() int {
+	fake.updateFilesMutex.RLock()
+	defer fake.updateFilesMutex.RUnlock()
+	return len(fake.updateFilesArgsForCall)
+}
+
+func (fake *FakeManager) UpdateFilesCalls(stub func([]file.File) error) {
+	fake.updateFilesMutex.Lock()
+	defer fake.updateFilesMutex.Unlock()
+	fake.UpdateFilesStub = stub
+}
+
+func (fake *FakeManager) UpdateFilesArgsForCall(i int) []file.File {
+	fake.updateFilesMutex.RLock()
+	defer fake.updateFilesMutex.RUnlock()
+	argsForCall := fake.updateFilesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManager) UpdateFilesReturns(result1 error) {
+	fake.updateFilesMutex.Lock()
+	defer fake.updateFilesMutex.Unlock()
+	fake.UpdateFilesStub = nil
+	fake.updateFilesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) UpdateFilesReturnsOnCall(i int, result1 error) {
+	fake.updateFilesMutex.Lock()
+	defer fake.updateFilesMutex.Unlock()
+	fake.UpdateFilesStub = nil
+	if fake.updateFilesReturnsOnCall == nil {
+		fake.updateFilesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateFilesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.changedFilesMutex.RLock()
+	defer fake.changedFilesMutex.RUnlock()
 	fake.replaceFilesMutex.RLock()
 	defer fake.replaceFilesMutex.RUnlock()
+	fake.updateFilesMutex.RLock()
+	defer fake.updateFilesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
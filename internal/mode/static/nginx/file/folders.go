@@ -20,6 +20,8 @@ type ClearFoldersOSFileManager interface {
 }
 
 // ClearFolders removes all files in the given folders and returns the removed files' full paths.
+// It verifies that the folders are empty afterward, so that no stale files, for example, includes generated by
+// a previous version, remain and break the NGINX configuration that references them.
 func ClearFolders(fileMgr ClearFoldersOSFileManager, paths []string) (removedFiles []string, e error) {
 	for _, path := range paths {
 		entries, err := fileMgr.ReadDir(path)
@@ -35,6 +37,20 @@ func ClearFolders(fileMgr ClearFoldersOSFileManager, paths []string) (removedFil
 
 			removedFiles = append(removedFiles, entryPath)
 		}
+
+		remaining, err := fileMgr.ReadDir(path)
+		if err != nil {
+			return removedFiles, fmt.Errorf("failed to read directory %q: %w", path, err)
+		}
+
+		if len(remaining) > 0 {
+			names := make([]string, 0, len(remaining))
+			for _, entry := range remaining {
+				names = append(names, entry.Name())
+			}
+
+			return removedFiles, fmt.Errorf("directory %q still contains stale files: %v", path, names)
+		}
 	}
 
 	return removedFiles, nil
@@ -90,3 +90,26 @@ func TestClearFoldersFails(t *testing.T) {
 		})
 	}
 }
+
+func TestClearFoldersFailsWhenStaleFilesRemain(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fileMgr := &filefakes.FakeClearFoldersOSFileManager{
+		ReadDirStub: func(_ string) ([]os.DirEntry, error) {
+			return []os.DirEntry{
+				&filefakes.FakeDirEntry{
+					NameStub: func() string {
+						return "stale.conf"
+					},
+				},
+			}, nil
+		},
+	}
+
+	removedFiles, err := file.ClearFolders(fileMgr, []string{"folder"})
+
+	g.Expect(err).To(MatchError(ContainSubstring("stale.conf")))
+	g.Expect(removedFiles).To(Equal([]string{filepath.Join("folder", "stale.conf")}))
+	g.Expect(fileMgr.ReadDirCallCount()).To(Equal(2))
+}
@@ -1,6 +1,7 @@
 package file
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -61,22 +62,32 @@ type OSFileManager interface {
 	Chmod(file *os.File, mode os.FileMode) error
 	// Write writes contents to the file.
 	Write(file *os.File, contents []byte) error
+	// ReadFile returns the contents of the file.
+	ReadFile(name string) ([]byte, error)
 }
 
 //counterfeiter:generate . Manager
 
 // Manager manages NGINX configuration files.
 type Manager interface {
-	// ReplaceFiles replaces the files on the file system with the given files removing any previous files.
+	// ReplaceFiles replaces the files on the file system with the given files removing any previous files
+	// that are not among them.
 	ReplaceFiles(files []File) error
+	// UpdateFiles writes the given files to the file system without removing any previous files.
+	UpdateFiles(files []File) error
+	// ChangedFiles returns the paths of the files written by the last ReplaceFiles and UpdateFiles calls
+	// that no longer exist or whose contents changed since.
+	ChangedFiles() ([]string, error)
 }
 
 // ManagerImpl is an implementation of Manager.
 // Note: It is not thread safe.
 type ManagerImpl struct {
-	logger           logr.Logger
-	osFileManager    OSFileManager
-	lastWrittenPaths []string
+	logger        logr.Logger
+	osFileManager OSFileManager
+	// lastWrittenChecksums are the SHA-256 checksums of the contents of the last written files, keyed by their paths.
+	lastWrittenChecksums map[string][sha256.Size]byte
+	lastWrittenPaths     []string
 }
 
 // NewManagerImpl creates a new NewManagerImpl.
@@ -88,9 +99,37 @@ func NewManagerImpl(logger logr.Logger, osFileManager OSFileManager) *ManagerImp
 }
 
 // ReplaceFiles replaces the files on the file system with the given files removing any previous files.
+// It writes the given files first and then removes the previously written files that are not among them (orphaned
+// files), so that the files are never missing in between. The manager owns the files that it wrote: if
+// ReplaceFiles fails, it keeps owning both the previous files and the written ones, so that the next call removes
+// the ones that are no longer generated.
 // It panics if a file type is unknown.
 func (m *ManagerImpl) ReplaceFiles(files []File) error {
-	for _, path := range m.lastWrittenPaths {
+	writtenPaths := make([]string, 0, len(files))
+	writtenChecksums := make(map[string][sha256.Size]byte, len(files))
+
+	for _, file := range files {
+		if err := writeFile(m.osFileManager, file); err != nil {
+			m.trackOwnedFiles(m.lastWrittenPaths, writtenPaths, writtenChecksums)
+			return fmt.Errorf("failed to write file %q of type %v: %w", file.Path, file.Type, err)
+		}
+
+		if _, exists := writtenChecksums[file.Path]; !exists {
+			writtenPaths = append(writtenPaths, file.Path)
+		}
+		writtenChecksums[file.Path] = sha256.Sum256(file.Content)
+		m.logger.V(1).Info("Wrote file", "path", file.Path)
+	}
+
+	// In some cases, NGINX reads files in runtime, like a JWK. If you remove such file, NGINX will fail
+	// any request (return 500 status code) that involves reading the file.
+	// However, we don't have such files yet, so we're not considering this case.
+
+	for i, path := range m.lastWrittenPaths {
+		if _, exists := writtenChecksums[path]; exists {
+			continue
+		}
+
 		if err := m.osFileManager.Remove(path); err != nil {
 			if os.IsNotExist(err) {
 				m.logger.Info(
@@ -100,30 +139,96 @@ func (m *ManagerImpl) ReplaceFiles(files []File) error {
 				)
 				continue
 			}
+
+			m.trackOwnedFiles(m.lastWrittenPaths[i:], writtenPaths, writtenChecksums)
 			return fmt.Errorf("failed to delete file %q: %w", path, err)
 		}
 
-		m.logger.V(1).Info("Deleted file", "path", path)
+		m.logger.V(1).Info("Deleted orphaned file", "path", path)
 	}
 
-	// In some cases, NGINX reads files in runtime, like a JWK. If you remove such file, NGINX will fail
-	// any request (return 500 status code) that involves reading the file.
-	// However, we don't have such files yet, so we're not considering this case.
+	m.lastWrittenPaths = writtenPaths
+	m.lastWrittenChecksums = writtenChecksums
+
+	return nil
+}
+
+// UpdateFiles writes the given files to the file system without removing any previous files. It is used to
+// update a part of the files written by ReplaceFiles, like the files of the upstreams when only their endpoints
+// change. The manager owns the written files in addition to the previous ones, even if UpdateFiles fails.
+// It panics if a file type is unknown.
+func (m *ManagerImpl) UpdateFiles(files []File) error {
+	writtenPaths := make([]string, 0, len(files))
+	writtenChecksums := make(map[string][sha256.Size]byte, len(files))
 
-	m.lastWrittenPaths = make([]string, 0, len(files))
+	defer func() {
+		m.trackOwnedFiles(m.lastWrittenPaths, writtenPaths, writtenChecksums)
+	}()
 
 	for _, file := range files {
 		if err := writeFile(m.osFileManager, file); err != nil {
 			return fmt.Errorf("failed to write file %q of type %v: %w", file.Path, file.Type, err)
 		}
 
-		m.lastWrittenPaths = append(m.lastWrittenPaths, file.Path)
+		if _, exists := writtenChecksums[file.Path]; !exists {
+			writtenPaths = append(writtenPaths, file.Path)
+		}
+		writtenChecksums[file.Path] = sha256.Sum256(file.Content)
 		m.logger.V(1).Info("Wrote file", "path", file.Path)
 	}
 
 	return nil
 }
 
+// trackOwnedFiles makes the manager own the previously written files that were not removed and the written files.
+func (m *ManagerImpl) trackOwnedFiles(
+	notRemovedPaths []string,
+	writtenPaths []string,
+	writtenChecksums map[string][sha256.Size]byte,
+) {
+	ownedPaths := make([]string, 0, len(notRemovedPaths)+len(writtenPaths))
+	ownedChecksums := make(map[string][sha256.Size]byte, len(notRemovedPaths)+len(writtenPaths))
+
+	for _, path := range notRemovedPaths {
+		ownedPaths = append(ownedPaths, path)
+		ownedChecksums[path] = m.lastWrittenChecksums[path]
+	}
+
+	for _, path := range writtenPaths {
+		if _, exists := ownedChecksums[path]; !exists {
+			ownedPaths = append(ownedPaths, path)
+		}
+		ownedChecksums[path] = writtenChecksums[path]
+	}
+
+	m.lastWrittenPaths = ownedPaths
+	m.lastWrittenChecksums = ownedChecksums
+}
+
+// ChangedFiles returns the paths of the files written by the last ReplaceFiles and UpdateFiles calls that no longer
+// exist or whose contents changed since, for example, because the files were edited manually.
+func (m *ManagerImpl) ChangedFiles() ([]string, error) {
+	var changed []string
+
+	for _, path := range m.lastWrittenPaths {
+		content, err := m.osFileManager.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				changed = append(changed, path)
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+		}
+
+		if sha256.Sum256(content) != m.lastWrittenChecksums[path] {
+			changed = append(changed, path)
+		}
+	}
+
+	return changed, nil
+}
+
 func writeFile(fileMgr OSFileManager, file File) error {
 	ensureType(file.Type)
 
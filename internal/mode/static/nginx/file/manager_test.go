@@ -108,11 +108,58 @@ var _ = Describe("EventHandler", func() {
 			ensureNotExist(regular1)
 		})
 
+		It("should report no changed files", func() {
+			changed, err := mgr.ChangedFiles()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeEmpty())
+		})
+
+		It("should report changed and removed files", func() {
+			Expect(os.WriteFile(regular2.Path, []byte("changed"), 0o644)).To(Succeed())
+			Expect(os.Remove(secret.Path)).To(Succeed())
+
+			changed, err := mgr.ChangedFiles()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(Equal([]string{regular2.Path, secret.Path}))
+		})
+
 		It("should remove all files", func() {
+			files := []file.File{regular2, regular3, secret}
+
+			// restore the files changed in the previous test
+			Expect(mgr.ReplaceFiles(files)).To(Succeed())
+			ensureFiles(files)
+
 			err := mgr.ReplaceFiles(nil)
 			Expect(err).ToNot(HaveOccurred())
 
 			ensureNotExist(regular2, regular3, secret)
+
+			changed, err := mgr.ChangedFiles()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeEmpty())
+		})
+	})
+
+	When("a written file can't be read", func() {
+		It("should return an error", func() {
+			fakeOSMgr := &filefakes.FakeOSFileManager{}
+			fakeOSMgr.ReadFileReturns(nil, errors.New("test error"))
+			mgr := file.NewManagerImpl(zap.New(), fakeOSMgr)
+
+			files := []file.File{
+				{
+					Type:    file.TypeRegular,
+					Path:    "regular-1.conf",
+					Content: []byte("regular-1"),
+				},
+			}
+
+			Expect(mgr.ReplaceFiles(files)).To(Succeed())
+
+			changed, err := mgr.ChangedFiles()
+			Expect(err).To(MatchError(ContainSubstring("test error")))
+			Expect(changed).To(BeNil())
 		})
 	})
 
@@ -132,7 +179,98 @@ var _ = Describe("EventHandler", func() {
 			Expect(mgr.ReplaceFiles(files)).ToNot(HaveOccurred())
 
 			fakeOSMgr.RemoveReturns(os.ErrNotExist)
-			Expect(mgr.ReplaceFiles(files)).ToNot(HaveOccurred())
+			Expect(mgr.ReplaceFiles(nil)).ToNot(HaveOccurred())
+			Expect(fakeOSMgr.RemoveCallCount()).To(Equal(1))
+		})
+	})
+
+	When("files are replaced", func() {
+		var (
+			fakeOSMgr *filefakes.FakeOSFileManager
+			mgr       *file.ManagerImpl
+		)
+
+		newFile := func(path string) file.File {
+			return file.File{
+				Type:    file.TypeRegular,
+				Path:    path,
+				Content: []byte(path),
+			}
+		}
+
+		removedPaths := func() []string {
+			paths := make([]string, 0, fakeOSMgr.RemoveCallCount())
+			for i := range fakeOSMgr.RemoveCallCount() {
+				paths = append(paths, fakeOSMgr.RemoveArgsForCall(i))
+			}
+			return paths
+		}
+
+		BeforeEach(func() {
+			fakeOSMgr = &filefakes.FakeOSFileManager{}
+			mgr = file.NewManagerImpl(zap.New(), fakeOSMgr)
+		})
+
+		It("should only remove orphaned files", func() {
+			Expect(mgr.ReplaceFiles([]file.File{newFile("a.conf"), newFile("b.conf")})).To(Succeed())
+			Expect(mgr.ReplaceFiles([]file.File{newFile("b.conf"), newFile("c.conf")})).To(Succeed())
+
+			Expect(removedPaths()).To(Equal([]string{"a.conf"}))
+		})
+
+		It("should remove the written files on the next call after a write fails", func() {
+			Expect(mgr.ReplaceFiles([]file.File{newFile("a.conf")})).To(Succeed())
+
+			fakeOSMgr.CreateStub = func(name string) (*os.File, error) {
+				if name == "c.conf" {
+					return nil, errors.New("test error")
+				}
+				return nil, nil
+			}
+			Expect(mgr.ReplaceFiles([]file.File{newFile("b.conf"), newFile("c.conf")})).ToNot(Succeed())
+			Expect(fakeOSMgr.RemoveCallCount()).To(BeZero())
+
+			fakeOSMgr.CreateStub = nil
+			Expect(mgr.ReplaceFiles(nil)).To(Succeed())
+
+			Expect(removedPaths()).To(Equal([]string{"a.conf", "b.conf"}))
+		})
+
+		It("should retry removing the files that were not removed after a remove fails", func() {
+			Expect(mgr.ReplaceFiles([]file.File{newFile("a.conf"), newFile("b.conf")})).To(Succeed())
+
+			fakeOSMgr.RemoveReturnsOnCall(0, errors.New("test error"))
+			Expect(mgr.ReplaceFiles([]file.File{newFile("c.conf")})).ToNot(Succeed())
+
+			Expect(mgr.ReplaceFiles(nil)).To(Succeed())
+
+			Expect(removedPaths()).To(Equal([]string{"a.conf", "a.conf", "b.conf", "c.conf"}))
+		})
+
+		It("should not remove any files when files are updated", func() {
+			Expect(mgr.ReplaceFiles([]file.File{newFile("a.conf"), newFile("b.conf")})).To(Succeed())
+			Expect(mgr.UpdateFiles([]file.File{newFile("b.conf"), newFile("c.conf")})).To(Succeed())
+
+			Expect(fakeOSMgr.CreateCallCount()).To(Equal(4))
+			Expect(fakeOSMgr.RemoveCallCount()).To(BeZero())
+		})
+
+		It("should remove the updated files on the next replace", func() {
+			Expect(mgr.ReplaceFiles([]file.File{newFile("a.conf")})).To(Succeed())
+			Expect(mgr.UpdateFiles([]file.File{newFile("b.conf")})).To(Succeed())
+
+			fakeOSMgr.CreateStub = func(name string) (*os.File, error) {
+				if name == "d.conf" {
+					return nil, errors.New("test error")
+				}
+				return nil, nil
+			}
+			Expect(mgr.UpdateFiles([]file.File{newFile("c.conf"), newFile("d.conf")})).ToNot(Succeed())
+
+			fakeOSMgr.CreateStub = nil
+			Expect(mgr.ReplaceFiles(nil)).To(Succeed())
+
+			Expect(removedPaths()).To(Equal([]string{"a.conf", "b.conf", "c.conf"}))
 		})
 	})
 
@@ -177,14 +315,19 @@ var _ = Describe("EventHandler", func() {
 			func(fakeOSMgr *filefakes.FakeOSFileManager) {
 				mgr := file.NewManagerImpl(zap.New(), fakeOSMgr)
 
+				filesToReplace := files
+
 				// special case for Remove
-				// to kick off removing, we need to successfully write files beforehand
+				// to kick off removing, we need to successfully write files beforehand and then replace them
+				// with no files, so that they become orphaned
 				if fakeOSMgr.RemoveStub != nil {
 					err := mgr.ReplaceFiles(files)
 					Expect(err).ToNot(HaveOccurred())
+
+					filesToReplace = nil
 				}
 
-				err := mgr.ReplaceFiles(files)
+				err := mgr.ReplaceFiles(filesToReplace)
 				Expect(err).Should(HaveOccurred())
 				Expect(err).To(MatchError(errTest))
 			},
@@ -41,3 +41,8 @@ func (s *StdLibOSFileManager) Create(name string) (*os.File, error) {
 func (s *StdLibOSFileManager) Chmod(file *os.File, mode os.FileMode) error {
 	return file.Chmod(mode)
 }
+
+// ReadFile wraps os.ReadFile.
+func (s *StdLibOSFileManager) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
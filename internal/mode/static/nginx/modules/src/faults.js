@@ -0,0 +1,26 @@
+// abort returns '1' if the request must be aborted, according to the abort percentage of the
+// FaultInjectionPolicy of the location. Otherwise, it returns an empty string.
+function abort(r) {
+	return isFaulted(r.variables.ngf_fault_abort_percentage) ? '1' : '';
+}
+
+// delay is the content handler of the internal location that delays requests. The locations with a delay
+// send an auth subrequest to it, which is held for the delay, according to the delay percentage of the
+// FaultInjectionPolicy of the location. The 204 status code allows the request to proceed.
+function delay(r) {
+	if (!isFaulted(r.variables.ngf_fault_delay_percentage)) {
+		r.return(204);
+		return;
+	}
+
+	setTimeout(() => r.return(204), Number(r.variables.ngf_fault_delay_ms));
+}
+
+function isFaulted(percentage) {
+	return Math.random() * 100 < Number(percentage || 0);
+}
+
+export default {
+	abort,
+	delay,
+};
@@ -0,0 +1,72 @@
+// Methods of the requests that can be hedged. The other requests are not idempotent, or have
+// a body, which the subrequests don't send.
+const HEDGED_METHODS = ['GET', 'HEAD'];
+
+// Headers of the response of a subrequest that NGINX sets for the response of the request itself.
+const SKIPPED_HEADERS = ['connection', 'content-length', 'keep-alive', 'transfer-encoding'];
+
+// hedge is the content handler of the locations that hedge their requests according to the
+// ProxySettingsPolicy of the location. It sends the request as a subrequest to the internal location
+// that proxies it, and, if the subrequest is not answered after the hedging delay, sends the request
+// again. The first response is returned, unless it is a server error and the other subrequest is
+// still pending. The requests with other methods are redirected to the internal location.
+function hedge(r) {
+	const location = r.variables.ngf_hedging_location;
+
+	if (!HEDGED_METHODS.includes(r.method)) {
+		r.internalRedirect(location);
+		return;
+	}
+
+	let pending = 0;
+	let done = false;
+	let timer;
+
+	const respond = (reply) => {
+		done = true;
+		if (timer !== undefined) {
+			clearTimeout(timer);
+		}
+
+		for (const name in reply.headersOut) {
+			if (!SKIPPED_HEADERS.includes(name.toLowerCase())) {
+				r.headersOut[name] = reply.headersOut[name];
+			}
+		}
+
+		r.return(reply.status, reply.responseBuffer || reply.responseText);
+	};
+
+	const onReply = (reply) => {
+		pending--;
+
+		if (done) {
+			return;
+		}
+
+		if (reply.status >= 500 && (pending > 0 || timer !== undefined)) {
+			return;
+		}
+
+		respond(reply);
+	};
+
+	const send = () => {
+		pending++;
+		r.subrequest(location, { method: r.method, args: r.variables.args || '' }, onReply);
+	};
+
+	send();
+
+	timer = setTimeout(() => {
+		timer = undefined;
+
+		if (!done) {
+			send();
+		}
+	}, Number(r.variables.ngf_hedging_delay_ms));
+}
+
+export default {
+	hedge,
+};
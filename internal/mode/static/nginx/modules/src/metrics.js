@@ -0,0 +1,422 @@
+const ZONE = 'ngf_route_latency';
+const LISTENER_ZONE = 'ngf_listener_requests';
+const STREAM_ZONE = 'ngf_stream_routes';
+const SLI_ZONE = 'ngf_route_sli';
+const ROUTE_VAR = 'ngf_route';
+const STREAM_ROUTE_VAR = 'ngf_stream_route';
+const SLI_THRESHOLD_VAR = 'ngf_sli_latency_threshold';
+const KEY_SEPARATOR = '|';
+const COUNT_KEY = 'count';
+const SUM_KEY = 'sum';
+const INF_BUCKET = '+Inf';
+const REJECTED = 'rejected';
+const OVERSIZED = 'oversized';
+const OTHER_REASON = 'other';
+
+// STREAM_COUNTERS are the counters of the stream Routes, keyed by the variable of the session they sum.
+// The connections counter counts the sessions.
+const STREAM_COUNTERS = {
+	connections: '',
+	sent_bytes: 'bytes_sent',
+	received_bytes: 'bytes_received',
+	session_time: 'session_time',
+};
+
+// SLI_COUNTERS are the counters of the service level indicators of the Routes.
+// The requests counter counts all requests, the errors counter the requests that failed with a 5xx status code,
+// and the slow_requests counter the requests that took longer than the latency threshold of the Route.
+const SLI_COUNTERS = ['requests', 'errors', 'slow_requests'];
+const SLI_THRESHOLD_KEY = 'latency_threshold';
+
+// BUCKETS are the upper bounds, in seconds, of the buckets of the latency histograms.
+const BUCKETS = [0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10];
+
+// REJECT_REASONS are the reasons of the rejected requests, keyed by their 4xx status code.
+// NGINX uses the non-standard 494, 495, 496 and 497 codes internally, before responding with a 400.
+const REJECT_REASONS = {
+	400: 'bad_request',
+	401: 'unauthorized',
+	403: 'forbidden',
+	404: 'not_found',
+	405: 'method_not_allowed',
+	408: 'request_timeout',
+	411: 'length_required',
+	413: 'content_too_large',
+	414: 'uri_too_long',
+	421: 'misdirected_request',
+	429: 'too_many_requests',
+	431: 'request_header_too_large',
+	494: 'request_header_too_large',
+	495: 'ssl_certificate_error',
+	496: 'ssl_certificate_required',
+	497: 'http_request_sent_to_https_port',
+	499: 'client_closed_request',
+};
+
+// OVERSIZED_PARTS are the parts of the oversized requests, keyed by their status code.
+const OVERSIZED_PARTS = {
+	413: 'body',
+	414: 'uri',
+	431: 'header',
+	494: 'header',
+};
+
+// recordLatency records the latency of the request in the histogram of the Route of the request.
+// It is evaluated by an access_log directive when the request is logged, and returns an empty string,
+// so that the access_log directive never logs the request.
+function recordLatency(r) {
+	const route = r.variables[ROUTE_VAR];
+	if (!route) {
+		return '';
+	}
+
+	const latency = parseFloat(r.variables.request_time);
+	if (isNaN(latency)) {
+		return '';
+	}
+
+	const dict = ngx.shared[ZONE];
+	if (!dict) {
+		r.error(`cannot record the latency of the request; the ${ZONE} zone is not defined`);
+		return '';
+	}
+
+	try {
+		dict.incr(createKey(route, findBucket(latency)), 1, 0);
+		dict.incr(createKey(route, COUNT_KEY), 1, 0);
+		dict.incr(createKey(route, SUM_KEY), latency, 0);
+	} catch (e) {
+		r.error(`cannot record the latency of the request: ${e.message}`);
+	}
+
+	return '';
+}
+
+// routeLatency responds with the latency histograms of the Routes, in JSON.
+// The bucket counts of a histogram are cumulative, as in Prometheus histograms.
+function routeLatency(r) {
+	const dict = ngx.shared[ZONE];
+	if (!dict) {
+		r.return(500, `the ${ZONE} zone is not defined`);
+		return;
+	}
+
+	r.headersOut['Content-Type'] = 'application/json';
+	r.return(200, JSON.stringify(buildHistograms(dict.items())));
+}
+
+// buildHistograms builds the histograms of the Routes from the items of the shared dictionary.
+function buildHistograms(items) {
+	const histograms = {};
+
+	for (const [key, value] of items) {
+		const idx = key.lastIndexOf(KEY_SEPARATOR);
+		if (idx === -1) {
+			continue;
+		}
+
+		const route = key.slice(0, idx);
+		const field = key.slice(idx + 1);
+
+		if (!histograms[route]) {
+			histograms[route] = { buckets: {}, count: 0, sum: 0 };
+		}
+
+		const histogram = histograms[route];
+		if (field === COUNT_KEY) {
+			histogram.count = value;
+		} else if (field === SUM_KEY) {
+			histogram.sum = value;
+		} else {
+			histogram.buckets[field] = value;
+		}
+	}
+
+	for (const route of Object.keys(histograms)) {
+		const histogram = histograms[route];
+		const cumulative = {};
+		let total = 0;
+
+		for (const bound of BUCKETS) {
+			total += histogram.buckets[String(bound)] || 0;
+			cumulative[String(bound)] = total;
+		}
+
+		histogram.buckets = cumulative;
+	}
+
+	return histograms;
+}
+
+// findBucket returns the upper bound of the smallest bucket that contains the latency.
+function findBucket(latency) {
+	for (const bound of BUCKETS) {
+		if (latency <= bound) {
+			return String(bound);
+		}
+	}
+
+	return INF_BUCKET;
+}
+
+function createKey(route, field) {
+	return `${route}${KEY_SEPARATOR}${field}`;
+}
+
+// recordListenerRequest records the request in the counters of the listener of the request,
+// if the request was rejected with a 4xx status code.
+// The listener is identified by the port and the server name of the server that handled the request,
+// which are known even if NGINX rejected the request before processing its headers.
+// It is evaluated by the condition of the access log when the request is logged, and returns an empty string,
+// so that it doesn't change the condition.
+function recordListenerRequest(r) {
+	const status = r.status;
+	if (status < 400 || status > 499) {
+		return '';
+	}
+
+	const dict = ngx.shared[LISTENER_ZONE];
+	if (!dict) {
+		r.error(`cannot record the request; the ${LISTENER_ZONE} zone is not defined`);
+		return '';
+	}
+
+	const listener = createListenerKey(r);
+
+	try {
+		dict.incr(createKey(listener, `${REJECTED}${KEY_SEPARATOR}${getRejectReason(status)}`), 1, 0);
+
+		const part = OVERSIZED_PARTS[status];
+		if (part) {
+			dict.incr(createKey(listener, `${OVERSIZED}${KEY_SEPARATOR}${part}`), 1, 0);
+		}
+	} catch (e) {
+		r.error(`cannot record the request: ${e.message}`);
+	}
+
+	return '';
+}
+
+// listenerRequests responds with the counters of the listeners, in JSON.
+function listenerRequests(r) {
+	const dict = ngx.shared[LISTENER_ZONE];
+	if (!dict) {
+		r.return(500, `the ${LISTENER_ZONE} zone is not defined`);
+		return;
+	}
+
+	r.headersOut['Content-Type'] = 'application/json';
+	r.return(200, JSON.stringify(buildListenerCounters(dict.items())));
+}
+
+// buildListenerCounters builds the counters of the listeners from the items of the shared dictionary.
+function buildListenerCounters(items) {
+	const listeners = {};
+
+	for (const [key, value] of items) {
+		// port|hostname|kind|label
+		const parts = key.split(KEY_SEPARATOR);
+		if (parts.length !== 4) {
+			continue;
+		}
+
+		const [port, hostname, kind, label] = parts;
+		if (kind !== REJECTED && kind !== OVERSIZED) {
+			continue;
+		}
+
+		const listenerKey = createKey(port, hostname);
+		if (!listeners[listenerKey]) {
+			listeners[listenerKey] = { port, hostname, rejected: {}, oversized: {} };
+		}
+
+		listeners[listenerKey][kind][label] = value;
+	}
+
+	return Object.values(listeners);
+}
+
+// createListenerKey returns the key of the listener of the request. The port is the port of the listener, which
+// differs from the port that NGINX binds to when NGINX binds to unprivileged ports. The servers of the HTTPS listeners
+// that share their port with TLSRoutes listen on a unix socket, so their port is taken from the name of the socket.
+function createListenerKey(r) {
+	let port = r.variables.ngf_gateway_port || r.variables.server_port;
+	if (!port) {
+		const match = /(\d+)\.sock$/.exec(r.variables.server_addr || '');
+		port = match ? match[1] : '';
+	}
+
+	return createKey(port, r.variables.server_name || '');
+}
+
+function getRejectReason(status) {
+	return REJECT_REASONS[status] || OTHER_REASON;
+}
+
+// recordStreamSession records the session in the counters of the stream Route of the session.
+// Like recordLatency, it is evaluated by an access_log directive of the stream context and returns
+// an empty string.
+function recordStreamSession(s) {
+	const route = s.variables[STREAM_ROUTE_VAR];
+	if (!route) {
+		return '';
+	}
+
+	const dict = ngx.shared[STREAM_ZONE];
+	if (!dict) {
+		s.error(`cannot record the session; the ${STREAM_ZONE} zone is not defined`);
+		return '';
+	}
+
+	try {
+		for (const [counter, variable] of Object.entries(STREAM_COUNTERS)) {
+			const value = variable ? parseFloat(s.variables[variable]) : 1;
+			if (!isNaN(value)) {
+				dict.incr(createKey(route, counter), value, 0);
+			}
+		}
+	} catch (e) {
+		s.error(`cannot record the session: ${e.message}`);
+	}
+
+	return '';
+}
+
+// streamRouteMetrics returns the counters of the stream Routes, in JSON.
+// It is evaluated by the return directive of the stream status server.
+function streamRouteMetrics(s) {
+	const dict = ngx.shared[STREAM_ZONE];
+	if (!dict) {
+		s.error(`cannot get the counters; the ${STREAM_ZONE} zone is not defined`);
+		return '{}';
+	}
+
+	return JSON.stringify(buildStreamRouteCounters(dict.items()));
+}
+
+// buildStreamRouteCounters builds the counters of the stream Routes from the items of the shared dictionary.
+function buildStreamRouteCounters(items) {
+	const routes = {};
+
+	for (const [key, value] of items) {
+		const idx = key.lastIndexOf(KEY_SEPARATOR);
+		if (idx === -1) {
+			continue;
+		}
+
+		const counter = key.slice(idx + 1);
+		if (!(counter in STREAM_COUNTERS)) {
+			continue;
+		}
+
+		const route = key.slice(0, idx);
+		if (!routes[route]) {
+			routes[route] = { connections: 0, sent_bytes: 0, received_bytes: 0, session_time: 0 };
+		}
+
+		routes[route][counter] = value;
+	}
+
+	return routes;
+}
+
+// recordSLI records the request in the service level indicator counters of the Route of the request.
+// Like recordLatency, it is evaluated by an access_log directive and returns an empty string.
+function recordSLI(r) {
+	const route = r.variables[ROUTE_VAR];
+	if (!route) {
+		return '';
+	}
+
+	const dict = ngx.shared[SLI_ZONE];
+	if (!dict) {
+		r.error(`cannot record the request; the ${SLI_ZONE} zone is not defined`);
+		return '';
+	}
+
+	const latency = parseFloat(r.variables.request_time);
+	const threshold = parseFloat(r.variables[SLI_THRESHOLD_VAR]);
+
+	try {
+		dict.incr(createKey(route, 'requests'), 1, 0);
+
+		if (r.status >= 500 && r.status <= 599) {
+			dict.incr(createKey(route, 'errors'), 1, 0);
+		}
+
+		if (!isNaN(threshold)) {
+			dict.set(createKey(route, SLI_THRESHOLD_KEY), threshold);
+
+			if (!isNaN(latency) && latency > threshold) {
+				dict.incr(createKey(route, 'slow_requests'), 1, 0);
+			}
+		}
+	} catch (e) {
+		r.error(`cannot record the request: ${e.message}`);
+	}
+
+	return '';
+}
+
+// routeSLI responds with the service level indicator counters of the Routes, in JSON.
+function routeSLI(r) {
+	const dict = ngx.shared[SLI_ZONE];
+	if (!dict) {
+		r.return(500, `the ${SLI_ZONE} zone is not defined`);
+		return;
+	}
+
+	r.headersOut['Content-Type'] = 'application/json';
+	r.return(200, JSON.stringify(buildSLICounters(dict.items())));
+}
+
+// buildSLICounters builds the service level indicator counters of the Routes from the items of the shared
+// dictionary.
+function buildSLICounters(items) {
+	const routes = {};
+
+	for (const [key, value] of items) {
+		const idx = key.lastIndexOf(KEY_SEPARATOR);
+		if (idx === -1) {
+			continue;
+		}
+
+		const field = key.slice(idx + 1);
+		if (field !== SLI_THRESHOLD_KEY && !SLI_COUNTERS.includes(field)) {
+			continue;
+		}
+
+		const route = key.slice(0, idx);
+		if (!routes[route]) {
+			routes[route] = { requests: 0, errors: 0, slow_requests: 0, latency_threshold: 0 };
+		}
+
+		routes[route][field] = value;
+	}
+
+	return routes;
+}
+
+export default {
+	recordLatency,
+	routeLatency,
+	buildHistograms,
+	findBucket,
+	createKey,
+	recordListenerRequest,
+	listenerRequests,
+	buildListenerCounters,
+	createListenerKey,
+	getRejectReason,
+	recordStreamSession,
+	streamRouteMetrics,
+	buildStreamRouteCounters,
+	recordSLI,
+	routeSLI,
+	buildSLICounters,
+	ZONE,
+	LISTENER_ZONE,
+	STREAM_ZONE,
+	SLI_ZONE,
+	BUCKETS,
+};
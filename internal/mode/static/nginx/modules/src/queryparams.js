@@ -0,0 +1,129 @@
+const MODIFICATIONS_VAR = 'ngf_query_parameter_modifications';
+const OPERATION_SEPARATOR = '.';
+const REMOVE = 'remove';
+const SET = 'set';
+const ADD = 'add';
+
+// modifyArgs returns the arguments of the request modified by the modifications of the
+// ngf_query_parameter_modifications variable. The modifications are encoded as a query string, in which
+// the name of each parameter is the operation and the name of the query parameter separated by '.',
+// for example, "remove.utm_source=&set.version=v2&add.tag=a". The modifications are applied in order.
+// The arguments that the modifications don't change are kept as is, including their encoding.
+function modifyArgs(r) {
+	const args = r.variables.args || '';
+
+	let modifications;
+	try {
+		modifications = parseModifications(r.variables[MODIFICATIONS_VAR] || '');
+	} catch (e) {
+		r.error(`cannot modify the query parameters: ${e.message}`);
+		return args;
+	}
+
+	if (modifications.length === 0) {
+		return args;
+	}
+
+	return applyModifications(parseArgs(args), modifications)
+		.map((arg) => arg.raw)
+		.join('&');
+}
+
+// parseModifications parses the encoded modifications into a list of { operation, name, value } objects.
+function parseModifications(encoded) {
+	return splitPairs(encoded).map((pair) => {
+		const key = decode(pair.key);
+		const sep = key.indexOf(OPERATION_SEPARATOR);
+		if (sep === -1) {
+			throw Error(`invalid modification ${pair.key}`);
+		}
+
+		const operation = key.slice(0, sep);
+		if (operation !== REMOVE && operation !== SET && operation !== ADD) {
+			throw Error(`unknown operation ${operation}`);
+		}
+
+		return { operation, name: key.slice(sep + 1), value: decode(pair.value) };
+	});
+}
+
+// parseArgs parses the arguments of the request into a list of { name, raw } objects, in which name is
+// the decoded name of the argument and raw is the argument as it appears in the request.
+function parseArgs(args) {
+	return splitPairs(args).map((pair) => {
+		let name;
+		try {
+			name = decode(pair.key);
+		} catch (e) {
+			// an argument with an invalid encoding can only be matched by its raw name
+			name = pair.key;
+		}
+
+		return { name, raw: pair.raw };
+	});
+}
+
+function splitPairs(s) {
+	return s
+		.split('&')
+		.filter((raw) => raw !== '')
+		.map((raw) => {
+			const eq = raw.indexOf('=');
+			if (eq === -1) {
+				return { key: raw, value: '', raw };
+			}
+
+			return { key: raw.slice(0, eq), value: raw.slice(eq + 1), raw };
+		});
+}
+
+function decode(s) {
+	return decodeURIComponent(s.replace(/\+/g, ' '));
+}
+
+function encodeArg(name, value) {
+	return `${encodeURIComponent(name)}=${encodeURIComponent(value)}`;
+}
+
+// applyModifications applies the modifications to the parsed arguments and returns the modified arguments.
+function applyModifications(args, modifications) {
+	let result = args;
+
+	for (const m of modifications) {
+		switch (m.operation) {
+			case REMOVE:
+				result = result.filter((arg) => arg.name !== m.name);
+				break;
+			case SET:
+				result = setArg(result, m.name, m.value);
+				break;
+			case ADD:
+				result = result.concat({ name: m.name, raw: encodeArg(m.name, m.value) });
+				break;
+		}
+	}
+
+	return result;
+}
+
+// setArg replaces the first argument with the name and removes the rest of the arguments with the name.
+// If there is no argument with the name, it appends the argument.
+function setArg(args, name, value) {
+	const arg = { name, raw: encodeArg(name, value) };
+
+	const idx = args.findIndex((a) => a.name === name);
+	if (idx === -1) {
+		return args.concat(arg);
+	}
+
+	return args
+		.filter((a, i) => i <= idx || a.name !== name)
+		.map((a, i) => (i === idx ? arg : a));
+}
+
+export default {
+	modifyArgs,
+	parseModifications,
+	parseArgs,
+	applyModifications,
+};
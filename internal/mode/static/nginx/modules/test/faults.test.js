@@ -0,0 +1,65 @@
+import { default as faults } from '../src/faults.js';
+import { afterEach, describe, expect, it, vi } from 'vitest';
+
+describe('abort', () => {
+	afterEach(() => {
+		vi.restoreAllMocks();
+	});
+
+	const tests = [
+		{ name: 'aborts all requests', percentage: '100', random: 0.99, expected: '1' },
+		{ name: 'aborts the requests below the percentage', percentage: '50', random: 0.49, expected: '1' },
+		{ name: 'does not abort the requests above the percentage', percentage: '50', random: 0.5, expected: '' },
+		{ name: 'does not abort any requests', percentage: '0', random: 0, expected: '' },
+		{ name: 'does not abort without a percentage', percentage: undefined, random: 0, expected: '' },
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			vi.spyOn(Math, 'random').mockReturnValue(test.random);
+
+			const r = { variables: { ngf_fault_abort_percentage: test.percentage } };
+
+			expect(faults.abort(r)).toEqual(test.expected);
+		});
+	});
+});
+
+describe('delay', () => {
+	afterEach(() => {
+		vi.restoreAllMocks();
+		vi.useRealTimers();
+	});
+
+	it('delays the request', () => {
+		vi.useFakeTimers();
+		vi.spyOn(Math, 'random').mockReturnValue(0.5);
+
+		const r = {
+			variables: { ngf_fault_delay_percentage: '100', ngf_fault_delay_ms: '1500' },
+			return: vi.fn(),
+		};
+
+		faults.delay(r);
+		expect(r.return).not.toHaveBeenCalled();
+
+		vi.advanceTimersByTime(1499);
+		expect(r.return).not.toHaveBeenCalled();
+
+		vi.advanceTimersByTime(1);
+		expect(r.return).toHaveBeenCalledWith(204);
+	});
+
+	it('does not delay the requests above the percentage', () => {
+		vi.useFakeTimers();
+		vi.spyOn(Math, 'random').mockReturnValue(0.5);
+
+		const r = {
+			variables: { ngf_fault_delay_percentage: '25', ngf_fault_delay_ms: '1500' },
+			return: vi.fn(),
+		};
+
+		faults.delay(r);
+		expect(r.return).toHaveBeenCalledWith(204);
+	});
+});
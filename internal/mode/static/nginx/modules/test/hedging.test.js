@@ -0,0 +1,107 @@
+import { default as hedging } from '../src/hedging.js';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+
+function createRequest(method) {
+	return {
+		method,
+		variables: {
+			ngf_hedging_location: '/_ngf-internal-rule0-hedging',
+			ngf_hedging_delay_ms: '100',
+			args: 'a=b',
+		},
+		headersOut: {},
+		internalRedirect: vi.fn(),
+		return: vi.fn(),
+		subrequest: vi.fn(),
+	};
+}
+
+function reply(r, i, status, body) {
+	const onReply = r.subrequest.mock.calls[i][2];
+	onReply({
+		status,
+		headersOut: { 'Content-Type': 'text/plain', 'Content-Length': String(body.length) },
+		responseText: body,
+	});
+}
+
+describe('hedge', () => {
+	beforeEach(() => {
+		vi.useFakeTimers();
+	});
+
+	afterEach(() => {
+		vi.useRealTimers();
+	});
+
+	it('redirects the requests that are not idempotent', () => {
+		const r = createRequest('POST');
+
+		hedging.hedge(r);
+
+		expect(r.internalRedirect).toHaveBeenCalledWith('/_ngf-internal-rule0-hedging');
+		expect(r.subrequest).not.toHaveBeenCalled();
+	});
+
+	it('returns the response that arrives before the delay', () => {
+		const r = createRequest('GET');
+
+		hedging.hedge(r);
+		expect(r.subrequest).toHaveBeenCalledTimes(1);
+		expect(r.subrequest.mock.calls[0][0]).toEqual('/_ngf-internal-rule0-hedging');
+		expect(r.subrequest.mock.calls[0][1]).toEqual({ method: 'GET', args: 'a=b' });
+
+		reply(r, 0, 200, 'first');
+		expect(r.return).toHaveBeenCalledWith(200, 'first');
+		expect(r.headersOut).toEqual({ 'Content-Type': 'text/plain' });
+
+		vi.advanceTimersByTime(100);
+		expect(r.subrequest).toHaveBeenCalledTimes(1);
+	});
+
+	it('hedges the request after the delay and returns the first response', () => {
+		const r = createRequest('GET');
+
+		hedging.hedge(r);
+
+		vi.advanceTimersByTime(99);
+		expect(r.subrequest).toHaveBeenCalledTimes(1);
+
+		vi.advanceTimersByTime(1);
+		expect(r.subrequest).toHaveBeenCalledTimes(2);
+
+		reply(r, 1, 200, 'second');
+		reply(r, 0, 200, 'first');
+
+		expect(r.return).toHaveBeenCalledTimes(1);
+		expect(r.return).toHaveBeenCalledWith(200, 'second');
+	});
+
+	it('waits for the other response after a server error', () => {
+		const r = createRequest('HEAD');
+
+		hedging.hedge(r);
+		vi.advanceTimersByTime(100);
+
+		reply(r, 0, 502, 'error');
+		expect(r.return).not.toHaveBeenCalled();
+
+		reply(r, 1, 200, 'second');
+		expect(r.return).toHaveBeenCalledWith(200, 'second');
+	});
+
+	it('hedges the request after a server error before the delay', () => {
+		const r = createRequest('GET');
+
+		hedging.hedge(r);
+
+		reply(r, 0, 503, 'error');
+		expect(r.return).not.toHaveBeenCalled();
+
+		vi.advanceTimersByTime(100);
+		expect(r.subrequest).toHaveBeenCalledTimes(2);
+
+		reply(r, 1, 503, 'error');
+		expect(r.return).toHaveBeenCalledWith(503, 'error');
+	});
+});
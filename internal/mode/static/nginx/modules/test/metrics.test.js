@@ -0,0 +1,587 @@
+import { default as metrics } from '../src/metrics.js';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+
+// Creates a shared dictionary of type number for testing.
+// See documentation for all methods available: http://nginx.org/en/docs/njs/reference.html#ngx_shared
+function createDict() {
+	const values = new Map();
+
+	return {
+		incr(key, delta, init) {
+			const value = (values.has(key) ? values.get(key) : init) + delta;
+			values.set(key, value);
+			return value;
+		},
+		set(key, value) {
+			values.set(key, value);
+			return true;
+		},
+		items() {
+			return Array.from(values.entries());
+		},
+		values,
+	};
+}
+
+// Creates a NGINX HTTP Request Object for testing.
+function createRequest({
+	route = '',
+	requestTime = '',
+	status = 0,
+	serverPort = '',
+	gatewayPort = '',
+	serverAddr = '',
+	serverName = '',
+	sliLatencyThreshold = '',
+} = {}) {
+	let r = {
+		// Test mocks
+		return(statusCode, body) {
+			r.testReturned = statusCode;
+			r.testBody = body;
+		},
+		error(msg) {
+			r.testError = msg;
+		},
+		headersOut: {},
+		variables: {},
+	};
+
+	if (route) {
+		r.variables.ngf_route = route;
+	}
+
+	if (requestTime) {
+		r.variables.request_time = requestTime;
+	}
+
+	if (status) {
+		r.status = status;
+	}
+
+	if (serverPort) {
+		r.variables.server_port = serverPort;
+	}
+
+	if (gatewayPort) {
+		r.variables.ngf_gateway_port = gatewayPort;
+	}
+
+	if (serverAddr) {
+		r.variables.server_addr = serverAddr;
+	}
+
+	if (serverName) {
+		r.variables.server_name = serverName;
+	}
+
+	if (sliLatencyThreshold) {
+		r.variables.ngf_sli_latency_threshold = sliLatencyThreshold;
+	}
+
+	return r;
+}
+
+describe('findBucket', () => {
+	const tests = [
+		{ name: 'returns the smallest bucket', latency: 0.001, expected: '0.005' },
+		{ name: 'returns the bucket equal to the latency', latency: 0.1, expected: '0.1' },
+		{ name: 'returns the next bucket', latency: 0.101, expected: '0.25' },
+		{ name: 'returns the largest bucket', latency: 10, expected: '10' },
+		{ name: 'returns the infinite bucket', latency: 10.5, expected: '+Inf' },
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			expect(metrics.findBucket(test.latency)).to.equal(test.expected);
+		});
+	});
+});
+
+describe('recordLatency', () => {
+	let dict;
+
+	beforeEach(() => {
+		dict = createDict();
+		globalThis.ngx = { shared: { [metrics.ZONE]: dict } };
+	});
+
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('records the latency of the request', () => {
+		const r = createRequest({ route: 'test/route', requestTime: '0.020' });
+
+		expect(metrics.recordLatency(r)).to.equal('');
+		expect(metrics.recordLatency(r)).to.equal('');
+
+		expect(dict.values.get('test/route|0.025')).to.equal(2);
+		expect(dict.values.get('test/route|count')).to.equal(2);
+		expect(dict.values.get('test/route|sum')).to.be.closeTo(0.04, 0.0001);
+	});
+
+	it('does not record the latency of a request without a route', () => {
+		const r = createRequest({ requestTime: '0.020' });
+
+		expect(metrics.recordLatency(r)).to.equal('');
+		expect(dict.values.size).to.equal(0);
+	});
+
+	it('does not record an invalid latency', () => {
+		const r = createRequest({ route: 'test/route', requestTime: '-' });
+
+		expect(metrics.recordLatency(r)).to.equal('');
+		expect(dict.values.size).to.equal(0);
+	});
+
+	it('logs an error if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+		const r = createRequest({ route: 'test/route', requestTime: '0.020' });
+
+		expect(metrics.recordLatency(r)).to.equal('');
+		expect(r.testError).to.contain(metrics.ZONE);
+	});
+});
+
+describe('buildHistograms', () => {
+	it('builds cumulative histograms of the routes', () => {
+		const histograms = metrics.buildHistograms([
+			['test/route1|0.005', 1],
+			['test/route1|0.1', 2],
+			['test/route1|+Inf', 1],
+			['test/route1|count', 4],
+			['test/route1|sum', 11.3],
+			['test/route2|1', 1],
+			['test/route2|count', 1],
+			['test/route2|sum', 0.7],
+			['invalid', 1],
+		]);
+
+		expect(Object.keys(histograms)).to.have.members(['test/route1', 'test/route2']);
+
+		expect(histograms['test/route1'].count).to.equal(4);
+		expect(histograms['test/route1'].sum).to.equal(11.3);
+		expect(histograms['test/route1'].buckets['0.005']).to.equal(1);
+		expect(histograms['test/route1'].buckets['0.05']).to.equal(1);
+		expect(histograms['test/route1'].buckets['0.1']).to.equal(3);
+		expect(histograms['test/route1'].buckets['10']).to.equal(3);
+		expect(histograms['test/route1'].buckets).to.not.have.property('+Inf');
+
+		expect(histograms['test/route2'].count).to.equal(1);
+		expect(histograms['test/route2'].buckets['0.5']).to.equal(0);
+		expect(histograms['test/route2'].buckets['1']).to.equal(1);
+	});
+});
+
+describe('routeLatency', () => {
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('responds with the histograms in JSON', () => {
+		const dict = createDict();
+		dict.incr('test/route|0.01', 1, 0);
+		dict.incr('test/route|count', 1, 0);
+		dict.incr('test/route|sum', 0.008, 0);
+		globalThis.ngx = { shared: { [metrics.ZONE]: dict } };
+
+		const r = createRequest();
+		metrics.routeLatency(r);
+
+		expect(r.testReturned).to.equal(200);
+		expect(r.headersOut['Content-Type']).to.equal('application/json');
+
+		const histograms = JSON.parse(r.testBody);
+		expect(histograms['test/route'].count).to.equal(1);
+		expect(histograms['test/route'].buckets['0.01']).to.equal(1);
+	});
+
+	it('returns 500 if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+
+		const r = createRequest();
+		metrics.routeLatency(r);
+
+		expect(r.testReturned).to.equal(500);
+	});
+});
+
+describe('createListenerKey', () => {
+	const tests = [
+		{
+			name: 'uses the port of the server',
+			request: createRequest({ serverPort: '80', serverName: 'cafe.example.com' }),
+			expected: '80|cafe.example.com',
+		},
+		{
+			name: 'uses the port of the listener of the server',
+			request: createRequest({ serverPort: '8080', gatewayPort: '80', serverName: 'cafe.example.com' }),
+			expected: '80|cafe.example.com',
+		},
+		{
+			name: 'uses the port in the name of the socket of the server',
+			request: createRequest({
+				serverAddr: 'unix:/var/run/nginx/https443.sock',
+				serverName: 'cafe.example.com',
+			}),
+			expected: '443|cafe.example.com',
+		},
+		{
+			name: 'uses an empty server name for a default server',
+			request: createRequest({ serverPort: '80' }),
+			expected: '80|',
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			expect(metrics.createListenerKey(test.request)).to.equal(test.expected);
+		});
+	});
+});
+
+describe('getRejectReason', () => {
+	const tests = [
+		{ status: 404, expected: 'not_found' },
+		{ status: 494, expected: 'request_header_too_large' },
+		{ status: 418, expected: 'other' },
+	];
+
+	tests.forEach((test) => {
+		it(`returns ${test.expected} for ${test.status}`, () => {
+			expect(metrics.getRejectReason(test.status)).to.equal(test.expected);
+		});
+	});
+});
+
+describe('recordListenerRequest', () => {
+	let dict;
+
+	beforeEach(() => {
+		dict = createDict();
+		globalThis.ngx = { shared: { [metrics.LISTENER_ZONE]: dict } };
+	});
+
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('records a rejected request', () => {
+		const r = createRequest({ status: 403, serverPort: '80', serverName: 'cafe.example.com' });
+
+		expect(metrics.recordListenerRequest(r)).to.equal('');
+		expect(dict.values.get('80|cafe.example.com|rejected|forbidden')).to.equal(1);
+		expect(dict.values.size).to.equal(1);
+	});
+
+	it('records an oversized request', () => {
+		const r = createRequest({ status: 413, serverPort: '80', serverName: 'cafe.example.com' });
+
+		expect(metrics.recordListenerRequest(r)).to.equal('');
+		expect(dict.values.get('80|cafe.example.com|rejected|content_too_large')).to.equal(1);
+		expect(dict.values.get('80|cafe.example.com|oversized|body')).to.equal(1);
+	});
+
+	it('does not record a request that was not rejected', () => {
+		[200, 301, 500].forEach((status) => {
+			const r = createRequest({ status, serverPort: '80', serverName: 'cafe.example.com' });
+			expect(metrics.recordListenerRequest(r)).to.equal('');
+		});
+
+		expect(dict.values.size).to.equal(0);
+	});
+
+	it('logs an error if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+		const r = createRequest({ status: 404, serverPort: '80' });
+
+		expect(metrics.recordListenerRequest(r)).to.equal('');
+		expect(r.testError).to.contain(metrics.LISTENER_ZONE);
+	});
+});
+
+describe('buildListenerCounters', () => {
+	it('builds the counters of the listeners', () => {
+		const listeners = metrics.buildListenerCounters([
+			['80|cafe.example.com|rejected|not_found', 3],
+			['80|cafe.example.com|rejected|content_too_large', 1],
+			['80|cafe.example.com|oversized|body', 1],
+			['443||rejected|bad_request', 2],
+			['80|cafe.example.com|unknown|label', 1],
+			['invalid', 1],
+		]);
+
+		expect(listeners).to.deep.equal([
+			{
+				port: '80',
+				hostname: 'cafe.example.com',
+				rejected: { not_found: 3, content_too_large: 1 },
+				oversized: { body: 1 },
+			},
+			{
+				port: '443',
+				hostname: '',
+				rejected: { bad_request: 2 },
+				oversized: {},
+			},
+		]);
+	});
+});
+
+describe('listenerRequests', () => {
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('responds with the counters in JSON', () => {
+		const dict = createDict();
+		dict.incr('80|cafe.example.com|rejected|not_found', 1, 0);
+		globalThis.ngx = { shared: { [metrics.LISTENER_ZONE]: dict } };
+
+		const r = createRequest();
+		metrics.listenerRequests(r);
+
+		expect(r.testReturned).to.equal(200);
+		expect(r.headersOut['Content-Type']).to.equal('application/json');
+		expect(JSON.parse(r.testBody)[0].rejected.not_found).to.equal(1);
+	});
+
+	it('returns 500 if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+
+		const r = createRequest();
+		metrics.listenerRequests(r);
+
+		expect(r.testReturned).to.equal(500);
+	});
+});
+
+// Creates a NGINX Stream Session Object for testing.
+function createSession({ route = '', bytesSent = '', bytesReceived = '', sessionTime = '' } = {}) {
+	let s = {
+		// Test mocks
+		error(msg) {
+			s.testError = msg;
+		},
+		variables: {},
+	};
+
+	if (route) {
+		s.variables.ngf_stream_route = route;
+	}
+
+	if (bytesSent) {
+		s.variables.bytes_sent = bytesSent;
+	}
+
+	if (bytesReceived) {
+		s.variables.bytes_received = bytesReceived;
+	}
+
+	if (sessionTime) {
+		s.variables.session_time = sessionTime;
+	}
+
+	return s;
+}
+
+describe('recordStreamSession', () => {
+	let dict;
+
+	beforeEach(() => {
+		dict = createDict();
+		globalThis.ngx = { shared: { [metrics.STREAM_ZONE]: dict } };
+	});
+
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('records the session of a Route', () => {
+		const sessions = [
+			{ bytesSent: '1024', bytesReceived: '512', sessionTime: '1.500' },
+			{ bytesSent: '2048', bytesReceived: '256', sessionTime: '0.250' },
+		];
+
+		sessions.forEach((session) => {
+			const s = createSession({ route: 'default/secure-app', ...session });
+			expect(metrics.recordStreamSession(s)).to.equal('');
+		});
+
+		expect(dict.values.get('default/secure-app|connections')).to.equal(2);
+		expect(dict.values.get('default/secure-app|sent_bytes')).to.equal(3072);
+		expect(dict.values.get('default/secure-app|received_bytes')).to.equal(768);
+		expect(dict.values.get('default/secure-app|session_time')).to.equal(1.75);
+	});
+
+	it('does not record the session without a Route', () => {
+		const s = createSession({ bytesSent: '1024', bytesReceived: '512', sessionTime: '1.500' });
+
+		expect(metrics.recordStreamSession(s)).to.equal('');
+		expect(dict.values.size).to.equal(0);
+	});
+
+	it('logs an error if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+		const s = createSession({ route: 'default/secure-app' });
+
+		expect(metrics.recordStreamSession(s)).to.equal('');
+		expect(s.testError).to.contain(metrics.STREAM_ZONE);
+	});
+});
+
+describe('buildStreamRouteCounters', () => {
+	it('builds the counters of the stream Routes', () => {
+		const routes = metrics.buildStreamRouteCounters([
+			['default/secure-app|connections', 2],
+			['default/secure-app|sent_bytes', 3072],
+			['default/secure-app|received_bytes', 768],
+			['default/secure-app|session_time', 1.75],
+			['test/db|connections', 1],
+			['test/db|unknown', 1],
+			['invalid', 1],
+		]);
+
+		expect(routes).to.deep.equal({
+			'default/secure-app': {
+				connections: 2,
+				sent_bytes: 3072,
+				received_bytes: 768,
+				session_time: 1.75,
+			},
+			'test/db': { connections: 1, sent_bytes: 0, received_bytes: 0, session_time: 0 },
+		});
+	});
+});
+
+describe('streamRouteMetrics', () => {
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('returns the counters in JSON', () => {
+		const dict = createDict();
+		dict.incr('default/secure-app|connections', 1, 0);
+		globalThis.ngx = { shared: { [metrics.STREAM_ZONE]: dict } };
+
+		const s = createSession();
+
+		expect(JSON.parse(metrics.streamRouteMetrics(s))['default/secure-app'].connections).to.equal(1);
+	});
+
+	it('returns an empty object if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+
+		const s = createSession();
+
+		expect(metrics.streamRouteMetrics(s)).to.equal('{}');
+		expect(s.testError).to.contain(metrics.STREAM_ZONE);
+	});
+});
+
+describe('recordSLI', () => {
+	let dict;
+
+	beforeEach(() => {
+		dict = createDict();
+		globalThis.ngx = { shared: { [metrics.SLI_ZONE]: dict } };
+	});
+
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('records the request in the counters of the route', () => {
+		const requests = [
+			createRequest({ route: 'test/route', requestTime: '0.1', status: 200, sliLatencyThreshold: '0.5' }),
+			createRequest({ route: 'test/route', requestTime: '0.7', status: 200, sliLatencyThreshold: '0.5' }),
+			createRequest({ route: 'test/route', requestTime: '0.2', status: 503, sliLatencyThreshold: '0.5' }),
+			createRequest({ route: 'test/route', requestTime: '0.2', status: 404, sliLatencyThreshold: '0.5' }),
+		];
+
+		for (const r of requests) {
+			expect(metrics.recordSLI(r)).to.equal('');
+		}
+
+		expect(dict.values.get('test/route|requests')).to.equal(4);
+		expect(dict.values.get('test/route|errors')).to.equal(1);
+		expect(dict.values.get('test/route|slow_requests')).to.equal(1);
+		expect(dict.values.get('test/route|latency_threshold')).to.equal(0.5);
+	});
+
+	it('does not record a request without a route', () => {
+		const r = createRequest({ requestTime: '0.1', status: 500, sliLatencyThreshold: '0.5' });
+
+		expect(metrics.recordSLI(r)).to.equal('');
+		expect(dict.values.size).to.equal(0);
+	});
+
+	it('does not record a slow request without a latency threshold', () => {
+		const r = createRequest({ route: 'test/route', requestTime: '10', status: 200 });
+
+		expect(metrics.recordSLI(r)).to.equal('');
+		expect(dict.values.get('test/route|requests')).to.equal(1);
+		expect(dict.values.has('test/route|slow_requests')).to.equal(false);
+		expect(dict.values.has('test/route|latency_threshold')).to.equal(false);
+	});
+
+	it('logs an error if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+		const r = createRequest({ route: 'test/route', requestTime: '0.1', status: 200 });
+
+		expect(metrics.recordSLI(r)).to.equal('');
+		expect(r.testError).to.contain(metrics.SLI_ZONE);
+	});
+});
+
+describe('buildSLICounters', () => {
+	it('builds the counters of the routes', () => {
+		const routes = metrics.buildSLICounters([
+			['test/route1|requests', 10],
+			['test/route1|errors', 2],
+			['test/route1|slow_requests', 3],
+			['test/route1|latency_threshold', 0.5],
+			['test/route2|requests', 1],
+			['test/route2|unknown', 1],
+			['invalid', 1],
+		]);
+
+		expect(routes).to.deep.equal({
+			'test/route1': { requests: 10, errors: 2, slow_requests: 3, latency_threshold: 0.5 },
+			'test/route2': { requests: 1, errors: 0, slow_requests: 0, latency_threshold: 0 },
+		});
+	});
+});
+
+describe('routeSLI', () => {
+	afterEach(() => {
+		delete globalThis.ngx;
+	});
+
+	it('responds with the counters in JSON', () => {
+		const dict = createDict();
+		dict.incr('test/route|requests', 2, 0);
+		dict.set('test/route|latency_threshold', 0.5);
+		globalThis.ngx = { shared: { [metrics.SLI_ZONE]: dict } };
+
+		const r = createRequest();
+		metrics.routeSLI(r);
+
+		expect(r.testReturned).to.equal(200);
+		expect(r.headersOut['Content-Type']).to.equal('application/json');
+
+		const routes = JSON.parse(r.testBody);
+		expect(routes['test/route'].requests).to.equal(2);
+		expect(routes['test/route'].latency_threshold).to.equal(0.5);
+	});
+
+	it('returns 500 if the zone is not defined', () => {
+		globalThis.ngx = { shared: {} };
+
+		const r = createRequest();
+		metrics.routeSLI(r);
+
+		expect(r.testReturned).to.equal(500);
+	});
+});
@@ -0,0 +1,115 @@
+import { default as qp } from '../src/queryparams.js';
+import { describe, expect, it } from 'vitest';
+
+// Creates a NGINX HTTP Request Object for testing.
+// See documentation for all properties available: http://nginx.org/en/docs/njs/reference.html
+function createRequest({ args = '', modifications = '' } = {}) {
+	return {
+		error(msg) {
+			console.log('\tngx_error:', msg);
+		},
+		variables: {
+			args,
+			ngf_query_parameter_modifications: modifications,
+		},
+	};
+}
+
+describe('parseModifications', () => {
+	it('parses the modifications', () => {
+		expect(qp.parseModifications('remove.utm_source=&set.a.b=v%202&add.tags%5B%5D=x')).toEqual([
+			{ operation: 'remove', name: 'utm_source', value: '' },
+			{ operation: 'set', name: 'a.b', value: 'v 2' },
+			{ operation: 'add', name: 'tags[]', value: 'x' },
+		]);
+	});
+
+	it('returns an empty list for empty modifications', () => {
+		expect(qp.parseModifications('')).toEqual([]);
+	});
+
+	it('throws an error for an invalid modification', () => {
+		expect(() => qp.parseModifications('version=v2')).toThrowError('invalid modification');
+		expect(() => qp.parseModifications('replace.version=v2')).toThrowError('unknown operation');
+	});
+});
+
+describe('parseArgs', () => {
+	it('parses the arguments', () => {
+		expect(qp.parseArgs('a=1&b&&c%5B%5D=2&d+e=3&%zz=4')).toEqual([
+			{ name: 'a', raw: 'a=1' },
+			{ name: 'b', raw: 'b' },
+			{ name: 'c[]', raw: 'c%5B%5D=2' },
+			{ name: 'd e', raw: 'd+e=3' },
+			{ name: '%zz', raw: '%zz=4' },
+		]);
+	});
+});
+
+describe('applyModifications', () => {
+	const args = qp.parseArgs('version=v1&tag=a&version=v2&utm_source=mail');
+
+	const tests = [
+		{
+			name: 'removes all the arguments with the name',
+			modifications: [{ operation: 'remove', name: 'version', value: '' }],
+			expected: 'tag=a&utm_source=mail',
+		},
+		{
+			name: 'replaces the first argument with the name and removes the rest',
+			modifications: [{ operation: 'set', name: 'version', value: 'v3' }],
+			expected: 'version=v3&tag=a&utm_source=mail',
+		},
+		{
+			name: 'appends a set argument that the request does not have',
+			modifications: [{ operation: 'set', name: 'debug', value: 'true' }],
+			expected: 'version=v1&tag=a&version=v2&utm_source=mail&debug=true',
+		},
+		{
+			name: 'appends an added argument',
+			modifications: [{ operation: 'add', name: 'tag', value: 'b c&d' }],
+			expected: 'version=v1&tag=a&version=v2&utm_source=mail&tag=b%20c%26d',
+		},
+		{
+			name: 'applies the modifications in order',
+			modifications: [
+				{ operation: 'remove', name: 'utm_source', value: '' },
+				{ operation: 'set', name: 'version', value: 'v3' },
+				{ operation: 'add', name: 'version', value: 'v4' },
+			],
+			expected: 'version=v3&tag=a&version=v4',
+		},
+	];
+
+	tests.forEach((test) => {
+		it(test.name, () => {
+			const result = qp.applyModifications(args, test.modifications);
+			expect(result.map((arg) => arg.raw).join('&')).toEqual(test.expected);
+		});
+	});
+});
+
+describe('modifyArgs', () => {
+	it('returns the modified arguments', () => {
+		const r = createRequest({
+			args: 'id=1&utm_source=mail',
+			modifications: 'remove.utm_source=&set.version=v2',
+		});
+		expect(qp.modifyArgs(r)).toEqual('id=1&version=v2');
+	});
+
+	it('returns the arguments when there are no modifications', () => {
+		const r = createRequest({ args: 'id=1&b+c=%20' });
+		expect(qp.modifyArgs(r)).toEqual('id=1&b+c=%20');
+	});
+
+	it('returns the arguments when the modifications are invalid', () => {
+		const r = createRequest({ args: 'id=1', modifications: 'invalid' });
+		expect(qp.modifyArgs(r)).toEqual('id=1');
+	});
+
+	it('returns the added arguments when the request has no arguments', () => {
+		const r = createRequest({ modifications: 'add.version=v2' });
+		expect(qp.modifyArgs(r)).toEqual('version=v2');
+	});
+});
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"syscall"
@@ -25,6 +26,18 @@ const (
 	PidFileTimeout = 10000 * time.Millisecond
 	// NginxReloadTimeout sets the timeout duration for reloading the Nginx configuration.
 	NginxReloadTimeout = 60000 * time.Millisecond
+
+	// healthCheckPidFileTimeout is the timeout for finding the PID file during a health check.
+	// The PID file is missing only when NGINX is starting or stopping, so the health check doesn't wait for it.
+	healthCheckPidFileTimeout = 1 * time.Second
+	// maxWorkerCrashes is the number of worker process crashes within workerCrashWindow after which
+	// the worker processes are considered to be crash-looping.
+	maxWorkerCrashes = 5
+	// workerCrashWindow is the window within which the worker process crashes are counted.
+	workerCrashWindow = 5 * time.Minute
+	// maxUnresponsiveChecks is the number of consecutive health checks in which NGINX doesn't respond after which
+	// NGINX is considered to be unresponsive.
+	maxUnresponsiveChecks = 3
 )
 
 type (
@@ -63,6 +76,14 @@ type Manager interface {
 	// GetUpstreams uses the NGINX Plus API to get the upstreams.
 	// Only usable if running NGINX Plus.
 	GetUpstreams() (ngxclient.Upstreams, error)
+	// GetConfigVersion returns the version of the configuration that NGINX is running.
+	GetConfigVersion() (int, error)
+	// CheckHealth checks the health of the NGINX processes. It returns an error that describes the problem
+	// if the worker processes of NGINX are gone or crash-loop, or if NGINX doesn't respond. Such problems
+	// require restarting NGINX. CheckHealth must not be called concurrently with Reload or Restart.
+	CheckHealth(ctx context.Context) error
+	// Restart restarts NGINX by killing its main process, so that the NGINX container is restarted.
+	Restart(ctx context.Context) error
 }
 
 // MetricsCollector is an interface for the metrics of the NGINX runtime manager.
@@ -74,6 +95,19 @@ type MetricsCollector interface {
 	ObserveLastReloadTime(ms time.Duration)
 }
 
+// processHealth is the state of the NGINX processes observed by the health checks.
+type processHealth struct {
+	// children are the PIDs of the child processes of the main process in the previous health check.
+	// They are nil if the previous health check didn't observe them, or a reload has happened since.
+	children []string
+	// crashes are the times when the health checks observed that the main process replaced crashed workers.
+	crashes []time.Time
+	// mainPID is the PID of the main process in the previous health check.
+	mainPID int
+	// unresponsiveChecks is the number of consecutive health checks in which NGINX didn't respond.
+	unresponsiveChecks int
+}
+
 // ManagerImpl implements Manager.
 type ManagerImpl struct {
 	processHandler   ProcessHandler
@@ -81,6 +115,7 @@ type ManagerImpl struct {
 	verifyClient     nginxConfigVerifier
 	ngxPlusClient    NginxPlusClient
 	logger           logr.Logger
+	health           processHealth
 }
 
 // NewManagerImpl creates a new ManagerImpl.
@@ -119,6 +154,9 @@ func (m *ManagerImpl) Reload(ctx context.Context, configVersion int) error {
 		return err
 	}
 
+	// A reload replaces the worker processes, so the health checks must not consider the replaced workers crashed.
+	m.health.children = nil
+
 	// send HUP signal to the NGINX main process reload configuration
 	// See https://nginx.org/en/docs/control.html
 	if errP := m.processHandler.Kill(pid); errP != nil {
@@ -143,6 +181,117 @@ func (m *ManagerImpl) Reload(ctx context.Context, configVersion int) error {
 	return nil
 }
 
+// GetConfigVersion returns the version of the configuration that NGINX is running.
+func (m *ManagerImpl) GetConfigVersion() (int, error) {
+	return m.verifyClient.GetConfigVersion()
+}
+
+// CheckHealth checks the health of the NGINX processes.
+func (m *ManagerImpl) CheckHealth(ctx context.Context) error {
+	pid, err := m.processHandler.FindMainProcess(ctx, healthCheckPidFileTimeout)
+	if err != nil {
+		// NGINX is starting or stopping. If it fails to start, the NGINX container is restarted anyway.
+		m.logger.V(1).Info("Skipping health check, NGINX main process not found", "error", err.Error())
+		return nil
+	}
+
+	if pid != m.health.mainPID {
+		m.health = processHealth{mainPID: pid}
+	}
+
+	content, err := m.processHandler.ReadFile(fmt.Sprintf(childProcPathFmt, pid))
+	if err != nil {
+		// the main process has exited since the PID file was read
+		m.logger.V(1).Info("Skipping health check, failed to read NGINX child processes", "error", err.Error())
+		return nil
+	}
+
+	children := strings.Fields(string(content))
+	if len(children) == 0 {
+		return errors.New("NGINX main process has no worker processes")
+	}
+
+	if crashes := countReplacedProcesses(m.health.children, children); crashes > 0 {
+		now := time.Now()
+		for range crashes {
+			m.health.crashes = append(m.health.crashes, now)
+		}
+	}
+	m.health.children = children
+
+	cutoff := time.Now().Add(-workerCrashWindow)
+	m.health.crashes = slices.DeleteFunc(m.health.crashes, func(t time.Time) bool {
+		return t.Before(cutoff)
+	})
+
+	if len(m.health.crashes) >= maxWorkerCrashes {
+		return fmt.Errorf(
+			"NGINX worker processes are crash-looping: %d crashed in the last %s",
+			len(m.health.crashes),
+			workerCrashWindow,
+		)
+	}
+
+	if _, err := m.verifyClient.GetConfigVersion(); err != nil {
+		m.health.unresponsiveChecks++
+		if m.health.unresponsiveChecks >= maxUnresponsiveChecks {
+			return fmt.Errorf(
+				"NGINX didn't respond in %d consecutive health checks: %w",
+				m.health.unresponsiveChecks,
+				err,
+			)
+		}
+
+		return nil
+	}
+
+	m.health.unresponsiveChecks = 0
+
+	return nil
+}
+
+// countReplacedProcesses returns the number of previous processes that have exited and been replaced by new
+// processes. The main process of NGINX replaces a worker process only when it crashes, while the worker processes
+// that exit gracefully, such as the old workers after a reload, are not replaced.
+func countReplacedProcesses(previous, current []string) int {
+	if previous == nil {
+		return 0
+	}
+
+	var exited, started int
+
+	for _, p := range previous {
+		if !slices.Contains(current, p) {
+			exited++
+		}
+	}
+
+	for _, p := range current {
+		if !slices.Contains(previous, p) {
+			started++
+		}
+	}
+
+	return min(exited, started)
+}
+
+// Restart restarts NGINX by killing its main process. The kubelet then restarts the NGINX container,
+// and NGINX starts with the configuration files.
+func (m *ManagerImpl) Restart(ctx context.Context) error {
+	pid, err := m.processHandler.FindMainProcess(ctx, PidFileTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to find NGINX main process: %w", err)
+	}
+
+	if err := m.processHandler.Terminate(pid); err != nil {
+		return fmt.Errorf("failed to kill NGINX main process: %w", err)
+	}
+
+	m.health = processHealth{}
+
+	return nil
+}
+
 // UpdateHTTPServers uses the NGINX Plus API to update HTTP upstream servers.
 // Only usable if running NGINX Plus.
 func (m *ManagerImpl) UpdateHTTPServers(upstream string, servers []ngxclient.UpstreamServer) error {
@@ -186,6 +335,7 @@ type ProcessHandler interface {
 	) (int, error)
 	ReadFile(file string) ([]byte, error)
 	Kill(pid int) error
+	Terminate(pid int) error
 }
 
 type ProcessHandlerImpl struct {
@@ -245,3 +395,8 @@ func (p *ProcessHandlerImpl) ReadFile(file string) ([]byte, error) {
 func (p *ProcessHandlerImpl) Kill(pid int) error {
 	return syscall.Kill(pid, syscall.SIGHUP)
 }
+
+// Terminate kills the process with the SIGKILL signal, so that even a process that doesn't handle signals exits.
+func (p *ProcessHandlerImpl) Terminate(pid int) error {
+	return syscall.Kill(pid, syscall.SIGKILL)
+}
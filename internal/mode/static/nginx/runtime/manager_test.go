@@ -98,6 +98,15 @@ var _ = Describe("NGINX Runtime Manager", func() {
 			Expect(verifyClient.WaitForCorrectVersionCallCount()).To(Equal(0))
 		})
 
+		It("returns the version of the running configuration", func() {
+			verifyClient.GetConfigVersionReturns(3, nil)
+
+			version, err := manager.GetConfigVersion()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal(3))
+		})
+
 		It("times out waiting for correct version", func() {
 			process.FindMainProcessReturns(1234, nil)
 			process.ReadFileReturns([]byte("child1\nchild2"), nil)
@@ -140,6 +149,126 @@ var _ = Describe("NGINX Runtime Manager", func() {
 		})
 	})
 
+	Context("CheckHealth and Restart", func() {
+		BeforeEach(func() {
+			process = &runtimefakes.FakeProcessHandler{}
+			metrics = &runtimefakes.FakeMetricsCollector{}
+			verifyClient = &runtimefakes.FakeVerifyClient{}
+			manager = runtime.NewManagerImpl(nil, metrics, zap.New(), process, verifyClient)
+
+			process.FindMainProcessReturns(1234, nil)
+			process.ReadFileReturns([]byte("10 11 "), nil)
+		})
+
+		It("is healthy", func() {
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+		})
+
+		It("skips the health check when the main process is not found", func() {
+			process.FindMainProcessReturns(0, errors.New("timed out"))
+
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			Expect(process.ReadFileCallCount()).To(Equal(0))
+		})
+
+		It("is unhealthy when the main process has no worker processes", func() {
+			process.ReadFileReturns([]byte("\n"), nil)
+
+			Expect(manager.CheckHealth(context.Background())).To(MatchError(
+				"NGINX main process has no worker processes",
+			))
+		})
+
+		It("is unhealthy when the worker processes crash-loop", func() {
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+
+			for i := range 4 {
+				process.ReadFileReturns([]byte(fmt.Sprintf("10 %d", 20+i)), nil)
+				Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			}
+
+			process.ReadFileReturns([]byte("10 30"), nil)
+			Expect(manager.CheckHealth(context.Background())).To(MatchError(
+				"NGINX worker processes are crash-looping: 5 crashed in the last 5m0s",
+			))
+		})
+
+		It("doesn't consider the workers replaced by a reload or exited gracefully as crashed", func() {
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+
+			for i := range 5 {
+				Expect(manager.Reload(context.Background(), i+1)).To(Succeed())
+
+				process.ReadFileReturns([]byte(fmt.Sprintf("10 11 %d", 20+i)), nil)
+				Expect(manager.CheckHealth(context.Background())).To(Succeed())
+
+				// the old workers exit gracefully
+				process.ReadFileReturns([]byte(fmt.Sprintf("%d", 20+i)), nil)
+				Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			}
+		})
+
+		It("resets the crashes when the main process changes", func() {
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+
+			for i := range 4 {
+				process.ReadFileReturns([]byte(fmt.Sprintf("10 %d", 20+i)), nil)
+				Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			}
+
+			process.FindMainProcessReturns(5678, nil)
+			process.ReadFileReturns([]byte("10 30"), nil)
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+		})
+
+		It("is unhealthy when NGINX doesn't respond in consecutive health checks", func() {
+			verifyClient.GetConfigVersionReturns(0, errors.New("connection refused"))
+
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			Expect(manager.CheckHealth(context.Background())).To(MatchError(
+				"NGINX didn't respond in 3 consecutive health checks: connection refused",
+			))
+		})
+
+		It("resets the unresponsive checks when NGINX responds", func() {
+			verifyClient.GetConfigVersionReturns(0, errors.New("connection refused"))
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+
+			verifyClient.GetConfigVersionReturns(1, nil)
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+
+			verifyClient.GetConfigVersionReturns(0, errors.New("connection refused"))
+			Expect(manager.CheckHealth(context.Background())).To(Succeed())
+		})
+
+		It("restarts NGINX by killing the main process", func() {
+			Expect(manager.Restart(context.Background())).To(Succeed())
+
+			Expect(process.TerminateCallCount()).To(Equal(1))
+			Expect(process.TerminateArgsForCall(0)).To(Equal(1234))
+		})
+
+		It("fails to restart NGINX when the main process is not found", func() {
+			process.FindMainProcessReturns(0, errors.New("timed out"))
+
+			Expect(manager.Restart(context.Background())).To(MatchError(
+				"failed to find NGINX main process: timed out",
+			))
+			Expect(process.TerminateCallCount()).To(Equal(0))
+		})
+
+		It("fails to restart NGINX when the main process can't be killed", func() {
+			process.TerminateReturns(errors.New("operation not permitted"))
+
+			Expect(manager.Restart(context.Background())).To(MatchError(
+				"failed to kill NGINX main process: operation not permitted",
+			))
+		})
+	})
+
 	When("running NGINX plus", func() {
 		BeforeEach(func() {
 			ngxPlusClient = &runtimefakes.FakeNginxPlusClient{}
@@ -10,6 +10,29 @@ import (
 )
 
 type FakeManager struct {
+	CheckHealthStub        func(context.Context) error
+	checkHealthMutex       sync.RWMutex
+	checkHealthArgsForCall []struct {
+		arg1 context.Context
+	}
+	checkHealthReturns struct {
+		result1 error
+	}
+	checkHealthReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetConfigVersionStub        func() (int, error)
+	getConfigVersionMutex       sync.RWMutex
+	getConfigVersionArgsForCall []struct {
+	}
+	getConfigVersionReturns struct {
+		result1 int
+		result2 error
+	}
+	getConfigVersionReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	GetUpstreamsStub        func() (client.Upstreams, error)
 	getUpstreamsMutex       sync.RWMutex
 	getUpstreamsArgsForCall []struct {
@@ -44,6 +67,17 @@ type FakeManager struct {
 	reloadReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestartStub        func(context.Context) error
+	restartMutex       sync.RWMutex
+	restartArgsForCall []struct {
+		arg1 context.Context
+	}
+	restartReturns struct {
+		result1 error
+	}
+	restartReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateHTTPServersStub        func(string, []client.UpstreamServer) error
 	updateHTTPServersMutex       sync.RWMutex
 	updateHTTPServersArgsForCall []struct {
@@ -60,6 +94,123 @@ type FakeManager struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeManager) CheckHealth(arg1 context.Context) error {
+	fake.checkHealthMutex.Lock()
+	ret, specificReturn := fake.checkHealthReturnsOnCall[len(fake.checkHealthArgsForCall)]
+	fake.checkHealthArgsForCall = append(fake.checkHealthArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.CheckHealthStub
+	fakeReturns := fake.checkHealthReturns
+	fake.recordInvocation("CheckHealth", []interface{}{arg1})
+	fake.checkHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManager) CheckHealthCallCount() int {
+	fake.checkHealthMutex.RLock()
+	defer fake.checkHealthMutex.RUnlock()
+	return len(fake.checkHealthArgsForCall)
+}
+
+func (fake *FakeManager) CheckHealthCalls(stub func(context.Context) error) {
+	fake.checkHealthMutex.Lock()
+	defer fake.checkHealthMutex.Unlock()
+	fake.CheckHealthStub = stub
+}
+
+func (fake *FakeManager) CheckHealthArgsForCall(i int) context.Context {
+	fake.checkHealthMutex.RLock()
+	defer fake.checkHealthMutex.RUnlock()
+	argsForCall := fake.checkHealthArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManager) CheckHealthReturns(result1 error) {
+	fake.checkHealthMutex.Lock()
+	defer fake.checkHealthMutex.Unlock()
+	fake.CheckHealthStub = nil
+	fake.checkHealthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) CheckHealthReturnsOnCall(i int, result1 error) {
+	fake.checkHealthMutex.Lock()
+	defer fake.checkHealthMutex.Unlock()
+	fake.CheckHealthStub = nil
+	if fake.checkHealthReturnsOnCall == nil {
+		fake.checkHealthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkHealthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetConfigVersion() (int, error) {
+	fake.getConfigVersionMutex.Lock()
+	ret, specificReturn := fake.getConfigVersionReturnsOnCall[len(fake.getConfigVersionArgsForCall)]
+	fake.getConfigVersionArgsForCall = append(fake.getConfigVersionArgsForCall, struct {
+	}{})
+	stub := fake.GetConfigVersionStub
+	fakeReturns := fake.getConfigVersionReturns
+	fake.recordInvocation("GetConfigVersion", []interface{}{})
+	fake.getConfigVersionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeManager) GetConfigVersionCallCount() int {
+	fake.getConfigVersionMutex.RLock()
+	defer fake.getConfigVersionMutex.RUnlock()
+	return len(fake.getConfigVersionArgsForCall)
+}
+
+func (fake *FakeManager) GetConfigVersionCalls(stub func() (int, error)) {
+	fake.getConfigVersionMutex.Lock()
+	defer fake.getConfigVersionMutex.Unlock()
+	fake.GetConfigVersionStub = stub
+}
+
+func (fake *FakeManager) GetConfigVersionReturns(result1 int, result2 error) {
+	fake.getConfigVersionMutex.Lock()
+	defer fake.getConfigVersionMutex.Unlock()
+	fake.GetConfigVersionStub = nil
+	fake.getConfigVersionReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetConfigVersionReturnsOnCall(i int, result1 int, result2 error) {
+	fake.getConfigVersionMutex.Lock()
+	defer fake.getConfigVersionMutex.Unlock()
+	fake.GetConfigVersionStub = nil
+	if fake.getConfigVersionReturnsOnCall == nil {
+		fake.getConfigVersionReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.getConfigVersionReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) GetUpstreams() (client.Upstreams, error) {
 	fake.getUpstreamsMutex.Lock()
 	ret, specificReturn := fake.getUpstreamsReturnsOnCall[len(fake.getUpstreamsArgsForCall)]
@@ -231,6 +382,67 @@ func (fake *FakeManager) ReloadReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) Restart(arg1 context.Context) error {
+	fake.restartMutex.Lock()
+	ret, specificReturn := fake.restartReturnsOnCall[len(fake.restartArgsForCall)]
+	fake.restartArgsForCall = append(fake.restartArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RestartStub
+	fakeReturns := fake.restartReturns
+	fake.recordInvocation("Restart", []interface{}{arg1})
+	fake.restartMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeManager) RestartCallCount() int {
+	fake.restartMutex.RLock()
+	defer fake.restartMutex.RUnlock()
+	return len(fake.restartArgsForCall)
+}
+
+func (fake *FakeManager) RestartCalls(stub func(context.Context) error) {
+	fake.restartMutex.Lock()
+	defer fake.restartMutex.Unlock()
+	fake.RestartStub = stub
+}
+
+func (fake *FakeManager) RestartArgsForCall(i int) context.Context {
+	fake.restartMutex.RLock()
+	defer fake.restartMutex.RUnlock()
+	argsForCall := fake.restartArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeManager) RestartReturns(result1 error) {
+	fake.restartMutex.Lock()
+	defer fake.restartMutex.Unlock()
+	fake.RestartStub = nil
+	fake.restartReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) RestartReturnsOnCall(i int, result1 error) {
+	fake.restartMutex.Lock()
+	defer fake.restartMutex.Unlock()
+	fake.RestartStub = nil
+	if fake.restartReturnsOnCall == nil {
+		fake.restartReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restartReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) UpdateHTTPServers(arg1 string, arg2 []client.UpstreamServer) error {
 	var arg2Copy []client.UpstreamServer
 	if arg2 != nil {
@@ -301,12 +513,18 @@ func (fake *FakeManager) UpdateHTTPServersReturnsOnCall(i int, result1 error) {
 func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkHealthMutex.RLock()
+	defer fake.checkHealthMutex.RUnlock()
+	fake.getConfigVersionMutex.RLock()
+	defer fake.getConfigVersionMutex.RUnlock()
 	fake.getUpstreamsMutex.RLock()
 	defer fake.getUpstreamsMutex.RUnlock()
 	fake.isPlusMutex.RLock()
 	defer fake.isPlusMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.restartMutex.RLock()
+	defer fake.restartMutex.RUnlock()
 	fake.updateHTTPServersMutex.RLock()
 	defer fake.updateHTTPServersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -48,6 +48,17 @@ type FakeProcessHandler struct {
 		result1 []byte
 		result2 error
 	}
+	TerminateStub        func(int) error
+	terminateMutex       sync.RWMutex
+	terminateArgsForCall []struct {
+		arg1 int
+	}
+	terminateReturns struct {
+		result1 error
+	}
+	terminateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -242,6 +253,67 @@ func (fake *FakeProcessHandler) ReadFileReturnsOnCall(i int, result1 []byte, res
 	}{result1, result2}
 }
 
+func (fake *FakeProcessHandler) Terminate(arg1 int) error {
+	fake.terminateMutex.Lock()
+	ret, specificReturn := fake.terminateReturnsOnCall[len(fake.terminateArgsForCall)]
+	fake.terminateArgsForCall = append(fake.terminateArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.TerminateStub
+	fakeReturns := fake.terminateReturns
+	fake.recordInvocation("Terminate", []interface{}{arg1})
+	fake.terminateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProcessHandler) TerminateCallCount() int {
+	fake.terminateMutex.RLock()
+	defer fake.terminateMutex.RUnlock()
+	return len(fake.terminateArgsForCall)
+}
+
+func (fake *FakeProcessHandler) TerminateCalls(stub func(int) error) {
+	fake.terminateMutex.Lock()
+	defer fake.terminateMutex.Unlock()
+	fake.TerminateStub = stub
+}
+
+func (fake *FakeProcessHandler) TerminateArgsForCall(i int) int {
+	fake.terminateMutex.RLock()
+	defer fake.terminateMutex.RUnlock()
+	argsForCall := fake.terminateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeProcessHandler) TerminateReturns(result1 error) {
+	fake.terminateMutex.Lock()
+	defer fake.terminateMutex.Unlock()
+	fake.TerminateStub = nil
+	fake.terminateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProcessHandler) TerminateReturnsOnCall(i int, result1 error) {
+	fake.terminateMutex.Lock()
+	defer fake.terminateMutex.Unlock()
+	fake.TerminateStub = nil
+	if fake.terminateReturnsOnCall == nil {
+		fake.terminateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.terminateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeProcessHandler) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -251,6 +323,8 @@ func (fake *FakeProcessHandler) Invocations() map[string][][]interface{} {
 	defer fake.killMutex.RUnlock()
 	fake.readFileMutex.RLock()
 	defer fake.readFileMutex.RUnlock()
+	fake.terminateMutex.RLock()
+	defer fake.terminateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
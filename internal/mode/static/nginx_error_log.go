@@ -0,0 +1,224 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	// nginxErrorReportPeriod is the period of the reports of the recurring NGINX errors.
+	nginxErrorReportPeriod = time.Minute
+	// recurringNginxErrorThreshold is the number of the messages of an error pattern in a report period from which
+	// the error is reported as recurring.
+	recurringNginxErrorThreshold = 10
+	// maxNginxErrorLogMessageSize is the maximum size of the syslog messages that NGINX sends.
+	maxNginxErrorLogMessageSize = 4096
+	// syslogTag is the tag that precedes the error log message in the syslog messages of NGINX.
+	syslogTag = "nginx: "
+)
+
+// nginxErrorPattern is a pattern of the NGINX error log messages that the control plane counts.
+type nginxErrorPattern struct {
+	// name is the name of the pattern, which is the value of the pattern label of the metric.
+	name string
+	// substr is the part of the message that identifies the pattern.
+	substr string
+}
+
+// nginxErrorPatterns are the patterns of the recurring NGINX errors that usually point to a problem with a backend
+// or with the capacity of NGINX, rather than with a single request.
+var nginxErrorPatterns = []nginxErrorPattern{
+	{name: "upstream_timed_out", substr: "upstream timed out"},
+	{name: "upstream_connect_failed", substr: "connect() failed"},
+	{name: "upstream_prematurely_closed", substr: "upstream prematurely closed connection"},
+	{name: "no_live_upstreams", substr: "no live upstreams"},
+	{name: "client_body_too_large", substr: "client intended to send too large body"},
+	{name: "worker_connections_not_enough", substr: "worker_connections are not enough"},
+	{name: "too_many_open_files", substr: "Too many open files"},
+}
+
+// nginxErrorLogCollector records the NGINX errors.
+type nginxErrorLogCollector interface {
+	IncNginxErrors(pattern string)
+}
+
+// recurringNginxError is an NGINX error pattern that was logged in the current report period.
+type recurringNginxError struct {
+	// sample is the first message of the pattern in the period.
+	sample string
+	count  int
+}
+
+// nginxErrorLogProcessor receives the messages of the NGINX error log over a unix socket, and surfaces the recurring
+// errors. Every message that matches an error pattern is counted by the metrics, but the processor logs a recurring
+// error once per report period, and emits a warning Event for the Gateway, instead of logging every message.
+// Implements the manager.Runnable interface.
+type nginxErrorLogProcessor struct {
+	// errors are the errors of the current report period, keyed by the name of the pattern.
+	errors map[string]*recurringNginxError
+	// latestGraph returns the latest graph, whose Gateway gets the Events.
+	latestGraph   func() *graph.Graph
+	eventRecorder record.EventRecorder
+	metrics       nginxErrorLogCollector
+	logger        logr.Logger
+	socket        string
+	lock          sync.Mutex
+}
+
+// Start listens on the unix socket and processes the messages until the context is canceled.
+func (p *nginxErrorLogProcessor) Start(ctx context.Context) error {
+	if err := os.Remove(p.socket); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove the stale socket %s: %w", p.socket, err)
+	}
+
+	conn, err := net.ListenPacket("unixgram", p.socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", p.socket, err)
+	}
+
+	// the NGINX worker processes run as a different user
+	if err := os.Chmod(p.socket, 0o666); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to set the permissions of %s: %w", p.socket, err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(nginxErrorReportPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				conn.Close()
+				return
+			case <-ticker.C:
+				p.report()
+			}
+		}
+	}()
+
+	buf := make([]byte, maxNginxErrorLogMessageSize)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to read from %s: %w", p.socket, err)
+		}
+
+		p.process(string(buf[:n]))
+	}
+}
+
+// process counts the message if it matches an error pattern.
+func (p *nginxErrorLogProcessor) process(msg string) {
+	pattern, matched := matchNginxErrorPattern(msg)
+	if !matched {
+		return
+	}
+
+	p.metrics.IncNginxErrors(pattern)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.errors == nil {
+		p.errors = make(map[string]*recurringNginxError)
+	}
+
+	e, exists := p.errors[pattern]
+	if !exists {
+		e = &recurringNginxError{sample: stripSyslogHeader(msg)}
+		p.errors[pattern] = e
+	}
+
+	e.count++
+}
+
+// report logs the errors of the report period that recurred at least recurringNginxErrorThreshold times and emits
+// an Event for each of them, and then starts a new period.
+func (p *nginxErrorLogProcessor) report() {
+	p.lock.Lock()
+	errs := p.errors
+	p.errors = nil
+	p.lock.Unlock()
+
+	patterns := make([]string, 0, len(errs))
+	for pattern, e := range errs {
+		if e.count >= recurringNginxErrorThreshold {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	if len(patterns) == 0 {
+		return
+	}
+
+	sort.Strings(patterns)
+
+	var gw *graph.Gateway
+	if gr := p.latestGraph(); gr != nil {
+		gw = gr.Gateway
+	}
+
+	for _, pattern := range patterns {
+		e := errs[pattern]
+
+		p.logger.Info(
+			"NGINX logged recurring errors",
+			"pattern", pattern,
+			"count", e.count,
+			"period", nginxErrorReportPeriod.String(),
+			"sample", e.sample,
+		)
+
+		if gw != nil && gw.Source != nil {
+			p.eventRecorder.Eventf(
+				gw.Source,
+				v1.EventTypeWarning,
+				"NginxErrorsRecurring",
+				"NGINX logged %d %s errors in the last %s, for example: %s",
+				e.count,
+				pattern,
+				nginxErrorReportPeriod.String(),
+				e.sample,
+			)
+		}
+	}
+}
+
+// matchNginxErrorPattern returns the name of the error pattern of the message.
+func matchNginxErrorPattern(msg string) (string, bool) {
+	for _, pattern := range nginxErrorPatterns {
+		if strings.Contains(msg, pattern.substr) {
+			return pattern.name, true
+		}
+	}
+
+	return "", false
+}
+
+// stripSyslogHeader returns the error log message of a syslog message of NGINX.
+func stripSyslogHeader(msg string) string {
+	if idx := strings.Index(msg, syslogTag); idx != -1 {
+		msg = msg[idx+len(syslogTag):]
+	}
+
+	return strings.TrimSpace(msg)
+}
@@ -0,0 +1,149 @@
+package static
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+type fakeNginxErrorLogCollector struct {
+	errors map[string]int
+}
+
+func (c *fakeNginxErrorLogCollector) IncNginxErrors(pattern string) {
+	if c.errors == nil {
+		c.errors = make(map[string]int)
+	}
+
+	c.errors[pattern]++
+}
+
+func TestMatchNginxErrorPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg             string
+		name            string
+		expPattern      string
+		expectedMatched bool
+	}{
+		{
+			msg: "<187>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [error] 12#12: *3 upstream timed out " +
+				"(110: Connection timed out) while reading response header from upstream",
+			name:            "upstream timed out",
+			expPattern:      "upstream_timed_out",
+			expectedMatched: true,
+		},
+		{
+			msg: "<187>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [error] 12#12: *3 connect() failed " +
+				"(111: Connection refused) while connecting to upstream",
+			name:            "connect failed",
+			expPattern:      "upstream_connect_failed",
+			expectedMatched: true,
+		},
+		{
+			msg:             "<187>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [error] 12#12: *3 no live upstreams",
+			name:            "no live upstreams",
+			expPattern:      "no_live_upstreams",
+			expectedMatched: true,
+		},
+		{
+			msg:             "<188>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [warn] 12#12: *3 an upstream response is buffered",
+			name:            "unknown message",
+			expectedMatched: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			pattern, matched := matchNginxErrorPattern(test.msg)
+			g.Expect(matched).To(Equal(test.expectedMatched))
+			g.Expect(pattern).To(Equal(test.expPattern))
+		})
+	}
+}
+
+func TestNginxErrorLogProcessorReport(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	const (
+		timedOutMsg = "<187>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [error] 12#12: *3 upstream timed out"
+		refusedMsg  = "<187>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [error] 12#12: *4 connect() failed"
+	)
+
+	gw := &v1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+
+	collector := &fakeNginxErrorLogCollector{}
+	recorder := record.NewFakeRecorder(10)
+
+	processor := &nginxErrorLogProcessor{
+		latestGraph: func() *graph.Graph {
+			return &graph.Graph{Gateway: &graph.Gateway{Source: gw}}
+		},
+		eventRecorder: recorder,
+		metrics:       collector,
+		logger:        logr.Discard(),
+	}
+
+	for range recurringNginxErrorThreshold {
+		processor.process(timedOutMsg)
+	}
+	processor.process(refusedMsg)
+	processor.process("<188>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [warn] 12#12: *5 a client request body is buffered")
+
+	g.Expect(collector.errors).To(Equal(map[string]int{
+		"upstream_timed_out":      recurringNginxErrorThreshold,
+		"upstream_connect_failed": 1,
+	}))
+
+	// Only the recurring error is reported.
+	processor.report()
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(Equal(
+		"Warning NginxErrorsRecurring NGINX logged 10 upstream_timed_out errors in the last 1m0s, " +
+			"for example: 2026/10/17 10:00:00 [error] 12#12: *3 upstream timed out",
+	))
+
+	// The counts are reset after the report.
+	processor.process(timedOutMsg)
+	processor.report()
+	g.Expect(recorder.Events).To(BeEmpty())
+}
+
+func TestNginxErrorLogProcessorReport_NoGateway(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	recorder := record.NewFakeRecorder(10)
+
+	processor := &nginxErrorLogProcessor{
+		latestGraph: func() *graph.Graph {
+			return nil
+		},
+		eventRecorder: recorder,
+		metrics:       &fakeNginxErrorLogCollector{},
+		logger:        logr.Discard(),
+	}
+
+	for range recurringNginxErrorThreshold {
+		processor.process("<187>Oct 17 10:00:00 nginx: 2026/10/17 10:00:00 [error] 12#12: *3 no live upstreams")
+	}
+
+	g.Expect(processor.report).ToNot(Panic())
+	g.Expect(recorder.Events).To(BeEmpty())
+}
@@ -0,0 +1,286 @@
+package static
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// configResource identifies a generation of a Route or a Policy whose configuration is in the NGINX configuration.
+type configResource struct {
+	// kind is the kind of the Routes, such as HTTPRoute, and the Go type of the Policies.
+	kind       string
+	nsName     types.NamespacedName
+	generation int64
+}
+
+// routeResource returns the configResource of the Route of the rule.
+func routeResource(mr dataplane.MatchRule) configResource {
+	return configResource{
+		kind:       mr.SourceKind,
+		nsName:     types.NamespacedName{Namespace: mr.Source.Namespace, Name: mr.Source.Name},
+		generation: mr.Source.Generation,
+	}
+}
+
+// routeResourceOf returns the configResource of a Route of the graph.
+func routeResourceOf(route *graph.L7Route) configResource {
+	kind := kinds.HTTPRoute
+	if route.RouteType == graph.RouteTypeGRPC {
+		kind = kinds.GRPCRoute
+	}
+
+	return configResource{
+		kind:       kind,
+		nsName:     client.ObjectKeyFromObject(route.Source),
+		generation: route.Source.GetGeneration(),
+	}
+}
+
+func policyResource(pol policies.Policy) configResource {
+	return configResource{
+		kind:       fmt.Sprintf("%T", pol),
+		nsName:     client.ObjectKeyFromObject(pol),
+		generation: pol.GetGeneration(),
+	}
+}
+
+func compareConfigResources(a, b configResource) int {
+	return cmp.Or(
+		cmp.Compare(a.kind, b.kind),
+		cmp.Compare(a.nsName.Namespace, b.nsName.Namespace),
+		cmp.Compare(a.nsName.Name, b.nsName.Name),
+		cmp.Compare(a.generation, b.generation),
+	)
+}
+
+// configResources returns the Routes whose rules are in the servers of the configuration, and the Policies
+// that apply to its servers, rules and upstreams.
+func configResources(conf dataplane.Configuration) map[configResource]struct{} {
+	resources := make(map[configResource]struct{})
+
+	addPolicies := func(pols []policies.Policy) {
+		for _, pol := range pols {
+			resources[policyResource(pol)] = struct{}{}
+		}
+	}
+
+	for _, servers := range [][]dataplane.VirtualServer{conf.HTTPServers, conf.SSLServers} {
+		for _, s := range servers {
+			addPolicies(s.Policies)
+
+			for _, pr := range s.PathRules {
+				addPolicies(pr.Policies)
+
+				for _, mr := range pr.MatchRules {
+					if mr.Source != nil {
+						resources[routeResource(mr)] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	for _, u := range conf.Upstreams {
+		addPolicies(u.Policies)
+	}
+
+	return resources
+}
+
+// addedConfigResources returns the Routes and the Policies that are in the configuration, but not in the previous
+// one. Such resources were added or changed since the previous configuration.
+func addedConfigResources(prevConf, conf dataplane.Configuration) []configResource {
+	prevResources := configResources(prevConf)
+
+	var added []configResource
+	for res := range configResources(conf) {
+		if _, exists := prevResources[res]; !exists {
+			added = append(added, res)
+		}
+	}
+
+	slices.SortFunc(added, compareConfigResources)
+
+	return added
+}
+
+// sortedConfigResources returns the resources of the configuration in a stable order.
+func sortedConfigResources(conf dataplane.Configuration) []configResource {
+	resources := make([]configResource, 0)
+	for res := range configResources(conf) {
+		resources = append(resources, res)
+	}
+
+	slices.SortFunc(resources, compareConfigResources)
+
+	return resources
+}
+
+// withoutConfigResources returns a copy of the configuration without the rules of the Routes and without
+// the Policies. The PathRules that are left without any rules are removed.
+func withoutConfigResources(conf dataplane.Configuration, excluded []configResource) dataplane.Configuration {
+	if len(excluded) == 0 {
+		return conf
+	}
+
+	excludedPolicy := func(pol policies.Policy) bool {
+		return slices.Contains(excluded, policyResource(pol))
+	}
+
+	excludedRule := func(mr dataplane.MatchRule) bool {
+		return mr.Source != nil && slices.Contains(excluded, routeResource(mr))
+	}
+
+	filterPolicies := func(pols []policies.Policy) []policies.Policy {
+		if pols == nil {
+			return nil
+		}
+
+		return slices.DeleteFunc(slices.Clone(pols), excludedPolicy)
+	}
+
+	filterServers := func(servers []dataplane.VirtualServer) []dataplane.VirtualServer {
+		if servers == nil {
+			return nil
+		}
+
+		filtered := make([]dataplane.VirtualServer, 0, len(servers))
+		for _, s := range servers {
+			var pathRules []dataplane.PathRule
+			for _, pr := range s.PathRules {
+				matchRules := slices.DeleteFunc(slices.Clone(pr.MatchRules), excludedRule)
+				if len(matchRules) == 0 && len(pr.MatchRules) > 0 {
+					continue
+				}
+
+				pr.MatchRules = matchRules
+				pr.Policies = filterPolicies(pr.Policies)
+				pathRules = append(pathRules, pr)
+			}
+
+			s.PathRules = pathRules
+			s.Policies = filterPolicies(s.Policies)
+			filtered = append(filtered, s)
+		}
+
+		return filtered
+	}
+
+	conf.HTTPServers = filterServers(conf.HTTPServers)
+	conf.SSLServers = filterServers(conf.SSLServers)
+
+	if conf.Upstreams != nil {
+		upstreams := make([]dataplane.Upstream, 0, len(conf.Upstreams))
+		for _, u := range conf.Upstreams {
+			u.Policies = filterPolicies(u.Policies)
+			upstreams = append(upstreams, u)
+		}
+
+		conf.Upstreams = upstreams
+	}
+
+	return conf
+}
+
+// withoutQuarantinedResources returns the configuration without the quarantined Routes and Policies. The resources
+// that are no longer in the configuration, or changed, are released from the quarantine first, so that a fixed
+// resource is applied again.
+func (h *eventHandlerImpl) withoutQuarantinedResources(conf dataplane.Configuration) dataplane.Configuration {
+	if len(h.quarantinedResources) == 0 {
+		return conf
+	}
+
+	resources := configResources(conf)
+	for res := range h.quarantinedResources {
+		if _, exists := resources[res]; !exists {
+			delete(h.quarantinedResources, res)
+		}
+	}
+
+	quarantined := make([]configResource, 0, len(h.quarantinedResources))
+	for res := range h.quarantinedResources {
+		quarantined = append(quarantined, res)
+	}
+
+	return withoutConfigResources(conf, quarantined)
+}
+
+// quarantineResources quarantines the Routes and the Policies, and emits a warning Event for each of them.
+func (h *eventHandlerImpl) quarantineResources(gr *graph.Graph, resources []configResource, applyErr error) {
+	if h.quarantinedResources == nil {
+		h.quarantinedResources = make(map[configResource]string, len(resources))
+	}
+
+	msg := "The resource was excluded from the NGINX configuration until it changes, " +
+		"because NGINX failed to generate or apply the configuration with it: " + applyErr.Error()
+
+	for _, res := range resources {
+		h.quarantinedResources[res] = msg
+	}
+
+	if gr == nil {
+		return
+	}
+
+	for _, route := range gr.Routes {
+		if route.Source != nil && slices.Contains(resources, routeResourceOf(route)) {
+			h.cfg.eventRecorder.Eventf(route.Source, v1.EventTypeWarning, "RouteQuarantined", "%s", msg)
+		}
+	}
+
+	for _, pol := range gr.NGFPolicies {
+		if pol.Source != nil && slices.Contains(resources, policyResource(pol.Source)) {
+			h.cfg.eventRecorder.Eventf(pol.Source, v1.EventTypeWarning, "PolicyQuarantined", "%s", msg)
+		}
+	}
+}
+
+// setQuarantinedConditions sets the conditions of the quarantined Routes and Policies of the graph, so that their
+// statuses report that they are excluded from the NGINX configuration.
+func (h *eventHandlerImpl) setQuarantinedConditions(gr *graph.Graph) {
+	if len(h.quarantinedResources) == 0 {
+		return
+	}
+
+	for _, route := range gr.Routes {
+		if route.Source == nil {
+			continue
+		}
+
+		if msg, quarantined := h.quarantinedResources[routeResourceOf(route)]; quarantined {
+			route.Conditions = appendCondition(route.Conditions, staticConds.NewRouteQuarantined(msg))
+		}
+	}
+
+	for _, pol := range gr.NGFPolicies {
+		if pol.Source == nil {
+			continue
+		}
+
+		if msg, quarantined := h.quarantinedResources[policyResource(pol.Source)]; quarantined {
+			pol.Conditions = appendCondition(pol.Conditions, staticConds.NewPolicyNotAcceptedQuarantined(msg))
+		}
+	}
+}
+
+// appendCondition appends the condition unless the conditions already have it, because the statuses can be
+// written several times for the same graph.
+func appendCondition(conds []conditions.Condition, cond conditions.Condition) []conditions.Condition {
+	if slices.Contains(conds, cond) {
+		return conds
+	}
+
+	return append(conds, cond)
+}
@@ -0,0 +1,162 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// referencedObjects tracks the Secrets and the ConfigMaps that the Graph references, so that only those are
+// processed. Their controllers only cache and watch their metadata, so that the data of all the Secrets and
+// the ConfigMaps of the cluster is not kept in memory. The full resources are got from the API server instead:
+// by the controllers, when a referenced resource changes, and by the event loop, when the Graph starts
+// referencing a resource.
+type referencedObjects struct {
+	// reader gets the resources from the API server.
+	reader     client.Reader
+	secrets    *referencedKind
+	configMaps *referencedKind
+}
+
+// referencedKind tracks the referenced resources of one kind.
+type referencedKind struct {
+	// newObject creates an empty resource of the kind.
+	newObject func() client.Object
+	// referencedNames returns the names of the resources of the kind that the Graph references.
+	referencedNames func(g *graph.Graph) map[types.NamespacedName]struct{}
+	// always are the resources that are processed even if the Graph doesn't reference them.
+	always map[types.NamespacedName]struct{}
+	// referenced are the resources that the latest Graph references, and the always processed resources.
+	// The controller reads them, so they are protected by lock.
+	referenced map[types.NamespacedName]struct{}
+	// fetched are the referenced resources that were got from the API server, including the ones that
+	// don't exist. They are only used by the event loop.
+	fetched map[types.NamespacedName]struct{}
+	lock    sync.RWMutex
+}
+
+// newReferencedObjects creates a new referencedObjects. The NGINX Plus usage reporting Secret is always processed,
+// if it is set. ConfigMaps are only tracked if watchConfigMaps is true.
+func newReferencedObjects(
+	reader client.Reader,
+	usageSecret *types.NamespacedName,
+	watchConfigMaps bool,
+) *referencedObjects {
+	r := &referencedObjects{
+		reader: reader,
+		secrets: newReferencedKind(
+			func() client.Object { return &v1.Secret{} },
+			(*graph.Graph).ReferencedSecretNames,
+		),
+	}
+
+	if usageSecret != nil {
+		r.secrets.always[*usageSecret] = struct{}{}
+		r.secrets.referenced[*usageSecret] = struct{}{}
+	}
+
+	if watchConfigMaps {
+		r.configMaps = newReferencedKind(
+			func() client.Object { return &v1.ConfigMap{} },
+			(*graph.Graph).ReferencedConfigMapNames,
+		)
+	}
+
+	return r
+}
+
+func newReferencedKind(
+	newObject func() client.Object,
+	referencedNames func(g *graph.Graph) map[types.NamespacedName]struct{},
+) *referencedKind {
+	return &referencedKind{
+		newObject:       newObject,
+		referencedNames: referencedNames,
+		always:          make(map[types.NamespacedName]struct{}),
+		referenced:      make(map[types.NamespacedName]struct{}),
+		fetched:         make(map[types.NamespacedName]struct{}),
+	}
+}
+
+// filter is the NamespacedNameFilterFunc of the controller of the kind, which only processes
+// the referenced resources.
+func (k *referencedKind) filter(nsname types.NamespacedName) (shouldProcess bool, msg string) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+
+	if _, referenced := k.referenced[nsname]; !referenced {
+		return false, "Resource is ignored because it is not referenced"
+	}
+
+	return true, ""
+}
+
+// update updates the referenced resources to the resources that the Graph references. It returns the events
+// that capture the resources that the Graph started referencing, got from the API server, and that drop
+// the resources that the Graph no longer references. The resources that couldn't be got are got again
+// on the next update.
+func (r *referencedObjects) update(ctx context.Context, g *graph.Graph) ([]interface{}, error) {
+	batch, err := r.secrets.update(ctx, r.reader, g)
+
+	if r.configMaps != nil {
+		configMapsBatch, configMapsErr := r.configMaps.update(ctx, r.reader, g)
+		batch = append(batch, configMapsBatch...)
+		err = errors.Join(err, configMapsErr)
+	}
+
+	return batch, err
+}
+
+func (k *referencedKind) update(ctx context.Context, reader client.Reader, g *graph.Graph) ([]interface{}, error) {
+	referenced := k.referencedNames(g)
+	for nsname := range k.always {
+		referenced[nsname] = struct{}{}
+	}
+
+	// The resources are referenced before they are got, so that the controller processes the changes
+	// that happen after they are got.
+	k.lock.Lock()
+	k.referenced = referenced
+	k.lock.Unlock()
+
+	var batch []interface{}
+	var errs []error
+
+	for nsname := range referenced {
+		if _, fetched := k.fetched[nsname]; fetched {
+			continue
+		}
+
+		obj := k.newObject()
+		if err := reader.Get(ctx, nsname, obj); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to get %T %s: %w", obj, nsname, err))
+				continue
+			}
+		} else {
+			batch = append(batch, &events.UpsertEvent{Resource: obj})
+		}
+
+		k.fetched[nsname] = struct{}{}
+	}
+
+	for nsname := range k.fetched {
+		if _, exists := referenced[nsname]; exists {
+			continue
+		}
+
+		delete(k.fetched, nsname)
+		batch = append(batch, &events.DeleteEvent{Type: k.newObject(), NamespacedName: nsname})
+	}
+
+	return batch, errors.Join(errs...)
+}
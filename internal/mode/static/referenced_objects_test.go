@@ -0,0 +1,137 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/events"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func isProcessed(k *referencedKind, nsname types.NamespacedName) bool {
+	shouldProcess, _ := k.filter(nsname)
+	return shouldProcess
+}
+
+func TestReferencedObjectsUpdate(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	tlsSecret := types.NamespacedName{Namespace: "test", Name: "tls"}
+	missingSecret := types.NamespacedName{Namespace: "test", Name: "missing"}
+	usageSecret := types.NamespacedName{Namespace: "nginx-gateway", Name: "usage"}
+	caConfigMap := types.NamespacedName{Namespace: "test", Name: "ca"}
+
+	k8sClient := fake.NewClientBuilder().WithObjects(
+		&v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: tlsSecret.Namespace, Name: tlsSecret.Name}},
+		&v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: usageSecret.Namespace, Name: usageSecret.Name}},
+		&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: caConfigMap.Namespace, Name: caConfigMap.Name}},
+	).Build()
+
+	referenced := newReferencedObjects(k8sClient, &usageSecret, true)
+
+	upsertedNames := func(batch []interface{}) []types.NamespacedName {
+		var names []types.NamespacedName
+		for _, e := range batch {
+			if upsert, ok := e.(*events.UpsertEvent); ok {
+				names = append(names, client.ObjectKeyFromObject(upsert.Resource))
+			}
+		}
+		return names
+	}
+
+	// the usage reporting Secret is processed before any Graph is built
+	g.Expect(isProcessed(referenced.secrets, usageSecret)).To(BeTrue())
+	g.Expect(isProcessed(referenced.secrets, tlsSecret)).To(BeFalse())
+
+	gr := &graph.Graph{
+		ReferencedSecrets: map[types.NamespacedName]*graph.Secret{
+			tlsSecret:     {},
+			missingSecret: {},
+		},
+		ReferencedCaCertConfigMaps: map[types.NamespacedName]*graph.CaCertConfigMap{
+			caConfigMap: {},
+		},
+	}
+
+	batch, err := referenced.update(context.Background(), gr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(batch).To(HaveLen(3))
+	g.Expect(upsertedNames(batch)).To(ConsistOf(tlsSecret, usageSecret, caConfigMap))
+
+	g.Expect(isProcessed(referenced.secrets, tlsSecret)).To(BeTrue())
+	g.Expect(isProcessed(referenced.secrets, missingSecret)).To(BeTrue())
+	g.Expect(isProcessed(referenced.configMaps, caConfigMap)).To(BeTrue())
+
+	// the resources are only got when the Graph starts referencing them
+	batch, err = referenced.update(context.Background(), gr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(batch).To(BeEmpty())
+
+	// the resources that the Graph no longer references are dropped, except the usage reporting Secret
+	batch, err = referenced.update(context.Background(), &graph.Graph{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(batch).To(ConsistOf(
+		&events.DeleteEvent{Type: &v1.Secret{}, NamespacedName: tlsSecret},
+		&events.DeleteEvent{Type: &v1.Secret{}, NamespacedName: missingSecret},
+		&events.DeleteEvent{Type: &v1.ConfigMap{}, NamespacedName: caConfigMap},
+	))
+
+	g.Expect(isProcessed(referenced.secrets, tlsSecret)).To(BeFalse())
+	g.Expect(isProcessed(referenced.secrets, usageSecret)).To(BeTrue())
+	g.Expect(isProcessed(referenced.configMaps, caConfigMap)).To(BeFalse())
+}
+
+func TestReferencedObjectsUpdateGetFails(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	secret := types.NamespacedName{Namespace: "test", Name: "tls"}
+
+	fail := true
+	k8sClient := fake.NewClientBuilder().
+		WithObjects(&v1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: secret.Namespace, Name: secret.Name}}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(
+				ctx context.Context,
+				c client.WithWatch,
+				key client.ObjectKey,
+				obj client.Object,
+				opts ...client.GetOption,
+			) error {
+				if fail {
+					return errors.New("test error")
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	referenced := newReferencedObjects(k8sClient, nil, false)
+	g.Expect(referenced.configMaps).To(BeNil())
+
+	gr := &graph.Graph{
+		ReferencedSecrets: map[types.NamespacedName]*graph.Secret{secret: {}},
+	}
+
+	batch, err := referenced.update(context.Background(), gr)
+	g.Expect(err).To(MatchError(ContainSubstring("test error")))
+	g.Expect(batch).To(BeEmpty())
+	g.Expect(isProcessed(referenced.secrets, secret)).To(BeTrue())
+
+	// the Secret is got again on the next update
+	fail = false
+
+	batch, err = referenced.update(context.Background(), gr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(batch).To(HaveLen(1))
+}
@@ -0,0 +1,61 @@
+package static
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+// registeredPolicies are the Policies registered in the policies package, which NGF supports in addition to
+// the built-in NGF Policies.
+type registeredPolicies []policies.Registration
+
+// addToScheme adds the types of the Policies to the scheme.
+func (r registeredPolicies) addToScheme(s *runtime.Scheme) error {
+	for _, reg := range r {
+		if err := reg.AddToScheme(s); err != nil {
+			return fmt.Errorf("cannot add registered policy %T to scheme: %w", reg.Object, err)
+		}
+	}
+
+	return nil
+}
+
+// objects returns the empty objects of the Policies.
+func (r registeredPolicies) objects() []policies.Policy {
+	objs := make([]policies.Policy, 0, len(r))
+	for _, reg := range r {
+		objs = append(objs, reg.Object)
+	}
+
+	return objs
+}
+
+// objectLists returns the empty lists of the Policies.
+func (r registeredPolicies) objectLists() []client.ObjectList {
+	lists := make([]client.ObjectList, 0, len(r))
+	for _, reg := range r {
+		lists = append(lists, reg.ObjectList)
+	}
+
+	return lists
+}
+
+// managerConfigs returns the configs to register the Policies with the CompositeValidator.
+func (r registeredPolicies) managerConfigs(mustExtractGVK kinds.MustExtractGVK) []policies.ManagerConfig {
+	cfgs := make([]policies.ManagerConfig, 0, len(r))
+	for _, reg := range r {
+		cfgs = append(cfgs, policies.ManagerConfig{
+			GVK:            mustExtractGVK(reg.Object),
+			Validator:      reg.Validator,
+			Merger:         reg.Merger,
+			LocationScoped: reg.LocationScoped,
+		})
+	}
+
+	return cfgs
+}
@@ -0,0 +1,60 @@
+package static
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+)
+
+func TestRegisteredPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	validator := &policiesfakes.FakeValidator{}
+	merger := &policiesfakes.FakeMerger{}
+
+	registered := registeredPolicies{
+		{
+			Object:         &ngfAPI.ClientSettingsPolicy{},
+			ObjectList:     &ngfAPI.ClientSettingsPolicyList{},
+			AddToScheme:    ngfAPI.AddToScheme,
+			Validator:      validator,
+			Generator:      &policiesfakes.FakeGenerator{},
+			Merger:         merger,
+			LocationScoped: true,
+		},
+	}
+
+	s := runtime.NewScheme()
+	g.Expect(registered.addToScheme(s)).To(Succeed())
+	g.Expect(s.Recognizes(ngfAPI.SchemeGroupVersion.WithKind(kinds.ClientSettingsPolicy))).To(BeTrue())
+
+	g.Expect(registered.objects()).To(Equal([]policies.Policy{&ngfAPI.ClientSettingsPolicy{}}))
+	g.Expect(registered.objectLists()).To(Equal([]client.ObjectList{&ngfAPI.ClientSettingsPolicyList{}}))
+
+	mustExtractGVK := kinds.NewMustExtractGKV(s)
+	g.Expect(registered.managerConfigs(mustExtractGVK)).To(Equal([]policies.ManagerConfig{
+		{
+			GVK:            ngfAPI.SchemeGroupVersion.WithKind(kinds.ClientSettingsPolicy),
+			Validator:      validator,
+			Merger:         merger,
+			LocationScoped: true,
+		},
+	}))
+
+	failing := registeredPolicies{
+		{
+			Object:      &ngfAPI.ClientSettingsPolicy{},
+			AddToScheme: func(_ *runtime.Scheme) error { return errors.New("test") },
+		},
+	}
+	g.Expect(failing.addToScheme(runtime.NewScheme())).To(MatchError(ContainSubstring("test")))
+}
@@ -0,0 +1,243 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// defaultReloadHealthCheckBackoff is the backoff of the health checks of NGINX after a reload. NGINX can fail
+// a health check right after a reload without a problem with the configuration, for example, if a worker process
+// crashes or NGINX is slow to respond, so the health check is retried before the configuration is considered bad.
+var defaultReloadHealthCheckBackoff = wait.Backoff{
+	Duration: 500 * time.Millisecond,
+	Factor:   2,
+	Steps:    3,
+}
+
+// maxNginxConfAttempts is the maximum number of the attempts to apply a configuration to NGINX, counting the retry
+// of the configuration, the attempts to isolate the offending resources, and the rollback. Every attempt can reload
+// NGINX, so the number is small.
+const maxNginxConfAttempts = 7
+
+// isolationAttempts is the number of the attempts that the isolation of a suspected resource can take: applying
+// the configuration without it, confirming that the configuration with it fails again, and applying the
+// configuration without it again.
+const isolationAttempts = 3
+
+// nginxConfAttempts counts the attempts to apply a configuration to NGINX.
+type nginxConfAttempts struct {
+	h    *eventHandlerImpl
+	left int
+}
+
+func (a *nginxConfAttempts) try(ctx context.Context, conf dataplane.Configuration) error {
+	a.left--
+	return a.h.tryNginxConf(ctx, conf)
+}
+
+// applyNginxConf applies the configuration to NGINX. If NGINX fails to generate or apply it, or is unhealthy after
+// the reload, the configuration is applied once more, because the failure can be transient, for example, if a worker
+// process crashed. If it fails again, the Routes and the Policies that caused the failure are quarantined: they are
+// excluded from the configuration until they change, and the configuration is applied without them. NGF tries to
+// find the offending resource by excluding the suspected resources one at a time, and then all of them together.
+// A suspected resource is only quarantined if the configuration with it fails again after the configuration without
+// it was applied, so that a transient failure doesn't quarantine a resource. The suspected resources are the
+// resources that were added or changed since the last applied configuration, or all resources if NGINX hasn't
+// applied any configuration yet. If NGINX fails to apply the configuration without them too, NGINX is rolled back to
+// the last applied configuration, so that one bad resource can't take down all routing. NGF makes at most
+// maxNginxConfAttempts attempts for a configuration.
+//
+// It returns the configuration that NGINX runs, and the error of applying the configuration unless it was applied
+// with or without the quarantined resources.
+func (h *eventHandlerImpl) applyNginxConf(
+	ctx context.Context,
+	logger logr.Logger,
+	gr *graph.Graph,
+	conf dataplane.Configuration,
+) (dataplane.Configuration, error) {
+	h.nginxLock.Lock()
+	defer h.nginxLock.Unlock()
+
+	attempts := &nginxConfAttempts{h: h, left: maxNginxConfAttempts}
+
+	err := attempts.try(ctx, conf)
+	if err == nil {
+		h.lastAppliedConfiguration = &conf
+		return conf, nil
+	}
+
+	logger.Error(err, "Failed to apply NGINX configuration, retrying", "version", conf.Version)
+
+	if err = attempts.try(ctx, conf); err == nil {
+		h.lastAppliedConfiguration = &conf
+		return conf, nil
+	}
+
+	var suspects []configResource
+	if h.lastAppliedConfiguration != nil {
+		suspects = addedConfigResources(*h.lastAppliedConfiguration, conf)
+	} else {
+		suspects = sortedConfigResources(conf)
+	}
+
+	if len(suspects) > 0 {
+		logger.Error(
+			err,
+			"Failed to apply NGINX configuration, applying it without the suspected resources",
+			"version", conf.Version,
+		)
+	}
+
+	if offenders, isolatedConf, ok := h.isolateOffenders(ctx, conf, suspects, attempts); ok {
+		if len(offenders) > 0 {
+			h.quarantineResources(gr, offenders, err)
+		}
+
+		h.lastAppliedConfiguration = &isolatedConf
+
+		return isolatedConf, nil
+	}
+
+	if h.lastAppliedConfiguration == nil {
+		return conf, err
+	}
+
+	lastApplied := *h.lastAppliedConfiguration
+
+	logger.Error(
+		err,
+		"Failed to apply NGINX configuration, rolling back to the last applied configuration",
+		"version", conf.Version,
+		"rollbackVersion", lastApplied.Version,
+	)
+
+	if rollbackErr := attempts.try(ctx, lastApplied); rollbackErr != nil {
+		return conf, errors.Join(err, fmt.Errorf("failed to roll back NGINX configuration: %w", rollbackErr))
+	}
+
+	if gr != nil && gr.Gateway != nil && gr.Gateway.Source != nil {
+		h.cfg.eventRecorder.Eventf(
+			gr.Gateway.Source,
+			v1.EventTypeWarning,
+			"ConfigurationRolledBack",
+			"NGINX failed to apply configuration version %d and was rolled back to version %d: %s",
+			conf.Version,
+			lastApplied.Version,
+			err.Error(),
+		)
+	}
+
+	return lastApplied, err
+}
+
+// isolateOffenders applies the configuration without the suspected resources, first without each of them alone,
+// and then, if NGINX applied a configuration before, without all of them, as long as the attempts allow it.
+// It keeps an attempt for the rollback, so it might not try to exclude all the suspected resources. It returns the
+// excluded resources and the applied configuration, or false if NGINX failed to apply all of the configurations.
+// If NGINX applies the configuration with the excluded resources when they are included again to confirm that they
+// are the offenders, no resources are excluded.
+// The caller must hold nginxLock.
+func (h *eventHandlerImpl) isolateOffenders(
+	ctx context.Context,
+	conf dataplane.Configuration,
+	suspects []configResource,
+	attempts *nginxConfAttempts,
+) ([]configResource, dataplane.Configuration, bool) {
+	if len(suspects) == 0 {
+		return nil, dataplane.Configuration{}, false
+	}
+
+	// Excluding all resources from the first configuration would leave NGINX without routing, which is not better
+	// than failing to apply it.
+	excludeAll := len(suspects) > 1 && h.lastAppliedConfiguration != nil
+
+	for _, suspect := range suspects {
+		// keep an attempt for the rollback
+		if attempts.left < isolationAttempts+1 {
+			break
+		}
+
+		excluded := []configResource{suspect}
+		if offenders, applied, done, ok := h.tryWithout(ctx, conf, excluded, attempts); done {
+			return offenders, applied, ok
+		}
+	}
+
+	if !excludeAll || attempts.left < isolationAttempts+1 {
+		return nil, dataplane.Configuration{}, false
+	}
+
+	offenders, applied, _, ok := h.tryWithout(ctx, conf, suspects, attempts)
+
+	return offenders, applied, ok
+}
+
+// tryWithout applies the configuration without the excluded resources. If NGINX applies it, the excluded resources
+// are included again to confirm that NGINX fails to apply the configuration with them. It returns true as done if
+// NGINX applied the configuration without the excluded resources, and the result of the isolation: the offenders
+// and the applied configuration, or false if NGINX failed to apply the configuration without the offenders again.
+// The caller must hold nginxLock.
+func (h *eventHandlerImpl) tryWithout(
+	ctx context.Context,
+	conf dataplane.Configuration,
+	excluded []configResource,
+	attempts *nginxConfAttempts,
+) (offenders []configResource, applied dataplane.Configuration, done bool, ok bool) {
+	candidate := withoutConfigResources(conf, excluded)
+	if err := attempts.try(ctx, candidate); err != nil {
+		return nil, dataplane.Configuration{}, false, false
+	}
+
+	// NGINX can apply the configuration without the excluded resources because the failure was transient.
+	if err := attempts.try(ctx, conf); err == nil {
+		return nil, conf, true, true
+	}
+
+	if err := attempts.try(ctx, candidate); err != nil {
+		return nil, dataplane.Configuration{}, true, false
+	}
+
+	return excluded, candidate, true, true
+}
+
+// tryNginxConf replaces the nginx conf files and reloads nginx, and then checks that NGINX is healthy with the
+// new configuration, retrying the failed health checks. The caller must hold nginxLock.
+func (h *eventHandlerImpl) tryNginxConf(ctx context.Context, conf dataplane.Configuration) error {
+	if err := h.replaceNginxConf(ctx, conf); err != nil {
+		return err
+	}
+
+	if err := h.checkHealthAfterReload(ctx); err != nil {
+		return fmt.Errorf("NGINX is unhealthy after the reload: %w", err)
+	}
+
+	return nil
+}
+
+// checkHealthAfterReload checks the health of NGINX, and retries the failed health checks with
+// reloadHealthCheckBackoff. It returns the error of the last health check. The caller must hold nginxLock.
+func (h *eventHandlerImpl) checkHealthAfterReload(ctx context.Context) error {
+	backoff := h.reloadHealthCheckBackoff
+
+	for {
+		err := h.cfg.nginxRuntimeMgr.CheckHealth(ctx)
+		if err == nil || backoff.Steps <= 1 {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff.Step()):
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package static
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	// sessionTicketKeysRotatedAtAnnotation is the annotation of the session ticket keys Secret with the time
+	// of the last rotation of the keys.
+	sessionTicketKeysRotatedAtAnnotation = "gateway.nginx.org/session-ticket-keys-rotated-at"
+	// sessionTicketKeySize is the size of the generated session ticket keys, which makes NGINX encrypt
+	// the tickets with AES256.
+	sessionTicketKeySize = 80
+)
+
+// sessionTicketKeysRotator rotates the TLS session ticket keys in the Secret that the NGINX of all replicas
+// are configured with. It creates the Secret if it does not exist. Only the leader rotates the keys, so that
+// the replicas don't overwrite the keys of each other.
+type sessionTicketKeysRotator struct {
+	k8sClient client.Client
+	logger    logr.Logger
+	secret    types.NamespacedName
+	period    time.Duration
+}
+
+// rotate rotates the keys if the rotation period has passed since the last rotation: the next key becomes
+// the current key, the current key becomes the previous key, and a new next key is generated.
+func (r *sessionTicketKeysRotator) rotate(ctx context.Context, now time.Time) {
+	var secret apiv1.Secret
+
+	err := r.k8sClient.Get(ctx, r.secret, &secret)
+	if apierrors.IsNotFound(err) {
+		if err = r.create(ctx, now); err != nil {
+			r.logger.Error(err, "Failed to create the session ticket keys Secret")
+		}
+		return
+	}
+
+	if err != nil {
+		r.logger.Error(err, "Failed to get the session ticket keys Secret")
+		return
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[sessionTicketKeysRotatedAtAnnotation])
+	if err == nil && now.Sub(rotatedAt) < r.period {
+		return
+	}
+
+	next, err := generateSessionTicketKey()
+	if err != nil {
+		r.logger.Error(err, "Failed to generate a session ticket key")
+		return
+	}
+
+	// A key that was changed by hand to an invalid key is replaced.
+	current := secret.Data[graph.SessionTicketKeyNext]
+	if len(current) != sessionTicketKeySize {
+		if current, err = generateSessionTicketKey(); err != nil {
+			r.logger.Error(err, "Failed to generate a session ticket key")
+			return
+		}
+	}
+
+	data := map[string][]byte{
+		graph.SessionTicketKeyCurrent: current,
+		graph.SessionTicketKeyNext:    next,
+	}
+	if previous, exists := secret.Data[graph.SessionTicketKeyCurrent]; exists {
+		data[graph.SessionTicketKeyPrevious] = previous
+	}
+
+	secret.Data = data
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[sessionTicketKeysRotatedAtAnnotation] = now.UTC().Format(time.RFC3339)
+
+	// The update fails with a conflict if the Secret was changed since we got it. The keys are rotated
+	// at the next run in that case.
+	if err = r.k8sClient.Update(ctx, &secret); err != nil {
+		r.logger.Error(err, "Failed to rotate the session ticket keys")
+		return
+	}
+
+	r.logger.Info("Rotated the session ticket keys")
+}
+
+func (r *sessionTicketKeysRotator) create(ctx context.Context, now time.Time) error {
+	current, err := generateSessionTicketKey()
+	if err != nil {
+		return err
+	}
+
+	next, err := generateSessionTicketKey()
+	if err != nil {
+		return err
+	}
+
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.secret.Namespace,
+			Name:      r.secret.Name,
+			Annotations: map[string]string{
+				sessionTicketKeysRotatedAtAnnotation: now.UTC().Format(time.RFC3339),
+			},
+		},
+		Type: apiv1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			graph.SessionTicketKeyCurrent: current,
+			graph.SessionTicketKeyNext:    next,
+		},
+	}
+
+	if err := r.k8sClient.Create(ctx, secret); err != nil {
+		return err
+	}
+
+	r.logger.Info("Created the session ticket keys Secret")
+
+	return nil
+}
+
+func generateSessionTicketKey() ([]byte, error) {
+	key := make([]byte, sessionTicketKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate a random key: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,78 @@
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestSessionTicketKeysRotator(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiv1.AddToScheme(scheme)).To(Succeed())
+
+	secretNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "session-ticket-keys"}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	rotator := &sessionTicketKeysRotator{
+		k8sClient: k8sClient,
+		logger:    logr.Discard(),
+		secret:    secretNsName,
+		period:    time.Hour,
+	}
+
+	getSecret := func() *apiv1.Secret {
+		var secret apiv1.Secret
+		g.Expect(k8sClient.Get(context.Background(), secretNsName, &secret)).To(Succeed())
+		return &secret
+	}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// The Secret is created.
+	rotator.rotate(context.Background(), start)
+
+	created := getSecret()
+	g.Expect(created.Annotations).To(HaveKeyWithValue(sessionTicketKeysRotatedAtAnnotation, "2024-01-01T00:00:00Z"))
+	g.Expect(created.Data).To(HaveLen(2))
+	g.Expect(created.Data[graph.SessionTicketKeyCurrent]).To(HaveLen(sessionTicketKeySize))
+	g.Expect(created.Data[graph.SessionTicketKeyNext]).To(HaveLen(sessionTicketKeySize))
+	g.Expect(created.Data[graph.SessionTicketKeyCurrent]).ToNot(Equal(created.Data[graph.SessionTicketKeyNext]))
+
+	// The keys are not rotated before the end of the period.
+	rotator.rotate(context.Background(), start.Add(59*time.Minute))
+
+	g.Expect(getSecret().Data).To(Equal(created.Data))
+
+	// The keys are rotated at the end of the period.
+	rotator.rotate(context.Background(), start.Add(time.Hour))
+
+	rotated := getSecret()
+	g.Expect(rotated.Annotations).To(HaveKeyWithValue(sessionTicketKeysRotatedAtAnnotation, "2024-01-01T01:00:00Z"))
+	g.Expect(rotated.Data).To(HaveLen(3))
+	g.Expect(rotated.Data[graph.SessionTicketKeyCurrent]).To(Equal(created.Data[graph.SessionTicketKeyNext]))
+	g.Expect(rotated.Data[graph.SessionTicketKeyPrevious]).To(Equal(created.Data[graph.SessionTicketKeyCurrent]))
+	g.Expect(rotated.Data[graph.SessionTicketKeyNext]).To(HaveLen(sessionTicketKeySize))
+	g.Expect(rotated.Data[graph.SessionTicketKeyNext]).ToNot(Equal(created.Data[graph.SessionTicketKeyNext]))
+
+	// An invalid next key is replaced.
+	rotated.Data[graph.SessionTicketKeyNext] = []byte("invalid")
+	g.Expect(k8sClient.Update(context.Background(), rotated)).To(Succeed())
+
+	rotator.rotate(context.Background(), start.Add(2*time.Hour))
+
+	replaced := getSecret()
+	g.Expect(replaced.Data[graph.SessionTicketKeyCurrent]).To(HaveLen(sessionTicketKeySize))
+	g.Expect(replaced.Data[graph.SessionTicketKeyPrevious]).To(Equal(rotated.Data[graph.SessionTicketKeyCurrent]))
+}
@@ -53,6 +53,9 @@ type ChangeProcessor interface {
 	// The method panics if the resource is of unsupported type or if the passed Gateway is different from the one
 	// this ChangeProcessor was created for.
 	CaptureDeleteChange(resourceType ngftypes.ObjectType, nsname types.NamespacedName)
+	// CaptureExternalCertificates captures the ExternalCertificates of the certificate source, replacing
+	// the previously captured ones.
+	CaptureExternalCertificates(certs map[string]*graph.ExternalCertificate)
 	// Process produces a graph-like representation of GatewayAPI resources.
 	// If no changes were captured, the changed return argument will be NoChange and graph will be empty.
 	Process() (changeType ChangeType, graphCfg *graph.Graph)
@@ -70,6 +73,12 @@ type ChangeProcessorConfig struct {
 	MustExtractGVK kinds.MustExtractGVK
 	// ProtectedPorts are the ports that may not be configured by a listener with a descriptive name of the ports.
 	ProtectedPorts graph.ProtectedPorts
+	// RegisteredPolicies are empty objects of the Policies registered in the policies package, which are
+	// processed like the built-in NGF Policies.
+	RegisteredPolicies []policies.Policy
+	// SessionTicketKeysSecret is the NamespacedName of the Secret with the TLS session ticket keys that are
+	// shared by the NGINX of all replicas. If nil, the keys are not shared.
+	SessionTicketKeysSecret *types.NamespacedName
 	// Logger is the logger for this Change Processor.
 	Logger logr.Logger
 	// GatewayCtlrName is the name of the Gateway controller.
@@ -91,25 +100,33 @@ type ChangeProcessorImpl struct {
 
 	cfg  ChangeProcessorConfig
 	lock sync.Mutex
+
+	// externalCertificatesChanged tells if the ExternalCertificates have changed since the last Process() call.
+	externalCertificatesChanged bool
 }
 
 // NewChangeProcessorImpl creates a new ChangeProcessorImpl for the Gateway resource with the configured namespace name.
 func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 	clusterStore := graph.ClusterState{
-		GatewayClasses:     make(map[types.NamespacedName]*v1.GatewayClass),
-		Gateways:           make(map[types.NamespacedName]*v1.Gateway),
-		HTTPRoutes:         make(map[types.NamespacedName]*v1.HTTPRoute),
-		Services:           make(map[types.NamespacedName]*apiv1.Service),
-		Namespaces:         make(map[types.NamespacedName]*apiv1.Namespace),
-		ReferenceGrants:    make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
-		Secrets:            make(map[types.NamespacedName]*apiv1.Secret),
-		CRDMetadata:        make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
-		BackendTLSPolicies: make(map[types.NamespacedName]*v1alpha3.BackendTLSPolicy),
-		ConfigMaps:         make(map[types.NamespacedName]*apiv1.ConfigMap),
-		NginxProxies:       make(map[types.NamespacedName]*ngfAPI.NginxProxy),
-		GRPCRoutes:         make(map[types.NamespacedName]*v1.GRPCRoute),
-		TLSRoutes:          make(map[types.NamespacedName]*v1alpha2.TLSRoute),
-		NGFPolicies:        make(map[graph.PolicyKey]policies.Policy),
+		GatewayClasses:        make(map[types.NamespacedName]*v1.GatewayClass),
+		Gateways:              make(map[types.NamespacedName]*v1.Gateway),
+		HTTPRoutes:            make(map[types.NamespacedName]*v1.HTTPRoute),
+		Services:              make(map[types.NamespacedName]*apiv1.Service),
+		Namespaces:            make(map[types.NamespacedName]*apiv1.Namespace),
+		ReferenceGrants:       make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
+		Secrets:               make(map[types.NamespacedName]*apiv1.Secret),
+		CRDMetadata:           make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+		BackendTLSPolicies:    make(map[types.NamespacedName]*v1alpha3.BackendTLSPolicy),
+		ConfigMaps:            make(map[types.NamespacedName]*apiv1.ConfigMap),
+		NginxProxies:          make(map[types.NamespacedName]*ngfAPI.NginxProxy),
+		GRPCRoutes:            make(map[types.NamespacedName]*v1.GRPCRoute),
+		TLSRoutes:             make(map[types.NamespacedName]*v1alpha2.TLSRoute),
+		NGFPolicies:           make(map[graph.PolicyKey]policies.Policy),
+		SnippetsFilters:       make(map[types.NamespacedName]*ngfAPI.SnippetsFilter),
+		RateLimitFilters:      make(map[types.NamespacedName]*ngfAPI.RateLimitFilter),
+		ResponseHeaderFilters: make(map[types.NamespacedName]*ngfAPI.ResponseHeaderFilter),
+		QueryParameterFilters: make(map[types.NamespacedName]*ngfAPI.QueryParameterFilter),
+		ABTestFilters:         make(map[types.NamespacedName]*ngfAPI.ABTestFilter),
 	}
 
 	processor := &ChangeProcessorImpl{
@@ -135,91 +152,178 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 	// Use this object store for all NGF policies
 	commonPolicyObjectStore := newNGFPolicyObjectStore(clusterStore.NGFPolicies, cfg.MustExtractGVK)
 
-	trackingUpdater := newChangeTrackingUpdater(
-		cfg.MustExtractGVK,
-		[]changeTrackingUpdaterObjectTypeCfg{
-			{
-				gvk:       cfg.MustExtractGVK(&v1.GatewayClass{}),
-				store:     newObjectStoreMapAdapter(clusterStore.GatewayClasses),
-				predicate: nil,
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&v1.Gateway{}),
-				store:     newObjectStoreMapAdapter(clusterStore.Gateways),
-				predicate: nil,
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&v1.HTTPRoute{}),
-				store:     newObjectStoreMapAdapter(clusterStore.HTTPRoutes),
-				predicate: nil,
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&v1beta1.ReferenceGrant{}),
-				store:     newObjectStoreMapAdapter(clusterStore.ReferenceGrants),
-				predicate: nil,
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&v1alpha3.BackendTLSPolicy{}),
-				store:     newObjectStoreMapAdapter(clusterStore.BackendTLSPolicies),
-				predicate: nil,
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&v1.GRPCRoute{}),
-				store:     newObjectStoreMapAdapter(clusterStore.GRPCRoutes),
-				predicate: nil,
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&apiv1.Namespace{}),
-				store:     newObjectStoreMapAdapter(clusterStore.Namespaces),
-				predicate: funcPredicate{stateChanged: isReferenced},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&apiv1.Service{}),
-				store:     newObjectStoreMapAdapter(clusterStore.Services),
-				predicate: funcPredicate{stateChanged: isReferenced},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&discoveryV1.EndpointSlice{}),
-				store:     nil,
-				predicate: funcPredicate{stateChanged: isReferenced},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&apiv1.Secret{}),
-				store:     newObjectStoreMapAdapter(clusterStore.Secrets),
-				predicate: funcPredicate{stateChanged: isReferenced},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&apiv1.ConfigMap{}),
-				store:     newObjectStoreMapAdapter(clusterStore.ConfigMaps),
-				predicate: funcPredicate{stateChanged: isReferenced},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&apiext.CustomResourceDefinition{}),
-				store:     newObjectStoreMapAdapter(clusterStore.CRDMetadata),
-				predicate: annotationChangedPredicate{annotation: gatewayclass.BundleVersionAnnotation},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&ngfAPI.NginxProxy{}),
-				store:     newObjectStoreMapAdapter(clusterStore.NginxProxies),
-				predicate: funcPredicate{stateChanged: isReferenced},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&ngfAPI.ClientSettingsPolicy{}),
-				store:     commonPolicyObjectStore,
-				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&ngfAPI.ObservabilityPolicy{}),
-				store:     commonPolicyObjectStore,
-				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
-			},
-			{
-				gvk:       cfg.MustExtractGVK(&v1alpha2.TLSRoute{}),
-				store:     newObjectStoreMapAdapter(clusterStore.TLSRoutes),
-				predicate: nil,
-			},
+	objectTypeCfgs := []changeTrackingUpdaterObjectTypeCfg{
+		{
+			gvk:       cfg.MustExtractGVK(&v1.GatewayClass{}),
+			store:     newObjectStoreMapAdapter(clusterStore.GatewayClasses),
+			predicate: nil,
 		},
-	)
+		{
+			gvk:       cfg.MustExtractGVK(&v1.Gateway{}),
+			store:     newObjectStoreMapAdapter(clusterStore.Gateways),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&v1.HTTPRoute{}),
+			store:     newObjectStoreMapAdapter(clusterStore.HTTPRoutes),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&v1beta1.ReferenceGrant{}),
+			store:     newObjectStoreMapAdapter(clusterStore.ReferenceGrants),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&v1alpha3.BackendTLSPolicy{}),
+			store:     newObjectStoreMapAdapter(clusterStore.BackendTLSPolicies),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&v1.GRPCRoute{}),
+			store:     newObjectStoreMapAdapter(clusterStore.GRPCRoutes),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&apiv1.Namespace{}),
+			store:     newObjectStoreMapAdapter(clusterStore.Namespaces),
+			predicate: funcPredicate{stateChanged: isReferenced},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&apiv1.Service{}),
+			store:     newObjectStoreMapAdapter(clusterStore.Services),
+			predicate: funcPredicate{stateChanged: isReferenced},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&discoveryV1.EndpointSlice{}),
+			store:     nil,
+			predicate: funcPredicate{stateChanged: isReferenced},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&apiv1.Secret{}),
+			store:     newObjectStoreMapAdapter(clusterStore.Secrets),
+			predicate: funcPredicate{stateChanged: isReferenced},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&apiv1.ConfigMap{}),
+			store:     newObjectStoreMapAdapter(clusterStore.ConfigMaps),
+			predicate: funcPredicate{stateChanged: isReferenced},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&apiext.CustomResourceDefinition{}),
+			store:     newObjectStoreMapAdapter(clusterStore.CRDMetadata),
+			predicate: annotationChangedPredicate{annotation: gatewayclass.BundleVersionAnnotation},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.NginxProxy{}),
+			store:     newObjectStoreMapAdapter(clusterStore.NginxProxies),
+			predicate: funcPredicate{stateChanged: isReferenced},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.ClientSettingsPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.ObservabilityPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.ProxySettingsPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.FaultInjectionPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.UpstreamSettingsPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.WAFPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.ModSecurityPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.BotMitigationPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.GeoIPPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.RequestHeadersPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.SecureLinkPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.StaticContentPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.DefaultCertificatePolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&v1alpha2.TLSRoute{}),
+			store:     newObjectStoreMapAdapter(clusterStore.TLSRoutes),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.SnippetsFilter{}),
+			store:     newObjectStoreMapAdapter(clusterStore.SnippetsFilters),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.RateLimitFilter{}),
+			store:     newObjectStoreMapAdapter(clusterStore.RateLimitFilters),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.ResponseHeaderFilter{}),
+			store:     newObjectStoreMapAdapter(clusterStore.ResponseHeaderFilters),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.QueryParameterFilter{}),
+			store:     newObjectStoreMapAdapter(clusterStore.QueryParameterFilters),
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPI.ABTestFilter{}),
+			store:     newObjectStoreMapAdapter(clusterStore.ABTestFilters),
+			predicate: nil,
+		},
+	}
+
+	for _, pol := range cfg.RegisteredPolicies {
+		objectTypeCfgs = append(objectTypeCfgs, changeTrackingUpdaterObjectTypeCfg{
+			gvk:       cfg.MustExtractGVK(pol),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		})
+	}
+
+	trackingUpdater := newChangeTrackingUpdater(cfg.MustExtractGVK, objectTypeCfgs)
 
 	processor.getAndResetClusterStateChanged = trackingUpdater.getAndResetChangedStatus
 	processor.updater = trackingUpdater
@@ -246,7 +350,7 @@ func (c *ChangeProcessorImpl) CaptureUpsertChange(obj client.Object) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	c.updater.Upsert(obj)
+	c.updater.Upsert(convertMetadataOnlyNamespace(obj))
 }
 
 func (c *ChangeProcessorImpl) CaptureDeleteChange(resourceType ngftypes.ObjectType, nsname types.NamespacedName) {
@@ -256,11 +360,36 @@ func (c *ChangeProcessorImpl) CaptureDeleteChange(resourceType ngftypes.ObjectTy
 	c.updater.Delete(resourceType, nsname)
 }
 
+func (c *ChangeProcessorImpl) CaptureExternalCertificates(certs map[string]*graph.ExternalCertificate) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.clusterState.ExternalCertificates = certs
+	c.externalCertificatesChanged = true
+}
+
+// convertMetadataOnlyNamespace converts a Namespace that is watched in the metadata-only form to a Namespace, so that
+// it is stored and processed like a full Namespace. It is enough, because only the name and the labels of
+// a Namespace are used. Any other object is returned as is.
+func convertMetadataOnlyNamespace(obj client.Object) client.Object {
+	partialObj, ok := obj.(*metav1.PartialObjectMetadata)
+	if !ok || partialObj.GroupVersionKind() != apiv1.SchemeGroupVersion.WithKind("Namespace") {
+		return obj
+	}
+
+	return &apiv1.Namespace{ObjectMeta: partialObj.ObjectMeta}
+}
+
 func (c *ChangeProcessorImpl) Process() (ChangeType, *graph.Graph) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	changeType := c.getAndResetClusterStateChanged()
+	if c.externalCertificatesChanged {
+		changeType = ClusterStateChange
+		c.externalCertificatesChanged = false
+	}
+
 	if changeType == NoChange {
 		return NoChange, nil
 	}
@@ -271,6 +400,7 @@ func (c *ChangeProcessorImpl) Process() (ChangeType, *graph.Graph) {
 		c.cfg.GatewayClassName,
 		c.cfg.Validators,
 		c.cfg.ProtectedPorts,
+		c.cfg.SessionTicketKeysSecret,
 	)
 
 	return changeType, c.latestGraph
@@ -2,6 +2,7 @@ package conditions
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -16,6 +17,18 @@ const (
 	// is invalid or not supported.
 	ListenerReasonUnsupportedValue v1.ListenerConditionReason = "UnsupportedValue"
 
+	// ListenerReasonCertificateExpired is used with the "ResolvedRefs" condition when the certificate that
+	// the Listener references is expired.
+	ListenerReasonCertificateExpired v1.ListenerConditionReason = "CertificateExpired"
+
+	// ListenerReasonCertificateKeyMismatch is used with the "ResolvedRefs" condition when the private key that
+	// the Listener references does not match the certificate.
+	ListenerReasonCertificateKeyMismatch v1.ListenerConditionReason = "CertificateKeyMismatch"
+
+	// ListenerReasonUnsupportedKey is used with the "ResolvedRefs" condition when the key of the certificate that
+	// the Listener references is of an unsupported type or too weak.
+	ListenerReasonUnsupportedKey v1.ListenerConditionReason = "UnsupportedKey"
+
 	// ListenerMessageFailedNginxReload is a message used with ListenerConditionProgrammed (false)
 	// when nginx fails to reload.
 	ListenerMessageFailedNginxReload = "The Listener is not programmed due to a failure to " +
@@ -49,6 +62,25 @@ const (
 	// Used with ResolvedRefs (false).
 	RouteReasonInvalidIPFamily v1.RouteConditionReason = "InvalidServiceIPFamily"
 
+	// RouteReasonDelegationNotAccepted is used with the "Accepted" (false) condition of a child HTTPRoute when
+	// the parent HTTPRoute it references does not delegate to it or is not attached to the Gateway.
+	RouteReasonDelegationNotAccepted v1.RouteConditionReason = "DelegationNotAccepted"
+
+	// RouteReasonInvalidFilter is used with the "ResolvedRefs" condition when a filter of the Route references
+	// a filter resource that doesn't exist or is invalid.
+	RouteReasonInvalidFilter v1.RouteConditionReason = "InvalidFilter"
+
+	// RouteUnsupportedField is an NGF-specific condition type that indicates that the Route sets fields that NGF
+	// does not support. NGF ignores such fields when generating NGINX configuration.
+	RouteUnsupportedField v1.RouteConditionType = "UnsupportedField"
+
+	// RouteReasonUnsupportedField is used with the "RouteUnsupportedField" condition when the condition is true.
+	RouteReasonUnsupportedField v1.RouteConditionReason = "UnsupportedField"
+
+	// RouteReasonQuarantined is used with the "Accepted" (false) condition when the Route is excluded from
+	// the NGINX configuration, because NGINX failed to generate or apply the configuration with it.
+	RouteReasonQuarantined v1.RouteConditionReason = "Quarantined"
+
 	// GatewayReasonGatewayConflict indicates there are multiple Gateway resources to choose from,
 	// and we ignored the resource in question and picked another Gateway as the winner.
 	// This reason is used with GatewayConditionAccepted (false).
@@ -61,6 +93,13 @@ const (
 	// is invalid or not supported.
 	GatewayReasonUnsupportedValue v1.GatewayConditionReason = "UnsupportedValue"
 
+	// GatewayUnsupportedField is an NGF-specific condition type that indicates that the Gateway sets fields that NGF
+	// does not support. NGF ignores such fields when generating NGINX configuration.
+	GatewayUnsupportedField v1.GatewayConditionType = "UnsupportedField"
+
+	// GatewayReasonUnsupportedField is used with the "GatewayUnsupportedField" condition when the condition is true.
+	GatewayReasonUnsupportedField v1.GatewayConditionReason = "UnsupportedField"
+
 	// GatewayMessageFailedNginxReload is a message used with GatewayConditionProgrammed (false)
 	// when nginx fails to reload.
 	GatewayMessageFailedNginxReload = "The Gateway is not programmed due to a failure to " +
@@ -95,10 +134,48 @@ const (
 	// when telemetry is not enabled in the NginxProxy resource.
 	PolicyMessageTelemetryNotEnabled = "Telemetry is not enabled in the NginxProxy resource"
 
+	// PolicyMessageGeoIPNotEnabled is a message used with the PolicyReasonNginxProxyConfigNotSet reason
+	// when GeoIP is not configured in the NginxProxy resource.
+	PolicyMessageGeoIPNotEnabled = "GeoIP is not configured in the NginxProxy resource"
+
 	// PolicyReasonTargetConflict is used with the "PolicyAccepted" condition when a Route that it targets
 	// has an overlapping hostname:port/path combination with another Route.
 	PolicyReasonTargetConflict v1alpha2.PolicyConditionReason = "TargetConflict"
 
+	// PolicyReasonInvalidBundle is used with the "PolicyAccepted" condition when the policy bundle that a WAFPolicy
+	// references does not exist, or is not a compiled NGINX App Protect policy bundle.
+	PolicyReasonInvalidBundle v1alpha2.PolicyConditionReason = "InvalidBundle"
+
+	// PolicyReasonInvalidSecret is used with the "PolicyAccepted" condition when the Secret that a SecureLinkPolicy
+	// references does not exist, or does not hold a valid secret.
+	PolicyReasonInvalidSecret v1alpha2.PolicyConditionReason = "InvalidSecret"
+
+	// PolicyReasonInvalidConfigMap is used with the "PolicyAccepted" condition when the ConfigMap that
+	// a StaticContentPolicy references does not exist, or does not hold the files of the policy.
+	PolicyReasonInvalidConfigMap v1alpha2.PolicyConditionReason = "InvalidConfigMap"
+
+	// PolicyReasonInvalidCertificateRef is used with the "PolicyAccepted" condition when the certificate that
+	// a DefaultCertificatePolicy references does not exist, or does not hold a valid certificate and key.
+	PolicyReasonInvalidCertificateRef v1alpha2.PolicyConditionReason = "InvalidCertificateRef"
+
+	// PolicyReasonQuarantined is used with the "PolicyAccepted" condition when the Policy is excluded from
+	// the NGINX configuration, because NGINX failed to generate or apply the configuration with it.
+	PolicyReasonQuarantined v1alpha2.PolicyConditionReason = "Quarantined"
+
+	// PolicyAncestorLimitReached is an NGF-specific condition type that indicates that NGF ignores Policies that target
+	// the resource, because the ancestor status lists of the Policies have reached the maximum size.
+	// Used with both Gateways and Routes.
+	PolicyAncestorLimitReached = "PolicyAncestorLimitReached"
+
+	// PolicyReasonAncestorLimitReached is used with the "PolicyAncestorLimitReached" condition when the condition
+	// is true.
+	PolicyReasonAncestorLimitReached = "AncestorLimitReached"
+
+	// PolicyMessageAncestorLimitReached is a message used with the PolicyReasonAncestorLimitReached reason.
+	// It is followed by the comma-separated list of the ignored Policies.
+	PolicyMessageAncestorLimitReached = "Policies cannot be applied because their ancestor status lists have " +
+		"reached the maximum size. Ignored policies: "
+
 	// GatewayIgnoredReason is used with v1.RouteConditionAccepted when the route references a Gateway that is ignored
 	// by NGF.
 	GatewayIgnoredReason v1.RouteConditionReason = "GatewayIgnored"
@@ -124,25 +201,15 @@ func NewDefaultRouteConditions() []conditions.Condition {
 }
 
 // NewRouteNotAllowedByListeners returns a Condition that indicates that the Route is not allowed by
-// any listener.
-func NewRouteNotAllowedByListeners() conditions.Condition {
-	return conditions.Condition{
-		Type:    string(v1.RouteConditionAccepted),
-		Status:  metav1.ConditionFalse,
-		Reason:  string(v1.RouteReasonNotAllowedByListeners),
-		Message: "Route is not allowed by any listener",
-	}
+// any of the evaluated listeners.
+func NewRouteNotAllowedByListeners(listeners []EvaluatedListener) conditions.Condition {
+	return newRouteRejection(v1.RouteReasonNotAllowedByListeners, "", listeners, false)
 }
 
-// NewRouteNoMatchingListenerHostname returns a Condition that indicates that the hostname of the listener
-// does not match the hostnames of the Route.
-func NewRouteNoMatchingListenerHostname() conditions.Condition {
-	return conditions.Condition{
-		Type:    string(v1.RouteConditionAccepted),
-		Status:  metav1.ConditionFalse,
-		Reason:  string(v1.RouteReasonNoMatchingListenerHostname),
-		Message: "Listener hostname does not match the Route hostnames",
-	}
+// NewRouteNoMatchingListenerHostname returns a Condition that indicates that the hostnames of the evaluated
+// listeners do not match the hostnames of the Route.
+func NewRouteNoMatchingListenerHostname(listeners []EvaluatedListener) conditions.Condition {
+	return newRouteRejection(v1.RouteReasonNoMatchingListenerHostname, "", listeners, true)
 }
 
 // NewRouteAccepted returns a Condition that indicates that the Route is accepted.
@@ -215,12 +282,7 @@ func NewRouteResolvedRefs() conditions.Condition {
 // NewRouteBackendRefInvalidKind returns a Condition that indicates that the Route has a backendRef with an
 // invalid kind.
 func NewRouteBackendRefInvalidKind(msg string) conditions.Condition {
-	return conditions.Condition{
-		Type:    string(v1.RouteConditionResolvedRefs),
-		Status:  metav1.ConditionFalse,
-		Reason:  string(v1.RouteReasonInvalidKind),
-		Message: msg,
-	}
+	return newRouteRejection(v1.RouteReasonInvalidKind, msg, nil, false)
 }
 
 // NewRouteBackendRefRefNotPermitted returns a Condition that indicates that the Route has a backendRef that
@@ -256,6 +318,17 @@ func NewRouteBackendRefUnsupportedValue(msg string) conditions.Condition {
 	}
 }
 
+// NewRouteResolvedRefsInvalidFilter returns a Condition that indicates that the Route has a filter that
+// references a filter resource that doesn't exist or is invalid.
+func NewRouteResolvedRefsInvalidFilter(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(RouteReasonInvalidFilter),
+		Message: msg,
+	}
+}
+
 // NewRouteInvalidGateway returns a Condition that indicates that the Route is not Accepted because the Gateway it
 // references is invalid.
 func NewRouteInvalidGateway() conditions.Condition {
@@ -278,6 +351,17 @@ func NewRouteNoMatchingParent() conditions.Condition {
 	}
 }
 
+// NewRouteDelegationNotAccepted returns a Condition that indicates that the child HTTPRoute is not Accepted
+// by the parent HTTPRoute it references.
+func NewRouteDelegationNotAccepted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(RouteReasonDelegationNotAccepted),
+		Message: msg,
+	}
+}
+
 // NewRouteUnsupportedConfiguration returns a Condition that indicates that the Route is not Accepted because
 // it is incompatible with the Gateway's configuration.
 func NewRouteUnsupportedConfiguration(msg string) conditions.Condition {
@@ -289,6 +373,17 @@ func NewRouteUnsupportedConfiguration(msg string) conditions.Condition {
 	}
 }
 
+// NewRouteQuarantined returns a Condition that indicates that the Route is not Accepted because it is excluded
+// from the NGINX configuration, until it changes.
+func NewRouteQuarantined(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(RouteReasonQuarantined),
+		Message: msg,
+	}
+}
+
 // NewRouteGatewayNotProgrammed returns a Condition that indicates that the Gateway it references is not programmed,
 // which does not guarantee that the Route has been configured.
 func NewRouteGatewayNotProgrammed(msg string) conditions.Condition {
@@ -311,6 +406,17 @@ func NewRouteInvalidIPFamily(msg string) conditions.Condition {
 	}
 }
 
+// NewRouteUnsupportedField returns a Condition that indicates that the Route sets fields that NGF does not support
+// and ignores.
+func NewRouteUnsupportedField(fields []string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(RouteUnsupportedField),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(RouteReasonUnsupportedField),
+		Message: unsupportedFieldMessage(fields),
+	}
+}
+
 // NewDefaultListenerConditions returns the default Conditions that must be present in the status of a Listener.
 func NewDefaultListenerConditions() []conditions.Condition {
 	return []conditions.Condition{
@@ -405,6 +511,26 @@ func NewListenerInvalidCertificateRef(msg string) []conditions.Condition {
 	}
 }
 
+// NewListenerRejectedCertificateRef returns Conditions that indicate that the certificate that a CertificateRef of
+// a Listener references is rejected for the reason, which is set on the ResolvedRefs condition.
+func NewListenerRejectedCertificateRef(reason v1.ListenerConditionReason, msg string) []conditions.Condition {
+	return []conditions.Condition{
+		{
+			Type:    string(v1.ListenerConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(v1.ListenerReasonInvalidCertificateRef),
+			Message: msg,
+		},
+		{
+			Type:    string(v1.ListenerReasonResolvedRefs),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(reason),
+			Message: msg,
+		},
+		NewListenerNotProgrammedInvalid(msg),
+	}
+}
+
 // NewListenerInvalidRouteKinds returns Conditions that indicate that an invalid or unsupported Route kind is
 // specified by the Listener.
 func NewListenerInvalidRouteKinds(msg string) []conditions.Condition {
@@ -616,6 +742,21 @@ func NewGatewayUnsupportedValue(msg string) []conditions.Condition {
 	}
 }
 
+// NewGatewayUnsupportedField returns a Condition that indicates that the Gateway sets fields that NGF does not
+// support and ignores.
+func NewGatewayUnsupportedField(fields []string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayUnsupportedField),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonUnsupportedField),
+		Message: unsupportedFieldMessage(fields),
+	}
+}
+
+func unsupportedFieldMessage(fields []string) string {
+	return "The following fields are not supported and are ignored: " + strings.Join(fields, ", ")
+}
+
 // NewGatewayProgrammed returns a Condition that indicates the Gateway is programmed.
 func NewGatewayProgrammed() conditions.Condition {
 	return conditions.Condition{
@@ -712,6 +853,18 @@ func NewPolicyTargetNotFound(msg string) conditions.Condition {
 	}
 }
 
+// NewPolicyAncestorLimitReached returns a Condition for a Gateway or a Route that indicates that NGF ignores
+// the Policy that targets it, because the ancestor status list of the Policy has reached the maximum size.
+// The policyName has the format "Kind namespace/name".
+func NewPolicyAncestorLimitReached(policyName string) conditions.Condition {
+	return conditions.Condition{
+		Type:    PolicyAncestorLimitReached,
+		Status:  metav1.ConditionTrue,
+		Reason:  PolicyReasonAncestorLimitReached,
+		Message: PolicyMessageAncestorLimitReached + policyName,
+	}
+}
+
 // NewPolicyNotAcceptedTargetConflict returns a Condition that indicates that the Policy is not accepted
 // because the target resource has a conflict with another resource when attempting to apply this policy.
 func NewPolicyNotAcceptedTargetConflict(msg string) conditions.Condition {
@@ -733,3 +886,79 @@ func NewPolicyNotAcceptedNginxProxyNotSet(msg string) conditions.Condition {
 		Message: msg,
 	}
 }
+
+// NewPolicyNotAcceptedInvalidBundle returns a Condition that indicates that the Policy is not accepted
+// because its policy bundle can't be resolved or was not compiled.
+func NewPolicyNotAcceptedInvalidBundle(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonInvalidBundle),
+		Message: msg,
+	}
+}
+
+// NewPolicyNotAcceptedInvalidSecret returns a Condition that indicates that the Policy is not accepted
+// because its Secret can't be resolved or is invalid.
+func NewPolicyNotAcceptedInvalidSecret(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonInvalidSecret),
+		Message: msg,
+	}
+}
+
+// NewPolicyNotAcceptedInvalidConfigMap returns a Condition that indicates that the Policy is not accepted
+// because its ConfigMap can't be resolved or is invalid.
+func NewPolicyNotAcceptedInvalidConfigMap(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonInvalidConfigMap),
+		Message: msg,
+	}
+}
+
+// NewPolicyNotAcceptedInvalidCertificateRef returns a Condition that indicates that the Policy is not accepted
+// because its certificate can't be resolved or is invalid.
+func NewPolicyNotAcceptedInvalidCertificateRef(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonInvalidCertificateRef),
+		Message: msg,
+	}
+}
+
+// NewPolicyNotAcceptedQuarantined returns a Condition that indicates that the Policy is not accepted because
+// it is excluded from the NGINX configuration, until it changes.
+func NewPolicyNotAcceptedQuarantined(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonQuarantined),
+		Message: msg,
+	}
+}
+
+// NewFilterAccepted returns a Condition that indicates that the filter is accepted.
+func NewFilterAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.FilterConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.FilterReasonAccepted),
+		Message: "Filter is accepted",
+	}
+}
+
+// NewFilterInvalid returns a Condition that indicates that the filter is not accepted because it is semantically or
+// syntactically invalid.
+func NewFilterInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.FilterConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.FilterReasonInvalid),
+		Message: msg,
+	}
+}
@@ -0,0 +1,91 @@
+package conditions
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+)
+
+// routeRejection describes a reason for the rejection of a Route.
+type routeRejection struct {
+	// conditionType is the type of the condition with the reason.
+	conditionType v1.RouteConditionType
+	// message describes the rejection.
+	message string
+	// remediation tells users how to fix the rejection.
+	remediation string
+}
+
+// routeRejections is the catalog of the reasons for the rejection of a Route, which are reported in the
+// conditions of the Route with the remediation hints.
+var routeRejections = map[v1.RouteConditionReason]routeRejection{
+	v1.RouteReasonNotAllowedByListeners: {
+		conditionType: v1.RouteConditionAccepted,
+		message:       "Route is not allowed by any listener",
+		remediation: "allow the namespace and the kind of the Route in the allowedRoutes of a listener, " +
+			"or reference a listener that allows them with the sectionName of the parentRef",
+	},
+	v1.RouteReasonNoMatchingListenerHostname: {
+		conditionType: v1.RouteConditionAccepted,
+		message:       "Listener hostname does not match the Route hostnames",
+		remediation: "add a hostname that matches the hostname of a listener to the hostnames of the Route, " +
+			"or reference a listener with a matching hostname with the sectionName of the parentRef",
+	},
+	v1.RouteReasonInvalidKind: {
+		conditionType: v1.RouteConditionResolvedRefs,
+		message:       "BackendRef references an unsupported resource",
+		remediation:   `reference a Service with the "Service" kind and an empty or "core" group in the backendRef`,
+	},
+}
+
+// EvaluatedListener is a listener that was evaluated for the attachment of a Route.
+type EvaluatedListener struct {
+	// Name is the name of the listener.
+	Name string
+	// Hostname is the hostname of the listener. It is empty if the listener matches any hostname.
+	Hostname string
+}
+
+// newRouteRejection returns a Condition for the rejection of a Route with the reason from the catalog.
+// The message includes the detail if set, the listeners that were evaluated, and the remediation hint.
+func newRouteRejection(
+	reason v1.RouteConditionReason,
+	detail string,
+	listeners []EvaluatedListener,
+	showHostnames bool,
+) conditions.Condition {
+	rejection := routeRejections[reason]
+
+	msg := rejection.message
+	if detail != "" {
+		msg = fmt.Sprintf("%s: %s", msg, detail)
+	}
+
+	if len(listeners) > 0 {
+		evaluated := make([]string, 0, len(listeners))
+		for _, l := range listeners {
+			listener := fmt.Sprintf("%q", l.Name)
+			if showHostnames {
+				hostname := "any hostname"
+				if l.Hostname != "" {
+					hostname = fmt.Sprintf("hostname %q", l.Hostname)
+				}
+				listener = fmt.Sprintf("%s (%s)", listener, hostname)
+			}
+			evaluated = append(evaluated, listener)
+		}
+
+		msg = fmt.Sprintf("%s; evaluated listeners: %s", msg, strings.Join(evaluated, ", "))
+	}
+
+	return conditions.Condition{
+		Type:    string(rejection.conditionType),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(reason),
+		Message: fmt.Sprintf("%s. Remediation: %s", msg, rejection.remediation),
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"encoding/base64"
 	"fmt"
 	"sort"
+	"strings"
 
-	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -15,14 +15,22 @@ import (
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
-	policies "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 const (
-	wildcardHostname    = "~^"
-	alpineSSLRootCAPath = "/etc/ssl/cert.pem"
+	wildcardHostname          = "~^"
+	alpineSSLRootCAPath       = "/etc/ssl/cert.pem"
+	defaultServerContentType  = "text/plain"
+	defaultServerRedirectCode = 302
+	httpsRedirectPort         = 80
+	httpsRedirectCode         = 301
+	httpsDefaultPort          = 443
+	defaultProtoHeader        = "X-Forwarded-Proto"
+	acmeChallengePathPrefix   = "/.well-known/acme-challenge"
 )
 
 // BuildConfiguration builds the Configuration from the Graph.
@@ -41,15 +49,33 @@ func BuildConfiguration(
 	}
 
 	baseHTTPConfig := buildBaseHTTPConfig(g)
-
-	upstreams := buildUpstreams(ctx, g.Gateway.Listeners, serviceResolver, baseHTTPConfig.IPFamily)
+	baseHTTPConfig.AccessLogRatios = buildAccessLogRatios(g)
+
+	upstreams := buildUpstreams(
+		ctx,
+		g.Gateway.Listeners,
+		serviceResolver,
+		g.ReferencedServices,
+		baseHTTPConfig.IPFamily,
+		g.Activator,
+	)
 	httpServers, sslServers := buildServers(g)
 	passthroughServers := buildPassthroughServers(g)
 	streamUpstreams := buildStreamUpstreams(ctx, g.Gateway.Listeners, serviceResolver, baseHTTPConfig.IPFamily)
 	backendGroups := buildBackendGroups(append(httpServers, sslServers...))
-	keyPairs := buildSSLKeyPairs(g.ReferencedSecrets, g.Gateway.Listeners)
+	keyPairs := buildSSLKeyPairs(
+		g.ReferencedSecrets,
+		g.ReferencedExternalCertificates,
+		g.Gateway.Listeners,
+		g.DefaultCertificate,
+	)
 	certBundles := buildCertBundles(g.ReferencedCaCertConfigMaps, backendGroups)
+	wafBundles := buildWAFBundles(g.WAFBundles)
+	secureLinkSecrets := buildSecureLinkSecrets(g.SecureLinkSecrets)
+	staticFiles := buildStaticFiles(g.StaticContents)
 	telemetry := buildTelemetry(g)
+	connectionLimits := buildConnectionLimits(g.NginxProxy)
+	logging := buildLogging(g.NginxProxy, g.Gateway)
 
 	config := Configuration{
 		HTTPServers:           httpServers,
@@ -61,8 +87,17 @@ func BuildConfiguration(
 		SSLKeyPairs:           keyPairs,
 		Version:               configVersion,
 		CertBundles:           certBundles,
+		WAFBundles:            wafBundles,
+		SecureLinkSecrets:     secureLinkSecrets,
+		StaticFiles:           staticFiles,
+		SessionTicketKeys:     buildSessionTicketKeys(g.SessionTicketKeys),
 		Telemetry:             telemetry,
 		BaseHTTPConfig:        baseHTTPConfig,
+		ConnectionLimits:      connectionLimits,
+		Logging:               logging,
+		UpstreamZoneSize:      buildUpstreamZoneSize(g),
+		UpstreamDrainTimeout:  buildUpstreamDrainTimeout(g),
+		ModSecurity:           hasValidModSecurityPolicy(g),
 	}
 
 	return config
@@ -107,6 +142,7 @@ func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 				passthroughServersMap[key] = append(passthroughServersMap[key], Layer4VirtualServer{
 					Hostname:     h,
 					UpstreamName: r.Spec.BackendRef.ServicePortReference(),
+					Route:        key.NamespacedName,
 					Port:         int32(l.Source.Port),
 				})
 			}
@@ -132,6 +168,11 @@ func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 		passthroughServers = append(passthroughServers, r...)
 	}
 
+	// The servers are sorted, so that the order of the generated config is stable.
+	sort.Slice(passthroughServers, func(i, j int) bool {
+		return lessLayer4VirtualServer(passthroughServers[i], passthroughServers[j])
+	})
+
 	passthroughServers = append(passthroughServers, listenerPassthroughServers...)
 
 	return passthroughServers
@@ -180,9 +221,10 @@ func buildStreamUpstreams(
 			}
 
 			uniqueUpstreams[upstreamName] = Upstream{
-				Name:      upstreamName,
-				Endpoints: eps,
-				ErrorMsg:  errMsg,
+				Name:       upstreamName,
+				HashedFrom: hashedFrom(br),
+				Endpoints:  eps,
+				ErrorMsg:   errMsg,
 			}
 		}
 	}
@@ -196,30 +238,57 @@ func buildStreamUpstreams(
 	for _, up := range uniqueUpstreams {
 		upstreams = append(upstreams, up)
 	}
+
+	// The upstreams are sorted, so that the order of the generated config is stable.
+	sort.Slice(upstreams, func(i, j int) bool {
+		return upstreams[i].Name < upstreams[j].Name
+	})
+
 	return upstreams
 }
 
-// buildSSLKeyPairs builds the SSLKeyPairs from the Secrets. It will only include Secrets that are referenced by
-// valid listeners, so that we don't include unused Secrets in the configuration of the data plane.
+// buildSSLKeyPairs builds the SSLKeyPairs from the Secrets and the ExternalCertificates. It will only include
+// Secrets and ExternalCertificates that are referenced by valid listeners, so that we don't include unused
+// certificates in the configuration of the data plane.
 func buildSSLKeyPairs(
 	secrets map[types.NamespacedName]*graph.Secret,
+	externalCerts map[string]*graph.ExternalCertificate,
 	listeners []*graph.Listener,
+	defaultCert *graph.DefaultCertificate,
 ) map[SSLKeyPairID]SSLKeyPair {
 	keyPairs := make(map[SSLKeyPairID]SSLKeyPair)
 
-	for _, l := range listeners {
-		if l.Valid && l.ResolvedSecret != nil {
-			id := generateSSLKeyPairID(*l.ResolvedSecret)
-			secret := secrets[*l.ResolvedSecret]
-			// The Data map keys are guaranteed to exist by the graph package.
-			// the Source field is guaranteed to be non-nil by the graph package.
+	addKeyPair := func(secret *types.NamespacedName, externalCert *string) {
+		switch {
+		case secret != nil:
+			id := generateSSLKeyPairID(*secret)
+			// The Secret is guaranteed to hold a valid certificate and key by the graph package.
+			cert, key := secrets[*secret].TLSKeyPair()
+			keyPairs[id] = SSLKeyPair{
+				Cert: cert,
+				Key:  key,
+			}
+		case externalCert != nil:
+			id := generateExternalSSLKeyPairID(*externalCert)
+			// The certificate is guaranteed to exist by the graph package.
+			cert := externalCerts[*externalCert]
 			keyPairs[id] = SSLKeyPair{
-				Cert: secret.Source.Data[apiv1.TLSCertKey],
-				Key:  secret.Source.Data[apiv1.TLSPrivateKeyKey],
+				Cert: cert.Cert,
+				Key:  cert.Key,
 			}
 		}
 	}
 
+	for _, l := range listeners {
+		if l.Valid {
+			addKeyPair(l.ResolvedSecret, l.ResolvedExternalCertificate)
+		}
+	}
+
+	if defaultCert != nil {
+		addKeyPair(defaultCert.Secret, defaultCert.ExternalCertificate)
+	}
+
 	return keyPairs
 }
 
@@ -264,6 +333,60 @@ func buildCertBundles(
 	return bundles
 }
 
+func buildWAFBundles(bundles map[types.NamespacedName]*graph.WAFBundle) map[WAFBundleID]WAFBundle {
+	wafBundles := make(map[WAFBundleID]WAFBundle)
+
+	for policyNsName, bundle := range bundles {
+		// The bundles of the invalid WAFPolicies have no data.
+		if bundle.Data != nil {
+			wafBundles[GenerateWAFBundleID(policyNsName)] = WAFBundle(bundle.Data)
+		}
+	}
+
+	return wafBundles
+}
+
+func buildSecureLinkSecrets(
+	secrets map[types.NamespacedName]*graph.SecureLinkSecret,
+) map[SecureLinkSecretID]SecureLinkSecret {
+	secureLinkSecrets := make(map[SecureLinkSecretID]SecureLinkSecret)
+
+	for policyNsName, secret := range secrets {
+		// The secrets of the invalid SecureLinkPolicies have no data.
+		if secret.Data != nil {
+			secureLinkSecrets[GenerateSecureLinkSecretID(policyNsName)] = SecureLinkSecret(secret.Data)
+		}
+	}
+
+	return secureLinkSecrets
+}
+
+func buildStaticFiles(contents map[types.NamespacedName]*graph.StaticContent) map[StaticFileID]StaticFile {
+	staticFiles := make(map[StaticFileID]StaticFile)
+
+	for policyNsName, content := range contents {
+		// The contents of the invalid StaticContentPolicies have no files.
+		for key, data := range content.Files {
+			staticFiles[GenerateStaticFileID(policyNsName, key)] = StaticFile(data)
+		}
+	}
+
+	return staticFiles
+}
+
+func buildSessionTicketKeys(keys *graph.SessionTicketKeys) []SessionTicketKey {
+	if keys == nil || len(keys.Keys) == 0 {
+		return nil
+	}
+
+	sessionTicketKeys := make([]SessionTicketKey, 0, len(keys.Keys))
+	for _, key := range keys.Keys {
+		sessionTicketKeys = append(sessionTicketKeys, SessionTicketKey(key))
+	}
+
+	return sessionTicketKeys
+}
+
 func buildBackendGroups(servers []VirtualServer) []BackendGroup {
 	type key struct {
 		nsname  types.NamespacedName
@@ -299,6 +422,11 @@ func buildBackendGroups(servers []VirtualServer) []BackendGroup {
 		groups = append(groups, group)
 	}
 
+	// The groups are sorted, so that the order of the generated config is stable.
+	sort.Slice(groups, func(i, j int) bool {
+		return lessBackendGroup(groups[i], groups[j])
+	})
+
 	return groups
 }
 
@@ -353,6 +481,8 @@ func buildServers(g *graph.Graph) (http, ssl []VirtualServer) {
 			rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
 			if rules == nil {
 				rules = newHostPathRules()
+				rules.caseInsensitivePaths = caseInsensitivePathsEnabled(g.NginxProxy)
+				rules.acmeChallengePassthrough = acmeChallengePassthroughEnabled(g.NginxProxy)
 				rulesForProtocol[l.Source.Protocol][l.Source.Port] = rules
 			}
 
@@ -365,19 +495,251 @@ func buildServers(g *graph.Graph) (http, ssl []VirtualServer) {
 
 	httpServers, sslServers := httpRules.buildServers(), sslRules.buildServers()
 
-	pols := buildPolicies(g.Gateway.Policies)
+	// The servers of the Listeners get the effective policies of their Listener in buildServers.
+	// The default servers don't belong to a single Listener, so they get the effective policies of the Gateway.
+	pols := g.Gateway.EffectivePolicies
 
 	for i := range httpServers {
-		httpServers[i].Policies = pols
+		if httpServers[i].IsDefault {
+			httpServers[i].Policies = pols
+			httpServers[i].DefaultResponse = buildDefaultServerResponse(g.NginxProxy, httpServers[i].Port)
+		}
 	}
 
+	// Without a default certificate, the default SSL servers reject the TLS handshakes.
+	defaultSSL := buildDefaultSSL(g.DefaultCertificate)
+
 	for i := range sslServers {
-		sslServers[i].Policies = pols
+		if sslServers[i].IsDefault {
+			sslServers[i].Policies = pols
+			sslServers[i].SSL = defaultSSL
+		}
 	}
 
+	httpServers = append(httpServers, buildHTTPSRedirectServers(g, httpServers, sslServers)...)
+
 	return httpServers, sslServers
 }
 
+// buildHTTPSRedirectServers builds the HTTP servers that redirect requests to HTTPS for the hostnames
+// of the SSL servers, if the NginxProxy configures HTTPSRedirect or the Gateway has the
+// gateway.nginx.org/https-redirect-listeners annotation. With the annotation, only the hostnames of the listeners
+// of the annotation are redirected. Hostnames that are already served by an HTTP server on the redirect port
+// are skipped.
+// If there is no default HTTP server on the redirect port, one is added so that requests for unknown
+// hostnames are not redirected.
+// An HTTP server that only serves the ACME HTTP-01 challenges of its hostname is not skipped: its redirect is set
+// in place, so that the server redirects all the other requests.
+func buildHTTPSRedirectServers(g *graph.Graph, httpServers, sslServers []VirtualServer) []VirtualServer {
+	np := g.NginxProxy
+	npRedirect := np != nil && np.Valid && np.Source.Spec.HTTPSRedirect != nil
+
+	if !npRedirect && g.Gateway.HTTPSRedirectListeners == nil {
+		return nil
+	}
+
+	port := int32(httpsRedirectPort)
+	code := httpsRedirectCode
+
+	if npRedirect {
+		cfg := np.Source.Spec.HTTPSRedirect
+
+		if cfg.Port != nil {
+			port = *cfg.Port
+		}
+
+		if cfg.StatusCode != nil {
+			code = *cfg.StatusCode
+		}
+	}
+
+	redirectHostnames := getListenerHostnames(g, g.Gateway.HTTPSRedirectListeners)
+
+	existingHostnames := make(map[string]struct{})
+	// the index in httpServers of the server that only serves the ACME challenges for each hostname
+	acmeServerForHost := make(map[string]int)
+	defaultServerExists := false
+
+	for i, s := range httpServers {
+		if s.Port != port {
+			continue
+		}
+
+		if s.IsDefault {
+			defaultServerExists = true
+			continue
+		}
+
+		if isACMEChallengeServer(s) {
+			acmeServerForHost[s.Hostname] = i
+			continue
+		}
+
+		existingHostnames[s.Hostname] = struct{}{}
+	}
+
+	// the port of the HTTPS server to redirect to for each hostname
+	sslPortForHost := make(map[string]int32)
+
+	for _, s := range sslServers {
+		if s.IsDefault || s.Hostname == wildcardHostname {
+			continue
+		}
+
+		if _, exists := existingHostnames[s.Hostname]; exists {
+			continue
+		}
+
+		if redirectHostnames != nil {
+			if _, redirected := redirectHostnames[s.Hostname]; !redirected {
+				continue
+			}
+		}
+
+		// prefer the well-known HTTPS port, otherwise the lowest port
+		if prevPort, exists := sslPortForHost[s.Hostname]; exists {
+			if prevPort == httpsDefaultPort || (s.Port != httpsDefaultPort && prevPort < s.Port) {
+				continue
+			}
+		}
+
+		sslPortForHost[s.Hostname] = s.Port
+	}
+
+	if len(sslPortForHost) == 0 {
+		return nil
+	}
+
+	servers := make([]VirtualServer, 0, len(sslPortForHost)+1)
+
+	if !defaultServerExists {
+		servers = append(servers, VirtualServer{
+			IsDefault:       true,
+			Port:            port,
+			DefaultResponse: buildDefaultServerResponse(np, port),
+		})
+	}
+
+	hostnames := make([]string, 0, len(sslPortForHost))
+	for h := range sslPortForHost {
+		hostnames = append(hostnames, h)
+	}
+
+	// We sort the hostnames so the order is preserved after reconfiguration.
+	sort.Strings(hostnames)
+
+	for _, h := range hostnames {
+		redirect := &HTTPSRedirect{
+			Port:       sslPortForHost[h],
+			StatusCode: code,
+		}
+
+		if idx, exists := acmeServerForHost[h]; exists {
+			httpServers[idx].HTTPSRedirect = redirect
+			continue
+		}
+
+		servers = append(servers, VirtualServer{
+			Hostname:      h,
+			Port:          port,
+			HTTPSRedirect: redirect,
+		})
+	}
+
+	return servers
+}
+
+// getListenerHostnames returns the hostnames of the Routes that are attached to the listeners of the Gateway,
+// or nil if there are no listeners.
+func getListenerHostnames(g *graph.Graph, listenerNames []string) map[string]struct{} {
+	if listenerNames == nil {
+		return nil
+	}
+
+	gwNsName := client.ObjectKeyFromObject(g.Gateway.Source)
+	hostnames := make(map[string]struct{})
+
+	for _, route := range g.Routes {
+		for _, ref := range route.ParentRefs {
+			if ref.Attachment == nil || ref.Gateway != gwNsName {
+				continue
+			}
+
+			for _, name := range listenerNames {
+				for _, h := range ref.Attachment.AcceptedHostnames[name] {
+					hostnames[h] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return hostnames
+}
+
+// buildDefaultServerResponse returns the response configured in the NginxProxy for the default server of the port.
+// An entry for the specific port takes precedence over an entry without a port.
+func buildDefaultServerResponse(np *graph.NginxProxy, port int32) *DefaultServerResponse {
+	if np == nil || !np.Valid {
+		return nil
+	}
+
+	var selected *ngfAPI.DefaultServer
+
+	for i, ds := range np.Source.Spec.DefaultServers {
+		if ds.Port == nil {
+			if selected == nil {
+				selected = &np.Source.Spec.DefaultServers[i]
+			}
+			continue
+		}
+
+		if *ds.Port == port {
+			selected = &np.Source.Spec.DefaultServers[i]
+			break
+		}
+	}
+
+	if selected == nil {
+		return nil
+	}
+
+	if selected.Redirect != nil {
+		code := defaultServerRedirectCode
+		if selected.Redirect.StatusCode != nil {
+			code = *selected.Redirect.StatusCode
+		}
+
+		url := selected.Redirect.URL
+		if selected.Redirect.PreserveRequestURI {
+			url = strings.TrimSuffix(url, "/") + "$request_uri"
+		}
+
+		return &DefaultServerResponse{
+			StatusCode: code,
+			Body:       url,
+		}
+	}
+
+	if selected.Response != nil {
+		resp := &DefaultServerResponse{
+			StatusCode:  selected.Response.StatusCode,
+			ContentType: defaultServerContentType,
+		}
+
+		if selected.Response.Body != nil {
+			resp.Body = *selected.Response.Body
+		}
+
+		if selected.Response.ContentType != nil {
+			resp.ContentType = *selected.Response.ContentType
+		}
+
+		return resp
+	}
+
+	return nil
+}
+
 // portPathRules keeps track of hostPathRules per port.
 type portPathRules map[v1.PortNumber]*hostPathRules
 
@@ -387,10 +749,20 @@ func (p portPathRules) buildServers() []VirtualServer {
 		serverCount += rules.maxServerCount()
 	}
 
+	ports := make([]v1.PortNumber, 0, len(p))
+	for port := range p {
+		ports = append(ports, port)
+	}
+
+	// The ports are sorted, so that the order of the servers, and the names of their locations, are stable.
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+
 	servers := make([]VirtualServer, 0, serverCount)
 
-	for _, rules := range p {
-		servers = append(servers, rules.buildServers()...)
+	for _, port := range ports {
+		servers = append(servers, p[port].buildServers()...)
 	}
 
 	return servers
@@ -407,6 +779,10 @@ type hostPathRules struct {
 	httpsListeners   []*graph.Listener
 	port             int32
 	listenersExist   bool
+	// caseInsensitivePaths indicates whether the paths of the routes are matched case-insensitively.
+	caseInsensitivePaths bool
+	// acmeChallengePassthrough indicates whether the rules of the ACME HTTP-01 challenges bypass the policies.
+	acmeChallengePassthrough bool
 }
 
 func newHostPathRules() *hostPathRules {
@@ -425,7 +801,18 @@ func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
 		hpr.httpsListeners = append(hpr.httpsListeners, l)
 	}
 
-	for _, r := range l.Routes {
+	keys := make([]graph.RouteKey, 0, len(l.Routes))
+	for key := range l.Routes {
+		keys = append(keys, key)
+	}
+
+	// The routes are upserted in a stable order, so that the order of the policies of the path rules is stable.
+	sort.Slice(keys, func(i, j int) bool {
+		return lessRouteKey(keys[i], keys[j])
+	})
+
+	for _, key := range keys {
+		r := l.Routes[key]
 		if !r.Valid {
 			continue
 		}
@@ -442,11 +829,14 @@ func (hpr *hostPathRules) upsertRoute(
 	GRPC := route.RouteType == graph.RouteTypeGRPC
 
 	var objectSrc *metav1.ObjectMeta
+	var objectKind string
 
 	if GRPC {
 		objectSrc = &helpers.MustCastObject[*v1.GRPCRoute](route.Source).ObjectMeta
+		objectKind = kinds.GRPCRoute
 	} else {
 		objectSrc = &helpers.MustCastObject[*v1.HTTPRoute](route.Source).ObjectMeta
+		objectKind = kinds.HTTPRoute
 	}
 
 	for _, p := range route.ParentRefs {
@@ -476,20 +866,31 @@ func (hpr *hostPathRules) upsertRoute(
 			continue
 		}
 
+		// the rules of the child HTTPRoute are generated instead of the delegating rule
+		if rule.Delegate != nil {
+			continue
+		}
+
 		var filters HTTPFilters
+		var abTest *ABTest
 		if rule.ValidFilters {
-			filters = createHTTPFilters(rule.Filters)
+			filters = createHTTPFilters(rule.Filters, rule.ExtensionRefFilters)
+			abTest = convertABTestFilter(rule.ExtensionRefFilters, rule.BackendRefs)
 		} else {
 			filters = HTTPFilters{
 				InvalidFilter: &InvalidHTTPFilter{},
 			}
 		}
 
-		pols := buildPolicies(route.Policies)
+		pols := route.EffectivePolicies[string(listener.Source.Name)]
 
 		for _, h := range hostnames {
 			for _, m := range rule.Matches {
 				path := getPath(m.Path)
+				if hpr.caseInsensitivePaths && *m.Path.Type != v1.PathMatchRegularExpression {
+					// the path is matched in any case, so the rules with the same path in a different case are merged
+					path = strings.ToLower(path)
+				}
 
 				key := pathAndType{
 					path:     path,
@@ -500,16 +901,27 @@ func (hpr *hostPathRules) upsertRoute(
 				if !exist {
 					hostRule.Path = path
 					hostRule.PathType = convertPathType(*m.Path.Type)
+					hostRule.CaseInsensitive = hpr.caseInsensitivePaths
 				}
 
 				routeNsName := client.ObjectKeyFromObject(route.Source)
 
 				hostRule.GRPC = GRPC
-				hostRule.Policies = append(hostRule.Policies, pols...)
+
+				// the challenges of the ACME clients must succeed regardless of the policies of the server
+				if hpr.acmeChallengePassthrough && isACMEChallengePath(*m.Path.Type, path) {
+					hostRule.ACMEChallenge = true
+				} else {
+					hostRule.Policies = append(hostRule.Policies, pols...)
+				}
+
+				backendGroup := newBackendGroup(rule.BackendRefs, routeNsName, i)
+				backendGroup.ABTest = abTest
 
 				hostRule.MatchRules = append(hostRule.MatchRules, MatchRule{
 					Source:       objectSrc,
-					BackendGroup: newBackendGroup(rule.BackendRefs, routeNsName, i),
+					SourceKind:   objectKind,
+					BackendGroup: backendGroup,
 					Filters:      filters,
 					Match:        convertMatch(m),
 				})
@@ -520,14 +932,37 @@ func (hpr *hostPathRules) upsertRoute(
 	}
 }
 
+// pathRuleLess sorts the path rules by path and path type, except for the regular expression path rules,
+// which come after the rest of the path rules, longest expression first. NGINX checks the regular
+// expression locations in the order of the configuration, so the longest matching expression wins.
+func pathRuleLess(r1, r2 PathRule) bool {
+	regex1 := r1.PathType == PathTypeRegularExpression
+	regex2 := r2.PathType == PathTypeRegularExpression
+
+	if regex1 != regex2 {
+		return regex2
+	}
+
+	if regex1 && len(r1.Path) != len(r2.Path) {
+		return len(r1.Path) > len(r2.Path)
+	}
+
+	if r1.Path != r2.Path {
+		return r1.Path < r2.Path
+	}
+
+	return r1.PathType < r2.PathType
+}
+
 func (hpr *hostPathRules) buildServers() []VirtualServer {
 	servers := make([]VirtualServer, 0, len(hpr.rulesPerHost)+len(hpr.httpsListeners))
 
 	for h, rules := range hpr.rulesPerHost {
 		s := VirtualServer{
-			Hostname:  h,
-			PathRules: make([]PathRule, 0, len(rules)),
-			Port:      hpr.port,
+			Hostname:             h,
+			PathRules:            make([]PathRule, 0, len(rules)),
+			Port:                 hpr.port,
+			CaseInsensitivePaths: hpr.caseInsensitivePaths,
 		}
 
 		l, ok := hpr.listenersForHost[h]
@@ -535,11 +970,9 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			panic(fmt.Sprintf("no listener found for hostname: %s", h))
 		}
 
-		if l.ResolvedSecret != nil {
-			s.SSL = &SSL{
-				KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
-			}
-		}
+		s.Policies = l.EffectivePolicies
+
+		s.SSL = buildSSL(l)
 
 		for _, r := range rules {
 			sortMatchRules(r.MatchRules)
@@ -549,11 +982,7 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 
 		// We sort the path rules so the order is preserved after reconfiguration.
 		sort.Slice(s.PathRules, func(i, j int) bool {
-			if s.PathRules[i].Path != s.PathRules[j].Path {
-				return s.PathRules[i].Path < s.PathRules[j].Path
-			}
-
-			return s.PathRules[i].PathType < s.PathRules[j].PathType
+			return pathRuleLess(s.PathRules[i], s.PathRules[j])
 		})
 
 		servers = append(servers, s)
@@ -567,13 +996,10 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			s := VirtualServer{
 				Hostname: hostname,
 				Port:     hpr.port,
+				Policies: l.EffectivePolicies,
 			}
 
-			if l.ResolvedSecret != nil {
-				s.SSL = &SSL{
-					KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
-				}
-			}
+			s.SSL = buildSSL(l)
 
 			servers = append(servers, s)
 		}
@@ -604,11 +1030,22 @@ func (hpr *hostPathRules) maxServerCount() int {
 	return len(hpr.rulesPerHost) + len(hpr.httpsListeners) + 1
 }
 
+// hashedFrom returns the Service and the port of the BackendRef if the name of its upstream is hashed.
+func hashedFrom(br graph.BackendRef) string {
+	if _, hashed := graph.UpstreamName(br.SvcNsName, br.ServicePort.Port); !hashed {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", br.SvcNsName, br.ServicePort.Port)
+}
+
 func buildUpstreams(
 	ctx context.Context,
 	listeners []*graph.Listener,
 	svcResolver resolver.ServiceResolver,
+	referencedServices map[types.NamespacedName]*graph.ReferencedService,
 	ipFamily IPFamilyType,
+	activator *graph.Activator,
 ) []Upstream {
 	// There can be duplicate upstreams if multiple routes reference the same upstream.
 	// We use a map to deduplicate them.
@@ -648,10 +1085,32 @@ func buildUpstreams(
 							errMsg = err.Error()
 						}
 
+						// The requests for a Service that scales from zero replicas are forwarded to the activator
+						// while the Service has no ready endpoints, so that the activator can scale it up.
+						if len(eps) == 0 && br.ScaleFromZero && activator != nil {
+							activatorEps, err := svcResolver.Resolve(
+								ctx,
+								activator.SvcNsName,
+								activator.ServicePort,
+								allowedAddressType,
+							)
+							if err == nil {
+								eps = activatorEps
+								errMsg = ""
+							}
+						}
+
+						var pols []policies.Policy
+						if svc, exists := referencedServices[br.SvcNsName]; exists {
+							pols = buildPolicies(svc.Policies)
+						}
+
 						uniqueUpstreams[upstreamName] = Upstream{
-							Name:      upstreamName,
-							Endpoints: eps,
-							ErrorMsg:  errMsg,
+							Name:       upstreamName,
+							HashedFrom: hashedFrom(br),
+							Endpoints:  eps,
+							ErrorMsg:   errMsg,
+							Policies:   pols,
 						}
 					}
 				}
@@ -668,9 +1127,32 @@ func buildUpstreams(
 	for _, up := range uniqueUpstreams {
 		upstreams = append(upstreams, up)
 	}
+
+	// The upstreams are sorted, so that the order of the generated config is stable.
+	sort.Slice(upstreams, func(i, j int) bool {
+		return upstreams[i].Name < upstreams[j].Name
+	})
+
 	return upstreams
 }
 
+// buildPolicies returns the sources of the valid policies.
+func buildPolicies(graphPolicies []*graph.Policy) []policies.Policy {
+	if len(graphPolicies) == 0 {
+		return nil
+	}
+
+	finalPolicies := make([]policies.Policy, 0, len(graphPolicies))
+
+	for _, policy := range graphPolicies {
+		if policy.Valid {
+			finalPolicies = append(finalPolicies, policy.Source)
+		}
+	}
+
+	return finalPolicies
+}
+
 func getAllowedAddressType(ipFamily IPFamilyType) []discoveryV1.AddressType {
 	switch ipFamily {
 	case IPv4:
@@ -699,7 +1181,7 @@ func getPath(path *v1.HTTPPathMatch) string {
 	return *path.Value
 }
 
-func createHTTPFilters(filters []v1.HTTPRouteFilter) HTTPFilters {
+func createHTTPFilters(filters []v1.HTTPRouteFilter, extensionRefFilters []graph.ExtensionRefFilter) HTTPFilters {
 	var result HTTPFilters
 
 	for _, f := range filters {
@@ -726,6 +1208,29 @@ func createHTTPFilters(filters []v1.HTTPRouteFilter) HTTPFilters {
 			}
 		}
 	}
+
+	for _, f := range extensionRefFilters {
+		switch {
+		case f.SnippetsFilter != nil:
+			result.SnippetsFilters = append(result.SnippetsFilters, convertSnippetsFilter(f.SnippetsFilter))
+		case f.RateLimitFilter != nil:
+			if result.RateLimit == nil {
+				// using the first filter
+				result.RateLimit = convertRateLimitFilter(f.RateLimitFilter)
+			}
+		case f.ResponseHeaderFilter != nil:
+			result.ConditionalResponseHeaders = append(
+				result.ConditionalResponseHeaders,
+				convertResponseHeaderFilter(f.ResponseHeaderFilter)...,
+			)
+		case f.QueryParameterFilter != nil:
+			result.QueryParameterModifiers = append(
+				result.QueryParameterModifiers,
+				convertQueryParameterFilter(f.QueryParameterFilter),
+			)
+		}
+	}
+
 	return result
 }
 
@@ -750,6 +1255,40 @@ func generateSSLKeyPairID(secret types.NamespacedName) SSLKeyPairID {
 	return SSLKeyPairID(fmt.Sprintf("ssl_keypair_%s_%s", secret.Namespace, secret.Name))
 }
 
+// buildSSL builds the SSL of a server of the listener from the Secret or the ExternalCertificate of the listener.
+// It returns nil if the listener has neither.
+func buildSSL(l *graph.Listener) *SSL {
+	switch {
+	case l.ResolvedSecret != nil:
+		return &SSL{KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret)}
+	case l.ResolvedExternalCertificate != nil:
+		return &SSL{KeyPairID: generateExternalSSLKeyPairID(*l.ResolvedExternalCertificate)}
+	default:
+		return nil
+	}
+}
+
+// buildDefaultSSL builds the SSL of the default SSL servers from the default certificate of the Gateway.
+// It returns nil if the Gateway doesn't have a default certificate.
+func buildDefaultSSL(cert *graph.DefaultCertificate) *SSL {
+	switch {
+	case cert == nil:
+		return nil
+	case cert.Secret != nil:
+		return &SSL{KeyPairID: generateSSLKeyPairID(*cert.Secret)}
+	case cert.ExternalCertificate != nil:
+		return &SSL{KeyPairID: generateExternalSSLKeyPairID(*cert.ExternalCertificate)}
+	default:
+		return nil
+	}
+}
+
+// generateExternalSSLKeyPairID generates an ID for the SSL key pair based on the ExternalCertificate name.
+// The ID doesn't collide with the IDs of the Secrets, and is safe to use as a file name.
+func generateExternalSSLKeyPairID(name string) SSLKeyPairID {
+	return SSLKeyPairID("external_ssl_keypair_" + name)
+}
+
 // generateCertBundleID generates an ID for the certificate bundle based on the ConfigMap namespaced name.
 // It is guaranteed to be unique per unique namespaced name.
 // The ID is safe to use as a file name.
@@ -757,6 +1296,31 @@ func generateCertBundleID(configMap types.NamespacedName) CertBundleID {
 	return CertBundleID(fmt.Sprintf("cert_bundle_%s_%s", configMap.Namespace, configMap.Name))
 }
 
+// GenerateWAFBundleID generates an ID for the policy bundle of a WAFPolicy based on the WAFPolicy namespaced name.
+// It is guaranteed to be unique per unique namespaced name.
+// The ID is safe to use as a file name.
+func GenerateWAFBundleID(wafPolicy types.NamespacedName) WAFBundleID {
+	return WAFBundleID(fmt.Sprintf("waf_bundle_%s_%s", wafPolicy.Namespace, wafPolicy.Name))
+}
+
+// GenerateSecureLinkSecretID generates an ID for the secret of a SecureLinkPolicy based on the SecureLinkPolicy
+// namespaced name. It is guaranteed to be unique per unique namespaced name.
+// The ID is safe to use as a file name.
+func GenerateSecureLinkSecretID(secureLinkPolicy types.NamespacedName) SecureLinkSecretID {
+	return SecureLinkSecretID(
+		fmt.Sprintf("secure_link_secret_%s_%s", secureLinkPolicy.Namespace, secureLinkPolicy.Name),
+	)
+}
+
+// GenerateStaticFileID generates an ID for a file of a StaticContentPolicy based on the StaticContentPolicy
+// namespaced name and the key of the ConfigMap that holds the file. It is guaranteed to be unique per unique
+// namespaced name and key. The ID is safe to use as a file name.
+func GenerateStaticFileID(staticContentPolicy types.NamespacedName, key string) StaticFileID {
+	return StaticFileID(
+		fmt.Sprintf("static_content_%s_%s_%s", staticContentPolicy.Namespace, staticContentPolicy.Name, key),
+	)
+}
+
 // buildTelemetry generates the Otel configuration.
 func buildTelemetry(g *graph.Graph) Telemetry {
 	if g.NginxProxy == nil || !g.NginxProxy.Valid ||
@@ -806,6 +1370,11 @@ func buildTelemetry(g *graph.Graph) Telemetry {
 		tel.Ratios = append(tel.Ratios, Ratio{Name: name, Value: ratio})
 	}
 
+	// The ratios are sorted, so that the order of the generated config is stable.
+	sort.Slice(tel.Ratios, func(i, j int) bool {
+		return tel.Ratios[i].Value < tel.Ratios[j].Value
+	})
+
 	return tel
 }
 
@@ -828,6 +1397,118 @@ func CreateRatioVarName(ratio int32) string {
 	return fmt.Sprintf("$otel_ratio_%d", ratio)
 }
 
+// buildAccessLogRatios builds the access log sampling ratios of the ObservabilityPolicies.
+func buildAccessLogRatios(g *graph.Graph) []Ratio {
+	ratioMap := make(map[string]int32)
+	for _, pol := range g.NGFPolicies {
+		if obsPol, ok := pol.Source.(*ngfAPI.ObservabilityPolicy); ok {
+			accessLog := obsPol.Spec.AccessLog
+			if accessLog != nil && accessLog.SampleRatio != nil &&
+				*accessLog.SampleRatio > 0 && *accessLog.SampleRatio < 100 {
+				ratioName := CreateAccessLogRatioVarName(*accessLog.SampleRatio)
+				ratioMap[ratioName] = *accessLog.SampleRatio
+			}
+		}
+	}
+
+	if len(ratioMap) == 0 {
+		return nil
+	}
+
+	ratios := make([]Ratio, 0, len(ratioMap))
+	for name, ratio := range ratioMap {
+		ratios = append(ratios, Ratio{Name: name, Value: ratio})
+	}
+
+	// The ratios are sorted, so that the order of the generated config is stable.
+	sort.Slice(ratios, func(i, j int) bool {
+		return ratios[i].Value < ratios[j].Value
+	})
+
+	return ratios
+}
+
+// hasValidModSecurityPolicy returns true if at least one ModSecurityPolicy is valid.
+func hasValidModSecurityPolicy(g *graph.Graph) bool {
+	for _, pol := range g.NGFPolicies {
+		if _, ok := pol.Source.(*ngfAPI.ModSecurityPolicy); ok && pol.Valid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreateAccessLogRatioVarName builds a variable name for an ObservabilityPolicy to be used with
+// ratio-based access log sampling.
+func CreateAccessLogRatioVarName(ratio int32) string {
+	return fmt.Sprintf("$ngf_access_log_ratio_%d", ratio)
+}
+
+// GeoIPAccessLogFormat is the access log format that appends the location of the client to the combined format.
+// It is defined if GeoIP is configured in the NginxProxy resource.
+const GeoIPAccessLogFormat = "ngf_geoip"
+
+// KubernetesAccessLogFormat is the JSON access log format that includes the Kubernetes context of the requests.
+// It is defined if the kubernetes access log format is configured in the NginxProxy resource.
+const KubernetesAccessLogFormat = "ngf_kubernetes"
+
+// buildUpstreamZoneSize returns the upstream zone size configured in the NginxProxy resource, if any.
+func buildUpstreamZoneSize(g *graph.Graph) string {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.UpstreamZoneSize == nil {
+		return ""
+	}
+
+	return string(*g.NginxProxy.Source.Spec.UpstreamZoneSize)
+}
+
+// buildUpstreamDrainTimeout returns the duration for which the upstreams that are no longer referenced stay
+// in the configuration, as configured in the NginxProxy resource, if any.
+func buildUpstreamDrainTimeout(g *graph.Graph) string {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.UpstreamDrainTimeout == nil {
+		return ""
+	}
+
+	return string(*g.NginxProxy.Source.Spec.UpstreamDrainTimeout)
+}
+
+// acmeChallengePassthroughEnabled returns whether the NginxProxy enables the passthrough of the ACME HTTP-01
+// challenges.
+func acmeChallengePassthroughEnabled(np *graph.NginxProxy) bool {
+	return np != nil && np.Valid && np.Source.Spec.ACMEChallengePassthrough
+}
+
+// isACMEChallengePath returns whether an Exact or a PathPrefix path matches the paths of the ACME HTTP-01
+// challenges, which are "/.well-known/acme-challenge/<token>".
+func isACMEChallengePath(pathType v1.PathMatchType, path string) bool {
+	if pathType != v1.PathMatchExact && pathType != v1.PathMatchPathPrefix {
+		return false
+	}
+
+	return path == acmeChallengePathPrefix || strings.HasPrefix(path, acmeChallengePathPrefix+"/")
+}
+
+// isACMEChallengeServer returns whether all the PathRules of a server are the rules of the ACME HTTP-01
+// challenges.
+func isACMEChallengeServer(s VirtualServer) bool {
+	if len(s.PathRules) == 0 {
+		return false
+	}
+
+	for _, rule := range s.PathRules {
+		if !rule.ACMEChallenge {
+			return false
+		}
+	}
+
+	return true
+}
+
+// caseInsensitivePathsEnabled returns whether the NginxProxy enables the case-insensitive matching of the paths.
+func caseInsensitivePathsEnabled(np *graph.NginxProxy) bool {
+	return np != nil && np.Valid && np.Source.Spec.CaseInsensitivePaths
+}
+
 // buildBaseHTTPConfig generates the base http context config that should be applied to all servers.
 func buildBaseHTTPConfig(g *graph.Graph) BaseHTTPConfig {
 	baseConfig := BaseHTTPConfig{
@@ -843,6 +1524,13 @@ func buildBaseHTTPConfig(g *graph.Graph) BaseHTTPConfig {
 		baseConfig.HTTP2 = false
 	}
 
+	if g.NginxProxy.Source.Spec.ServerHeader != nil {
+		baseConfig.ServerHeader = ServerHeader{
+			Value:       g.NginxProxy.Source.Spec.ServerHeader.Value,
+			HideVersion: g.NginxProxy.Source.Spec.ServerHeader.HideVersion,
+		}
+	}
+
 	if g.NginxProxy.Source.Spec.IPFamily != nil {
 		switch *g.NginxProxy.Source.Spec.IPFamily {
 		case ngfAPI.IPv4:
@@ -852,6 +1540,13 @@ func buildBaseHTTPConfig(g *graph.Graph) BaseHTTPConfig {
 		}
 	}
 
+	if geoIP := g.NginxProxy.Source.Spec.GeoIP; geoIP != nil {
+		baseConfig.GeoIP = &GeoIP{DatabasePath: geoIP.DatabasePath}
+		if geoIP.AutoReloadInterval != nil {
+			baseConfig.GeoIP.AutoReloadInterval = string(*geoIP.AutoReloadInterval)
+		}
+	}
+
 	if g.NginxProxy.Source.Spec.RewriteClientIP != nil {
 		if g.NginxProxy.Source.Spec.RewriteClientIP.Mode != nil {
 			switch *g.NginxProxy.Source.Spec.RewriteClientIP.Mode {
@@ -871,27 +1566,73 @@ func buildBaseHTTPConfig(g *graph.Graph) BaseHTTPConfig {
 		if g.NginxProxy.Source.Spec.RewriteClientIP.SetIPRecursively != nil {
 			baseConfig.RewriteClientIPSettings.IPRecursive = *g.NginxProxy.Source.Spec.RewriteClientIP.SetIPRecursively
 		}
+
+		baseConfig.RewriteClientIPSettings.TrustedHops = convertTrustedHops(
+			g.NginxProxy.Source.Spec.RewriteClientIP.TrustedHops,
+		)
 	}
 
 	return baseConfig
 }
 
-func buildPolicies(graphPolicies []*graph.Policy) []policies.Policy {
-	if len(graphPolicies) == 0 {
-		return nil
+// buildConnectionLimits returns the connection limits configured in the NginxProxy resource.
+func buildConnectionLimits(np *graph.NginxProxy) ConnectionLimits {
+	var limits ConnectionLimits
+
+	if np == nil || !np.Valid || np.Source.Spec.ConnectionLimits == nil {
+		return limits
 	}
 
-	finalPolicies := make([]policies.Policy, 0, len(graphPolicies))
+	spec := np.Source.Spec.ConnectionLimits
 
-	for _, policy := range graphPolicies {
-		if !policy.Valid {
-			continue
+	if spec.WorkerConnections != nil {
+		limits.WorkerConnections = *spec.WorkerConnections
+	}
+
+	if spec.DefaultServerMaxConnections != nil {
+		limits.DefaultServerMaxConnections = *spec.DefaultServerMaxConnections
+	}
+
+	if spec.MaxRequestRate != nil {
+		limits.MaxRequestRate = *spec.MaxRequestRate
+	}
+
+	return limits
+}
+
+// buildLogging returns the configuration of the logs in the NginxProxy resource. The Gateway overrides the level of
+// the error log of the NginxProxy.
+func buildLogging(np *graph.NginxProxy, gw *graph.Gateway) Logging {
+	var logging Logging
+
+	if gw != nil && gw.ErrorLogLevel != "" {
+		logging.ErrorLevel = string(gw.ErrorLogLevel)
+	}
+
+	if np == nil || !np.Valid || np.Source.Spec.Logging == nil {
+		return logging
+	}
+
+	format := np.Source.Spec.Logging.AccessLogFormat
+	if format != nil && *format == ngfAPI.NginxAccessLogFormatKubernetes && gw != nil && gw.Source != nil {
+		logging.KubernetesAccessLog = &KubernetesAccessLog{
+			Gateway: client.ObjectKeyFromObject(gw.Source),
 		}
+	}
 
-		finalPolicies = append(finalPolicies, policy.Source)
+	if logging.ErrorLevel == "" && np.Source.Spec.Logging.ErrorLevel != nil {
+		logging.ErrorLevel = string(*np.Source.Spec.Logging.ErrorLevel)
 	}
 
-	return finalPolicies
+	if spec := np.Source.Spec.Logging.StreamAccessLog; spec != nil {
+		if spec.Format != nil {
+			logging.StreamAccessLog.Format = *spec.Format
+		}
+
+		logging.StreamAccessLog.Disabled = spec.Disable
+	}
+
+	return logging
 }
 
 func convertAddresses(addresses []ngfAPI.Address) []string {
@@ -901,3 +1642,26 @@ func convertAddresses(addresses []ngfAPI.Address) []string {
 	}
 	return trustedAddresses
 }
+
+// convertTrustedHops converts the trusted hops of the NginxProxy. The proto header of a hop defaults
+// to X-Forwarded-Proto.
+func convertTrustedHops(hops []ngfAPI.TrustedProxyHop) []TrustedProxyHop {
+	if len(hops) == 0 {
+		return nil
+	}
+
+	trustedHops := make([]TrustedProxyHop, 0, len(hops))
+	for _, hop := range hops {
+		protoHeader := defaultProtoHeader
+		if hop.ProtoHeader != nil {
+			protoHeader = string(*hop.ProtoHeader)
+		}
+
+		trustedHops = append(trustedHops, TrustedProxyHop{
+			ProtoHeader: protoHeader,
+			Addresses:   convertAddresses(hop.Addresses),
+		})
+	}
+
+	return trustedHops
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -21,6 +22,7 @@ import (
 
 	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	policiesfakes "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
@@ -125,6 +127,11 @@ func TestBuildConfiguration(t *testing.T) {
 		Valid:  true,
 	}
 
+	listenerPolicy := &graph.Policy{
+		Source: createFakePolicy("attach-listener", "OrangePolicy"),
+		Valid:  true,
+	}
+
 	hrPolicy1 := &graph.Policy{
 		Source: createFakePolicy("attach-hr", "LemonPolicy"),
 		Valid:  true,
@@ -304,6 +311,15 @@ func TestBuildConfiguration(t *testing.T) {
 		"listener-80-1",
 		pathAndType{path: "/", pathType: prefix},
 	)
+	hrDelegating, _, routeHRDelegating := createTestResources(
+		"hr-delegating",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/app", pathType: prefix},
+	)
+	routeHRDelegating.Spec.Rules[0].Delegate = &types.NamespacedName{Namespace: "app", Name: "child"}
+	routeHRDelegating.Spec.Rules[0].BackendRefs = nil
+
 	hr3, expHR3Groups, routeHR3 := createTestResources(
 		"hr-3",
 		"foo.example.com",
@@ -583,6 +599,7 @@ func TestBuildConfiguration(t *testing.T) {
 	)
 
 	l7RouteWithPolicy.Policies = []*graph.Policy{hrPolicy1, invalidPolicy}
+	l7RouteWithPolicy.EffectivePolicies = map[string][]policies.Policy{"listener-80-1": {hrPolicy1.Source}}
 
 	httpsHRWithPolicy, expHTTPSHRWithPolicyGroups, l7HTTPSRouteWithPolicy := createTestResources(
 		"https-hr-with-policy",
@@ -595,6 +612,7 @@ func TestBuildConfiguration(t *testing.T) {
 	)
 
 	l7HTTPSRouteWithPolicy.Policies = []*graph.Policy{hrPolicy2, invalidPolicy}
+	l7HTTPSRouteWithPolicy.EffectivePolicies = map[string][]policies.Policy{"listener-443-1": {hrPolicy2.Source}}
 
 	secret1NsName := types.NamespacedName{Namespace: "test", Name: "secret-1"}
 	secret1 := &graph.Secret{
@@ -910,6 +928,77 @@ func TestBuildConfiguration(t *testing.T) {
 			}),
 			msg: "https listeners with no routes",
 		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:                        "listener-443-1",
+					Source:                      listener443,
+					Valid:                       true,
+					Routes:                      map[graph.RouteKey]*graph.L7Route{},
+					ResolvedExternalCertificate: helpers.GetPointer("cafe"),
+				})
+				g.ReferencedExternalCertificates = map[string]*graph.ExternalCertificate{
+					"cafe": {
+						Cert: []byte("external-cert"),
+						Key:  []byte("external-key"),
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.HTTPServers = []VirtualServer{}
+				conf.SSLServers = append(conf.SSLServers, VirtualServer{
+					Hostname: wildcardHostname,
+					SSL:      &SSL{KeyPairID: "external_ssl_keypair_cafe"},
+					Port:     443,
+				})
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{
+					"external_ssl_keypair_cafe": {
+						Cert: []byte("external-cert"),
+						Key:  []byte("external-key"),
+					},
+				}
+				return conf
+			}),
+			msg: "https listener with an external certificate",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:           "listener-443-1",
+					Source:         listener443,
+					Valid:          true,
+					Routes:         map[graph.RouteKey]*graph.L7Route{},
+					ResolvedSecret: &secret1NsName,
+				})
+				g.ReferencedSecrets = map[types.NamespacedName]*graph.Secret{
+					secret1NsName: secret1,
+				}
+				g.ReferencedExternalCertificates = map[string]*graph.ExternalCertificate{
+					"default": {
+						Cert: []byte("default-cert"),
+						Key:  []byte("default-key"),
+					},
+				}
+				g.DefaultCertificate = &graph.DefaultCertificate{ExternalCertificate: helpers.GetPointer("default")}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.HTTPServers = []VirtualServer{}
+				conf.SSLServers[0].SSL = &SSL{KeyPairID: "external_ssl_keypair_default"}
+				conf.SSLServers = append(conf.SSLServers, VirtualServer{
+					Hostname: wildcardHostname,
+					SSL:      &SSL{KeyPairID: "ssl_keypair_test_secret-1"},
+					Port:     443,
+				})
+				conf.SSLKeyPairs["external_ssl_keypair_default"] = SSLKeyPair{
+					Cert: []byte("default-cert"),
+					Key:  []byte("default-key"),
+				}
+				return conf
+			}),
+			msg: "https listener with a default certificate",
+		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
 				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
@@ -962,6 +1051,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR2Groups[0],
 										Source:       &hr2.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -978,6 +1068,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR1Groups[0],
 										Source:       &hr1.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1019,6 +1110,7 @@ func TestBuildConfiguration(t *testing.T) {
 								{
 									BackendGroup: expGRGroups[0],
 									Source:       &gr.ObjectMeta,
+									SourceKind:   kinds.GRPCRoute,
 								},
 							},
 						},
@@ -1034,6 +1126,49 @@ func TestBuildConfiguration(t *testing.T) {
 			}),
 			msg: "one http listener with one grpc route",
 		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						graph.CreateRouteKey(hr1):          routeHR1,
+						graph.CreateRouteKey(hrDelegating): routeHRDelegating,
+					},
+				})
+				g.Routes = map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(hr1):          routeHR1,
+					graph.CreateRouteKey(hrDelegating): routeHRDelegating,
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.HTTPServers = append(conf.HTTPServers, VirtualServer{
+					Hostname: "foo.example.com",
+					PathRules: []PathRule{
+						{
+							Path:     "/",
+							PathType: PathTypePrefix,
+							MatchRules: []MatchRule{
+								{
+									BackendGroup: expHR1Groups[0],
+									Source:       &hr1.ObjectMeta,
+									SourceKind:   kinds.HTTPRoute,
+								},
+							},
+						},
+					},
+					Port: 80,
+				})
+				conf.SSLServers = []VirtualServer{}
+				conf.Upstreams = []Upstream{fooUpstream}
+				conf.BackendGroups = []BackendGroup{expHR1Groups[0]}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				return conf
+			}),
+			msg: "one http listener with a route that delegates a path prefix to a child route",
+		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
 				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
@@ -1081,6 +1216,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR2Groups[0],
 										Source:       &httpsHR2.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1098,6 +1234,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR5Groups[0],
 										Source:       &httpsHR5.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1115,6 +1252,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR1Groups[0],
 										Source:       &httpsHR1.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1190,10 +1328,12 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR3Groups[0],
 										Source:       &hr3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 									{
 										BackendGroup: expHR4Groups[1],
 										Source:       &hr4.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1204,6 +1344,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR4Groups[0],
 										Source:       &hr4.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1214,6 +1355,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR3Groups[1],
 										Source:       &hr3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1233,10 +1375,12 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR3Groups[0],
 										Source:       &httpsHR3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 									{
 										BackendGroup: expHTTPSHR4Groups[1],
 										Source:       &httpsHR4.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1247,6 +1391,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR4Groups[0],
 										Source:       &httpsHR4.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1257,6 +1402,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR3Groups[1],
 										Source:       &httpsHR3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1345,6 +1491,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR3Groups[0],
 										Source:       &hr3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1355,6 +1502,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR3Groups[1],
 										Source:       &hr3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1375,6 +1523,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR8Groups[0],
 										Source:       &hr8.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1385,6 +1534,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR8Groups[1],
 										Source:       &hr8.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1404,6 +1554,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR3Groups[0],
 										Source:       &httpsHR3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1414,6 +1565,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR3Groups[1],
 										Source:       &httpsHR3.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1440,6 +1592,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR7Groups[0],
 										Source:       &httpsHR7.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1450,6 +1603,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR7Groups[1],
 										Source:       &httpsHR7.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1549,6 +1703,7 @@ func TestBuildConfiguration(t *testing.T) {
 								MatchRules: []MatchRule{
 									{
 										Source:       &hr5.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 										BackendGroup: expHR5Groups[0],
 										Filters: HTTPFilters{
 											RequestRedirect: &expRedirect,
@@ -1562,6 +1717,7 @@ func TestBuildConfiguration(t *testing.T) {
 								MatchRules: []MatchRule{
 									{
 										Source:       &hr5.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 										BackendGroup: expHR5Groups[1],
 										Filters: HTTPFilters{
 											InvalidFilter: &InvalidHTTPFilter{},
@@ -1657,6 +1813,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR6Groups[0],
 										Source:       &hr6.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1676,6 +1833,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR6Groups[0],
 										Source:       &httpsHR6.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1700,6 +1858,7 @@ func TestBuildConfiguration(t *testing.T) {
 					{
 						Hostname:     "app.example.com",
 						UpstreamName: "default_secure-app_8443",
+						Route:        types.NamespacedName{Namespace: "default", Name: "secure-app"},
 						Port:         443,
 					},
 					{
@@ -1711,6 +1870,7 @@ func TestBuildConfiguration(t *testing.T) {
 					{
 						Hostname:     "app.example.com",
 						UpstreamName: "default_secure-app_8443",
+						Route:        types.NamespacedName{Namespace: "default", Name: "secure-app"},
 						Port:         444,
 						IsDefault:    false,
 					},
@@ -1752,6 +1912,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR7Groups[1],
 										Source:       &hr7.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1762,6 +1923,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHR7Groups[0],
 										Source:       &hr7.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1821,10 +1983,12 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR5Groups[0],
 										Source:       &httpsHR5.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 									{
 										BackendGroup: expHTTPSHR5Groups[0],
 										Source:       &httpsHR5.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1887,10 +2051,12 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR8Groups[0],
 										Source:       &httpsHR8.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 									{
 										BackendGroup: expHTTPSHR8Groups[1],
 										Source:       &httpsHR8.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -1946,10 +2112,12 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHR9Groups[0],
 										Source:       &httpsHR9.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 									{
 										BackendGroup: expHTTPSHR9Groups[1],
 										Source:       &httpsHR9.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 							},
@@ -2050,6 +2218,7 @@ func TestBuildConfiguration(t *testing.T) {
 						Routes: map[graph.RouteKey]*graph.L7Route{
 							graph.CreateRouteKey(hrWithPolicy): l7RouteWithPolicy,
 						},
+						EffectivePolicies: []policies.Policy{gwPolicy1.Source, gwPolicy2.Source},
 					},
 					{
 						Name:   "listener-443",
@@ -2058,10 +2227,13 @@ func TestBuildConfiguration(t *testing.T) {
 						Routes: map[graph.RouteKey]*graph.L7Route{
 							graph.CreateRouteKey(httpsHRWithPolicy): l7HTTPSRouteWithPolicy,
 						},
-						ResolvedSecret: &secret1NsName,
+						ResolvedSecret:    &secret1NsName,
+						Policies:          []*graph.Policy{listenerPolicy},
+						EffectivePolicies: []policies.Policy{gwPolicy1.Source, listenerPolicy.Source},
 					},
 				}...)
 				g.Gateway.Policies = []*graph.Policy{gwPolicy1, gwPolicy2}
+				g.Gateway.EffectivePolicies = []policies.Policy{gwPolicy1.Source, gwPolicy2.Source}
 				g.Routes = map[graph.RouteKey]*graph.L7Route{
 					graph.CreateRouteKey(hrWithPolicy):      l7RouteWithPolicy,
 					graph.CreateRouteKey(httpsHRWithPolicy): l7HTTPSRouteWithPolicy,
@@ -2088,6 +2260,7 @@ func TestBuildConfiguration(t *testing.T) {
 									{
 										BackendGroup: expHTTPSHRWithPolicyGroups[0],
 										Source:       &httpsHRWithPolicy.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 									},
 								},
 								Policies: []policies.Policy{hrPolicy2.Source},
@@ -2095,13 +2268,13 @@ func TestBuildConfiguration(t *testing.T) {
 						},
 						SSL:      &SSL{KeyPairID: "ssl_keypair_test_secret-1"},
 						Port:     443,
-						Policies: []policies.Policy{gwPolicy1.Source, gwPolicy2.Source},
+						Policies: []policies.Policy{gwPolicy1.Source, listenerPolicy.Source},
 					},
 					{
 						Hostname: wildcardHostname,
 						SSL:      &SSL{KeyPairID: "ssl_keypair_test_secret-1"},
 						Port:     443,
-						Policies: []policies.Policy{gwPolicy1.Source, gwPolicy2.Source},
+						Policies: []policies.Policy{gwPolicy1.Source, listenerPolicy.Source},
 					},
 				}
 				conf.HTTPServers = []VirtualServer{
@@ -2119,6 +2292,7 @@ func TestBuildConfiguration(t *testing.T) {
 								MatchRules: []MatchRule{
 									{
 										Source:       &hrWithPolicy.ObjectMeta,
+										SourceKind:   kinds.HTTPRoute,
 										BackendGroup: expHRWithPolicyGroups[0],
 									},
 								},
@@ -2228,6 +2402,46 @@ func TestBuildConfiguration(t *testing.T) {
 			}),
 			msg: "NginxProxy with rewriteClientIP details set",
 		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPI.NginxProxy{
+						Spec: ngfAPI.NginxProxySpec{
+							GeoIP: &ngfAPI.GeoIP{
+								DatabasePath:       "/etc/nginx/geoip/GeoLite2-City.mmdb",
+								AutoReloadInterval: helpers.GetPointer[ngfAPI.Duration]("60m"),
+							},
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2:    true,
+					IPFamily: Dual,
+					GeoIP: &GeoIP{
+						DatabasePath:       "/etc/nginx/geoip/GeoLite2-City.mmdb",
+						AutoReloadInterval: "60m",
+					},
+				}
+				return conf
+			}),
+			msg: "NginxProxy with geoIP set",
+		},
 	}
 
 	for _, test := range tests {
@@ -2458,7 +2672,7 @@ func TestCreateFilters(t *testing.T) {
 		t.Run(test.msg, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			result := createHTTPFilters(test.filters)
+			result := createHTTPFilters(test.filters, nil)
 
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
@@ -2716,10 +2930,28 @@ func TestBuildUpstreams(t *testing.T) {
 	emptyEndpointsErrMsg := "empty endpoints error"
 	nilEndpointsErrMsg := "nil endpoints error"
 
+	validPolicy := &ngfAPI.UpstreamSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "valid", Namespace: "test"},
+	}
+	invalidPolicy := &ngfAPI.UpstreamSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid", Namespace: "test"},
+	}
+
+	referencedServices := map[types.NamespacedName]*graph.ReferencedService{
+		{Namespace: "test", Name: "bar"}: {
+			Policies: []*graph.Policy{
+				{Source: validPolicy, Valid: true},
+				{Source: invalidPolicy, Valid: false},
+			},
+		},
+		{Namespace: "test", Name: "foo"}: {},
+	}
+
 	expUpstreams := []Upstream{
 		{
 			Name:      "test_bar_80",
 			Endpoints: barEndpoints,
+			Policies:  []policies.Policy{validPolicy},
 		},
 		{
 			Name:      "test_baz2_80",
@@ -2780,10 +3012,187 @@ func TestBuildUpstreams(t *testing.T) {
 
 	g := NewWithT(t)
 
-	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver, Dual)
+	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver, referencedServices, Dual, nil)
 	g.Expect(upstreams).To(ConsistOf(expUpstreams))
 }
 
+func TestBuildUpstreamsScaleFromZero(t *testing.T) {
+	t.Parallel()
+
+	activatorEndpoints := []resolver.Endpoint{
+		{
+			Address: "10.0.0.100",
+			Port:    8080,
+		},
+	}
+
+	activator := &graph.Activator{
+		SvcNsName:   types.NamespacedName{Namespace: "keda", Name: "activator"},
+		ServicePort: apiv1.ServicePort{Port: 8080},
+	}
+
+	noEndpointsErrMsg := "no endpoints found"
+
+	createListeners := func(scaleFromZero bool) []*graph.Listener {
+		return []*graph.Listener{
+			{
+				Name:  "listener-1",
+				Valid: true,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					{NamespacedName: types.NamespacedName{Name: "hr", Namespace: "test"}}: {
+						Valid: true,
+						Spec: graph.L7RouteSpec{
+							Rules: refsToValidRules([]graph.BackendRef{
+								{
+									SvcNsName:     types.NamespacedName{Namespace: "test", Name: "foo"},
+									ServicePort:   apiv1.ServicePort{Port: 80},
+									Valid:         true,
+									ScaleFromZero: scaleFromZero,
+								},
+							}),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		activator     *graph.Activator
+		activatorErr  error
+		name          string
+		expUpstreams  []Upstream
+		scaleFromZero bool
+	}{
+		{
+			name:          "service scales from zero",
+			activator:     activator,
+			scaleFromZero: true,
+			expUpstreams: []Upstream{
+				{
+					Name:      "test_foo_80",
+					Endpoints: activatorEndpoints,
+				},
+			},
+		},
+		{
+			name:          "service does not scale from zero",
+			activator:     activator,
+			scaleFromZero: false,
+			expUpstreams: []Upstream{
+				{
+					Name:     "test_foo_80",
+					ErrorMsg: noEndpointsErrMsg,
+				},
+			},
+		},
+		{
+			name:          "no activator",
+			activator:     nil,
+			scaleFromZero: true,
+			expUpstreams: []Upstream{
+				{
+					Name:     "test_foo_80",
+					ErrorMsg: noEndpointsErrMsg,
+				},
+			},
+		},
+		{
+			name:          "activator has no endpoints",
+			activator:     activator,
+			activatorErr:  errors.New("activator error"),
+			scaleFromZero: true,
+			expUpstreams: []Upstream{
+				{
+					Name:     "test_foo_80",
+					ErrorMsg: noEndpointsErrMsg,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			fakeResolver := &resolverfakes.FakeServiceResolver{}
+			fakeResolver.ResolveCalls(func(
+				_ context.Context,
+				svcNsName types.NamespacedName,
+				_ apiv1.ServicePort,
+				_ []discoveryV1.AddressType,
+			) ([]resolver.Endpoint, error) {
+				if svcNsName == activator.SvcNsName {
+					if test.activatorErr != nil {
+						return nil, test.activatorErr
+					}
+					return activatorEndpoints, nil
+				}
+				return nil, errors.New(noEndpointsErrMsg)
+			})
+
+			upstreams := buildUpstreams(
+				context.TODO(),
+				createListeners(test.scaleFromZero),
+				fakeResolver,
+				nil,
+				Dual,
+				test.activator,
+			)
+			g.Expect(upstreams).To(ConsistOf(test.expUpstreams))
+		})
+	}
+}
+
+func TestBuildUpstreamsHashedNames(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	longSvcNsName := types.NamespacedName{Namespace: "test", Name: strings.Repeat("a", 63)}
+	hashedName, hashed := graph.UpstreamName(longSvcNsName, 80)
+	g.Expect(hashed).To(BeTrue())
+
+	listeners := []*graph.Listener{
+		{
+			Name:  "listener-1",
+			Valid: true,
+			Routes: map[graph.RouteKey]*graph.L7Route{
+				{NamespacedName: types.NamespacedName{Name: "hr", Namespace: "test"}}: {
+					Valid: true,
+					Spec: graph.L7RouteSpec{
+						Rules: refsToValidRules([]graph.BackendRef{
+							{
+								SvcNsName:   longSvcNsName,
+								ServicePort: apiv1.ServicePort{Port: 80},
+								Valid:       true,
+							},
+							{
+								SvcNsName:   types.NamespacedName{Namespace: "test", Name: "foo"},
+								ServicePort: apiv1.ServicePort{Port: 80},
+								Valid:       true,
+							},
+						}),
+					},
+				},
+			},
+		},
+	}
+
+	fakeResolver := &resolverfakes.FakeServiceResolver{}
+
+	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver, nil, Dual, nil)
+	g.Expect(upstreams).To(ConsistOf(
+		Upstream{
+			Name:       hashedName,
+			HashedFrom: "test/" + longSvcNsName.Name + ":80",
+		},
+		Upstream{
+			Name: "test_foo_80",
+		},
+	))
+}
+
 func TestBuildBackendGroups(t *testing.T) {
 	t.Parallel()
 	createBackendGroup := func(name string, ruleIdx int, backendNames ...string) BackendGroup {
@@ -3232,84 +3641,6 @@ func TestBuildTelemetry(t *testing.T) {
 	}
 }
 
-func TestBuildPolicies(t *testing.T) {
-	t.Parallel()
-	getPolicy := func(kind, name string) policies.Policy {
-		return &policiesfakes.FakePolicy{
-			GetNameStub: func() string {
-				return name
-			},
-			GetNamespaceStub: func() string {
-				return "test"
-			},
-			GetObjectKindStub: func() schema.ObjectKind {
-				objKind := &policiesfakes.FakeObjectKind{
-					GroupVersionKindStub: func() schema.GroupVersionKind {
-						return schema.GroupVersionKind{Kind: kind}
-					},
-				}
-
-				return objKind
-			},
-		}
-	}
-
-	tests := []struct {
-		name        string
-		policies    []*graph.Policy
-		expPolicies []string
-	}{
-		{
-			name:        "nil policies",
-			policies:    nil,
-			expPolicies: nil,
-		},
-		{
-			name: "mix of valid and invalid policies",
-			policies: []*graph.Policy{
-				{
-					Source: getPolicy("Kind1", "valid1"),
-					Valid:  true,
-				},
-				{
-					Source: getPolicy("Kind2", "valid2"),
-					Valid:  true,
-				},
-				{
-					Source: getPolicy("Kind1", "invalid1"),
-					Valid:  false,
-				},
-				{
-					Source: getPolicy("Kind2", "invalid2"),
-					Valid:  false,
-				},
-				{
-					Source: getPolicy("Kind3", "valid3"),
-					Valid:  true,
-				},
-			},
-			expPolicies: []string{
-				"valid1",
-				"valid2",
-				"valid3",
-			},
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-			g := NewWithT(t)
-
-			pols := buildPolicies(test.policies)
-			g.Expect(pols).To(HaveLen(len(test.expPolicies)))
-			for _, pol := range pols {
-				g.Expect(test.expPolicies).To(ContainElement(pol.GetName()))
-			}
-		})
-	}
-}
-
 func TestGetAllowedAddressType(t *testing.T) {
 	t.Parallel()
 	test := []struct {
@@ -3463,12 +3794,14 @@ func TestCreatePassthroughServers(t *testing.T) {
 		{
 			Hostname:     "app.example.com",
 			UpstreamName: "default_secure-app_8443",
+			Route:        secureAppKey.NamespacedName,
 			Port:         443,
 			IsDefault:    false,
 		},
 		{
 			Hostname:     "cafe.example.com",
 			UpstreamName: "default_secure-app_8443",
+			Route:        secureAppKey.NamespacedName,
 			Port:         443,
 			IsDefault:    false,
 		},
@@ -3733,8 +4066,57 @@ func TestBuildRewriteIPSettings(t *testing.T) {
 				IPRecursive:      false,
 			},
 		},
-	}
-
+		{
+			msg: "rewrite IP settings configured with trusted hops",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPI.NginxProxy{
+						Spec: ngfAPI.NginxProxySpec{
+							RewriteClientIP: &ngfAPI.RewriteClientIP{
+								Mode: helpers.GetPointer(ngfAPI.RewriteClientIPModeXForwardedFor),
+								TrustedHops: []ngfAPI.TrustedProxyHop{
+									{
+										Name:        "cdn",
+										ProtoHeader: helpers.GetPointer[v1.HTTPHeaderName]("CloudFront-Forwarded-Proto"),
+										Addresses: []ngfAPI.Address{
+											{
+												Type:  ngfAPI.AddressTypeCIDR,
+												Value: "130.176.0.0/16",
+											},
+										},
+									},
+									{
+										Name: "lb",
+										Addresses: []ngfAPI.Address{
+											{
+												Type:  ngfAPI.AddressTypeCIDR,
+												Value: "10.0.0.0/8",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expRewriteIPSettings: RewriteClientIPSettings{
+				Mode: RewriteIPModeXForwardedFor,
+				TrustedHops: []TrustedProxyHop{
+					{
+						ProtoHeader: "CloudFront-Forwarded-Proto",
+						Addresses:   []string{"130.176.0.0/16"},
+					},
+					{
+						ProtoHeader: "X-Forwarded-Proto",
+						Addresses:   []string{"10.0.0.0/8"},
+					},
+				},
+			},
+		},
+	}
+
 	for _, tc := range tests {
 		t.Run(tc.msg, func(t *testing.T) {
 			t.Parallel()
@@ -3744,3 +4126,1407 @@ func TestBuildRewriteIPSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildDefaultServerResponse(t *testing.T) {
+	t.Parallel()
+
+	createNginxProxy := func(defaultServers ...ngfAPI.DefaultServer) *graph.NginxProxy {
+		return &graph.NginxProxy{
+			Valid: true,
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DefaultServers: defaultServers,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		np   *graph.NginxProxy
+		exp  *DefaultServerResponse
+		msg  string
+		port int32
+	}{
+		{
+			msg:  "nil nginxproxy",
+			np:   nil,
+			port: 80,
+			exp:  nil,
+		},
+		{
+			msg: "invalid nginxproxy",
+			np: &graph.NginxProxy{
+				Valid: false,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						DefaultServers: []ngfAPI.DefaultServer{
+							{Response: &ngfAPI.DefaultServerResponse{StatusCode: 410}},
+						},
+					},
+				},
+			},
+			port: 80,
+			exp:  nil,
+		},
+		{
+			msg:  "no default servers configured",
+			np:   createNginxProxy(),
+			port: 80,
+			exp:  nil,
+		},
+		{
+			msg: "no entry matches the port",
+			np: createNginxProxy(ngfAPI.DefaultServer{
+				Port:     helpers.GetPointer[int32](8080),
+				Response: &ngfAPI.DefaultServerResponse{StatusCode: 410},
+			}),
+			port: 80,
+			exp:  nil,
+		},
+		{
+			msg: "response with defaults",
+			np: createNginxProxy(ngfAPI.DefaultServer{
+				Response: &ngfAPI.DefaultServerResponse{StatusCode: 410},
+			}),
+			port: 80,
+			exp: &DefaultServerResponse{
+				StatusCode:  410,
+				ContentType: "text/plain",
+			},
+		},
+		{
+			msg: "response with body and content type",
+			np: createNginxProxy(ngfAPI.DefaultServer{
+				Response: &ngfAPI.DefaultServerResponse{
+					StatusCode:  503,
+					Body:        helpers.GetPointer("unknown host $host"),
+					ContentType: helpers.GetPointer("text/plain"),
+				},
+			}),
+			port: 80,
+			exp: &DefaultServerResponse{
+				StatusCode:  503,
+				Body:        "unknown host $host",
+				ContentType: "text/plain",
+			},
+		},
+		{
+			msg: "redirect with default status code",
+			np: createNginxProxy(ngfAPI.DefaultServer{
+				Redirect: &ngfAPI.DefaultServerRedirect{URL: "https://example.com"},
+			}),
+			port: 80,
+			exp: &DefaultServerResponse{
+				StatusCode: 302,
+				Body:       "https://example.com",
+			},
+		},
+		{
+			msg: "redirect preserving the request URI",
+			np: createNginxProxy(ngfAPI.DefaultServer{
+				Redirect: &ngfAPI.DefaultServerRedirect{
+					URL:                "https://example.com/",
+					StatusCode:         helpers.GetPointer(301),
+					PreserveRequestURI: true,
+				},
+			}),
+			port: 80,
+			exp: &DefaultServerResponse{
+				StatusCode: 301,
+				Body:       "https://example.com$request_uri",
+			},
+		},
+		{
+			msg: "entry for the port takes precedence over entry without a port",
+			np: createNginxProxy(
+				ngfAPI.DefaultServer{
+					Response: &ngfAPI.DefaultServerResponse{StatusCode: 410},
+				},
+				ngfAPI.DefaultServer{
+					Port:     helpers.GetPointer[int32](8080),
+					Response: &ngfAPI.DefaultServerResponse{StatusCode: 503},
+				},
+			),
+			port: 8080,
+			exp: &DefaultServerResponse{
+				StatusCode:  503,
+				ContentType: "text/plain",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildDefaultServerResponse(tc.np, tc.port)).To(Equal(tc.exp))
+		})
+	}
+}
+
+func TestBuildHTTPSRedirectServers(t *testing.T) {
+	t.Parallel()
+
+	createNginxProxy := func(redirect *ngfAPI.HTTPSRedirect) *graph.NginxProxy {
+		return &graph.NginxProxy{
+			Valid: true,
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					HTTPSRedirect: redirect,
+				},
+			},
+		}
+	}
+
+	sslServers := []VirtualServer{
+		{IsDefault: true, Port: 443},
+		{Hostname: "foo.example.com", Port: 443},
+		{Hostname: "foo.example.com", Port: 8443},
+		{Hostname: "bar.example.com", Port: 9443},
+		{Hostname: "bar.example.com", Port: 8443},
+		{Hostname: "http.example.com", Port: 443},
+		{Hostname: wildcardHostname, Port: 443},
+	}
+
+	httpServers := []VirtualServer{
+		{IsDefault: true, Port: 80},
+		{Hostname: "http.example.com", Port: 80},
+	}
+
+	gwSource := &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}}
+
+	// the Routes of the foo listener have the foo.example.com hostname, and of the bar listener bar.example.com
+	routes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "route"}}: {
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway: client.ObjectKeyFromObject(gwSource),
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{
+							"foo": {"foo.example.com"},
+							"bar": {"bar.example.com"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		np          *graph.NginxProxy
+		msg         string
+		listeners   []string
+		httpServers []VirtualServer
+		sslServers  []VirtualServer
+		exp         []VirtualServer
+	}{
+		{
+			msg:         "nil nginxproxy",
+			np:          nil,
+			httpServers: httpServers,
+			sslServers:  sslServers,
+			exp:         nil,
+		},
+		{
+			msg: "invalid nginxproxy",
+			np: &graph.NginxProxy{
+				Valid: false,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						HTTPSRedirect: &ngfAPI.HTTPSRedirect{},
+					},
+				},
+			},
+			httpServers: httpServers,
+			sslServers:  sslServers,
+			exp:         nil,
+		},
+		{
+			msg:         "httpsRedirect not configured",
+			np:          createNginxProxy(nil),
+			httpServers: httpServers,
+			sslServers:  sslServers,
+			exp:         nil,
+		},
+		{
+			msg:         "no ssl servers",
+			np:          createNginxProxy(&ngfAPI.HTTPSRedirect{}),
+			httpServers: httpServers,
+			sslServers:  nil,
+			exp:         nil,
+		},
+		{
+			msg:         "redirect servers with defaults",
+			np:          createNginxProxy(&ngfAPI.HTTPSRedirect{}),
+			httpServers: httpServers,
+			sslServers:  sslServers,
+			exp: []VirtualServer{
+				{
+					Hostname: "bar.example.com",
+					Port:     80,
+					HTTPSRedirect: &HTTPSRedirect{
+						Port:       8443,
+						StatusCode: 301,
+					},
+				},
+				{
+					Hostname: "foo.example.com",
+					Port:     80,
+					HTTPSRedirect: &HTTPSRedirect{
+						Port:       443,
+						StatusCode: 301,
+					},
+				},
+			},
+		},
+		{
+			msg: "redirect servers on a port without a default server",
+			np: createNginxProxy(&ngfAPI.HTTPSRedirect{
+				Port:       helpers.GetPointer[int32](8080),
+				StatusCode: helpers.GetPointer(308),
+			}),
+			httpServers: httpServers,
+			sslServers: []VirtualServer{
+				{Hostname: "http.example.com", Port: 443},
+			},
+			exp: []VirtualServer{
+				{
+					IsDefault: true,
+					Port:      8080,
+				},
+				{
+					Hostname: "http.example.com",
+					Port:     8080,
+					HTTPSRedirect: &HTTPSRedirect{
+						Port:       443,
+						StatusCode: 308,
+					},
+				},
+			},
+		},
+		{
+			msg:         "redirect servers for the listeners of the Gateway with defaults",
+			np:          nil,
+			listeners:   []string{"foo"},
+			httpServers: httpServers,
+			sslServers:  sslServers,
+			exp: []VirtualServer{
+				{
+					Hostname: "foo.example.com",
+					Port:     80,
+					HTTPSRedirect: &HTTPSRedirect{
+						Port:       443,
+						StatusCode: 301,
+					},
+				},
+			},
+		},
+		{
+			msg: "redirect servers for the listeners of the Gateway with the settings of the nginxproxy",
+			np: createNginxProxy(&ngfAPI.HTTPSRedirect{
+				StatusCode: helpers.GetPointer(308),
+			}),
+			listeners:   []string{"bar"},
+			httpServers: httpServers,
+			sslServers:  sslServers,
+			exp: []VirtualServer{
+				{
+					Hostname: "bar.example.com",
+					Port:     80,
+					HTTPSRedirect: &HTTPSRedirect{
+						Port:       8443,
+						StatusCode: 308,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gr := &graph.Graph{
+				Gateway: &graph.Gateway{
+					Source:                 gwSource,
+					HTTPSRedirectListeners: tc.listeners,
+				},
+				Routes:     routes,
+				NginxProxy: tc.np,
+			}
+
+			g.Expect(buildHTTPSRedirectServers(gr, tc.httpServers, tc.sslServers)).To(Equal(tc.exp))
+		})
+	}
+}
+
+func TestBuildHTTPSRedirectServersACMEChallenge(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	np := &graph.NginxProxy{
+		Valid: true,
+		Source: &ngfAPI.NginxProxy{
+			Spec: ngfAPI.NginxProxySpec{
+				HTTPSRedirect:            &ngfAPI.HTTPSRedirect{},
+				ACMEChallengePassthrough: true,
+			},
+		},
+	}
+
+	acmeRules := []PathRule{{Path: "/.well-known/acme-challenge/", PathType: PathTypePrefix, ACMEChallenge: true}}
+
+	httpServers := []VirtualServer{
+		{IsDefault: true, Port: 80},
+		{Hostname: "foo.example.com", Port: 80, PathRules: acmeRules},
+		{
+			Hostname: "bar.example.com",
+			Port:     80,
+			PathRules: append(
+				[]PathRule{{Path: "/", PathType: PathTypePrefix}},
+				acmeRules...,
+			),
+		},
+	}
+
+	sslServers := []VirtualServer{
+		{Hostname: "foo.example.com", Port: 443},
+		{Hostname: "bar.example.com", Port: 443},
+		{Hostname: "baz.example.com", Port: 443},
+	}
+
+	gr := &graph.Graph{
+		Gateway:    &graph.Gateway{Source: &v1.Gateway{}},
+		NginxProxy: np,
+	}
+
+	servers := buildHTTPSRedirectServers(gr, httpServers, sslServers)
+
+	// the server that only serves the ACME challenges redirects the other requests itself
+	g.Expect(servers).To(Equal([]VirtualServer{
+		{
+			Hostname: "baz.example.com",
+			Port:     80,
+			HTTPSRedirect: &HTTPSRedirect{
+				Port:       443,
+				StatusCode: 301,
+			},
+		},
+	}))
+	g.Expect(httpServers[1].HTTPSRedirect).To(Equal(&HTTPSRedirect{Port: 443, StatusCode: 301}))
+	g.Expect(httpServers[2].HTTPSRedirect).To(BeNil())
+}
+
+func TestIsACMEChallengePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg      string
+		path     string
+		pathType v1.PathMatchType
+		exp      bool
+	}{
+		{
+			msg:      "prefix",
+			path:     "/.well-known/acme-challenge",
+			pathType: v1.PathMatchPathPrefix,
+			exp:      true,
+		},
+		{
+			msg:      "exact token",
+			path:     "/.well-known/acme-challenge/token",
+			pathType: v1.PathMatchExact,
+			exp:      true,
+		},
+		{
+			msg:      "other well-known path",
+			path:     "/.well-known/acme-challenges",
+			pathType: v1.PathMatchPathPrefix,
+			exp:      false,
+		},
+		{
+			msg:      "root path",
+			path:     "/",
+			pathType: v1.PathMatchPathPrefix,
+			exp:      false,
+		},
+		{
+			msg:      "regular expression",
+			path:     "/.well-known/acme-challenge/.*",
+			pathType: v1.PathMatchRegularExpression,
+			exp:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(isACMEChallengePath(tc.pathType, tc.path)).To(Equal(tc.exp))
+		})
+	}
+}
+
+func TestBuildServerHeader(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		msg             string
+		g               *graph.Graph
+		expServerHeader ServerHeader
+	}{
+		{
+			msg: "no server header configured",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid:  true,
+					Source: &ngfAPI.NginxProxy{},
+				},
+			},
+			expServerHeader: ServerHeader{},
+		},
+		{
+			msg: "server header configured",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPI.NginxProxy{
+						Spec: ngfAPI.NginxProxySpec{
+							ServerHeader: &ngfAPI.ServerHeader{
+								Value:       helpers.GetPointer("my-server"),
+								HideVersion: true,
+							},
+						},
+					},
+				},
+			},
+			expServerHeader: ServerHeader{
+				Value:       helpers.GetPointer("my-server"),
+				HideVersion: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			baseConfig := buildBaseHTTPConfig(tc.g)
+			g.Expect(baseConfig.ServerHeader).To(Equal(tc.expServerHeader))
+		})
+	}
+}
+
+func TestBuildUpstreamZoneSize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		msg     string
+		g       *graph.Graph
+		expSize string
+	}{
+		{
+			msg:     "no nginxproxy",
+			g:       &graph.Graph{},
+			expSize: "",
+		},
+		{
+			msg: "invalid nginxproxy",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: false,
+					Source: &ngfAPI.NginxProxy{
+						Spec: ngfAPI.NginxProxySpec{
+							UpstreamZoneSize: helpers.GetPointer[ngfAPI.Size]("2m"),
+						},
+					},
+				},
+			},
+			expSize: "",
+		},
+		{
+			msg: "upstream zone size not configured",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid:  true,
+					Source: &ngfAPI.NginxProxy{},
+				},
+			},
+			expSize: "",
+		},
+		{
+			msg: "upstream zone size configured",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPI.NginxProxy{
+						Spec: ngfAPI.NginxProxySpec{
+							UpstreamZoneSize: helpers.GetPointer[ngfAPI.Size]("2m"),
+						},
+					},
+				},
+			},
+			expSize: "2m",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildUpstreamZoneSize(tc.g)).To(Equal(tc.expSize))
+		})
+	}
+}
+
+func TestBuildUpstreamDrainTimeout(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		g          *graph.Graph
+		msg        string
+		expTimeout string
+	}{
+		{
+			msg:        "no nginxproxy",
+			g:          &graph.Graph{},
+			expTimeout: "",
+		},
+		{
+			msg: "invalid nginxproxy",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: false,
+					Source: &ngfAPI.NginxProxy{
+						Spec: ngfAPI.NginxProxySpec{
+							UpstreamDrainTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+						},
+					},
+				},
+			},
+			expTimeout: "",
+		},
+		{
+			msg: "upstream drain timeout configured",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPI.NginxProxy{
+						Spec: ngfAPI.NginxProxySpec{
+							UpstreamDrainTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+						},
+					},
+				},
+			},
+			expTimeout: "30s",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildUpstreamDrainTimeout(tc.g)).To(Equal(tc.expTimeout))
+		})
+	}
+}
+
+func TestBuildConnectionLimits(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		np        *graph.NginxProxy
+		msg       string
+		expLimits ConnectionLimits
+	}{
+		{
+			msg:       "no nginxproxy",
+			np:        nil,
+			expLimits: ConnectionLimits{},
+		},
+		{
+			msg: "invalid nginxproxy",
+			np: &graph.NginxProxy{
+				Valid: false,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						ConnectionLimits: &ngfAPI.ConnectionLimits{
+							WorkerConnections: helpers.GetPointer[int32](4096),
+						},
+					},
+				},
+			},
+			expLimits: ConnectionLimits{},
+		},
+		{
+			msg: "connection limits not configured",
+			np: &graph.NginxProxy{
+				Valid:  true,
+				Source: &ngfAPI.NginxProxy{},
+			},
+			expLimits: ConnectionLimits{},
+		},
+		{
+			msg: "connection limits configured",
+			np: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						ConnectionLimits: &ngfAPI.ConnectionLimits{
+							WorkerConnections:           helpers.GetPointer[int32](4096),
+							DefaultServerMaxConnections: helpers.GetPointer[int32](10),
+							MaxRequestRate:              helpers.GetPointer[int32](1000),
+						},
+					},
+				},
+			},
+			expLimits: ConnectionLimits{
+				WorkerConnections:           4096,
+				DefaultServerMaxConnections: 10,
+				MaxRequestRate:              1000,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildConnectionLimits(tc.np)).To(Equal(tc.expLimits))
+		})
+	}
+}
+
+func TestBuildLogging(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		np         *graph.NginxProxy
+		gw         *graph.Gateway
+		msg        string
+		expLogging Logging
+	}{
+		{
+			msg:        "no nginxproxy",
+			np:         nil,
+			expLogging: Logging{},
+		},
+		{
+			msg: "invalid nginxproxy",
+			np: &graph.NginxProxy{
+				Valid: false,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Logging: &ngfAPI.NginxLogging{
+							StreamAccessLog: &ngfAPI.StreamAccessLog{Disable: true},
+						},
+					},
+				},
+			},
+			expLogging: Logging{},
+		},
+		{
+			msg: "logging not configured",
+			np: &graph.NginxProxy{
+				Valid:  true,
+				Source: &ngfAPI.NginxProxy{},
+			},
+			expLogging: Logging{},
+		},
+		{
+			msg: "stream access log configured",
+			np: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Logging: &ngfAPI.NginxLogging{
+							StreamAccessLog: &ngfAPI.StreamAccessLog{
+								Format:  helpers.GetPointer("$remote_addr $ngf_stream_route $session_time"),
+								Disable: true,
+							},
+						},
+					},
+				},
+			},
+			expLogging: Logging{
+				StreamAccessLog: StreamAccessLog{
+					Format:   "$remote_addr $ngf_stream_route $session_time",
+					Disabled: true,
+				},
+			},
+		},
+		{
+			msg: "error level configured",
+			np: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Logging: &ngfAPI.NginxLogging{
+							ErrorLevel: helpers.GetPointer(ngfAPI.NginxErrorLogLevelWarn),
+						},
+					},
+				},
+			},
+			gw:         &graph.Gateway{},
+			expLogging: Logging{ErrorLevel: "warn"},
+		},
+		{
+			msg: "gateway overrides error level",
+			np: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Logging: &ngfAPI.NginxLogging{
+							ErrorLevel: helpers.GetPointer(ngfAPI.NginxErrorLogLevelWarn),
+						},
+					},
+				},
+			},
+			gw:         &graph.Gateway{ErrorLogLevel: ngfAPI.NginxErrorLogLevelDebug},
+			expLogging: Logging{ErrorLevel: "debug"},
+		},
+		{
+			msg: "kubernetes access log format configured",
+			np: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Logging: &ngfAPI.NginxLogging{
+							AccessLogFormat: helpers.GetPointer(ngfAPI.NginxAccessLogFormatKubernetes),
+						},
+					},
+				},
+			},
+			gw: &graph.Gateway{
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+				},
+			},
+			expLogging: Logging{
+				KubernetesAccessLog: &KubernetesAccessLog{
+					Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+				},
+			},
+		},
+		{
+			msg: "combined access log format configured",
+			np: &graph.NginxProxy{
+				Valid: true,
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						Logging: &ngfAPI.NginxLogging{
+							AccessLogFormat: helpers.GetPointer(ngfAPI.NginxAccessLogFormatCombined),
+						},
+					},
+				},
+			},
+			gw: &graph.Gateway{
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+				},
+			},
+			expLogging: Logging{},
+		},
+		{
+			msg:        "gateway sets error level without nginxproxy",
+			gw:         &graph.Gateway{ErrorLogLevel: ngfAPI.NginxErrorLogLevelError},
+			expLogging: Logging{ErrorLevel: "error"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildLogging(tc.np, tc.gw)).To(Equal(tc.expLogging))
+		})
+	}
+}
+
+func TestBuildWAFBundles(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		bundles    map[types.NamespacedName]*graph.WAFBundle
+		expBundles map[WAFBundleID]WAFBundle
+		msg        string
+	}{
+		{
+			msg:        "no bundles",
+			bundles:    nil,
+			expBundles: map[WAFBundleID]WAFBundle{},
+		},
+		{
+			msg: "valid and invalid bundles",
+			bundles: map[types.NamespacedName]*graph.WAFBundle{
+				{Namespace: "test", Name: "valid"}: {
+					ConfigMap: types.NamespacedName{Namespace: "test", Name: "bundles"},
+					Data:      []byte("bundle"),
+				},
+				{Namespace: "test", Name: "invalid"}: {
+					ConfigMap: types.NamespacedName{Namespace: "test", Name: "missing"},
+				},
+			},
+			expBundles: map[WAFBundleID]WAFBundle{
+				"waf_bundle_test_valid": WAFBundle("bundle"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildWAFBundles(tc.bundles)).To(Equal(tc.expBundles))
+		})
+	}
+}
+
+func TestBuildSecureLinkSecrets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		secrets    map[types.NamespacedName]*graph.SecureLinkSecret
+		expSecrets map[SecureLinkSecretID]SecureLinkSecret
+		msg        string
+	}{
+		{
+			msg:        "no secrets",
+			secrets:    nil,
+			expSecrets: map[SecureLinkSecretID]SecureLinkSecret{},
+		},
+		{
+			msg: "valid and invalid secrets",
+			secrets: map[types.NamespacedName]*graph.SecureLinkSecret{
+				{Namespace: "test", Name: "valid"}: {
+					Secret: types.NamespacedName{Namespace: "test", Name: "secure-link"},
+					Data:   []byte("0123456789abcdef"),
+				},
+				{Namespace: "test", Name: "invalid"}: {
+					Secret: types.NamespacedName{Namespace: "test", Name: "missing"},
+				},
+			},
+			expSecrets: map[SecureLinkSecretID]SecureLinkSecret{
+				"secure_link_secret_test_valid": SecureLinkSecret("0123456789abcdef"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildSecureLinkSecrets(tc.secrets)).To(Equal(tc.expSecrets))
+		})
+	}
+}
+
+func TestBuildStaticFiles(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		contents map[types.NamespacedName]*graph.StaticContent
+		expFiles map[StaticFileID]StaticFile
+		msg      string
+	}{
+		{
+			msg:      "no contents",
+			contents: nil,
+			expFiles: map[StaticFileID]StaticFile{},
+		},
+		{
+			msg: "valid and invalid contents",
+			contents: map[types.NamespacedName]*graph.StaticContent{
+				{Namespace: "test", Name: "valid"}: {
+					ConfigMap: types.NamespacedName{Namespace: "test", Name: "static"},
+					Files: map[string][]byte{
+						"security.txt":     []byte("Contact: mailto:security@example.com"),
+						"maintenance.html": []byte("<h1>Maintenance</h1>"),
+					},
+				},
+				{Namespace: "test", Name: "invalid"}: {
+					ConfigMap: types.NamespacedName{Namespace: "test", Name: "missing"},
+				},
+			},
+			expFiles: map[StaticFileID]StaticFile{
+				"static_content_test_valid_security.txt":     StaticFile("Contact: mailto:security@example.com"),
+				"static_content_test_valid_maintenance.html": StaticFile("<h1>Maintenance</h1>"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildStaticFiles(tc.contents)).To(Equal(tc.expFiles))
+		})
+	}
+}
+
+func TestBuildSessionTicketKeys(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		keys    *graph.SessionTicketKeys
+		msg     string
+		expKeys []SessionTicketKey
+	}{
+		{
+			msg:     "keys are not shared",
+			keys:    nil,
+			expKeys: nil,
+		},
+		{
+			msg: "no valid keys",
+			keys: &graph.SessionTicketKeys{
+				Secret: types.NamespacedName{Namespace: "nginx-gateway", Name: "session-ticket-keys"},
+			},
+			expKeys: nil,
+		},
+		{
+			msg: "keys",
+			keys: &graph.SessionTicketKeys{
+				Secret: types.NamespacedName{Namespace: "nginx-gateway", Name: "session-ticket-keys"},
+				Keys:   [][]byte{[]byte("current"), []byte("next")},
+			},
+			expKeys: []SessionTicketKey{SessionTicketKey("current"), SessionTicketKey("next")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildSessionTicketKeys(tc.keys)).To(Equal(tc.expKeys))
+		})
+	}
+}
+
+func TestBuildAccessLogRatios(t *testing.T) {
+	t.Parallel()
+
+	createObsPolicy := func(name string, accessLog *ngfAPI.AccessLog) *graph.Policy {
+		return &graph.Policy{
+			Source: &ngfAPI.ObservabilityPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "custom-ns",
+				},
+				Spec: ngfAPI.ObservabilityPolicySpec{
+					AccessLog: accessLog,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		g         *graph.Graph
+		msg       string
+		expRatios []Ratio
+	}{
+		{
+			msg:       "no policies",
+			g:         &graph.Graph{},
+			expRatios: nil,
+		},
+		{
+			msg: "no sample ratios",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					{NsName: types.NamespacedName{Name: "obsPolicy1"}}: createObsPolicy("obsPolicy1", nil),
+					{NsName: types.NamespacedName{Name: "obsPolicy2"}}: createObsPolicy(
+						"obsPolicy2",
+						&ngfAPI.AccessLog{Disable: helpers.GetPointer(true)},
+					),
+					{NsName: types.NamespacedName{Name: "csPolicy"}}: {
+						Source: &ngfAPI.ClientSettingsPolicy{},
+					},
+				},
+			},
+			expRatios: nil,
+		},
+		{
+			msg: "multiple sample ratios",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					{NsName: types.NamespacedName{Name: "obsPolicy1"}}: createObsPolicy(
+						"obsPolicy1",
+						&ngfAPI.AccessLog{SampleRatio: helpers.GetPointer[int32](50)},
+					),
+					{NsName: types.NamespacedName{Name: "obsPolicy2"}}: createObsPolicy(
+						"obsPolicy2",
+						&ngfAPI.AccessLog{SampleRatio: helpers.GetPointer[int32](10)},
+					),
+					{NsName: types.NamespacedName{Name: "obsPolicy3"}}: createObsPolicy(
+						"obsPolicy3",
+						&ngfAPI.AccessLog{SampleRatio: helpers.GetPointer[int32](50)},
+					),
+					{NsName: types.NamespacedName{Name: "obsPolicy4"}}: createObsPolicy(
+						"obsPolicy4",
+						&ngfAPI.AccessLog{SampleRatio: helpers.GetPointer[int32](0)},
+					),
+					{NsName: types.NamespacedName{Name: "obsPolicy5"}}: createObsPolicy(
+						"obsPolicy5",
+						&ngfAPI.AccessLog{SampleRatio: helpers.GetPointer[int32](100)},
+					),
+				},
+			},
+			expRatios: []Ratio{
+				{Name: "$ngf_access_log_ratio_10", Value: 10},
+				{Name: "$ngf_access_log_ratio_50", Value: 50},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(buildAccessLogRatios(tc.g)).To(Equal(tc.expRatios))
+		})
+	}
+}
+
+func TestHasValidModSecurityPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		g      *graph.Graph
+		msg    string
+		expect bool
+	}{
+		{
+			msg:    "no policies",
+			g:      &graph.Graph{},
+			expect: false,
+		},
+		{
+			msg: "invalid ModSecurityPolicy",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					{NsName: types.NamespacedName{Name: "csPolicy"}}: {
+						Source: &ngfAPI.ClientSettingsPolicy{},
+						Valid:  true,
+					},
+					{NsName: types.NamespacedName{Name: "modsecPolicy"}}: {
+						Source: &ngfAPI.ModSecurityPolicy{},
+						Valid:  false,
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			msg: "valid ModSecurityPolicy",
+			g: &graph.Graph{
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					{NsName: types.NamespacedName{Name: "modsecPolicy1"}}: {
+						Source: &ngfAPI.ModSecurityPolicy{},
+						Valid:  false,
+					},
+					{NsName: types.NamespacedName{Name: "modsecPolicy2"}}: {
+						Source: &ngfAPI.ModSecurityPolicy{},
+						Valid:  true,
+					},
+				},
+			},
+			expect: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(hasValidModSecurityPolicy(tc.g)).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestPathRuleLess(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	rules := []PathRule{
+		{Path: "/v[0-9]+", PathType: PathTypeRegularExpression},
+		{Path: "/foo", PathType: PathTypePrefix},
+		{Path: "/api/.*", PathType: PathTypeRegularExpression},
+		{Path: "/foo", PathType: PathTypeExact},
+		{Path: "/v[0-9]+/users", PathType: PathTypeRegularExpression},
+		{Path: "/", PathType: PathTypePrefix},
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		return pathRuleLess(rules[i], rules[j])
+	})
+
+	g.Expect(rules).To(Equal([]PathRule{
+		{Path: "/", PathType: PathTypePrefix},
+		{Path: "/foo", PathType: PathTypeExact},
+		{Path: "/foo", PathType: PathTypePrefix},
+		{Path: "/v[0-9]+/users", PathType: PathTypeRegularExpression},
+		{Path: "/v[0-9]+", PathType: PathTypeRegularExpression},
+		{Path: "/api/.*", PathType: PathTypeRegularExpression},
+	}))
+}
+
+func TestHostPathRulesCaseInsensitivePaths(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	listener := &graph.Listener{
+		Source: v1.Listener{
+			Name:     "listener-80",
+			Port:     80,
+			Protocol: v1.HTTPProtocolType,
+		},
+		Valid: true,
+	}
+
+	createMatch := func(path string, pathType v1.PathMatchType) v1.HTTPRouteMatch {
+		return v1.HTTPRouteMatch{
+			Path: &v1.HTTPPathMatch{
+				Value: helpers.GetPointer(path),
+				Type:  helpers.GetPointer(pathType),
+			},
+		}
+	}
+
+	route := &graph.L7Route{
+		RouteType: graph.RouteTypeHTTP,
+		Source: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					ValidMatches: true,
+					ValidFilters: true,
+					Matches: []v1.HTTPRouteMatch{
+						createMatch("/Coffee", v1.PathMatchPathPrefix),
+						createMatch("/coffee", v1.PathMatchPathPrefix),
+						createMatch("/Tea/[A-Z]+", v1.PathMatchRegularExpression),
+					},
+				},
+			},
+		},
+		Valid: true,
+		ParentRefs: []graph.ParentRef{
+			{
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{
+						"listener-80": {"cafe.example.com"},
+					},
+				},
+			},
+		},
+	}
+
+	rules := newHostPathRules()
+	rules.caseInsensitivePaths = true
+	rules.upsertListener(listener)
+	rules.upsertRoute(route, listener)
+
+	servers := rules.buildServers()
+	g.Expect(servers).To(HaveLen(2))
+
+	server := servers[1]
+	g.Expect(server.Hostname).To(Equal("cafe.example.com"))
+	g.Expect(server.CaseInsensitivePaths).To(BeTrue())
+	g.Expect(server.PathRules).To(HaveLen(2))
+
+	// the prefix paths that only differ in case are merged into a single lowercase path rule
+	g.Expect(server.PathRules[0].Path).To(Equal("/coffee"))
+	g.Expect(server.PathRules[0].PathType).To(Equal(PathTypePrefix))
+	g.Expect(server.PathRules[0].CaseInsensitive).To(BeTrue())
+	g.Expect(server.PathRules[0].MatchRules).To(HaveLen(2))
+
+	// the regular expression path is kept as is, because NGINX matches it case-insensitively
+	g.Expect(server.PathRules[1].Path).To(Equal("/Tea/[A-Z]+"))
+	g.Expect(server.PathRules[1].PathType).To(Equal(PathTypeRegularExpression))
+	g.Expect(server.PathRules[1].CaseInsensitive).To(BeTrue())
+}
+
+func TestHostPathRulesACMEChallengePassthrough(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	listener := &graph.Listener{
+		Source: v1.Listener{
+			Name:     "listener-80",
+			Port:     80,
+			Protocol: v1.HTTPProtocolType,
+		},
+		Valid: true,
+	}
+
+	createMatch := func(path string) v1.HTTPRouteMatch {
+		return v1.HTTPRouteMatch{
+			Path: &v1.HTTPPathMatch{
+				Value: helpers.GetPointer(path),
+				Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+			},
+		}
+	}
+
+	pol := &ngfAPI.ClientSettingsPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "csp"}}
+
+	route := &graph.L7Route{
+		RouteType: graph.RouteTypeHTTP,
+		Source: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+		},
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					ValidMatches: true,
+					ValidFilters: true,
+					Matches: []v1.HTTPRouteMatch{
+						createMatch("/coffee"),
+						createMatch("/.well-known/acme-challenge/"),
+					},
+				},
+			},
+		},
+		Valid: true,
+		ParentRefs: []graph.ParentRef{
+			{
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{
+						"listener-80": {"cafe.example.com"},
+					},
+				},
+			},
+		},
+		EffectivePolicies: map[string][]policies.Policy{
+			"listener-80": {pol},
+		},
+	}
+
+	tests := []struct {
+		msg         string
+		passthrough bool
+	}{
+		{
+			msg:         "passthrough disabled",
+			passthrough: false,
+		},
+		{
+			msg:         "passthrough enabled",
+			passthrough: true,
+		},
+	}
+
+	for _, tc := range tests {
+		rules := newHostPathRules()
+		rules.acmeChallengePassthrough = tc.passthrough
+		rules.upsertListener(listener)
+		rules.upsertRoute(route, listener)
+
+		servers := rules.buildServers()
+		g.Expect(servers).To(HaveLen(2), tc.msg)
+
+		server := servers[1]
+		g.Expect(server.PathRules).To(HaveLen(2), tc.msg)
+
+		g.Expect(server.PathRules[0].Path).To(Equal("/.well-known/acme-challenge/"), tc.msg)
+		g.Expect(server.PathRules[0].ACMEChallenge).To(Equal(tc.passthrough), tc.msg)
+		if tc.passthrough {
+			g.Expect(server.PathRules[0].Policies).To(BeEmpty(), tc.msg)
+		} else {
+			g.Expect(server.PathRules[0].Policies).To(ConsistOf(pol), tc.msg)
+		}
+
+		g.Expect(server.PathRules[1].Path).To(Equal("/coffee"), tc.msg)
+		g.Expect(server.PathRules[1].ACMEChallenge).To(BeFalse(), tc.msg)
+		g.Expect(server.PathRules[1].Policies).To(ConsistOf(pol), tc.msg)
+	}
+}
+
+func TestBuildConfigurationIsStable(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fakeResolver := &resolverfakes.FakeServiceResolver{}
+	fakeResolver.ResolveReturns([]resolver.Endpoint{{Address: "10.0.0.1", Port: 8080}}, nil)
+
+	createRoute := func(name, listenerName string) *graph.L7Route {
+		return &graph.L7Route{
+			RouteType: graph.RouteTypeHTTP,
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			},
+			Spec: graph.L7RouteSpec{
+				Rules: []graph.RouteRule{
+					{
+						ValidMatches: true,
+						ValidFilters: true,
+						Matches: []v1.HTTPRouteMatch{
+							{
+								Path: &v1.HTTPPathMatch{
+									Value: helpers.GetPointer("/"),
+									Type:  helpers.GetPointer(v1.PathMatchPathPrefix),
+								},
+							},
+						},
+						BackendRefs: []graph.BackendRef{
+							{
+								SvcNsName:   types.NamespacedName{Namespace: "test", Name: name},
+								ServicePort: apiv1.ServicePort{Port: 80},
+								Valid:       true,
+								Weight:      1,
+							},
+						},
+					},
+				},
+			},
+			Valid: true,
+			ParentRefs: []graph.ParentRef{
+				{
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{
+							listenerName: {"cafe.example.com"},
+						},
+					},
+				},
+			},
+			EffectivePolicies: map[string][]policies.Policy{
+				listenerName: {
+					&ngfAPI.ClientSettingsPolicy{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+					},
+				},
+			},
+		}
+	}
+
+	listeners := make([]*graph.Listener, 0, 5)
+
+	for i := range 5 {
+		name := fmt.Sprintf("listener-%d", i)
+
+		routes := make(map[graph.RouteKey]*graph.L7Route)
+		for j := range 5 {
+			route := createRoute(fmt.Sprintf("route-%d", j), name)
+			routes[graph.CreateRouteKey(route.Source)] = route
+		}
+
+		listeners = append(listeners, &graph.Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Port:     v1.PortNumber(8080 + i),
+				Protocol: v1.HTTPProtocolType,
+			},
+			Valid:  true,
+			Routes: routes,
+		})
+	}
+
+	gr := &graph.Graph{
+		GatewayClass: &graph.GatewayClass{Valid: true},
+		Gateway:      &graph.Gateway{Listeners: listeners},
+	}
+
+	expected := BuildConfiguration(context.Background(), gr, fakeResolver, 1)
+
+	// the maps of the graph are iterated in a random order, so the configuration is built several times
+	for range 20 {
+		g.Expect(BuildConfiguration(context.Background(), gr, fakeResolver, 1)).To(Equal(expected))
+	}
+}
@@ -2,8 +2,21 @@ package dataplane
 
 import (
 	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode"
 
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	defaultRateLimitKey        = "$binary_remote_addr"
+	defaultRateLimitZoneSize   = "10m"
+	defaultRateLimitRejectCode = 503
 )
 
 func convertMatch(m v1.HTTPRouteMatch) Match {
@@ -42,6 +55,7 @@ func convertHTTPRequestRedirectFilter(filter *v1.HTTPRequestRedirectFilter) *HTT
 		Scheme:     filter.Scheme,
 		Hostname:   (*string)(filter.Hostname),
 		Port:       (*int32)(filter.Port),
+		Path:       convertPathModifier(filter.Path),
 		StatusCode: filter.StatusCode,
 	}
 }
@@ -75,12 +89,70 @@ func convertHTTPHeaderFilter(filter *v1.HTTPHeaderFilter) *HTTPHeaderFilter {
 	return result
 }
 
+func convertSnippetsFilter(filter *graph.SnippetsFilter) SnippetsFilter {
+	createSnippet := func(nginxContext ngfAPI.NginxContext, name string) *Snippet {
+		contents, exists := filter.Snippets[nginxContext]
+		if !exists {
+			return nil
+		}
+
+		return &Snippet{
+			Name:     fmt.Sprintf("SnippetsFilter_%s_%s_%s", name, filter.Source.Namespace, filter.Source.Name),
+			Contents: contents,
+		}
+	}
+
+	return SnippetsFilter{
+		HTTPSnippet:     createSnippet(ngfAPI.NginxContextHTTP, "http"),
+		ServerSnippet:   createSnippet(ngfAPI.NginxContextHTTPServer, "server"),
+		LocationSnippet: createSnippet(ngfAPI.NginxContextHTTPServerLocation, "location"),
+	}
+}
+
+func convertRateLimitFilter(filter *graph.RateLimitFilter) *RateLimit {
+	spec := filter.Source.Spec
+
+	result := &RateLimit{
+		Zone: RateLimitZone{
+			Name: fmt.Sprintf("ratelimit_%s_%s", filter.Source.Namespace, filter.Source.Name),
+			Key:  defaultRateLimitKey,
+			Size: defaultRateLimitZoneSize,
+			Rate: spec.Rate,
+		},
+		RejectCode: defaultRateLimitRejectCode,
+	}
+
+	if spec.Key != nil {
+		result.Zone.Key = *spec.Key
+	}
+
+	if spec.ZoneSize != nil {
+		result.Zone.Size = string(*spec.ZoneSize)
+	}
+
+	if spec.Burst != nil {
+		result.Burst = *spec.Burst
+	}
+
+	if spec.NoDelay != nil {
+		result.NoDelay = *spec.NoDelay
+	}
+
+	if spec.RejectCode != nil {
+		result.RejectCode = int(*spec.RejectCode)
+	}
+
+	return result
+}
+
 func convertPathType(pathType v1.PathMatchType) PathType {
 	switch pathType {
 	case v1.PathMatchPathPrefix:
 		return PathTypePrefix
 	case v1.PathMatchExact:
 		return PathTypeExact
+	case v1.PathMatchRegularExpression:
+		return PathTypeRegularExpression
 	default:
 		panic(fmt.Sprintf("unsupported path type: %s", pathType))
 	}
@@ -104,3 +176,143 @@ func convertPathModifier(path *v1.HTTPPathModifier) *HTTPPathModifier {
 
 	return nil
 }
+
+// responseHeaderIDReplacer replaces the characters of the namespace and the name of a ResponseHeaderFilter that
+// are not allowed in an NGINX variable name. Because Kubernetes names don't contain '_', the replacement
+// can't make the IDs of two filters equal.
+var responseHeaderIDReplacer = strings.NewReplacer("-", "_h", ".", "_d")
+
+func convertResponseHeaderFilter(filter *graph.ResponseHeaderFilter) []ConditionalResponseHeader {
+	headers := make([]ConditionalResponseHeader, 0, len(filter.Source.Spec.Headers))
+
+	for i, h := range filter.Source.Spec.Headers {
+		header := ConditionalResponseHeader{
+			ID: fmt.Sprintf(
+				"%s_%s_%d",
+				responseHeaderIDReplacer.Replace(filter.Source.Namespace),
+				responseHeaderIDReplacer.Replace(filter.Source.Name),
+				i,
+			),
+			Name:  string(h.Name),
+			Value: h.Value,
+		}
+
+		for _, code := range h.When.StatusCodes {
+			header.StatusCodes = append(header.StatusCodes, int(code))
+		}
+
+		if h.When.Header != nil {
+			header.HeaderMatch = &ResponseHeaderMatch{
+				Name:   string(h.When.Header.Name),
+				Absent: h.When.Header.Type == ngfAPI.ResponseHeaderMatchAbsent,
+			}
+
+			if h.When.Header.Value != nil {
+				header.HeaderMatch.Value = *h.When.Header.Value
+			}
+		}
+
+		headers = append(headers, header)
+	}
+
+	return headers
+}
+
+func convertQueryParameterFilter(filter *graph.QueryParameterFilter) QueryParameterModifier {
+	spec := filter.Source.Spec
+
+	var modifier QueryParameterModifier
+
+	for _, p := range spec.Set {
+		modifier.Set = append(modifier.Set, QueryParameter{Name: string(p.Name), Value: p.Value})
+	}
+
+	for _, p := range spec.Add {
+		modifier.Add = append(modifier.Add, QueryParameter{Name: string(p.Name), Value: p.Value})
+	}
+
+	for _, name := range spec.Remove {
+		modifier.Remove = append(modifier.Remove, string(name))
+	}
+
+	return modifier
+}
+
+// defaultABTestCookiePath is the path of the cookie of an ABTestFilter that doesn't set the path.
+const defaultABTestCookiePath = "/"
+
+// convertABTestFilter converts the ABTestFilter that a rule references, if any. The variants are the valid
+// backendRefs of the rule, including the backendRefs with the weight 0, so that the override header can select
+// a variant before it gets any traffic.
+func convertABTestFilter(filters []graph.ExtensionRefFilter, refs []graph.BackendRef) *ABTest {
+	var filter *graph.ABTestFilter
+
+	for _, f := range filters {
+		if f.ABTestFilter != nil {
+			filter = f.ABTestFilter
+			break
+		}
+	}
+
+	if filter == nil {
+		return nil
+	}
+
+	spec := filter.Source.Spec
+
+	abTest := &ABTest{
+		CookieName: spec.Cookie.Name,
+		CookiePath: defaultABTestCookiePath,
+	}
+
+	if spec.Cookie.Path != nil {
+		abTest.CookiePath = *spec.Cookie.Path
+	}
+
+	if spec.Cookie.MaxAge != nil {
+		abTest.CookieMaxAge = convertDurationToSeconds(*spec.Cookie.MaxAge)
+	}
+
+	if spec.OverrideHeader == nil {
+		return abTest
+	}
+
+	abTest.OverrideHeader = string(*spec.OverrideHeader)
+
+	seen := make(map[string]struct{}, len(refs))
+
+	for _, ref := range refs {
+		if !ref.Valid {
+			continue
+		}
+
+		// the first backendRef of a Service wins if the rule references several ports of the Service
+		if _, exists := seen[ref.SvcNsName.Name]; exists {
+			continue
+		}
+		seen[ref.SvcNsName.Name] = struct{}{}
+
+		abTest.Variants = append(abTest.Variants, ABTestVariant{
+			ServiceName:  ref.SvcNsName.Name,
+			UpstreamName: ref.ServicePortReference(),
+		})
+	}
+
+	return abTest
+}
+
+// convertDurationToSeconds converts a validated Duration to seconds, rounded up. A Duration without a unit
+// is in seconds.
+func convertDurationToSeconds(d ngfAPI.Duration) int64 {
+	value := string(d)
+	if value != "" && unicode.IsDigit(rune(value[len(value)-1])) {
+		value += "s"
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0
+	}
+
+	return int64(math.Ceil(duration.Seconds()))
+}
@@ -4,9 +4,14 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
 func TestConvertMatch(t *testing.T) {
@@ -139,15 +144,23 @@ func TestConvertHTTPRequestRedirectFilter(t *testing.T) {
 		},
 		{
 			filter: &v1.HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("https"),
-				Hostname:   helpers.GetPointer[v1.PreciseHostname]("example.com"),
-				Port:       helpers.GetPointer[v1.PortNumber](8443),
+				Scheme:   helpers.GetPointer("https"),
+				Hostname: helpers.GetPointer[v1.PreciseHostname]("example.com"),
+				Port:     helpers.GetPointer[v1.PortNumber](8443),
+				Path: &v1.HTTPPathModifier{
+					Type:            v1.FullPathHTTPPathModifier,
+					ReplaceFullPath: helpers.GetPointer("/full"),
+				},
 				StatusCode: helpers.GetPointer(302),
 			},
 			expected: &HTTPRequestRedirectFilter{
-				Scheme:     helpers.GetPointer("https"),
-				Hostname:   helpers.GetPointer("example.com"),
-				Port:       helpers.GetPointer[int32](8443),
+				Scheme:   helpers.GetPointer("https"),
+				Hostname: helpers.GetPointer("example.com"),
+				Port:     helpers.GetPointer[int32](8443),
+				Path: &HTTPPathModifier{
+					Type:        ReplaceFullPath,
+					Replacement: "/full",
+				},
 				StatusCode: helpers.GetPointer(302),
 			},
 			name: "full",
@@ -291,7 +304,11 @@ func TestConvertPathType(t *testing.T) {
 			pathType: v1.PathMatchExact,
 		},
 		{
+			expected: PathTypeRegularExpression,
 			pathType: v1.PathMatchRegularExpression,
+		},
+		{
+			pathType: v1.PathMatchType("Unknown"),
 			panic:    true,
 		},
 	}
@@ -309,3 +326,300 @@ func TestConvertPathType(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertSnippetsFilter(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	filter := &graph.SnippetsFilter{
+		Source: &ngfAPI.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "snippets"},
+		},
+		Snippets: map[ngfAPI.NginxContext]string{
+			ngfAPI.NginxContextHTTP:               "log_format custom '$request';",
+			ngfAPI.NginxContextHTTPServerLocation: "add_header X-Location on;",
+		},
+		Valid: true,
+	}
+
+	g.Expect(convertSnippetsFilter(filter)).To(Equal(SnippetsFilter{
+		HTTPSnippet: &Snippet{
+			Name:     "SnippetsFilter_http_test_snippets",
+			Contents: "log_format custom '$request';",
+		},
+		LocationSnippet: &Snippet{
+			Name:     "SnippetsFilter_location_test_snippets",
+			Contents: "add_header X-Location on;",
+		},
+	}))
+}
+
+func TestConvertRateLimitFilter(t *testing.T) {
+	t.Parallel()
+
+	createFilter := func(spec ngfAPI.RateLimitFilterSpec) *graph.RateLimitFilter {
+		return &graph.RateLimitFilter{
+			Source: &ngfAPI.RateLimitFilter{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "rate-limit"},
+				Spec:       spec,
+			},
+			Valid: true,
+		}
+	}
+
+	tests := []struct {
+		filter   *graph.RateLimitFilter
+		expected *RateLimit
+		name     string
+	}{
+		{
+			name:   "defaults",
+			filter: createFilter(ngfAPI.RateLimitFilterSpec{Rate: "10r/s"}),
+			expected: &RateLimit{
+				Zone: RateLimitZone{
+					Name: "ratelimit_test_rate-limit",
+					Key:  "$binary_remote_addr",
+					Size: "10m",
+					Rate: "10r/s",
+				},
+				RejectCode: 503,
+			},
+		},
+		{
+			name: "all fields",
+			filter: createFilter(ngfAPI.RateLimitFilterSpec{
+				Key:        helpers.GetPointer("$http_x_api_key"),
+				ZoneSize:   helpers.GetPointer[ngfAPI.Size]("1m"),
+				Burst:      helpers.GetPointer[int32](5),
+				NoDelay:    helpers.GetPointer(true),
+				RejectCode: helpers.GetPointer[int32](429),
+				Rate:       "30r/m",
+			}),
+			expected: &RateLimit{
+				Zone: RateLimitZone{
+					Name: "ratelimit_test_rate-limit",
+					Key:  "$http_x_api_key",
+					Size: "1m",
+					Rate: "30r/m",
+				},
+				RejectCode: 429,
+				Burst:      5,
+				NoDelay:    true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertRateLimitFilter(test.filter)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertResponseHeaderFilter(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	filter := &graph.ResponseHeaderFilter{
+		Source: &ngfAPI.ResponseHeaderFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "response.headers"},
+			Spec: ngfAPI.ResponseHeaderFilterSpec{
+				Headers: []ngfAPI.ConditionalResponseHeader{
+					{
+						Name:  "Cache-Control",
+						Value: "max-age=60",
+						When:  ngfAPI.ResponseCondition{StatusCodes: []ngfAPI.StatusCode{200, 204}},
+					},
+					{
+						Name:  "X-Cache-Default",
+						Value: "true",
+						When: ngfAPI.ResponseCondition{
+							Header: &ngfAPI.ResponseHeaderMatch{
+								Name: "Cache-Control",
+								Type: ngfAPI.ResponseHeaderMatchAbsent,
+							},
+						},
+					},
+					{
+						Name:  "X-Json",
+						Value: "true",
+						When: ngfAPI.ResponseCondition{
+							StatusCodes: []ngfAPI.StatusCode{200},
+							Header: &ngfAPI.ResponseHeaderMatch{
+								Name:  "Content-Type",
+								Type:  ngfAPI.ResponseHeaderMatchExact,
+								Value: helpers.GetPointer("application/json"),
+							},
+						},
+					},
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	g.Expect(convertResponseHeaderFilter(filter)).To(Equal([]ConditionalResponseHeader{
+		{
+			ID:          "test_hns_response_dheaders_0",
+			Name:        "Cache-Control",
+			Value:       "max-age=60",
+			StatusCodes: []int{200, 204},
+		},
+		{
+			ID:    "test_hns_response_dheaders_1",
+			Name:  "X-Cache-Default",
+			Value: "true",
+			HeaderMatch: &ResponseHeaderMatch{
+				Name:   "Cache-Control",
+				Absent: true,
+			},
+		},
+		{
+			ID:          "test_hns_response_dheaders_2",
+			Name:        "X-Json",
+			Value:       "true",
+			StatusCodes: []int{200},
+			HeaderMatch: &ResponseHeaderMatch{
+				Name:  "Content-Type",
+				Value: "application/json",
+			},
+		},
+	}))
+}
+
+func TestConvertQueryParameterFilter(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	filter := &graph.QueryParameterFilter{
+		Source: &ngfAPI.QueryParameterFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "query-parameters"},
+			Spec: ngfAPI.QueryParameterFilterSpec{
+				Set:    []ngfAPI.QueryParameter{{Name: "version", Value: "v2"}},
+				Add:    []ngfAPI.QueryParameter{{Name: "tag", Value: "a"}, {Name: "debug", Value: ""}},
+				Remove: []ngfAPI.QueryParameterName{"utm_source"},
+			},
+		},
+		Valid: true,
+	}
+
+	g.Expect(convertQueryParameterFilter(filter)).To(Equal(QueryParameterModifier{
+		Set:    []QueryParameter{{Name: "version", Value: "v2"}},
+		Add:    []QueryParameter{{Name: "tag", Value: "a"}, {Name: "debug", Value: ""}},
+		Remove: []string{"utm_source"},
+	}))
+}
+
+func TestConvertABTestFilter(t *testing.T) {
+	t.Parallel()
+
+	createFilters := func(spec ngfAPI.ABTestFilterSpec) []graph.ExtensionRefFilter {
+		return []graph.ExtensionRefFilter{
+			{RateLimitFilter: &graph.RateLimitFilter{Valid: true}},
+			{
+				ABTestFilter: &graph.ABTestFilter{
+					Source: &ngfAPI.ABTestFilter{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "ab-test"},
+						Spec:       spec,
+					},
+					Valid: true,
+				},
+			},
+		}
+	}
+
+	createRef := func(name string, port int32, valid bool) graph.BackendRef {
+		return graph.BackendRef{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: name},
+			ServicePort: apiv1.ServicePort{Port: port},
+			Valid:       valid,
+			Weight:      1,
+		}
+	}
+
+	refs := []graph.BackendRef{
+		createRef("coffee-v1", 80, true),
+		createRef("coffee-v2", 80, true),
+		createRef("coffee-v2", 8080, true),
+		createRef("coffee-v3", 80, false),
+	}
+
+	tests := []struct {
+		expected *ABTest
+		name     string
+		filters  []graph.ExtensionRefFilter
+	}{
+		{
+			name:    "no ab test filter",
+			filters: []graph.ExtensionRefFilter{{RateLimitFilter: &graph.RateLimitFilter{Valid: true}}},
+		},
+		{
+			name: "defaults",
+			filters: createFilters(ngfAPI.ABTestFilterSpec{
+				Cookie: ngfAPI.ABTestCookie{Name: "variant"},
+			}),
+			expected: &ABTest{
+				CookieName: "variant",
+				CookiePath: "/",
+			},
+		},
+		{
+			name: "all fields",
+			filters: createFilters(ngfAPI.ABTestFilterSpec{
+				OverrideHeader: helpers.GetPointer[v1.HTTPHeaderName]("X-Variant"),
+				Cookie: ngfAPI.ABTestCookie{
+					Name:   "variant",
+					Path:   helpers.GetPointer("/coffee"),
+					MaxAge: helpers.GetPointer[ngfAPI.Duration]("1h"),
+				},
+			}),
+			expected: &ABTest{
+				CookieName:     "variant",
+				CookiePath:     "/coffee",
+				CookieMaxAge:   3600,
+				OverrideHeader: "X-Variant",
+				Variants: []ABTestVariant{
+					{ServiceName: "coffee-v1", UpstreamName: "test_coffee-v1_80"},
+					{ServiceName: "coffee-v2", UpstreamName: "test_coffee-v2_80"},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertABTestFilter(test.filters, refs)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertDurationToSeconds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		duration ngfAPI.Duration
+		expected int64
+	}{
+		{duration: "30", expected: 30},
+		{duration: "30s", expected: 30},
+		{duration: "1500ms", expected: 2},
+		{duration: "10m", expected: 600},
+		{duration: "24h", expected: 86400},
+		{duration: "invalid", expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.duration), func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertDurationToSeconds(test.duration)).To(Equal(test.expected))
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"sort"
 
 	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
 )
 
 func sortMatchRules(matchRules []MatchRule) {
@@ -79,3 +80,35 @@ func higherPriority(rule1, rule2 MatchRule) bool {
 	// If still tied, compare the object meta of the two routes.
 	return ngfsort.LessObjectMeta(rule1.Source, rule2.Source)
 }
+
+// lessRouteKey returns true if key1 comes before key2, ordering by route type and then by namespaced name.
+func lessRouteKey(key1, key2 graph.RouteKey) bool {
+	if key1.RouteType != key2.RouteType {
+		return key1.RouteType < key2.RouteType
+	}
+
+	return key1.NamespacedName.String() < key2.NamespacedName.String()
+}
+
+// lessBackendGroup returns true if group1 comes before group2, ordering by source and then by rule index.
+func lessBackendGroup(group1, group2 BackendGroup) bool {
+	if group1.Source != group2.Source {
+		return group1.Source.String() < group2.Source.String()
+	}
+
+	return group1.RuleIdx < group2.RuleIdx
+}
+
+// lessLayer4VirtualServer returns true if server1 comes before server2, ordering by port, hostname,
+// and then by upstream name.
+func lessLayer4VirtualServer(server1, server2 Layer4VirtualServer) bool {
+	if server1.Port != server2.Port {
+		return server1.Port < server2.Port
+	}
+
+	if server1.Hostname != server2.Hostname {
+		return server1.Hostname < server2.Hostname
+	}
+
+	return server1.UpstreamName < server2.UpstreamName
+}
@@ -18,6 +18,8 @@ const (
 	PathTypePrefix PathType = "prefix"
 	// PathTypeExact indicates that the path is exact.
 	PathTypeExact PathType = "exact"
+	// PathTypeRegularExpression indicates that the path is a regular expression.
+	PathTypeRegularExpression PathType = "regularExpression"
 )
 
 // Configuration is an intermediate representation of dataplane configuration.
@@ -26,6 +28,15 @@ type Configuration struct {
 	SSLKeyPairs map[SSLKeyPairID]SSLKeyPair
 	// CertBundles holds all unique Certificate Bundles.
 	CertBundles map[CertBundleID]CertBundle
+	// WAFBundles holds the NGINX App Protect policy bundles of the valid WAFPolicies.
+	WAFBundles map[WAFBundleID]WAFBundle
+	// SecureLinkSecrets holds the secrets of the valid SecureLinkPolicies.
+	SecureLinkSecrets map[SecureLinkSecretID]SecureLinkSecret
+	// StaticFiles holds the files of the valid StaticContentPolicies.
+	StaticFiles map[StaticFileID]StaticFile
+	// SessionTicketKeys holds the TLS session ticket keys that are shared by the NGINX of all replicas. The first
+	// key encrypts the tickets. If empty, NGINX generates its own keys.
+	SessionTicketKeys []SessionTicketKey
 	// HTTPServers holds all HTTPServers.
 	HTTPServers []VirtualServer
 	// SSLServers holds all SSLServers.
@@ -38,10 +49,23 @@ type Configuration struct {
 	StreamUpstreams []Upstream
 	// BackendGroups holds all unique BackendGroups.
 	BackendGroups []BackendGroup
+	// UpstreamZoneSize overrides the calculated zone size of all upstreams. If empty, the zone size
+	// is calculated from the number of endpoints of each upstream.
+	UpstreamZoneSize string
+	// UpstreamDrainTimeout is the duration for which an upstream that is no longer referenced stays
+	// in the configuration, so that the requests in flight to its backend complete. If empty, the upstream
+	// is removed immediately.
+	UpstreamDrainTimeout string
 	// Telemetry holds the Otel configuration.
 	Telemetry Telemetry
 	// BaseHTTPConfig holds the configuration options at the http context.
 	BaseHTTPConfig BaseHTTPConfig
+	// ConnectionLimits holds the limits on the connections and requests that NGINX accepts.
+	ConnectionLimits ConnectionLimits
+	// Logging holds the configuration of the logs of NGINX.
+	Logging Logging
+	// ModSecurity is true if at least one ModSecurityPolicy is valid, so NGINX must load the ModSecurity module.
+	ModSecurity bool
 	// Version represents the version of the generated configuration.
 	Version int
 }
@@ -57,6 +81,30 @@ type CertBundleID string
 // CertBundle is a Certificate bundle.
 type CertBundle []byte
 
+// WAFBundleID is a unique identifier for an NGINX App Protect policy bundle.
+// The ID is safe to use as a file name.
+type WAFBundleID string
+
+// WAFBundle is a compiled NGINX App Protect policy bundle.
+type WAFBundle []byte
+
+// SecureLinkSecretID is a unique identifier for the secret of a SecureLinkPolicy.
+// The ID is safe to use as a file name.
+type SecureLinkSecretID string
+
+// SecureLinkSecret is the secret that the URLs of a SecureLinkPolicy are signed with.
+type SecureLinkSecret []byte
+
+// StaticFileID is a unique identifier for a file of a StaticContentPolicy.
+// The ID is safe to use as a file name.
+type StaticFileID string
+
+// StaticFile is the content of a file of a StaticContentPolicy.
+type StaticFile []byte
+
+// SessionTicketKey is a key that NGINX encrypts and decrypts the TLS session tickets with.
+type SessionTicketKey []byte
+
 // SSLKeyPair is an SSL private/public key pair.
 type SSLKeyPair struct {
 	// Cert is the certificate.
@@ -67,8 +115,16 @@ type SSLKeyPair struct {
 
 // VirtualServer is a virtual server.
 type VirtualServer struct {
-	// SSL holds the SSL configuration for the server.
+	// SSL holds the SSL configuration for the server. For the default SSL server, it holds the default
+	// certificate of the Gateway, and is nil if the Gateway doesn't have one.
 	SSL *SSL
+	// DefaultResponse is the response returned by the default server for unmatched requests.
+	// If nil, the default server returns a 404. Only set if IsDefault is true.
+	DefaultResponse *DefaultServerResponse
+	// HTTPSRedirect is the redirect to HTTPS returned by the server for the requests that none of its PathRules
+	// match. Only set for the servers generated to redirect HTTP requests to the hostnames of HTTPS listeners,
+	// which only have the PathRules of the ACME HTTP-01 challenges, if any.
+	HTTPSRedirect *HTTPSRedirect
 	// Hostname is the hostname of the server.
 	Hostname string
 	// PathRules is a collection of routing rules.
@@ -79,6 +135,27 @@ type VirtualServer struct {
 	Port int32
 	// IsDefault indicates whether the server is the default server.
 	IsDefault bool
+	// CaseInsensitivePaths indicates whether the server matches the request path against the PathRules,
+	// which are all case-insensitive, ignoring the case of the letters.
+	CaseInsensitivePaths bool
+}
+
+// DefaultServerResponse is the response returned by a default server for unmatched requests.
+type DefaultServerResponse struct {
+	// Body is the body of the response. For a redirect, it is the URL to redirect to.
+	Body string
+	// ContentType is the Content-Type of the response.
+	ContentType string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+}
+
+// HTTPSRedirect is a redirect of HTTP requests to HTTPS.
+type HTTPSRedirect struct {
+	// Port is the port of the HTTPS server to redirect to.
+	Port int32
+	// StatusCode is the HTTP status code of the redirect.
+	StatusCode int
 }
 
 // Layer4VirtualServer is a virtual server for Layer 4 traffic.
@@ -87,6 +164,8 @@ type Layer4VirtualServer struct {
 	Hostname string
 	// UpstreamName refers to the name of the upstream that is used.
 	UpstreamName string
+	// Route is the Route of the server. It is empty for the servers of the listeners without Routes.
+	Route types.NamespacedName
 	// Port is the port of the server.
 	Port int32
 	// IsDefault refers to whether this server is created for the default listener hostname.
@@ -97,10 +176,15 @@ type Layer4VirtualServer struct {
 type Upstream struct {
 	// Name is the name of the Upstream. Will be unique for each service/port combination.
 	Name string
+	// HashedFrom is the Service and the port of the Upstream in the <namespace>/<name>:<port> format. It is only
+	// set if the Name is hashed, because the Name would be too long or contain unusual characters otherwise.
+	HashedFrom string
 	// ErrorMsg contains the error message if the Upstream is invalid.
 	ErrorMsg string
 	// Endpoints are the endpoints of the Upstream.
 	Endpoints []resolver.Endpoint
+	// Policies holds all the valid NGF Policies that target the Service of the Upstream.
+	Policies []policies.Policy
 }
 
 // SSL is the SSL configuration for a server.
@@ -121,6 +205,12 @@ type PathRule struct {
 	Policies []policies.Policy
 	// GRPC indicates if this is a gRPC rule
 	GRPC bool
+	// CaseInsensitive indicates if the path is matched case-insensitively.
+	// The Path of an Exact or a Prefix rule is lowercase, and the request path is lowercased before it is matched.
+	CaseInsensitive bool
+	// ACMEChallenge indicates if the rule matches the path of the ACME HTTP-01 challenges, and takes precedence
+	// over the other rules without any policies. Only set if ACME challenge passthrough is enabled.
+	ACMEChallenge bool
 }
 
 // InvalidHTTPFilter is a special filter for handling the case when configured filters are invalid.
@@ -139,6 +229,58 @@ type HTTPFilters struct {
 	RequestHeaderModifiers *HTTPHeaderFilter
 	// ResponseHeaderModifiers holds the HTTPHeaderFilter.
 	ResponseHeaderModifiers *HTTPHeaderFilter
+	// RateLimit holds the rate limit of the RateLimitFilter that the rule references.
+	RateLimit *RateLimit
+	// SnippetsFilters hold the SnippetsFilters that the rule references, in the order of the filters.
+	SnippetsFilters []SnippetsFilter
+	// ConditionalResponseHeaders hold the headers of the ResponseHeaderFilters that the rule references,
+	// in the order of the filters.
+	ConditionalResponseHeaders []ConditionalResponseHeader
+	// QueryParameterModifiers hold the modifications of the QueryParameterFilters that the rule references,
+	// in the order of the filters.
+	QueryParameterModifiers []QueryParameterModifier
+}
+
+// SnippetsFilter holds the NGINX configuration snippets of a SnippetsFilter.
+type SnippetsFilter struct {
+	// HTTPSnippet is the snippet for the http context.
+	HTTPSnippet *Snippet
+	// ServerSnippet is the snippet for the server context.
+	ServerSnippet *Snippet
+	// LocationSnippet is the snippet for the location context.
+	LocationSnippet *Snippet
+}
+
+// Snippet is an NGINX configuration snippet.
+type Snippet struct {
+	// Name is the unique name of the snippet. The name is safe to use as a file name.
+	Name string
+	// Contents is the NGINX configuration of the snippet.
+	Contents string
+}
+
+// RateLimit limits the rate of the requests.
+type RateLimit struct {
+	// Zone is the shared memory zone that stores the states of the keys.
+	Zone RateLimitZone
+	// RejectCode is the status code of the response to the rejected requests.
+	RejectCode int
+	// Burst is the number of requests above the rate that are delayed or served without a delay.
+	Burst int32
+	// NoDelay serves the requests of the burst without a delay.
+	NoDelay bool
+}
+
+// RateLimitZone is a shared memory zone of a rate limit.
+type RateLimitZone struct {
+	// Name is the unique name of the zone.
+	Name string
+	// Key is the key that the requests are limited by.
+	Key string
+	// Size is the size of the zone.
+	Size string
+	// Rate is the maximum rate of the requests.
+	Rate string
 }
 
 // HTTPHeader represents an HTTP header.
@@ -159,6 +301,25 @@ type HTTPHeaderFilter struct {
 	Remove []string
 }
 
+// QueryParameter represents a query parameter of a request.
+type QueryParameter struct {
+	// Name is the name of the query parameter.
+	Name string
+	// Value is the value of the query parameter.
+	Value string
+}
+
+// QueryParameterModifier modifies the query parameters of a request.
+// The modifications are applied in the following order: Remove, Set, Add.
+type QueryParameterModifier struct {
+	// Set replaces query parameters or adds them if the request doesn't have them.
+	Set []QueryParameter
+	// Add adds query parameters. It keeps any existing parameters with the same name.
+	Add []QueryParameter
+	// Remove removes query parameters.
+	Remove []string
+}
+
 // HTTPRequestRedirectFilter redirects HTTP requests.
 type HTTPRequestRedirectFilter struct {
 	// Scheme is the scheme of the redirect.
@@ -167,6 +328,8 @@ type HTTPRequestRedirectFilter struct {
 	Hostname *string
 	// Port is the port of the redirect.
 	Port *int32
+	// Path is the path of the redirect.
+	Path *HTTPPathModifier
 	// StatusCode is the HTTP status code of the redirect.
 	StatusCode *int
 }
@@ -223,6 +386,8 @@ type MatchRule struct {
 	Filters HTTPFilters
 	// Source is the ObjectMeta of the resource that includes the rule.
 	Source *metav1.ObjectMeta
+	// SourceKind is the kind of the resource that includes the rule, HTTPRoute or GRPCRoute.
+	SourceKind string
 	// Match holds the match for the rule.
 	Match Match
 	// BackendGroup is the group of Backends that the rule routes to.
@@ -241,6 +406,9 @@ type Match struct {
 
 // BackendGroup represents a group of Backends for a routing rule in an HTTPRoute.
 type BackendGroup struct {
+	// ABTest makes the split of the requests between the Backends sticky to the clients.
+	// It is set if the rule references an ABTestFilter.
+	ABTest *ABTest
 	// Source is the NamespacedName of the HTTPRoute the group belongs to.
 	Source types.NamespacedName
 	// Backends is a list of Backends in the Group.
@@ -258,6 +426,30 @@ func (bg *BackendGroup) Name() string {
 	return fmt.Sprintf("%s__%s_rule%d", bg.Source.Namespace, bg.Source.Name, bg.RuleIdx)
 }
 
+// ABTest makes the split of the requests between the Backends of a BackendGroup sticky to the clients.
+// The requests are split by a key that a cookie stores, rather than randomly.
+type ABTest struct {
+	// CookieName is the name of the cookie that stores the key.
+	CookieName string
+	// CookiePath is the path of the cookie.
+	CookiePath string
+	// OverrideHeader is the name of the request header that selects a Backend by the name of its Service.
+	// If empty, the Backend of a request is only selected by the key.
+	OverrideHeader string
+	// Variants are the valid Backends that the OverrideHeader can select. Only set if OverrideHeader is set.
+	Variants []ABTestVariant
+	// CookieMaxAge is the max age of the cookie in seconds. If 0, the cookie is a session cookie.
+	CookieMaxAge int64
+}
+
+// ABTestVariant is a Backend that the override header of an ABTest can select.
+type ABTestVariant struct {
+	// ServiceName is the name of the Service of the Backend.
+	ServiceName string
+	// UpstreamName is the name of the upstream of the Backend.
+	UpstreamName string
+}
+
 // Backend represents a Backend for a routing rule.
 type Backend struct {
 	// VerifyTLS holds the backend TLS verification configuration.
@@ -307,24 +499,98 @@ type SpanAttribute struct {
 
 // BaseHTTPConfig holds the configuration options at the http context.
 type BaseHTTPConfig struct {
+	// GeoIP holds the configuration of the GeoIP2 module. If nil, the module is not used.
+	GeoIP *GeoIP
+	// ServerHeader holds the configuration of the Server response header.
+	ServerHeader ServerHeader
 	// IPFamily specifies the IP family for all servers.
 	IPFamily IPFamilyType
+	// AccessLogRatios is a list of access log sampling ratios.
+	AccessLogRatios []Ratio
 	// RewriteIPSettings defines configuration for rewriting the client IP to the original client's IP.
 	RewriteClientIPSettings RewriteClientIPSettings
 	// HTTP2 specifies whether http2 should be enabled for all servers.
 	HTTP2 bool
 }
 
+// GeoIP holds the configuration of the GeoIP2 module.
+type GeoIP struct {
+	// DatabasePath is the path of the MaxMind database in the NGINX container.
+	DatabasePath string
+	// AutoReloadInterval is the interval at which NGINX reloads the database if it changed.
+	// If empty, the database is not reloaded.
+	AutoReloadInterval string
+}
+
+// ConnectionLimits holds the limits on the connections and requests that NGINX accepts.
+type ConnectionLimits struct {
+	// WorkerConnections is the maximum number of simultaneous connections of each NGINX worker process.
+	// If 0, the default is used.
+	WorkerConnections int32
+	// DefaultServerMaxConnections is the maximum number of simultaneous connections from a client IP address
+	// to the default servers. If 0, the connections are not limited.
+	DefaultServerMaxConnections int32
+	// MaxRequestRate is the maximum rate of requests to each hostname, in requests per second.
+	// If 0, the rate is not limited.
+	MaxRequestRate int32
+}
+
+// Logging holds the configuration of the logs of NGINX.
+type Logging struct {
+	// KubernetesAccessLog holds the configuration of the access log format that includes the Kubernetes context
+	// of the requests. If nil, the access log uses the combined format.
+	KubernetesAccessLog *KubernetesAccessLog
+	// ErrorLevel is the minimum severity level of the messages of the error log. If empty, the default level
+	// is used.
+	ErrorLevel string
+	// StreamAccessLog holds the configuration of the access log of the stream connections.
+	StreamAccessLog StreamAccessLog
+}
+
+// KubernetesAccessLog holds the configuration of the access log format that includes the Kubernetes context
+// of the requests.
+type KubernetesAccessLog struct {
+	// Gateway is the Gateway that the requests are sent to.
+	Gateway types.NamespacedName
+}
+
+// StreamAccessLog holds the configuration of the access log of the stream connections.
+type StreamAccessLog struct {
+	// Format is the format of the entries of the access log. If empty, the default format is used.
+	Format string
+	// Disabled specifies whether the access log is turned off.
+	Disabled bool
+}
+
+// ServerHeader holds the configuration of the Server response header.
+type ServerHeader struct {
+	// Value replaces the value of the Server response header. An empty value removes the header.
+	// If nil, the header is not replaced.
+	Value *string
+	// HideVersion specifies whether to hide the NGINX version in the Server response header.
+	HideVersion bool
+}
+
 // RewriteIPSettings defines configuration for rewriting the client IP to the original client's IP.
 type RewriteClientIPSettings struct {
 	// Mode specifies the mode for rewriting the client IP.
 	Mode RewriteIPModeType
 	// TrustedAddresses specifies the addresses that are trusted to provide the client IP.
 	TrustedAddresses []string
+	// TrustedHops are the layers of proxies in front of NGINX, ordered from the client to NGINX.
+	TrustedHops []TrustedProxyHop
 	// IPRecursive specifies whether a recursive search is used when selecting the client IP.
 	IPRecursive bool
 }
 
+// TrustedProxyHop is a layer of proxies in front of NGINX.
+type TrustedProxyHop struct {
+	// ProtoHeader is the request header in which the proxies of the hop send the scheme of the requests.
+	ProtoHeader string
+	// Addresses are the addresses of the proxies of the hop.
+	Addresses []string
+}
+
 // RewriteIPModeType specifies the mode for rewriting the client IP.
 type RewriteIPModeType string
 
@@ -347,7 +613,7 @@ const (
 	IPv6 IPFamilyType = "ipv6"
 )
 
-// Ratio represents a tracing sampling ratio used in an nginx config with the otel_module.
+// Ratio represents a tracing or access log sampling ratio used in an nginx config.
 type Ratio struct {
 	// Name is based on the associated ObservabilityPolicy's NamespacedName,
 	// and is used as the nginx variable name for this ratio.
@@ -355,3 +621,28 @@ type Ratio struct {
 	// Value is the value of the ratio.
 	Value int32
 }
+
+// ConditionalResponseHeader is a header that is added to the responses that match its condition.
+type ConditionalResponseHeader struct {
+	// HeaderMatch matches a header of the upstream response. If nil, the condition doesn't match a header.
+	HeaderMatch *ResponseHeaderMatch
+	// ID is the unique ID of the header. The ID is safe to use in an NGINX variable name.
+	ID string
+	// Name is the name of the header.
+	Name string
+	// Value is the value of the header.
+	Value string
+	// StatusCodes are the status codes of the responses that match. If empty, the condition doesn't match
+	// the status code.
+	StatusCodes []int
+}
+
+// ResponseHeaderMatch matches a header of the upstream response.
+type ResponseHeaderMatch struct {
+	// Name is the name of the header.
+	Name string
+	// Value is the value that the header must have. Empty if Absent is true.
+	Value string
+	// Absent matches if the upstream response doesn't have the header.
+	Absent bool
+}
@@ -0,0 +1,55 @@
+package graph
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Activator is the Service to which NGINX forwards the requests for Services that scale from zero replicas,
+// while those Services have no ready endpoints.
+type Activator struct {
+	// SvcNsName is the NamespacedName of the activator Service.
+	SvcNsName types.NamespacedName
+	// ServicePort is the port of the activator Service.
+	ServicePort v1.ServicePort
+}
+
+// buildActivator returns the Activator configured in the NginxProxy.
+// It returns nil if scaling from zero is not configured, the NginxProxy is invalid,
+// or the activator Service or its port does not exist.
+func buildActivator(npCfg *NginxProxy, services map[types.NamespacedName]*v1.Service) *Activator {
+	if npCfg == nil || !npCfg.Valid {
+		return nil
+	}
+
+	nsname, configured := activatorNsName(npCfg)
+	if !configured {
+		return nil
+	}
+
+	svc, exists := services[nsname]
+	if !exists {
+		return nil
+	}
+
+	svcPort, err := getServicePort(svc, npCfg.Source.Spec.ScaleFromZero.Activator.Port)
+	if err != nil {
+		return nil
+	}
+
+	return &Activator{
+		SvcNsName:   nsname,
+		ServicePort: svcPort,
+	}
+}
+
+// activatorNsName returns the NamespacedName of the activator Service configured in the NginxProxy, if any.
+func activatorNsName(npCfg *NginxProxy) (types.NamespacedName, bool) {
+	if npCfg == nil || npCfg.Source == nil || npCfg.Source.Spec.ScaleFromZero == nil {
+		return types.NamespacedName{}, false
+	}
+
+	ref := npCfg.Source.Spec.ScaleFromZero.Activator
+
+	return types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, true
+}
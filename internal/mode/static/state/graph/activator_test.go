@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+func TestBuildActivator(t *testing.T) {
+	t.Parallel()
+
+	activatorNsName := types.NamespacedName{Namespace: "keda", Name: "activator"}
+
+	svcPort := v1.ServicePort{Name: "http", Port: 8080}
+
+	services := map[types.NamespacedName]*v1.Service{
+		activatorNsName: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "keda", Name: "activator"},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{svcPort},
+			},
+		},
+	}
+
+	createNginxProxy := func(name string, port int32, valid bool) *NginxProxy {
+		return &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					ScaleFromZero: &ngfAPI.ScaleFromZero{
+						Activator: ngfAPI.ActivatorReference{
+							Name:      name,
+							Namespace: "keda",
+							Port:      port,
+						},
+					},
+				},
+			},
+			Valid: valid,
+		}
+	}
+
+	tests := []struct {
+		npCfg  *NginxProxy
+		expect *Activator
+		name   string
+	}{
+		{
+			name:   "no NginxProxy",
+			npCfg:  nil,
+			expect: nil,
+		},
+		{
+			name: "scale from zero not configured",
+			npCfg: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{},
+				Valid:  true,
+			},
+			expect: nil,
+		},
+		{
+			name:   "invalid NginxProxy",
+			npCfg:  createNginxProxy("activator", 8080, false),
+			expect: nil,
+		},
+		{
+			name:   "service does not exist",
+			npCfg:  createNginxProxy("not-exist", 8080, true),
+			expect: nil,
+		},
+		{
+			name:   "port does not exist",
+			npCfg:  createNginxProxy("activator", 9090, true),
+			expect: nil,
+		},
+		{
+			name:  "valid activator",
+			npCfg: createNginxProxy("activator", 8080, true),
+			expect: &Activator{
+				SvcNsName:   activatorNsName,
+				ServicePort: svcPort,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildActivator(test.npCfg, services)).To(Equal(test.expect))
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package graph
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"regexp"
 	"slices"
 
 	v1 "k8s.io/api/core/v1"
@@ -32,14 +35,53 @@ type BackendRef struct {
 	// Valid indicates whether the backendRef is valid.
 	// No configuration should be generated for an invalid BackendRef.
 	Valid bool
+	// ScaleFromZero indicates whether the Service opts in to scaling from zero replicas, in which case
+	// the requests are forwarded to the Activator while the Service has no ready endpoints.
+	ScaleFromZero bool
 }
 
 // ServicePortReference returns a string representation for the service and port that is referenced by the BackendRef.
+// It is used as the name of the upstream of the BackendRef. See UpstreamName.
 func (b BackendRef) ServicePortReference() string {
 	if !b.Valid {
 		return ""
 	}
-	return fmt.Sprintf("%s_%s_%d", b.SvcNsName.Namespace, b.SvcNsName.Name, b.ServicePort.Port)
+
+	name, _ := UpstreamName(b.SvcNsName, b.ServicePort.Port)
+	return name
+}
+
+const (
+	// maxUpstreamNameLength is the maximum length of the name of an upstream. The name is the host of the URLs
+	// of the proxy_pass directives, so it is limited to the length of a DNS label.
+	maxUpstreamNameLength = 63
+	// upstreamNameHashLength is the length of the hash in a hashed upstream name.
+	upstreamNameHashLength = 10
+)
+
+// invalidUpstreamNameCharsRegexp matches the characters that are not allowed in the name of an upstream.
+var invalidUpstreamNameCharsRegexp = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// UpstreamName returns the name of the upstream for the port of the Service, in the <namespace>_<name>_<port>
+// format. If the name is longer than the NGINX limit or contains unusual characters, the name is hashed: it is
+// the sanitized and truncated name followed by the hash of the full name, which is stable, so that the name
+// doesn't change between the generations. hashed reports whether the name is hashed.
+func UpstreamName(svcNsName types.NamespacedName, port int32) (name string, hashed bool) {
+	name = fmt.Sprintf("%s_%s_%d", svcNsName.Namespace, svcNsName.Name, port)
+
+	if len(name) <= maxUpstreamNameLength && !invalidUpstreamNameCharsRegexp.MatchString(name) {
+		return name, false
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	hash := hex.EncodeToString(sum[:])[:upstreamNameHashLength]
+
+	prefix := invalidUpstreamNameCharsRegexp.ReplaceAllString(name, "_")
+	if maxPrefixLength := maxUpstreamNameLength - len(hash) - 1; len(prefix) > maxPrefixLength {
+		prefix = prefix[:maxPrefixLength]
+	}
+
+	return prefix + "_" + hash, true
 }
 
 func addBackendRefsToRouteRules(
@@ -75,6 +117,11 @@ func addBackendRefsToRules(
 			continue
 		}
 
+		// the backendRef of a delegating rule references a child HTTPRoute, which is processed separately.
+		if rule.Delegate != nil {
+			continue
+		}
+
 		// zero backendRefs is OK. For example, a rule can include a redirect filter.
 		if len(rule.RouteBackendRefs) == 0 {
 			continue
@@ -204,6 +251,7 @@ func createBackendRef(
 		ServicePort:      svcPort,
 		Valid:            true,
 		Weight:           weight,
+		ScaleFromZero:    services[svcNsName].Annotations[ngfAPI.ScaleFromZeroAnnotation] == "true",
 	}
 
 	return backendRef, nil
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -777,9 +778,12 @@ func TestCreateBackend(t *testing.T) {
 	svc1 := createService("service1")
 	svc2 := createService("service2")
 	svc3 := createService("service3")
+	svc4 := createService("service4")
+	svc4.Annotations = map[string]string{ngfAPI.ScaleFromZeroAnnotation: "true"}
 	svc1NamespacedName := types.NamespacedName{Namespace: "test", Name: "service1"}
 	svc2NamespacedName := types.NamespacedName{Namespace: "test", Name: "service2"}
 	svc3NamespacedName := types.NamespacedName{Namespace: "test", Name: "service3"}
+	svc4NamespacedName := types.NamespacedName{Namespace: "test", Name: "service4"}
 
 	btp := BackendTLSPolicy{
 		Source: &v1alpha3.BackendTLSPolicy{
@@ -999,12 +1003,31 @@ func TestCreateBackend(t *testing.T) {
 			),
 			name: "invalid policy",
 		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "service4"
+					return backend
+				}),
+			},
+			expectedBackend: BackendRef{
+				SvcNsName:     svc4NamespacedName,
+				ServicePort:   svc1.Spec.Ports[0],
+				Weight:        5,
+				Valid:         true,
+				ScaleFromZero: true,
+			},
+			expectedServicePortReference: "test_service4_80",
+			expectedCondition:            nil,
+			name:                         "service scales from zero",
+		},
 	}
 
 	services := map[types.NamespacedName]*v1.Service{
 		client.ObjectKeyFromObject(svc1): svc1,
 		client.ObjectKeyFromObject(svc2): svc2,
 		client.ObjectKeyFromObject(svc3): svc3,
+		client.ObjectKeyFromObject(svc4): svc4,
 	}
 	policies := map[types.NamespacedName]*BackendTLSPolicy{
 		client.ObjectKeyFromObject(btp.Source):  &btp,
@@ -1298,3 +1321,72 @@ func TestGetRefGrantFromResourceForRoute_Panics(t *testing.T) {
 
 	g.Expect(get).To(Panic())
 }
+
+func TestUpstreamName(t *testing.T) {
+	t.Parallel()
+
+	longName := strings.Repeat("a", 63)
+
+	tests := []struct {
+		name       string
+		svcNsName  types.NamespacedName
+		expName    string
+		port       int32
+		expHashed  bool
+		expPrefix  string
+		expPattern string
+	}{
+		{
+			name:      "short name",
+			svcNsName: types.NamespacedName{Namespace: "test", Name: "coffee"},
+			port:      80,
+			expName:   "test_coffee_80",
+		},
+		{
+			name:       "too long name",
+			svcNsName:  types.NamespacedName{Namespace: "test", Name: longName},
+			port:       8080,
+			expHashed:  true,
+			expPrefix:  "test_aaaa",
+			expPattern: `^test_a+_[0-9a-f]{10}$`,
+		},
+		{
+			name:       "unusual characters",
+			svcNsName:  types.NamespacedName{Namespace: "test", Name: "tea/cup"},
+			port:       80,
+			expHashed:  true,
+			expPrefix:  "test_tea_cup_80_",
+			expPattern: `^test_tea_cup_80_[0-9a-f]{10}$`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			name, hashed := UpstreamName(test.svcNsName, test.port)
+			g.Expect(hashed).To(Equal(test.expHashed))
+
+			if !test.expHashed {
+				g.Expect(name).To(Equal(test.expName))
+				return
+			}
+
+			g.Expect(len(name)).To(BeNumerically("<=", maxUpstreamNameLength))
+			g.Expect(name).To(HavePrefix(test.expPrefix))
+			g.Expect(name).To(MatchRegexp(test.expPattern))
+
+			// the hashed name is stable
+			sameName, _ := UpstreamName(test.svcNsName, test.port)
+			g.Expect(sameName).To(Equal(name))
+		})
+	}
+
+	g := NewWithT(t)
+
+	// the hashed names of different Services sharing the truncated prefix are different
+	name1, _ := UpstreamName(types.NamespacedName{Namespace: "test", Name: longName + "1"}, 80)
+	name2, _ := UpstreamName(types.NamespacedName{Namespace: "test", Name: longName + "2"}, 80)
+	g.Expect(name1).ToNot(Equal(name2))
+}
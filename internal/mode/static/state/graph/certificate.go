@@ -0,0 +1,159 @@
+package graph
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// minRSAKeyBits is the minimum size of the RSA keys of the certificates. Smaller keys are considered weak.
+const minRSAKeyBits = 2048
+
+// rejectedCertificateError is the error of a certificate and private key that can be parsed, but are rejected,
+// because the clients would reject the certificate, or NGINX would fail to load them. Reason is the reason of the
+// ResolvedRefs condition of the Listeners that reference the certificate.
+type rejectedCertificateError struct {
+	reason v1.ListenerConditionReason
+	msg    string
+}
+
+func (e *rejectedCertificateError) Error() string {
+	return e.msg
+}
+
+// validateCertificate validates the PEM-encoded certificate chain and private key. The private key must be
+// supported and match the certificate, and the certificate must not be expired at the time now.
+func validateCertificate(certPEM, keyPEM []byte, now time.Time) error {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		if keysMismatch(certPEM, keyPEM) {
+			return &rejectedCertificateError{
+				reason: staticConds.ListenerReasonCertificateKeyMismatch,
+				msg:    "private key does not match the certificate",
+			}
+		}
+
+		return err
+	}
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	if err := validatePublicKey(leaf.PublicKey); err != nil {
+		return err
+	}
+
+	if now.After(leaf.NotAfter) {
+		return &rejectedCertificateError{
+			reason: staticConds.ListenerReasonCertificateExpired,
+			msg:    fmt.Sprintf("certificate expired at %s", leaf.NotAfter.UTC().Format(time.RFC3339)),
+		}
+	}
+
+	return nil
+}
+
+// validatePublicKey validates that the key of a certificate is supported by NGINX and is not weak.
+func validatePublicKey(key crypto.PublicKey) error {
+	var msg string
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if bits := k.N.BitLen(); bits < minRSAKeyBits {
+			msg = fmt.Sprintf("RSA key of %d bits is too weak; must be at least %d bits", bits, minRSAKeyBits)
+		}
+	case *ecdsa.PublicKey:
+		switch k.Curve {
+		case elliptic.P256(), elliptic.P384(), elliptic.P521():
+		default:
+			msg = fmt.Sprintf(
+				"ECDSA key of curve %s is not supported; must be P-256, P-384, or P-521",
+				k.Curve.Params().Name,
+			)
+		}
+	case ed25519.PublicKey:
+	default:
+		msg = fmt.Sprintf("key type %T is not supported; must be RSA, ECDSA, or Ed25519", key)
+	}
+
+	if msg != "" {
+		return &rejectedCertificateError{
+			reason: staticConds.ListenerReasonUnsupportedKey,
+			msg:    msg,
+		}
+	}
+
+	return nil
+}
+
+// keysMismatch returns true if the certificate and the private key can be parsed, but the private key does not
+// belong to the public key of the certificate.
+func keysMismatch(certPEM, keyPEM []byte) bool {
+	var certDER []byte
+	for rest := certPEM; ; {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			return false
+		}
+
+		if block.Type == "CERTIFICATE" {
+			certDER = block.Bytes
+			break
+		}
+	}
+
+	leaf, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return false
+	}
+
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return false
+	}
+
+	privateKey, err := parsePrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return false
+	}
+
+	publicKey, ok := privateKey.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return false
+	}
+
+	return !publicKey.Equal(leaf.PublicKey)
+}
+
+// parsePrivateKey parses a DER-encoded private key in the formats that crypto/tls supports.
+func parsePrivateKey(der []byte) (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+	}
+
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	return nil, errors.New("failed to parse private key")
+}
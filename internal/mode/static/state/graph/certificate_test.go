@@ -0,0 +1,224 @@
+package graph
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// createTestCertificate creates a PEM-encoded self-signed certificate of the key, which expires at notAfter,
+// and the PEM-encoded key.
+func createTestCertificate(t *testing.T, key crypto.Signer, notAfter time.Time) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cafe.example.com"},
+		DNSNames:     []string{"cafe.example.com"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestValidateCertificate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	notAfter := now.Add(24 * time.Hour)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	otherECKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	weakECKey, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	weakRSAKey, err := rsa.GenerateKey(rand.Reader, 1024) //nolint:gosec // weak key on purpose
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	ecCert, ecKeyPEM := createTestCertificate(t, ecKey, notAfter)
+	_, otherECKeyPEM := createTestCertificate(t, otherECKey, notAfter)
+	expiredCert, expiredKeyPEM := createTestCertificate(t, ecKey, now.Add(-time.Hour))
+	weakECCert, weakECKeyPEM := createTestCertificate(t, weakECKey, notAfter)
+	weakRSACert, weakRSAKeyPEM := createTestCertificate(t, weakRSAKey, notAfter)
+	edCert, edKeyPEM := createTestCertificate(t, edKey, notAfter)
+
+	tests := []struct {
+		name      string
+		expMsg    string
+		expReason v1.ListenerConditionReason
+		cert      []byte
+		key       []byte
+		expErr    bool
+	}{
+		{
+			name: "valid RSA certificate",
+			cert: cert,
+			key:  key,
+		},
+		{
+			name: "valid ECDSA certificate",
+			cert: ecCert,
+			key:  ecKeyPEM,
+		},
+		{
+			name: "valid Ed25519 certificate",
+			cert: edCert,
+			key:  edKeyPEM,
+		},
+		{
+			name:      "expired certificate",
+			cert:      expiredCert,
+			key:       expiredKeyPEM,
+			expErr:    true,
+			expReason: staticConds.ListenerReasonCertificateExpired,
+			expMsg:    "certificate expired at 2026-10-16T11:00:00Z",
+		},
+		{
+			name:      "mismatched private key of the same type",
+			cert:      ecCert,
+			key:       otherECKeyPEM,
+			expErr:    true,
+			expReason: staticConds.ListenerReasonCertificateKeyMismatch,
+			expMsg:    "private key does not match the certificate",
+		},
+		{
+			name:      "mismatched private key of another type",
+			cert:      cert,
+			key:       ecKeyPEM,
+			expErr:    true,
+			expReason: staticConds.ListenerReasonCertificateKeyMismatch,
+			expMsg:    "private key does not match the certificate",
+		},
+		{
+			name:      "weak RSA key",
+			cert:      weakRSACert,
+			key:       weakRSAKeyPEM,
+			expErr:    true,
+			expReason: staticConds.ListenerReasonUnsupportedKey,
+			expMsg:    "RSA key of 1024 bits is too weak; must be at least 2048 bits",
+		},
+		{
+			name:      "unsupported ECDSA curve",
+			cert:      weakECCert,
+			key:       weakECKeyPEM,
+			expErr:    true,
+			expReason: staticConds.ListenerReasonUnsupportedKey,
+			expMsg:    "ECDSA key of curve P-224 is not supported; must be P-256, P-384, or P-521",
+		},
+		{
+			name:   "invalid certificate",
+			cert:   invalidCert,
+			key:    key,
+			expErr: true,
+		},
+		{
+			name:   "invalid key",
+			cert:   cert,
+			key:    invalidKey,
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateCertificate(test.cert, test.key, now)
+			if !test.expErr {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).To(HaveOccurred())
+
+			var rejectedErr *rejectedCertificateError
+			if test.expReason == "" {
+				g.Expect(errors.As(err, &rejectedErr)).To(BeFalse())
+				return
+			}
+
+			g.Expect(errors.As(err, &rejectedErr)).To(BeTrue())
+			g.Expect(rejectedErr.reason).To(Equal(test.expReason))
+			g.Expect(rejectedErr.Error()).To(Equal(test.expMsg))
+		})
+	}
+}
+
+func TestCertificateRefConditions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err      error
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "rejected certificate",
+			err: fmt.Errorf("TLS secret is invalid: %w", &rejectedCertificateError{
+				reason: staticConds.ListenerReasonCertificateExpired,
+				msg:    "certificate expired at 2026-10-16T11:00:00Z",
+			}),
+			expConds: staticConds.NewListenerRejectedCertificateRef(
+				staticConds.ListenerReasonCertificateExpired,
+				"invalid",
+			),
+		},
+		{
+			name:     "invalid certificate",
+			err:      errors.New("secret does not exist"),
+			expConds: staticConds.NewListenerInvalidCertificateRef("invalid"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(certificateRefConditions(test.err, "invalid")).To(Equal(test.expConds))
+		})
+	}
+}
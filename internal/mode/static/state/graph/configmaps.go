@@ -1,11 +1,13 @@
 package graph
 
 import (
+	"bytes"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"sort"
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -54,20 +56,10 @@ func (r *configMapResolver) resolve(nsname types.NamespacedName) error {
 	if !exist {
 		validationErr = errors.New("ConfigMap does not exist")
 	} else {
-		if cm.Data != nil {
-			if _, exists := cm.Data[CAKey]; exists {
-				validationErr = validateCA([]byte(cm.Data[CAKey]))
-				caCert = []byte(cm.Data[CAKey])
-			}
-		}
-		if cm.BinaryData != nil {
-			if _, exists := cm.BinaryData[CAKey]; exists {
-				validationErr = validateCA(cm.BinaryData[CAKey])
-				caCert = cm.BinaryData[CAKey]
-			}
-		}
-		if len(caCert) == 0 {
-			validationErr = fmt.Errorf("ConfigMap does not have the data or binaryData field %v", CAKey)
+		var caKey string
+		caKey, caCert, validationErr = findCA(cm)
+		if validationErr == nil {
+			validationErr = validateCA(caCert, caKey)
 		}
 	}
 
@@ -98,8 +90,53 @@ func (r *configMapResolver) getResolvedConfigMaps() map[types.NamespacedName]*Ca
 	return resolved
 }
 
-// validateCA validates the ca.crt entry in the ConfigMap. If it is valid, the function returns nil.
-func validateCA(caData []byte) error {
+// findCA returns the key and the data of the CA certificate of the ConfigMap. The CA certificate is the ca.crt
+// data or binaryData field. Otherwise, it is the only field that holds a PEM-encoded certificate, so that
+// the ConfigMaps of other tools are supported, such as the trust bundles of trust-manager.
+func findCA(cm *apiv1.ConfigMap) (string, []byte, error) {
+	if data, exists := cm.BinaryData[CAKey]; exists {
+		return CAKey, data, nil
+	}
+
+	if data, exists := cm.Data[CAKey]; exists {
+		return CAKey, []byte(data), nil
+	}
+
+	fields := make(map[string][]byte, len(cm.Data)+len(cm.BinaryData))
+	for k, v := range cm.Data {
+		fields[k] = []byte(v)
+	}
+	for k, v := range cm.BinaryData {
+		fields[k] = v
+	}
+
+	var caKeys []string
+	for k, v := range fields {
+		if bytes.Contains(v, []byte("-----BEGIN CERTIFICATE-----")) {
+			caKeys = append(caKeys, k)
+		}
+	}
+	sort.Strings(caKeys)
+
+	switch len(caKeys) {
+	case 0:
+		return "", nil, fmt.Errorf(
+			"ConfigMap does not have the data or binaryData field %v or a field with a PEM-encoded certificate",
+			CAKey,
+		)
+	case 1:
+		return caKeys[0], fields[caKeys[0]], nil
+	default:
+		return "", nil, fmt.Errorf(
+			"ConfigMap has more than one field with a PEM-encoded certificate: %s; use the field %v",
+			quoteKeys(caKeys),
+			CAKey,
+		)
+	}
+}
+
+// validateCA validates the CA certificate entry in the ConfigMap. If it is valid, the function returns nil.
+func validateCA(caData []byte, key string) error {
 	data := make([]byte, base64.StdEncoding.DecodedLen(len(caData)))
 	_, err := base64.StdEncoding.Decode(data, caData)
 	if err != nil {
@@ -107,15 +144,15 @@ func validateCA(caData []byte) error {
 	}
 	block, _ := pem.Decode(data)
 	if block == nil {
-		return fmt.Errorf("the data field %s must hold a valid CERTIFICATE PEM block", CAKey)
+		return fmt.Errorf("the data field %s must hold a valid CERTIFICATE PEM block", key)
 	}
 	if block.Type != "CERTIFICATE" {
-		return fmt.Errorf("the data field %s must hold a valid CERTIFICATE PEM block, but got '%s'", CAKey, block.Type)
+		return fmt.Errorf("the data field %s must hold a valid CERTIFICATE PEM block, but got '%s'", key, block.Type)
 	}
 
 	_, err = x509.ParseCertificate(block.Bytes)
 	if err != nil {
-		return fmt.Errorf("failed to validate certificate: %w", err)
+		return fmt.Errorf("failed to validate certificate of the data field %s: %w", key, err)
 	}
 
 	return nil
@@ -124,7 +124,7 @@ func TestValidateCA(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			err := validateCA(test.data)
+			err := validateCA(test.data, CAKey)
 			if test.errorExpected {
 				g.Expect(err).To(HaveOccurred())
 			} else {
@@ -163,6 +163,28 @@ func TestResolve(t *testing.T) {
 				"ca.crt": "invalid",
 			},
 		},
+		{Namespace: "test", Name: "trust-bundle"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "trust-bundle",
+				Namespace: "test",
+			},
+			Data: map[string]string{
+				"trust-bundle.pem": caBlock,
+				"README":           "not a certificate",
+			},
+		},
+		{Namespace: "test", Name: "multiple-bundles"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "multiple-bundles",
+				Namespace: "test",
+			},
+			Data: map[string]string{
+				"bundle-1.pem": caBlock,
+			},
+			BinaryData: map[string][]byte{
+				"bundle-2.pem": []byte(caBlock),
+			},
+		},
 		{Namespace: "test", Name: "nocaentry"}: {
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "nocaentry",
@@ -201,6 +223,16 @@ func TestResolve(t *testing.T) {
 			nsname:        types.NamespacedName{Namespace: "test", Name: "non-existent"},
 			errorExpected: true,
 		},
+		{
+			name:          "valid configmap with a non-standard key",
+			nsname:        types.NamespacedName{Namespace: "test", Name: "trust-bundle"},
+			errorExpected: false,
+		},
+		{
+			name:          "configmap with multiple keys with certificates",
+			nsname:        types.NamespacedName{Namespace: "test", Name: "multiple-bundles"},
+			errorExpected: true,
+		},
 		{
 			name:          "configmap missing ca entry",
 			nsname:        types.NamespacedName{Namespace: "test", Name: "nocaentry"},
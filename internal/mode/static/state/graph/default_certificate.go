@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// DefaultCertificate is the certificate of the DefaultCertificatePolicy of the Gateway, which NGINX presents to
+// the clients without SNI or with a server name that none of the Listeners match.
+// Exactly one of its fields is set.
+type DefaultCertificate struct {
+	// Secret is the NamespacedName of the Secret that holds the certificate.
+	Secret *types.NamespacedName
+	// ExternalCertificate is the name of the ExternalCertificate.
+	ExternalCertificate *string
+}
+
+// buildDefaultCertificate resolves the certificate of the valid DefaultCertificatePolicy that targets the Gateway.
+// A DefaultCertificatePolicy with a certificate that can't be resolved becomes invalid. The Secrets are resolved
+// with the secretResolver, so that the Graph references them, including the Secrets that don't exist yet.
+// It returns nil if the Gateway doesn't have a valid DefaultCertificatePolicy.
+func buildDefaultCertificate(
+	gw *Gateway,
+	pols map[PolicyKey]*Policy,
+	secretResolver *secretResolver,
+	externalCertResolver *externalCertificateResolver,
+) *DefaultCertificate {
+	if gw == nil {
+		return nil
+	}
+
+	gwNsName := client.ObjectKeyFromObject(gw.Source)
+
+	var defaultCert *DefaultCertificate
+
+	for _, policy := range pols {
+		dp, ok := policy.Source.(*ngfAPI.DefaultCertificatePolicy)
+		if !ok || !policy.Valid || !targetsGateway(policy, gwNsName) {
+			continue
+		}
+
+		ref := dp.Spec.CertificateRef
+
+		kind := kinds.Secret
+		if ref.Kind != nil {
+			kind = string(*ref.Kind)
+		}
+
+		var cert DefaultCertificate
+		var err error
+
+		if kind == kinds.ExternalCertificate {
+			name := string(ref.Name)
+			cert.ExternalCertificate = &name
+			err = externalCertResolver.resolve(name)
+		} else {
+			nsname := types.NamespacedName{Namespace: dp.Namespace, Name: string(ref.Name)}
+			cert.Secret = &nsname
+			err = secretResolver.resolve(nsname)
+		}
+
+		if err != nil {
+			msg := fmt.Sprintf("%s %s is invalid: %s", kind, ref.Name, err)
+			policy.Conditions = append(policy.Conditions, staticConds.NewPolicyNotAcceptedInvalidCertificateRef(msg))
+			policy.Valid = false
+
+			continue
+		}
+
+		// The DefaultCertificatePolicies of a Gateway conflict, so only one of them is valid.
+		defaultCert = &cert
+	}
+
+	return defaultCert
+}
+
+func targetsGateway(policy *Policy, gwNsName types.NamespacedName) bool {
+	for _, ref := range policy.TargetRefs {
+		if ref.Kind == kinds.Gateway && ref.Nsname == gwNsName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestBuildDefaultCertificate(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: testNs, Name: "gw"}
+	gw := &Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "gw"},
+		},
+	}
+
+	secretNsName := types.NamespacedName{Namespace: testNs, Name: "default-cert"}
+	secrets := map[types.NamespacedName]*apiv1.Secret{
+		secretNsName: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "default-cert"},
+			Type:       apiv1.SecretTypeTLS,
+			Data: map[string][]byte{
+				apiv1.TLSCertKey:       cert,
+				apiv1.TLSPrivateKeyKey: key,
+			},
+		},
+	}
+	externalCerts := map[string]*ExternalCertificate{
+		"cafe": {Cert: cert, Key: key},
+	}
+
+	createPolicy := func(kind, name string, target types.NamespacedName, valid bool) *Policy {
+		return &Policy{
+			Source: &ngfAPI.DefaultCertificatePolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "default-cert"},
+				Spec: ngfAPI.DefaultCertificatePolicySpec{
+					CertificateRef: ngfAPI.DefaultCertificateReference{
+						Kind: helpers.GetPointer(v1.Kind(kind)),
+						Name: v1.ObjectName(name),
+					},
+				},
+			},
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.Gateway, Nsname: target}},
+			Valid:      valid,
+		}
+	}
+
+	tests := []struct {
+		gw         *Gateway
+		policy     *Policy
+		expCert    *DefaultCertificate
+		name       string
+		expConds   []conditions.Condition
+		expValid   bool
+		expSecrets []types.NamespacedName
+	}{
+		{
+			name:       "Secret",
+			gw:         gw,
+			policy:     createPolicy(kinds.Secret, "default-cert", gwNsName, true),
+			expCert:    &DefaultCertificate{Secret: &secretNsName},
+			expValid:   true,
+			expSecrets: []types.NamespacedName{secretNsName},
+		},
+		{
+			name:     "ExternalCertificate",
+			gw:       gw,
+			policy:   createPolicy(kinds.ExternalCertificate, "cafe", gwNsName, true),
+			expCert:  &DefaultCertificate{ExternalCertificate: helpers.GetPointer("cafe")},
+			expValid: true,
+		},
+		{
+			name:   "Secret does not exist",
+			gw:     gw,
+			policy: createPolicy(kinds.Secret, "missing", gwNsName, true),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidCertificateRef("Secret missing is invalid: secret does not exist"),
+			},
+			expValid:   false,
+			expSecrets: []types.NamespacedName{{Namespace: testNs, Name: "missing"}},
+		},
+		{
+			name:   "ExternalCertificate does not exist",
+			gw:     gw,
+			policy: createPolicy(kinds.ExternalCertificate, "missing", gwNsName, true),
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidCertificateRef(
+					"ExternalCertificate missing is invalid: certificate does not exist in the certificate source",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:     "invalid policy",
+			gw:       gw,
+			policy:   createPolicy(kinds.Secret, "default-cert", gwNsName, false),
+			expValid: false,
+		},
+		{
+			name:     "policy targets another Gateway",
+			gw:       gw,
+			policy:   createPolicy(kinds.Secret, "default-cert", types.NamespacedName{Namespace: testNs, Name: "other"}, true),
+			expValid: true,
+		},
+		{
+			name:     "no Gateway",
+			policy:   createPolicy(kinds.Secret, "default-cert", gwNsName, true),
+			expValid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			pols := map[PolicyKey]*Policy{
+				{NsName: types.NamespacedName{Namespace: testNs, Name: "default-cert"}}: test.policy,
+			}
+
+			secretResolver := newSecretResolver(secrets)
+			externalCertResolver := newExternalCertificateResolver(externalCerts)
+
+			g.Expect(buildDefaultCertificate(test.gw, pols, secretResolver, externalCertResolver)).To(Equal(test.expCert))
+			g.Expect(test.policy.Conditions).To(Equal(test.expConds))
+			g.Expect(test.policy.Valid).To(Equal(test.expValid))
+
+			resolvedSecrets := secretResolver.getResolvedSecrets()
+			g.Expect(resolvedSecrets).To(HaveLen(len(test.expSecrets)))
+			for _, nsname := range test.expSecrets {
+				g.Expect(resolvedSecrets).To(HaveKey(nsname))
+			}
+		})
+	}
+}
@@ -0,0 +1,722 @@
+package graph
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// SnippetsFilter represents a SnippetsFilter.
+type SnippetsFilter struct {
+	// Source is the SnippetsFilter resource.
+	Source *ngfAPI.SnippetsFilter
+	// Snippets are the snippets of the filter, keyed by the NGINX context.
+	Snippets map[ngfAPI.NginxContext]string
+	// Conditions define the conditions to be reported in the status of the SnippetsFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the SnippetsFilter is valid.
+	Valid bool
+	// Referenced indicates whether an HTTPRoute references the SnippetsFilter.
+	Referenced bool
+}
+
+// RateLimitFilter represents a RateLimitFilter.
+type RateLimitFilter struct {
+	// Source is the RateLimitFilter resource.
+	Source *ngfAPI.RateLimitFilter
+	// Conditions define the conditions to be reported in the status of the RateLimitFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the RateLimitFilter is valid.
+	Valid bool
+	// Referenced indicates whether an HTTPRoute references the RateLimitFilter.
+	Referenced bool
+}
+
+// ResponseHeaderFilter represents a ResponseHeaderFilter.
+type ResponseHeaderFilter struct {
+	// Source is the ResponseHeaderFilter resource.
+	Source *ngfAPI.ResponseHeaderFilter
+	// Conditions define the conditions to be reported in the status of the ResponseHeaderFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the ResponseHeaderFilter is valid.
+	Valid bool
+	// Referenced indicates whether an HTTPRoute references the ResponseHeaderFilter.
+	Referenced bool
+}
+
+// QueryParameterFilter represents a QueryParameterFilter.
+type QueryParameterFilter struct {
+	// Source is the QueryParameterFilter resource.
+	Source *ngfAPI.QueryParameterFilter
+	// Conditions define the conditions to be reported in the status of the QueryParameterFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the QueryParameterFilter is valid.
+	Valid bool
+	// Referenced indicates whether an HTTPRoute references the QueryParameterFilter.
+	Referenced bool
+}
+
+// ABTestFilter represents an ABTestFilter.
+type ABTestFilter struct {
+	// Source is the ABTestFilter resource.
+	Source *ngfAPI.ABTestFilter
+	// Conditions define the conditions to be reported in the status of the ABTestFilter.
+	Conditions []conditions.Condition
+	// Valid indicates whether the ABTestFilter is valid.
+	Valid bool
+	// Referenced indicates whether an HTTPRoute references the ABTestFilter.
+	Referenced bool
+}
+
+// ExtensionRefFilter is a filter of an HTTPRoute rule that references an NGF filter resource with an extensionRef.
+// Only one of the filters is set.
+type ExtensionRefFilter struct {
+	// SnippetsFilter is the referenced SnippetsFilter.
+	SnippetsFilter *SnippetsFilter
+	// RateLimitFilter is the referenced RateLimitFilter.
+	RateLimitFilter *RateLimitFilter
+	// ResponseHeaderFilter is the referenced ResponseHeaderFilter.
+	ResponseHeaderFilter *ResponseHeaderFilter
+	// QueryParameterFilter is the referenced QueryParameterFilter.
+	QueryParameterFilter *QueryParameterFilter
+	// ABTestFilter is the referenced ABTestFilter.
+	ABTestFilter *ABTestFilter
+}
+
+var (
+	// rateRegexp and rateLimitKeyRegexp mirror the validation of the RateLimitFilter CRD.
+	rateRegexp         = regexp.MustCompile(`^[1-9][0-9]{0,5}r/(s|m)$`)
+	rateLimitKeyRegexp = regexp.MustCompile(`^([^"\s;{}\\]|\\[^\s])*$`)
+	// queryParameterNameRegexp mirrors the validation of the QueryParameterName type of the QueryParameterFilter CRD.
+	queryParameterNameRegexp = regexp.MustCompile(`^[A-Za-z0-9\-._~\[\]]+$`)
+	// abTestCookieNameRegexp, abTestCookiePathRegexp, and durationRegexp mirror the validation of the ABTestFilter CRD.
+	abTestCookieNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	abTestCookiePathRegexp = regexp.MustCompile(`^/[A-Za-z0-9\-._~/]*$`)
+	durationRegexp         = regexp.MustCompile(`^[0-9]{1,4}(ms|s|m|h)?$`)
+)
+
+// supportedExtensionRefFilterKinds are the kinds of the NGF filter resources that an extensionRef can reference.
+var supportedExtensionRefFilterKinds = []string{
+	kinds.SnippetsFilter,
+	kinds.RateLimitFilter,
+	kinds.ResponseHeaderFilter,
+	kinds.QueryParameterFilter,
+	kinds.ABTestFilter,
+}
+
+// singleExtensionRefFilterKinds are the kinds of the NGF filter resources that a rule can reference at most once.
+var singleExtensionRefFilterKinds = []v1.Kind{
+	kinds.RateLimitFilter,
+	kinds.ABTestFilter,
+}
+
+func processSnippetsFilters(
+	filters map[types.NamespacedName]*ngfAPI.SnippetsFilter,
+) map[types.NamespacedName]*SnippetsFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*SnippetsFilter, len(filters))
+
+	for nsname, sf := range filters {
+		processed[nsname] = processSnippetsFilter(sf)
+	}
+
+	return processed
+}
+
+func processSnippetsFilter(sf *ngfAPI.SnippetsFilter) *SnippetsFilter {
+	snippetsPath := field.NewPath("spec").Child("snippets")
+	snippets := make(map[ngfAPI.NginxContext]string, len(sf.Spec.Snippets))
+
+	var allErrs field.ErrorList
+
+	if len(sf.Spec.Snippets) == 0 {
+		allErrs = append(allErrs, field.Required(snippetsPath, "at least one snippet is required"))
+	}
+
+	for i, snippet := range sf.Spec.Snippets {
+		snippetPath := snippetsPath.Index(i)
+
+		switch snippet.Context {
+		case ngfAPI.NginxContextHTTP, ngfAPI.NginxContextHTTPServer, ngfAPI.NginxContextHTTPServerLocation:
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				snippetPath.Child("context"),
+				snippet.Context,
+				[]string{
+					string(ngfAPI.NginxContextHTTP),
+					string(ngfAPI.NginxContextHTTPServer),
+					string(ngfAPI.NginxContextHTTPServerLocation),
+				},
+			))
+			continue
+		}
+
+		if _, exists := snippets[snippet.Context]; exists {
+			allErrs = append(allErrs, field.Duplicate(snippetPath.Child("context"), snippet.Context))
+			continue
+		}
+
+		if snippet.Value == "" {
+			allErrs = append(allErrs, field.Required(snippetPath.Child("value"), "cannot be empty"))
+			continue
+		}
+
+		snippets[snippet.Context] = snippet.Value
+	}
+
+	if len(allErrs) > 0 {
+		return &SnippetsFilter{
+			Source:     sf,
+			Conditions: []conditions.Condition{staticConds.NewFilterInvalid(allErrs.ToAggregate().Error())},
+		}
+	}
+
+	return &SnippetsFilter{
+		Source:     sf,
+		Snippets:   snippets,
+		Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+		Valid:      true,
+	}
+}
+
+func processRateLimitFilters(
+	filters map[types.NamespacedName]*ngfAPI.RateLimitFilter,
+	validator validation.GenericValidator,
+) map[types.NamespacedName]*RateLimitFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*RateLimitFilter, len(filters))
+
+	for nsname, rlf := range filters {
+		processed[nsname] = processRateLimitFilter(rlf, validator)
+	}
+
+	return processed
+}
+
+func processRateLimitFilter(rlf *ngfAPI.RateLimitFilter, validator validation.GenericValidator) *RateLimitFilter {
+	specPath := field.NewPath("spec")
+	spec := rlf.Spec
+
+	var allErrs field.ErrorList
+
+	if !rateRegexp.MatchString(spec.Rate) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("rate"),
+			spec.Rate,
+			"must be a number of requests per second or minute, for example, 10r/s or 30r/m",
+		))
+	}
+
+	if spec.Key != nil && (*spec.Key == "" || !rateLimitKeyRegexp.MatchString(*spec.Key)) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("key"),
+			*spec.Key,
+			`must not be empty or contain '"', ';', '{', '}', whitespace, or end with an unescaped '\'`,
+		))
+	}
+
+	if spec.ZoneSize != nil {
+		if err := validator.ValidateNginxSize(string(*spec.ZoneSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("zoneSize"), *spec.ZoneSize, err.Error()))
+		}
+	}
+
+	if spec.Burst != nil && *spec.Burst < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("burst"), *spec.Burst, "must not be negative"))
+	}
+
+	if spec.RejectCode != nil && (*spec.RejectCode < 400 || *spec.RejectCode > 599) {
+		allErrs = append(allErrs, field.Invalid(
+			specPath.Child("rejectCode"),
+			*spec.RejectCode,
+			"must be between 400 and 599",
+		))
+	}
+
+	if len(allErrs) > 0 {
+		return &RateLimitFilter{
+			Source:     rlf,
+			Conditions: []conditions.Condition{staticConds.NewFilterInvalid(allErrs.ToAggregate().Error())},
+		}
+	}
+
+	return &RateLimitFilter{
+		Source:     rlf,
+		Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+		Valid:      true,
+	}
+}
+
+func processResponseHeaderFilters(
+	filters map[types.NamespacedName]*ngfAPI.ResponseHeaderFilter,
+	validator validation.HTTPFieldsValidator,
+) map[types.NamespacedName]*ResponseHeaderFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*ResponseHeaderFilter, len(filters))
+
+	for nsname, rhf := range filters {
+		processed[nsname] = processResponseHeaderFilter(rhf, validator)
+	}
+
+	return processed
+}
+
+func processResponseHeaderFilter(
+	rhf *ngfAPI.ResponseHeaderFilter,
+	validator validation.HTTPFieldsValidator,
+) *ResponseHeaderFilter {
+	headersPath := field.NewPath("spec").Child("headers")
+
+	var allErrs field.ErrorList
+
+	if len(rhf.Spec.Headers) == 0 {
+		allErrs = append(allErrs, field.Required(headersPath, "at least one header is required"))
+	}
+
+	for i, h := range rhf.Spec.Headers {
+		headerPath := headersPath.Index(i)
+
+		if err := validator.ValidateFilterHeaderName(string(h.Name)); err != nil {
+			allErrs = append(allErrs, field.Invalid(headerPath.Child("name"), h.Name, err.Error()))
+		}
+
+		if err := validator.ValidateFilterHeaderValue(h.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(headerPath.Child("value"), h.Value, err.Error()))
+		}
+
+		if !responseHeaderNameAllowed(string(h.Name)) {
+			allErrs = append(allErrs, field.Invalid(headerPath.Child("name"), h.Name, "header name is not allowed"))
+		}
+
+		allErrs = append(allErrs, validateResponseCondition(h.When, validator, headerPath.Child("when"))...)
+	}
+
+	if len(allErrs) > 0 {
+		return &ResponseHeaderFilter{
+			Source:     rhf,
+			Conditions: []conditions.Condition{staticConds.NewFilterInvalid(allErrs.ToAggregate().Error())},
+		}
+	}
+
+	return &ResponseHeaderFilter{
+		Source:     rhf,
+		Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+		Valid:      true,
+	}
+}
+
+func validateResponseCondition(
+	cond ngfAPI.ResponseCondition,
+	validator validation.HTTPFieldsValidator,
+	condPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(cond.StatusCodes) == 0 && cond.Header == nil {
+		allErrs = append(allErrs, field.Required(condPath, "at least one of statusCodes or header must be specified"))
+	}
+
+	for i, code := range cond.StatusCodes {
+		if code < 100 || code > 599 {
+			allErrs = append(allErrs, field.Invalid(
+				condPath.Child("statusCodes").Index(i),
+				code,
+				"must be between 100 and 599",
+			))
+		}
+	}
+
+	if cond.Header == nil {
+		return allErrs
+	}
+
+	headerPath := condPath.Child("header")
+
+	if err := validator.ValidateFilterHeaderName(string(cond.Header.Name)); err != nil {
+		allErrs = append(allErrs, field.Invalid(headerPath.Child("name"), cond.Header.Name, err.Error()))
+	}
+
+	switch cond.Header.Type {
+	case ngfAPI.ResponseHeaderMatchExact:
+		if cond.Header.Value == nil {
+			allErrs = append(allErrs, field.Required(headerPath.Child("value"), "required if type is Exact"))
+		} else if err := validator.ValidateFilterHeaderValue(*cond.Header.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(headerPath.Child("value"), *cond.Header.Value, err.Error()))
+		}
+	case ngfAPI.ResponseHeaderMatchAbsent:
+		if cond.Header.Value != nil {
+			allErrs = append(allErrs, field.Forbidden(headerPath.Child("value"), "cannot be set if type is Absent"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			headerPath.Child("type"),
+			cond.Header.Type,
+			[]string{string(ngfAPI.ResponseHeaderMatchExact), string(ngfAPI.ResponseHeaderMatchAbsent)},
+		))
+	}
+
+	return allErrs
+}
+
+func processQueryParameterFilters(
+	filters map[types.NamespacedName]*ngfAPI.QueryParameterFilter,
+) map[types.NamespacedName]*QueryParameterFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*QueryParameterFilter, len(filters))
+
+	for nsname, qpf := range filters {
+		processed[nsname] = processQueryParameterFilter(qpf)
+	}
+
+	return processed
+}
+
+func processQueryParameterFilter(qpf *ngfAPI.QueryParameterFilter) *QueryParameterFilter {
+	specPath := field.NewPath("spec")
+	spec := qpf.Spec
+
+	var allErrs field.ErrorList
+
+	if len(spec.Set) == 0 && len(spec.Add) == 0 && len(spec.Remove) == 0 {
+		allErrs = append(allErrs, field.Required(specPath, "at least one of set, add, or remove must be specified"))
+	}
+
+	allErrs = append(allErrs, validateQueryParameters(spec.Set, specPath.Child("set"))...)
+	allErrs = append(allErrs, validateQueryParameters(spec.Add, specPath.Child("add"))...)
+
+	removePath := specPath.Child("remove")
+	removed := make(map[ngfAPI.QueryParameterName]struct{}, len(spec.Remove))
+
+	for i, name := range spec.Remove {
+		namePath := removePath.Index(i)
+
+		if _, exists := removed[name]; exists {
+			allErrs = append(allErrs, field.Duplicate(namePath, name))
+		}
+		removed[name] = struct{}{}
+
+		allErrs = append(allErrs, validateQueryParameterName(name, namePath)...)
+	}
+
+	if len(allErrs) > 0 {
+		return &QueryParameterFilter{
+			Source:     qpf,
+			Conditions: []conditions.Condition{staticConds.NewFilterInvalid(allErrs.ToAggregate().Error())},
+		}
+	}
+
+	return &QueryParameterFilter{
+		Source:     qpf,
+		Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+		Valid:      true,
+	}
+}
+
+func validateQueryParameters(params []ngfAPI.QueryParameter, paramsPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make(map[ngfAPI.QueryParameterName]struct{}, len(params))
+
+	for i, p := range params {
+		namePath := paramsPath.Index(i).Child("name")
+
+		if _, exists := names[p.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(namePath, p.Name))
+		}
+		names[p.Name] = struct{}{}
+
+		allErrs = append(allErrs, validateQueryParameterName(p.Name, namePath)...)
+	}
+
+	return allErrs
+}
+
+func validateQueryParameterName(name ngfAPI.QueryParameterName, namePath *field.Path) field.ErrorList {
+	if !queryParameterNameRegexp.MatchString(string(name)) {
+		return field.ErrorList{field.Invalid(
+			namePath,
+			name,
+			"must not be empty and must only contain alphanumeric characters, '-', '.', '_', '~', '[', or ']'",
+		)}
+	}
+
+	return nil
+}
+
+func processABTestFilters(
+	filters map[types.NamespacedName]*ngfAPI.ABTestFilter,
+	validator validation.HTTPFieldsValidator,
+) map[types.NamespacedName]*ABTestFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*ABTestFilter, len(filters))
+
+	for nsname, abf := range filters {
+		processed[nsname] = processABTestFilter(abf, validator)
+	}
+
+	return processed
+}
+
+func processABTestFilter(abf *ngfAPI.ABTestFilter, validator validation.HTTPFieldsValidator) *ABTestFilter {
+	specPath := field.NewPath("spec")
+	cookiePath := specPath.Child("cookie")
+	spec := abf.Spec
+
+	var allErrs field.ErrorList
+
+	if spec.OverrideHeader != nil {
+		if err := validator.ValidateFilterHeaderName(string(*spec.OverrideHeader)); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("overrideHeader"), *spec.OverrideHeader, err.Error()))
+		}
+	}
+
+	if !abTestCookieNameRegexp.MatchString(spec.Cookie.Name) {
+		allErrs = append(allErrs, field.Invalid(
+			cookiePath.Child("name"),
+			spec.Cookie.Name,
+			"must not be empty and must only contain alphanumeric characters or '_'",
+		))
+	}
+
+	if spec.Cookie.Path != nil && !abTestCookiePathRegexp.MatchString(*spec.Cookie.Path) {
+		allErrs = append(allErrs, field.Invalid(
+			cookiePath.Child("path"),
+			*spec.Cookie.Path,
+			"must start with '/' and must only contain alphanumeric characters, '-', '.', '_', '~', or '/'",
+		))
+	}
+
+	if spec.Cookie.MaxAge != nil && !durationRegexp.MatchString(string(*spec.Cookie.MaxAge)) {
+		allErrs = append(allErrs, field.Invalid(
+			cookiePath.Child("maxAge"),
+			*spec.Cookie.MaxAge,
+			"must be a duration in milliseconds (ms), seconds (s), minutes (m), or hours (h)",
+		))
+	}
+
+	if len(allErrs) > 0 {
+		return &ABTestFilter{
+			Source:     abf,
+			Conditions: []conditions.Condition{staticConds.NewFilterInvalid(allErrs.ToAggregate().Error())},
+		}
+	}
+
+	return &ABTestFilter{
+		Source:     abf,
+		Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+		Valid:      true,
+	}
+}
+
+// validateFilterExtensionRef validates the reference of an extensionRef filter. The referenced filter resource
+// is resolved after the Route is built.
+func validateFilterExtensionRef(ref *v1.LocalObjectReference, filterPath *field.Path) field.ErrorList {
+	refPath := filterPath.Child("extensionRef")
+
+	if ref == nil {
+		return field.ErrorList{field.Required(refPath, "cannot be nil")}
+	}
+
+	var allErrs field.ErrorList
+
+	if ref.Group != ngfAPI.GroupName {
+		allErrs = append(allErrs, field.NotSupported(refPath.Child("group"), ref.Group, []string{ngfAPI.GroupName}))
+	}
+
+	switch ref.Kind {
+	case kinds.SnippetsFilter,
+		kinds.RateLimitFilter,
+		kinds.ResponseHeaderFilter,
+		kinds.QueryParameterFilter,
+		kinds.ABTestFilter:
+	default:
+		allErrs = append(allErrs, field.NotSupported(refPath.Child("kind"), ref.Kind, supportedExtensionRefFilterKinds))
+	}
+
+	return allErrs
+}
+
+// validateExtensionRefFilterCounts validates that a rule references at most one filter of each of the
+// singleExtensionRefFilterKinds: NGINX rejects the requests above the limit of any RateLimitFilter with
+// a single status code, and splits the requests between the backends of the rule by a single ABTestFilter.
+func validateExtensionRefFilterCounts(filters []v1.HTTPRouteFilter, rulePath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, kind := range singleExtensionRefFilterKinds {
+		var count int
+
+		for _, f := range filters {
+			if f.Type == v1.HTTPRouteFilterExtensionRef && f.ExtensionRef != nil && f.ExtensionRef.Kind == kind {
+				count++
+			}
+		}
+
+		if count > 1 {
+			allErrs = append(allErrs, field.TooMany(rulePath.Child("filters"), count, 1))
+		}
+	}
+
+	return allErrs
+}
+
+// resolveExtensionRefFilters resolves the NGF filter resources that the extensionRef filters of the HTTPRoute rules
+// reference. A rule with a filter that references a missing or invalid filter resource has invalid filters,
+// so that NGINX returns a 500 error for its requests.
+func resolveExtensionRefFilters(
+	routes map[RouteKey]*L7Route,
+	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	rateLimitFilters map[types.NamespacedName]*RateLimitFilter,
+	responseHeaderFilters map[types.NamespacedName]*ResponseHeaderFilter,
+	queryParameterFilters map[types.NamespacedName]*QueryParameterFilter,
+	abTestFilters map[types.NamespacedName]*ABTestFilter,
+) {
+	for _, route := range routes {
+		if !route.Valid || route.RouteType != RouteTypeHTTP {
+			continue
+		}
+
+		routeNs := route.Source.GetNamespace()
+
+		for i := range route.Spec.Rules {
+			rule := &route.Spec.Rules[i]
+			if !rule.ValidFilters {
+				continue
+			}
+
+			resolved, err := resolveRuleExtensionRefFilters(
+				rule.Filters,
+				routeNs,
+				field.NewPath("spec").Child("rules").Index(i),
+				snippetsFilters,
+				rateLimitFilters,
+				responseHeaderFilters,
+				queryParameterFilters,
+				abTestFilters,
+			)
+			if err != nil {
+				rule.ValidFilters = false
+				route.Conditions = append(route.Conditions, staticConds.NewRouteResolvedRefsInvalidFilter(err.Error()))
+
+				continue
+			}
+
+			rule.ExtensionRefFilters = resolved
+		}
+	}
+}
+
+func resolveRuleExtensionRefFilters(
+	filters []v1.HTTPRouteFilter,
+	routeNs string,
+	rulePath *field.Path,
+	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	rateLimitFilters map[types.NamespacedName]*RateLimitFilter,
+	responseHeaderFilters map[types.NamespacedName]*ResponseHeaderFilter,
+	queryParameterFilters map[types.NamespacedName]*QueryParameterFilter,
+	abTestFilters map[types.NamespacedName]*ABTestFilter,
+) ([]ExtensionRefFilter, error) {
+	var resolved []ExtensionRefFilter
+
+	for j, f := range filters {
+		if f.Type != v1.HTTPRouteFilterExtensionRef || f.ExtensionRef == nil {
+			continue
+		}
+
+		refPath := rulePath.Child("filters").Index(j).Child("extensionRef")
+		nsname := types.NamespacedName{Namespace: routeNs, Name: string(f.ExtensionRef.Name)}
+
+		switch f.ExtensionRef.Kind {
+		case kinds.SnippetsFilter:
+			sf, exists := snippetsFilters[nsname]
+			if !exists {
+				return nil, field.NotFound(refPath, fmt.Sprintf("%s %s", kinds.SnippetsFilter, nsname))
+			}
+
+			sf.Referenced = true
+
+			if !sf.Valid {
+				return nil, field.Invalid(refPath, nsname.String(), "referenced SnippetsFilter is invalid")
+			}
+
+			resolved = append(resolved, ExtensionRefFilter{SnippetsFilter: sf})
+		case kinds.RateLimitFilter:
+			rlf, exists := rateLimitFilters[nsname]
+			if !exists {
+				return nil, field.NotFound(refPath, fmt.Sprintf("%s %s", kinds.RateLimitFilter, nsname))
+			}
+
+			rlf.Referenced = true
+
+			if !rlf.Valid {
+				return nil, field.Invalid(refPath, nsname.String(), "referenced RateLimitFilter is invalid")
+			}
+
+			resolved = append(resolved, ExtensionRefFilter{RateLimitFilter: rlf})
+		case kinds.ResponseHeaderFilter:
+			rhf, exists := responseHeaderFilters[nsname]
+			if !exists {
+				return nil, field.NotFound(refPath, fmt.Sprintf("%s %s", kinds.ResponseHeaderFilter, nsname))
+			}
+
+			rhf.Referenced = true
+
+			if !rhf.Valid {
+				return nil, field.Invalid(refPath, nsname.String(), "referenced ResponseHeaderFilter is invalid")
+			}
+
+			resolved = append(resolved, ExtensionRefFilter{ResponseHeaderFilter: rhf})
+		case kinds.QueryParameterFilter:
+			qpf, exists := queryParameterFilters[nsname]
+			if !exists {
+				return nil, field.NotFound(refPath, fmt.Sprintf("%s %s", kinds.QueryParameterFilter, nsname))
+			}
+
+			qpf.Referenced = true
+
+			if !qpf.Valid {
+				return nil, field.Invalid(refPath, nsname.String(), "referenced QueryParameterFilter is invalid")
+			}
+
+			resolved = append(resolved, ExtensionRefFilter{QueryParameterFilter: qpf})
+		case kinds.ABTestFilter:
+			abf, exists := abTestFilters[nsname]
+			if !exists {
+				return nil, field.NotFound(refPath, fmt.Sprintf("%s %s", kinds.ABTestFilter, nsname))
+			}
+
+			abf.Referenced = true
+
+			if !abf.Valid {
+				return nil, field.Invalid(refPath, nsname.String(), "referenced ABTestFilter is invalid")
+			}
+
+			resolved = append(resolved, ExtensionRefFilter{ABTestFilter: abf})
+		}
+	}
+
+	return resolved, nil
+}
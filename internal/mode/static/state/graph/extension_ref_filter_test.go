@@ -0,0 +1,618 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestProcessSnippetsFilter(t *testing.T) {
+	t.Parallel()
+
+	createFilter := func(snippets ...ngfAPI.Snippet) *ngfAPI.SnippetsFilter {
+		return &ngfAPI.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "snippets"},
+			Spec:       ngfAPI.SnippetsFilterSpec{Snippets: snippets},
+		}
+	}
+
+	tests := []struct {
+		filter   *ngfAPI.SnippetsFilter
+		expected *SnippetsFilter
+		name     string
+	}{
+		{
+			name: "valid",
+			filter: createFilter(
+				ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTP, Value: "log_format custom '$request';"},
+				ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTPServerLocation, Value: "add_header X-Snippet on;"},
+			),
+			expected: &SnippetsFilter{
+				Snippets: map[ngfAPI.NginxContext]string{
+					ngfAPI.NginxContextHTTP:               "log_format custom '$request';",
+					ngfAPI.NginxContextHTTPServerLocation: "add_header X-Snippet on;",
+				},
+				Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+				Valid:      true,
+			},
+		},
+		{
+			name: "invalid",
+			filter: createFilter(
+				ngfAPI.Snippet{Context: "main", Value: "worker_processes 1;"},
+				ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTPServer, Value: "add_header X-Snippet on;"},
+				ngfAPI.Snippet{Context: ngfAPI.NginxContextHTTPServer, Value: "add_header X-Duplicate on;"},
+			),
+			expected: &SnippetsFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewFilterInvalid(
+						"[spec.snippets[0].context: Unsupported value: \"main\": supported values: " +
+							"\"http\", \"http.server\", \"http.server.location\", " +
+							"spec.snippets[2].context: Duplicate value: \"http.server\"]",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			test.expected.Source = test.filter
+
+			g.Expect(processSnippetsFilter(test.filter)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestProcessRateLimitFilter(t *testing.T) {
+	t.Parallel()
+
+	createFilter := func(spec ngfAPI.RateLimitFilterSpec) *ngfAPI.RateLimitFilter {
+		return &ngfAPI.RateLimitFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "rate-limit"},
+			Spec:       spec,
+		}
+	}
+
+	invalidSizeValidator := &validationfakes.FakeGenericValidator{}
+	invalidSizeValidator.ValidateNginxSizeReturns(errors.New("invalid size"))
+
+	tests := []struct {
+		filter    *ngfAPI.RateLimitFilter
+		validator *validationfakes.FakeGenericValidator
+		expected  *RateLimitFilter
+		name      string
+	}{
+		{
+			name: "valid",
+			filter: createFilter(ngfAPI.RateLimitFilterSpec{
+				Key:        helpers.GetPointer("$http_x_api_key"),
+				ZoneSize:   helpers.GetPointer[ngfAPI.Size]("1m"),
+				Burst:      helpers.GetPointer[int32](5),
+				NoDelay:    helpers.GetPointer(true),
+				RejectCode: helpers.GetPointer[int32](429),
+				Rate:       "10r/s",
+			}),
+			validator: &validationfakes.FakeGenericValidator{},
+			expected: &RateLimitFilter{
+				Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+				Valid:      true,
+			},
+		},
+		{
+			name: "invalid",
+			filter: createFilter(ngfAPI.RateLimitFilterSpec{
+				Key:        helpers.GetPointer("$http_x_api_key;"),
+				ZoneSize:   helpers.GetPointer[ngfAPI.Size]("1x"),
+				RejectCode: helpers.GetPointer[int32](200),
+				Rate:       "10r/h",
+			}),
+			validator: invalidSizeValidator,
+			expected: &RateLimitFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewFilterInvalid(
+						"[spec.rate: Invalid value: \"10r/h\": must be a number of requests per second or minute, " +
+							"for example, 10r/s or 30r/m, " +
+							"spec.key: Invalid value: \"$http_x_api_key;\": must not be empty or contain " +
+							"'\"', ';', '{', '}', whitespace, or end with an unescaped '\\', " +
+							"spec.zoneSize: Invalid value: \"1x\": invalid size, " +
+							"spec.rejectCode: Invalid value: 200: must be between 400 and 599]",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			test.expected.Source = test.filter
+
+			g.Expect(processRateLimitFilter(test.filter, test.validator)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestProcessResponseHeaderFilter(t *testing.T) {
+	t.Parallel()
+
+	createFilter := func(headers ...ngfAPI.ConditionalResponseHeader) *ngfAPI.ResponseHeaderFilter {
+		return &ngfAPI.ResponseHeaderFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "response-headers"},
+			Spec:       ngfAPI.ResponseHeaderFilterSpec{Headers: headers},
+		}
+	}
+
+	invalidValueValidator := &validationfakes.FakeHTTPFieldsValidator{}
+	invalidValueValidator.ValidateFilterHeaderValueReturns(errors.New("invalid value"))
+
+	tests := []struct {
+		filter    *ngfAPI.ResponseHeaderFilter
+		validator *validationfakes.FakeHTTPFieldsValidator
+		expected  *ResponseHeaderFilter
+		name      string
+	}{
+		{
+			name: "valid",
+			filter: createFilter(
+				ngfAPI.ConditionalResponseHeader{
+					Name:  "Cache-Control",
+					Value: "max-age=60",
+					When:  ngfAPI.ResponseCondition{StatusCodes: []ngfAPI.StatusCode{200, 204}},
+				},
+				ngfAPI.ConditionalResponseHeader{
+					Name:  "X-Cache-Default",
+					Value: "true",
+					When: ngfAPI.ResponseCondition{
+						Header: &ngfAPI.ResponseHeaderMatch{
+							Name: "Cache-Control",
+							Type: ngfAPI.ResponseHeaderMatchAbsent,
+						},
+					},
+				},
+			),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			expected: &ResponseHeaderFilter{
+				Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+				Valid:      true,
+			},
+		},
+		{
+			name: "invalid",
+			filter: createFilter(
+				ngfAPI.ConditionalResponseHeader{
+					Name:  "Server",
+					Value: "value",
+					When:  ngfAPI.ResponseCondition{StatusCodes: []ngfAPI.StatusCode{600}},
+				},
+				ngfAPI.ConditionalResponseHeader{
+					Name:  "X-Header",
+					Value: "value",
+					When: ngfAPI.ResponseCondition{
+						Header: &ngfAPI.ResponseHeaderMatch{
+							Name: "X-Upstream",
+							Type: ngfAPI.ResponseHeaderMatchExact,
+						},
+					},
+				},
+				ngfAPI.ConditionalResponseHeader{
+					Name:  "X-Header",
+					Value: "value",
+				},
+			),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			expected: &ResponseHeaderFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewFilterInvalid(
+						"[spec.headers[0].name: Invalid value: \"Server\": header name is not allowed, " +
+							"spec.headers[0].when.statusCodes[0]: Invalid value: 600: must be between 100 and 599, " +
+							"spec.headers[1].when.header.value: Required value: required if type is Exact, " +
+							"spec.headers[2].when: Required value: at least one of statusCodes or header must be specified]",
+					),
+				},
+			},
+		},
+		{
+			name: "invalid header value",
+			filter: createFilter(
+				ngfAPI.ConditionalResponseHeader{
+					Name:  "X-Header",
+					Value: "$value",
+					When:  ngfAPI.ResponseCondition{StatusCodes: []ngfAPI.StatusCode{200}},
+				},
+			),
+			validator: invalidValueValidator,
+			expected: &ResponseHeaderFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewFilterInvalid(
+						"spec.headers[0].value: Invalid value: \"$value\": invalid value",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			test.expected.Source = test.filter
+
+			g.Expect(processResponseHeaderFilter(test.filter, test.validator)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestProcessQueryParameterFilter(t *testing.T) {
+	t.Parallel()
+
+	createFilter := func(spec ngfAPI.QueryParameterFilterSpec) *ngfAPI.QueryParameterFilter {
+		return &ngfAPI.QueryParameterFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "query-parameters"},
+			Spec:       spec,
+		}
+	}
+
+	tests := []struct {
+		filter   *ngfAPI.QueryParameterFilter
+		expected *QueryParameterFilter
+		name     string
+	}{
+		{
+			name: "valid",
+			filter: createFilter(ngfAPI.QueryParameterFilterSpec{
+				Set:    []ngfAPI.QueryParameter{{Name: "version", Value: "v2"}},
+				Add:    []ngfAPI.QueryParameter{{Name: "tags[]", Value: "a b"}},
+				Remove: []ngfAPI.QueryParameterName{"utm_source", "utm_medium"},
+			}),
+			expected: &QueryParameterFilter{
+				Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+				Valid:      true,
+			},
+		},
+		{
+			name:   "empty",
+			filter: createFilter(ngfAPI.QueryParameterFilterSpec{}),
+			expected: &QueryParameterFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewFilterInvalid(
+						"spec: Required value: at least one of set, add, or remove must be specified",
+					),
+				},
+			},
+		},
+		{
+			name: "invalid",
+			filter: createFilter(ngfAPI.QueryParameterFilterSpec{
+				Set:    []ngfAPI.QueryParameter{{Name: "version", Value: "v1"}, {Name: "version", Value: "v2"}},
+				Add:    []ngfAPI.QueryParameter{{Name: "a&b", Value: "c"}},
+				Remove: []ngfAPI.QueryParameterName{"utm_source", "utm_source", ""},
+			}),
+			expected: &QueryParameterFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewFilterInvalid(
+						"[spec.set[1].name: Duplicate value: \"version\", " +
+							"spec.add[0].name: Invalid value: \"a&b\": must not be empty and must only contain " +
+							"alphanumeric characters, '-', '.', '_', '~', '[', or ']', " +
+							"spec.remove[1]: Duplicate value: \"utm_source\", " +
+							"spec.remove[2]: Invalid value: \"\": must not be empty and must only contain " +
+							"alphanumeric characters, '-', '.', '_', '~', '[', or ']']",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			test.expected.Source = test.filter
+
+			g.Expect(processQueryParameterFilter(test.filter)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestProcessABTestFilter(t *testing.T) {
+	t.Parallel()
+
+	createFilter := func(spec ngfAPI.ABTestFilterSpec) *ngfAPI.ABTestFilter {
+		return &ngfAPI.ABTestFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "ab-test"},
+			Spec:       spec,
+		}
+	}
+
+	invalidHeaderValidator := &validationfakes.FakeHTTPFieldsValidator{}
+	invalidHeaderValidator.ValidateFilterHeaderNameReturns(errors.New("invalid header"))
+
+	tests := []struct {
+		filter    *ngfAPI.ABTestFilter
+		validator *validationfakes.FakeHTTPFieldsValidator
+		expected  *ABTestFilter
+		name      string
+	}{
+		{
+			name: "valid",
+			filter: createFilter(ngfAPI.ABTestFilterSpec{
+				OverrideHeader: helpers.GetPointer[v1.HTTPHeaderName]("X-Variant"),
+				Cookie: ngfAPI.ABTestCookie{
+					Name:   "ngf_variant",
+					Path:   helpers.GetPointer("/coffee"),
+					MaxAge: helpers.GetPointer[ngfAPI.Duration]("24h"),
+				},
+			}),
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			expected: &ABTestFilter{
+				Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+				Valid:      true,
+			},
+		},
+		{
+			name: "invalid",
+			filter: createFilter(ngfAPI.ABTestFilterSpec{
+				OverrideHeader: helpers.GetPointer[v1.HTTPHeaderName]("X-Variant"),
+				Cookie: ngfAPI.ABTestCookie{
+					Name:   "ngf-variant",
+					Path:   helpers.GetPointer("coffee"),
+					MaxAge: helpers.GetPointer[ngfAPI.Duration]("1d"),
+				},
+			}),
+			validator: invalidHeaderValidator,
+			expected: &ABTestFilter{
+				Conditions: []conditions.Condition{
+					staticConds.NewFilterInvalid(
+						"[spec.overrideHeader: Invalid value: \"X-Variant\": invalid header, " +
+							"spec.cookie.name: Invalid value: \"ngf-variant\": must not be empty and must only contain " +
+							"alphanumeric characters or '_', " +
+							"spec.cookie.path: Invalid value: \"coffee\": must start with '/' and must only contain " +
+							"alphanumeric characters, '-', '.', '_', '~', or '/', " +
+							"spec.cookie.maxAge: Invalid value: \"1d\": must be a duration in milliseconds (ms), " +
+							"seconds (s), minutes (m), or hours (h)]",
+					),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			test.expected.Source = test.filter
+
+			g.Expect(processABTestFilter(test.filter, test.validator)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestValidateExtensionRefFilterCounts(t *testing.T) {
+	t.Parallel()
+
+	rateLimitFilter := v1.HTTPRouteFilter{
+		Type: v1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kinds.RateLimitFilter,
+			Name:  "rate-limit",
+		},
+	}
+
+	snippetsFilter := v1.HTTPRouteFilter{
+		Type: v1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kinds.SnippetsFilter,
+			Name:  "snippets",
+		},
+	}
+
+	abTestFilter := v1.HTTPRouteFilter{
+		Type: v1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1.LocalObjectReference{
+			Group: ngfAPI.GroupName,
+			Kind:  kinds.ABTestFilter,
+			Name:  "ab-test",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		filters        []v1.HTTPRouteFilter
+		expectErrCount int
+	}{
+		{
+			name:    "one rate limit filter and one ab test filter",
+			filters: []v1.HTTPRouteFilter{rateLimitFilter, abTestFilter, snippetsFilter, snippetsFilter},
+		},
+		{
+			name:           "multiple rate limit filters",
+			filters:        []v1.HTTPRouteFilter{rateLimitFilter, snippetsFilter, rateLimitFilter},
+			expectErrCount: 1,
+		},
+		{
+			name:           "multiple ab test filters",
+			filters:        []v1.HTTPRouteFilter{abTestFilter, rateLimitFilter, abTestFilter},
+			expectErrCount: 1,
+		},
+		{
+			name:           "multiple rate limit and ab test filters",
+			filters:        []v1.HTTPRouteFilter{abTestFilter, rateLimitFilter, abTestFilter, rateLimitFilter},
+			expectErrCount: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateExtensionRefFilterCounts(test.filters, field.NewPath("test"))
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestResolveExtensionRefFilters(t *testing.T) {
+	t.Parallel()
+
+	createExtensionRefFilter := func(kind v1.Kind, name string) v1.HTTPRouteFilter {
+		return v1.HTTPRouteFilter{
+			Type: v1.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &v1.LocalObjectReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kind,
+				Name:  v1.ObjectName(name),
+			},
+		}
+	}
+
+	headerFilter := v1.HTTPRouteFilter{
+		Type:                  v1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &v1.HTTPHeaderFilter{},
+	}
+
+	createSnippetsFilters := func() map[types.NamespacedName]*SnippetsFilter {
+		return map[types.NamespacedName]*SnippetsFilter{
+			{Namespace: "test", Name: "snippets"}: {Valid: true},
+			{Namespace: "test", Name: "invalid"}:  {},
+			{Namespace: "other", Name: "other"}:   {Valid: true},
+		}
+	}
+
+	createRateLimitFilters := func() map[types.NamespacedName]*RateLimitFilter {
+		return map[types.NamespacedName]*RateLimitFilter{
+			{Namespace: "test", Name: "rate-limit"}: {Valid: true},
+		}
+	}
+
+	createResponseHeaderFilters := func() map[types.NamespacedName]*ResponseHeaderFilter {
+		return map[types.NamespacedName]*ResponseHeaderFilter{
+			{Namespace: "test", Name: "response-headers"}: {Valid: true},
+		}
+	}
+
+	createQueryParameterFilters := func() map[types.NamespacedName]*QueryParameterFilter {
+		return map[types.NamespacedName]*QueryParameterFilter{
+			{Namespace: "test", Name: "query-parameters"}: {Valid: true},
+		}
+	}
+
+	createABTestFilters := func() map[types.NamespacedName]*ABTestFilter {
+		return map[types.NamespacedName]*ABTestFilter{
+			{Namespace: "test", Name: "ab-test"}: {Valid: true},
+		}
+	}
+
+	createRoute := func(filters ...v1.HTTPRouteFilter) *L7Route {
+		return &L7Route{
+			Source:    &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route"}},
+			RouteType: RouteTypeHTTP,
+			Valid:     true,
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{
+					{Filters: filters, ValidMatches: true, ValidFilters: true},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		route              *L7Route
+		expSnippetsRefs    map[types.NamespacedName]bool
+		name               string
+		expConditions      []conditions.Condition
+		expExtensionRefLen int
+		expValidFilters    bool
+	}{
+		{
+			name: "resolved filters",
+			route: createRoute(
+				headerFilter,
+				createExtensionRefFilter(kinds.SnippetsFilter, "snippets"),
+				createExtensionRefFilter(kinds.RateLimitFilter, "rate-limit"),
+				createExtensionRefFilter(kinds.ResponseHeaderFilter, "response-headers"),
+				createExtensionRefFilter(kinds.QueryParameterFilter, "query-parameters"),
+				createExtensionRefFilter(kinds.ABTestFilter, "ab-test"),
+			),
+			expSnippetsRefs: map[types.NamespacedName]bool{
+				{Namespace: "test", Name: "snippets"}: true,
+			},
+			expExtensionRefLen: 5,
+			expValidFilters:    true,
+		},
+		{
+			name:  "missing filter",
+			route: createRoute(createExtensionRefFilter(kinds.SnippetsFilter, "other")),
+			expConditions: []conditions.Condition{
+				staticConds.NewRouteResolvedRefsInvalidFilter(
+					"spec.rules[0].filters[0].extensionRef: Not found: \"SnippetsFilter test/other\"",
+				),
+			},
+		},
+		{
+			name:  "invalid filter",
+			route: createRoute(createExtensionRefFilter(kinds.SnippetsFilter, "invalid")),
+			expSnippetsRefs: map[types.NamespacedName]bool{
+				{Namespace: "test", Name: "invalid"}: true,
+			},
+			expConditions: []conditions.Condition{
+				staticConds.NewRouteResolvedRefsInvalidFilter(
+					"spec.rules[0].filters[0].extensionRef: Invalid value: \"test/invalid\": " +
+						"referenced SnippetsFilter is invalid",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			snippetsFilters := createSnippetsFilters()
+			routes := map[RouteKey]*L7Route{
+				CreateRouteKey(test.route.Source): test.route,
+			}
+
+			resolveExtensionRefFilters(
+				routes,
+				snippetsFilters,
+				createRateLimitFilters(),
+				createResponseHeaderFilters(),
+				createQueryParameterFilters(),
+				createABTestFilters(),
+			)
+
+			rule := test.route.Spec.Rules[0]
+			g.Expect(rule.ValidFilters).To(Equal(test.expValidFilters))
+			g.Expect(rule.ExtensionRefFilters).To(HaveLen(test.expExtensionRefLen))
+			g.Expect(test.route.Conditions).To(Equal(test.expConditions))
+
+			for nsname, filter := range snippetsFilters {
+				g.Expect(filter.Referenced).To(Equal(test.expSnippetsRefs[nsname]))
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+// ExternalCertificate is a TLS certificate and key of a certificate source other than Kubernetes Secrets,
+// such as the files of a Secrets Store CSI driver volume. Listeners reference an ExternalCertificate
+// by its name with a certificateRef of the ExternalCertificate kind.
+type ExternalCertificate struct {
+	// Cert is the PEM-encoded certificate chain.
+	Cert []byte
+	// Key is the PEM-encoded private key.
+	Key []byte
+}
+
+type externalCertificateEntry struct {
+	// cert is nil if the certificate does not exist.
+	cert *ExternalCertificate
+	// err holds the corresponding error if the certificate is invalid or does not exist.
+	err error
+}
+
+// externalCertificateResolver wraps the ExternalCertificates so that they can be resolved (includes validation).
+// All resolved ExternalCertificates are saved to be used later.
+type externalCertificateResolver struct {
+	certs         map[string]*ExternalCertificate
+	resolvedCerts map[string]*externalCertificateEntry
+}
+
+func newExternalCertificateResolver(certs map[string]*ExternalCertificate) *externalCertificateResolver {
+	return &externalCertificateResolver{
+		certs:         certs,
+		resolvedCerts: make(map[string]*externalCertificateEntry),
+	}
+}
+
+func (r *externalCertificateResolver) resolve(name string) error {
+	if entry, resolved := r.resolvedCerts[name]; resolved {
+		return entry.err
+	}
+
+	cert, exist := r.certs[name]
+
+	var validationErr error
+
+	if !exist {
+		validationErr = errors.New("certificate does not exist in the certificate source")
+	} else if err := validateCertificate(cert.Cert, cert.Key, time.Now()); err != nil {
+		validationErr = fmt.Errorf("certificate is invalid: %w", err)
+	}
+
+	r.resolvedCerts[name] = &externalCertificateEntry{
+		cert: cert,
+		err:  validationErr,
+	}
+
+	return validationErr
+}
+
+func (r *externalCertificateResolver) getResolvedCertificates() map[string]*ExternalCertificate {
+	resolved := make(map[string]*ExternalCertificate)
+
+	for name, entry := range r.resolvedCerts {
+		if entry.err == nil {
+			resolved[name] = entry.cert
+		}
+	}
+
+	if len(resolved) == 0 {
+		return nil
+	}
+
+	return resolved
+}
+
+// isExternalCertificateRef returns whether a certificateRef references an ExternalCertificate.
+func isExternalCertificateRef(ref v1.SecretObjectReference) bool {
+	return ref.Group != nil && *ref.Group == ngfAPI.GroupName &&
+		ref.Kind != nil && *ref.Kind == kinds.ExternalCertificate
+}
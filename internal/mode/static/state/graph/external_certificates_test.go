@@ -0,0 +1,118 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+)
+
+func TestExternalCertificateResolver(t *testing.T) {
+	t.Parallel()
+
+	validCert := &ExternalCertificate{
+		Cert: cert,
+		Key:  key,
+	}
+	invalidExternalCert := &ExternalCertificate{
+		Cert: invalidCert,
+		Key:  key,
+	}
+
+	resolver := newExternalCertificateResolver(map[string]*ExternalCertificate{
+		"cafe":    validCert,
+		"invalid": invalidExternalCert,
+	})
+
+	tests := []struct {
+		name           string
+		certName       string
+		expectedErrStr string // prefix of the error
+	}{
+		{
+			name:     "valid certificate",
+			certName: "cafe",
+		},
+		{
+			name:     "valid certificate, again",
+			certName: "cafe",
+		},
+		{
+			name:           "invalid certificate",
+			certName:       "invalid",
+			expectedErrStr: "certificate is invalid: ",
+		},
+		{
+			name:           "non-existing certificate",
+			certName:       "does-not-exist",
+			expectedErrStr: "certificate does not exist in the certificate source",
+		},
+	}
+
+	// Not running tests with t.Run(...) because the last one (getResolvedCertificates) depends on the execution of
+	// all cases.
+
+	g := NewWithT(t)
+
+	for _, test := range tests {
+		err := resolver.resolve(test.certName)
+		if test.expectedErrStr == "" {
+			g.Expect(err).ToNot(HaveOccurred(), "case %q", test.name)
+		} else {
+			g.Expect(err).To(MatchError(HavePrefix(test.expectedErrStr)), "case %q", test.name)
+		}
+	}
+
+	g.Expect(resolver.getResolvedCertificates()).To(Equal(map[string]*ExternalCertificate{"cafe": validCert}))
+	g.Expect(newExternalCertificateResolver(nil).getResolvedCertificates()).To(BeNil())
+}
+
+func TestIsExternalCertificateRef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ref      v1.SecretObjectReference
+		name     string
+		expected bool
+	}{
+		{
+			name: "external certificate",
+			ref: v1.SecretObjectReference{
+				Group: helpers.GetPointer[v1.Group](ngfAPI.GroupName),
+				Kind:  helpers.GetPointer[v1.Kind](kinds.ExternalCertificate),
+				Name:  "cafe",
+			},
+			expected: true,
+		},
+		{
+			name: "secret",
+			ref: v1.SecretObjectReference{
+				Kind: helpers.GetPointer[v1.Kind]("Secret"),
+				Name: "cafe",
+			},
+			expected: false,
+		},
+		{
+			name: "external certificate kind with core group",
+			ref: v1.SecretObjectReference{
+				Group: helpers.GetPointer[v1.Group](""),
+				Kind:  helpers.GetPointer[v1.Kind](kinds.ExternalCertificate),
+				Name:  "cafe",
+			},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(isExternalCertificateRef(test.ref)).To(Equal(test.expected))
+		})
+	}
+}
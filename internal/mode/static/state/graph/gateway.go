@@ -2,13 +2,16 @@ package graph
 
 import (
 	"sort"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
@@ -23,6 +26,16 @@ type Gateway struct {
 	Conditions []conditions.Condition
 	// Policies holds the policies attached to the Gateway.
 	Policies []*Policy
+	// EffectivePolicies holds the effective policies of the Gateway, which merge the valid policies of the same kind
+	// attached to the Gateway.
+	EffectivePolicies []policies.Policy
+	// ErrorLogLevel is the level of the NGINX error log that the Gateway sets with the
+	// gateway.nginx.org/error-log-level annotation. It is empty if the annotation is not set or invalid.
+	ErrorLogLevel ngfAPI.NginxErrorLogLevel
+	// HTTPSRedirectListeners are the names of the HTTPS listeners whose hostnames are redirected from HTTP to HTTPS,
+	// which the Gateway sets with the gateway.nginx.org/https-redirect-listeners annotation.
+	// It is nil if the annotation is not set or invalid.
+	HTTPSRedirectListeners []string
 	// Valid indicates whether the Gateway Spec is valid.
 	Valid bool
 }
@@ -95,6 +108,7 @@ func processGateways(
 func buildGateway(
 	gw *v1.Gateway,
 	secretResolver *secretResolver,
+	externalCertResolver *externalCertificateResolver,
 	gc *GatewayClass,
 	refGrantResolver *referenceGrantResolver,
 	protectedPorts ProtectedPorts,
@@ -105,19 +119,86 @@ func buildGateway(
 
 	conds := validateGateway(gw, gc)
 
+	var unsupportedFields []string
+	if gw.Spec.Infrastructure != nil {
+		unsupportedFields = append(unsupportedFields, field.NewPath("spec", "infrastructure").String())
+	}
+
+	errorLogLevel, valid := getErrorLogLevelOverride(gw)
+	if !valid {
+		annotationPath := field.NewPath("metadata", "annotations").Key(ngfAPI.ErrorLogLevelAnnotation)
+		unsupportedFields = append(unsupportedFields, annotationPath.String())
+	}
+
+	httpsRedirectListeners, valid := getHTTPSRedirectListeners(gw)
+	if !valid {
+		annotationPath := field.NewPath("metadata", "annotations").Key(ngfAPI.HTTPSRedirectListenersAnnotation)
+		unsupportedFields = append(unsupportedFields, annotationPath.String())
+	}
+
+	var unsupportedFieldConds []conditions.Condition
+	if len(unsupportedFields) > 0 {
+		unsupportedFieldConds = append(unsupportedFieldConds, staticConds.NewGatewayUnsupportedField(unsupportedFields))
+	}
+
 	if len(conds) > 0 {
 		return &Gateway{
 			Source:     gw,
 			Valid:      false,
-			Conditions: conds,
+			Conditions: append(conds, unsupportedFieldConds...),
 		}
 	}
 
 	return &Gateway{
-		Source:    gw,
-		Listeners: buildListeners(gw, secretResolver, refGrantResolver, protectedPorts),
-		Valid:     true,
+		Source:                 gw,
+		Listeners:              buildListeners(gw, secretResolver, externalCertResolver, refGrantResolver, protectedPorts),
+		Conditions:             unsupportedFieldConds,
+		ErrorLogLevel:          errorLogLevel,
+		HTTPSRedirectListeners: httpsRedirectListeners,
+		Valid:                  true,
+	}
+}
+
+// getHTTPSRedirectListeners returns the names of the listeners of the gateway.nginx.org/https-redirect-listeners
+// annotation of the Gateway, and false if the annotation has a name that is not the name of an HTTPS listener
+// of the Gateway.
+func getHTTPSRedirectListeners(gw *v1.Gateway) ([]string, bool) {
+	value, exists := gw.Annotations[ngfAPI.HTTPSRedirectListenersAnnotation]
+	if !exists {
+		return nil, true
+	}
+
+	httpsListeners := make(map[string]struct{})
+	for _, l := range gw.Spec.Listeners {
+		if l.Protocol == v1.HTTPSProtocolType {
+			httpsListeners[string(l.Name)] = struct{}{}
+		}
+	}
+
+	names := strings.Split(value, ",")
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+		if _, ok := httpsListeners[names[i]]; !ok {
+			return nil, false
+		}
+	}
+
+	return names, true
+}
+
+// getErrorLogLevelOverride returns the level of the NGINX error log of the gateway.nginx.org/error-log-level
+// annotation of the Gateway, and false if the annotation has an unsupported level.
+func getErrorLogLevelOverride(gw *v1.Gateway) (ngfAPI.NginxErrorLogLevel, bool) {
+	level, exists := gw.Annotations[ngfAPI.ErrorLogLevelAnnotation]
+	if !exists {
+		return "", true
 	}
+
+	if !isSupportedErrorLogLevel(level) {
+		return "", false
+	}
+
+	return ngfAPI.NginxErrorLogLevel(level), true
 }
 
 func validateGateway(gw *v1.Gateway, gc *GatewayClass) []conditions.Condition {
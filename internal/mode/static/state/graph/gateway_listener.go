@@ -14,6 +14,7 @@ import (
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
@@ -33,10 +34,18 @@ type Listener struct {
 	// ResolvedSecret is the namespaced name of the Secret resolved for this listener.
 	// Only applicable for HTTPS listeners.
 	ResolvedSecret *types.NamespacedName
+	// ResolvedExternalCertificate is the name of the ExternalCertificate resolved for this listener.
+	// Only applicable for HTTPS listeners that reference an ExternalCertificate instead of a Secret.
+	ResolvedExternalCertificate *string
 	// Conditions holds the conditions of the Listener.
 	Conditions []conditions.Condition
 	// SupportedKinds is the list of RouteGroupKinds allowed by the listener.
 	SupportedKinds []v1.RouteGroupKind
+	// Policies holds the policies attached to the Listener with the sectionName of their targetRef.
+	Policies []*Policy
+	// EffectivePolicies holds the effective policies of the server blocks of the Listener, in which the valid policies
+	// attached to the Listener override the valid policies of the same kind attached to the Gateway.
+	EffectivePolicies []policies.Policy
 	// Valid shows whether the Listener is valid.
 	// A Listener is considered valid if NGF can generate valid NGINX configuration for it.
 	Valid bool
@@ -48,12 +57,19 @@ type Listener struct {
 func buildListeners(
 	gw *v1.Gateway,
 	secretResolver *secretResolver,
+	externalCertResolver *externalCertificateResolver,
 	refGrantResolver *referenceGrantResolver,
 	protectedPorts ProtectedPorts,
 ) []*Listener {
 	listeners := make([]*Listener, 0, len(gw.Spec.Listeners))
 
-	listenerFactory := newListenerConfiguratorFactory(gw, secretResolver, refGrantResolver, protectedPorts)
+	listenerFactory := newListenerConfiguratorFactory(
+		gw,
+		secretResolver,
+		externalCertResolver,
+		refGrantResolver,
+		protectedPorts,
+	)
 
 	for _, gl := range gw.Spec.Listeners {
 		configurator := listenerFactory.getConfiguratorForListener(gl)
@@ -83,6 +99,7 @@ func (f *listenerConfiguratorFactory) getConfiguratorForListener(l v1.Listener)
 func newListenerConfiguratorFactory(
 	gw *v1.Gateway,
 	secretResolver *secretResolver,
+	externalCertResolver *externalCertificateResolver,
 	refGrantResolver *referenceGrantResolver,
 	protectedPorts ProtectedPorts,
 ) *listenerConfiguratorFactory {
@@ -123,7 +140,12 @@ func newListenerConfiguratorFactory(
 				sharedPortConflictResolver,
 			},
 			externalReferenceResolvers: []listenerExternalReferenceResolver{
-				createExternalReferencesForTLSSecretsResolver(gw.Namespace, secretResolver, refGrantResolver),
+				createExternalReferencesForTLSSecretsResolver(
+					gw.Namespace,
+					secretResolver,
+					externalCertResolver,
+					refGrantResolver,
+				),
 			},
 		},
 		tls: &listenerConfigurator{
@@ -408,17 +430,36 @@ func createHTTPSListenerValidator(protectedPorts ProtectedPorts) listenerValidat
 
 		certRefPath := tlsPath.Child("certificateRefs").Index(0)
 
-		if certRef.Kind != nil && *certRef.Kind != "Secret" {
-			path := certRefPath.Child("kind")
-			valErr := field.NotSupported(path, *certRef.Kind, []string{"Secret"})
-			conds = append(conds, staticConds.NewListenerInvalidCertificateRef(valErr.Error())...)
-		}
+		if isExternalCertificateRef(certRef) {
+			// The ExternalCertificates of the certificate source don't belong to a namespace.
+			if certRef.Namespace != nil && *certRef.Namespace != "" {
+				path := certRefPath.Child("namespace")
+				valErr := field.Forbidden(path, "namespace is not supported for kind "+kinds.ExternalCertificate)
+				conds = append(conds, staticConds.NewListenerInvalidCertificateRef(valErr.Error())...)
+			}
+		} else {
+			switch {
+			case certRef.Kind != nil && *certRef.Kind == "ConfigMap":
+				path := certRefPath.Child("kind")
+				valErr := field.Invalid(
+					path,
+					*certRef.Kind,
+					"a ConfigMap can only hold CA certificates, but a listener requires a certificate and a private key; "+
+						"reference a Secret instead",
+				)
+				conds = append(conds, staticConds.NewListenerInvalidCertificateRef(valErr.Error())...)
+			case certRef.Kind != nil && *certRef.Kind != "Secret":
+				path := certRefPath.Child("kind")
+				valErr := field.NotSupported(path, *certRef.Kind, []string{"Secret", kinds.ExternalCertificate})
+				conds = append(conds, staticConds.NewListenerInvalidCertificateRef(valErr.Error())...)
+			}
 
-		// for Kind Secret, certRef.Group must be nil or empty
-		if certRef.Group != nil && *certRef.Group != "" {
-			path := certRefPath.Child("group")
-			valErr := field.NotSupported(path, *certRef.Group, []string{""})
-			conds = append(conds, staticConds.NewListenerInvalidCertificateRef(valErr.Error())...)
+			// for Kind Secret, certRef.Group must be nil or empty
+			if certRef.Group != nil && *certRef.Group != "" {
+				path := certRefPath.Child("group")
+				valErr := field.NotSupported(path, *certRef.Group, []string{""})
+				conds = append(conds, staticConds.NewListenerInvalidCertificateRef(valErr.Error())...)
+			}
 		}
 
 		if l := len(listener.TLS.CertificateRefs); l > 1 {
@@ -511,11 +552,28 @@ func createPortConflictResolver() listenerConflictResolver {
 func createExternalReferencesForTLSSecretsResolver(
 	gwNs string,
 	secretResolver *secretResolver,
+	externalCertResolver *externalCertificateResolver,
 	refGrantResolver *referenceGrantResolver,
 ) listenerExternalReferenceResolver {
 	return func(l *Listener) {
 		certRef := l.Source.TLS.CertificateRefs[0]
 
+		if isExternalCertificateRef(certRef) {
+			name := string(certRef.Name)
+
+			if err := externalCertResolver.resolve(name); err != nil {
+				path := field.NewPath("tls", "certificateRefs").Index(0)
+				valErr := field.Invalid(path, name, err.Error())
+
+				l.Conditions = append(l.Conditions, certificateRefConditions(err, valErr.Error())...)
+				l.Valid = false
+			} else {
+				l.ResolvedExternalCertificate = &name
+			}
+
+			return
+		}
+
 		certRefNs := gwNs
 		if certRef.Namespace != nil {
 			certRefNs = string(*certRef.Namespace)
@@ -541,7 +599,7 @@ func createExternalReferencesForTLSSecretsResolver(
 			// field.NotFound could be better, but it doesn't allow us to set the error message.
 			valErr := field.Invalid(path, certRefNsName, err.Error())
 
-			l.Conditions = append(l.Conditions, staticConds.NewListenerInvalidCertificateRef(valErr.Error())...)
+			l.Conditions = append(l.Conditions, certificateRefConditions(err, valErr.Error())...)
 			l.Valid = false
 		} else {
 			l.ResolvedSecret = &certRefNsName
@@ -549,6 +607,17 @@ func createExternalReferencesForTLSSecretsResolver(
 	}
 }
 
+// certificateRefConditions returns the Conditions of a Listener with a CertificateRef that failed to resolve with
+// the error. The ResolvedRefs condition has the precise reason if the certificate was rejected.
+func certificateRefConditions(err error, msg string) []conditions.Condition {
+	var rejectedErr *rejectedCertificateError
+	if errors.As(err, &rejectedErr) {
+		return staticConds.NewListenerRejectedCertificateRef(rejectedErr.reason, msg)
+	}
+
+	return staticConds.NewListenerInvalidCertificateRef(msg)
+}
+
 // GetAllowedRouteLabelSelector returns a listener's AllowedRoutes label selector if it exists.
 func GetAllowedRouteLabelSelector(l v1.Listener) *metav1.LabelSelector {
 	if l.AllowedRoutes != nil && l.AllowedRoutes.Namespaces != nil {
@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
@@ -92,11 +93,17 @@ func TestValidateHTTPSListener(t *testing.T) {
 	}
 
 	invalidSecretRefKind := v1.SecretObjectReference{
-		Kind:      (*v1.Kind)(helpers.GetPointer("ConfigMap")),
+		Kind:      (*v1.Kind)(helpers.GetPointer("Service")),
 		Name:      "secret",
 		Namespace: (*v1.Namespace)(helpers.GetPointer(secretNs)),
 	}
 
+	configMapRef := v1.SecretObjectReference{
+		Kind:      (*v1.Kind)(helpers.GetPointer("ConfigMap")),
+		Name:      "ca",
+		Namespace: (*v1.Namespace)(helpers.GetPointer(secretNs)),
+	}
+
 	protectedPorts := ProtectedPorts{9113: "MetricsPort"}
 
 	tests := []struct {
@@ -209,10 +216,62 @@ func TestValidateHTTPSListener(t *testing.T) {
 				},
 			},
 			expected: staticConds.NewListenerInvalidCertificateRef(
-				`tls.certificateRefs[0].kind: Unsupported value: "ConfigMap": supported values: "Secret"`,
+				`tls.certificateRefs[0].kind: Unsupported value: "Service": supported values: "Secret", ` +
+					`"ExternalCertificate"`,
 			),
 			name: "invalid cert ref kind",
 		},
+		{
+			l: v1.Listener{
+				Port: 443,
+				TLS: &v1.GatewayTLSConfig{
+					Mode:            helpers.GetPointer(v1.TLSModeTerminate),
+					CertificateRefs: []v1.SecretObjectReference{configMapRef},
+				},
+			},
+			expected: staticConds.NewListenerInvalidCertificateRef(
+				`tls.certificateRefs[0].kind: Invalid value: "ConfigMap": a ConfigMap can only hold CA certificates, ` +
+					`but a listener requires a certificate and a private key; reference a Secret instead`,
+			),
+			name: "config map cert ref",
+		},
+		{
+			l: v1.Listener{
+				Port: 443,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModeTerminate),
+					CertificateRefs: []v1.SecretObjectReference{
+						{
+							Group: helpers.GetPointer[v1.Group](ngfAPI.GroupName),
+							Kind:  helpers.GetPointer[v1.Kind](kinds.ExternalCertificate),
+							Name:  "cafe",
+						},
+					},
+				},
+			},
+			expected: nil,
+			name:     "valid external certificate ref",
+		},
+		{
+			l: v1.Listener{
+				Port: 443,
+				TLS: &v1.GatewayTLSConfig{
+					Mode: helpers.GetPointer(v1.TLSModeTerminate),
+					CertificateRefs: []v1.SecretObjectReference{
+						{
+							Group:     helpers.GetPointer[v1.Group](ngfAPI.GroupName),
+							Kind:      helpers.GetPointer[v1.Kind](kinds.ExternalCertificate),
+							Name:      "cafe",
+							Namespace: helpers.GetPointer[v1.Namespace]("test"),
+						},
+					},
+				},
+			},
+			expected: staticConds.NewListenerInvalidCertificateRef(
+				`tls.certificateRefs[0].namespace: Forbidden: namespace is not supported for kind ExternalCertificate`,
+			),
+			name: "external certificate ref with namespace",
+		},
 		{
 			l: v1.Listener{
 				Port: 443,
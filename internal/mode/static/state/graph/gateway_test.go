@@ -12,6 +12,8 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
@@ -233,6 +235,19 @@ func TestBuildGateway(t *testing.T) {
 		},
 	}
 
+	createExternalCertTLSConfig := func(name string) *v1.GatewayTLSConfig {
+		return &v1.GatewayTLSConfig{
+			Mode: helpers.GetPointer(v1.TLSModeTerminate),
+			CertificateRefs: []v1.SecretObjectReference{
+				{
+					Group: helpers.GetPointer[v1.Group](ngfAPI.GroupName),
+					Kind:  helpers.GetPointer[v1.Kind](kinds.ExternalCertificate),
+					Name:  v1.ObjectName(name),
+				},
+			},
+		}
+	}
+
 	createListener := func(
 		name string,
 		hostname string,
@@ -314,6 +329,18 @@ func TestBuildGateway(t *testing.T) {
 		443,
 		tlsConfigInvalidSecret,
 	)
+	externalCertListener := createHTTPSListener(
+		"external-cert",
+		"foo.example.com",
+		443,
+		createExternalCertTLSConfig("cafe"),
+	)
+	invalidExternalCertListener := createHTTPSListener(
+		"invalid-external-cert",
+		"foo.example.com",
+		443,
+		createExternalCertTLSConfig("does-not-exist"),
+	)
 	invalidHTTPSPortListener := createHTTPSListener(
 		"invalid-https-port",
 		"foo.example.com",
@@ -338,20 +365,24 @@ func TestBuildGateway(t *testing.T) {
 	)
 
 	type gatewayCfg struct {
-		listeners []v1.Listener
-		addresses []v1.GatewayAddress
+		infrastructure *v1.GatewayInfrastructure
+		annotations    map[string]string
+		listeners      []v1.Listener
+		addresses      []v1.GatewayAddress
 	}
 
 	var lastCreatedGateway *v1.Gateway
 	createGateway := func(cfg gatewayCfg) *v1.Gateway {
 		lastCreatedGateway = &v1.Gateway{
 			ObjectMeta: metav1.ObjectMeta{
-				Namespace: "test",
+				Namespace:   "test",
+				Annotations: cfg.annotations,
 			},
 			Spec: v1.GatewaySpec{
 				GatewayClassName: gcName,
 				Listeners:        cfg.listeners,
 				Addresses:        cfg.addresses,
+				Infrastructure:   cfg.infrastructure,
 			},
 		}
 		return lastCreatedGateway
@@ -688,6 +719,51 @@ func TestBuildGateway(t *testing.T) {
 			},
 			name: "invalid https listener (secret does not exist)",
 		},
+		{
+			gateway:      createGateway(gatewayCfg{listeners: []v1.Listener{externalCertListener}}),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:                        "external-cert",
+						Source:                      externalCertListener,
+						Valid:                       true,
+						Attachable:                  true,
+						ResolvedExternalCertificate: helpers.GetPointer("cafe"),
+						Routes:                      map[RouteKey]*L7Route{},
+						L4Routes:                    map[L4RouteKey]*L4Route{},
+						SupportedKinds:              supportedKindsForListeners,
+					},
+				},
+				Valid: true,
+			},
+			name: "valid https listener with an external certificate",
+		},
+		{
+			gateway:      createGateway(gatewayCfg{listeners: []v1.Listener{invalidExternalCertListener}}),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:       "invalid-external-cert",
+						Source:     invalidExternalCertListener,
+						Valid:      false,
+						Attachable: true,
+						Routes:     map[RouteKey]*L7Route{},
+						L4Routes:   map[L4RouteKey]*L4Route{},
+						Conditions: staticConds.NewListenerInvalidCertificateRef(
+							`tls.certificateRefs[0]: Invalid value: "does-not-exist": ` +
+								`certificate does not exist in the certificate source`,
+						),
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				Valid: true,
+			},
+			name: "invalid https listener (external certificate does not exist)",
+		},
 		{
 			gateway: createGateway(
 				gatewayCfg{
@@ -890,6 +966,168 @@ func TestBuildGateway(t *testing.T) {
 			},
 			name: "gateway addresses are not supported",
 		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners: []v1.Listener{foo80Listener1},
+					infrastructure: &v1.GatewayInfrastructure{
+						Labels: map[v1.AnnotationKey]v1.AnnotationValue{"key": "value"},
+					},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-80-1",
+						Source:         foo80Listener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayUnsupportedField([]string{"spec.infrastructure"}),
+				},
+				Valid: true,
+			},
+			name: "gateway infrastructure is not supported",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners:   []v1.Listener{foo80Listener1},
+					annotations: map[string]string{ngfAPI.ErrorLogLevelAnnotation: "warn"},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-80-1",
+						Source:         foo80Listener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				ErrorLogLevel: ngfAPI.NginxErrorLogLevelWarn,
+				Valid:         true,
+			},
+			name: "gateway overrides the error log level",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners:   []v1.Listener{foo80Listener1},
+					annotations: map[string]string{ngfAPI.ErrorLogLevelAnnotation: "verbose"},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-80-1",
+						Source:         foo80Listener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayUnsupportedField(
+						[]string{"metadata.annotations[gateway.nginx.org/error-log-level]"},
+					),
+				},
+				Valid: true,
+			},
+			name: "gateway error log level override is not supported",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners:   []v1.Listener{foo443HTTPSListener1, foo80Listener1},
+					annotations: map[string]string{ngfAPI.HTTPSRedirectListenersAnnotation: " foo-443-https-1 "},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-443-https-1",
+						Source:         foo443HTTPSListener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
+						SupportedKinds: supportedKindsForListeners,
+					},
+					{
+						Name:           "foo-80-1",
+						Source:         foo80Listener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				HTTPSRedirectListeners: []string{"foo-443-https-1"},
+				Valid:                  true,
+			},
+			name: "gateway redirects http to the https listeners",
+		},
+		{
+			gateway: createGateway(
+				gatewayCfg{
+					listeners:   []v1.Listener{foo443HTTPSListener1, foo80Listener1},
+					annotations: map[string]string{ngfAPI.HTTPSRedirectListenersAnnotation: "foo-443-https-1,foo-80-1"},
+				},
+			),
+			gatewayClass: validGC,
+			expected: &Gateway{
+				Source: getLastCreatedGateway(),
+				Listeners: []*Listener{
+					{
+						Name:           "foo-443-https-1",
+						Source:         foo443HTTPSListener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secretSameNs)),
+						SupportedKinds: supportedKindsForListeners,
+					},
+					{
+						Name:           "foo-80-1",
+						Source:         foo80Listener1,
+						Valid:          true,
+						Attachable:     true,
+						Routes:         map[RouteKey]*L7Route{},
+						L4Routes:       map[L4RouteKey]*L4Route{},
+						SupportedKinds: supportedKindsForListeners,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayUnsupportedField(
+						[]string{"metadata.annotations[gateway.nginx.org/https-redirect-listeners]"},
+					),
+				},
+				Valid: true,
+			},
+			name: "gateway https redirect listeners are not https listeners",
+		},
 		{
 			gateway:  nil,
 			expected: nil,
@@ -1032,11 +1270,23 @@ func TestBuildGateway(t *testing.T) {
 			client.ObjectKeyFromObject(secretDiffNamespace): secretDiffNamespace,
 		})
 
+	externalCertResolver := newExternalCertificateResolver(
+		map[string]*ExternalCertificate{
+			"cafe": {Cert: cert, Key: key},
+		})
+
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 			resolver := newReferenceGrantResolver(test.refGrants)
-			result := buildGateway(test.gateway, secretResolver, test.gatewayClass, resolver, protectedPorts)
+			result := buildGateway(
+				test.gateway,
+				secretResolver,
+				externalCertResolver,
+				test.gatewayClass,
+				resolver,
+				protectedPorts,
+			)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
 	}
@@ -281,9 +281,12 @@ func TestBuildGatewayClass(t *testing.T) {
 			gc:          validGC,
 			crdMetadata: invalidCRDs,
 			expected: &GatewayClass{
-				Source:     validGC,
-				Valid:      false,
-				Conditions: conditions.NewGatewayClassUnsupportedVersion(gatewayclass.SupportedVersion),
+				Source: validGC,
+				Valid:  false,
+				Conditions: conditions.NewGatewayClassUnsupportedVersion(
+					gatewayclass.SupportedVersion,
+					[]string{"v99.0.0"},
+				),
 			},
 			name: "invalid gatewayclass; unsupported version",
 		},
@@ -22,20 +22,28 @@ import (
 
 // ClusterState includes cluster resources necessary to build the Graph.
 type ClusterState struct {
-	GatewayClasses     map[types.NamespacedName]*gatewayv1.GatewayClass
-	Gateways           map[types.NamespacedName]*gatewayv1.Gateway
-	HTTPRoutes         map[types.NamespacedName]*gatewayv1.HTTPRoute
-	TLSRoutes          map[types.NamespacedName]*v1alpha2.TLSRoute
-	Services           map[types.NamespacedName]*v1.Service
-	Namespaces         map[types.NamespacedName]*v1.Namespace
-	ReferenceGrants    map[types.NamespacedName]*v1beta1.ReferenceGrant
-	Secrets            map[types.NamespacedName]*v1.Secret
-	CRDMetadata        map[types.NamespacedName]*metav1.PartialObjectMetadata
-	BackendTLSPolicies map[types.NamespacedName]*v1alpha3.BackendTLSPolicy
-	ConfigMaps         map[types.NamespacedName]*v1.ConfigMap
-	NginxProxies       map[types.NamespacedName]*ngfAPI.NginxProxy
-	GRPCRoutes         map[types.NamespacedName]*gatewayv1.GRPCRoute
-	NGFPolicies        map[PolicyKey]policies.Policy
+	GatewayClasses        map[types.NamespacedName]*gatewayv1.GatewayClass
+	Gateways              map[types.NamespacedName]*gatewayv1.Gateway
+	HTTPRoutes            map[types.NamespacedName]*gatewayv1.HTTPRoute
+	TLSRoutes             map[types.NamespacedName]*v1alpha2.TLSRoute
+	Services              map[types.NamespacedName]*v1.Service
+	Namespaces            map[types.NamespacedName]*v1.Namespace
+	ReferenceGrants       map[types.NamespacedName]*v1beta1.ReferenceGrant
+	Secrets               map[types.NamespacedName]*v1.Secret
+	CRDMetadata           map[types.NamespacedName]*metav1.PartialObjectMetadata
+	BackendTLSPolicies    map[types.NamespacedName]*v1alpha3.BackendTLSPolicy
+	ConfigMaps            map[types.NamespacedName]*v1.ConfigMap
+	NginxProxies          map[types.NamespacedName]*ngfAPI.NginxProxy
+	GRPCRoutes            map[types.NamespacedName]*gatewayv1.GRPCRoute
+	NGFPolicies           map[PolicyKey]policies.Policy
+	SnippetsFilters       map[types.NamespacedName]*ngfAPI.SnippetsFilter
+	RateLimitFilters      map[types.NamespacedName]*ngfAPI.RateLimitFilter
+	ResponseHeaderFilters map[types.NamespacedName]*ngfAPI.ResponseHeaderFilter
+	QueryParameterFilters map[types.NamespacedName]*ngfAPI.QueryParameterFilter
+	ABTestFilters         map[types.NamespacedName]*ngfAPI.ABTestFilter
+	// ExternalCertificates holds the certificates of the certificate source by name.
+	// They are not Kubernetes resources.
+	ExternalCertificates map[string]*ExternalCertificate
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
@@ -56,24 +64,53 @@ type Graph struct {
 	Routes map[RouteKey]*L7Route
 	// L4Routes hold L4Route resources.
 	L4Routes map[L4RouteKey]*L4Route
-	// ReferencedSecrets includes Secrets referenced by Gateway Listeners, including invalid ones.
+	// ReferencedSecrets includes Secrets referenced by Gateway Listeners and the DefaultCertificatePolicy,
+	// including invalid ones.
 	// It is different from the other maps, because it includes entries for Secrets that do not exist
 	// in the cluster. We need such entries so that we can query the Graph to determine if a Secret is referenced
 	// by the Gateway, including the case when the Secret is newly created.
 	ReferencedSecrets map[types.NamespacedName]*Secret
+	// ReferencedExternalCertificates includes the valid ExternalCertificates referenced by Gateway Listeners
+	// and the DefaultCertificatePolicy by name.
+	ReferencedExternalCertificates map[string]*ExternalCertificate
 	// ReferencedNamespaces includes Namespaces with labels that match the Gateway Listener's label selector.
 	ReferencedNamespaces map[types.NamespacedName]*v1.Namespace
 	// ReferencedServices includes the NamespacedNames of all the Services that are referenced by at least one HTTPRoute.
 	// Storing the whole resource is not necessary, compared to the similar maps above.
-	ReferencedServices map[types.NamespacedName]struct{}
+	ReferencedServices map[types.NamespacedName]*ReferencedService
 	// ReferencedCaCertConfigMaps includes ConfigMaps that have been referenced by any BackendTLSPolicies.
 	ReferencedCaCertConfigMaps map[types.NamespacedName]*CaCertConfigMap
+	// WAFBundles holds the NGINX App Protect policy bundles of the WAFPolicies by the NamespacedName of the WAFPolicy.
+	WAFBundles map[types.NamespacedName]*WAFBundle
+	// SecureLinkSecrets holds the secrets of the SecureLinkPolicies by the NamespacedName of the SecureLinkPolicy.
+	SecureLinkSecrets map[types.NamespacedName]*SecureLinkSecret
+	// StaticContents holds the files of the StaticContentPolicies by the NamespacedName of the StaticContentPolicy.
+	StaticContents map[types.NamespacedName]*StaticContent
+	// DefaultCertificate is the certificate of the DefaultCertificatePolicy of the Gateway.
+	// It is nil if the Gateway doesn't have a valid DefaultCertificatePolicy.
+	DefaultCertificate *DefaultCertificate
+	// SessionTicketKeys holds the TLS session ticket keys that are shared by the NGINX of all replicas.
+	// It is nil if the keys are not shared.
+	SessionTicketKeys *SessionTicketKeys
 	// BackendTLSPolicies holds BackendTLSPolicy resources.
 	BackendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy
 	// NginxProxy holds the NginxProxy config for the GatewayClass.
 	NginxProxy *NginxProxy
+	// Activator holds the activator Service for the Services that scale from zero replicas.
+	// It is nil if scaling from zero is not configured in the NginxProxy.
+	Activator *Activator
 	// NGFPolicies holds all NGF Policies.
 	NGFPolicies map[PolicyKey]*Policy
+	// SnippetsFilters holds all SnippetsFilters.
+	SnippetsFilters map[types.NamespacedName]*SnippetsFilter
+	// RateLimitFilters holds all RateLimitFilters.
+	RateLimitFilters map[types.NamespacedName]*RateLimitFilter
+	// ResponseHeaderFilters holds all ResponseHeaderFilters.
+	ResponseHeaderFilters map[types.NamespacedName]*ResponseHeaderFilter
+	// QueryParameterFilters holds all QueryParameterFilters.
+	QueryParameterFilters map[types.NamespacedName]*QueryParameterFilter
+	// ABTestFilters holds all ABTestFilters.
+	ABTestFilters map[types.NamespacedName]*ABTestFilter
 	// GlobalSettings contains global settings from the current state of the graph that may be
 	// needed for policy validation or generation if certain policies rely on those global settings.
 	GlobalSettings *policies.GlobalSettings
@@ -87,10 +124,11 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 	switch obj := resourceType.(type) {
 	case *v1.Secret:
 		_, exists := g.ReferencedSecrets[nsname]
-		return exists
+		return exists || isSecureLinkSecret(g.SecureLinkSecrets, nsname) ||
+			(g.SessionTicketKeys != nil && g.SessionTicketKeys.Secret == nsname)
 	case *v1.ConfigMap:
 		_, exists := g.ReferencedCaCertConfigMaps[nsname]
-		return exists
+		return exists || isWAFBundleConfigMap(g.WAFBundles, nsname) || isStaticContentConfigMap(g.StaticContents, nsname)
 	case *v1.Namespace:
 		// `existed` is needed as it checks the graph's ReferencedNamespaces which stores all the namespaces that
 		// match the Gateway listener's label selector when the graph was created. This covers the case when
@@ -126,6 +164,46 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 	}
 }
 
+// ReferencedSecretNames returns the NamespacedNames of the Secrets that the Graph references,
+// including the Secrets that don't exist. IsReferenced returns true for each of them.
+func (g *Graph) ReferencedSecretNames() map[types.NamespacedName]struct{} {
+	names := make(map[types.NamespacedName]struct{})
+
+	for nsname := range g.ReferencedSecrets {
+		names[nsname] = struct{}{}
+	}
+
+	for _, secret := range g.SecureLinkSecrets {
+		names[secret.Secret] = struct{}{}
+	}
+
+	if g.SessionTicketKeys != nil {
+		names[g.SessionTicketKeys.Secret] = struct{}{}
+	}
+
+	return names
+}
+
+// ReferencedConfigMapNames returns the NamespacedNames of the ConfigMaps that the Graph references,
+// including the ConfigMaps that don't exist. IsReferenced returns true for each of them.
+func (g *Graph) ReferencedConfigMapNames() map[types.NamespacedName]struct{} {
+	names := make(map[types.NamespacedName]struct{})
+
+	for nsname := range g.ReferencedCaCertConfigMaps {
+		names[nsname] = struct{}{}
+	}
+
+	for _, bundle := range g.WAFBundles {
+		names[bundle.ConfigMap] = struct{}{}
+	}
+
+	for _, content := range g.StaticContents {
+		names[content.ConfigMap] = struct{}{}
+	}
+
+	return names
+}
+
 // IsNGFPolicyRelevant returns whether the NGF Policy is a part of the Graph, or targets a resource in the Graph.
 func (g *Graph) IsNGFPolicyRelevant(
 	policy policies.Policy,
@@ -146,9 +224,19 @@ func (g *Graph) IsNGFPolicyRelevant(
 	}
 
 	for _, ref := range policy.GetTargetRefs() {
-		if ref.Group == gatewayv1.GroupName && g.gatewayAPIResourceExist(ref, policy.GetNamespace()) {
+		targetRef := ref.LocalPolicyTargetReference
+		if ref.Group == gatewayv1.GroupName && g.gatewayAPIResourceExist(targetRef, policy.GetNamespace()) {
 			return true
 		}
+
+		if ref.Group == "" && ref.Kind == kinds.Service {
+			if _, exists := g.ReferencedServices[types.NamespacedName{
+				Namespace: policy.GetNamespace(),
+				Name:      string(ref.Name),
+			}]; exists {
+				return true
+			}
+		}
 	}
 
 	return false
@@ -180,6 +268,7 @@ func BuildGraph(
 	gcName string,
 	validators validation.Validators,
 	protectedPorts ProtectedPorts,
+	sessionTicketKeysSecret *types.NamespacedName,
 ) *Graph {
 	var globalSettings *policies.GlobalSettings
 
@@ -196,17 +285,19 @@ func BuildGraph(
 		globalSettings = &policies.GlobalSettings{
 			NginxProxyValid:  npCfg.Valid,
 			TelemetryEnabled: spec.Telemetry != nil && spec.Telemetry.Exporter != nil,
+			GeoIPEnabled:     spec.GeoIP != nil,
 		}
 	}
 
 	secretResolver := newSecretResolver(state.Secrets)
+	externalCertResolver := newExternalCertificateResolver(state.ExternalCertificates)
 	configMapResolver := newConfigMapResolver(state.ConfigMaps)
 
 	processedGws := processGateways(state.Gateways, gcName)
 
 	refGrantResolver := newReferenceGrantResolver(state.ReferenceGrants)
 
-	gw := buildGateway(processedGws.Winner, secretResolver, gc, refGrantResolver, protectedPorts)
+	gw := buildGateway(processedGws.Winner, secretResolver, externalCertResolver, gc, refGrantResolver, protectedPorts)
 
 	processedBackendTLSPolicies := processBackendTLSPolicies(
 		state.BackendTLSPolicies,
@@ -223,6 +314,25 @@ func BuildGraph(
 		npCfg,
 	)
 
+	addDelegatedRoutes(validators.HTTPFieldsValidator, state.HTTPRoutes, routes)
+
+	processedSnippetsFilters := processSnippetsFilters(state.SnippetsFilters)
+	processedRateLimitFilters := processRateLimitFilters(state.RateLimitFilters, validators.GenericValidator)
+	processedResponseHeaderFilters := processResponseHeaderFilters(
+		state.ResponseHeaderFilters,
+		validators.HTTPFieldsValidator,
+	)
+	processedQueryParameterFilters := processQueryParameterFilters(state.QueryParameterFilters)
+	processedABTestFilters := processABTestFilters(state.ABTestFilters, validators.HTTPFieldsValidator)
+	resolveExtensionRefFilters(
+		routes,
+		processedSnippetsFilters,
+		processedRateLimitFilters,
+		processedResponseHeaderFilters,
+		processedQueryParameterFilters,
+		processedABTestFilters,
+	)
+
 	l4routes := buildL4RoutesForGateways(
 		state.TLSRoutes,
 		processedGws.GetAllNsNames(),
@@ -236,7 +346,7 @@ func BuildGraph(
 
 	referencedNamespaces := buildReferencedNamespaces(state.Namespaces, gw)
 
-	referencedServices := buildReferencedServices(routes, l4routes)
+	referencedServices := buildReferencedServices(routes, l4routes, npCfg)
 
 	// policies must be processed last because they rely on the state of the other resources in the graph
 	processedPolicies := processPolicies(
@@ -244,27 +354,47 @@ func BuildGraph(
 		validators.PolicyValidator,
 		processedGws,
 		routes,
+		referencedServices,
 		globalSettings,
+		controllerName,
 	)
 
+	wafBundles := buildWAFBundles(processedPolicies, state.ConfigMaps)
+	secureLinkSecrets := buildSecureLinkSecrets(processedPolicies, state.Secrets)
+	staticContents := buildStaticContents(processedPolicies, state.ConfigMaps)
+	defaultCert := buildDefaultCertificate(gw, processedPolicies, secretResolver, externalCertResolver)
+
 	g := &Graph{
-		GatewayClass:               gc,
-		Gateway:                    gw,
-		Routes:                     routes,
-		L4Routes:                   l4routes,
-		IgnoredGatewayClasses:      processedGwClasses.Ignored,
-		IgnoredGateways:            processedGws.Ignored,
-		ReferencedSecrets:          secretResolver.getResolvedSecrets(),
-		ReferencedNamespaces:       referencedNamespaces,
-		ReferencedServices:         referencedServices,
-		ReferencedCaCertConfigMaps: configMapResolver.getResolvedConfigMaps(),
-		BackendTLSPolicies:         processedBackendTLSPolicies,
-		NginxProxy:                 npCfg,
-		NGFPolicies:                processedPolicies,
-		GlobalSettings:             globalSettings,
+		GatewayClass:                   gc,
+		Gateway:                        gw,
+		Routes:                         routes,
+		L4Routes:                       l4routes,
+		IgnoredGatewayClasses:          processedGwClasses.Ignored,
+		IgnoredGateways:                processedGws.Ignored,
+		ReferencedSecrets:              secretResolver.getResolvedSecrets(),
+		ReferencedExternalCertificates: externalCertResolver.getResolvedCertificates(),
+		ReferencedNamespaces:           referencedNamespaces,
+		ReferencedServices:             referencedServices,
+		ReferencedCaCertConfigMaps:     configMapResolver.getResolvedConfigMaps(),
+		WAFBundles:                     wafBundles,
+		SecureLinkSecrets:              secureLinkSecrets,
+		StaticContents:                 staticContents,
+		DefaultCertificate:             defaultCert,
+		SessionTicketKeys:              buildSessionTicketKeys(state.Secrets, sessionTicketKeysSecret),
+		BackendTLSPolicies:             processedBackendTLSPolicies,
+		NginxProxy:                     npCfg,
+		Activator:                      buildActivator(npCfg, state.Services),
+		NGFPolicies:                    processedPolicies,
+		SnippetsFilters:                processedSnippetsFilters,
+		RateLimitFilters:               processedRateLimitFilters,
+		ResponseHeaderFilters:          processedResponseHeaderFilters,
+		QueryParameterFilters:          processedQueryParameterFilters,
+		ABTestFilters:                  processedABTestFilters,
+		GlobalSettings:                 globalSettings,
 	}
 
 	g.attachPolicies(controllerName)
+	g.setEffectivePolicies(validators.PolicyValidator)
 
 	return g
 }
@@ -440,6 +440,9 @@ func TestBuildGraph(t *testing.T) {
 					{Key: "key", Value: "value"},
 				},
 			},
+			GeoIP: &ngfAPI.GeoIP{
+				DatabasePath: "/etc/nginx/geoip/GeoLite2-Country.mmdb",
+			},
 		},
 	}
 
@@ -785,7 +788,7 @@ func TestBuildGraph(t *testing.T) {
 			ReferencedNamespaces: map[types.NamespacedName]*v1.Namespace{
 				client.ObjectKeyFromObject(ns): ns,
 			},
-			ReferencedServices: map[types.NamespacedName]struct{}{
+			ReferencedServices: map[types.NamespacedName]*ReferencedService{
 				client.ObjectKeyFromObject(svc):  {},
 				client.ObjectKeyFromObject(svc1): {},
 			},
@@ -809,6 +812,7 @@ func TestBuildGraph(t *testing.T) {
 			GlobalSettings: &policies.GlobalSettings{
 				NginxProxyValid:  true,
 				TelemetryEnabled: true,
+				GeoIPEnabled:     true,
 			},
 		}
 	}
@@ -871,6 +875,7 @@ func TestBuildGraph(t *testing.T) {
 					PolicyValidator:     fakePolicyValidator,
 				},
 				protectedPorts,
+				nil,
 			)
 
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
@@ -986,6 +991,33 @@ func TestIsReferenced(t *testing.T) {
 			Name:      "configmap",
 		},
 	}
+	wafBundleConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "waf-bundles",
+		},
+	}
+
+	staticContentConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "static-content",
+		},
+	}
+
+	secureLinkSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "secure-link",
+		},
+	}
+
+	sessionTicketKeysSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "session-ticket-keys",
+		},
+	}
 
 	gcWithNginxProxy := &GatewayClass{
 		Source: &gatewayv1.GatewayClass{
@@ -1021,7 +1053,7 @@ func TestIsReferenced(t *testing.T) {
 		ReferencedNamespaces: map[types.NamespacedName]*v1.Namespace{
 			client.ObjectKeyFromObject(nsInGraph): nsInGraph,
 		},
-		ReferencedServices: map[types.NamespacedName]struct{}{
+		ReferencedServices: map[types.NamespacedName]*ReferencedService{
 			client.ObjectKeyFromObject(serviceInGraph): {},
 		},
 		ReferencedCaCertConfigMaps: map[types.NamespacedName]*CaCertConfigMap{
@@ -1030,6 +1062,24 @@ func TestIsReferenced(t *testing.T) {
 				CACert: []byte(caBlock),
 			},
 		},
+		WAFBundles: map[types.NamespacedName]*WAFBundle{
+			{Namespace: testNs, Name: "waf-policy"}: {
+				ConfigMap: client.ObjectKeyFromObject(wafBundleConfigMap),
+			},
+		},
+		SecureLinkSecrets: map[types.NamespacedName]*SecureLinkSecret{
+			{Namespace: testNs, Name: "secure-link-policy"}: {
+				Secret: client.ObjectKeyFromObject(secureLinkSecret),
+			},
+		},
+		StaticContents: map[types.NamespacedName]*StaticContent{
+			{Namespace: testNs, Name: "static-content-policy"}: {
+				ConfigMap: client.ObjectKeyFromObject(staticContentConfigMap),
+			},
+		},
+		SessionTicketKeys: &SessionTicketKeys{
+			Secret: client.ObjectKeyFromObject(sessionTicketKeysSecret),
+		},
 	}
 
 	tests := []struct {
@@ -1079,6 +1129,18 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: false,
 		},
+		{
+			name:     "Secret in graph's SecureLinkSecrets is referenced",
+			resource: secureLinkSecret,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "Secret of graph's SessionTicketKeys is referenced",
+			resource: sessionTicketKeysSecret,
+			graph:    graph,
+			expected: true,
+		},
 
 		// Service tests
 		{
@@ -1145,6 +1207,18 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: false,
 		},
+		{
+			name:     "ConfigMap in graph's WAFBundles is referenced",
+			resource: wafBundleConfigMap,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "ConfigMap in graph's StaticContents is referenced",
+			resource: staticContentConfigMap,
+			graph:    graph,
+			expected: true,
+		},
 
 		// NginxProxy tests
 		{
@@ -1181,6 +1255,57 @@ func TestIsReferenced(t *testing.T) {
 	}
 }
 
+func TestReferencedSecretAndConfigMapNames(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	nsname := func(name string) types.NamespacedName {
+		return types.NamespacedName{Namespace: testNs, Name: name}
+	}
+
+	graph := &Graph{
+		ReferencedSecrets: map[types.NamespacedName]*Secret{
+			nsname("tls"):     {Source: &v1.Secret{}},
+			nsname("missing"): {},
+		},
+		SecureLinkSecrets: map[types.NamespacedName]*SecureLinkSecret{
+			nsname("policy"): {Secret: nsname("secure-link")},
+		},
+		SessionTicketKeys: &SessionTicketKeys{Secret: nsname("session-ticket-keys")},
+		ReferencedCaCertConfigMaps: map[types.NamespacedName]*CaCertConfigMap{
+			nsname("ca"): {},
+		},
+		WAFBundles: map[types.NamespacedName]*WAFBundle{
+			nsname("waf-policy"): {ConfigMap: nsname("waf-bundles")},
+		},
+		StaticContents: map[types.NamespacedName]*StaticContent{
+			nsname("static-policy"): {ConfigMap: nsname("static")},
+		},
+	}
+
+	g.Expect(graph.ReferencedSecretNames()).To(Equal(map[types.NamespacedName]struct{}{
+		nsname("tls"):                 {},
+		nsname("missing"):             {},
+		nsname("secure-link"):         {},
+		nsname("session-ticket-keys"): {},
+	}))
+	g.Expect(graph.ReferencedConfigMapNames()).To(Equal(map[types.NamespacedName]struct{}{
+		nsname("ca"):          {},
+		nsname("waf-bundles"): {},
+		nsname("static"):      {},
+	}))
+
+	for name := range graph.ReferencedSecretNames() {
+		g.Expect(graph.IsReferenced(&v1.Secret{}, name)).To(BeTrue())
+	}
+	for name := range graph.ReferencedConfigMapNames() {
+		g.Expect(graph.IsReferenced(&v1.ConfigMap{}, name)).To(BeTrue())
+	}
+
+	g.Expect((&Graph{}).ReferencedSecretNames()).To(BeEmpty())
+	g.Expect((&Graph{}).ReferencedConfigMapNames()).To(BeEmpty())
+}
+
 func TestIsNGFPolicyRelevant(t *testing.T) {
 	t.Parallel()
 	policyGVK := schema.GroupVersionKind{Kind: "MyKind"}
@@ -1206,6 +1331,9 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 				hrKey: {},
 				grKey: {},
 			},
+			ReferencedServices: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "test", Name: "svc"}: {},
+			},
 			NGFPolicies: map[PolicyKey]*Policy{
 				{GVK: policyGVK, NsName: existingPolicyNsName}: {
 					Source: &policiesfakes.FakePolicy{},
@@ -1220,13 +1348,13 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 		return mod(getGraph())
 	}
 
-	getPolicy := func(ref v1alpha2.LocalPolicyTargetReference) policies.Policy {
+	getPolicy := func(ref v1alpha2.LocalPolicyTargetReferenceWithSectionName) policies.Policy {
 		return &policiesfakes.FakePolicy{
 			GetNamespaceStub: func() string {
 				return testNs
 			},
-			GetTargetRefsStub: func() []v1alpha2.LocalPolicyTargetReference {
-				return []v1alpha2.LocalPolicyTargetReference{ref}
+			GetTargetRefsStub: func() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+				return []v1alpha2.LocalPolicyTargetReferenceWithSectionName{ref}
 			},
 		}
 	}
@@ -1280,6 +1408,20 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-gr"},
 			expRelevant: true,
 		},
+		{
+			name:        "relevant; policy references a service in the graph",
+			graph:       getGraph(),
+			policy:      getPolicy(createTestRef(kinds.Service, "", "svc")),
+			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-svc"},
+			expRelevant: true,
+		},
+		{
+			name:        "irrelevant; policy references a service that is not in the graph",
+			graph:       getGraph(),
+			policy:      getPolicy(createTestRef(kinds.Service, "", "diff")),
+			nsname:      types.NamespacedName{Namespace: "test", Name: "not-relevant-svc"},
+			expRelevant: false,
+		},
 		{
 			name:        "irrelevant; policy does not reference a relevant gw or route in the graph",
 			graph:       getGraph(),
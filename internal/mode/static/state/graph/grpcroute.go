@@ -73,9 +73,26 @@ func buildGRPCRoute(
 		}
 	}
 
+	if fields := getUnsupportedGRPCRouteFields(ghr.Spec.Rules); len(fields) > 0 {
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedField(fields))
+	}
+
 	return r
 }
 
+// getUnsupportedGRPCRouteFields returns the paths of the set fields of the GRPCRoute rules that NGF doesn't support.
+func getUnsupportedGRPCRouteFields(specRules []v1.GRPCRouteRule) []string {
+	var fields []string
+
+	for i, rule := range specRules {
+		if rule.SessionPersistence != nil {
+			fields = append(fields, field.NewPath("spec").Child("rules").Index(i).Child("sessionPersistence").String())
+		}
+	}
+
+	return fields
+}
+
 func processGRPCRouteRules(
 	specRules []v1.GRPCRouteRule,
 	validator validation.HTTPFieldsValidator,
@@ -294,6 +294,18 @@ func TestBuildGRPCRoute(t *testing.T) {
 		},
 	}
 
+	grUnsupportedFieldsRule := createGRPCMethodMatch("myService", "myMethod", "Exact")
+	grUnsupportedFieldsRule.SessionPersistence = &v1.SessionPersistence{
+		SessionName: helpers.GetPointer("session"),
+	}
+
+	grUnsupportedFields := createGRPCRoute(
+		"gr",
+		gatewayNsName.Name,
+		"example.com",
+		[]v1.GRPCRouteRule{grUnsupportedFieldsRule},
+	)
+
 	createAllValidValidator := func() *validationfakes.FakeHTTPFieldsValidator {
 		v := &validationfakes.FakeHTTPFieldsValidator{}
 		v.ValidateMethodInMatchReturns(true, nil)
@@ -682,6 +694,38 @@ func TestBuildGRPCRoute(t *testing.T) {
 			},
 			name: "invalid hostname",
 		},
+		{
+			validator: createAllValidValidator(),
+			gr:        grUnsupportedFields,
+			expected: &L7Route{
+				RouteType: RouteTypeGRPC,
+				Source:    grUnsupportedFields,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: grUnsupportedFields.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedField([]string{"spec.rules[0].sessionPersistence"}),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: grUnsupportedFields.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches:     true,
+							ValidFilters:     true,
+							Matches:          convertGRPCMatches(grUnsupportedFields.Spec.Rules[0].Matches),
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "unsupported fields are ignored",
+		},
 	}
 
 	gatewayNsNames := []types.NamespacedName{gatewayNsName}
@@ -56,10 +56,23 @@ func buildHTTPRoute(
 	r.Valid = true
 	r.Attachable = true
 
-	rules, atLeastOneValid, allRulesErrs := processHTTPRouteRules(ghr.Spec.Rules, validator)
+	rules, atLeastOneValid, allRulesErrs := processHTTPRouteRules(ghr.Spec.Rules, ghr.Namespace, validator)
 
 	r.Spec.Rules = rules
 
+	addHTTPRouteRulesConditions(r, ghr, atLeastOneValid, allRulesErrs)
+
+	return r
+}
+
+// addHTTPRouteRulesConditions adds the conditions that report the invalid and the unsupported fields of the rules
+// to the Route. The Route becomes invalid if all rules are invalid.
+func addHTTPRouteRulesConditions(
+	r *L7Route,
+	ghr *v1.HTTPRoute,
+	atLeastOneValid bool,
+	allRulesErrs field.ErrorList,
+) {
 	if len(allRulesErrs) > 0 {
 		msg := allRulesErrs.ToAggregate().Error()
 
@@ -73,11 +86,49 @@ func buildHTTPRoute(
 		}
 	}
 
-	return r
+	if fields := getUnsupportedHTTPRouteFields(ghr.Spec.Rules); len(fields) > 0 {
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedField(fields))
+	}
+}
+
+// getUnsupportedHTTPRouteFields returns the paths of the set fields of the HTTPRoute rules that NGF doesn't support.
+func getUnsupportedHTTPRouteFields(specRules []v1.HTTPRouteRule) []string {
+	var fields []string
+
+	for i, rule := range specRules {
+		rulePath := field.NewPath("spec").Child("rules").Index(i)
+
+		if rule.Timeouts != nil {
+			fields = append(fields, rulePath.Child("timeouts").String())
+		}
+
+		if rule.SessionPersistence != nil {
+			fields = append(fields, rulePath.Child("sessionPersistence").String())
+		}
+	}
+
+	return fields
+}
+
+// ValidateHTTPRoute validates the hostnames and the rules of an HTTPRoute with the same validation
+// that is used when building the graph. It does not validate the parentRefs and the backendRefs,
+// because their validity depends on other resources.
+func ValidateHTTPRoute(validator validation.HTTPFieldsValidator, ghr *v1.HTTPRoute) error {
+	if err := validateHostnames(
+		ghr.Spec.Hostnames,
+		field.NewPath("spec").Child("hostnames"),
+	); err != nil {
+		return err
+	}
+
+	_, _, allRulesErrs := processHTTPRouteRules(ghr.Spec.Rules, ghr.Namespace, validator)
+
+	return allRulesErrs.ToAggregate()
 }
 
 func processHTTPRouteRules(
 	specRules []v1.HTTPRouteRule,
+	routeNamespace string,
 	validator validation.HTTPFieldsValidator,
 ) (rules []RouteRule, atLeastOneValid bool, allRulesErrs field.ErrorList) {
 	rules = make([]RouteRule, len(specRules))
@@ -91,11 +142,17 @@ func processHTTPRouteRules(
 			matchesErrs = append(matchesErrs, validateMatch(validator, match, matchPath)...)
 		}
 
+		// A rule that delegates to a child HTTPRoute does not match requests itself,
+		// so its delegation errors invalidate its matches.
+		delegate, delegationErrs := validateDelegation(rule, routeNamespace, rulePath)
+		matchesErrs = append(matchesErrs, delegationErrs...)
+
 		var filtersErrs field.ErrorList
 		for j, filter := range rule.Filters {
 			filterPath := rulePath.Child("filters").Index(j)
 			filtersErrs = append(filtersErrs, validateFilter(validator, filter, filterPath)...)
 		}
+		filtersErrs = append(filtersErrs, validateExtensionRefFilterCounts(rule.Filters, rulePath)...)
 
 		var allErrs field.ErrorList
 		allErrs = append(allErrs, matchesErrs...)
@@ -124,7 +181,12 @@ func processHTTPRouteRules(
 			backendRefs = append(backendRefs, rbr)
 		}
 
+		if len(matchesErrs) > 0 {
+			delegate = nil
+		}
+
 		rules[i] = RouteRule{
+			Delegate:         delegate,
 			ValidMatches:     len(matchesErrs) == 0,
 			ValidFilters:     len(filtersErrs) == 0,
 			Matches:          rule.Matches,
@@ -233,14 +295,24 @@ func validatePathMatch(
 		return field.ErrorList{field.Invalid(fieldPath.Child("value"), *path.Value, msg)}
 	}
 
-	if *path.Type != v1.PathMatchPathPrefix && *path.Type != v1.PathMatchExact {
+	switch *path.Type {
+	case v1.PathMatchPathPrefix, v1.PathMatchExact:
+		if err := validator.ValidatePathInMatch(*path.Value); err != nil {
+			valErr := field.Invalid(fieldPath.Child("value"), *path.Value, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+	case v1.PathMatchRegularExpression:
+		if err := validator.ValidatePathRegexInMatch(*path.Value); err != nil {
+			valErr := field.Invalid(fieldPath.Child("value"), *path.Value, err.Error())
+			allErrs = append(allErrs, valErr)
+		}
+	default:
 		valErr := field.NotSupported(fieldPath.Child("type"), *path.Type,
-			[]string{string(v1.PathMatchExact), string(v1.PathMatchPathPrefix)})
-		allErrs = append(allErrs, valErr)
-	}
-
-	if err := validator.ValidatePathInMatch(*path.Value); err != nil {
-		valErr := field.Invalid(fieldPath.Child("value"), *path.Value, err.Error())
+			[]string{
+				string(v1.PathMatchExact),
+				string(v1.PathMatchPathPrefix),
+				string(v1.PathMatchRegularExpression),
+			})
 		allErrs = append(allErrs, valErr)
 	}
 
@@ -265,6 +337,8 @@ func validateFilter(
 		return validateFilterResponseHeaderModifier(
 			validator, filter.ResponseHeaderModifier, filterPath.Child(string(filter.Type)),
 		)
+	case v1.HTTPRouteFilterExtensionRef:
+		return validateFilterExtensionRef(filter.ExtensionRef, filterPath)
 	default:
 		valErr := field.NotSupported(
 			filterPath.Child("type"),
@@ -274,6 +348,7 @@ func validateFilter(
 				string(v1.HTTPRouteFilterURLRewrite),
 				string(v1.HTTPRouteFilterRequestHeaderModifier),
 				string(v1.HTTPRouteFilterResponseHeaderModifier),
+				string(v1.HTTPRouteFilterExtensionRef),
 			},
 		)
 		allErrs = append(allErrs, valErr)
@@ -316,8 +391,7 @@ func validateFilterRedirect(
 	}
 
 	if redirect.Path != nil {
-		valErr := field.Forbidden(redirectPath.Child("path"), "path is not supported")
-		allErrs = append(allErrs, valErr)
+		allErrs = append(allErrs, validatePathModifier(validator, redirect.Path, redirectPath.Child("path"))...)
 	}
 
 	if redirect.StatusCode != nil {
@@ -351,23 +425,38 @@ func validateFilterRewrite(
 	}
 
 	if rewrite.Path != nil {
-		var path string
-		switch rewrite.Path.Type {
-		case v1.FullPathHTTPPathModifier:
-			path = *rewrite.Path.ReplaceFullPath
-		case v1.PrefixMatchHTTPPathModifier:
-			path = *rewrite.Path.ReplacePrefixMatch
-		default:
-			msg := fmt.Sprintf("urlRewrite path type %s not supported", rewrite.Path.Type)
-			valErr := field.Invalid(rewritePath.Child("path"), *rewrite.Path, msg)
-			return append(allErrs, valErr)
-		}
-
-		if err := validator.ValidateRewritePath(path); err != nil {
-			valErr := field.Invalid(rewritePath.Child("path"), *rewrite.Path, err.Error())
-			allErrs = append(allErrs, valErr)
-		}
+		allErrs = append(allErrs, validatePathModifier(validator, rewrite.Path, rewritePath.Child("path"))...)
 	}
 
 	return allErrs
 }
+
+// validatePathModifier validates the path modifier of a urlRewrite or requestRedirect filter.
+func validatePathModifier(
+	validator validation.HTTPFieldsValidator,
+	modifier *v1.HTTPPathModifier,
+	modifierPath *field.Path,
+) field.ErrorList {
+	var path *string
+
+	switch modifier.Type {
+	case v1.FullPathHTTPPathModifier:
+		path = modifier.ReplaceFullPath
+	case v1.PrefixMatchHTTPPathModifier:
+		path = modifier.ReplacePrefixMatch
+	default:
+		msg := fmt.Sprintf("path type %s not supported", modifier.Type)
+		return field.ErrorList{field.Invalid(modifierPath, *modifier, msg)}
+	}
+
+	if path == nil {
+		msg := fmt.Sprintf("path must be set for path type %s", modifier.Type)
+		return field.ErrorList{field.Invalid(modifierPath, *modifier, msg)}
+	}
+
+	if err := validator.ValidateRewritePath(*path); err != nil {
+		return field.ErrorList{field.Invalid(modifierPath, *modifier, err.Error())}
+	}
+
+	return nil
+}
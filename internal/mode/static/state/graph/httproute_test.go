@@ -11,8 +11,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
 )
@@ -211,6 +213,14 @@ func TestBuildHTTPRoute(t *testing.T) {
 		hrDuplicateSectionName.Spec.ParentRefs[0],
 	)
 
+	hrUnsupportedFields := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/", "/unsupported")
+	hrUnsupportedFields.Spec.Rules[0].Timeouts = &gatewayv1.HTTPRouteTimeouts{
+		Request: helpers.GetPointer[gatewayv1.Duration]("10s"),
+	}
+	hrUnsupportedFields.Spec.Rules[1].SessionPersistence = &gatewayv1.SessionPersistence{
+		SessionName: helpers.GetPointer("session"),
+	}
+
 	validatorInvalidFieldsInRule := &validationfakes.FakeHTTPFieldsValidator{
 		ValidatePathInMatchStub: func(path string) error {
 			if path == invalidPath {
@@ -577,6 +587,47 @@ func TestBuildHTTPRoute(t *testing.T) {
 			},
 			name: "dropped invalid rule with invalid filters",
 		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrUnsupportedFields,
+			expected: &L7Route{
+				RouteType: RouteTypeHTTP,
+				Source:    hrUnsupportedFields,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrUnsupportedFields.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedField([]string{
+						"spec.rules[0].timeouts",
+						"spec.rules[1].sessionPersistence",
+					}),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: hrUnsupportedFields.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches:     true,
+							ValidFilters:     true,
+							Matches:          hrUnsupportedFields.Spec.Rules[0].Matches,
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+						{
+							ValidMatches:     true,
+							ValidFilters:     true,
+							Matches:          hrUnsupportedFields.Spec.Rules[1].Matches,
+							RouteBackendRefs: []RouteBackendRef{},
+						},
+					},
+				},
+			},
+			name: "unsupported fields are ignored",
+		},
 	}
 
 	gatewayNsNames := []types.NamespacedName{gatewayNsName}
@@ -648,6 +699,32 @@ func TestValidateMatch(t *testing.T) {
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
 					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/v[0-9]+/.*"),
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid regular expression match",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidatePathRegexInMatchReturns(errors.New("invalid regex"))
+				return validator
+			}(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression),
+					Value: helpers.GetPointer("/v[0-9+/"),
+				},
+			},
+			expectErrCount: 1,
+			name:           "invalid regular expression",
+		},
+		{
+			validator: createAllValidValidator(),
+			match: gatewayv1.HTTPRouteMatch{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  helpers.GetPointer(gatewayv1.PathMatchType("Unknown")),
 					Value: helpers.GetPointer("/"),
 				},
 			},
@@ -824,7 +901,7 @@ func TestValidateMatch(t *testing.T) {
 			validator: createAllValidValidator(),
 			match: gatewayv1.HTTPRouteMatch{
 				Path: &gatewayv1.HTTPPathMatch{
-					Type:  helpers.GetPointer(gatewayv1.PathMatchRegularExpression), // invalid
+					Type:  helpers.GetPointer(gatewayv1.PathMatchType("Unknown")), // invalid
 					Value: helpers.GetPointer("/"),
 				},
 				Headers: []gatewayv1.HTTPHeaderMatch{
@@ -896,6 +973,37 @@ func TestValidateFilter(t *testing.T) {
 			expectErrCount: 0,
 			name:           "valid response header modifiers filter",
 		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gatewayv1.LocalObjectReference{
+					Group: ngfAPI.GroupName,
+					Kind:  kinds.RateLimitFilter,
+					Name:  "rate-limit",
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid extensionRef filter",
+		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+			},
+			expectErrCount: 1,
+			name:           "extensionRef filter without extensionRef",
+		},
+		{
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gatewayv1.LocalObjectReference{
+					Group: "example.com",
+					Kind:  "MyFilter",
+					Name:  "filter",
+				},
+			},
+			expectErrCount: 2,
+			name:           "extensionRef filter with unsupported group and kind",
+		},
 		{
 			filter: gatewayv1.HTTPRouteFilter{
 				Type: gatewayv1.HTTPRouteFilterRequestMirror,
@@ -1003,6 +1111,42 @@ func TestValidateFilterRedirect(t *testing.T) {
 			expectErrCount: 1,
 			name:           "redirect filter with unsupported path modifier",
 		},
+		{
+			validator: createAllValidValidator(),
+			requestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+				Path: &gatewayv1.HTTPPathModifier{
+					Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: helpers.GetPointer("/prefix"),
+				},
+			},
+			expectErrCount: 0,
+			name:           "redirect filter with prefix match path",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				validator := createAllValidValidator()
+				validator.ValidateRewritePathReturns(errors.New("invalid path"))
+				return validator
+			}(),
+			requestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+				Path: &gatewayv1.HTTPPathModifier{
+					Type:            gatewayv1.FullPathHTTPPathModifier,
+					ReplaceFullPath: helpers.GetPointer("/full"), // any value is invalid by the validator
+				},
+			},
+			expectErrCount: 1,
+			name:           "redirect filter with invalid full path",
+		},
+		{
+			validator: createAllValidValidator(),
+			requestRedirect: &gatewayv1.HTTPRequestRedirectFilter{
+				Path: &gatewayv1.HTTPPathModifier{
+					Type: gatewayv1.FullPathHTTPPathModifier,
+				},
+			},
+			expectErrCount: 1,
+			name:           "redirect filter with missing full path",
+		},
 		{
 			validator: func() *validationfakes.FakeHTTPFieldsValidator {
 				validator := createAllValidValidator()
@@ -1,6 +1,10 @@
 package graph
 
 import (
+	"regexp"
+	"slices"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/types"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -128,10 +132,326 @@ func validateNginxProxy(
 	}
 
 	allErrs = append(allErrs, validateRewriteClientIP(npCfg)...)
+	allErrs = append(allErrs, validateDefaultServers(validator, npCfg)...)
+	allErrs = append(allErrs, validateHTTPSRedirect(npCfg)...)
+	allErrs = append(allErrs, validateConnectionLimits(npCfg)...)
+	allErrs = append(allErrs, validateGeoIP(validator, npCfg)...)
+	allErrs = append(allErrs, validateLogging(npCfg)...)
+
+	if npCfg.Spec.ServerHeader != nil && npCfg.Spec.ServerHeader.Value != nil {
+		value := *npCfg.Spec.ServerHeader.Value
+		if err := validator.ValidateServerHeaderValue(value); err != nil {
+			valuePath := spec.Child("serverHeader").Child("value")
+			allErrs = append(allErrs, field.Invalid(valuePath, value, err.Error()))
+		}
+	}
+
+	if npCfg.Spec.UpstreamZoneSize != nil {
+		size := *npCfg.Spec.UpstreamZoneSize
+		if err := validator.ValidateNginxSize(string(size)); err != nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("upstreamZoneSize"), size, err.Error()))
+		}
+	}
+
+	if npCfg.Spec.UpstreamDrainTimeout != nil {
+		timeout := *npCfg.Spec.UpstreamDrainTimeout
+		if err := validator.ValidateNginxDuration(string(timeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(spec.Child("upstreamDrainTimeout"), timeout, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateHTTPSRedirect(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+	redirectPath := field.NewPath("spec").Child("httpsRedirect")
+
+	redirect := npCfg.Spec.HTTPSRedirect
+	if redirect == nil {
+		return allErrs
+	}
+
+	if redirect.Port != nil && (*redirect.Port < 1 || *redirect.Port > 65535) {
+		allErrs = append(allErrs, field.Invalid(redirectPath.Child("port"), *redirect.Port, "must be between 1 and 65535"))
+	}
+
+	if redirect.StatusCode != nil {
+		if _, ok := supportedRedirectCodes[*redirect.StatusCode]; !ok {
+			allErrs = append(
+				allErrs,
+				field.NotSupported(
+					redirectPath.Child("statusCode"),
+					*redirect.StatusCode,
+					[]string{"301", "302", "307", "308"},
+				),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func validateConnectionLimits(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+	limitsPath := field.NewPath("spec").Child("connectionLimits")
+
+	limits := npCfg.Spec.ConnectionLimits
+	if limits == nil {
+		return allErrs
+	}
+
+	if limits.WorkerConnections != nil && (*limits.WorkerConnections < 1 || *limits.WorkerConnections > 65535) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(limitsPath.Child("workerConnections"), *limits.WorkerConnections, "must be between 1 and 65535"),
+		)
+	}
+
+	if limits.DefaultServerMaxConnections != nil && *limits.DefaultServerMaxConnections < 1 {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				limitsPath.Child("defaultServerMaxConnections"),
+				*limits.DefaultServerMaxConnections,
+				"must be greater than 0",
+			),
+		)
+	}
+
+	if limits.MaxRequestRate != nil && (*limits.MaxRequestRate < 1 || *limits.MaxRequestRate > 999999) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(limitsPath.Child("maxRequestRate"), *limits.MaxRequestRate, "must be between 1 and 999999"),
+		)
+	}
+
+	return allErrs
+}
+
+// geoIPDatabasePathRegexp matches the paths of the GeoIP database, which are written unquoted in the NGINX config.
+var geoIPDatabasePathRegexp = regexp.MustCompile(`^/[A-Za-z0-9._/-]*\.mmdb$`)
+
+func validateGeoIP(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+	geoIPPath := field.NewPath("spec").Child("geoIP")
+
+	geoIP := npCfg.Spec.GeoIP
+	if geoIP == nil {
+		return allErrs
+	}
+
+	if !geoIPDatabasePathRegexp.MatchString(geoIP.DatabasePath) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				geoIPPath.Child("databasePath"),
+				geoIP.DatabasePath,
+				"must be an absolute path of an .mmdb file that contains only alphanumeric characters, '.', '_', '/', or '-'",
+			),
+		)
+	}
+
+	if geoIP.AutoReloadInterval != nil {
+		if err := validator.ValidateNginxDuration(string(*geoIP.AutoReloadInterval)); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(geoIPPath.Child("autoReloadInterval"), *geoIP.AutoReloadInterval, err.Error()),
+			)
+		}
+	}
+
+	return allErrs
+}
+
+// streamLogFormatRegexp matches the formats of the stream access log, which are written in single quotes
+// in the NGINX config.
+var streamLogFormatRegexp = regexp.MustCompile(`^[^'\\]+$`)
+
+func validateLogging(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+	loggingPath := field.NewPath("spec").Child("logging")
+
+	logging := npCfg.Spec.Logging
+	if logging == nil {
+		return allErrs
+	}
+
+	if logging.AccessLogFormat != nil && !slices.Contains(supportedAccessLogFormats, string(*logging.AccessLogFormat)) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(
+				loggingPath.Child("accessLogFormat"),
+				*logging.AccessLogFormat,
+				supportedAccessLogFormats,
+			),
+		)
+	}
+
+	if logging.ErrorLevel != nil && !isSupportedErrorLogLevel(string(*logging.ErrorLevel)) {
+		allErrs = append(
+			allErrs,
+			field.NotSupported(loggingPath.Child("errorLevel"), *logging.ErrorLevel, supportedErrorLogLevels),
+		)
+	}
+
+	if logging.StreamAccessLog == nil || logging.StreamAccessLog.Format == nil {
+		return allErrs
+	}
+
+	format := *logging.StreamAccessLog.Format
+	if !streamLogFormatRegexp.MatchString(format) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				loggingPath.Child("streamAccessLog").Child("format"),
+				format,
+				"must be a non-empty string without single quotes or backslashes",
+			),
+		)
+	}
+
+	return allErrs
+}
+
+var supportedAccessLogFormats = []string{
+	string(ngfAPI.NginxAccessLogFormatCombined),
+	string(ngfAPI.NginxAccessLogFormatKubernetes),
+}
+
+// supportedErrorLogLevels are the levels of the NGINX error log, from the most to the least verbose.
+var supportedErrorLogLevels = []string{
+	string(ngfAPI.NginxErrorLogLevelDebug),
+	string(ngfAPI.NginxErrorLogLevelInfo),
+	string(ngfAPI.NginxErrorLogLevelNotice),
+	string(ngfAPI.NginxErrorLogLevelWarn),
+	string(ngfAPI.NginxErrorLogLevelError),
+	string(ngfAPI.NginxErrorLogLevelCrit),
+	string(ngfAPI.NginxErrorLogLevelAlert),
+	string(ngfAPI.NginxErrorLogLevelEmerg),
+}
+
+func isSupportedErrorLogLevel(level string) bool {
+	return slices.Contains(supportedErrorLogLevels, level)
+}
+
+var supportedRedirectCodes = map[int]struct{}{
+	301: {},
+	302: {},
+	307: {},
+	308: {},
+}
+
+func validateDefaultServers(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+	defaultServersPath := field.NewPath("spec").Child("defaultServers")
+
+	ports := make(map[int32]struct{})
+	var anyPortFound bool
+
+	for i, ds := range npCfg.Spec.DefaultServers {
+		dsPath := defaultServersPath.Index(i)
+
+		if ds.Port != nil {
+			if _, exists := ports[*ds.Port]; exists {
+				allErrs = append(allErrs, field.Duplicate(dsPath.Child("port"), *ds.Port))
+			}
+			ports[*ds.Port] = struct{}{}
+		} else {
+			if anyPortFound {
+				allErrs = append(
+					allErrs,
+					field.Duplicate(dsPath.Child("port"), "only one entry without a port is allowed"),
+				)
+			}
+			anyPortFound = true
+		}
+
+		if (ds.Redirect == nil) == (ds.Response == nil) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(dsPath, ds, "exactly one of redirect or response must be specified"),
+			)
+			continue
+		}
+
+		if ds.Redirect != nil {
+			redirectPath := dsPath.Child("redirect")
+
+			if err := validator.ValidateEscapedStringNoVarExpansion(ds.Redirect.URL); err != nil {
+				allErrs = append(allErrs, field.Invalid(redirectPath.Child("url"), ds.Redirect.URL, err.Error()))
+			}
+
+			if ds.Redirect.StatusCode != nil {
+				if _, ok := supportedRedirectCodes[*ds.Redirect.StatusCode]; !ok {
+					allErrs = append(
+						allErrs,
+						field.NotSupported(
+							redirectPath.Child("statusCode"),
+							*ds.Redirect.StatusCode,
+							[]string{"301", "302", "307", "308"},
+						),
+					)
+				}
+			}
+		}
+
+		if ds.Response != nil {
+			responsePath := dsPath.Child("response")
+
+			if ds.Response.StatusCode < 200 || ds.Response.StatusCode > 599 {
+				allErrs = append(
+					allErrs,
+					field.Invalid(
+						responsePath.Child("statusCode"),
+						ds.Response.StatusCode,
+						"must be between 200 and 599",
+					),
+				)
+			}
+
+			if ds.Response.Body != nil {
+				if err := validator.ValidateReturnBody(*ds.Response.Body); err != nil {
+					allErrs = append(allErrs, field.Invalid(responsePath.Child("body"), *ds.Response.Body, err.Error()))
+				} else if strings.Contains(*ds.Response.Body, "$") && !isPlainTextContentType(ds.Response.ContentType) {
+					allErrs = append(
+						allErrs,
+						field.Invalid(
+							responsePath.Child("body"),
+							*ds.Response.Body,
+							"variables can only be referenced if the contentType is text/plain",
+						),
+					)
+				}
+			}
+
+			if ds.Response.ContentType != nil {
+				if err := validator.ValidateEscapedStringNoVarExpansion(*ds.Response.ContentType); err != nil {
+					allErrs = append(
+						allErrs,
+						field.Invalid(responsePath.Child("contentType"), *ds.Response.ContentType, err.Error()),
+					)
+				}
+			}
+		}
+	}
 
 	return allErrs
 }
 
+// isPlainTextContentType returns whether the content type of a default server response is text/plain, which is the
+// default. The $host and $request_uri variables are not escaped, so a body that references them with another content
+// type, such as text/html, would let a client inject markup into the response.
+func isPlainTextContentType(contentType *string) bool {
+	if contentType == nil {
+		return true
+	}
+
+	mediaType, _, _ := strings.Cut(*contentType, ";")
+
+	return strings.EqualFold(strings.TrimSpace(mediaType), "text/plain")
+}
+
 func validateRewriteClientIP(npCfg *ngfAPI.NginxProxy) field.ErrorList {
 	var allErrs field.ErrorList
 	spec := field.NewPath("spec")
@@ -143,10 +463,10 @@ func validateRewriteClientIP(npCfg *ngfAPI.NginxProxy) field.ErrorList {
 
 		if rewriteClientIP.Mode != nil {
 			mode := *rewriteClientIP.Mode
-			if len(rewriteClientIP.TrustedAddresses) == 0 {
+			if len(rewriteClientIP.TrustedAddresses) == 0 && len(rewriteClientIP.TrustedHops) == 0 {
 				allErrs = append(
 					allErrs,
-					field.Required(rewriteClientIPPath, "trustedAddresses field required when mode is set"),
+					field.Required(rewriteClientIPPath, "trustedAddresses or trustedHops field required when mode is set"),
 				)
 			}
 
@@ -171,27 +491,100 @@ func validateRewriteClientIP(npCfg *ngfAPI.NginxProxy) field.ErrorList {
 			)
 		}
 
-		for _, addr := range rewriteClientIP.TrustedAddresses {
-			switch addr.Type {
-			case ngfAPI.AddressTypeCIDR:
-				if err := k8svalidation.IsValidCIDR(trustedAddressesPath, addr.Value); err != nil {
-					allErrs = append(
-						allErrs,
-						field.Invalid(trustedAddressesPath.Child(addr.Value),
-							addr,
-							err.ToAggregate().Error(),
-						),
-					)
-				}
-			default:
+		allErrs = append(allErrs, validateAddresses(trustedAddressesPath, rewriteClientIP.TrustedAddresses)...)
+		allErrs = append(allErrs, validateTrustedHops(rewriteClientIPPath, rewriteClientIP)...)
+	}
+
+	return allErrs
+}
+
+// trustedHopProtoHeaderRegexp matches the proto headers of the trusted hops, which are used in NGINX variable names.
+var trustedHopProtoHeaderRegexp = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+func validateTrustedHops(rewriteClientIPPath *field.Path, rewriteClientIP *ngfAPI.RewriteClientIP) field.ErrorList {
+	var allErrs field.ErrorList
+	trustedHopsPath := rewriteClientIPPath.Child("trustedHops")
+
+	if len(rewriteClientIP.TrustedHops) == 0 {
+		return allErrs
+	}
+
+	modePath := rewriteClientIPPath.Child("mode")
+
+	switch {
+	case rewriteClientIP.Mode == nil:
+		allErrs = append(allErrs, field.Required(modePath, "mode must be XForwardedFor when trustedHops is set"))
+	case *rewriteClientIP.Mode != ngfAPI.RewriteClientIPModeXForwardedFor:
+		allErrs = append(
+			allErrs,
+			field.Invalid(modePath, *rewriteClientIP.Mode, "must be XForwardedFor when trustedHops is set"),
+		)
+	}
+
+	if len(rewriteClientIP.TrustedHops) > 4 {
+		allErrs = append(allErrs, field.TooMany(trustedHopsPath, len(rewriteClientIP.TrustedHops), 4))
+	}
+
+	names := make(map[string]struct{}, len(rewriteClientIP.TrustedHops))
+
+	for i, hop := range rewriteClientIP.TrustedHops {
+		hopPath := trustedHopsPath.Index(i)
+
+		if _, exists := names[hop.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(hopPath.Child("name"), hop.Name))
+		}
+		names[hop.Name] = struct{}{}
+
+		if hop.ProtoHeader != nil && !trustedHopProtoHeaderRegexp.MatchString(string(*hop.ProtoHeader)) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					hopPath.Child("protoHeader"),
+					*hop.ProtoHeader,
+					"must contain only alphanumeric characters or '-'",
+				),
+			)
+		}
+
+		addressesPath := hopPath.Child("addresses")
+
+		if len(hop.Addresses) == 0 {
+			allErrs = append(allErrs, field.Required(addressesPath, "at least one address is required"))
+		}
+
+		if len(hop.Addresses) > 16 {
+			allErrs = append(allErrs, field.TooLongMaxLength(addressesPath, hop.Addresses, 16))
+		}
+
+		allErrs = append(allErrs, validateAddresses(addressesPath, hop.Addresses)...)
+	}
+
+	return allErrs
+}
+
+func validateAddresses(addressesPath *field.Path, addresses []ngfAPI.Address) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, addr := range addresses {
+		switch addr.Type {
+		case ngfAPI.AddressTypeCIDR:
+			if err := k8svalidation.IsValidCIDR(addressesPath, addr.Value); err != nil {
 				allErrs = append(
 					allErrs,
-					field.NotSupported(trustedAddressesPath.Child("type"),
-						addr.Type,
-						[]string{string(ngfAPI.AddressTypeCIDR)},
+					field.Invalid(addressesPath.Child(addr.Value),
+						addr,
+						err.ToAggregate().Error(),
 					),
 				)
 			}
+		default:
+			allErrs = append(
+				allErrs,
+				field.NotSupported(addressesPath.Child("type"),
+					addr.Type,
+					[]string{string(ngfAPI.AddressTypeCIDR)},
+				),
+			)
 		}
 	}
 
@@ -234,6 +234,9 @@ func createValidValidator() *validationfakes.FakeGenericValidator {
 	v.ValidateEndpointReturns(nil)
 	v.ValidateServiceNameReturns(nil)
 	v.ValidateNginxDurationReturns(nil)
+	v.ValidateReturnBodyReturns(nil)
+	v.ValidateNginxSizeReturns(nil)
+	v.ValidateServerHeaderValueReturns(nil)
 
 	return v
 }
@@ -244,6 +247,9 @@ func createInvalidValidator() *validationfakes.FakeGenericValidator {
 	v.ValidateEndpointReturns(errors.New("error"))
 	v.ValidateServiceNameReturns(errors.New("error"))
 	v.ValidateNginxDurationReturns(errors.New("error"))
+	v.ValidateReturnBodyReturns(errors.New("error"))
+	v.ValidateNginxSizeReturns(errors.New("error"))
+	v.ValidateServerHeaderValueReturns(errors.New("error"))
 
 	return v
 }
@@ -272,7 +278,9 @@ func TestValidateNginxProxy(t *testing.T) {
 							{Key: "key", Value: "value"},
 						},
 					},
-					IPFamily: helpers.GetPointer[ngfAPI.IPFamilyType](ngfAPI.Dual),
+					IPFamily:             helpers.GetPointer[ngfAPI.IPFamilyType](ngfAPI.Dual),
+					UpstreamZoneSize:     helpers.GetPointer[ngfAPI.Size]("2m"),
+					UpstreamDrainTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
 					RewriteClientIP: &ngfAPI.RewriteClientIP{
 						SetIPRecursively: helpers.GetPointer(true),
 						TrustedAddresses: []ngfAPI.Address{
@@ -351,6 +359,41 @@ func TestValidateNginxProxy(t *testing.T) {
 			expErrSubstring: "telemetry.spanAttributes",
 			expectErrCount:  2,
 		},
+		{
+			name:      "invalid serverHeader value",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					ServerHeader: &ngfAPI.ServerHeader{
+						Value: helpers.GetPointer("my-server"), // any value is invalid by the validator
+					},
+				},
+			},
+			expErrSubstring: "spec.serverHeader.value",
+			expectErrCount:  1,
+		},
+		{
+			name:      "invalid upstreamZoneSize",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					UpstreamZoneSize: helpers.GetPointer[ngfAPI.Size]("1x"), // any value is invalid by the validator
+				},
+			},
+			expErrSubstring: "spec.upstreamZoneSize",
+			expectErrCount:  1,
+		},
+		{
+			name:      "invalid upstreamDrainTimeout",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					UpstreamDrainTimeout: helpers.GetPointer[ngfAPI.Duration]("1y"), // any value is invalid by the validator
+				},
+			},
+			expErrSubstring: "spec.upstreamDrainTimeout",
+			expectErrCount:  1,
+		},
 		{
 			name:      "invalid ipFamily type",
 			validator: createInvalidValidator(),
@@ -449,7 +492,8 @@ func TestValidateRewriteClientIP(t *testing.T) {
 				},
 			},
 			expectErrCount: 1,
-			errorString:    "spec.rewriteClientIP: Required value: trustedAddresses field required when mode is set",
+			errorString: "spec.rewriteClientIP: Required value: " +
+				"trustedAddresses or trustedHops field required when mode is set",
 		},
 		{
 			name:      "invalid when trustedAddresses is greater in length than 16",
@@ -522,7 +566,7 @@ func TestValidateRewriteClientIP(t *testing.T) {
 				},
 			},
 			expectErrCount: 2,
-			errorString: "[spec.rewriteClientIP: Required value: trustedAddresses field " +
+			errorString: "[spec.rewriteClientIP: Required value: trustedAddresses or trustedHops field " +
 				"required when mode is set, spec.rewriteClientIP.mode: " +
 				"Unsupported value: \"invalid\": supported values: \"ProxyProtocol\", \"XForwardedFor\"]",
 		},
@@ -547,6 +591,61 @@ func TestValidateRewriteClientIP(t *testing.T) {
 			errorString: "spec.rewriteClientIP.trustedAddresses.type: " +
 				"Unsupported value: \"invalid\": supported values: \"cidr\"",
 		},
+		{
+			name:      "valid trustedHops",
+			validator: createValidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					RewriteClientIP: &ngfAPI.RewriteClientIP{
+						Mode: helpers.GetPointer(ngfAPI.RewriteClientIPModeXForwardedFor),
+						TrustedHops: []ngfAPI.TrustedProxyHop{
+							{
+								Name:        "cdn",
+								ProtoHeader: helpers.GetPointer[v1.HTTPHeaderName]("CloudFront-Forwarded-Proto"),
+								Addresses:   []ngfAPI.Address{{Type: ngfAPI.AddressTypeCIDR, Value: "130.176.0.0/16"}},
+							},
+							{
+								Name:      "lb",
+								Addresses: []ngfAPI.Address{{Type: ngfAPI.AddressTypeCIDR, Value: "10.0.0.0/8"}},
+							},
+						},
+					},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid trustedHops",
+			validator: createInvalidValidator(),
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					RewriteClientIP: &ngfAPI.RewriteClientIP{
+						Mode: helpers.GetPointer(ngfAPI.RewriteClientIPModeProxyProtocol),
+						TrustedHops: []ngfAPI.TrustedProxyHop{
+							{
+								Name:        "cdn",
+								ProtoHeader: helpers.GetPointer[v1.HTTPHeaderName]("X_Proto"),
+								Addresses:   []ngfAPI.Address{{Type: ngfAPI.AddressTypeCIDR, Value: "130.176.0.0/33"}},
+							},
+							{
+								Name: "cdn",
+							},
+						},
+					},
+				},
+			},
+			expectErrCount: 5,
+			errorString: "[spec.rewriteClientIP.mode: Invalid value: \"ProxyProtocol\": " +
+				"must be XForwardedFor when trustedHops is set, " +
+				"spec.rewriteClientIP.trustedHops[0].protoHeader: Invalid value: \"X_Proto\": " +
+				"must contain only alphanumeric characters or '-', " +
+				"spec.rewriteClientIP.trustedHops[0].addresses.130.176.0.0/33: Invalid value: " +
+				"v1alpha1.Address{Type:\"cidr\", Value:\"130.176.0.0/33\"}: " +
+				"spec.rewriteClientIP.trustedHops[0].addresses: Invalid value: \"130.176.0.0/33\": " +
+				"must be a valid CIDR value, (e.g. 10.9.8.0/24 or 2001:db8::/64), " +
+				"spec.rewriteClientIP.trustedHops[1].name: Duplicate value: \"cdn\", " +
+				"spec.rewriteClientIP.trustedHops[1].addresses: Required value: at least one address is required]",
+		},
 	}
 
 	for _, test := range tests {
@@ -562,3 +661,453 @@ func TestValidateRewriteClientIP(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDefaultServers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		validator      *validationfakes.FakeGenericValidator
+		name           string
+		errorString    string
+		defaultServers []ngfAPI.DefaultServer
+		expectErrCount int
+	}{
+		{
+			name:      "valid defaultServers",
+			validator: createValidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{
+					Redirect: &ngfAPI.DefaultServerRedirect{
+						URL:                "https://example.com",
+						StatusCode:         helpers.GetPointer(301),
+						PreserveRequestURI: true,
+					},
+				},
+				{
+					Port: helpers.GetPointer[int32](8080),
+					Response: &ngfAPI.DefaultServerResponse{
+						StatusCode:  503,
+						Body:        helpers.GetPointer("unknown host $host"),
+						ContentType: helpers.GetPointer("text/plain"),
+					},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid redirect url and status code",
+			validator: createInvalidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{
+					Redirect: &ngfAPI.DefaultServerRedirect{
+						URL:        "https://example.com",
+						StatusCode: helpers.GetPointer(404),
+					},
+				},
+			},
+			expectErrCount: 2,
+			errorString: "[spec.defaultServers[0].redirect.url: Invalid value: \"https://example.com\": error, " +
+				"spec.defaultServers[0].redirect.statusCode: Unsupported value: 404: " +
+				"supported values: \"301\", \"302\", \"307\", \"308\"]",
+		},
+		{
+			name:      "invalid response status code, body, and content type",
+			validator: createInvalidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{
+					Response: &ngfAPI.DefaultServerResponse{
+						StatusCode:  100,
+						Body:        helpers.GetPointer("body"),
+						ContentType: helpers.GetPointer("text/plain"),
+					},
+				},
+			},
+			expectErrCount: 3,
+			errorString: "[spec.defaultServers[0].response.statusCode: Invalid value: 100: " +
+				"must be between 200 and 599, " +
+				"spec.defaultServers[0].response.body: Invalid value: \"body\": error, " +
+				"spec.defaultServers[0].response.contentType: Invalid value: \"text/plain\": error]",
+		},
+		{
+			name:      "valid response body with variables and the default content type",
+			validator: createValidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{
+					Response: &ngfAPI.DefaultServerResponse{
+						StatusCode: 404,
+						Body:       helpers.GetPointer("no route for $host$request_uri"),
+					},
+				},
+				{
+					Port: helpers.GetPointer[int32](8080),
+					Response: &ngfAPI.DefaultServerResponse{
+						StatusCode:  404,
+						Body:        helpers.GetPointer("no route for $host"),
+						ContentType: helpers.GetPointer("Text/Plain; charset=utf-8"),
+					},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid response body with variables and an HTML content type",
+			validator: createValidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{
+					Response: &ngfAPI.DefaultServerResponse{
+						StatusCode:  404,
+						Body:        helpers.GetPointer("<p>No route for $host</p>"),
+						ContentType: helpers.GetPointer("text/html"),
+					},
+				},
+				{
+					Port: helpers.GetPointer[int32](8080),
+					Response: &ngfAPI.DefaultServerResponse{
+						StatusCode:  404,
+						Body:        helpers.GetPointer("<p>No route</p>"),
+						ContentType: helpers.GetPointer("text/html"),
+					},
+				},
+			},
+			expectErrCount: 1,
+			errorString: "spec.defaultServers[0].response.body: Invalid value: \"<p>No route for $host</p>\": " +
+				"variables can only be referenced if the contentType is text/plain",
+		},
+		{
+			name:      "invalid when both redirect and response are set",
+			validator: createValidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{
+					Redirect: &ngfAPI.DefaultServerRedirect{URL: "https://example.com"},
+					Response: &ngfAPI.DefaultServerResponse{StatusCode: 404},
+				},
+			},
+			expectErrCount: 1,
+			errorString:    "exactly one of redirect or response must be specified",
+		},
+		{
+			name:      "invalid when neither redirect nor response is set",
+			validator: createValidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{Port: helpers.GetPointer[int32](80)},
+			},
+			expectErrCount: 1,
+			errorString:    "exactly one of redirect or response must be specified",
+		},
+		{
+			name:      "invalid duplicate ports",
+			validator: createValidValidator(),
+			defaultServers: []ngfAPI.DefaultServer{
+				{
+					Port:     helpers.GetPointer[int32](80),
+					Response: &ngfAPI.DefaultServerResponse{StatusCode: 404},
+				},
+				{
+					Port:     helpers.GetPointer[int32](80),
+					Response: &ngfAPI.DefaultServerResponse{StatusCode: 410},
+				},
+				{
+					Response: &ngfAPI.DefaultServerResponse{StatusCode: 404},
+				},
+				{
+					Response: &ngfAPI.DefaultServerResponse{StatusCode: 410},
+				},
+			},
+			expectErrCount: 2,
+			errorString: "[spec.defaultServers[1].port: Duplicate value: 80, " +
+				"spec.defaultServers[3].port: Duplicate value: \"only one entry without a port is allowed\"]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DefaultServers: test.defaultServers,
+				},
+			}
+
+			allErrs := validateDefaultServers(test.validator, np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(ContainSubstring(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateHTTPSRedirect(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		redirect       *ngfAPI.HTTPSRedirect
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			name:           "httpsRedirect not set",
+			redirect:       nil,
+			expectErrCount: 0,
+		},
+		{
+			name: "valid httpsRedirect",
+			redirect: &ngfAPI.HTTPSRedirect{
+				Port:       helpers.GetPointer[int32](8080),
+				StatusCode: helpers.GetPointer(308),
+			},
+			expectErrCount: 0,
+		},
+		{
+			name: "invalid port and status code",
+			redirect: &ngfAPI.HTTPSRedirect{
+				Port:       helpers.GetPointer[int32](0),
+				StatusCode: helpers.GetPointer(200),
+			},
+			expectErrCount: 2,
+			errorString: "[spec.httpsRedirect.port: Invalid value: 0: must be between 1 and 65535, " +
+				"spec.httpsRedirect.statusCode: Unsupported value: 200: " +
+				"supported values: \"301\", \"302\", \"307\", \"308\"]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					HTTPSRedirect: test.redirect,
+				},
+			}
+
+			allErrs := validateHTTPSRedirect(np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateConnectionLimits(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		limits         *ngfAPI.ConnectionLimits
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			name:           "connectionLimits not set",
+			limits:         nil,
+			expectErrCount: 0,
+		},
+		{
+			name: "valid connectionLimits",
+			limits: &ngfAPI.ConnectionLimits{
+				WorkerConnections:           helpers.GetPointer[int32](4096),
+				DefaultServerMaxConnections: helpers.GetPointer[int32](10),
+				MaxRequestRate:              helpers.GetPointer[int32](1000),
+			},
+			expectErrCount: 0,
+		},
+		{
+			name: "invalid connectionLimits",
+			limits: &ngfAPI.ConnectionLimits{
+				WorkerConnections:           helpers.GetPointer[int32](70000),
+				DefaultServerMaxConnections: helpers.GetPointer[int32](0),
+				MaxRequestRate:              helpers.GetPointer[int32](-1),
+			},
+			expectErrCount: 3,
+			errorString: "[spec.connectionLimits.workerConnections: Invalid value: 70000: must be between 1 and 65535, " +
+				"spec.connectionLimits.defaultServerMaxConnections: Invalid value: 0: must be greater than 0, " +
+				"spec.connectionLimits.maxRequestRate: Invalid value: -1: must be between 1 and 999999]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					ConnectionLimits: test.limits,
+				},
+			}
+
+			allErrs := validateConnectionLimits(np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateGeoIP(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		geoIP          *ngfAPI.GeoIP
+		validator      *validationfakes.FakeGenericValidator
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			name:           "geoIP not set",
+			validator:      createValidValidator(),
+			geoIP:          nil,
+			expectErrCount: 0,
+		},
+		{
+			name:      "valid geoIP",
+			validator: createValidValidator(),
+			geoIP: &ngfAPI.GeoIP{
+				DatabasePath:       "/etc/nginx/geoip/GeoLite2-City.mmdb",
+				AutoReloadInterval: helpers.GetPointer[ngfAPI.Duration]("60m"),
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid geoIP",
+			validator: createInvalidValidator(),
+			geoIP: &ngfAPI.GeoIP{
+				DatabasePath:       "/etc/nginx/geoip/db.mmdb; include /etc/passwd",
+				AutoReloadInterval: helpers.GetPointer[ngfAPI.Duration]("1y"),
+			},
+			expectErrCount: 2,
+			errorString: "[spec.geoIP.databasePath: Invalid value: \"/etc/nginx/geoip/db.mmdb; include /etc/passwd\": " +
+				"must be an absolute path of an .mmdb file that contains only alphanumeric characters, " +
+				"'.', '_', '/', or '-', spec.geoIP.autoReloadInterval: Invalid value: \"1y\": error]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					GeoIP: test.geoIP,
+				},
+			}
+
+			allErrs := validateGeoIP(test.validator, np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateLogging(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		logging        *ngfAPI.NginxLogging
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			name:           "logging not set",
+			logging:        nil,
+			expectErrCount: 0,
+		},
+		{
+			name: "format not set",
+			logging: &ngfAPI.NginxLogging{
+				StreamAccessLog: &ngfAPI.StreamAccessLog{Disable: true},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name: "valid access log format",
+			logging: &ngfAPI.NginxLogging{
+				AccessLogFormat: helpers.GetPointer(ngfAPI.NginxAccessLogFormatKubernetes),
+			},
+			expectErrCount: 0,
+		},
+		{
+			name: "invalid access log format",
+			logging: &ngfAPI.NginxLogging{
+				AccessLogFormat: helpers.GetPointer[ngfAPI.NginxAccessLogFormat]("json"),
+			},
+			expectErrCount: 1,
+			errorString: "spec.logging.accessLogFormat: Unsupported value: \"json\": supported values: " +
+				"\"combined\", \"kubernetes\"",
+		},
+		{
+			name: "valid error level",
+			logging: &ngfAPI.NginxLogging{
+				ErrorLevel: helpers.GetPointer(ngfAPI.NginxErrorLogLevelNotice),
+			},
+			expectErrCount: 0,
+		},
+		{
+			name: "invalid error level",
+			logging: &ngfAPI.NginxLogging{
+				ErrorLevel: helpers.GetPointer[ngfAPI.NginxErrorLogLevel]("verbose"),
+			},
+			expectErrCount: 1,
+			errorString: "spec.logging.errorLevel: Unsupported value: \"verbose\": supported values: " +
+				"\"debug\", \"info\", \"notice\", \"warn\", \"error\", \"crit\", \"alert\", \"emerg\"",
+		},
+		{
+			name: "valid format",
+			logging: &ngfAPI.NginxLogging{
+				StreamAccessLog: &ngfAPI.StreamAccessLog{
+					Format: helpers.GetPointer(`$remote_addr $ngf_stream_route $bytes_sent "$upstream_addr"`),
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name: "invalid format",
+			logging: &ngfAPI.NginxLogging{
+				StreamAccessLog: &ngfAPI.StreamAccessLog{
+					Format: helpers.GetPointer(`$remote_addr'; include /etc/passwd; '`),
+				},
+			},
+			expectErrCount: 1,
+			errorString: "spec.logging.streamAccessLog.format: Invalid value: " +
+				"\"$remote_addr'; include /etc/passwd; '\": " +
+				"must be a non-empty string without single quotes or backslashes",
+		},
+		{
+			name: "empty format",
+			logging: &ngfAPI.NginxLogging{
+				StreamAccessLog: &ngfAPI.StreamAccessLog{
+					Format: helpers.GetPointer(""),
+				},
+			},
+			expectErrCount: 1,
+			errorString: "spec.logging.streamAccessLog.format: Invalid value: \"\": " +
+				"must be a non-empty string without single quotes or backslashes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			np := &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					Logging: test.logging,
+				},
+			}
+
+			allErrs := validateLogging(np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
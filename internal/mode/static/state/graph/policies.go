@@ -2,6 +2,8 @@ package graph
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
 	"sort"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -10,6 +12,7 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	ngfsort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
@@ -49,6 +52,9 @@ type PolicyTargetRef struct {
 	Group v1.Group
 	// Nsname is the NamespacedName of the object.
 	Nsname types.NamespacedName
+	// SectionName is the name of the section of the object, which is a Listener for a Gateway.
+	// It is empty if the Policy targets the whole object.
+	SectionName string
 }
 
 // PolicyKey is a unique identifier for an NGF Policy.
@@ -63,6 +69,8 @@ const (
 	gatewayGroupKind = v1.GroupName + "/" + kinds.Gateway
 	hrGroupKind      = v1.GroupName + "/" + kinds.HTTPRoute
 	grpcGroupKind    = v1.GroupName + "/" + kinds.GRPCRoute
+	// serviceGroupKind is the group/kind of a Service, which belongs to the core group, whose name is empty.
+	serviceGroupKind = "/" + kinds.Service
 )
 
 // attachPolicies attaches the graph's processed policies to the resources they target. It modifies the graph in place.
@@ -71,24 +79,76 @@ func (g *Graph) attachPolicies(ctlrName string) {
 		return
 	}
 
-	for _, policy := range g.NGFPolicies {
+	// Policies are attached in a stable order, so that the conditions of the targets don't change between runs.
+	keys := make([]PolicyKey, 0, len(g.NGFPolicies))
+	for key := range g.NGFPolicies {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return policyName(keys[i]) < policyName(keys[j])
+	})
+
+	for _, key := range keys {
+		policy := g.NGFPolicies[key]
+
 		for _, ref := range policy.TargetRefs {
 			switch ref.Kind {
 			case kinds.Gateway:
-				attachPolicyToGateway(policy, ref, g.Gateway, g.IgnoredGateways, ctlrName)
+				attachPolicyToGateway(policy, key, ref, g.Gateway, g.IgnoredGateways, ctlrName)
 			case kinds.HTTPRoute, kinds.GRPCRoute:
 				route, exists := g.Routes[routeKeyForKind(ref.Kind, ref.Nsname)]
 				if !exists {
 					continue
 				}
 
-				attachPolicyToRoute(policy, route, ctlrName)
+				attachPolicyToRoute(policy, key, route, ctlrName)
+			case kinds.Service:
+				svc, exists := g.ReferencedServices[ref.Nsname]
+				if !exists {
+					continue
+				}
+
+				attachPolicyToService(policy, key, svc, g.Gateway, ctlrName)
 			}
 		}
 	}
 }
 
-func attachPolicyToRoute(policy *Policy, route *L7Route, ctlrName string) {
+// attachPolicyToService attaches a Policy to a Service. The ancestor of the Policy is the Gateway, because
+// the Policy affects the upstreams of the Gateway, rather than the Service itself.
+func attachPolicyToService(policy *Policy, key PolicyKey, svc *ReferencedService, gw *Gateway, ctlrName string) {
+	ancestor := PolicyAncestor{
+		Ancestor: createParentReference(v1.GroupName, kinds.Gateway, client.ObjectKeyFromObject(gw.Source)),
+	}
+
+	// A Policy that targets multiple Services has the same Gateway ancestor for all of them.
+	if slices.ContainsFunc(policy.Ancestors, func(a PolicyAncestor) bool {
+		return reflect.DeepEqual(a.Ancestor, ancestor.Ancestor)
+	}) {
+		if gw.Valid {
+			svc.Policies = append(svc.Policies, policy)
+		}
+		return
+	}
+
+	if ngfPolicyAncestorsFull(policy, ctlrName) {
+		// The Policy can't report its status for the Gateway, so we don't apply it and report it on the Gateway instead.
+		gw.Conditions = addPolicyAncestorLimitReachedCondition(gw.Conditions, key)
+		return
+	}
+
+	if !gw.Valid {
+		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")}
+		policy.Ancestors = append(policy.Ancestors, ancestor)
+		return
+	}
+
+	policy.Ancestors = append(policy.Ancestors, ancestor)
+	svc.Policies = append(svc.Policies, policy)
+}
+
+func attachPolicyToRoute(policy *Policy, key PolicyKey, route *L7Route, ctlrName string) {
 	kind := v1.Kind(kinds.HTTPRoute)
 	if route.RouteType == RouteTypeGRPC {
 		kind = kinds.GRPCRoute
@@ -101,7 +161,8 @@ func attachPolicyToRoute(policy *Policy, route *L7Route, ctlrName string) {
 	}
 
 	if ngfPolicyAncestorsFull(policy, ctlrName) {
-		// FIXME (kate-osborn): https://github.com/nginxinc/nginx-gateway-fabric/issues/1987
+		// The Policy can't report its status for the Route, so we don't apply it and report it on the Route instead.
+		route.Conditions = addPolicyAncestorLimitReachedCondition(route.Conditions, key)
 		return
 	}
 
@@ -117,6 +178,7 @@ func attachPolicyToRoute(policy *Policy, route *L7Route, ctlrName string) {
 
 func attachPolicyToGateway(
 	policy *Policy,
+	key PolicyKey,
 	ref PolicyTargetRef,
 	gw *Gateway,
 	ignoredGateways map[types.NamespacedName]*v1.Gateway,
@@ -132,8 +194,16 @@ func attachPolicyToGateway(
 		Ancestor: createParentReference(v1.GroupName, kinds.Gateway, ref.Nsname),
 	}
 
+	if ref.SectionName != "" {
+		ancestor.Ancestor.SectionName = helpers.GetPointer(v1.SectionName(ref.SectionName))
+	}
+
 	if ngfPolicyAncestorsFull(policy, ctlrName) {
-		// FIXME (kate-osborn): https://github.com/nginxinc/nginx-gateway-fabric/issues/1987
+		// The Policy can't report its status for the Gateway, so we don't apply it and report it on the Gateway instead.
+		// The ignored Gateways only report that they are ignored.
+		if !ignored {
+			gw.Conditions = addPolicyAncestorLimitReachedCondition(gw.Conditions, key)
+		}
 		return
 	}
 
@@ -149,16 +219,199 @@ func attachPolicyToGateway(
 		return
 	}
 
+	if ref.SectionName != "" {
+		attachPolicyToListener(policy, ancestor, ref.SectionName, gw)
+		return
+	}
+
 	policy.Ancestors = append(policy.Ancestors, ancestor)
 	gw.Policies = append(gw.Policies, policy)
 }
 
+func attachPolicyToListener(policy *Policy, ancestor PolicyAncestor, listenerName string, gw *Gateway) {
+	var listener *Listener
+
+	for _, l := range gw.Listeners {
+		if l.Name == listenerName {
+			listener = l
+			break
+		}
+	}
+
+	if listener == nil {
+		msg := fmt.Sprintf("TargetRef sectionName %q does not match any Listener of the Gateway", listenerName)
+		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound(msg)}
+		policy.Ancestors = append(policy.Ancestors, ancestor)
+		return
+	}
+
+	if !listener.Valid {
+		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is invalid")}
+		policy.Ancestors = append(policy.Ancestors, ancestor)
+		return
+	}
+
+	policy.Ancestors = append(policy.Ancestors, ancestor)
+	listener.Policies = append(listener.Policies, policy)
+}
+
+// setEffectivePolicies sets the effective policies of the Gateway, its Listeners and the Routes, so that
+// the Listeners inherit the policies attached to the Gateway, and the Routes inherit the policies attached to
+// the Gateway and their Listeners. It modifies the graph in place.
+func (g *Graph) setEffectivePolicies(validator validation.PolicyValidator) {
+	if g.Gateway == nil {
+		return
+	}
+
+	gwPolicies := validPolicySources(g.Gateway.Policies)
+	g.Gateway.EffectivePolicies = validator.EffectivePolicies(gwPolicies)
+
+	listenerPolicies := make(map[string][]policies.Policy, len(g.Gateway.Listeners))
+
+	for _, l := range g.Gateway.Listeners {
+		listenerPolicies[l.Name] = validPolicySources(l.Policies)
+		l.EffectivePolicies = g.effectiveListenerPolicies(validator, g.Gateway.Policies, l.Policies)
+	}
+
+	for _, route := range g.Routes {
+		routePolicies := validPolicySources(route.Policies)
+
+		for _, ref := range route.ParentRefs {
+			if ref.Attachment == nil {
+				continue
+			}
+
+			for listenerName := range ref.Attachment.AcceptedHostnames {
+				lPolicies, exists := listenerPolicies[listenerName]
+				if !exists {
+					continue
+				}
+
+				effective := validator.EffectivePolicies(gwPolicies, lPolicies, routePolicies)
+				if len(effective) == 0 {
+					continue
+				}
+
+				if route.EffectivePolicies == nil {
+					route.EffectivePolicies = make(map[string][]policies.Policy)
+				}
+
+				route.EffectivePolicies[listenerName] = effective
+			}
+		}
+	}
+}
+
+// effectiveListenerPolicies returns the effective policies of the server blocks of a Listener. Unlike a Route,
+// which inherits the policies of the kinds that are not attached to it from its server block, the server block
+// must include the effective policies of every kind attached to the Gateway or the Listener.
+func (g *Graph) effectiveListenerPolicies(
+	validator validation.PolicyValidator,
+	gwPolicies []*Policy,
+	listenerPolicies []*Policy,
+) []policies.Policy {
+	if len(validPolicySources(listenerPolicies)) == 0 {
+		return g.Gateway.EffectivePolicies
+	}
+
+	gvks := make(map[*Policy]schema.GroupVersionKind, len(g.NGFPolicies))
+	for key, pol := range g.NGFPolicies {
+		gvks[pol] = key.GVK
+	}
+
+	type kindPolicies struct {
+		gateway  []*Policy
+		listener []*Policy
+	}
+
+	policiesPerKind := make(map[schema.GroupVersionKind]*kindPolicies)
+	getKindPolicies := func(pol *Policy) *kindPolicies {
+		gvk := gvks[pol]
+		if policiesPerKind[gvk] == nil {
+			policiesPerKind[gvk] = &kindPolicies{}
+		}
+
+		return policiesPerKind[gvk]
+	}
+
+	for _, pol := range gwPolicies {
+		kp := getKindPolicies(pol)
+		kp.gateway = append(kp.gateway, pol)
+	}
+
+	for _, pol := range listenerPolicies {
+		kp := getKindPolicies(pol)
+		kp.listener = append(kp.listener, pol)
+	}
+
+	// The kinds are sorted, so that the order of the policies is stable.
+	kindsOfPolicies := make([]schema.GroupVersionKind, 0, len(policiesPerKind))
+	for gvk := range policiesPerKind {
+		kindsOfPolicies = append(kindsOfPolicies, gvk)
+	}
+
+	sort.Slice(kindsOfPolicies, func(i, j int) bool {
+		return kindsOfPolicies[i].String() < kindsOfPolicies[j].String()
+	})
+
+	var effective []policies.Policy
+
+	for _, gvk := range kindsOfPolicies {
+		kp := policiesPerKind[gvk]
+
+		levels := [][]policies.Policy{validPolicySources(kp.gateway)}
+		if lPolicies := validPolicySources(kp.listener); len(lPolicies) > 0 {
+			levels = append(levels, lPolicies)
+		}
+
+		effective = append(effective, validator.EffectivePolicies(levels...)...)
+	}
+
+	return effective
+}
+
+func validPolicySources(pols []*Policy) []policies.Policy {
+	if len(pols) == 0 {
+		return nil
+	}
+
+	sources := make([]policies.Policy, 0, len(pols))
+
+	for _, policy := range pols {
+		if policy.Valid {
+			sources = append(sources, policy.Source)
+		}
+	}
+
+	return sources
+}
+
+// addPolicyAncestorLimitReachedCondition adds the Policy to the PolicyAncestorLimitReached condition
+// in the conditions of a target, or adds the condition if it doesn't exist yet.
+func addPolicyAncestorLimitReachedCondition(conds []conditions.Condition, key PolicyKey) []conditions.Condition {
+	for i, cond := range conds {
+		if cond.Type == staticConds.PolicyAncestorLimitReached {
+			conds[i].Message += ", " + policyName(key)
+			return conds
+		}
+	}
+
+	return append(conds, staticConds.NewPolicyAncestorLimitReached(policyName(key)))
+}
+
+// policyName returns the name of the Policy in the format "Kind namespace/name".
+func policyName(key PolicyKey) string {
+	return fmt.Sprintf("%s %s", key.GVK.Kind, key.NsName)
+}
+
 func processPolicies(
 	pols map[PolicyKey]policies.Policy,
 	validator validation.PolicyValidator,
 	gateways processedGateways,
 	routes map[RouteKey]*L7Route,
+	services map[types.NamespacedName]*ReferencedService,
 	globalSettings *policies.GlobalSettings,
+	ctlrName string,
 ) map[PolicyKey]*Policy {
 	if len(pols) == 0 || gateways.Winner == nil {
 		return nil
@@ -187,19 +440,37 @@ func processPolicies(
 				} else {
 					targetedRoutes[client.ObjectKeyFromObject(route.Source)] = route
 				}
+			case serviceGroupKind:
+				if _, exists := services[refNsName]; !exists {
+					continue
+				}
 			default:
 				continue
 			}
 
-			targetRefs = append(targetRefs,
-				PolicyTargetRef{
-					Kind:   ref.Kind,
-					Group:  ref.Group,
-					Nsname: refNsName,
-				})
+			targetRef := PolicyTargetRef{
+				Kind:   ref.Kind,
+				Group:  ref.Group,
+				Nsname: refNsName,
+			}
+
+			if ref.SectionName != nil {
+				targetRef.SectionName = string(*ref.SectionName)
+			}
+
+			targetRefs = append(targetRefs, targetRef)
 		}
 
 		if len(targetRefs) == 0 {
+			// The Policy doesn't target any resources anymore, but its status still has our ancestors,
+			// so we keep it to prune them from the status.
+			if hasNGFAncestors(policy, ctlrName) {
+				processedPolicies[key] = &Policy{
+					Source:    policy,
+					Ancestors: []PolicyAncestor{},
+				}
+			}
+
 			continue
 		}
 
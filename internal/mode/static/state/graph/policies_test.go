@@ -18,6 +18,7 @@ import (
 	policiesfakes "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
 )
 
 var testNs = "test"
@@ -239,12 +240,18 @@ func TestAttachPolicyToRoute(t *testing.T) {
 		}
 	}
 
+	policyKey := PolicyKey{
+		NsName: types.NamespacedName{Namespace: testNs, Name: "policy"},
+		GVK:    schema.GroupVersionKind{Kind: kinds.ClientSettingsPolicy},
+	}
+
 	tests := []struct {
-		route        *L7Route
-		policy       *Policy
-		name         string
-		expAncestors []PolicyAncestor
-		expAttached  bool
+		route         *L7Route
+		policy        *Policy
+		name          string
+		expAncestors  []PolicyAncestor
+		expConditions []conditions.Condition
+		expAttached   bool
 	}{
 		{
 			name:   "policy attaches to http route",
@@ -320,7 +327,10 @@ func TestAttachPolicyToRoute(t *testing.T) {
 			route:        createHTTPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
 			policy:       &Policy{Source: createTestPolicyWithAncestors(16)},
 			expAncestors: nil,
-			expAttached:  false,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyAncestorLimitReached("ClientSettingsPolicy test/policy"),
+			},
+			expAttached: false,
 		},
 	}
 
@@ -329,7 +339,7 @@ func TestAttachPolicyToRoute(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			attachPolicyToRoute(test.policy, test.route, "nginx-gateway")
+			attachPolicyToRoute(test.policy, policyKey, test.route, "nginx-gateway")
 
 			if test.expAttached {
 				g.Expect(test.route.Policies).To(HaveLen(1))
@@ -338,6 +348,7 @@ func TestAttachPolicyToRoute(t *testing.T) {
 			}
 
 			g.Expect(test.policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+			g.Expect(test.route.Conditions).To(Equal(test.expConditions))
 		})
 	}
 }
@@ -356,6 +367,10 @@ func TestAttachPolicyToGateway(t *testing.T) {
 					Name:      nsname.Name,
 				},
 			},
+			Listeners: []*Listener{
+				{Name: "listener", Valid: true},
+				{Name: "invalid-listener", Valid: false},
+			},
 			Valid: valid,
 		}
 	}
@@ -369,12 +384,26 @@ func TestAttachPolicyToGateway(t *testing.T) {
 		}
 	}
 
+	getListenerParentRef := func(gwNsName types.NamespacedName, listenerName string) v1.ParentReference {
+		ref := getGatewayParentRef(gwNsName)
+		ref.SectionName = helpers.GetPointer(v1.SectionName(listenerName))
+
+		return ref
+	}
+
+	policyKey := PolicyKey{
+		NsName: types.NamespacedName{Namespace: testNs, Name: "policy"},
+		GVK:    schema.GroupVersionKind{Kind: kinds.ClientSettingsPolicy},
+	}
+
 	tests := []struct {
-		policy       *Policy
-		gw           *Gateway
-		name         string
-		expAncestors []PolicyAncestor
-		expAttached  bool
+		policy                *Policy
+		gw                    *Gateway
+		name                  string
+		expAncestors          []PolicyAncestor
+		expConditions         []conditions.Condition
+		expAttached           bool
+		expAttachedToListener bool
 	}{
 		{
 			name: "attached",
@@ -414,6 +443,68 @@ func TestAttachPolicyToGateway(t *testing.T) {
 			},
 			expAttached: true,
 		},
+		{
+			name: "attached to listener",
+			policy: &Policy{
+				Source: &policiesfakes.FakePolicy{},
+				TargetRefs: []PolicyTargetRef{
+					{
+						Nsname:      gatewayNsName,
+						Kind:        "Gateway",
+						SectionName: "listener",
+					},
+				},
+			},
+			gw: newGateway(true, gatewayNsName),
+			expAncestors: []PolicyAncestor{
+				{Ancestor: getListenerParentRef(gatewayNsName, "listener")},
+			},
+			expAttachedToListener: true,
+		},
+		{
+			name: "not attached; listener not found",
+			policy: &Policy{
+				Source: &policiesfakes.FakePolicy{},
+				TargetRefs: []PolicyTargetRef{
+					{
+						Nsname:      gatewayNsName,
+						Kind:        "Gateway",
+						SectionName: "dne",
+					},
+				},
+			},
+			gw: newGateway(true, gatewayNsName),
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: getListenerParentRef(gatewayNsName, "dne"),
+					Conditions: []conditions.Condition{
+						staticConds.NewPolicyTargetNotFound(
+							`TargetRef sectionName "dne" does not match any Listener of the Gateway`,
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "not attached; invalid listener",
+			policy: &Policy{
+				Source: &policiesfakes.FakePolicy{},
+				TargetRefs: []PolicyTargetRef{
+					{
+						Nsname:      gatewayNsName,
+						Kind:        "Gateway",
+						SectionName: "invalid-listener",
+					},
+				},
+			},
+			gw: newGateway(true, gatewayNsName),
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   getListenerParentRef(gatewayNsName, "invalid-listener"),
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is invalid")},
+				},
+			},
+		},
 		{
 			name: "not attached; gateway ignored",
 			policy: &Policy{
@@ -482,6 +573,24 @@ func TestAttachPolicyToGateway(t *testing.T) {
 			},
 			gw:           newGateway(true, gatewayNsName),
 			expAncestors: nil,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyAncestorLimitReached("ClientSettingsPolicy test/policy"),
+			},
+			expAttached: false,
+		},
+		{
+			name: "not attached; max ancestors; gateway ignored",
+			policy: &Policy{
+				Source: createTestPolicyWithAncestors(16),
+				TargetRefs: []PolicyTargetRef{
+					{
+						Nsname: ignoredGatewayNsName,
+						Kind:   "Gateway",
+					},
+				},
+			},
+			gw:           newGateway(true, gatewayNsName),
+			expAncestors: nil,
 			expAttached:  false,
 		},
 	}
@@ -495,7 +604,14 @@ func TestAttachPolicyToGateway(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			attachPolicyToGateway(test.policy, test.policy.TargetRefs[0], test.gw, ignoredGateways, "nginx-gateway")
+			attachPolicyToGateway(
+				test.policy,
+				policyKey,
+				test.policy.TargetRefs[0],
+				test.gw,
+				ignoredGateways,
+				"nginx-gateway",
+			)
 
 			if test.expAttached {
 				g.Expect(test.gw.Policies).To(HaveLen(1))
@@ -503,11 +619,232 @@ func TestAttachPolicyToGateway(t *testing.T) {
 				g.Expect(test.gw.Policies).To(BeEmpty())
 			}
 
+			if test.expAttachedToListener {
+				g.Expect(test.gw.Listeners[0].Policies).To(HaveLen(1))
+			} else {
+				g.Expect(test.gw.Listeners[0].Policies).To(BeEmpty())
+			}
+
 			g.Expect(test.policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+			g.Expect(test.gw.Conditions).To(Equal(test.expConditions))
 		})
 	}
 }
 
+func TestAttachPolicyToService(t *testing.T) {
+	t.Parallel()
+	gatewayNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
+
+	newGateway := func(valid bool) *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: gatewayNsName.Namespace,
+					Name:      gatewayNsName.Name,
+				},
+			},
+			Valid: valid,
+		}
+	}
+
+	gatewayParentRef := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+		Kind:      helpers.GetPointer[v1.Kind](kinds.Gateway),
+		Namespace: (*v1.Namespace)(&gatewayNsName.Namespace),
+		Name:      v1.ObjectName(gatewayNsName.Name),
+	}
+
+	policyKey := PolicyKey{
+		NsName: types.NamespacedName{Namespace: testNs, Name: "policy"},
+		GVK:    schema.GroupVersionKind{Kind: kinds.UpstreamSettingsPolicy},
+	}
+
+	tests := []struct {
+		policy        *Policy
+		gw            *Gateway
+		name          string
+		expAncestors  []PolicyAncestor
+		expConditions []conditions.Condition
+		expAttached   bool
+	}{
+		{
+			name:   "attached",
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			gw:     newGateway(true),
+			expAncestors: []PolicyAncestor{
+				{Ancestor: gatewayParentRef},
+			},
+			expAttached: true,
+		},
+		{
+			name: "attached; gateway ancestor already exists for another service",
+			policy: &Policy{
+				Source: &policiesfakes.FakePolicy{},
+				Ancestors: []PolicyAncestor{
+					{Ancestor: gatewayParentRef},
+				},
+			},
+			gw: newGateway(true),
+			expAncestors: []PolicyAncestor{
+				{Ancestor: gatewayParentRef},
+			},
+			expAttached: true,
+		},
+		{
+			name: "not attached; gateway ancestor already exists; invalid gateway",
+			policy: &Policy{
+				Source: &policiesfakes.FakePolicy{},
+				Ancestors: []PolicyAncestor{
+					{
+						Ancestor:   gatewayParentRef,
+						Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")},
+					},
+				},
+			},
+			gw: newGateway(false),
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   gatewayParentRef,
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:   "not attached; invalid gateway",
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			gw:     newGateway(false),
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   gatewayParentRef,
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:         "not attached; max ancestors",
+			policy:       &Policy{Source: createTestPolicyWithAncestors(16)},
+			gw:           newGateway(true),
+			expAncestors: nil,
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyAncestorLimitReached("UpstreamSettingsPolicy test/policy"),
+			},
+			expAttached: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			svc := &ReferencedService{}
+
+			attachPolicyToService(test.policy, policyKey, svc, test.gw, "nginx-gateway")
+
+			if test.expAttached {
+				g.Expect(svc.Policies).To(HaveLen(1))
+			} else {
+				g.Expect(svc.Policies).To(BeEmpty())
+			}
+
+			g.Expect(test.policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+			g.Expect(test.gw.Conditions).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestSetEffectivePolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	policyGVK := schema.GroupVersionKind{Group: "Group", Version: "Version", Kind: "MyPolicy"}
+	gwPolicy := &Policy{Source: createTestPolicy(policyGVK, "gw-pol"), Valid: true}
+	invalidGwPolicy := &Policy{Source: createTestPolicy(policyGVK, "invalid-gw-pol"), Valid: false}
+	listenerPolicy := &Policy{Source: createTestPolicy(policyGVK, "listener-pol"), Valid: true}
+	invalidListenerPolicy := &Policy{Source: createTestPolicy(policyGVK, "invalid-listener-pol"), Valid: false}
+	routePolicy := &Policy{Source: createTestPolicy(policyGVK, "route-pol"), Valid: true}
+
+	listenerWithoutPolicies := &Listener{Name: "listener-1"}
+	listenerWithPolicies := &Listener{
+		Name:     "listener-2",
+		Policies: []*Policy{listenerPolicy, invalidListenerPolicy},
+	}
+
+	createParentRefs := func(listenerNames ...string) []ParentRef {
+		acceptedHostnames := make(map[string][]string, len(listenerNames))
+		for _, name := range listenerNames {
+			acceptedHostnames[name] = []string{"foo.example.com"}
+		}
+
+		return []ParentRef{
+			{
+				Attachment: &ParentRefAttachmentStatus{AcceptedHostnames: acceptedHostnames},
+			},
+			{
+				// not attached
+			},
+		}
+	}
+
+	route := &L7Route{
+		Policies:   []*Policy{routePolicy},
+		ParentRefs: createParentRefs("listener-1", "listener-2", "dne"),
+	}
+	routeWithoutPolicies := &L7Route{ParentRefs: createParentRefs("listener-1")}
+
+	graph := &Graph{
+		Gateway: &Gateway{
+			Listeners: []*Listener{listenerWithoutPolicies, listenerWithPolicies},
+			Policies:  []*Policy{gwPolicy, invalidGwPolicy},
+		},
+		Routes: map[RouteKey]*L7Route{
+			{NamespacedName: types.NamespacedName{Namespace: testNs, Name: "hr"}}:  route,
+			{NamespacedName: types.NamespacedName{Namespace: testNs, Name: "hr2"}}: routeWithoutPolicies,
+		},
+		NGFPolicies: map[PolicyKey]*Policy{
+			createTestPolicyKey(policyGVK, "gw-pol"):               gwPolicy,
+			createTestPolicyKey(policyGVK, "invalid-gw-pol"):       invalidGwPolicy,
+			createTestPolicyKey(policyGVK, "listener-pol"):         listenerPolicy,
+			createTestPolicyKey(policyGVK, "invalid-listener-pol"): invalidListenerPolicy,
+			createTestPolicyKey(policyGVK, "route-pol"):            routePolicy,
+		},
+	}
+
+	// The fake returns the policies of all levels, so that we can check which policies are inherited.
+	validator := &validationfakes.FakePolicyValidator{
+		EffectivePoliciesStub: func(levels ...[]policies.Policy) []policies.Policy {
+			var result []policies.Policy
+			for _, level := range levels {
+				result = append(result, level...)
+			}
+
+			return result
+		},
+	}
+
+	graph.setEffectivePolicies(validator)
+
+	g.Expect(graph.Gateway.EffectivePolicies).To(Equal([]policies.Policy{gwPolicy.Source}))
+	g.Expect(listenerWithoutPolicies.EffectivePolicies).To(Equal([]policies.Policy{gwPolicy.Source}))
+	g.Expect(listenerWithPolicies.EffectivePolicies).To(Equal(
+		[]policies.Policy{gwPolicy.Source, listenerPolicy.Source},
+	))
+	g.Expect(route.EffectivePolicies).To(Equal(map[string][]policies.Policy{
+		"listener-1": {gwPolicy.Source, routePolicy.Source},
+		"listener-2": {gwPolicy.Source, listenerPolicy.Source, routePolicy.Source},
+	}))
+	g.Expect(routeWithoutPolicies.EffectivePolicies).To(Equal(map[string][]policies.Policy{
+		"listener-1": {gwPolicy.Source},
+	}))
+
+	// no Gateway
+	validator = &validationfakes.FakePolicyValidator{}
+	(&Graph{}).setEffectivePolicies(validator)
+	g.Expect(validator.EffectivePoliciesCallCount()).To(BeZero())
+}
+
 func TestProcessPolicies(t *testing.T) {
 	t.Parallel()
 	policyGVK := schema.GroupVersionKind{Group: "Group", Version: "Version", Kind: "MyPolicy"}
@@ -518,6 +855,7 @@ func TestProcessPolicies(t *testing.T) {
 	grpcRef := createTestRef(kinds.GRPCRoute, v1.GroupName, "grpc")
 	gatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "gw")
 	ignoredGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "ignored")
+	svcRef := createTestRef(kinds.Service, "", "svc")
 
 	// These refs reference objects that do not belong to NGF.
 	// Policies that contain these refs should NOT be processed.
@@ -525,6 +863,7 @@ func TestProcessPolicies(t *testing.T) {
 	hrWrongGroup := createTestRef(kinds.HTTPRoute, "WrongGroup", "hr")
 	gatewayWrongGroupRef := createTestRef(kinds.Gateway, "WrongGroup", "gw")
 	nonNGFGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "not-ours")
+	svcDoesNotExistRef := createTestRef(kinds.Service, "", "dne")
 
 	pol1, pol1Key := createTestPolicyAndKey(policyGVK, "pol1", hrRef)
 	pol2, pol2Key := createTestPolicyAndKey(policyGVK, "pol2", grpcRef)
@@ -534,10 +873,36 @@ func TestProcessPolicies(t *testing.T) {
 	pol6, pol6Key := createTestPolicyAndKey(policyGVK, "pol6", hrWrongGroup)
 	pol7, pol7Key := createTestPolicyAndKey(policyGVK, "pol7", gatewayWrongGroupRef)
 	pol8, pol8Key := createTestPolicyAndKey(policyGVK, "pol8", nonNGFGatewayRef)
+	pol12, pol12Key := createTestPolicyAndKey(policyGVK, "pol12", svcRef)
+	pol13, pol13Key := createTestPolicyAndKey(policyGVK, "pol13", svcDoesNotExistRef)
 
 	pol1Conflict, pol1ConflictKey := createTestPolicyAndKey(policyGVK, "pol1-conflict", hrRef)
 
-	allValidValidator := &policiesfakes.FakeValidator{}
+	// These policies don't reference any objects that belong to NGF, but their status has ancestors.
+	// Only the policy with NGF ancestors should be processed, so that its status is updated.
+	pol9, pol9Key := createTestPolicyAndKey(policyGVK, "pol9", hrDoesNotExistRef)
+	pol9Fake, ok := pol9.(*policiesfakes.FakePolicy)
+	if !ok {
+		t.Fatal("pol9 is not a FakePolicy")
+	}
+	pol9Fake.GetPolicyStatusReturns(v1alpha2.PolicyStatus{
+		Ancestors: []v1alpha2.PolicyAncestorStatus{{ControllerName: "nginx-gateway"}},
+	})
+
+	pol10, pol10Key := createTestPolicyAndKey(policyGVK, "pol10", hrDoesNotExistRef)
+	pol10Fake, ok := pol10.(*policiesfakes.FakePolicy)
+	if !ok {
+		t.Fatal("pol10 is not a FakePolicy")
+	}
+	pol10Fake.GetPolicyStatusReturns(v1alpha2.PolicyStatus{
+		Ancestors: []v1alpha2.PolicyAncestorStatus{{ControllerName: "some-other-controller"}},
+	})
+
+	listenerRef := createTestRef(kinds.Gateway, v1.GroupName, "gw")
+	listenerRef.SectionName = helpers.GetPointer[v1.SectionName]("listener")
+	pol11, pol11Key := createTestPolicyAndKey(policyGVK, "pol11", listenerRef)
+
+	allValidValidator := &validationfakes.FakePolicyValidator{}
 
 	tests := []struct {
 		validator            validation.PolicyValidator
@@ -553,14 +918,16 @@ func TestProcessPolicies(t *testing.T) {
 			name:      "mix of relevant and irrelevant policies",
 			validator: allValidValidator,
 			policies: map[PolicyKey]policies.Policy{
-				pol1Key: pol1,
-				pol2Key: pol2,
-				pol3Key: pol3,
-				pol4Key: pol4,
-				pol5Key: pol5,
-				pol6Key: pol6,
-				pol7Key: pol7,
-				pol8Key: pol8,
+				pol1Key:  pol1,
+				pol2Key:  pol2,
+				pol3Key:  pol3,
+				pol4Key:  pol4,
+				pol5Key:  pol5,
+				pol6Key:  pol6,
+				pol7Key:  pol7,
+				pol8Key:  pol8,
+				pol12Key: pol12,
+				pol13Key: pol13,
 			},
 			expProcessedPolicies: map[PolicyKey]*Policy{
 				pol1Key: {
@@ -611,11 +978,22 @@ func TestProcessPolicies(t *testing.T) {
 					Ancestors: []PolicyAncestor{},
 					Valid:     true,
 				},
+				pol12Key: {
+					Source: pol12,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "svc"},
+							Kind:   kinds.Service,
+						},
+					},
+					Ancestors: []PolicyAncestor{},
+					Valid:     true,
+				},
 			},
 		},
 		{
 			name: "invalid and valid policies",
-			validator: &policiesfakes.FakeValidator{
+			validator: &validationfakes.FakePolicyValidator{
 				ValidateStub: func(
 					policy policies.Policy,
 					_ *policies.GlobalSettings,
@@ -663,7 +1041,7 @@ func TestProcessPolicies(t *testing.T) {
 		},
 		{
 			name: "conflicted policies",
-			validator: &policiesfakes.FakeValidator{
+			validator: &validationfakes.FakePolicyValidator{
 				ConflictsStub: func(_ policies.Policy, _ policies.Policy) bool {
 					return true
 				},
@@ -702,6 +1080,59 @@ func TestProcessPolicies(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "policies targeting a gateway and its listener do not conflict",
+			validator: &validationfakes.FakePolicyValidator{
+				ConflictsStub: func(_ policies.Policy, _ policies.Policy) bool {
+					return true
+				},
+			},
+			policies: map[PolicyKey]policies.Policy{
+				pol3Key:  pol3,
+				pol11Key: pol11,
+			},
+			expProcessedPolicies: map[PolicyKey]*Policy{
+				pol3Key: {
+					Source: pol3,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "gw"},
+							Kind:   kinds.Gateway,
+							Group:  v1.GroupName,
+						},
+					},
+					Ancestors: []PolicyAncestor{},
+					Valid:     true,
+				},
+				pol11Key: {
+					Source: pol11,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname:      types.NamespacedName{Namespace: testNs, Name: "gw"},
+							Kind:        kinds.Gateway,
+							Group:       v1.GroupName,
+							SectionName: "listener",
+						},
+					},
+					Ancestors: []PolicyAncestor{},
+					Valid:     true,
+				},
+			},
+		},
+		{
+			name:      "policies without targets and with stale ancestors",
+			validator: allValidValidator,
+			policies: map[PolicyKey]policies.Policy{
+				pol9Key:  pol9,
+				pol10Key: pol10,
+			},
+			expProcessedPolicies: map[PolicyKey]*Policy{
+				pol9Key: {
+					Source:    pol9,
+					Ancestors: []PolicyAncestor{},
+				},
+			},
+		},
 	}
 
 	gateways := processedGateways{
@@ -740,12 +1171,16 @@ func TestProcessPolicies(t *testing.T) {
 		},
 	}
 
+	services := map[types.NamespacedName]*ReferencedService{
+		{Namespace: testNs, Name: "svc"}: {},
+	}
+
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			processed := processPolicies(test.policies, test.validator, gateways, routes, nil)
+			processed := processPolicies(test.policies, test.validator, gateways, routes, services, nil, "nginx-gateway")
 			g.Expect(processed).To(BeEquivalentTo(test.expProcessedPolicies))
 		})
 	}
@@ -770,7 +1205,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 	}{
 		{
 			name:      "no overlap",
-			validator: &policiesfakes.FakeValidator{},
+			validator: &validationfakes.FakePolicyValidator{},
 			policies: map[PolicyKey]policies.Policy{
 				pol1Key: pol1,
 			},
@@ -788,7 +1223,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 		},
 		{
 			name:      "policy references route that overlaps a non-referenced route",
-			validator: &policiesfakes.FakeValidator{},
+			validator: &validationfakes.FakePolicyValidator{},
 			policies: map[PolicyKey]policies.Policy{
 				pol1Key: pol1,
 			},
@@ -815,7 +1250,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 		},
 		{
 			name:      "policy references 2 routes that overlap",
-			validator: &policiesfakes.FakeValidator{},
+			validator: &validationfakes.FakePolicyValidator{},
 			policies: map[PolicyKey]policies.Policy{
 				pol2Key: pol2,
 			},
@@ -833,7 +1268,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 		},
 		{
 			name:      "policy references 2 routes that overlap with non-referenced route",
-			validator: &policiesfakes.FakeValidator{},
+			validator: &validationfakes.FakePolicyValidator{},
 			policies: map[PolicyKey]policies.Policy{
 				pol2Key: pol2,
 			},
@@ -885,7 +1320,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			processed := processPolicies(test.policies, test.validator, gateways, test.routes, nil)
+			processed := processPolicies(test.policies, test.validator, gateways, test.routes, nil, nil, "nginx-gateway")
 			g.Expect(processed).To(HaveLen(1))
 
 			for _, pol := range processed {
@@ -918,7 +1353,7 @@ func TestMarkConflictedPolicies(t *testing.T) {
 	tests := []struct {
 		name                  string
 		policies              map[PolicyKey]*Policy
-		fakeValidator         *policiesfakes.FakeValidator
+		fakeValidator         *validationfakes.FakePolicyValidator
 		conflictedNames       []string
 		expConflictToBeCalled bool
 	}{
@@ -936,7 +1371,7 @@ func TestMarkConflictedPolicies(t *testing.T) {
 					Valid:      true,
 				},
 			},
-			fakeValidator:         &policiesfakes.FakeValidator{},
+			fakeValidator:         &validationfakes.FakePolicyValidator{},
 			expConflictToBeCalled: false,
 		},
 		{
@@ -953,7 +1388,7 @@ func TestMarkConflictedPolicies(t *testing.T) {
 					Valid:      true,
 				},
 			},
-			fakeValidator:         &policiesfakes.FakeValidator{},
+			fakeValidator:         &validationfakes.FakePolicyValidator{},
 			expConflictToBeCalled: false,
 		},
 		{
@@ -970,7 +1405,7 @@ func TestMarkConflictedPolicies(t *testing.T) {
 					Valid:      false,
 				},
 			},
-			fakeValidator:         &policiesfakes.FakeValidator{},
+			fakeValidator:         &validationfakes.FakePolicyValidator{},
 			expConflictToBeCalled: false,
 		},
 		{
@@ -1003,7 +1438,7 @@ func TestMarkConflictedPolicies(t *testing.T) {
 					Valid:      true,
 				},
 			},
-			fakeValidator: &policiesfakes.FakeValidator{
+			fakeValidator: &validationfakes.FakePolicyValidator{
 				ConflictsStub: func(policy policies.Policy, policy2 policies.Policy) bool {
 					pol1Name := policy.GetName()
 					pol2Name := policy2.GetName()
@@ -1067,7 +1502,7 @@ func createTestPolicyWithAncestors(numAncestors int) policies.Policy {
 func createTestPolicyAndKey(
 	gvk schema.GroupVersionKind,
 	name string,
-	refs ...v1alpha2.LocalPolicyTargetReference,
+	refs ...v1alpha2.LocalPolicyTargetReferenceWithSectionName,
 ) (policies.Policy, PolicyKey) {
 	pol := createTestPolicy(gvk, name, refs...)
 	key := createTestPolicyKey(gvk, name)
@@ -1078,7 +1513,7 @@ func createTestPolicyAndKey(
 func createTestPolicy(
 	gvk schema.GroupVersionKind,
 	name string,
-	refs ...v1alpha2.LocalPolicyTargetReference,
+	refs ...v1alpha2.LocalPolicyTargetReferenceWithSectionName,
 ) policies.Policy {
 	return &policiesfakes.FakePolicy{
 		GetNameStub: func() string {
@@ -1087,7 +1522,7 @@ func createTestPolicy(
 		GetNamespaceStub: func() string {
 			return testNs
 		},
-		GetTargetRefsStub: func() []v1alpha2.LocalPolicyTargetReference {
+		GetTargetRefsStub: func() []v1alpha2.LocalPolicyTargetReferenceWithSectionName {
 			return refs
 		},
 		GetObjectKindStub: func() schema.ObjectKind {
@@ -1107,11 +1542,13 @@ func createTestPolicyKey(gvk schema.GroupVersionKind, name string) PolicyKey {
 	}
 }
 
-func createTestRef(kind v1.Kind, group v1.Group, name string) v1alpha2.LocalPolicyTargetReference {
-	return v1alpha2.LocalPolicyTargetReference{
-		Group: group,
-		Kind:  kind,
-		Name:  v1.ObjectName(name),
+func createTestRef(kind v1.Kind, group v1.Group, name string) v1alpha2.LocalPolicyTargetReferenceWithSectionName {
+	return v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+		LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+			Group: group,
+			Kind:  kind,
+			Name:  v1.ObjectName(name),
+		},
 	}
 }
 
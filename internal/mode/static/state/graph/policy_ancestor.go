@@ -4,6 +4,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 )
 
 const maxAncestors = 16
@@ -49,6 +51,17 @@ func ngfPolicyAncestorsFull(policy *Policy, ctlrName string) bool {
 	return nonNGFControllerCount+len(policy.Ancestors) >= maxAncestors
 }
 
+// hasNGFAncestors returns whether the status of the Policy has any ancestors that are managed by NGF.
+func hasNGFAncestors(policy policies.Policy, ctlrName string) bool {
+	for _, ancestor := range policy.GetPolicyStatus().Ancestors {
+		if ancestor.ControllerName == v1.GatewayController(ctlrName) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func createParentReference(
 	group v1.Group,
 	kind v1.Kind,
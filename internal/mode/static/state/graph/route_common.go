@@ -15,6 +15,7 @@ import (
 
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	ngfSort "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/sort"
 	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
@@ -31,6 +32,9 @@ type ParentRef struct {
 	SectionName *v1.SectionName
 	// Port is the network port this Route targets.
 	Port *v1.PortNumber
+	// ParentRoute is the NamespacedName of the referenced parent HTTPRoute. It is set when the Route is a child
+	// HTTPRoute to which the parent HTTPRoute delegates path prefixes. In that case, Gateway is not set.
+	ParentRoute *types.NamespacedName
 	// Gateway is the NamespacedName of the referenced Gateway
 	Gateway types.NamespacedName
 	// Idx is the index of the corresponding ParentReference in the Route.
@@ -101,6 +105,10 @@ type L4RouteSpec struct {
 type L7Route struct {
 	// Source is the source Gateway API object of the Route.
 	Source client.Object
+	// EffectivePolicies holds the effective policies of the Route for each Listener it is attached to, keyed by
+	// the Listener name. The valid policies attached to the Route inherit the settings of the valid policies of the same
+	// kind attached to the Listener and the Gateway.
+	EffectivePolicies map[string][]policies.Policy
 	// RouteType is the type (http or grpc) of the Route.
 	RouteType RouteType
 	// Spec is the L7RouteSpec of the Route
@@ -125,6 +133,9 @@ type L7RouteSpec struct {
 }
 
 type RouteRule struct {
+	// Delegate is the NamespacedName of the child HTTPRoute to which the rule delegates the path prefixes of
+	// its matches. NGINX configuration is generated for the rules of the child HTTPRoute instead of the rule.
+	Delegate *types.NamespacedName
 	// Matches define the predicate used to match requests to a given action.
 	Matches []v1.HTTPRouteMatch
 	// Filters define processing steps that must be completed during the request or response lifecycle.
@@ -133,6 +144,9 @@ type RouteRule struct {
 	RouteBackendRefs []RouteBackendRef
 	// BackendRefs is an internal representation of a backendRef in a Route.
 	BackendRefs []BackendRef
+	// ExtensionRefFilters are the NGF filter resources that the extensionRef filters of the rule reference,
+	// in the order of the filters.
+	ExtensionRefFilters []ExtensionRefFilter
 	// ValidMatches indicates if the matches are valid and accepted by the Route.
 	ValidMatches bool
 	// ValidFilters indicates if the filters are valid and accepted by the Route.
@@ -323,9 +337,15 @@ func bindRoutesToListeners(
 	}
 
 	for _, r := range l7Routes {
+		if isDelegatedRoute(r) {
+			continue
+		}
+
 		bindL7RouteToListeners(r, gw, namespaces)
 	}
 
+	bindDelegatedRoutesToListeners(l7Routes, gw)
+
 	var routes []*L4Route
 	for _, r := range l4Routes {
 		routes = append(routes, r)
@@ -471,6 +491,7 @@ func tryToAttachL4RouteToListeners(
 		return h1 == GetMoreSpecificHostname(h1, h2)
 	})
 
+	var allowingListeners []*Listener
 	for _, l := range attachableListeners {
 		routeAllowed, routeAttached, routeHostnamesUnique := bindToListenerL4(
 			l,
@@ -484,16 +505,20 @@ func tryToAttachL4RouteToListeners(
 		attached = attached || routeAttached
 		hostnamesUnique = hostnamesUnique || routeHostnamesUnique
 		attachedToAtLeastOneValidListener = attachedToAtLeastOneValidListener || (routeAttached && l.Valid)
+
+		if routeAllowed {
+			allowingListeners = append(allowingListeners, l)
+		}
 	}
 
 	if !attached {
 		if !allowed {
-			return staticConds.NewRouteNotAllowedByListeners(), false
+			return staticConds.NewRouteNotAllowedByListeners(evaluatedListeners(attachableListeners)), false
 		}
 		if !hostnamesUnique {
 			return staticConds.NewRouteHostnameConflict(), false
 		}
-		return staticConds.NewRouteNoMatchingListenerHostname(), false
+		return staticConds.NewRouteNoMatchingListenerHostname(evaluatedListeners(allowingListeners)), false
 	}
 
 	if !attachedToAtLeastOneValidListener {
@@ -631,18 +656,23 @@ func tryToAttachL7RouteToListeners(
 	var attachedToAtLeastOneValidListener bool
 
 	var allowed, attached bool
+	var allowingListeners []*Listener
 	for _, l := range attachableListeners {
 		routeAllowed, routeAttached := bind(l)
 		allowed = allowed || routeAllowed
 		attached = attached || routeAttached
 		attachedToAtLeastOneValidListener = attachedToAtLeastOneValidListener || (routeAttached && l.Valid)
+
+		if routeAllowed {
+			allowingListeners = append(allowingListeners, l)
+		}
 	}
 
 	if !attached {
 		if !allowed {
-			return staticConds.NewRouteNotAllowedByListeners(), false
+			return staticConds.NewRouteNotAllowedByListeners(evaluatedListeners(attachableListeners)), false
 		}
-		return staticConds.NewRouteNoMatchingListenerHostname(), false
+		return staticConds.NewRouteNoMatchingListenerHostname(evaluatedListeners(allowingListeners)), false
 	}
 
 	if !attachedToAtLeastOneValidListener {
@@ -652,6 +682,23 @@ func tryToAttachL7RouteToListeners(
 	return conditions.Condition{}, true
 }
 
+// evaluatedListeners returns the listeners that were evaluated for the attachment of a Route, for the
+// conditions of the Route.
+func evaluatedListeners(listeners []*Listener) []staticConds.EvaluatedListener {
+	evaluated := make([]staticConds.EvaluatedListener, 0, len(listeners))
+
+	for _, l := range listeners {
+		listener := staticConds.EvaluatedListener{Name: l.Name}
+		if l.Source.Hostname != nil {
+			listener.Hostname = string(*l.Source.Hostname)
+		}
+
+		evaluated = append(evaluated, listener)
+	}
+
+	return evaluated
+}
+
 // findAttachableListeners returns a list of attachable listeners and whether the listener exists for a non-empty
 // sectionName.
 func findAttachableListeners(sectionName string, listeners []*Listener) ([]*Listener, bool) {
@@ -971,6 +1018,18 @@ func validateResponseHeaders(
 	path *field.Path,
 ) field.ErrorList {
 	var allErrs field.ErrorList
+
+	for _, h := range headers {
+		if !responseHeaderNameAllowed(string(h.Name)) {
+			allErrs = append(allErrs, field.Invalid(path, h, "header name is not allowed"))
+		}
+	}
+
+	return allErrs
+}
+
+// responseHeaderNameAllowed returns whether the response header can be modified.
+func responseHeaderNameAllowed(name string) bool {
 	disallowedResponseHeaderSet := map[string]struct{}{
 		"server":         {},
 		"date":           {},
@@ -981,16 +1040,10 @@ func validateResponseHeaders(
 	}
 	invalidPrefix := "x-accel"
 
-	for _, h := range headers {
-		valErr := field.Invalid(path, h, "header name is not allowed")
-		name := strings.ToLower(string(h.Name))
-		if _, exists := disallowedResponseHeaderSet[name]; exists ||
-			strings.HasPrefix(name, strings.ToLower(invalidPrefix)) {
-			allErrs = append(allErrs, valErr)
-		}
-	}
+	name = strings.ToLower(name)
+	_, disallowed := disallowedResponseHeaderSet[name]
 
-	return allErrs
+	return !disallowed && !strings.HasPrefix(name, invalidPrefix)
 }
 
 func validateRequestHeadersCaseInsensitiveUnique(
@@ -730,8 +730,10 @@ func TestBindRouteToListeners(t *testing.T) {
 					Gateway:     client.ObjectKeyFromObject(gw),
 					SectionName: hr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNoMatchingListenerHostname(),
+						Attached: false,
+						FailedCondition: staticConds.NewRouteNoMatchingListenerHostname(
+							[]staticConds.EvaluatedListener{{Name: "listener-80-1", Hostname: "bar.example.com"}},
+						),
 						AcceptedHostnames: map[string][]string{},
 					},
 				},
@@ -939,8 +941,10 @@ func TestBindRouteToListeners(t *testing.T) {
 					Gateway:     client.ObjectKeyFromObject(gw),
 					SectionName: hr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						Attached: false,
+						FailedCondition: staticConds.NewRouteNotAllowedByListeners(
+							[]staticConds.EvaluatedListener{{Name: "listener-80-1"}},
+						),
 						AcceptedHostnames: map[string][]string{},
 					},
 				},
@@ -1025,8 +1029,10 @@ func TestBindRouteToListeners(t *testing.T) {
 					Gateway:     client.ObjectKeyFromObject(gwDiffNamespace),
 					SectionName: hr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						Attached: false,
+						FailedCondition: staticConds.NewRouteNotAllowedByListeners(
+							[]staticConds.EvaluatedListener{{Name: "listener-80-1"}},
+						),
 						AcceptedHostnames: map[string][]string{},
 					},
 				},
@@ -1148,8 +1154,10 @@ func TestBindRouteToListeners(t *testing.T) {
 					Gateway:     client.ObjectKeyFromObject(gw),
 					SectionName: gr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						Attached: false,
+						FailedCondition: staticConds.NewRouteNotAllowedByListeners(
+							[]staticConds.EvaluatedListener{{Name: "listener-80-1"}},
+						),
 						AcceptedHostnames: map[string][]string{},
 					},
 				},
@@ -2161,12 +2169,9 @@ func TestBindL4RouteToListeners(t *testing.T) {
 					SectionName: tr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
 						AcceptedHostnames: map[string][]string{},
-						FailedCondition: conditions.Condition{
-							Type:    "Accepted",
-							Status:  "False",
-							Reason:  "NotAllowedByListeners",
-							Message: "Route is not allowed by any listener",
-						},
+						FailedCondition: staticConds.NewRouteNotAllowedByListeners(
+							[]staticConds.EvaluatedListener{{Name: "listener-443"}},
+						),
 					},
 				},
 			},
@@ -2249,7 +2254,9 @@ func TestBindL4RouteToListeners(t *testing.T) {
 					SectionName: tr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
 						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNoMatchingListenerHostname(),
+						FailedCondition: staticConds.NewRouteNoMatchingListenerHostname(
+							[]staticConds.EvaluatedListener{{Name: "listener-443", Hostname: "*.example.org"}},
+						),
 					},
 				},
 			},
@@ -2393,7 +2400,9 @@ func TestBindL4RouteToListeners(t *testing.T) {
 					Gateway: client.ObjectKeyFromObject(gw),
 					Attachment: &ParentRefAttachmentStatus{
 						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAllowedByListeners(),
+						FailedCondition: staticConds.NewRouteNotAllowedByListeners(
+							[]staticConds.EvaluatedListener{{Name: "listener-443"}},
+						),
 					},
 					SectionName: helpers.GetPointer[gatewayv1.SectionName]("listener-443"),
 				},
@@ -0,0 +1,393 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Route delegation allows a parent HTTPRoute, attached to the Gateway, to delegate path prefixes to child HTTPRoutes,
+// which can live in other namespaces. A rule of the parent HTTPRoute delegates the path prefixes of its matches
+// with a single backendRef that references the child HTTPRoute. The child HTTPRoute accepts the delegation by
+// referencing the parent HTTPRoute in its parentRefs, so that both owners consent to the delegation and no
+// ReferenceGrant is required.
+//
+// The child HTTPRoute inherits the hostnames and the Listeners of the parent HTTPRoute, and the paths of its matches
+// must be within the delegated path prefixes. Only one level of delegation is supported.
+
+// validateDelegation validates a rule that delegates the path prefixes of its matches to a child HTTPRoute,
+// and returns the NamespacedName of the child HTTPRoute. It returns nil if the rule does not delegate.
+func validateDelegation(
+	rule v1.HTTPRouteRule,
+	routeNamespace string,
+	rulePath *field.Path,
+) (*types.NamespacedName, field.ErrorList) {
+	idx := slices.IndexFunc(rule.BackendRefs, func(ref v1.HTTPBackendRef) bool {
+		return isHTTPRouteRef(ref.Group, ref.Kind)
+	})
+	if idx == -1 {
+		return nil, nil
+	}
+
+	var allErrs field.ErrorList
+
+	if len(rule.BackendRefs) != 1 {
+		allErrs = append(allErrs, field.Invalid(
+			rulePath.Child("backendRefs"),
+			len(rule.BackendRefs),
+			"a rule that delegates to an HTTPRoute must have exactly one backendRef",
+		))
+	}
+
+	if len(rule.Filters) > 0 {
+		allErrs = append(allErrs, field.Forbidden(
+			rulePath.Child("filters"),
+			"cannot be set when the rule delegates to an HTTPRoute",
+		))
+	}
+
+	for i, match := range rule.Matches {
+		matchPath := rulePath.Child("matches").Index(i)
+
+		if match.Path != nil && match.Path.Type != nil && *match.Path.Type != v1.PathMatchPathPrefix {
+			allErrs = append(allErrs, field.NotSupported(
+				matchPath.Child("path", "type"),
+				*match.Path.Type,
+				[]string{string(v1.PathMatchPathPrefix)},
+			))
+		}
+
+		if len(match.Headers) > 0 || len(match.QueryParams) > 0 || match.Method != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				matchPath,
+				"only path matches are supported when the rule delegates to an HTTPRoute",
+			))
+		}
+	}
+
+	ref := rule.BackendRefs[idx]
+
+	ns := routeNamespace
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+
+	return &types.NamespacedName{Namespace: ns, Name: string(ref.Name)}, allErrs
+}
+
+// isHTTPRouteRef returns true if the group and kind of a reference point to an HTTPRoute.
+func isHTTPRouteRef[G ~string, K ~string](group *G, kind *K) bool {
+	return kind != nil && string(*kind) == kinds.HTTPRoute && group != nil && string(*group) == v1.GroupName
+}
+
+// delegation is a delegation of path prefixes from a parent HTTPRoute to a child HTTPRoute.
+type delegation struct {
+	// parent is the NamespacedName of the parent HTTPRoute.
+	parent types.NamespacedName
+	// prefixes are the delegated path prefixes.
+	prefixes []string
+}
+
+// addDelegatedRoutes builds the child HTTPRoutes to which the HTTPRoutes attached to the Gateways delegate path
+// prefixes, and adds them to the routes. It also adds a condition to the parent HTTPRoutes for every delegation
+// that the child HTTPRoute does not accept.
+func addDelegatedRoutes(
+	validator validation.HTTPFieldsValidator,
+	httpRoutes map[types.NamespacedName]*v1.HTTPRoute,
+	routes map[RouteKey]*L7Route,
+) {
+	delegations := make(map[types.NamespacedName][]delegation)
+	parents := make(map[types.NamespacedName]*L7Route)
+
+	for key, r := range routes {
+		if key.RouteType != RouteTypeHTTP || isDelegatedRoute(r) {
+			continue
+		}
+
+		parents[key.NamespacedName] = r
+
+		for _, rule := range r.Spec.Rules {
+			if rule.Delegate == nil {
+				continue
+			}
+
+			delegations[*rule.Delegate] = append(delegations[*rule.Delegate], delegation{
+				parent:   key.NamespacedName,
+				prefixes: delegatedPrefixes(rule.Matches),
+			})
+		}
+	}
+
+	accepted := make(map[delegationKey]struct{})
+
+	for nsname, ghr := range httpRoutes {
+		if _, exists := parents[nsname]; exists {
+			continue
+		}
+
+		parentRefs := buildParentRouteRefs(ghr, parents)
+		if len(parentRefs) == 0 {
+			continue
+		}
+
+		var prefixes []string
+		for _, ref := range parentRefs {
+			for _, d := range delegations[nsname] {
+				if d.parent == *ref.ParentRoute {
+					prefixes = append(prefixes, d.prefixes...)
+					accepted[delegationKey{parent: d.parent, child: nsname}] = struct{}{}
+				}
+			}
+		}
+
+		routes[CreateRouteKey(ghr)] = buildDelegatedHTTPRoute(validator, ghr, parentRefs, prefixes)
+	}
+
+	for parentNsName, parent := range parents {
+		for i, rule := range parent.Spec.Rules {
+			if rule.Delegate == nil {
+				continue
+			}
+
+			if _, ok := accepted[delegationKey{parent: parentNsName, child: *rule.Delegate}]; ok {
+				continue
+			}
+
+			refPath := field.NewPath("spec").Child("rules").Index(i).Child("backendRefs").Index(0)
+			msg := field.NotFound(refPath.Child("name"), rule.Delegate.Name).Error() +
+				": the HTTPRoute does not exist or does not reference this HTTPRoute in its parentRefs"
+
+			parent.Conditions = append(parent.Conditions, staticConds.NewRouteBackendRefRefBackendNotFound(msg))
+		}
+	}
+}
+
+// delegationKey identifies a delegation from a parent HTTPRoute to a child HTTPRoute.
+type delegationKey struct {
+	parent types.NamespacedName
+	child  types.NamespacedName
+}
+
+// delegatedPrefixes returns the path prefixes of the matches of a delegating rule.
+func delegatedPrefixes(matches []v1.HTTPRouteMatch) []string {
+	if len(matches) == 0 {
+		return []string{"/"}
+	}
+
+	prefixes := make([]string, 0, len(matches))
+	for _, m := range matches {
+		prefixes = append(prefixes, getPathValue(m.Path))
+	}
+
+	return prefixes
+}
+
+// buildParentRouteRefs returns the ParentRefs of an HTTPRoute that reference the parent HTTPRoutes.
+func buildParentRouteRefs(ghr *v1.HTTPRoute, parents map[types.NamespacedName]*L7Route) []ParentRef {
+	var refs []ParentRef
+
+	for i, p := range ghr.Spec.ParentRefs {
+		if !isHTTPRouteRef(p.Group, p.Kind) {
+			continue
+		}
+
+		ns := ghr.Namespace
+		if p.Namespace != nil {
+			ns = string(*p.Namespace)
+		}
+
+		nsname := types.NamespacedName{Namespace: ns, Name: string(p.Name)}
+		if _, exists := parents[nsname]; !exists {
+			continue
+		}
+
+		refs = append(refs, ParentRef{
+			Idx:         i,
+			ParentRoute: &nsname,
+			SectionName: p.SectionName,
+			Port:        p.Port,
+		})
+	}
+
+	return refs
+}
+
+// buildDelegatedHTTPRoute builds a child HTTPRoute. The paths of its matches must be within the path prefixes
+// delegated to it.
+func buildDelegatedHTTPRoute(
+	validator validation.HTTPFieldsValidator,
+	ghr *v1.HTTPRoute,
+	parentRefs []ParentRef,
+	prefixes []string,
+) *L7Route {
+	r := &L7Route{
+		Source:     ghr,
+		RouteType:  RouteTypeHTTP,
+		ParentRefs: parentRefs,
+	}
+
+	if len(ghr.Spec.Hostnames) > 0 {
+		valErr := field.Forbidden(
+			field.NewPath("spec").Child("hostnames"),
+			"cannot be set when the HTTPRoute references a parent HTTPRoute, because the hostnames are inherited",
+		)
+		r.Conditions = append(r.Conditions, staticConds.NewRouteUnsupportedValue(valErr.Error()))
+
+		return r
+	}
+
+	r.Valid = true
+	r.Attachable = true
+
+	rules, _, allRulesErrs := processHTTPRouteRules(ghr.Spec.Rules, ghr.Namespace, validator)
+
+	var atLeastOneValid bool
+
+	for i := range rules {
+		rulePath := field.NewPath("spec").Child("rules").Index(i)
+
+		if rules[i].Delegate != nil {
+			valErr := field.Forbidden(
+				rulePath.Child("backendRefs"),
+				"cannot reference an HTTPRoute, because only one level of delegation is supported",
+			)
+			allRulesErrs = append(allRulesErrs, valErr)
+			rules[i].Delegate = nil
+			rules[i].ValidMatches = false
+		}
+
+		for j, m := range rules[i].Matches {
+			path := getPathValue(m.Path)
+
+			if !slices.ContainsFunc(prefixes, func(prefix string) bool { return pathWithinPrefix(path, prefix) }) {
+				msg := fmt.Sprintf("must be within the path prefixes delegated by the parent HTTPRoutes: %s",
+					strings.Join(prefixes, ", "))
+				allRulesErrs = append(allRulesErrs, field.Invalid(rulePath.Child("matches").Index(j), path, msg))
+				rules[i].ValidMatches = false
+			}
+		}
+
+		if rules[i].ValidMatches && rules[i].ValidFilters {
+			atLeastOneValid = true
+		}
+	}
+
+	r.Spec.Rules = rules
+
+	addHTTPRouteRulesConditions(r, ghr, atLeastOneValid, allRulesErrs)
+
+	return r
+}
+
+// getPathValue returns the value of a path match, defaulting to "/".
+func getPathValue(path *v1.HTTPPathMatch) string {
+	if path == nil || path.Value == nil || *path.Value == "" {
+		return "/"
+	}
+
+	return *path.Value
+}
+
+// pathWithinPrefix returns true if the path is equal to the prefix or is within the prefix,
+// based on the path elements.
+func pathWithinPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// isDelegatedRoute returns true if the Route is a child HTTPRoute that references parent HTTPRoutes.
+func isDelegatedRoute(r *L7Route) bool {
+	return len(r.ParentRefs) > 0 && r.ParentRefs[0].ParentRoute != nil
+}
+
+// bindDelegatedRoutesToListeners binds the child HTTPRoutes to the Listeners of their parent HTTPRoutes.
+// It must be called after the parent HTTPRoutes are bound.
+func bindDelegatedRoutesToListeners(routes map[RouteKey]*L7Route, gw *Gateway) {
+	listeners := make(map[string]*Listener, len(gw.Listeners))
+	for _, l := range gw.Listeners {
+		listeners[l.Name] = l
+	}
+
+	for key, r := range routes {
+		if !isDelegatedRoute(r) || !r.Attachable {
+			continue
+		}
+
+		for i := range r.ParentRefs {
+			ref := &r.ParentRefs[i]
+
+			attachment := &ParentRefAttachmentStatus{
+				AcceptedHostnames: make(map[string][]string),
+			}
+			ref.Attachment = attachment
+
+			if ref.SectionName != nil || ref.Port != nil {
+				valErr := field.Forbidden(
+					field.NewPath("spec").Child("parentRefs").Index(ref.Idx),
+					"sectionName and port cannot be set for a parent HTTPRoute",
+				)
+				attachment.FailedCondition = staticConds.NewRouteUnsupportedValue(valErr.Error())
+				continue
+			}
+
+			parent := routes[RouteKey{NamespacedName: *ref.ParentRoute, RouteType: RouteTypeHTTP}]
+
+			if !delegatesTo(parent, key.NamespacedName) {
+				attachment.FailedCondition = staticConds.NewRouteDelegationNotAccepted(
+					"The parent HTTPRoute does not delegate to this HTTPRoute",
+				)
+				continue
+			}
+
+			for _, parentRef := range parent.ParentRefs {
+				if parentRef.Attachment == nil || !parentRef.Attachment.Attached {
+					continue
+				}
+
+				for name, hostnames := range parentRef.Attachment.AcceptedHostnames {
+					l, exists := listeners[name]
+					if !exists {
+						continue
+					}
+
+					for _, h := range hostnames {
+						if !slices.Contains(attachment.AcceptedHostnames[name], h) {
+							attachment.AcceptedHostnames[name] = append(attachment.AcceptedHostnames[name], h)
+						}
+					}
+
+					attachment.ListenerPort = parentRef.Attachment.ListenerPort
+					attachment.Attached = true
+
+					l.Routes[key] = r
+				}
+			}
+
+			if !attachment.Attached {
+				attachment.FailedCondition = staticConds.NewRouteDelegationNotAccepted(
+					"The parent HTTPRoute is not attached to the Gateway",
+				)
+			}
+		}
+	}
+}
+
+// delegatesTo returns true if a valid rule of the parent HTTPRoute delegates to the child HTTPRoute.
+func delegatesTo(parent *L7Route, child types.NamespacedName) bool {
+	if parent == nil || !parent.Valid {
+		return false
+	}
+
+	return slices.ContainsFunc(parent.Spec.Rules, func(rule RouteRule) bool {
+		return rule.Delegate != nil && *rule.Delegate == child
+	})
+}
@@ -0,0 +1,384 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func createHTTPRouteBackendRef(namespace, name string) gatewayv1.HTTPBackendRef {
+	return gatewayv1.HTTPBackendRef{
+		BackendRef: gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Group:     helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName),
+				Kind:      helpers.GetPointer[gatewayv1.Kind](kinds.HTTPRoute),
+				Namespace: helpers.GetPointer(gatewayv1.Namespace(namespace)),
+				Name:      gatewayv1.ObjectName(name),
+			},
+		},
+	}
+}
+
+func createPrefixMatch(path string) gatewayv1.HTTPRouteMatch {
+	return gatewayv1.HTTPRouteMatch{
+		Path: &gatewayv1.HTTPPathMatch{
+			Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+			Value: helpers.GetPointer(path),
+		},
+	}
+}
+
+func createChildHTTPRoute(name string, parentRef gatewayv1.ParentReference, paths ...string) *gatewayv1.HTTPRoute {
+	rules := make([]gatewayv1.HTTPRouteRule, 0, len(paths))
+	for _, path := range paths {
+		rules = append(rules, gatewayv1.HTTPRouteRule{
+			Matches: []gatewayv1.HTTPRouteMatch{createPrefixMatch(path)},
+		})
+	}
+
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "app",
+			Name:      name,
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{parentRef},
+			},
+			Rules: rules,
+		},
+	}
+}
+
+func createParentRouteRef(name string) gatewayv1.ParentReference {
+	return gatewayv1.ParentReference{
+		Group:     helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName),
+		Kind:      helpers.GetPointer[gatewayv1.Kind](kinds.HTTPRoute),
+		Namespace: helpers.GetPointer[gatewayv1.Namespace]("test"),
+		Name:      gatewayv1.ObjectName(name),
+	}
+}
+
+func TestValidateDelegation(t *testing.T) {
+	t.Parallel()
+
+	rulePath := field.NewPath("spec").Child("rules").Index(0)
+
+	tests := []struct {
+		rule        gatewayv1.HTTPRouteRule
+		expDelegate *types.NamespacedName
+		name        string
+		expErrCount int
+	}{
+		{
+			name: "rule does not delegate",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches:     []gatewayv1.HTTPRouteMatch{createPrefixMatch("/")},
+				BackendRefs: []gatewayv1.HTTPBackendRef{{}},
+			},
+			expDelegate: nil,
+		},
+		{
+			name: "valid delegation",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches:     []gatewayv1.HTTPRouteMatch{createPrefixMatch("/app")},
+				BackendRefs: []gatewayv1.HTTPBackendRef{createHTTPRouteBackendRef("app", "child")},
+			},
+			expDelegate: &types.NamespacedName{Namespace: "app", Name: "child"},
+		},
+		{
+			name: "valid delegation in route namespace",
+			rule: gatewayv1.HTTPRouteRule{
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					func() gatewayv1.HTTPBackendRef {
+						ref := createHTTPRouteBackendRef("", "child")
+						ref.Namespace = nil
+						return ref
+					}(),
+				},
+			},
+			expDelegate: &types.NamespacedName{Namespace: "test", Name: "child"},
+		},
+		{
+			name: "invalid delegation",
+			rule: gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{
+					{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  helpers.GetPointer(gatewayv1.PathMatchExact),
+							Value: helpers.GetPointer("/app"),
+						},
+						Method: helpers.GetPointer(gatewayv1.HTTPMethodGet),
+					},
+				},
+				Filters: []gatewayv1.HTTPRouteFilter{
+					{Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier},
+				},
+				BackendRefs: []gatewayv1.HTTPBackendRef{createHTTPRouteBackendRef("app", "child"), {}},
+			},
+			expDelegate: &types.NamespacedName{Namespace: "app", Name: "child"},
+			expErrCount: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			delegate, errs := validateDelegation(test.rule, "test", rulePath)
+			g.Expect(delegate).To(Equal(test.expDelegate))
+			g.Expect(errs).To(HaveLen(test.expErrCount))
+		})
+	}
+}
+
+func TestAddDelegatedRoutes(t *testing.T) {
+	t.Parallel()
+
+	createParent := func(name string, delegate string) *L7Route {
+		hr := createHTTPRoute(name, "gateway", "foo.example.com", "/")
+		hr.Spec.Rules = append(hr.Spec.Rules, gatewayv1.HTTPRouteRule{
+			Matches:     []gatewayv1.HTTPRouteMatch{createPrefixMatch("/app")},
+			BackendRefs: []gatewayv1.HTTPBackendRef{createHTTPRouteBackendRef("app", delegate)},
+		})
+
+		return buildHTTPRoute(
+			&validationfakes.FakeHTTPFieldsValidator{},
+			hr,
+			[]types.NamespacedName{{Namespace: "test", Name: "gateway"}},
+		)
+	}
+
+	childNsName := types.NamespacedName{Namespace: "app", Name: "child"}
+	childKey := RouteKey{NamespacedName: childNsName, RouteType: RouteTypeHTTP}
+	parentNsName := types.NamespacedName{Namespace: "test", Name: "parent"}
+	parentKey := RouteKey{NamespacedName: parentNsName, RouteType: RouteTypeHTTP}
+
+	tests := []struct {
+		child         *gatewayv1.HTTPRoute
+		expChild      func(g *WithT, r *L7Route)
+		name          string
+		expParentCond []conditions.Condition
+	}{
+		{
+			name:  "child accepts the delegation",
+			child: createChildHTTPRoute("child", createParentRouteRef("parent"), "/app", "/app/v2"),
+			expChild: func(g *WithT, r *L7Route) {
+				g.Expect(r.Valid).To(BeTrue())
+				g.Expect(r.Conditions).To(BeEmpty())
+				g.Expect(r.ParentRefs).To(Equal([]ParentRef{{Idx: 0, ParentRoute: &parentNsName}}))
+				g.Expect(r.Spec.Rules).To(HaveLen(2))
+				g.Expect(r.Spec.Rules[0].ValidMatches).To(BeTrue())
+				g.Expect(r.Spec.Rules[1].ValidMatches).To(BeTrue())
+			},
+		},
+		{
+			name:  "child matches outside the delegated prefix",
+			child: createChildHTTPRoute("child", createParentRouteRef("parent"), "/app", "/application"),
+			expChild: func(g *WithT, r *L7Route) {
+				g.Expect(r.Valid).To(BeTrue())
+				g.Expect(r.Spec.Rules[0].ValidMatches).To(BeTrue())
+				g.Expect(r.Spec.Rules[1].ValidMatches).To(BeFalse())
+				g.Expect(r.Conditions).To(HaveLen(1))
+				g.Expect(r.Conditions[0].Type).To(Equal(string(gatewayv1.RouteConditionPartiallyInvalid)))
+			},
+		},
+		{
+			name: "child sets hostnames",
+			child: func() *gatewayv1.HTTPRoute {
+				hr := createChildHTTPRoute("child", createParentRouteRef("parent"), "/app")
+				hr.Spec.Hostnames = []gatewayv1.Hostname{"bar.example.com"}
+				return hr
+			}(),
+			expChild: func(g *WithT, r *L7Route) {
+				g.Expect(r.Valid).To(BeFalse())
+				g.Expect(r.Conditions).To(HaveLen(1))
+			},
+		},
+		{
+			name:  "child does not reference the parent",
+			child: createChildHTTPRoute("child", createParentRouteRef("other-parent")),
+			expChild: func(g *WithT, r *L7Route) {
+				g.Expect(r).To(BeNil())
+			},
+			expParentCond: []conditions.Condition{
+				staticConds.NewRouteBackendRefRefBackendNotFound(
+					`spec.rules[1].backendRefs[0].name: Not found: "child": ` +
+						"the HTTPRoute does not exist or does not reference this HTTPRoute in its parentRefs",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			routes := map[RouteKey]*L7Route{
+				parentKey: createParent("parent", "child"),
+			}
+
+			addDelegatedRoutes(
+				&validationfakes.FakeHTTPFieldsValidator{},
+				map[types.NamespacedName]*gatewayv1.HTTPRoute{
+					parentNsName: helpers.MustCastObject[*gatewayv1.HTTPRoute](routes[parentKey].Source),
+					childNsName:  test.child,
+				},
+				routes,
+			)
+
+			test.expChild(g, routes[childKey])
+			g.Expect(routes[parentKey].Conditions).To(Equal(test.expParentCond))
+			g.Expect(routes[parentKey].Spec.Rules[1].Delegate).To(Equal(&childNsName))
+		})
+	}
+}
+
+func TestBindDelegatedRoutesToListeners(t *testing.T) {
+	t.Parallel()
+
+	childNsName := types.NamespacedName{Namespace: "app", Name: "child"}
+	childKey := RouteKey{NamespacedName: childNsName, RouteType: RouteTypeHTTP}
+	parentNsName := types.NamespacedName{Namespace: "test", Name: "parent"}
+	parentKey := RouteKey{NamespacedName: parentNsName, RouteType: RouteTypeHTTP}
+
+	createParent := func(attached bool, delegate *types.NamespacedName) *L7Route {
+		return &L7Route{
+			Valid: true,
+			ParentRefs: []ParentRef{
+				{
+					Attachment: &ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{"listener-80": {"foo.example.com"}},
+						ListenerPort:      80,
+						Attached:          attached,
+					},
+				},
+			},
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{{Delegate: delegate, ValidMatches: true, ValidFilters: true}},
+			},
+		}
+	}
+
+	tests := []struct {
+		parent        *L7Route
+		expAttachment *ParentRefAttachmentStatus
+		name          string
+		parentRef     ParentRef
+		expBound      bool
+	}{
+		{
+			name:      "child is bound to the listeners of the parent",
+			parent:    createParent(true, &childNsName),
+			parentRef: ParentRef{ParentRoute: &parentNsName},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{"listener-80": {"foo.example.com"}},
+				ListenerPort:      80,
+				Attached:          true,
+			},
+			expBound: true,
+		},
+		{
+			name:      "parent is not attached",
+			parent:    createParent(false, &childNsName),
+			parentRef: ParentRef{ParentRoute: &parentNsName},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{},
+				FailedCondition: staticConds.NewRouteDelegationNotAccepted(
+					"The parent HTTPRoute is not attached to the Gateway",
+				),
+			},
+		},
+		{
+			name:      "parent does not delegate",
+			parent:    createParent(true, nil),
+			parentRef: ParentRef{ParentRoute: &parentNsName},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{},
+				FailedCondition: staticConds.NewRouteDelegationNotAccepted(
+					"The parent HTTPRoute does not delegate to this HTTPRoute",
+				),
+			},
+		},
+		{
+			name:   "sectionName is set",
+			parent: createParent(true, &childNsName),
+			parentRef: ParentRef{
+				ParentRoute: &parentNsName,
+				SectionName: helpers.GetPointer[gatewayv1.SectionName]("listener-80"),
+			},
+			expAttachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{},
+				FailedCondition: staticConds.NewRouteUnsupportedValue(
+					"spec.parentRefs[0]: Forbidden: sectionName and port cannot be set for a parent HTTPRoute",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			listener := &Listener{
+				Name:   "listener-80",
+				Routes: map[RouteKey]*L7Route{},
+			}
+			gw := &Gateway{Listeners: []*Listener{listener}}
+
+			child := &L7Route{
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{test.parentRef},
+			}
+
+			bindDelegatedRoutesToListeners(map[RouteKey]*L7Route{parentKey: test.parent, childKey: child}, gw)
+
+			g.Expect(child.ParentRefs[0].Attachment).To(Equal(test.expAttachment))
+			if test.expBound {
+				g.Expect(listener.Routes).To(HaveKeyWithValue(childKey, child))
+			} else {
+				g.Expect(listener.Routes).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestPathWithinPrefix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path   string
+		prefix string
+		exp    bool
+	}{
+		{path: "/app", prefix: "/app", exp: true},
+		{path: "/app/v2", prefix: "/app", exp: true},
+		{path: "/app/v2", prefix: "/app/", exp: true},
+		{path: "/application", prefix: "/app", exp: false},
+		{path: "/other", prefix: "/app", exp: false},
+		{path: "/anything", prefix: "/", exp: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path+" within "+test.prefix, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(pathWithinPrefix(test.path, test.prefix)).To(Equal(test.exp))
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package graph
 
 import (
-	"crypto/tls"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -15,6 +18,21 @@ type Secret struct {
 	Source *apiv1.Secret
 }
 
+// TLSKeyPair returns the PEM-encoded certificate chain and private key of the Secret. It returns nil
+// if the Secret doesn't exist or doesn't have the keys of a certificate and a private key.
+func (s *Secret) TLSKeyPair() (cert, key []byte) {
+	if s.Source == nil {
+		return nil, nil
+	}
+
+	cert, key, _, _, err := findTLSKeyPair(s.Source.Data)
+	if err != nil {
+		return nil, nil
+	}
+
+	return cert, key
+}
+
 type secretEntry struct {
 	Secret
 	// err holds the corresponding error if the Secret is invalid or does not exist.
@@ -48,15 +66,16 @@ func (r *secretResolver) resolve(nsname types.NamespacedName) error {
 	case !exist:
 		validationErr = errors.New("secret does not exist")
 
-	case secret.Type != apiv1.SecretTypeTLS:
-		validationErr = fmt.Errorf("secret type must be %q not %q", apiv1.SecretTypeTLS, secret.Type)
+	case secret.Type != apiv1.SecretTypeTLS && secret.Type != apiv1.SecretTypeOpaque && secret.Type != "":
+		validationErr = fmt.Errorf(
+			"secret type must be %q or %q not %q",
+			apiv1.SecretTypeTLS,
+			apiv1.SecretTypeOpaque,
+			secret.Type,
+		)
 
 	default:
-		// A TLS Secret is guaranteed to have these data fields.
-		_, err := tls.X509KeyPair(secret.Data[apiv1.TLSCertKey], secret.Data[apiv1.TLSPrivateKeyKey])
-		if err != nil {
-			validationErr = fmt.Errorf("TLS secret is invalid: %w", err)
-		}
+		validationErr = validateTLSKeyPair(secret.Data, time.Now())
 	}
 
 	r.resolvedSecrets[nsname] = &secretEntry{
@@ -84,3 +103,109 @@ func (r *secretResolver) getResolvedSecrets() map[types.NamespacedName]*Secret {
 
 	return resolved
 }
+
+// validateTLSKeyPair validates that the data of a Secret holds a valid certificate and private key,
+// which are not rejected at the time now. The errors name the keys of the Secret, so that users can see
+// which key is invalid.
+func validateTLSKeyPair(data map[string][]byte, now time.Time) error {
+	cert, key, certKey, keyKey, err := findTLSKeyPair(data)
+	if err != nil {
+		return err
+	}
+
+	if err := validateCertificate(cert, key, now); err != nil {
+		if certKey == apiv1.TLSCertKey && keyKey == apiv1.TLSPrivateKeyKey {
+			return fmt.Errorf("TLS secret is invalid: %w", err)
+		}
+
+		return fmt.Errorf("certificate of key %q or private key of key %q is invalid: %w", certKey, keyKey, err)
+	}
+
+	return nil
+}
+
+// findTLSKeyPair returns the PEM-encoded certificate chain and private key of the data of a Secret, and the keys
+// of the Secret that hold them. The certificate and the key are the tls.crt and tls.key keys, like in
+// a kubernetes.io/tls Secret. Otherwise, they are the PEM blocks of the other keys, so that the Secrets of other
+// tools are supported, such as a cert.pem and a key.pem key, or a single key with a bundle of the certificate chain
+// and the key. The ca.crt key is skipped, because it holds the certificates of the CA.
+func findTLSKeyPair(data map[string][]byte) (cert, key []byte, certKey, keyKey string, err error) {
+	_, hasCert := data[apiv1.TLSCertKey]
+	_, hasKey := data[apiv1.TLSPrivateKeyKey]
+
+	if hasCert && hasKey {
+		return data[apiv1.TLSCertKey], data[apiv1.TLSPrivateKeyKey], apiv1.TLSCertKey, apiv1.TLSPrivateKeyKey, nil
+	}
+
+	dataKeys := make([]string, 0, len(data))
+	for k := range data {
+		if k != CAKey {
+			dataKeys = append(dataKeys, k)
+		}
+	}
+	sort.Strings(dataKeys)
+
+	var certKeys, keyKeys []string
+
+	for _, k := range dataKeys {
+		var blockCert, blockKey bool
+
+		rest := data[k]
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+
+			switch {
+			case block.Type == "CERTIFICATE":
+				blockCert = true
+				cert = append(cert, pem.EncodeToMemory(block)...)
+			case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+				if blockKey {
+					return nil, nil, "", "", fmt.Errorf("key %q of the secret has more than one private key", k)
+				}
+
+				blockKey = true
+				key = pem.EncodeToMemory(block)
+			}
+		}
+
+		if blockCert {
+			certKeys = append(certKeys, k)
+		}
+
+		if blockKey {
+			keyKeys = append(keyKeys, k)
+		}
+	}
+
+	switch {
+	case len(certKeys) == 0:
+		return nil, nil, "", "", errors.New("secret does not have a key with a PEM-encoded certificate")
+	case len(certKeys) > 1:
+		return nil, nil, "", "", fmt.Errorf(
+			"secret has more than one key with a PEM-encoded certificate: %s",
+			quoteKeys(certKeys),
+		)
+	case len(keyKeys) == 0:
+		return nil, nil, "", "", errors.New("secret does not have a key with a PEM-encoded private key")
+	case len(keyKeys) > 1:
+		return nil, nil, "", "", fmt.Errorf(
+			"secret has more than one key with a PEM-encoded private key: %s",
+			quoteKeys(keyKeys),
+		)
+	}
+
+	return cert, key, certKeys[0], keyKeys[0], nil
+}
+
+func quoteKeys(keys []string) string {
+	quoted := make([]string, 0, len(keys))
+	for _, k := range keys {
+		quoted = append(quoted, fmt.Sprintf("%q", k))
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// SecretTypeSecureLink is the type of the Secrets that hold the secrets of SecureLinkPolicies.
+// A dedicated type allows NGINX Gateway Fabric to only cache the Secrets that it may reference.
+const SecretTypeSecureLink apiv1.SecretType = "gateway.nginx.org/secure-link"
+
+// secureLinkSecretMinLength is the minimum length of the secret of a SecureLinkPolicy.
+const secureLinkSecretMinLength = 16
+
+// secureLinkSecretRegexp matches the secrets of SecureLinkPolicies, which are written in double quotes
+// in the NGINX config.
+var secureLinkSecretRegexp = regexp.MustCompile(`^[A-Za-z0-9\-_.+/=]+$`)
+
+// SecureLinkSecret is the secret of a SecureLinkPolicy.
+type SecureLinkSecret struct {
+	// Secret is the NamespacedName of the Secret that holds the secret.
+	Secret types.NamespacedName
+	// Data is the secret. It is nil if the secret is invalid, or if the SecureLinkPolicy is invalid.
+	Data []byte
+}
+
+// buildSecureLinkSecrets resolves the secrets of the SecureLinkPolicies. A SecureLinkPolicy with an invalid secret
+// becomes invalid. The secrets are returned by the NamespacedName of their SecureLinkPolicy. The secrets that
+// can't be resolved are returned too, so that the Graph references their Secrets, including the Secrets that
+// don't exist yet.
+func buildSecureLinkSecrets(
+	pols map[PolicyKey]*Policy,
+	secrets map[types.NamespacedName]*apiv1.Secret,
+) map[types.NamespacedName]*SecureLinkSecret {
+	secureLinkSecrets := make(map[types.NamespacedName]*SecureLinkSecret)
+
+	for key, policy := range pols {
+		sp, ok := policy.Source.(*ngfAPI.SecureLinkPolicy)
+		if !ok || len(policy.TargetRefs) == 0 {
+			continue
+		}
+
+		ref := sp.Spec.SecretRef
+		secureLinkSecret := &SecureLinkSecret{
+			Secret: types.NamespacedName{Namespace: sp.Namespace, Name: ref.Name},
+		}
+		secureLinkSecrets[key.NsName] = secureLinkSecret
+
+		data, err := resolveSecureLinkSecret(secrets[secureLinkSecret.Secret], ref.Key)
+		if err != nil {
+			msg := fmt.Sprintf("Secret %s is invalid: %s", secureLinkSecret.Secret, err)
+			policy.Conditions = append(policy.Conditions, staticConds.NewPolicyNotAcceptedInvalidSecret(msg))
+			policy.Valid = false
+
+			continue
+		}
+
+		if policy.Valid {
+			secureLinkSecret.Data = data
+		}
+	}
+
+	if len(secureLinkSecrets) == 0 {
+		return nil
+	}
+
+	return secureLinkSecrets
+}
+
+// resolveSecureLinkSecret returns the secret in the key of the Secret.
+func resolveSecureLinkSecret(secret *apiv1.Secret, key string) ([]byte, error) {
+	if secret == nil {
+		return nil, errors.New("Secret does not exist")
+	}
+
+	if secret.Type != SecretTypeSecureLink {
+		return nil, fmt.Errorf("secret type must be %q not %q", SecretTypeSecureLink, secret.Type)
+	}
+
+	data, exists := secret.Data[key]
+	if !exists {
+		return nil, fmt.Errorf("Secret does not have the key %s", key)
+	}
+
+	if len(data) < secureLinkSecretMinLength {
+		return nil, fmt.Errorf("the secret must be at least %d characters long", secureLinkSecretMinLength)
+	}
+
+	if !secureLinkSecretRegexp.Match(data) {
+		return nil, errors.New(
+			"the secret must contain only alphanumeric characters, '-', '_', '.', '+', '/', or '='",
+		)
+	}
+
+	return data, nil
+}
+
+// isSecureLinkSecret returns true if the Secret holds the secret of any SecureLinkPolicy.
+func isSecureLinkSecret(secrets map[types.NamespacedName]*SecureLinkSecret, nsname types.NamespacedName) bool {
+	for _, secret := range secrets {
+		if secret.Secret == nsname {
+			return true
+		}
+	}
+
+	return false
+}
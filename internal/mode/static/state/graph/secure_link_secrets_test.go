@@ -0,0 +1,178 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestBuildSecureLinkSecrets(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("0123456789abcdef")
+
+	secrets := map[types.NamespacedName]*v1.Secret{
+		{Namespace: testNs, Name: "secure-link"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "secure-link"},
+			Type:       SecretTypeSecureLink,
+			Data: map[string][]byte{
+				"secret":  secret,
+				"short":   []byte("secret"),
+				"invalid": []byte("0123456789abcdef\"; return 200;"),
+			},
+		},
+		{Namespace: testNs, Name: "opaque"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "opaque"},
+			Type:       v1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				"secret": secret,
+			},
+		},
+	}
+
+	createPolicy := func(secretName, key string, valid bool) *Policy {
+		return &Policy{
+			Source: &ngfAPI.SecureLinkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "secure-link-policy"},
+				Spec: ngfAPI.SecureLinkPolicySpec{
+					SecretRef: ngfAPI.SecureLinkSecretKeyReference{Name: secretName, Key: key},
+				},
+			},
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.HTTPRoute, Nsname: types.NamespacedName{Name: "route"}}},
+			Valid:      valid,
+		}
+	}
+
+	policyNsName := types.NamespacedName{Namespace: testNs, Name: "secure-link-policy"}
+	secretNsName := types.NamespacedName{Namespace: testNs, Name: "secure-link"}
+
+	tests := []struct {
+		policy     *Policy
+		expSecrets map[types.NamespacedName]*SecureLinkSecret
+		name       string
+		expConds   []conditions.Condition
+		expValid   bool
+	}{
+		{
+			name:   "valid secret",
+			policy: createPolicy("secure-link", "secret", true),
+			expSecrets: map[types.NamespacedName]*SecureLinkSecret{
+				policyNsName: {Secret: secretNsName, Data: secret},
+			},
+			expValid: true,
+		},
+		{
+			name:   "valid secret of an invalid policy",
+			policy: createPolicy("secure-link", "secret", false),
+			expSecrets: map[types.NamespacedName]*SecureLinkSecret{
+				policyNsName: {Secret: secretNsName},
+			},
+			expValid: false,
+		},
+		{
+			name:   "Secret does not exist",
+			policy: createPolicy("missing", "secret", true),
+			expSecrets: map[types.NamespacedName]*SecureLinkSecret{
+				policyNsName: {Secret: types.NamespacedName{Namespace: testNs, Name: "missing"}},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidSecret("Secret test/missing is invalid: Secret does not exist"),
+			},
+			expValid: false,
+		},
+		{
+			name:   "wrong Secret type",
+			policy: createPolicy("opaque", "secret", true),
+			expSecrets: map[types.NamespacedName]*SecureLinkSecret{
+				policyNsName: {Secret: types.NamespacedName{Namespace: testNs, Name: "opaque"}},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidSecret(
+					"Secret test/opaque is invalid: secret type must be \"gateway.nginx.org/secure-link\" not \"Opaque\"",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "key does not exist",
+			policy: createPolicy("secure-link", "missing", true),
+			expSecrets: map[types.NamespacedName]*SecureLinkSecret{
+				policyNsName: {Secret: secretNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidSecret(
+					"Secret test/secure-link is invalid: Secret does not have the key missing",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "secret too short",
+			policy: createPolicy("secure-link", "short", true),
+			expSecrets: map[types.NamespacedName]*SecureLinkSecret{
+				policyNsName: {Secret: secretNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidSecret(
+					"Secret test/secure-link is invalid: the secret must be at least 16 characters long",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "secret with invalid characters",
+			policy: createPolicy("secure-link", "invalid", true),
+			expSecrets: map[types.NamespacedName]*SecureLinkSecret{
+				policyNsName: {Secret: secretNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidSecret(
+					"Secret test/secure-link is invalid: the secret must contain only alphanumeric characters, " +
+						"'-', '_', '.', '+', '/', or '='",
+				),
+			},
+			expValid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			pols := map[PolicyKey]*Policy{
+				{NsName: policyNsName}: test.policy,
+			}
+
+			g.Expect(buildSecureLinkSecrets(pols, secrets)).To(Equal(test.expSecrets))
+			g.Expect(test.policy.Conditions).To(Equal(test.expConds))
+			g.Expect(test.policy.Valid).To(Equal(test.expValid))
+		})
+	}
+}
+
+func TestBuildSecureLinkSecrets_NoSecureLinkPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pols := map[PolicyKey]*Policy{
+		{NsName: types.NamespacedName{Namespace: testNs, Name: "csp"}}: {
+			Source:     &ngfAPI.ClientSettingsPolicy{},
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.Gateway}},
+			Valid:      true,
+		},
+		{NsName: types.NamespacedName{Namespace: testNs, Name: "untargeted"}}: {
+			Source: &ngfAPI.SecureLinkPolicy{},
+		},
+	}
+
+	g.Expect(buildSecureLinkSecrets(pols, nil)).To(BeNil())
+}
@@ -4,11 +4,18 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// ReferencedService represents a Service that is referenced by at least one Route.
+type ReferencedService struct {
+	// Policies is a list of NGF Policies that target this Service.
+	Policies []*Policy
+}
+
 func buildReferencedServices(
 	l7routes map[RouteKey]*L7Route,
 	l4Routes map[L4RouteKey]*L4Route,
-) map[types.NamespacedName]struct{} {
-	svcNames := make(map[types.NamespacedName]struct{})
+	npCfg *NginxProxy,
+) map[types.NamespacedName]*ReferencedService {
+	svcNames := make(map[types.NamespacedName]*ReferencedService)
 
 	attached := func(parentRefs []ParentRef) bool {
 		for _, ref := range parentRefs {
@@ -27,7 +34,7 @@ func buildReferencedServices(
 		for _, rule := range routeRules {
 			for _, ref := range rule.BackendRefs {
 				if ref.SvcNsName != (types.NamespacedName{}) {
-					svcNames[ref.SvcNsName] = struct{}{}
+					svcNames[ref.SvcNsName] = &ReferencedService{}
 				}
 			}
 		}
@@ -36,7 +43,7 @@ func buildReferencedServices(
 	populateServiceNamesForL4Routes := func(route *L4Route) {
 		nsname := route.Spec.BackendRef.SvcNsName
 		if nsname != (types.NamespacedName{}) {
-			svcNames[nsname] = struct{}{}
+			svcNames[nsname] = &ReferencedService{}
 		}
 	}
 
@@ -69,6 +76,12 @@ func buildReferencedServices(
 		populateServiceNamesForL4Routes(route)
 	}
 
+	// The activator Service is referenced even if it does not exist yet,
+	// so that the Graph is rebuilt when the Service is created.
+	if nsname, configured := activatorNsName(npCfg); configured {
+		svcNames[nsname] = &ReferencedService{}
+	}
+
 	if len(svcNames) == 0 {
 		return nil
 	}
@@ -5,6 +5,8 @@ import (
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
 )
 
 func TestBuildReferencedServices(t *testing.T) {
@@ -184,7 +186,8 @@ func TestBuildReferencedServices(t *testing.T) {
 	tests := []struct {
 		l7Routes map[RouteKey]*L7Route
 		l4Routes map[L4RouteKey]*L4Route
-		exp      map[types.NamespacedName]struct{}
+		exp      map[types.NamespacedName]*ReferencedService
+		npCfg    *NginxProxy
 		name     string
 	}{
 		{
@@ -195,7 +198,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			l4Routes: map[L4RouteKey]*L4Route{
 				{NamespacedName: types.NamespacedName{Name: "normal-l4-route"}}: normalL4Route,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "banana-ns", Name: "service"}:   {},
 				{Namespace: "tlsroute-ns", Name: "service"}: {},
 			},
@@ -205,7 +208,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "two-svc-one-rule"}}: validRouteTwoServicesOneRule,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 			},
@@ -215,7 +218,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule"}}: validRouteTwoServicesTwoRules,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 			},
@@ -231,7 +234,7 @@ func TestBuildReferencedServices(t *testing.T) {
 				{NamespacedName: types.NamespacedName{Name: "l4-route-2"}}:                    normalL4Route2,
 				{NamespacedName: types.NamespacedName{Name: "l4-route-same-svc-as-l7-route"}}: normalL4RouteWithSameSvcAsL7Route,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 				{Namespace: "tlsroute-ns", Name: "service"}:  {},
@@ -247,7 +250,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			l4Routes: map[L4RouteKey]*L4Route{
 				{NamespacedName: types.NamespacedName{Name: "normal-l4-route"}}: normalL4Route,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "service-ns", Name: "service"}:   {},
 				{Namespace: "service-ns2", Name: "service2"}: {},
 				{Namespace: "banana-ns", Name: "service"}:    {},
@@ -284,7 +287,7 @@ func TestBuildReferencedServices(t *testing.T) {
 				{NamespacedName: types.NamespacedName{Name: "invalid-l4-route"}}: invalidL4Route,
 				{NamespacedName: types.NamespacedName{Name: "normal-l4-route"}}:  normalL4Route,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "banana-ns", Name: "service"}:   {},
 				{Namespace: "tlsroute-ns", Name: "service"}: {},
 			},
@@ -297,7 +300,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			l4Routes: map[L4RouteKey]*L4Route{
 				{NamespacedName: types.NamespacedName{Name: "multiple-parent-ref-l4-route"}}: attachedL4RoutesWithManyParentRefs,
 			},
-			exp: map[types.NamespacedName]struct{}{
+			exp: map[types.NamespacedName]*ReferencedService{
 				{Namespace: "banana-ns", Name: "service"}:   {},
 				{Namespace: "tlsroute-ns", Name: "service"}: {},
 			},
@@ -312,13 +315,36 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 			exp: nil,
 		},
+		{
+			name: "activator service",
+			l7Routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "normal-route"}}: normalRoute,
+			},
+			npCfg: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						ScaleFromZero: &ngfAPI.ScaleFromZero{
+							Activator: ngfAPI.ActivatorReference{
+								Name:      "activator",
+								Namespace: "keda",
+								Port:      8080,
+							},
+						},
+					},
+				},
+			},
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "banana-ns", Name: "service"}: {},
+				{Namespace: "keda", Name: "activator"}:    {},
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			g.Expect(buildReferencedServices(test.l7Routes, test.l4Routes)).To(Equal(test.exp))
+			g.Expect(buildReferencedServices(test.l7Routes, test.l4Routes, test.npCfg)).To(Equal(test.exp))
 		})
 	}
 }
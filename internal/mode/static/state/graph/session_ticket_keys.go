@@ -0,0 +1,66 @@
+package graph
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The keys of the data of the session ticket keys Secret. The current key encrypts the new tickets. The next key
+// becomes the current key at the next rotation. It decrypts the tickets already, so that a replica that is
+// configured with the rotated keys later than another replica accepts the tickets of the other replica. The previous
+// key decrypts the tickets that were encrypted before the last rotation.
+const (
+	// SessionTicketKeyCurrent is the key of the Secret data with the current session ticket key.
+	SessionTicketKeyCurrent = "current.key"
+	// SessionTicketKeyNext is the key of the Secret data with the next session ticket key.
+	SessionTicketKeyNext = "next.key"
+	// SessionTicketKeyPrevious is the key of the Secret data with the previous session ticket key.
+	SessionTicketKeyPrevious = "previous.key"
+)
+
+// sessionTicketKeySizes are the sizes of the session ticket keys that NGINX supports: 48 bytes for the AES128
+// encryption of the tickets, and 80 bytes for the AES256 encryption.
+var sessionTicketKeySizes = map[int]struct{}{48: {}, 80: {}}
+
+// SessionTicketKeys are the TLS session ticket keys that the NGINX of all replicas share, so that the clients can
+// resume their TLS sessions on any replica.
+type SessionTicketKeys struct {
+	// Secret is the NamespacedName of the Secret that holds the keys.
+	Secret types.NamespacedName
+	// Keys are the current, the next, and the previous keys, in that order. The first key encrypts the tickets.
+	// It is empty if the Secret does not exist, or does not hold a valid current key.
+	Keys [][]byte
+}
+
+// buildSessionTicketKeys builds the SessionTicketKeys from the Secret. It returns nil if the keys are not shared
+// between the replicas. The SessionTicketKeys are returned even if the Secret does not exist, so that the Graph
+// references the Secret when it is created.
+func buildSessionTicketKeys(
+	secrets map[types.NamespacedName]*v1.Secret,
+	secretNsName *types.NamespacedName,
+) *SessionTicketKeys {
+	if secretNsName == nil {
+		return nil
+	}
+
+	keys := &SessionTicketKeys{Secret: *secretNsName}
+
+	secret, exists := secrets[*secretNsName]
+	if !exists || !isValidSessionTicketKey(secret.Data[SessionTicketKeyCurrent]) {
+		return keys
+	}
+
+	for _, name := range []string{SessionTicketKeyCurrent, SessionTicketKeyNext, SessionTicketKeyPrevious} {
+		// NGINX fails to load a key of an unsupported size, so such keys are skipped.
+		if key := secret.Data[name]; isValidSessionTicketKey(key) {
+			keys.Keys = append(keys.Keys, key)
+		}
+	}
+
+	return keys
+}
+
+func isValidSessionTicketKey(key []byte) bool {
+	_, valid := sessionTicketKeySizes[len(key)]
+	return valid
+}
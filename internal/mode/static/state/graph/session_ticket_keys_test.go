@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBuildSessionTicketKeys(t *testing.T) {
+	t.Parallel()
+
+	secretNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "session-ticket-keys"}
+
+	current := bytes.Repeat([]byte{1}, 80)
+	next := bytes.Repeat([]byte{2}, 80)
+	previous := bytes.Repeat([]byte{3}, 48)
+
+	createSecrets := func(data map[string][]byte) map[types.NamespacedName]*v1.Secret {
+		return map[types.NamespacedName]*v1.Secret{
+			secretNsName: {
+				ObjectMeta: metav1.ObjectMeta{Namespace: secretNsName.Namespace, Name: secretNsName.Name},
+				Data:       data,
+			},
+		}
+	}
+
+	tests := []struct {
+		secrets      map[types.NamespacedName]*v1.Secret
+		secretNsName *types.NamespacedName
+		expected     *SessionTicketKeys
+		name         string
+	}{
+		{
+			name: "all keys",
+			secrets: createSecrets(map[string][]byte{
+				SessionTicketKeyCurrent:  current,
+				SessionTicketKeyNext:     next,
+				SessionTicketKeyPrevious: previous,
+			}),
+			secretNsName: &secretNsName,
+			expected: &SessionTicketKeys{
+				Secret: secretNsName,
+				Keys:   [][]byte{current, next, previous},
+			},
+		},
+		{
+			name: "invalid next key",
+			secrets: createSecrets(map[string][]byte{
+				SessionTicketKeyCurrent: current,
+				SessionTicketKeyNext:    []byte("invalid"),
+			}),
+			secretNsName: &secretNsName,
+			expected: &SessionTicketKeys{
+				Secret: secretNsName,
+				Keys:   [][]byte{current},
+			},
+		},
+		{
+			name: "invalid current key",
+			secrets: createSecrets(map[string][]byte{
+				SessionTicketKeyCurrent: []byte("invalid"),
+				SessionTicketKeyNext:    next,
+			}),
+			secretNsName: &secretNsName,
+			expected:     &SessionTicketKeys{Secret: secretNsName},
+		},
+		{
+			name:         "Secret does not exist",
+			secretNsName: &secretNsName,
+			expected:     &SessionTicketKeys{Secret: secretNsName},
+		},
+		{
+			name:     "keys are not shared",
+			secrets:  createSecrets(map[string][]byte{SessionTicketKeyCurrent: current}),
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildSessionTicketKeys(test.secrets, test.secretNsName)).To(Equal(test.expected))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// StaticContent is the content of the files of a StaticContentPolicy.
+type StaticContent struct {
+	// Files holds the content of the files by the key of the ConfigMap. It is nil if any of the files is invalid,
+	// or if the StaticContentPolicy is invalid.
+	Files map[string][]byte
+	// ConfigMap is the NamespacedName of the ConfigMap that holds the files.
+	ConfigMap types.NamespacedName
+}
+
+// buildStaticContents resolves the files of the StaticContentPolicies. A StaticContentPolicy with a file that can't
+// be resolved becomes invalid. The contents are returned by the NamespacedName of their StaticContentPolicy.
+// The contents that can't be resolved are returned too, so that the Graph references their ConfigMaps, including
+// the ConfigMaps that don't exist yet.
+func buildStaticContents(
+	pols map[PolicyKey]*Policy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) map[types.NamespacedName]*StaticContent {
+	contents := make(map[types.NamespacedName]*StaticContent)
+
+	for key, policy := range pols {
+		sp, ok := policy.Source.(*ngfAPI.StaticContentPolicy)
+		if !ok || len(policy.TargetRefs) == 0 {
+			continue
+		}
+
+		content := &StaticContent{
+			ConfigMap: types.NamespacedName{Namespace: sp.Namespace, Name: sp.Spec.ConfigMapRef.Name},
+		}
+		contents[key.NsName] = content
+
+		files, err := resolveStaticFiles(configMaps[content.ConfigMap], sp.Spec.Files)
+		if err != nil {
+			msg := fmt.Sprintf("ConfigMap %s is invalid: %s", content.ConfigMap, err)
+			policy.Conditions = append(policy.Conditions, staticConds.NewPolicyNotAcceptedInvalidConfigMap(msg))
+			policy.Valid = false
+
+			continue
+		}
+
+		if policy.Valid {
+			content.Files = files
+		}
+	}
+
+	if len(contents) == 0 {
+		return nil
+	}
+
+	return contents
+}
+
+// resolveStaticFiles returns the content of the files by the key of the ConfigMap. The content of a file
+// is either in the data or the binaryData field of the ConfigMap.
+func resolveStaticFiles(cm *apiv1.ConfigMap, files []ngfAPI.StaticFile) (map[string][]byte, error) {
+	if cm == nil {
+		return nil, errors.New("ConfigMap does not exist")
+	}
+
+	resolved := make(map[string][]byte, len(files))
+
+	for _, file := range files {
+		if data, exists := cm.Data[file.Key]; exists {
+			resolved[file.Key] = []byte(data)
+			continue
+		}
+
+		data, exists := cm.BinaryData[file.Key]
+		if !exists {
+			return nil, fmt.Errorf("ConfigMap does not have the key %s", file.Key)
+		}
+
+		resolved[file.Key] = data
+	}
+
+	return resolved, nil
+}
+
+// isStaticContentConfigMap returns true if the ConfigMap holds the files of any StaticContentPolicy.
+func isStaticContentConfigMap(contents map[types.NamespacedName]*StaticContent, nsname types.NamespacedName) bool {
+	for _, content := range contents {
+		if content.ConfigMap == nsname {
+			return true
+		}
+	}
+
+	return false
+}
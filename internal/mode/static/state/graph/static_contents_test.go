@@ -0,0 +1,143 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestBuildStaticContents(t *testing.T) {
+	t.Parallel()
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: testNs, Name: "static"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "static"},
+			Data: map[string]string{
+				"security.txt": "Contact: mailto:security@example.com",
+			},
+			BinaryData: map[string][]byte{
+				"favicon.ico": {0x00, 0x01},
+			},
+		},
+	}
+
+	createPolicy := func(configMapName string, valid bool, keys ...string) *Policy {
+		files := make([]ngfAPI.StaticFile, 0, len(keys))
+		for _, key := range keys {
+			files = append(files, ngfAPI.StaticFile{Path: "/" + key, Key: key})
+		}
+
+		return &Policy{
+			Source: &ngfAPI.StaticContentPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "static-content"},
+				Spec: ngfAPI.StaticContentPolicySpec{
+					ConfigMapRef: ngfAPI.StaticContentConfigMapReference{Name: configMapName},
+					Files:        files,
+				},
+			},
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.Gateway, Nsname: types.NamespacedName{Name: "gw"}}},
+			Valid:      valid,
+		}
+	}
+
+	policyNsName := types.NamespacedName{Namespace: testNs, Name: "static-content"}
+	configMapNsName := types.NamespacedName{Namespace: testNs, Name: "static"}
+
+	tests := []struct {
+		policy      *Policy
+		expContents map[types.NamespacedName]*StaticContent
+		name        string
+		expConds    []conditions.Condition
+		expValid    bool
+	}{
+		{
+			name:   "valid files in data and binaryData",
+			policy: createPolicy("static", true, "security.txt", "favicon.ico"),
+			expContents: map[types.NamespacedName]*StaticContent{
+				policyNsName: {
+					ConfigMap: configMapNsName,
+					Files: map[string][]byte{
+						"security.txt": []byte("Contact: mailto:security@example.com"),
+						"favicon.ico":  {0x00, 0x01},
+					},
+				},
+			},
+			expValid: true,
+		},
+		{
+			name:   "valid files of an invalid policy",
+			policy: createPolicy("static", false, "security.txt"),
+			expContents: map[types.NamespacedName]*StaticContent{
+				policyNsName: {ConfigMap: configMapNsName},
+			},
+			expValid: false,
+		},
+		{
+			name:   "ConfigMap does not exist",
+			policy: createPolicy("missing", true, "security.txt"),
+			expContents: map[types.NamespacedName]*StaticContent{
+				policyNsName: {ConfigMap: types.NamespacedName{Namespace: testNs, Name: "missing"}},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidConfigMap(
+					"ConfigMap test/missing is invalid: ConfigMap does not exist",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "key does not exist",
+			policy: createPolicy("static", true, "security.txt", "missing.html"),
+			expContents: map[types.NamespacedName]*StaticContent{
+				policyNsName: {ConfigMap: configMapNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidConfigMap(
+					"ConfigMap test/static is invalid: ConfigMap does not have the key missing.html",
+				),
+			},
+			expValid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			pols := map[PolicyKey]*Policy{
+				{NsName: policyNsName}: test.policy,
+			}
+
+			g.Expect(buildStaticContents(pols, configMaps)).To(Equal(test.expContents))
+			g.Expect(test.policy.Conditions).To(Equal(test.expConds))
+			g.Expect(test.policy.Valid).To(Equal(test.expValid))
+		})
+	}
+}
+
+func TestBuildStaticContents_NoStaticContentPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pols := map[PolicyKey]*Policy{
+		{NsName: types.NamespacedName{Namespace: testNs, Name: "csp"}}: {
+			Source:     &ngfAPI.ClientSettingsPolicy{},
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.Gateway}},
+			Valid:      true,
+		},
+		{NsName: types.NamespacedName{Namespace: testNs, Name: "untargeted"}}: {
+			Source: &ngfAPI.StaticContentPolicy{},
+		},
+	}
+
+	g.Expect(buildStaticContents(pols, nil)).To(BeNil())
+}
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// WAFBundle is the NGINX App Protect policy bundle of a WAFPolicy.
+type WAFBundle struct {
+	// ConfigMap is the NamespacedName of the ConfigMap that holds the bundle.
+	ConfigMap types.NamespacedName
+	// Data is the compiled bundle. It is nil if the bundle is invalid, or if the WAFPolicy is invalid.
+	Data []byte
+}
+
+// buildWAFBundles resolves the policy bundles of the WAFPolicies. A WAFPolicy with an invalid bundle becomes invalid.
+// The bundles are returned by the NamespacedName of their WAFPolicy. The bundles that can't be resolved are
+// returned too, so that the Graph references their ConfigMaps, including the ConfigMaps that don't exist yet.
+func buildWAFBundles(
+	pols map[PolicyKey]*Policy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) map[types.NamespacedName]*WAFBundle {
+	bundles := make(map[types.NamespacedName]*WAFBundle)
+
+	for key, policy := range pols {
+		wp, ok := policy.Source.(*ngfAPI.WAFPolicy)
+		if !ok || len(policy.TargetRefs) == 0 {
+			continue
+		}
+
+		ref := wp.Spec.PolicyBundle.ConfigMapRef
+		bundle := &WAFBundle{
+			ConfigMap: types.NamespacedName{Namespace: wp.Namespace, Name: ref.Name},
+		}
+		bundles[key.NsName] = bundle
+
+		data, err := resolveWAFBundle(configMaps[bundle.ConfigMap], ref.Key)
+		if err != nil {
+			msg := fmt.Sprintf("Policy bundle ConfigMap %s is invalid: %s", bundle.ConfigMap, err)
+			policy.Conditions = append(policy.Conditions, staticConds.NewPolicyNotAcceptedInvalidBundle(msg))
+			policy.Valid = false
+
+			continue
+		}
+
+		if policy.Valid {
+			bundle.Data = data
+		}
+	}
+
+	if len(bundles) == 0 {
+		return nil
+	}
+
+	return bundles
+}
+
+// resolveWAFBundle returns the policy bundle in the key of the ConfigMap.
+func resolveWAFBundle(cm *apiv1.ConfigMap, key string) ([]byte, error) {
+	if cm == nil {
+		return nil, errors.New("ConfigMap does not exist")
+	}
+
+	data, exists := cm.BinaryData[key]
+	if !exists {
+		if _, exists := cm.Data[key]; exists {
+			return nil, fmt.Errorf("the policy bundle must be in the binaryData field %s instead of the data field", key)
+		}
+
+		return nil, fmt.Errorf("ConfigMap does not have the binaryData field %s", key)
+	}
+
+	if err := validateWAFBundle(data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// validateWAFBundle validates that the data is a compiled NGINX App Protect policy bundle, which is a gzip-compressed
+// tar archive. NGINX App Protect can't enforce a policy that was not compiled, such as a JSON policy.
+// Only the first header of the archive is read, so that large bundles are not decompressed on every Graph build.
+func validateWAFBundle(data []byte) error {
+	if json.Valid(data) {
+		return errors.New("the policy bundle is a JSON policy, which must be compiled with the NGINX App Protect compiler")
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("the policy bundle is not a compiled bundle: %w", err)
+	}
+
+	if _, err := tar.NewReader(gz).Next(); err != nil {
+		return fmt.Errorf("the policy bundle is not a compiled bundle: failed to read the archive: %w", err)
+	}
+
+	return nil
+}
+
+// isWAFBundleConfigMap returns true if the ConfigMap holds the policy bundle of any WAFPolicy.
+func isWAFBundleConfigMap(bundles map[types.NamespacedName]*WAFBundle, nsname types.NamespacedName) bool {
+	for _, bundle := range bundles {
+		if bundle.ConfigMap == nsname {
+			return true
+		}
+	}
+
+	return false
+}
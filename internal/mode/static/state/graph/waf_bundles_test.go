@@ -0,0 +1,202 @@
+package graph
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createWAFBundle(t *testing.T) []byte {
+	t.Helper()
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	content := []byte(`{"policy": {"name": "compiled"}}`)
+	g.Expect(tw.WriteHeader(&tar.Header{Name: "policy.json", Mode: 0o600, Size: int64(len(content))})).To(Succeed())
+	_, err := tw.Write(content)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(tw.Close()).To(Succeed())
+	g.Expect(gz.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+func TestBuildWAFBundles(t *testing.T) {
+	t.Parallel()
+
+	bundle := createWAFBundle(t)
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: testNs, Name: "bundles"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "bundles"},
+			BinaryData: map[string][]byte{
+				"compiled.tgz": bundle,
+				"policy.json":  []byte(`{"policy": {"name": "uncompiled"}}`),
+				"invalid.tgz":  []byte("invalid"),
+			},
+			Data: map[string]string{
+				"data.tgz": "bundle",
+			},
+		},
+	}
+
+	createPolicy := func(configMapName, key string, valid bool) *Policy {
+		return &Policy{
+			Source: &ngfAPI.WAFPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNs, Name: "waf"},
+				Spec: ngfAPI.WAFPolicySpec{
+					PolicyBundle: ngfAPI.WAFPolicyBundle{
+						ConfigMapRef: ngfAPI.WAFConfigMapKeyReference{Name: configMapName, Key: key},
+					},
+				},
+			},
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.Gateway, Nsname: types.NamespacedName{Name: "gw"}}},
+			Valid:      valid,
+		}
+	}
+
+	policyNsName := types.NamespacedName{Namespace: testNs, Name: "waf"}
+	bundlesNsName := types.NamespacedName{Namespace: testNs, Name: "bundles"}
+
+	tests := []struct {
+		policy     *Policy
+		expBundles map[types.NamespacedName]*WAFBundle
+		name       string
+		expConds   []conditions.Condition
+		expValid   bool
+	}{
+		{
+			name:   "valid bundle",
+			policy: createPolicy("bundles", "compiled.tgz", true),
+			expBundles: map[types.NamespacedName]*WAFBundle{
+				policyNsName: {ConfigMap: bundlesNsName, Data: bundle},
+			},
+			expValid: true,
+		},
+		{
+			name:   "valid bundle of an invalid policy",
+			policy: createPolicy("bundles", "compiled.tgz", false),
+			expBundles: map[types.NamespacedName]*WAFBundle{
+				policyNsName: {ConfigMap: bundlesNsName},
+			},
+			expValid: false,
+		},
+		{
+			name:   "ConfigMap does not exist",
+			policy: createPolicy("missing", "compiled.tgz", true),
+			expBundles: map[types.NamespacedName]*WAFBundle{
+				policyNsName: {ConfigMap: types.NamespacedName{Namespace: testNs, Name: "missing"}},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidBundle(
+					"Policy bundle ConfigMap test/missing is invalid: ConfigMap does not exist",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "key does not exist",
+			policy: createPolicy("bundles", "missing.tgz", true),
+			expBundles: map[types.NamespacedName]*WAFBundle{
+				policyNsName: {ConfigMap: bundlesNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidBundle(
+					"Policy bundle ConfigMap test/bundles is invalid: " +
+						"ConfigMap does not have the binaryData field missing.tgz",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "bundle in data field",
+			policy: createPolicy("bundles", "data.tgz", true),
+			expBundles: map[types.NamespacedName]*WAFBundle{
+				policyNsName: {ConfigMap: bundlesNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidBundle(
+					"Policy bundle ConfigMap test/bundles is invalid: " +
+						"the policy bundle must be in the binaryData field data.tgz instead of the data field",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "uncompiled JSON policy",
+			policy: createPolicy("bundles", "policy.json", true),
+			expBundles: map[types.NamespacedName]*WAFBundle{
+				policyNsName: {ConfigMap: bundlesNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidBundle(
+					"Policy bundle ConfigMap test/bundles is invalid: " +
+						"the policy bundle is a JSON policy, which must be compiled with the NGINX App Protect compiler",
+				),
+			},
+			expValid: false,
+		},
+		{
+			name:   "invalid bundle",
+			policy: createPolicy("bundles", "invalid.tgz", true),
+			expBundles: map[types.NamespacedName]*WAFBundle{
+				policyNsName: {ConfigMap: bundlesNsName},
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedInvalidBundle(
+					"Policy bundle ConfigMap test/bundles is invalid: " +
+						"the policy bundle is not a compiled bundle: unexpected EOF",
+				),
+			},
+			expValid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			pols := map[PolicyKey]*Policy{
+				{NsName: policyNsName}: test.policy,
+			}
+
+			g.Expect(buildWAFBundles(pols, configMaps)).To(Equal(test.expBundles))
+			g.Expect(test.policy.Conditions).To(Equal(test.expConds))
+			g.Expect(test.policy.Valid).To(Equal(test.expValid))
+		})
+	}
+}
+
+func TestBuildWAFBundles_NoWAFPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pols := map[PolicyKey]*Policy{
+		{NsName: types.NamespacedName{Namespace: testNs, Name: "csp"}}: {
+			Source:     &ngfAPI.ClientSettingsPolicy{},
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.Gateway}},
+			Valid:      true,
+		},
+		{NsName: types.NamespacedName{Namespace: testNs, Name: "untargeted"}}: {
+			Source: &ngfAPI.WAFPolicy{},
+		},
+	}
+
+	g.Expect(buildWAFBundles(pols, nil)).To(BeNil())
+}
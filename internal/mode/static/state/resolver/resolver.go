@@ -1,6 +1,7 @@
 package resolver
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"slices"
@@ -147,6 +148,15 @@ func resolveEndpoints(
 		endpoints = append(endpoints, ep)
 	}
 
+	// The endpoints are sorted, so that the order of the servers of the upstreams is stable.
+	slices.SortFunc(endpoints, func(a, b Endpoint) int {
+		if c := cmp.Compare(a.Address, b.Address); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Port, b.Port)
+	})
+
 	return endpoints, nil
 }
 
@@ -0,0 +1,52 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/index"
+)
+
+// StaticServiceResolver implements ServiceResolver using a fixed list of EndpointSlices
+// instead of the Kubernetes API. It is used to generate configuration without a cluster.
+type StaticServiceResolver struct {
+	endpointSlices []discoveryV1.EndpointSlice
+}
+
+// NewStaticServiceResolver creates a new instance of a StaticServiceResolver.
+func NewStaticServiceResolver(endpointSlices []discoveryV1.EndpointSlice) *StaticServiceResolver {
+	return &StaticServiceResolver{endpointSlices: endpointSlices}
+}
+
+// Resolve resolves a Service's NamespacedName and ServicePort to a list of Endpoints.
+// Returns an error if the Service or ServicePort cannot be resolved.
+func (r *StaticServiceResolver) Resolve(
+	_ context.Context,
+	svcNsName types.NamespacedName,
+	svcPort v1.ServicePort,
+	allowedAddressType []discoveryV1.AddressType,
+) ([]Endpoint, error) {
+	var endpointSliceList discoveryV1.EndpointSliceList
+
+	for _, slice := range r.endpointSlices {
+		if slice.Namespace == svcNsName.Namespace && index.GetServiceNameFromEndpointSlice(&slice) == svcNsName.Name {
+			endpointSliceList.Items = append(endpointSliceList.Items, slice)
+		}
+	}
+
+	if len(endpointSliceList.Items) == 0 {
+		return nil, fmt.Errorf("no endpoints found for Service %s", svcNsName)
+	}
+
+	return resolveEndpoints(
+		svcNsName,
+		svcPort,
+		endpointSliceList,
+		initEndpointSetWithCalculatedSize,
+		allowedAddressType,
+	)
+}
@@ -0,0 +1,84 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/controller/index"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestStaticServiceResolver(t *testing.T) {
+	t.Parallel()
+
+	createSlice := func(namespace, svcName, address string) discoveryV1.EndpointSlice {
+		return discoveryV1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      svcName + "-slice",
+				Labels:    map[string]string{index.KubernetesServiceNameLabel: svcName},
+			},
+			AddressType: discoveryV1.AddressTypeIPv4,
+			Endpoints: []discoveryV1.Endpoint{
+				{
+					Addresses:  []string{address},
+					Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+				},
+			},
+			Ports: []discoveryV1.EndpointPort{
+				{
+					Name: helpers.GetPointer("http"),
+					Port: helpers.GetPointer[int32](8080),
+				},
+			},
+		}
+	}
+
+	r := NewStaticServiceResolver([]discoveryV1.EndpointSlice{
+		createSlice("test", "coffee", "10.0.0.1"),
+		createSlice("other", "coffee", "10.0.0.2"),
+		createSlice("test", "tea", "10.0.0.3"),
+	})
+
+	svcPort := v1.ServicePort{Name: "http", Port: 80}
+
+	tests := []struct {
+		name         string
+		svcNsName    types.NamespacedName
+		expEndpoints []Endpoint
+		expErr       bool
+	}{
+		{
+			name:         "service with endpoints",
+			svcNsName:    types.NamespacedName{Namespace: "test", Name: "coffee"},
+			expEndpoints: []Endpoint{{Address: "10.0.0.1", Port: 8080}},
+		},
+		{
+			name:      "service without endpoints",
+			svcNsName: types.NamespacedName{Namespace: "test", Name: "milk"},
+			expErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			endpoints, err := r.Resolve(context.Background(), test.svcNsName, svcPort, dualAddressType)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(endpoints).To(Equal(test.expEndpoints))
+		})
+	}
+}
@@ -18,6 +18,11 @@ type FakeChangeProcessor struct {
 		arg1 types.ObjectType
 		arg2 typesa.NamespacedName
 	}
+	CaptureExternalCertificatesStub        func(map[string]*graph.ExternalCertificate)
+	captureExternalCertificatesMutex       sync.RWMutex
+	captureExternalCertificatesArgsForCall []struct {
+		arg1 map[string]*graph.ExternalCertificate
+	}
 	CaptureUpsertChangeStub        func(client.Object)
 	captureUpsertChangeMutex       sync.RWMutex
 	captureUpsertChangeArgsForCall []struct {
@@ -82,6 +87,38 @@ func (fake *FakeChangeProcessor) CaptureDeleteChangeArgsForCall(i int) (types.Ob
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeChangeProcessor) CaptureExternalCertificates(arg1 map[string]*graph.ExternalCertificate) {
+	fake.captureExternalCertificatesMutex.Lock()
+	fake.captureExternalCertificatesArgsForCall = append(fake.captureExternalCertificatesArgsForCall, struct {
+		arg1 map[string]*graph.ExternalCertificate
+	}{arg1})
+	stub := fake.CaptureExternalCertificatesStub
+	fake.recordInvocation("CaptureExternalCertificates", []interface{}{arg1})
+	fake.captureExternalCertificatesMutex.Unlock()
+	if stub != nil {
+		fake.CaptureExternalCertificatesStub(arg1)
+	}
+}
+
+func (fake *FakeChangeProcessor) CaptureExternalCertificatesCallCount() int {
+	fake.captureExternalCertificatesMutex.RLock()
+	defer fake.captureExternalCertificatesMutex.RUnlock()
+	return len(fake.captureExternalCertificatesArgsForCall)
+}
+
+func (fake *FakeChangeProcessor) CaptureExternalCertificatesCalls(stub func(map[string]*graph.ExternalCertificate)) {
+	fake.captureExternalCertificatesMutex.Lock()
+	defer fake.captureExternalCertificatesMutex.Unlock()
+	fake.CaptureExternalCertificatesStub = stub
+}
+
+func (fake *FakeChangeProcessor) CaptureExternalCertificatesArgsForCall(i int) map[string]*graph.ExternalCertificate {
+	fake.captureExternalCertificatesMutex.RLock()
+	defer fake.captureExternalCertificatesMutex.RUnlock()
+	argsForCall := fake.captureExternalCertificatesArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeChangeProcessor) CaptureUpsertChange(arg1 client.Object) {
 	fake.captureUpsertChangeMutex.Lock()
 	fake.captureUpsertChangeArgsForCall = append(fake.captureUpsertChangeArgsForCall, struct {
@@ -228,6 +265,8 @@ func (fake *FakeChangeProcessor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.captureDeleteChangeMutex.RLock()
 	defer fake.captureDeleteChangeMutex.RUnlock()
+	fake.captureExternalCertificatesMutex.RLock()
+	defer fake.captureExternalCertificatesMutex.RUnlock()
 	fake.captureUpsertChangeMutex.RLock()
 	defer fake.captureUpsertChangeMutex.RUnlock()
 	fake.getLatestGraphMutex.RLock()
@@ -52,6 +52,28 @@ type FakeGenericValidator struct {
 	validateNginxSizeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateReturnBodyStub        func(string) error
+	validateReturnBodyMutex       sync.RWMutex
+	validateReturnBodyArgsForCall []struct {
+		arg1 string
+	}
+	validateReturnBodyReturns struct {
+		result1 error
+	}
+	validateReturnBodyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateServerHeaderValueStub        func(string) error
+	validateServerHeaderValueMutex       sync.RWMutex
+	validateServerHeaderValueArgsForCall []struct {
+		arg1 string
+	}
+	validateServerHeaderValueReturns struct {
+		result1 error
+	}
+	validateServerHeaderValueReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateServiceNameStub        func(string) error
 	validateServiceNameMutex       sync.RWMutex
 	validateServiceNameArgsForCall []struct {
@@ -311,6 +333,128 @@ func (fake *FakeGenericValidator) ValidateNginxSizeReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateReturnBody(arg1 string) error {
+	fake.validateReturnBodyMutex.Lock()
+	ret, specificReturn := fake.validateReturnBodyReturnsOnCall[len(fake.validateReturnBodyArgsForCall)]
+	fake.validateReturnBodyArgsForCall = append(fake.validateReturnBodyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateReturnBodyStub
+	fakeReturns := fake.validateReturnBodyReturns
+	fake.recordInvocation("ValidateReturnBody", []interface{}{arg1})
+	fake.validateReturnBodyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateReturnBodyCallCount() int {
+	fake.validateReturnBodyMutex.RLock()
+	defer fake.validateReturnBodyMutex.RUnlock()
+	return len(fake.validateReturnBodyArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateReturnBodyCalls(stub func(string) error) {
+	fake.validateReturnBodyMutex.Lock()
+	defer fake.validateReturnBodyMutex.Unlock()
+	fake.ValidateReturnBodyStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateReturnBodyArgsForCall(i int) string {
+	fake.validateReturnBodyMutex.RLock()
+	defer fake.validateReturnBodyMutex.RUnlock()
+	argsForCall := fake.validateReturnBodyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateReturnBodyReturns(result1 error) {
+	fake.validateReturnBodyMutex.Lock()
+	defer fake.validateReturnBodyMutex.Unlock()
+	fake.ValidateReturnBodyStub = nil
+	fake.validateReturnBodyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateReturnBodyReturnsOnCall(i int, result1 error) {
+	fake.validateReturnBodyMutex.Lock()
+	defer fake.validateReturnBodyMutex.Unlock()
+	fake.ValidateReturnBodyStub = nil
+	if fake.validateReturnBodyReturnsOnCall == nil {
+		fake.validateReturnBodyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateReturnBodyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateServerHeaderValue(arg1 string) error {
+	fake.validateServerHeaderValueMutex.Lock()
+	ret, specificReturn := fake.validateServerHeaderValueReturnsOnCall[len(fake.validateServerHeaderValueArgsForCall)]
+	fake.validateServerHeaderValueArgsForCall = append(fake.validateServerHeaderValueArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateServerHeaderValueStub
+	fakeReturns := fake.validateServerHeaderValueReturns
+	fake.recordInvocation("ValidateServerHeaderValue", []interface{}{arg1})
+	fake.validateServerHeaderValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateServerHeaderValueCallCount() int {
+	fake.validateServerHeaderValueMutex.RLock()
+	defer fake.validateServerHeaderValueMutex.RUnlock()
+	return len(fake.validateServerHeaderValueArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateServerHeaderValueCalls(stub func(string) error) {
+	fake.validateServerHeaderValueMutex.Lock()
+	defer fake.validateServerHeaderValueMutex.Unlock()
+	fake.ValidateServerHeaderValueStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateServerHeaderValueArgsForCall(i int) string {
+	fake.validateServerHeaderValueMutex.RLock()
+	defer fake.validateServerHeaderValueMutex.RUnlock()
+	argsForCall := fake.validateServerHeaderValueArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateServerHeaderValueReturns(result1 error) {
+	fake.validateServerHeaderValueMutex.Lock()
+	defer fake.validateServerHeaderValueMutex.Unlock()
+	fake.ValidateServerHeaderValueStub = nil
+	fake.validateServerHeaderValueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateServerHeaderValueReturnsOnCall(i int, result1 error) {
+	fake.validateServerHeaderValueMutex.Lock()
+	defer fake.validateServerHeaderValueMutex.Unlock()
+	fake.ValidateServerHeaderValueStub = nil
+	if fake.validateServerHeaderValueReturnsOnCall == nil {
+		fake.validateServerHeaderValueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateServerHeaderValueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateServiceName(arg1 string) error {
 	fake.validateServiceNameMutex.Lock()
 	ret, specificReturn := fake.validateServiceNameReturnsOnCall[len(fake.validateServiceNameArgsForCall)]
@@ -383,6 +527,10 @@ func (fake *FakeGenericValidator) Invocations() map[string][][]interface{} {
 	defer fake.validateNginxDurationMutex.RUnlock()
 	fake.validateNginxSizeMutex.RLock()
 	defer fake.validateNginxSizeMutex.RUnlock()
+	fake.validateReturnBodyMutex.RLock()
+	defer fake.validateReturnBodyMutex.RUnlock()
+	fake.validateServerHeaderValueMutex.RLock()
+	defer fake.validateServerHeaderValueMutex.RUnlock()
 	fake.validateServiceNameMutex.RLock()
 	defer fake.validateServiceNameMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
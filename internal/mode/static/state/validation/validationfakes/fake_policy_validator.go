@@ -22,6 +22,17 @@ type FakePolicyValidator struct {
 	conflictsReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	EffectivePoliciesStub        func(...[]policies.Policy) []policies.Policy
+	effectivePoliciesMutex       sync.RWMutex
+	effectivePoliciesArgsForCall []struct {
+		arg1 [][]policies.Policy
+	}
+	effectivePoliciesReturns struct {
+		result1 []policies.Policy
+	}
+	effectivePoliciesReturnsOnCall map[int]struct {
+		result1 []policies.Policy
+	}
 	ValidateStub        func(policies.Policy, *policies.GlobalSettings) []conditions.Condition
 	validateMutex       sync.RWMutex
 	validateArgsForCall []struct {
@@ -100,6 +111,67 @@ func (fake *FakePolicyValidator) ConflictsReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakePolicyValidator) EffectivePolicies(arg1 ...[]policies.Policy) []policies.Policy {
+	fake.effectivePoliciesMutex.Lock()
+	ret, specificReturn := fake.effectivePoliciesReturnsOnCall[len(fake.effectivePoliciesArgsForCall)]
+	fake.effectivePoliciesArgsForCall = append(fake.effectivePoliciesArgsForCall, struct {
+		arg1 [][]policies.Policy
+	}{arg1})
+	stub := fake.EffectivePoliciesStub
+	fakeReturns := fake.effectivePoliciesReturns
+	fake.recordInvocation("EffectivePolicies", []interface{}{arg1})
+	fake.effectivePoliciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) EffectivePoliciesCallCount() int {
+	fake.effectivePoliciesMutex.RLock()
+	defer fake.effectivePoliciesMutex.RUnlock()
+	return len(fake.effectivePoliciesArgsForCall)
+}
+
+func (fake *FakePolicyValidator) EffectivePoliciesCalls(stub func(...[]policies.Policy) []policies.Policy) {
+	fake.effectivePoliciesMutex.Lock()
+	defer fake.effectivePoliciesMutex.Unlock()
+	fake.EffectivePoliciesStub = stub
+}
+
+func (fake *FakePolicyValidator) EffectivePoliciesArgsForCall(i int) [][]policies.Policy {
+	fake.effectivePoliciesMutex.RLock()
+	defer fake.effectivePoliciesMutex.RUnlock()
+	argsForCall := fake.effectivePoliciesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) EffectivePoliciesReturns(result1 []policies.Policy) {
+	fake.effectivePoliciesMutex.Lock()
+	defer fake.effectivePoliciesMutex.Unlock()
+	fake.EffectivePoliciesStub = nil
+	fake.effectivePoliciesReturns = struct {
+		result1 []policies.Policy
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) EffectivePoliciesReturnsOnCall(i int, result1 []policies.Policy) {
+	fake.effectivePoliciesMutex.Lock()
+	defer fake.effectivePoliciesMutex.Unlock()
+	fake.EffectivePoliciesStub = nil
+	if fake.effectivePoliciesReturnsOnCall == nil {
+		fake.effectivePoliciesReturnsOnCall = make(map[int]struct {
+			result1 []policies.Policy
+		})
+	}
+	fake.effectivePoliciesReturnsOnCall[i] = struct {
+		result1 []policies.Policy
+	}{result1}
+}
+
 func (fake *FakePolicyValidator) Validate(arg1 policies.Policy, arg2 *policies.GlobalSettings) []conditions.Condition {
 	fake.validateMutex.Lock()
 	ret, specificReturn := fake.validateReturnsOnCall[len(fake.validateArgsForCall)]
@@ -167,6 +239,8 @@ func (fake *FakePolicyValidator) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.conflictsMutex.RLock()
 	defer fake.conflictsMutex.RUnlock()
+	fake.effectivePoliciesMutex.RLock()
+	defer fake.effectivePoliciesMutex.RUnlock()
 	fake.validateMutex.RLock()
 	defer fake.validateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -23,6 +23,7 @@ type Validators struct {
 //counterfeiter:generate . HTTPFieldsValidator
 type HTTPFieldsValidator interface {
 	ValidatePathInMatch(path string) error
+	ValidatePathRegexInMatch(regex string) error
 	ValidateHeaderNameInMatch(name string) error
 	ValidateHeaderValueInMatch(value string) error
 	ValidateQueryParamNameInMatch(name string) error
@@ -47,6 +48,8 @@ type GenericValidator interface {
 	ValidateNginxDuration(duration string) error
 	ValidateNginxSize(size string) error
 	ValidateEndpoint(endpoint string) error
+	ValidateReturnBody(body string) error
+	ValidateServerHeaderValue(value string) error
 }
 
 // PolicyValidator validates an NGF Policy.
@@ -57,4 +60,7 @@ type PolicyValidator interface {
 	Validate(policy policies.Policy, globalSettings *policies.GlobalSettings) []conditions.Condition
 	// Conflicts returns true if the two Policies conflict.
 	Conflicts(a, b policies.Policy) bool
+	// EffectivePolicies returns the effective Policies of a target from the valid Policies attached to the levels
+	// of the hierarchy of the target, ordered from the least specific level to the most specific level.
+	EffectivePolicies(levels ...[]policies.Policy) []policies.Policy
 }
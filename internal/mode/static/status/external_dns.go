@@ -0,0 +1,32 @@
+package status
+
+import (
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// PrepareExternalDNSHostnames prepares the hostnames of the Gateway that external-dns publishes: the hostnames
+// that the attached Routes claim, in alphabetical order. The Routes that claim all hostnames are skipped,
+// because there is no DNS record for all hostnames. It returns nil if there is no Gateway.
+func PrepareExternalDNSHostnames(
+	gw *graph.Gateway,
+	l4routes map[graph.L4RouteKey]*graph.L4Route,
+	routes map[graph.RouteKey]*graph.L7Route,
+) []string {
+	report := PrepareHostnameReport(gw, l4routes, routes)
+	if report == nil {
+		return nil
+	}
+
+	hostnames := make([]string, 0, len(report.Hostnames))
+
+	// The hostnames of the report are in alphabetical order.
+	for _, usage := range report.Hostnames {
+		if usage.Hostname == allHostnames {
+			continue
+		}
+
+		hostnames = append(hostnames, usage.Hostname)
+	}
+
+	return hostnames
+}
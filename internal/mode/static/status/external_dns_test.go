@@ -0,0 +1,76 @@
+package status
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestPrepareExternalDNSHostnames(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	gw := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+		},
+	}
+
+	createRoute := func(name string, attached bool, hostnames ...string) *graph.L7Route {
+		return &graph.L7Route{
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			},
+			RouteType: graph.RouteTypeHTTP,
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway: gwNsName,
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{"http": hostnames},
+						Attached:          attached,
+					},
+				},
+			},
+		}
+	}
+
+	routes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tea"}}: createRoute(
+			"tea",
+			true,
+			"tea.example.com",
+			"cafe.example.com",
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "coffee"}}: createRoute(
+			"coffee",
+			true,
+			"cafe.example.com",
+			"*.coffee.example.com",
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "all"}}: createRoute(
+			"all",
+			true,
+			wildcardHostname,
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "test", Name: "detached"}}: createRoute(
+			"detached",
+			false,
+			"detached.example.com",
+		),
+	}
+
+	g.Expect(PrepareExternalDNSHostnames(nil, nil, routes)).To(BeNil())
+	g.Expect(PrepareExternalDNSHostnames(gw, nil, nil)).To(BeEmpty())
+	g.Expect(PrepareExternalDNSHostnames(gw, nil, routes)).To(Equal([]string{
+		"*.coffee.example.com",
+		"cafe.example.com",
+		"tea.example.com",
+	}))
+}
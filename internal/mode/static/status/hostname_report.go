@@ -0,0 +1,249 @@
+package status
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const (
+	// wildcardHostname is the hostname that the graph uses for the Routes that claim all hostnames.
+	wildcardHostname = "~^"
+	// allHostnames is the hostname that the HostnameReport uses for the Routes that claim all hostnames.
+	allHostnames = "*"
+)
+
+// PrepareHostnameReport prepares the HostnameReport of the Gateway. It returns nil if there is no Gateway.
+func PrepareHostnameReport(
+	gw *graph.Gateway,
+	l4routes map[graph.L4RouteKey]*graph.L4Route,
+	routes map[graph.RouteKey]*graph.L7Route,
+) *ngfAPI.HostnameReport {
+	if gw == nil {
+		return nil
+	}
+
+	gwNsName := client.ObjectKeyFromObject(gw.Source)
+	claims := make(map[string]map[types.NamespacedName]*ngfAPI.HostnameRoute)
+	var conflicts []ngfAPI.HostnameConflict
+
+	claim := func(hostname string, route ngfAPI.HostnameRoute, listener string) {
+		if hostname == wildcardHostname {
+			hostname = allHostnames
+		}
+
+		if claims[hostname] == nil {
+			claims[hostname] = make(map[types.NamespacedName]*ngfAPI.HostnameRoute)
+		}
+
+		// Routes of different kinds can have the same name, so the kind is part of the key.
+		key := types.NamespacedName{Namespace: route.Namespace, Name: route.Kind + "/" + route.Name}
+
+		claimingRoute, exists := claims[hostname][key]
+		if !exists {
+			claimingRoute = &route
+			claims[hostname][key] = claimingRoute
+		}
+
+		if !slices.Contains(claimingRoute.Listeners, listener) {
+			claimingRoute.Listeners = append(claimingRoute.Listeners, listener)
+		}
+	}
+
+	for _, route := range routes {
+		hostnameRoute := ngfAPI.HostnameRoute{
+			Kind:      kinds.HTTPRoute,
+			Namespace: route.Source.GetNamespace(),
+			Name:      route.Source.GetName(),
+		}
+		if route.RouteType == graph.RouteTypeGRPC {
+			hostnameRoute.Kind = kinds.GRPCRoute
+		}
+
+		for _, ref := range route.ParentRefs {
+			// The Routes that a parent HTTPRoute delegates path prefixes to serve the hostnames of the parent.
+			if ref.Gateway != gwNsName || ref.ParentRoute != nil || ref.Attachment == nil || !ref.Attachment.Attached {
+				continue
+			}
+
+			for listener, hostnames := range ref.Attachment.AcceptedHostnames {
+				for _, h := range hostnames {
+					claim(h, hostnameRoute, listener)
+				}
+			}
+		}
+	}
+
+	for _, route := range l4routes {
+		hostnameRoute := ngfAPI.HostnameRoute{
+			Kind:      kinds.TLSRoute,
+			Namespace: route.Source.GetNamespace(),
+			Name:      route.Source.GetName(),
+		}
+
+		for _, ref := range route.ParentRefs {
+			if ref.Gateway != gwNsName || ref.Attachment == nil {
+				continue
+			}
+
+			if !ref.Attachment.Attached {
+				if ref.Attachment.FailedCondition.Reason == string(staticConds.RouteReasonHostnameConflict) {
+					conflicts = append(conflicts, routeConflicts(hostnameRoute, route.Spec.Hostnames)...)
+				}
+				continue
+			}
+
+			for listener, hostnames := range ref.Attachment.AcceptedHostnames {
+				for _, h := range hostnames {
+					claim(h, hostnameRoute, listener)
+				}
+			}
+		}
+	}
+
+	conflicts = append(conflicts, listenerConflicts(gw.Listeners)...)
+
+	usages := make([]ngfAPI.HostnameUsage, 0, len(claims))
+
+	for hostname, claimingRoutes := range claims {
+		usage := ngfAPI.HostnameUsage{
+			Hostname:   hostname,
+			Namespaces: []string{},
+			Routes:     make([]ngfAPI.HostnameRoute, 0, len(claimingRoutes)),
+		}
+
+		for _, route := range claimingRoutes {
+			slices.Sort(route.Listeners)
+			usage.Routes = append(usage.Routes, *route)
+
+			if !slices.Contains(usage.Namespaces, route.Namespace) {
+				usage.Namespaces = append(usage.Namespaces, route.Namespace)
+			}
+		}
+
+		slices.Sort(usage.Namespaces)
+		slices.SortFunc(usage.Routes, func(a, b ngfAPI.HostnameRoute) int {
+			return cmp.Or(
+				cmp.Compare(a.Namespace, b.Namespace),
+				cmp.Compare(a.Name, b.Name),
+				cmp.Compare(a.Kind, b.Kind),
+			)
+		})
+
+		if len(usage.Namespaces) > 1 {
+			conflicts = append(conflicts, ngfAPI.HostnameConflict{
+				Hostname: hostname,
+				Reason:   ngfAPI.HostnameConflictReasonMultipleNamespaces,
+				Message: fmt.Sprintf(
+					"Routes of the namespaces %s claim the hostname",
+					strings.Join(usage.Namespaces, ", "),
+				),
+			})
+		}
+
+		usages = append(usages, usage)
+	}
+
+	slices.SortFunc(usages, func(a, b ngfAPI.HostnameUsage) int {
+		return cmp.Compare(a.Hostname, b.Hostname)
+	})
+
+	slices.SortFunc(conflicts, func(a, b ngfAPI.HostnameConflict) int {
+		return cmp.Or(
+			cmp.Compare(a.Hostname, b.Hostname),
+			cmp.Compare(a.Reason, b.Reason),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+
+	report := &ngfAPI.HostnameReport{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: ngfAPI.SchemeGroupVersion.String(),
+			Kind:       kinds.HostnameReport,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gwNsName.Name,
+			Namespace: gwNsName.Namespace,
+			// The Gateway owns the report, so that the report is deleted together with the Gateway.
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: v1.GroupVersion.String(),
+					Kind:       kinds.Gateway,
+					Name:       gw.Source.Name,
+					UID:        gw.Source.UID,
+					Controller: helpers.GetPointer(true),
+				},
+			},
+		},
+	}
+
+	if len(usages) > 0 {
+		report.Hostnames = usages
+	}
+
+	if len(conflicts) > 0 {
+		report.Conflicts = conflicts
+	}
+
+	return report
+}
+
+func routeConflicts(route ngfAPI.HostnameRoute, hostnames []v1.Hostname) []ngfAPI.HostnameConflict {
+	conflicts := make([]ngfAPI.HostnameConflict, 0, len(hostnames))
+
+	for _, h := range hostnames {
+		conflicts = append(conflicts, ngfAPI.HostnameConflict{
+			Hostname: string(h),
+			Reason:   ngfAPI.HostnameConflictReasonRouteConflict,
+			Message: fmt.Sprintf(
+				"%s %s/%s is not attached, because its hostname conflicts with another %s on the same port",
+				route.Kind,
+				route.Namespace,
+				route.Name,
+				route.Kind,
+			),
+		})
+	}
+
+	return conflicts
+}
+
+func listenerConflicts(listeners []*graph.Listener) []ngfAPI.HostnameConflict {
+	var conflicts []ngfAPI.HostnameConflict
+
+	for _, l := range listeners {
+		for _, cond := range l.Conditions {
+			if cond.Reason != string(v1.ListenerReasonHostnameConflict) {
+				continue
+			}
+
+			hostname := allHostnames
+			if l.Source.Hostname != nil && *l.Source.Hostname != "" {
+				hostname = string(*l.Source.Hostname)
+			}
+
+			conflicts = append(conflicts, ngfAPI.HostnameConflict{
+				Hostname: hostname,
+				Reason:   ngfAPI.HostnameConflictReasonListenerConflict,
+				Message:  fmt.Sprintf("Listener %s is not accepted: %s", l.Name, cond.Message),
+			})
+
+			// The conflict is reported with more than one condition.
+			break
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,277 @@
+package status
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+func TestPrepareHostnameReport(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	gw := &graph.Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: gwNsName.Namespace,
+				Name:      gwNsName.Name,
+				UID:       "gateway-uid",
+			},
+		},
+		Listeners: []*graph.Listener{
+			{
+				Name:   "http",
+				Source: v1.Listener{Name: "http"},
+				Valid:  true,
+			},
+			{
+				Name:   "https",
+				Source: v1.Listener{Name: "https", Hostname: helpers.GetPointer[v1.Hostname]("cafe.example.com")},
+				Conditions: staticConds.NewListenerHostnameConflict(
+					"Multiple listeners for the same port 443 specify the same hostname",
+				),
+			},
+		},
+	}
+
+	attachment := func(hostnames map[string][]string) *graph.ParentRefAttachmentStatus {
+		return &graph.ParentRefAttachmentStatus{
+			AcceptedHostnames: hostnames,
+			Attached:          true,
+		}
+	}
+
+	createRoute := func(
+		namespace, name string,
+		routeType graph.RouteType,
+		refs ...graph.ParentRef,
+	) *graph.L7Route {
+		return &graph.L7Route{
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			},
+			RouteType:  routeType,
+			ParentRefs: refs,
+		}
+	}
+
+	routes := map[graph.RouteKey]*graph.L7Route{
+		{NamespacedName: types.NamespacedName{Namespace: "cafe", Name: "coffee"}}: createRoute(
+			"cafe",
+			"coffee",
+			graph.RouteTypeHTTP,
+			graph.ParentRef{
+				Gateway:    gwNsName,
+				Attachment: attachment(map[string][]string{"http": {"cafe.example.com", "tea.example.com"}}),
+			},
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "cafe", Name: "grpc"}}: createRoute(
+			"cafe",
+			"grpc",
+			graph.RouteTypeGRPC,
+			graph.ParentRef{
+				Gateway:    gwNsName,
+				Attachment: attachment(map[string][]string{"http": {"cafe.example.com"}}),
+			},
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "tea", Name: "tea"}}: createRoute(
+			"tea",
+			"tea",
+			graph.RouteTypeHTTP,
+			graph.ParentRef{
+				Gateway:    gwNsName,
+				Attachment: attachment(map[string][]string{"http": {"tea.example.com"}}),
+			},
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "catch-all"}}: createRoute(
+			"default",
+			"catch-all",
+			graph.RouteTypeHTTP,
+			graph.ParentRef{
+				Gateway:    gwNsName,
+				Attachment: attachment(map[string][]string{"http": {wildcardHostname}}),
+			},
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "tea", Name: "child"}}: createRoute(
+			"tea",
+			"child",
+			graph.RouteTypeHTTP,
+			graph.ParentRef{
+				Gateway:     gwNsName,
+				ParentRoute: &types.NamespacedName{Namespace: "cafe", Name: "coffee"},
+				Attachment:  attachment(map[string][]string{"http": {"cafe.example.com"}}),
+			},
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "tea", Name: "not-attached"}}: createRoute(
+			"tea",
+			"not-attached",
+			graph.RouteTypeHTTP,
+			graph.ParentRef{
+				Gateway: gwNsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					FailedCondition: staticConds.NewRouteNoMatchingListenerHostname(nil),
+				},
+			},
+		),
+		{NamespacedName: types.NamespacedName{Namespace: "tea", Name: "other-gateway"}}: createRoute(
+			"tea",
+			"other-gateway",
+			graph.RouteTypeHTTP,
+			graph.ParentRef{
+				Gateway:    types.NamespacedName{Namespace: "test", Name: "other"},
+				Attachment: attachment(map[string][]string{"http": {"cafe.example.com"}}),
+			},
+		),
+	}
+
+	l4Routes := map[graph.L4RouteKey]*graph.L4Route{
+		{NamespacedName: types.NamespacedName{Namespace: "secure", Name: "tls"}}: {
+			Source: &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "secure", Name: "tls"},
+			},
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway:    gwNsName,
+					Attachment: attachment(map[string][]string{"tls": {"secure.example.com"}}),
+				},
+			},
+		},
+		{NamespacedName: types.NamespacedName{Namespace: "secure", Name: "conflict"}}: {
+			Source: &v1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "secure", Name: "conflict"},
+			},
+			Spec: graph.L4RouteSpec{Hostnames: []v1.Hostname{"secure.example.com"}},
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway: gwNsName,
+					Attachment: &graph.ParentRefAttachmentStatus{
+						FailedCondition: staticConds.NewRouteHostnameConflict(),
+					},
+				},
+			},
+		},
+	}
+
+	expected := &ngfAPI.HostnameReport{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.nginx.org/v1alpha1",
+			Kind:       kinds.HostnameReport,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "gateway.networking.k8s.io/v1",
+					Kind:       kinds.Gateway,
+					Name:       "gateway",
+					UID:        "gateway-uid",
+					Controller: helpers.GetPointer(true),
+				},
+			},
+		},
+		Hostnames: []ngfAPI.HostnameUsage{
+			{
+				Hostname:   "*",
+				Namespaces: []string{"default"},
+				Routes: []ngfAPI.HostnameRoute{
+					{Kind: kinds.HTTPRoute, Namespace: "default", Name: "catch-all", Listeners: []string{"http"}},
+				},
+			},
+			{
+				Hostname:   "cafe.example.com",
+				Namespaces: []string{"cafe"},
+				Routes: []ngfAPI.HostnameRoute{
+					{Kind: kinds.HTTPRoute, Namespace: "cafe", Name: "coffee", Listeners: []string{"http"}},
+					{Kind: kinds.GRPCRoute, Namespace: "cafe", Name: "grpc", Listeners: []string{"http"}},
+				},
+			},
+			{
+				Hostname:   "secure.example.com",
+				Namespaces: []string{"secure"},
+				Routes: []ngfAPI.HostnameRoute{
+					{Kind: kinds.TLSRoute, Namespace: "secure", Name: "tls", Listeners: []string{"tls"}},
+				},
+			},
+			{
+				Hostname:   "tea.example.com",
+				Namespaces: []string{"cafe", "tea"},
+				Routes: []ngfAPI.HostnameRoute{
+					{Kind: kinds.HTTPRoute, Namespace: "cafe", Name: "coffee", Listeners: []string{"http"}},
+					{Kind: kinds.HTTPRoute, Namespace: "tea", Name: "tea", Listeners: []string{"http"}},
+				},
+			},
+		},
+		Conflicts: []ngfAPI.HostnameConflict{
+			{
+				Hostname: "cafe.example.com",
+				Reason:   ngfAPI.HostnameConflictReasonListenerConflict,
+				Message: "Listener https is not accepted: " +
+					"Multiple listeners for the same port 443 specify the same hostname",
+			},
+			{
+				Hostname: "secure.example.com",
+				Reason:   ngfAPI.HostnameConflictReasonRouteConflict,
+				Message: "TLSRoute secure/conflict is not attached, " +
+					"because its hostname conflicts with another TLSRoute on the same port",
+			},
+			{
+				Hostname: "tea.example.com",
+				Reason:   ngfAPI.HostnameConflictReasonMultipleNamespaces,
+				Message:  "Routes of the namespaces cafe, tea claim the hostname",
+			},
+		},
+	}
+
+	tests := []struct {
+		gw       *graph.Gateway
+		l4Routes map[graph.L4RouteKey]*graph.L4Route
+		routes   map[graph.RouteKey]*graph.L7Route
+		expected *ngfAPI.HostnameReport
+		name     string
+	}{
+		{
+			name:     "no gateway",
+			routes:   routes,
+			expected: nil,
+		},
+		{
+			name: "no routes",
+			gw: &graph.Gateway{
+				Source: gw.Source,
+			},
+			expected: &ngfAPI.HostnameReport{
+				TypeMeta:   expected.TypeMeta,
+				ObjectMeta: expected.ObjectMeta,
+			},
+		},
+		{
+			name:     "routes and conflicts",
+			gw:       gw,
+			l4Routes: l4Routes,
+			routes:   routes,
+			expected: expected,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			report := PrepareHostnameReport(test.gw, test.l4Routes, test.routes)
+			g.Expect(report).To(Equal(test.expected))
+		})
+	}
+}
@@ -150,6 +150,17 @@ func prepareRouteStatus(
 			Conditions:     apiConds,
 		}
 
+		if ref.ParentRoute != nil {
+			ps.ParentRef = v1.ParentReference{
+				Group:       helpers.GetPointer[v1.Group](v1.GroupName),
+				Kind:        helpers.GetPointer[v1.Kind](kinds.HTTPRoute),
+				Namespace:   helpers.GetPointer(v1.Namespace(ref.ParentRoute.Namespace)),
+				Name:        v1.ObjectName(ref.ParentRoute.Name),
+				SectionName: ref.SectionName,
+				Port:        ref.Port,
+			}
+		}
+
 		parents = append(parents, ps)
 	}
 
@@ -293,6 +304,8 @@ func prepareGatewayRequest(
 	}
 
 	gwConds := staticConds.NewDefaultGatewayConditions()
+	gwConds = append(gwConds, gateway.Conditions...)
+
 	if validListenerCount == 0 {
 		gwConds = append(gwConds, staticConds.NewGatewayNotAcceptedListenersNotValid()...)
 	} else if validListenerCount < len(gateway.Listeners) {
@@ -331,11 +344,8 @@ func PrepareNGFPolicyRequests(
 	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
 
 	for key, pol := range policies {
-		ancestorStatuses := make([]v1alpha2.PolicyAncestorStatus, 0, len(pol.TargetRefs))
-
-		if len(pol.Ancestors) == 0 {
-			continue
-		}
+		// If the Policy has no ancestors, the update removes the stale ancestor statuses of NGF.
+		ancestorStatuses := make([]v1alpha2.PolicyAncestorStatus, 0, len(pol.Ancestors))
 
 		for _, ancestor := range pol.Ancestors {
 			allConds := make([]conditions.Condition, 0, len(pol.Conditions)+len(ancestor.Conditions)+1)
@@ -409,6 +419,177 @@ func PrepareBackendTLSPolicyRequests(
 	return reqs
 }
 
+// PrepareSnippetsFilterRequests prepares status UpdateRequests for the given SnippetsFilters.
+// Only the SnippetsFilters that HTTPRoutes reference get a status.
+func PrepareSnippetsFilterRequests(
+	filters map[types.NamespacedName]*graph.SnippetsFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(filters))
+
+	for nsname, filter := range filters {
+		if !filter.Referenced {
+			continue
+		}
+
+		status := prepareFilterControllerStatus(
+			filter.Conditions,
+			filter.Source.Generation,
+			transitionTime,
+			gatewayCtlrName,
+		)
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.SnippetsFilter{},
+			Setter:       newFilterStatusSetter(status),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareRateLimitFilterRequests prepares status UpdateRequests for the given RateLimitFilters.
+// Only the RateLimitFilters that HTTPRoutes reference get a status.
+func PrepareRateLimitFilterRequests(
+	filters map[types.NamespacedName]*graph.RateLimitFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(filters))
+
+	for nsname, filter := range filters {
+		if !filter.Referenced {
+			continue
+		}
+
+		status := prepareFilterControllerStatus(
+			filter.Conditions,
+			filter.Source.Generation,
+			transitionTime,
+			gatewayCtlrName,
+		)
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.RateLimitFilter{},
+			Setter:       newFilterStatusSetter(status),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareResponseHeaderFilterRequests prepares status UpdateRequests for the given ResponseHeaderFilters.
+// Only the ResponseHeaderFilters that HTTPRoutes reference get a status.
+func PrepareResponseHeaderFilterRequests(
+	filters map[types.NamespacedName]*graph.ResponseHeaderFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(filters))
+
+	for nsname, filter := range filters {
+		if !filter.Referenced {
+			continue
+		}
+
+		status := prepareFilterControllerStatus(
+			filter.Conditions,
+			filter.Source.Generation,
+			transitionTime,
+			gatewayCtlrName,
+		)
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.ResponseHeaderFilter{},
+			Setter:       newFilterStatusSetter(status),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareQueryParameterFilterRequests prepares status UpdateRequests for the given QueryParameterFilters.
+// Only the QueryParameterFilters that HTTPRoutes reference get a status.
+func PrepareQueryParameterFilterRequests(
+	filters map[types.NamespacedName]*graph.QueryParameterFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(filters))
+
+	for nsname, filter := range filters {
+		if !filter.Referenced {
+			continue
+		}
+
+		status := prepareFilterControllerStatus(
+			filter.Conditions,
+			filter.Source.Generation,
+			transitionTime,
+			gatewayCtlrName,
+		)
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.QueryParameterFilter{},
+			Setter:       newFilterStatusSetter(status),
+		})
+	}
+
+	return reqs
+}
+
+// PrepareABTestFilterRequests prepares status UpdateRequests for the given ABTestFilters.
+// Only the ABTestFilters that HTTPRoutes reference get a status.
+func PrepareABTestFilterRequests(
+	filters map[types.NamespacedName]*graph.ABTestFilter,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(filters))
+
+	for nsname, filter := range filters {
+		if !filter.Referenced {
+			continue
+		}
+
+		status := prepareFilterControllerStatus(
+			filter.Conditions,
+			filter.Source.Generation,
+			transitionTime,
+			gatewayCtlrName,
+		)
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &ngfAPI.ABTestFilter{},
+			Setter:       newFilterStatusSetter(status),
+		})
+	}
+
+	return reqs
+}
+
+func prepareFilterControllerStatus(
+	conds []conditions.Condition,
+	generation int64,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) ngfAPI.ControllerStatus {
+	return ngfAPI.ControllerStatus{
+		ControllerName: v1.GatewayController(gatewayCtlrName),
+		Conditions: conditions.ConvertConditions(
+			conditions.DeduplicateConditions(conds),
+			generation,
+			transitionTime,
+		),
+	}
+}
+
 // ControlPlaneUpdateResult describes the result of a control plane update.
 type ControlPlaneUpdateResult struct {
 	// Error is the error that occurred during the update.
@@ -42,6 +42,7 @@ func createK8sClientFor(resourceType ngftypes.ObjectType) client.Client {
 		WithStatusSubresource(
 			resourceType,
 		).
+		WithInterceptorFuncs(helpers.ApplyAsUpdateInterceptorFuncs()).
 		Build()
 
 	return k8sClient
@@ -234,6 +235,28 @@ func TestBuildHTTPRouteStatuses(t *testing.T) {
 			CommonRouteSpec: commonRouteSpecInvalid,
 		},
 	}
+	parentRouteNsName := types.NamespacedName{Namespace: "test", Name: "hr-valid"}
+
+	hrChild := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "app",
+			Name:       "hr-child",
+			Generation: 3,
+		},
+		Spec: v1.HTTPRouteSpec{
+			CommonRouteSpec: v1.CommonRouteSpec{
+				ParentRefs: []v1.ParentReference{
+					{
+						Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+						Kind:      helpers.GetPointer[v1.Kind](kinds.HTTPRoute),
+						Namespace: helpers.GetPointer(v1.Namespace(parentRouteNsName.Namespace)),
+						Name:      v1.ObjectName(parentRouteNsName.Name),
+					},
+				},
+			},
+		},
+	}
+
 	routes := map[graph.RouteKey]*graph.L7Route{
 		graph.CreateRouteKey(hrValid): {
 			Valid:      true,
@@ -248,6 +271,21 @@ func TestBuildHTTPRouteStatuses(t *testing.T) {
 			ParentRefs: parentRefsInvalid,
 			RouteType:  graph.RouteTypeHTTP,
 		},
+		graph.CreateRouteKey(hrChild): {
+			Valid:  true,
+			Source: hrChild,
+			ParentRefs: []graph.ParentRef{
+				{
+					Idx:         0,
+					ParentRoute: &parentRouteNsName,
+					Attachment: &graph.ParentRefAttachmentStatus{
+						Attached:        false,
+						FailedCondition: invalidAttachmentCondition,
+					},
+				},
+			},
+			RouteType: graph.RouteTypeHTTP,
+		},
 	}
 
 	expectedStatuses := map[types.NamespacedName]v1.HTTPRouteStatus{
@@ -257,6 +295,40 @@ func TestBuildHTTPRouteStatuses(t *testing.T) {
 		{Namespace: "test", Name: "hr-invalid"}: {
 			RouteStatus: routeStatusInvalid,
 		},
+		{Namespace: "app", Name: "hr-child"}: {
+			RouteStatus: v1.RouteStatus{
+				Parents: []v1.RouteParentStatus{
+					{
+						ParentRef:      hrChild.Spec.ParentRefs[0],
+						ControllerName: gatewayCtlrName,
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(v1.RouteConditionAccepted),
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 3,
+								LastTransitionTime: transitionTime,
+								Reason:             string(v1.RouteReasonAccepted),
+								Message:            "The route is accepted",
+							},
+							{
+								Type:               string(v1.RouteConditionResolvedRefs),
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 3,
+								LastTransitionTime: transitionTime,
+								Reason:             string(v1.RouteReasonResolvedRefs),
+								Message:            "All references are resolved",
+							},
+							{
+								Type:               invalidAttachmentCondition.Type,
+								Status:             metav1.ConditionTrue,
+								ObservedGeneration: 3,
+								LastTransitionTime: transitionTime,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	g := NewWithT(t)
@@ -865,6 +937,61 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid gateway; unsupported fields",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewGatewayUnsupportedField([]string{"spec.infrastructure"}),
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+						{
+							Type:               string(staticConds.GatewayUnsupportedField),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(staticConds.GatewayReasonUnsupportedField),
+							Message:            "The following fields are not supported and are ignored: spec.infrastructure",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "valid gateway; some valid listeners",
 			gateway: &graph.Gateway{
@@ -1744,11 +1871,13 @@ func TestBuildNGFPolicyStatuses(t *testing.T) {
 			},
 		},
 		{
-			name: "Policy with nil ancestor",
+			name: "Policy with nil ancestor; status is updated to remove stale ancestors",
 			policies: map[graph.PolicyKey]*graph.Policy{
 				nilAncestorPolicyKey: getPolicy(nilAncestorPolicyCfg),
 			},
-			expected: map[types.NamespacedName]v1alpha2.PolicyStatus{},
+			expected: map[types.NamespacedName]v1alpha2.PolicyStatus{
+				nilAncestorPolicyKey.NsName: {},
+			},
 		},
 	}
 
@@ -1782,3 +1911,146 @@ func TestBuildNGFPolicyStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareFilterRequests(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	const gatewayCtlrName = "controller"
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: "test", Name: name, Generation: 3}
+	}
+
+	snippetsFilters := map[types.NamespacedName]*graph.SnippetsFilter{
+		{Namespace: "test", Name: "referenced"}: {
+			Source:     &ngfAPI.SnippetsFilter{ObjectMeta: objectMeta("referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+			Referenced: true,
+		},
+		{Namespace: "test", Name: "not-referenced"}: {
+			Source:     &ngfAPI.SnippetsFilter{ObjectMeta: objectMeta("not-referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+		},
+	}
+
+	rateLimitFilters := map[types.NamespacedName]*graph.RateLimitFilter{
+		{Namespace: "test", Name: "invalid"}: {
+			Source:     &ngfAPI.RateLimitFilter{ObjectMeta: objectMeta("invalid")},
+			Conditions: []conditions.Condition{staticConds.NewFilterInvalid("invalid rate")},
+			Referenced: true,
+		},
+	}
+
+	responseHeaderFilters := map[types.NamespacedName]*graph.ResponseHeaderFilter{
+		{Namespace: "test", Name: "referenced"}: {
+			Source:     &ngfAPI.ResponseHeaderFilter{ObjectMeta: objectMeta("referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+			Referenced: true,
+		},
+		{Namespace: "test", Name: "not-referenced"}: {
+			Source:     &ngfAPI.ResponseHeaderFilter{ObjectMeta: objectMeta("not-referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+		},
+	}
+
+	queryParameterFilters := map[types.NamespacedName]*graph.QueryParameterFilter{
+		{Namespace: "test", Name: "referenced"}: {
+			Source:     &ngfAPI.QueryParameterFilter{ObjectMeta: objectMeta("referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+			Referenced: true,
+		},
+		{Namespace: "test", Name: "not-referenced"}: {
+			Source:     &ngfAPI.QueryParameterFilter{ObjectMeta: objectMeta("not-referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+		},
+	}
+
+	abTestFilters := map[types.NamespacedName]*graph.ABTestFilter{
+		{Namespace: "test", Name: "referenced"}: {
+			Source:     &ngfAPI.ABTestFilter{ObjectMeta: objectMeta("referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+			Referenced: true,
+		},
+		{Namespace: "test", Name: "not-referenced"}: {
+			Source:     &ngfAPI.ABTestFilter{ObjectMeta: objectMeta("not-referenced")},
+			Conditions: []conditions.Condition{staticConds.NewFilterAccepted()},
+			Valid:      true,
+		},
+	}
+
+	snippetsReqs := PrepareSnippetsFilterRequests(snippetsFilters, transitionTime, gatewayCtlrName)
+	g.Expect(snippetsReqs).To(HaveLen(1))
+	g.Expect(snippetsReqs[0].NsName).To(Equal(types.NamespacedName{Namespace: "test", Name: "referenced"}))
+
+	sf := &ngfAPI.SnippetsFilter{}
+	g.Expect(snippetsReqs[0].Setter(sf)).To(BeTrue())
+	g.Expect(sf.Status.Controllers).To(Equal([]ngfAPI.ControllerStatus{
+		{
+			ControllerName: gatewayCtlrName,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(ngfAPI.FilterConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 3,
+					LastTransitionTime: transitionTime,
+					Reason:             string(ngfAPI.FilterReasonAccepted),
+					Message:            "Filter is accepted",
+				},
+			},
+		},
+	}))
+
+	rateLimitReqs := PrepareRateLimitFilterRequests(rateLimitFilters, transitionTime, gatewayCtlrName)
+	g.Expect(rateLimitReqs).To(HaveLen(1))
+
+	rlf := &ngfAPI.RateLimitFilter{}
+	g.Expect(rateLimitReqs[0].Setter(rlf)).To(BeTrue())
+	g.Expect(rlf.Status.Controllers).To(Equal([]ngfAPI.ControllerStatus{
+		{
+			ControllerName: gatewayCtlrName,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(ngfAPI.FilterConditionAccepted),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 3,
+					LastTransitionTime: transitionTime,
+					Reason:             string(ngfAPI.FilterReasonInvalid),
+					Message:            "invalid rate",
+				},
+			},
+		},
+	}))
+
+	responseHeaderReqs := PrepareResponseHeaderFilterRequests(responseHeaderFilters, transitionTime, gatewayCtlrName)
+	g.Expect(responseHeaderReqs).To(HaveLen(1))
+	g.Expect(responseHeaderReqs[0].NsName).To(Equal(types.NamespacedName{Namespace: "test", Name: "referenced"}))
+
+	rhf := &ngfAPI.ResponseHeaderFilter{}
+	g.Expect(responseHeaderReqs[0].Setter(rhf)).To(BeTrue())
+	g.Expect(rhf.Status.Controllers).To(HaveLen(1))
+
+	queryParameterReqs := PrepareQueryParameterFilterRequests(queryParameterFilters, transitionTime, gatewayCtlrName)
+	g.Expect(queryParameterReqs).To(HaveLen(1))
+	g.Expect(queryParameterReqs[0].NsName).To(Equal(types.NamespacedName{Namespace: "test", Name: "referenced"}))
+
+	qpf := &ngfAPI.QueryParameterFilter{}
+	g.Expect(queryParameterReqs[0].Setter(qpf)).To(BeTrue())
+	g.Expect(qpf.Status.Controllers).To(HaveLen(1))
+
+	abTestReqs := PrepareABTestFilterRequests(abTestFilters, transitionTime, gatewayCtlrName)
+	g.Expect(abTestReqs).To(HaveLen(1))
+	g.Expect(abTestReqs[0].NsName).To(Equal(types.NamespacedName{Namespace: "test", Name: "referenced"}))
+
+	abf := &ngfAPI.ABTestFilter{}
+	g.Expect(abTestReqs[0].Setter(abf)).To(BeTrue())
+	g.Expect(abf.Status.Controllers).To(HaveLen(1))
+}
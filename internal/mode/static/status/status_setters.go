@@ -1,6 +1,7 @@
 package status
 
 import (
+	"fmt"
 	"slices"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -333,3 +334,41 @@ func ancestorStatusEqual(p1, p2 v1alpha2.PolicyAncestorStatus) bool {
 
 	return frameworkStatus.ConditionsEqual(p1.Conditions, p2.Conditions)
 }
+
+func newFilterStatusSetter(status ngfAPI.ControllerStatus) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		var filterStatus *ngfAPI.FilterStatus
+
+		switch filter := object.(type) {
+		case *ngfAPI.SnippetsFilter:
+			filterStatus = &filter.Status
+		case *ngfAPI.RateLimitFilter:
+			filterStatus = &filter.Status
+		case *ngfAPI.ResponseHeaderFilter:
+			filterStatus = &filter.Status
+		case *ngfAPI.QueryParameterFilter:
+			filterStatus = &filter.Status
+		case *ngfAPI.ABTestFilter:
+			filterStatus = &filter.Status
+		default:
+			panic(fmt.Sprintf("unsupported filter type %T", object))
+		}
+
+		controllers := make([]ngfAPI.ControllerStatus, 0, len(filterStatus.Controllers)+1)
+
+		// keep all the statuses that belong to other controllers
+		for _, cs := range filterStatus.Controllers {
+			if cs.ControllerName != status.ControllerName {
+				controllers = append(controllers, cs)
+				continue
+			}
+
+			if frameworkStatus.ConditionsEqual(cs.Conditions, status.Conditions) {
+				return false
+			}
+		}
+
+		filterStatus.Controllers = append(controllers, status)
+		return true
+	}
+}
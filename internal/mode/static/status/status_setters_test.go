@@ -1587,3 +1587,73 @@ func TestPolicyStatusEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFilterStatusSetter(t *testing.T) {
+	t.Parallel()
+	const (
+		controllerName      = "controller"
+		otherControllerName = "other-controller"
+	)
+
+	newStatus := ngfAPI.ControllerStatus{
+		ControllerName: controllerName,
+		Conditions:     []metav1.Condition{{Message: "new condition"}},
+	}
+	otherStatus := ngfAPI.ControllerStatus{
+		ControllerName: otherControllerName,
+		Conditions:     []metav1.Condition{{Message: "some condition"}},
+	}
+
+	tests := []struct {
+		name                 string
+		status, expStatus    ngfAPI.FilterStatus
+		expStatusSet, useRLF bool
+	}{
+		{
+			name:         "SnippetsFilter has no status",
+			expStatus:    ngfAPI.FilterStatus{Controllers: []ngfAPI.ControllerStatus{newStatus}},
+			expStatusSet: true,
+		},
+		{
+			name: "RateLimitFilter has old status and other controller status",
+			status: ngfAPI.FilterStatus{
+				Controllers: []ngfAPI.ControllerStatus{
+					{
+						ControllerName: controllerName,
+						Conditions:     []metav1.Condition{{Message: "old condition"}},
+					},
+					otherStatus,
+				},
+			},
+			expStatus:    ngfAPI.FilterStatus{Controllers: []ngfAPI.ControllerStatus{otherStatus, newStatus}},
+			expStatusSet: true,
+			useRLF:       true,
+		},
+		{
+			name:         "SnippetsFilter has same status",
+			status:       ngfAPI.FilterStatus{Controllers: []ngfAPI.ControllerStatus{newStatus, otherStatus}},
+			expStatus:    ngfAPI.FilterStatus{Controllers: []ngfAPI.ControllerStatus{newStatus, otherStatus}},
+			expStatusSet: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			setter := newFilterStatusSetter(newStatus)
+
+			if test.useRLF {
+				rlf := &ngfAPI.RateLimitFilter{Status: test.status}
+				g.Expect(setter(rlf)).To(Equal(test.expStatusSet))
+				g.Expect(rlf.Status).To(Equal(test.expStatus))
+				return
+			}
+
+			sf := &ngfAPI.SnippetsFilter{Status: test.status}
+			g.Expect(setter(sf)).To(Equal(test.expStatusSet))
+			g.Expect(sf.Status).To(Equal(test.expStatus))
+		})
+	}
+}
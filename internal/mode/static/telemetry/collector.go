@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 
 	tel "github.com/nginxinc/telemetry-exporter/pkg/telemetry"
 	appsv1 "k8s.io/api/apps/v1"
@@ -46,7 +47,13 @@ type Data struct {
 	// FlagValues contains the values of the command-line flags, where each value corresponds to the flag from FlagNames
 	// at the same index.
 	// Each value is either 'true' or 'false' for boolean flags and 'default' or 'user-defined' for non-boolean flags.
-	FlagValues        []string
+	FlagValues []string
+	// FeatureGates contains the names of the enabled feature gates.
+	FeatureGates []string
+	// DataPlaneFlavor is the flavor of the data plane (values are 'nginx' or 'nginx-plus').
+	DataPlaneFlavor string
+	// EnabledFeatures contains the kinds of the NGF Policies and Filters that are in use.
+	EnabledFeatures   []string
 	NGFResourceCounts // embedding is required by the generator.
 	// NGFReplicaCount is the number of replicas of the NGF Pod.
 	NGFReplicaCount int64
@@ -101,6 +108,10 @@ type DataCollectorConfig struct {
 	ImageSource string
 	// Flags contains the command-line NGF flag keys and values.
 	Flags config.Flags
+	// FeatureGates contains the names of the enabled feature gates.
+	FeatureGates []string
+	// Plus indicates whether the data plane is NGINX Plus.
+	Plus bool
 }
 
 // DataCollectorImpl is am implementation of DataCollector.
@@ -159,6 +170,9 @@ func (c DataCollectorImpl) Collect(ctx context.Context) (Data, error) {
 		ImageSource:       c.cfg.ImageSource,
 		FlagNames:         c.cfg.Flags.Names,
 		FlagValues:        c.cfg.Flags.Values,
+		FeatureGates:      c.cfg.FeatureGates,
+		DataPlaneFlavor:   dataPlaneFlavor(c.cfg.Plus),
+		EnabledFeatures:   collectEnabledFeatures(c.cfg.GraphGetter.GetLatestGraph()),
 		NGFReplicaCount:   int64(replicaCount),
 	}
 
@@ -230,6 +244,55 @@ func collectGraphResourceCount(
 	return ngfResourceCounts, nil
 }
 
+// collectEnabledFeatures returns the sorted kinds of the NGF Policies and Filters of the graph. It only reports
+// which kinds are in use, not the resources themselves.
+func collectEnabledFeatures(g *graph.Graph) []string {
+	if g == nil {
+		return nil
+	}
+
+	features := make(map[string]struct{})
+
+	for policyKey := range g.NGFPolicies {
+		features[policyKey.GVK.Kind] = struct{}{}
+	}
+
+	filters := map[string]int{
+		kinds.SnippetsFilter:       len(g.SnippetsFilters),
+		kinds.RateLimitFilter:      len(g.RateLimitFilters),
+		kinds.ResponseHeaderFilter: len(g.ResponseHeaderFilters),
+		kinds.QueryParameterFilter: len(g.QueryParameterFilters),
+		kinds.ABTestFilter:         len(g.ABTestFilters),
+	}
+
+	for kind, count := range filters {
+		if count > 0 {
+			features[kind] = struct{}{}
+		}
+	}
+
+	if len(features) == 0 {
+		return nil
+	}
+
+	enabled := make([]string, 0, len(features))
+	for feature := range features {
+		enabled = append(enabled, feature)
+	}
+
+	sort.Strings(enabled)
+
+	return enabled
+}
+
+func dataPlaneFlavor(plus bool) string {
+	if plus {
+		return "nginx-plus"
+	}
+
+	return "nginx"
+}
+
 type RouteCounts struct {
 	HTTPRouteCount int64
 	GRPCRouteCount int64
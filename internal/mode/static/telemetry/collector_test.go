@@ -173,6 +173,8 @@ var _ = Describe("Collector", Ordered, func() {
 			ImageSource:       "local",
 			FlagNames:         flags.Names,
 			FlagValues:        flags.Values,
+			FeatureGates:      []string{"TLSRoute"},
+			DataPlaneFlavor:   "nginx",
 		}
 
 		k8sClientReader = &eventsfakes.FakeReader{}
@@ -190,6 +192,7 @@ var _ = Describe("Collector", Ordered, func() {
 			PodNSName:           podNSName,
 			ImageSource:         "local",
 			Flags:               flags,
+			FeatureGates:        []string{"TLSRoute"},
 		})
 
 		baseGetCalls = createGetCallsFunc(ngfPod, ngfReplicaSet, kubeNamespace)
@@ -299,7 +302,7 @@ var _ = Describe("Collector", Ordered, func() {
 						},
 						client.ObjectKeyFromObject(nilsecret): nil,
 					},
-					ReferencedServices: map[types.NamespacedName]struct{}{
+					ReferencedServices: map[types.NamespacedName]*graph.ReferencedService{
 						client.ObjectKeyFromObject(svc1):   {},
 						client.ObjectKeyFromObject(svc2):   {},
 						client.ObjectKeyFromObject(nilsvc): {},
@@ -380,6 +383,7 @@ var _ = Describe("Collector", Ordered, func() {
 					ObservabilityPolicyCount:                 1,
 					NginxProxyCount:                          1,
 				}
+				expData.EnabledFeatures = []string{kinds.ClientSettingsPolicy, kinds.ObservabilityPolicy}
 				expData.ClusterVersion = "1.29.2"
 				expData.ClusterPlatform = "kind"
 
@@ -527,7 +531,7 @@ var _ = Describe("Collector", Ordered, func() {
 						Source: secret,
 					},
 				},
-				ReferencedServices: map[types.NamespacedName]struct{}{
+				ReferencedServices: map[types.NamespacedName]*graph.ReferencedService{
 					client.ObjectKeyFromObject(svc): {},
 				},
 				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
@@ -623,6 +627,7 @@ var _ = Describe("Collector", Ordered, func() {
 					ObservabilityPolicyCount:                 1,
 					NginxProxyCount:                          1,
 				}
+				expData.EnabledFeatures = []string{kinds.ClientSettingsPolicy, kinds.ObservabilityPolicy}
 
 				data, err := dataCollector.Collect(ctx)
 
@@ -17,6 +17,9 @@ func (d *Data) Attributes() []attribute.KeyValue {
 	attrs = append(attrs, d.Data.Attributes()...)
 	attrs = append(attrs, attribute.StringSlice("FlagNames", d.FlagNames))
 	attrs = append(attrs, attribute.StringSlice("FlagValues", d.FlagValues))
+	attrs = append(attrs, attribute.StringSlice("FeatureGates", d.FeatureGates))
+	attrs = append(attrs, attribute.String("DataPlaneFlavor", d.DataPlaneFlavor))
+	attrs = append(attrs, attribute.StringSlice("EnabledFeatures", d.EnabledFeatures))
 	attrs = append(attrs, d.NGFResourceCounts.Attributes()...)
 	attrs = append(attrs, attribute.Int64("NGFReplicaCount", d.NGFReplicaCount))
 
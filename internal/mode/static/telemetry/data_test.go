@@ -22,8 +22,11 @@ func TestDataAttributes(t *testing.T) {
 			InstallationID:      "123",
 			ClusterNodeCount:    3,
 		},
-		FlagNames:  []string{"test-flag"},
-		FlagValues: []string{"test-value"},
+		FlagNames:       []string{"test-flag"},
+		FlagValues:      []string{"test-value"},
+		FeatureGates:    []string{"TLSRoute"},
+		DataPlaneFlavor: "nginx-plus",
+		EnabledFeatures: []string{"ObservabilityPolicy"},
 		NGFResourceCounts: NGFResourceCounts{
 			GatewayCount:                             1,
 			GatewayClassCount:                        2,
@@ -55,6 +58,9 @@ func TestDataAttributes(t *testing.T) {
 		attribute.Int64("ClusterNodeCount", 3),
 		attribute.StringSlice("FlagNames", []string{"test-flag"}),
 		attribute.StringSlice("FlagValues", []string{"test-value"}),
+		attribute.StringSlice("FeatureGates", []string{"TLSRoute"}),
+		attribute.String("DataPlaneFlavor", "nginx-plus"),
+		attribute.StringSlice("EnabledFeatures", []string{"ObservabilityPolicy"}),
 		attribute.Int64("GatewayCount", 1),
 		attribute.Int64("GatewayClassCount", 2),
 		attribute.Int64("HTTPRouteCount", 3),
@@ -94,6 +100,9 @@ func TestDataAttributesWithEmptyData(t *testing.T) {
 		attribute.Int64("ClusterNodeCount", 0),
 		attribute.StringSlice("FlagNames", nil),
 		attribute.StringSlice("FlagValues", nil),
+		attribute.StringSlice("FeatureGates", nil),
+		attribute.String("DataPlaneFlavor", ""),
+		attribute.StringSlice("EnabledFeatures", nil),
 		attribute.Int64("GatewayCount", 0),
 		attribute.Int64("GatewayClassCount", 0),
 		attribute.Int64("HTTPRouteCount", 0),
@@ -31,7 +31,7 @@ func CreateTelemetryJobWorker(
 		}
 
 		// Export telemetry
-		logger.V(1).Info("Exporting telemetry data")
+		logger.V(1).Info("Exporting telemetry data", "data", data)
 
 		if err := exporter.Export(ctx, &data); err != nil {
 			logger.Error(err, "Failed to export telemetry data")
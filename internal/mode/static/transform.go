@@ -0,0 +1,25 @@
+package static
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// stripUnusedMetadata removes the managed fields and the last applied configuration annotation from an object
+// before the object is stored in the cache. NGF doesn't use them, and in large clusters they make up a large part
+// of the memory of the cache.
+// Anything that is not an object, for example, a tombstone of a deleted object, is returned as is.
+func stripUnusedMetadata(obj interface{}) (interface{}, error) {
+	accessor, err := meta.Accessor(obj)
+	if err == nil {
+		accessor.SetManagedFields(nil)
+
+		annotations := accessor.GetAnnotations()
+		if _, exists := annotations[apiv1.LastAppliedConfigAnnotation]; exists {
+			delete(annotations, apiv1.LastAppliedConfigAnnotation)
+			accessor.SetAnnotations(annotations)
+		}
+	}
+
+	return obj, nil
+}
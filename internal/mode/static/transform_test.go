@@ -0,0 +1,103 @@
+package static
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestStripUnusedMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		obj    interface{}
+		expObj interface{}
+		name   string
+	}{
+		{
+			name: "managed fields and last applied configuration are removed",
+			obj: &apiv1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "svc",
+					Namespace: "test",
+					Annotations: map[string]string{
+						apiv1.LastAppliedConfigAnnotation: `{"apiVersion":"v1","kind":"Service"}`,
+						"example.com/annotation":          "value",
+					},
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply},
+					},
+				},
+			},
+			expObj: &apiv1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "svc",
+					Namespace: "test",
+					Annotations: map[string]string{
+						"example.com/annotation": "value",
+					},
+				},
+			},
+		},
+		{
+			name: "metadata-only object",
+			obj: &metav1.PartialObjectMetadata{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+					Annotations: map[string]string{
+						apiv1.LastAppliedConfigAnnotation: `{"apiVersion":"v1","kind":"Namespace"}`,
+					},
+					Labels: map[string]string{
+						"app": "test",
+					},
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply},
+					},
+				},
+			},
+			expObj: &metav1.PartialObjectMetadata{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "ns",
+					Annotations: map[string]string{},
+					Labels: map[string]string{
+						"app": "test",
+					},
+				},
+			},
+		},
+		{
+			name: "object without annotations",
+			obj: &apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "test",
+				},
+			},
+			expObj: &apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: "test",
+				},
+			},
+		},
+		{
+			name:   "not an object",
+			obj:    cache.DeletedFinalStateUnknown{Key: "test/svc"},
+			expObj: cache.DeletedFinalStateUnknown{Key: "test/svc"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			obj, err := stripUnusedMetadata(test.obj)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(obj).To(Equal(test.expObj))
+		})
+	}
+}
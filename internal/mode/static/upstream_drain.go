@@ -0,0 +1,132 @@
+package static
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	ngfAPI "github.com/nginxinc/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// upstreamDrainExpiredEvent is sent to the event loop when the drain deadline of an upstream passed,
+// so that the configuration is rebuilt without the upstream.
+type upstreamDrainExpiredEvent struct{}
+
+// drainingUpstream is an upstream that is no longer referenced, but stays in the configuration
+// until its deadline.
+type drainingUpstream struct {
+	// deadline is the time when the upstream is removed from the configuration.
+	deadline time.Time
+	// upstream is the last version of the upstream.
+	upstream dataplane.Upstream
+}
+
+// upstreamDrainer keeps the upstreams that are no longer referenced in the configuration for the drain timeout
+// of the NginxProxy resource, so that the requests in flight to their backends complete before the upstreams
+// are removed. For example, the upstream of the old backend of a blue/green cutover drains this way.
+type upstreamDrainer struct {
+	// draining are the draining upstreams by name.
+	draining map[string]drainingUpstream
+	lock     sync.Mutex
+}
+
+func newUpstreamDrainer() *upstreamDrainer {
+	return &upstreamDrainer{
+		draining: make(map[string]drainingUpstream),
+	}
+}
+
+// retain adds the draining upstreams to the upstreams of conf. The upstreams of prevConf that conf doesn't
+// have start draining, and the upstreams that conf references again stop draining.
+// It returns the names of the upstreams that finished draining, sorted, which are not added to conf.
+func (d *upstreamDrainer) retain(prevConf, conf *dataplane.Configuration, now time.Time) []string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	referenced := make(map[string]struct{}, len(conf.Upstreams))
+	for _, u := range conf.Upstreams {
+		referenced[u.Name] = struct{}{}
+		delete(d.draining, u.Name)
+	}
+
+	var timeout time.Duration
+	if conf.UpstreamDrainTimeout != "" {
+		// The timeout is validated when the NginxProxy resource is processed.
+		timeout, _ = parseDuration(ngfAPI.Duration(conf.UpstreamDrainTimeout))
+	}
+
+	// The upstreams of prevConf include the draining upstreams, which keep their deadline.
+	if prevConf != nil && timeout > 0 {
+		for _, u := range prevConf.Upstreams {
+			if _, ok := referenced[u.Name]; ok {
+				continue
+			}
+
+			if _, ok := d.draining[u.Name]; ok {
+				continue
+			}
+
+			d.draining[u.Name] = drainingUpstream{
+				deadline: now.Add(timeout),
+				upstream: u,
+			}
+		}
+	}
+
+	names := make([]string, 0, len(d.draining))
+	for name := range d.draining {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var drained []string
+
+	for _, name := range names {
+		// If the drain timeout was removed, the draining upstreams are removed immediately.
+		if timeout == 0 || !now.Before(d.draining[name].deadline) {
+			delete(d.draining, name)
+			drained = append(drained, name)
+			continue
+		}
+
+		conf.Upstreams = append(conf.Upstreams, d.draining[name].upstream)
+	}
+
+	return drained
+}
+
+// expired returns true if the drain deadline of an upstream passed.
+func (d *upstreamDrainer) expired(now time.Time) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for _, u := range d.draining {
+		if !now.Before(u.deadline) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recordDrainedUpstreams emits an Event for the Gateway for every upstream that finished draining
+// and was removed from the NGINX configuration.
+func (h *eventHandlerImpl) recordDrainedUpstreams(gr *graph.Graph, drained []string) {
+	if gr == nil || gr.Gateway == nil || gr.Gateway.Source == nil {
+		return
+	}
+
+	for _, name := range drained {
+		h.cfg.eventRecorder.Eventf(
+			gr.Gateway.Source,
+			v1.EventTypeNormal,
+			"UpstreamDrained",
+			"The upstream %s finished draining and was removed from the NGINX configuration",
+			name,
+		)
+	}
+}
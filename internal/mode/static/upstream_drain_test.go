@@ -0,0 +1,96 @@
+package static
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestUpstreamDrainerRetain(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	blue := dataplane.Upstream{Name: "test_blue_80"}
+	green := dataplane.Upstream{Name: "test_green_80"}
+
+	createConf := func(timeout string, upstreams ...dataplane.Upstream) *dataplane.Configuration {
+		return &dataplane.Configuration{
+			Upstreams:            upstreams,
+			UpstreamDrainTimeout: timeout,
+		}
+	}
+
+	drainer := newUpstreamDrainer()
+
+	// The first configuration has no previous configuration.
+	conf := createConf("30s", blue)
+	g.Expect(drainer.retain(nil, conf, now)).To(BeEmpty())
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{blue}))
+	g.Expect(drainer.expired(now)).To(BeFalse())
+
+	// The cutover to green drains blue.
+	prevConf := conf
+	conf = createConf("30s", green)
+	g.Expect(drainer.retain(prevConf, conf, now)).To(BeEmpty())
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{green, blue}))
+
+	// Blue keeps its deadline while other changes happen.
+	prevConf = conf
+	conf = createConf("30s", green)
+	g.Expect(drainer.retain(prevConf, conf, now.Add(20*time.Second))).To(BeEmpty())
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{green, blue}))
+	g.Expect(drainer.expired(now.Add(29 * time.Second))).To(BeFalse())
+	g.Expect(drainer.expired(now.Add(30 * time.Second))).To(BeTrue())
+
+	// Blue is removed once its deadline passed, and doesn't start draining again.
+	prevConf = conf
+	conf = createConf("30s", green)
+	g.Expect(drainer.retain(prevConf, conf, now.Add(30*time.Second))).To(Equal([]string{"test_blue_80"}))
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{green}))
+	g.Expect(drainer.expired(now.Add(time.Hour))).To(BeFalse())
+
+	// A draining upstream that is referenced again stops draining.
+	prevConf = conf
+	conf = createConf("30s", blue)
+	g.Expect(drainer.retain(prevConf, conf, now)).To(BeEmpty())
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{blue, green}))
+
+	prevConf = conf
+	conf = createConf("30s", blue, green)
+	g.Expect(drainer.retain(prevConf, conf, now)).To(BeEmpty())
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{blue, green}))
+	g.Expect(drainer.expired(now.Add(time.Hour))).To(BeFalse())
+}
+
+func TestUpstreamDrainerRetain_NoTimeout(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	blue := dataplane.Upstream{Name: "test_blue_80"}
+	green := dataplane.Upstream{Name: "test_green_80"}
+
+	drainer := newUpstreamDrainer()
+
+	// Without a drain timeout, the upstreams are removed immediately.
+	conf := &dataplane.Configuration{Upstreams: []dataplane.Upstream{green}}
+	g.Expect(drainer.retain(&dataplane.Configuration{Upstreams: []dataplane.Upstream{blue}}, conf, now)).To(BeEmpty())
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{green}))
+
+	// Removing the drain timeout removes the draining upstreams.
+	prevConf := &dataplane.Configuration{Upstreams: []dataplane.Upstream{blue}}
+	conf = &dataplane.Configuration{Upstreams: []dataplane.Upstream{green}, UpstreamDrainTimeout: "1m"}
+	g.Expect(drainer.retain(prevConf, conf, now)).To(BeEmpty())
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{green, blue}))
+
+	prevConf = conf
+	conf = &dataplane.Configuration{Upstreams: []dataplane.Upstream{green}}
+	g.Expect(drainer.retain(prevConf, conf, now)).To(Equal([]string{"test_blue_80"}))
+	g.Expect(conf.Upstreams).To(Equal([]dataplane.Upstream{green}))
+}
@@ -0,0 +1,7 @@
+/*
+Package webhook contains the validating admission webhooks for NGF and Gateway API resources.
+
+The webhooks reuse the validation of the graph, so that invalid resources are rejected when they are applied
+instead of only being reported in their status.
+*/
+package webhook
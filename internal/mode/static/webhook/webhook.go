@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Register registers the validating admission webhooks for HTTPRoutes and the given NGF Policies
+// with the webhook server of the manager.
+func Register(mgr manager.Manager, validators validation.Validators, policyTypes []policies.Policy) error {
+	err := ctlr.NewWebhookManagedBy(mgr).
+		For(&gatewayv1.HTTPRoute{}).
+		WithValidator(httpRouteValidator{validator: validators.HTTPFieldsValidator}).
+		Complete()
+	if err != nil {
+		return fmt.Errorf("cannot register webhook for HTTPRoute: %w", err)
+	}
+
+	for _, policyType := range policyTypes {
+		err := ctlr.NewWebhookManagedBy(mgr).
+			For(policyType).
+			WithValidator(policyValidator{validator: validators.PolicyValidator}).
+			Complete()
+		if err != nil {
+			return fmt.Errorf("cannot register webhook for %T: %w", policyType, err)
+		}
+	}
+
+	return nil
+}
+
+// httpRouteValidator rejects HTTPRoutes with hostnames, matches, or filters that NGF considers invalid.
+// Implements admission.CustomValidator.
+type httpRouteValidator struct {
+	validator validation.HTTPFieldsValidator
+}
+
+func (v httpRouteValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+func (v httpRouteValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(newObj)
+}
+
+func (v httpRouteValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v httpRouteValidator) validate(obj runtime.Object) error {
+	route, ok := obj.(*gatewayv1.HTTPRoute)
+	if !ok {
+		return fmt.Errorf("expected an HTTPRoute but got %T", obj)
+	}
+
+	return graph.ValidateHTTPRoute(v.validator, route)
+}
+
+// policyValidator rejects NGF Policies with an invalid spec.
+// Implements admission.CustomValidator.
+type policyValidator struct {
+	validator validation.PolicyValidator
+}
+
+// admissionGlobalSettings are the GlobalSettings used to validate Policies on admission.
+// The global settings depend on the NginxProxy resource, which can change after a Policy is created,
+// so Policies are validated as if the settings they depend on are enabled.
+var admissionGlobalSettings = &policies.GlobalSettings{
+	NginxProxyValid:  true,
+	TelemetryEnabled: true,
+	GeoIPEnabled:     true,
+}
+
+func (v policyValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+func (v policyValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(newObj)
+}
+
+func (v policyValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v policyValidator) validate(obj runtime.Object) error {
+	policy, ok := obj.(policies.Policy)
+	if !ok {
+		return fmt.Errorf("expected a Policy but got %T", obj)
+	}
+
+	for _, cond := range v.validator.Validate(policy, admissionGlobalSettings) {
+		if cond.Reason == string(v1alpha2.PolicyReasonInvalid) {
+			return errors.New(cond.Message)
+		}
+	}
+
+	return nil
+}
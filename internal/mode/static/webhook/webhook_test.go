@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestHTTPRouteValidator(t *testing.T) {
+	t.Parallel()
+
+	createRoute := func(hostname gatewayv1.Hostname, path string) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			Spec: gatewayv1.HTTPRouteSpec{
+				Hostnames: []gatewayv1.Hostname{hostname},
+				Rules: []gatewayv1.HTTPRouteRule{
+					{
+						Matches: []gatewayv1.HTTPRouteMatch{
+							{
+								Path: &gatewayv1.HTTPPathMatch{
+									Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+									Value: helpers.GetPointer(path),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		obj             runtime.Object
+		name            string
+		expErrSubstring string
+	}{
+		{
+			name: "valid route",
+			obj:  createRoute("foo.example.com", "/coffee"),
+		},
+		{
+			name:            "invalid hostname",
+			obj:             createRoute("foo.example.com.", "/coffee"),
+			expErrSubstring: "spec.hostnames[0]",
+		},
+		{
+			name:            "invalid path",
+			obj:             createRoute("foo.example.com", "/coffee;"),
+			expErrSubstring: "spec.rules[0].matches[0].path",
+		},
+		{
+			name:            "not an HTTPRoute",
+			obj:             &gatewayv1.GRPCRoute{},
+			expErrSubstring: "expected an HTTPRoute",
+		},
+	}
+
+	v := httpRouteValidator{validator: validation.HTTPValidator{}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			_, createErr := v.ValidateCreate(context.Background(), test.obj)
+			_, updateErr := v.ValidateUpdate(context.Background(), nil, test.obj)
+
+			if test.expErrSubstring == "" {
+				g.Expect(createErr).ToNot(HaveOccurred())
+				g.Expect(updateErr).ToNot(HaveOccurred())
+			} else {
+				g.Expect(createErr).To(MatchError(ContainSubstring(test.expErrSubstring)))
+				g.Expect(updateErr).To(MatchError(ContainSubstring(test.expErrSubstring)))
+			}
+
+			_, deleteErr := v.ValidateDelete(context.Background(), test.obj)
+			g.Expect(deleteErr).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestPolicyValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		obj             runtime.Object
+		name            string
+		expErrSubstring string
+		conds           []conditions.Condition
+	}{
+		{
+			name: "valid policy",
+			obj:  &policiesfakes.FakePolicy{},
+		},
+		{
+			name:            "invalid policy",
+			obj:             &policiesfakes.FakePolicy{},
+			conds:           []conditions.Condition{staticConds.NewPolicyInvalid("invalid spec")},
+			expErrSubstring: "invalid spec",
+		},
+		{
+			name: "policy not accepted for a reason other than an invalid spec",
+			obj:  &policiesfakes.FakePolicy{},
+			conds: []conditions.Condition{
+				staticConds.NewPolicyNotAcceptedNginxProxyNotSet(staticConds.PolicyMessageNginxProxyInvalid),
+			},
+		},
+		{
+			name:            "not a policy",
+			obj:             &gatewayv1.HTTPRoute{},
+			expErrSubstring: "expected a Policy",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			fakeValidator := &validationfakes.FakePolicyValidator{}
+			fakeValidator.ValidateReturns(test.conds)
+			v := policyValidator{validator: fakeValidator}
+
+			_, createErr := v.ValidateCreate(context.Background(), test.obj)
+			_, updateErr := v.ValidateUpdate(context.Background(), nil, test.obj)
+
+			if test.expErrSubstring == "" {
+				g.Expect(createErr).ToNot(HaveOccurred())
+				g.Expect(updateErr).ToNot(HaveOccurred())
+			} else {
+				g.Expect(createErr).To(MatchError(ContainSubstring(test.expErrSubstring)))
+				g.Expect(updateErr).To(MatchError(ContainSubstring(test.expErrSubstring)))
+			}
+
+			_, deleteErr := v.ValidateDelete(context.Background(), test.obj)
+			g.Expect(deleteErr).ToNot(HaveOccurred())
+
+			if fakeValidator.ValidateCallCount() > 0 {
+				_, globalSettings := fakeValidator.ValidateArgsForCall(0)
+				g.Expect(globalSettings).To(Equal(&policies.GlobalSettings{
+					NginxProxyValid:  true,
+					TelemetryEnabled: true,
+					GeoIPEnabled:     true,
+				}))
+			}
+		})
+	}
+}
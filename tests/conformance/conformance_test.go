@@ -19,6 +19,7 @@ package conformance
 
 import (
 	"os"
+	"runtime/debug"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -30,6 +31,9 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// defaultImplementationVersion is the version of NGINX Gateway Fabric built from the main branch.
+const defaultImplementationVersion = "edge"
+
 func TestConformance(t *testing.T) {
 	g := NewWithT(t)
 
@@ -46,7 +50,7 @@ func TestConformance(t *testing.T) {
 		Organization: "nginxinc",
 		Project:      "nginx-gateway-fabric",
 		URL:          "https://github.com/nginxinc/nginx-gateway-fabric",
-		Version:      *flags.ImplementationVersion,
+		Version:      implementationVersion(),
 		Contact: []string{
 			"https://github.com/nginxinc/nginx-gateway-fabric/discussions/new/choose",
 		},
@@ -59,19 +63,42 @@ func TestConformance(t *testing.T) {
 	err = testSuite.Run(t, tests.ConformanceTests)
 	g.Expect(err).To(Not(HaveOccurred()))
 
+	if *flags.ReportOutput == "" {
+		return
+	}
+
 	report, err := testSuite.Report()
 	g.Expect(err).To(Not(HaveOccurred()))
 
 	yamlReport, err := yaml.Marshal(report)
 	g.Expect(err).ToNot(HaveOccurred())
 
-	f, err := os.Create(*flags.ReportOutput)
-	g.Expect(err).ToNot(HaveOccurred())
-	defer f.Close()
+	g.Expect(os.WriteFile(*flags.ReportOutput, yamlReport, 0o600)).To(Succeed())
+	t.Logf("Conformance report written to %s", *flags.ReportOutput)
+}
 
-	_, err = f.WriteString("CONFORMANCE PROFILE\n")
-	g.Expect(err).ToNot(HaveOccurred())
+// implementationVersion returns the version of NGINX Gateway Fabric for the conformance report.
+// The version flag takes precedence. Otherwise, the version is taken from the build info of the test binary,
+// which is the module version or the VCS revision it was built from, and defaults to "edge".
+func implementationVersion() string {
+	if *flags.ImplementationVersion != "" {
+		return *flags.ImplementationVersion
+	}
 
-	_, err = f.Write(yamlReport)
-	g.Expect(err).ToNot(HaveOccurred())
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return defaultImplementationVersion
+	}
+
+	if info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" && setting.Value != "" {
+			return setting.Value
+		}
+	}
+
+	return defaultImplementationVersion
 }
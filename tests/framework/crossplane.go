@@ -148,6 +148,10 @@ func injectCrossplaneContainer(
 								MountPath: "/etc/nginx/module-includes",
 								Name:      "module-includes",
 							},
+							{
+								MountPath: "/etc/nginx/events-includes",
+								Name:      "events-includes",
+							},
 							{
 								MountPath: "/etc/nginx/secrets",
 								Name:      "nginx-secrets",
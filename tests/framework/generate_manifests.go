@@ -15,11 +15,11 @@ import (
 const gwTmplTxt = `apiVersion: gateway.networking.k8s.io/v1
 kind: Gateway
 metadata:
-  name: gateway
+  name: {{ .Name }}
 spec:
   gatewayClassName: nginx
   listeners:
-{{- range $l := . }}
+{{- range $l := .Listeners }}
   - name: {{ $l.Name }}
     hostname: "{{ $l.HostnamePrefix }}.example.com"{{ if ne $l.SecretName "" }}
     port: 443
@@ -40,7 +40,7 @@ metadata:
   name: {{ .Name }}
 spec:
   parentRefs:
-  - name: gateway
+  - name: {{ .GatewayName }}
     sectionName: {{ .ListenerName }}
   hostnames:
   - "{{ .HostnamePrefix }}.example.com"
@@ -105,6 +105,13 @@ var (
 	appTmpl    = template.Must(template.New("app").Parse(appTmplTxt))
 )
 
+const defaultGatewayName = "gateway"
+
+type gateway struct {
+	Name      string
+	Listeners []listener
+}
+
 type listener struct {
 	Name           string
 	HostnamePrefix string
@@ -113,6 +120,7 @@ type listener struct {
 
 type route struct {
 	Name           string
+	GatewayName    string
 	ListenerName   string
 	HostnamePrefix string
 	BackendName    string
@@ -176,6 +184,7 @@ func GenerateScaleListenerObjects(numListeners int, tls bool) (ScaleObjects, err
 
 		r := route{
 			Name:           fmt.Sprintf("route-%d", i),
+			GatewayName:    defaultGatewayName,
 			ListenerName:   listenerName,
 			HostnamePrefix: hostnamePrefix,
 			BackendName:    backendName,
@@ -183,7 +192,12 @@ func GenerateScaleListenerObjects(numListeners int, tls bool) (ScaleObjects, err
 
 		backends = append(backends, backendName)
 
-		objects, err := generateManifests(listeners, []route{r})
+		gw := gateway{
+			Name:      defaultGatewayName,
+			Listeners: listeners,
+		}
+
+		objects, err := generateManifests([]gateway{gw}, []route{r})
 		if err != nil {
 			return ScaleObjects{}, err
 		}
@@ -243,18 +257,19 @@ func GenerateScaleHTTPRouteObjects(numRoutes int) (ScaleObjects, error) {
 	for i := range numRoutes {
 		r := route{
 			Name:           fmt.Sprintf("route-%d", i),
+			GatewayName:    defaultGatewayName,
 			HostnamePrefix: fmt.Sprintf("%d", i),
 			ListenerName:   "listener",
 			BackendName:    backendName,
 		}
 
-		var listeners []listener
+		var gateways []gateway
 		if i == 0 {
 			// only generate a Gateway on the first iteration
-			listeners = []listener{l}
+			gateways = []gateway{{Name: defaultGatewayName, Listeners: []listener{l}}}
 		}
 
-		objects, err := generateManifests(listeners, []route{r})
+		objects, err := generateManifests(gateways, []route{r})
 		if err != nil {
 			return ScaleObjects{}, err
 		}
@@ -272,11 +287,68 @@ func GenerateScaleHTTPRouteObjects(numRoutes int) (ScaleObjects, error) {
 	return result, nil
 }
 
-func generateManifests(listeners []listener, routes []route) ([]client.Object, error) {
+// GenerateScaleObjects generates objects for a given number of Gateways, HTTPRoutes, and backends for the scale test.
+// The HTTPRoutes are distributed across the Gateways and backends in a round-robin fashion.
+// All Gateways and HTTPRoutes are returned in a single scale iteration group, so that they can be applied at once.
+func GenerateScaleObjects(numGateways, numRoutes, numBackends int) (ScaleObjects, error) {
+	if numGateways < 1 || numBackends < 1 {
+		return ScaleObjects{}, errors.New("at least one Gateway and one backend are required")
+	}
+
+	gateways := make([]gateway, 0, numGateways)
+	for i := range numGateways {
+		gateways = append(gateways, gateway{
+			Name: fmt.Sprintf("gateway-%d", i),
+			Listeners: []listener{
+				{
+					Name:           "listener",
+					HostnamePrefix: "*",
+				},
+			},
+		})
+	}
+
+	backends := make([]string, 0, numBackends)
+	for i := range numBackends {
+		backends = append(backends, fmt.Sprintf("backend-%d", i))
+	}
+
+	routes := make([]route, 0, numRoutes)
+	for i := range numRoutes {
+		routes = append(routes, route{
+			Name:           fmt.Sprintf("route-%d", i),
+			GatewayName:    gateways[i%numGateways].Name,
+			ListenerName:   "listener",
+			HostnamePrefix: fmt.Sprintf("%d", i),
+			BackendName:    backends[i%numBackends],
+		})
+	}
+
+	objects, err := generateManifests(gateways, routes)
+	if err != nil {
+		return ScaleObjects{}, err
+	}
+
+	backendObjects, err := generateBackendAppObjects(backends)
+	if err != nil {
+		return ScaleObjects{}, err
+	}
+
+	return ScaleObjects{
+		BaseObjects:          backendObjects,
+		ScaleIterationGroups: [][]client.Object{objects},
+	}, nil
+}
+
+func generateManifests(gateways []gateway, routes []route) ([]client.Object, error) {
 	var buf bytes.Buffer
 
-	if len(listeners) > 0 {
-		if err := gwTmpl.Execute(&buf, listeners); err != nil {
+	for _, gw := range gateways {
+		if buf.Len() > 0 {
+			buf.WriteString("\n---\n")
+		}
+
+		if err := gwTmpl.Execute(&buf, gw); err != nil {
 			return nil, err
 		}
 	}
@@ -265,6 +265,32 @@ func GetFirstValueOfPrometheusVector(val model.Value) (float64, error) {
 	return float64(res[0].Value), nil
 }
 
+// GetMaxValueOfPrometheusMatrix returns the max value across all samples of a Prometheus matrix.
+func GetMaxValueOfPrometheusMatrix(val model.Value) (float64, error) {
+	matrix, ok := val.(model.Matrix)
+	if !ok {
+		return 0, fmt.Errorf("expected a matrix, got %T", val)
+	}
+
+	var maxVal float64
+	var found bool
+
+	for _, sample := range matrix {
+		for _, pair := range sample.Values {
+			if v := float64(pair.Value); !found || v > maxVal {
+				maxVal = v
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		return 0, errors.New("empty matrix")
+	}
+
+	return maxVal, nil
+}
+
 // WritePrometheusMatrixToCSVFile writes a Prometheus matrix to a CSV file.
 func WritePrometheusMatrixToCSVFile(fileName string, value model.Value) error {
 	file, err := os.Create(fileName)
@@ -579,6 +605,41 @@ func CreateResponseChecker(url, address string, requestTimeout time.Duration) fu
 	}
 }
 
+// RuntimeStats contains the runtime stats of the NGF controller that indicate resource leaks when they grow over time.
+type RuntimeStats struct {
+	// HeapInuseBytes is the number of bytes in in-use heap spans.
+	HeapInuseBytes float64 `json:"heapInuseBytes"`
+	// Goroutines is the number of goroutines.
+	Goroutines float64 `json:"goroutines"`
+	// OpenFDs is the number of open file descriptors.
+	OpenFDs float64 `json:"openFDs"`
+}
+
+// GetRuntimeStats gets the current runtime stats of the NGF controller.
+// The stats are exported by the Go and process collectors of the controller-runtime metrics registry.
+func GetRuntimeStats(promInstance PrometheusInstance, ngfPodName string) (RuntimeStats, error) {
+	heap, err := getFirstValueOfVector(fmt.Sprintf(`go_memstats_heap_inuse_bytes{pod="%s"}`, ngfPodName), promInstance)
+	if err != nil {
+		return RuntimeStats{}, fmt.Errorf("failed to get heap stats: %w", err)
+	}
+
+	goroutines, err := getFirstValueOfVector(fmt.Sprintf(`go_goroutines{pod="%s"}`, ngfPodName), promInstance)
+	if err != nil {
+		return RuntimeStats{}, fmt.Errorf("failed to get goroutine count: %w", err)
+	}
+
+	fds, err := getFirstValueOfVector(fmt.Sprintf(`process_open_fds{pod="%s"}`, ngfPodName), promInstance)
+	if err != nil {
+		return RuntimeStats{}, fmt.Errorf("failed to get open file descriptor count: %w", err)
+	}
+
+	return RuntimeStats{
+		HeapInuseBytes: heap,
+		Goroutines:     goroutines,
+		OpenFDs:        fds,
+	}, nil
+}
+
 func getFirstValueOfVector(query string, promInstance PrometheusInstance) (float64, error) {
 	result, err := promInstance.Query(query)
 	if err != nil {
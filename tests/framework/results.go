@@ -2,6 +2,7 @@ package framework
 
 import (
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -124,6 +125,16 @@ func WriteContent(resultsFile *os.File, content string) error {
 	return nil
 }
 
+// WriteJSONResults writes the results to the given file in JSON format, so that they can be consumed by other tools.
+func WriteJSONResults(filename string, results any) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(data, '\n'), 0o644)
+}
+
 // NewVegetaCSVEncoder returns a vegeta CSV encoder.
 func NewVegetaCSVEncoder(w io.Writer) vegeta.Encoder {
 	return vegeta.NewCSVEncoder(w)
@@ -0,0 +1,12 @@
+#!/usr/bin/env bash
+
+set -eo pipefail
+
+# Fails if the core or the extended result of any conformance profile in the report is a failure.
+REPORT=${1:-conformance-profile.yaml}
+
+FAILED=$(yq '[.profiles[] | select(.core.result == "failure" or .extended.result == "failure") | .name] | join(",")' "${REPORT}")
+if [ -n "${FAILED}" ]; then
+    echo "Conformance profiles failed: ${FAILED}"
+    exit 2
+fi
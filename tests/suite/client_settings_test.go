@@ -373,7 +373,11 @@ func waitForClientSettingsAncestorStatus(
 
 			ancestor := pol.Status.Ancestors[0]
 
-			if err := ancestorMustEqualTargetRef(ancestor, pol.GetTargetRefs()[0], policyNsname.Namespace); err != nil {
+			if err := ancestorMustEqualTargetRef(
+				ancestor,
+				pol.GetTargetRefs()[0].LocalPolicyTargetReference,
+				policyNsname.Namespace,
+			); err != nil {
 				return false, err
 			}
 
@@ -55,9 +55,13 @@ var _ = Describe("Scale test", Ordered, Label("nfr", "scale"), func() {
 	)
 
 	const (
-		httpListenerCount       = 64
-		httpsListenerCount      = 64
-		httpRouteCount          = 1000
+		httpListenerCount  = 64
+		httpsListenerCount = 64
+		httpRouteCount     = 1000
+		// NGF programs only one Gateway per GatewayClass, so the combined scale test uses a single Gateway.
+		combinedGatewayCount    = 1
+		combinedRouteCount      = 500
+		combinedBackendCount    = 50
 		ossUpstreamServerCount  = 648
 		plusUpstreamServerCount = 556
 	)
@@ -117,18 +121,22 @@ var _ = Describe("Scale test", Ordered, Label("nfr", "scale"), func() {
 	})
 
 	type scaleTestResults struct {
-		Name                   string
-		EventsBuckets          []framework.Bucket
-		ReloadBuckets          []framework.Bucket
-		EventsAvgTime          int
-		EventsCount            int
-		NGFContainerRestarts   int
-		NGFErrors              int
-		NginxContainerRestarts int
-		NginxErrors            int
-		ReloadAvgTime          int
-		ReloadCount            int
-		ReloadErrsCount        int
+		Name                   string             `json:"name"`
+		Version                string             `json:"version"`
+		EventsBuckets          []framework.Bucket `json:"eventsBuckets"`
+		ReloadBuckets          []framework.Bucket `json:"reloadBuckets"`
+		EventsAvgTime          int                `json:"eventsAvgTimeMs"`
+		EventsCount            int                `json:"eventsCount"`
+		NGFContainerRestarts   int                `json:"ngfContainerRestarts"`
+		NGFErrors              int                `json:"ngfErrors"`
+		NGFMaxMemoryBytes      int                `json:"ngfMaxMemoryBytes"`
+		NginxContainerRestarts int                `json:"nginxContainerRestarts"`
+		NginxErrors            int                `json:"nginxErrors"`
+		ReloadAvgTime          int                `json:"reloadAvgTimeMs"`
+		ReloadCount            int                `json:"reloadCount"`
+		ReloadErrsCount        int                `json:"reloadErrsCount"`
+		TimeToProgrammed       int                `json:"timeToProgrammedMs,omitempty"`
+		Plus                   bool               `json:"plus"`
 	}
 
 	const scaleResultTemplate = `
@@ -153,6 +161,17 @@ var _ = Describe("Scale test", Ordered, Label("nfr", "scale"), func() {
 	- {{ .Le }}ms: {{ .Val }}
 {{- end }}
 
+{{- if .TimeToProgrammed }}
+
+### Time to Programmed
+
+- Time for all Gateways and HTTPRoutes to be programmed: {{ .TimeToProgrammed }}ms
+{{- end }}
+
+### Memory
+
+- NGF max memory usage: {{ .NGFMaxMemoryBytes }} bytes
+
 ### Errors
 
 - NGF errors: {{ .NGFErrors }}
@@ -218,7 +237,10 @@ The logs are attached only if there are errors.
 		return len(errors)
 	}
 
-	runTestWithMetricsAndLogs := func(testName, testResultsDir string, test func()) {
+	// runTestWithMetricsAndResults runs the test and collects the metrics and logs of NGF.
+	// The test can record its own measurements in the results, which are written to the results file
+	// and to a JSON file in the test results directory.
+	runTestWithMetricsAndResults := func(testName, testResultsDir string, test func(results *scaleTestResults)) {
 		var (
 			metricExistTimeout = 2 * time.Minute
 			metricExistPolling = 1 * time.Second
@@ -251,7 +273,8 @@ The logs are attached only if there are errors.
 			).WithTimeout(metricExistTimeout).WithPolling(metricExistPolling).Should(Succeed())
 		}
 
-		test()
+		var results scaleTestResults
+		test(&results)
 
 		// We sleep for 2 scape intervals to ensure that Prometheus scrapes the metrics after the test() finishes
 		// before endTime, so that we don't lose any metric values like reloads.
@@ -310,6 +333,9 @@ The logs are attached only if there are errors.
 		memCSV := filepath.Join(testResultsDir, framework.CreateResultsFilename("csv", "memory", *plusEnabled))
 		Expect(framework.WritePrometheusMatrixToCSVFile(memCSV, result)).To(Succeed())
 
+		maxMemory, err := framework.GetMaxValueOfPrometheusMatrix(result)
+		Expect(err).ToNot(HaveOccurred())
+
 		memPNG := framework.CreateResultsFilename("png", "memory", *plusEnabled)
 		Expect(
 			framework.GenerateMemoryPNG(testResultsDir, memCSV, memPNG),
@@ -393,23 +419,31 @@ The logs are attached only if there are errors.
 
 		// Write results
 
-		results := scaleTestResults{
-			Name:                   testName,
-			ReloadCount:            int(reloadCount),
-			ReloadErrsCount:        int(reloadErrsCount),
-			ReloadAvgTime:          int(reloadAvgTime),
-			ReloadBuckets:          reloadBuckets,
-			EventsCount:            int(eventsCount),
-			EventsAvgTime:          int(eventsAvgTime),
-			EventsBuckets:          eventsBuckets,
-			NGFErrors:              ngfErrors,
-			NginxErrors:            nginxErrors,
-			NGFContainerRestarts:   ngfRestarts,
-			NginxContainerRestarts: nginxRestarts,
-		}
+		results.Name = testName
+		results.Version = version
+		results.Plus = *plusEnabled
+		results.ReloadCount = int(reloadCount)
+		results.ReloadErrsCount = int(reloadErrsCount)
+		results.ReloadAvgTime = int(reloadAvgTime)
+		results.ReloadBuckets = reloadBuckets
+		results.EventsCount = int(eventsCount)
+		results.EventsAvgTime = int(eventsAvgTime)
+		results.EventsBuckets = eventsBuckets
+		results.NGFMaxMemoryBytes = int(maxMemory)
+		results.NGFErrors = ngfErrors
+		results.NginxErrors = nginxErrors
+		results.NGFContainerRestarts = ngfRestarts
+		results.NginxContainerRestarts = nginxRestarts
 
 		err = writeScaleResults(outFile, results)
 		Expect(err).ToNot(HaveOccurred())
+
+		jsonFile := filepath.Join(testResultsDir, framework.CreateResultsFilename("json", "results", *plusEnabled))
+		Expect(framework.WriteJSONResults(jsonFile, results)).To(Succeed())
+	}
+
+	runTestWithMetricsAndLogs := func(testName, testResultsDir string, test func()) {
+		runTestWithMetricsAndResults(testName, testResultsDir, func(*scaleTestResults) { test() })
 	}
 
 	runScaleResources := func(objects framework.ScaleObjects, testResultsDir string, protocol string) {
@@ -462,6 +496,25 @@ The logs are attached only if there are errors.
 		Expect(os.Remove(ttrCsvFile.Name())).To(Succeed())
 	}
 
+	runScaleGatewaysAndRoutes := func(objects framework.ScaleObjects) time.Duration {
+		Expect(resourceManager.Apply(objects.BaseObjects)).To(Succeed())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		Expect(resourceManager.WaitForPodsToBeReady(ctx, namespace)).To(Succeed())
+
+		start := time.Now()
+
+		for _, objs := range objects.ScaleIterationGroups {
+			Expect(resourceManager.Apply(objs)).To(Succeed())
+		}
+
+		Expect(resourceManager.WaitForAppsToBeReadyWithCtx(ctx, namespace)).To(Succeed())
+
+		return time.Since(start)
+	}
+
 	runScaleUpstreams := func() {
 		Expect(resourceManager.ApplyFromFiles(upstreamsManifests, namespace)).To(Succeed())
 		Expect(resourceManager.WaitForAppsToBeReady(namespace)).To(Succeed())
@@ -574,6 +627,31 @@ The logs are attached only if there are errors.
 		)
 	})
 
+	It(fmt.Sprintf(
+		"scales %d Gateway(s), %d HTTP routes, and %d backends",
+		combinedGatewayCount,
+		combinedRouteCount,
+		combinedBackendCount,
+	), func() {
+		const testName = "TestScale_GatewaysRoutesBackends"
+
+		testResultsDir := filepath.Join(resultsDir, testName)
+		Expect(os.MkdirAll(testResultsDir, 0o755)).To(Succeed())
+
+		objects, err := framework.GenerateScaleObjects(combinedGatewayCount, combinedRouteCount, combinedBackendCount)
+		Expect(err).ToNot(HaveOccurred())
+
+		setNamespace(objects)
+
+		runTestWithMetricsAndResults(
+			testName,
+			testResultsDir,
+			func(results *scaleTestResults) {
+				results.TimeToProgrammed = int(runScaleGatewaysAndRoutes(objects).Milliseconds())
+			},
+		)
+	})
+
 	It(fmt.Sprintf("scales upstream servers to %d for OSS and %d for Plus",
 		ossUpstreamServerCount,
 		plusUpstreamServerCount,
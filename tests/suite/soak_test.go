@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctlr "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginxinc/nginx-gateway-fabric/tests/framework"
+)
+
+// Soak test is an NFR test, but does not include the "nfr" label, because it runs for a long time
+// (configured by the soak-duration flag) and needs to run on its own.
+// It continuously churns resources and checks that the runtime stats of NGF (heap, goroutines, open file descriptors)
+// return to their baseline once the churn stops, to detect leaks.
+var _ = Describe("Soak test", Ordered, Label("soak"), func() {
+	var (
+		namespace = "soak"
+
+		scrapeInterval = 15 * time.Second
+		sampleInterval = 1 * time.Minute
+
+		resultsDir            string
+		ngfPodName            string
+		promInstance          framework.PrometheusInstance
+		promPortForwardStopCh = make(chan struct{})
+	)
+
+	const (
+		soakRouteCount   = 50
+		soakBackendCount = 5
+
+		// The max allowed growth of the runtime stats after the churn, relative to the baseline.
+		// The heap growth limit is more permissive, because the heap size depends on when the garbage collector runs.
+		maxHeapGrowth      = 0.5
+		maxGoroutineGrowth = 0.2
+		maxOpenFDsGrowth   = 0.2
+	)
+
+	type runtimeStatsSample struct {
+		framework.RuntimeStats
+		Time       time.Time `json:"time"`
+		Iterations int       `json:"iterations"`
+	}
+
+	type soakTestResults struct {
+		Version    string                 `json:"version"`
+		Samples    []runtimeStatsSample   `json:"samples"`
+		Baseline   framework.RuntimeStats `json:"baseline"`
+		Final      framework.RuntimeStats `json:"final"`
+		Duration   string                 `json:"duration"`
+		Iterations int                    `json:"iterations"`
+		Plus       bool                   `json:"plus"`
+	}
+
+	BeforeAll(func() {
+		var err error
+		resultsDir, err = framework.CreateResultsDir("soak", version)
+		Expect(err).ToNot(HaveOccurred())
+
+		promCfg := framework.PrometheusConfig{
+			ScrapeInterval: scrapeInterval,
+		}
+
+		promInstance, err = framework.InstallPrometheus(resourceManager, promCfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		if !clusterInfo.IsGKE {
+			Expect(promInstance.PortForward(ctlr.GetConfigOrDie(), promPortForwardStopCh)).To(Succeed())
+		}
+
+		ns := &core.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+		Expect(resourceManager.Apply([]client.Object{ns})).To(Succeed())
+
+		podNames, err := framework.GetReadyNGFPodNames(k8sClient, ngfNamespace, releaseName, timeoutConfig.GetTimeout)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(podNames).To(HaveLen(1))
+		ngfPodName = podNames[0]
+	})
+
+	AfterAll(func() {
+		Expect(resourceManager.DeleteNamespace(namespace)).To(Succeed())
+		close(promPortForwardStopCh)
+		Expect(framework.UninstallPrometheus(resourceManager)).To(Succeed())
+	})
+
+	getRuntimeStats := func() framework.RuntimeStats {
+		var stats framework.RuntimeStats
+
+		Eventually(func() error {
+			var err error
+			stats, err = framework.GetRuntimeStats(promInstance, ngfPodName)
+			return err
+		}).WithTimeout(2 * time.Minute).WithPolling(scrapeInterval).Should(Succeed())
+
+		return stats
+	}
+
+	// waitForStableRuntimeStats waits for the in-flight work of NGF to settle and for Prometheus to scrape
+	// the settled runtime stats.
+	waitForStableRuntimeStats := func() framework.RuntimeStats {
+		time.Sleep(4 * scrapeInterval)
+		return getRuntimeStats()
+	}
+
+	waitForResources := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		Expect(resourceManager.WaitForAppsToBeReadyWithCtx(ctx, namespace)).To(Succeed())
+	}
+
+	// copyObjects returns copies of the objects, so that they can be created again after they're deleted.
+	copyObjects := func(objects []client.Object) []client.Object {
+		copies := make([]client.Object, 0, len(objects))
+		for _, obj := range objects {
+			objCopy, ok := obj.DeepCopyObject().(client.Object)
+			Expect(ok).To(BeTrue())
+			copies = append(copies, objCopy)
+		}
+
+		return copies
+	}
+
+	checkGrowth := func(name string, baseline, final, maxGrowth float64) {
+		GinkgoWriter.Printf("%s: baseline %v, final %v\n", name, baseline, final)
+
+		Expect(final).To(
+			BeNumerically("<=", baseline*(1+maxGrowth)),
+			fmt.Sprintf("%s grew from %v to %v, which exceeds the max growth of %v%%", name, baseline, final, maxGrowth*100),
+		)
+	}
+
+	It("does not leak resources under continuous resource churn", func() {
+		objects, err := framework.GenerateScaleObjects(1, soakRouteCount, soakBackendCount)
+		Expect(err).ToNot(HaveOccurred())
+
+		var gateways, routes []client.Object
+		for _, obj := range objects.ScaleIterationGroups[0] {
+			if obj.GetObjectKind().GroupVersionKind().Kind == "HTTPRoute" {
+				routes = append(routes, obj)
+			} else {
+				gateways = append(gateways, obj)
+			}
+		}
+
+		for _, obj := range objects.BaseObjects {
+			obj.SetNamespace(namespace)
+		}
+		for _, obj := range objects.ScaleIterationGroups[0] {
+			obj.SetNamespace(namespace)
+		}
+
+		Expect(resourceManager.Apply(objects.BaseObjects)).To(Succeed())
+		Expect(resourceManager.Apply(gateways)).To(Succeed())
+		Expect(resourceManager.Apply(copyObjects(routes))).To(Succeed())
+		waitForResources()
+
+		results := soakTestResults{
+			Version:  version,
+			Plus:     *plusEnabled,
+			Duration: soakDuration.String(),
+			Baseline: waitForStableRuntimeStats(),
+		}
+
+		start := time.Now()
+		lastSample := start
+
+		for time.Since(start) < *soakDuration {
+			// churn HTTPRoutes
+			Expect(resourceManager.Delete(routes)).To(Succeed())
+			Expect(resourceManager.Apply(copyObjects(routes))).To(Succeed())
+
+			// churn endpoints
+			backend := fmt.Sprintf("backend-%d", results.Iterations%soakBackendCount)
+			Expect(resourceManager.ScaleDeployment(namespace, backend, 2)).To(Succeed())
+			waitForResources()
+			Expect(resourceManager.ScaleDeployment(namespace, backend, 1)).To(Succeed())
+			waitForResources()
+
+			results.Iterations++
+
+			if time.Since(lastSample) >= sampleInterval {
+				results.Samples = append(results.Samples, runtimeStatsSample{
+					RuntimeStats: getRuntimeStats(),
+					Time:         time.Now(),
+					Iterations:   results.Iterations,
+				})
+				lastSample = time.Now()
+			}
+		}
+
+		results.Final = waitForStableRuntimeStats()
+
+		filename := filepath.Join(resultsDir, framework.CreateResultsFilename("json", version, *plusEnabled))
+		Expect(framework.WriteJSONResults(filename, results)).To(Succeed())
+
+		checkGrowth("heap in use bytes", results.Baseline.HeapInuseBytes, results.Final.HeapInuseBytes, maxHeapGrowth)
+		checkGrowth("goroutines", results.Baseline.Goroutines, results.Final.Goroutines, maxGoroutineGrowth)
+		checkGrowth("open file descriptors", results.Baseline.OpenFDs, results.Final.OpenFDs, maxOpenFDsGrowth)
+	})
+})
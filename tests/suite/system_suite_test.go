@@ -62,6 +62,9 @@ var (
 	isGKEInternalLB          = flag.Bool("is-gke-internal-lb", false, "Is the LB service GKE internal only")
 	plusEnabled              = flag.Bool("plus-enabled", false, "Is NGINX Plus enabled")
 	clusterName              = flag.String("cluster-name", "kind", "Cluster name")
+	soakDuration             = flag.Duration(
+		"soak-duration", time.Hour, "Duration of the continuous resource churn in the soak test",
+	)
 )
 
 var (
@@ -335,6 +338,7 @@ func isNFR(labelFilter string) bool {
 		strings.Contains(labelFilter, "performance") ||
 		strings.Contains(labelFilter, "upgrade") ||
 		strings.Contains(labelFilter, "scale") ||
+		strings.Contains(labelFilter, "soak") ||
 		strings.Contains(labelFilter, "reconfiguration")
 }
 